@@ -10,6 +10,7 @@ import (
 	"github.com/rilldata/rill/admin/billing"
 	"github.com/rilldata/rill/admin/billing/payment"
 	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/gitprovider"
 	"github.com/rilldata/rill/admin/jobs"
 	"github.com/rilldata/rill/admin/provisioner"
 	"github.com/rilldata/rill/runtime/pkg/email"
@@ -40,6 +41,7 @@ type Service struct {
 	ProvisionerSet      map[string]provisioner.Provisioner
 	Email               *email.Client
 	Github              Github
+	GitProviders        map[string]gitprovider.Provider
 	AI                  ai.Client
 	Assets              *storage.BucketHandle
 	Used                *usedFlusher
@@ -117,6 +119,7 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 		ProvisionerSet:      provSet,
 		Email:               emailClient,
 		Github:              github,
+		GitProviders:        newGitProviders(github),
 		AI:                  aiClient,
 		Assets:              assets,
 		Used:                newUsedFlusher(logger, db),
@@ -7,5 +7,7 @@ import (
 )
 
 type Client interface {
-	Complete(ctx context.Context, msgs []*adminv1.CompletionMessage) (*adminv1.CompletionMessage, error)
+	// Complete sends the messages of a chat to the AI and asks it to generate a new message.
+	// If tools are provided, the AI may respond with calls to the tools instead of text.
+	Complete(ctx context.Context, msgs []*adminv1.CompletionMessage, tools []*adminv1.Tool) (*adminv1.CompletionMessage, error)
 }
@@ -2,6 +2,7 @@ package ai
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -24,18 +25,46 @@ func NewOpenAI(apiKey string) (Client, error) {
 	}, nil
 }
 
-func (c *openAI) Complete(ctx context.Context, msgs []*adminv1.CompletionMessage) (*adminv1.CompletionMessage, error) {
+func (c *openAI) Complete(ctx context.Context, msgs []*adminv1.CompletionMessage, tools []*adminv1.Tool) (*adminv1.CompletionMessage, error) {
 	reqMsgs := make([]openai.ChatCompletionMessage, len(msgs))
 	for i, msg := range msgs {
 		reqMsgs[i] = openai.ChatCompletionMessage{
-			Role:    msg.Role,
-			Content: msg.Data,
+			Role:       msg.Role,
+			Content:    msg.Data,
+			ToolCallID: msg.ToolCallId,
 		}
+		for _, call := range msg.ToolCalls {
+			reqMsgs[i].ToolCalls = append(reqMsgs[i].ToolCalls, openai.ToolCall{
+				ID:   call.Id,
+				Type: openai.ToolTypeFunction,
+				Function: openai.FunctionCall{
+					Name:      call.Name,
+					Arguments: call.Input,
+				},
+			})
+		}
+	}
+
+	var reqTools []openai.Tool
+	for _, tool := range tools {
+		var params any
+		if tool.InputSchema != "" {
+			params = json.RawMessage(tool.InputSchema)
+		}
+		reqTools = append(reqTools, openai.Tool{
+			Type: openai.ToolTypeFunction,
+			Function: &openai.FunctionDefinition{
+				Name:        tool.Name,
+				Description: tool.Description,
+				Parameters:  params,
+			},
+		})
 	}
 
 	res, err := c.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
 		Model:       openai.GPT4o,
 		Messages:    reqMsgs,
+		Tools:       reqTools,
 		Temperature: 0.2,
 	})
 	if err != nil {
@@ -46,8 +75,17 @@ func (c *openAI) Complete(ctx context.Context, msgs []*adminv1.CompletionMessage
 		return nil, errors.New("no choices returned")
 	}
 
-	return &adminv1.CompletionMessage{
+	resMsg := &adminv1.CompletionMessage{
 		Role: openai.ChatMessageRoleAssistant,
 		Data: res.Choices[0].Message.Content,
-	}, nil
+	}
+	for _, call := range res.Choices[0].Message.ToolCalls {
+		resMsg.ToolCalls = append(resMsg.ToolCalls, &adminv1.ToolCall{
+			Id:    call.ID,
+			Name:  call.Function.Name,
+			Input: call.Function.Arguments,
+		})
+	}
+
+	return resMsg, nil
 }
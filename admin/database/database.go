@@ -102,6 +102,8 @@ type DB interface {
 	FindDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
 	FindDeployment(ctx context.Context, id string) (*Deployment, error)
 	FindDeploymentByInstanceID(ctx context.Context, instanceID string) (*Deployment, error)
+	FindPreviewDeployment(ctx context.Context, projectID, branch string) (*Deployment, error)
+	FindExpiredPreviewDeployments(ctx context.Context, ttl time.Duration) ([]*Deployment, error)
 	InsertDeployment(ctx context.Context, opts *InsertDeploymentOptions) (*Deployment, error)
 	DeleteDeployment(ctx context.Context, id string) error
 	UpdateDeployment(ctx context.Context, id string, opts *UpdateDeploymentOptions) (*Deployment, error)
@@ -298,6 +300,13 @@ type DB interface {
 	InsertProvisionerResource(ctx context.Context, opts *InsertProvisionerResourceOptions) (*ProvisionerResource, error)
 	UpdateProvisionerResource(ctx context.Context, id string, opts *UpdateProvisionerResourceOptions) (*ProvisionerResource, error)
 	DeleteProvisionerResource(ctx context.Context, id string) error
+
+	FindProjectGitConnections(ctx context.Context, afterProjectID string, limit int) ([]*ProjectGitConnection, error)
+	FindProjectGitConnection(ctx context.Context, projectID string) (*ProjectGitConnection, error)
+	UpsertProjectGitConnection(ctx context.Context, opts *UpsertProjectGitConnectionOptions) (*ProjectGitConnection, error)
+	UpdateProjectGitConnectionBranchHeads(ctx context.Context, projectID string, prevHeads, heads map[string]string, polled bool) (bool, error)
+	PatchProjectGitConnectionBranchHeads(ctx context.Context, projectID string, set map[string]string, unset []string) error
+	DeleteProjectGitConnection(ctx context.Context, projectID string) error
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -472,6 +481,7 @@ type Deployment struct {
 	ID                string           `db:"id"`
 	ProjectID         string           `db:"project_id"`
 	Branch            string           `db:"branch"`
+	Preview           bool             `db:"preview"`
 	RuntimeHost       string           `db:"runtime_host"`
 	RuntimeInstanceID string           `db:"runtime_instance_id"`
 	RuntimeAudience   string           `db:"runtime_audience"`
@@ -486,6 +496,7 @@ type Deployment struct {
 type InsertDeploymentOptions struct {
 	ProjectID         string
 	Branch            string
+	Preview           bool
 	RuntimeHost       string
 	RuntimeInstanceID string
 	RuntimeAudience   string
@@ -1204,3 +1215,33 @@ type UpdateProvisionerResourceOptions struct {
 	State         map[string]any
 	Config        map[string]any
 }
+
+// ProjectGitConnection connects a project to a Git repository on a provider other than the Github App (such as GitLab, Bitbucket or a generic Git server).
+// The Password, SSHPrivateKey and WebhookSecret fields are encrypted at rest.
+type ProjectGitConnection struct {
+	ProjectID     string            `db:"project_id"`
+	Provider      string            `db:"provider"`
+	Remote        string            `db:"remote"`
+	Username      string            `db:"username"`
+	Password      string            `db:"-"`
+	SSHPrivateKey string            `db:"-"`
+	SSHHostKey    string            `db:"ssh_host_key"`
+	WebhookSecret string            `db:"-"`
+	BranchHeads   map[string]string `db:"-"`
+	PolledOn      *time.Time        `db:"polled_on"`
+	CreatedOn     time.Time         `db:"created_on"`
+	UpdatedOn     time.Time         `db:"updated_on"`
+}
+
+// UpsertProjectGitConnectionOptions defines options for creating or replacing a ProjectGitConnection.
+// Replacing a connection resets its branch heads.
+type UpsertProjectGitConnectionOptions struct {
+	ProjectID     string `validate:"required"`
+	Provider      string `validate:"required"`
+	Remote        string `validate:"required"`
+	Username      string
+	Password      string
+	SSHPrivateKey string
+	SSHHostKey    string
+	WebhookSecret string
+}
@@ -0,0 +1,2 @@
+ALTER TABLE deployments ADD COLUMN preview BOOLEAN NOT NULL DEFAULT false;
+CREATE UNIQUE INDEX deployments_project_id_preview_branch_idx ON deployments (project_id, branch) WHERE preview;
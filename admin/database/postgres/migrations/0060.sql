@@ -0,0 +1,13 @@
+CREATE TABLE project_git_connections (
+	project_id UUID PRIMARY KEY REFERENCES projects (id) ON DELETE CASCADE,
+	provider TEXT NOT NULL,
+	remote TEXT NOT NULL,
+	username TEXT NOT NULL DEFAULT '',
+	secrets BYTEA NOT NULL,
+	secrets_encryption_key_id TEXT NOT NULL DEFAULT '',
+	ssh_host_key TEXT NOT NULL DEFAULT '',
+	branch_heads JSONB NOT NULL DEFAULT '{}'::JSONB,
+	polled_on TIMESTAMPTZ,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
@@ -500,7 +500,7 @@ func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.De
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT d.* FROM deployments d
 		JOIN projects p ON d.project_id = p.id
-		WHERE p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
+		WHERE NOT d.preview AND p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
 	`)
 	if err != nil {
 		return nil, parseErr("deployments", err)
@@ -535,6 +535,28 @@ func (c *connection) FindDeploymentByInstanceID(ctx context.Context, instanceID
 	return res, nil
 }
 
+func (c *connection) FindPreviewDeployment(ctx context.Context, projectID, branch string) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.branch=$2 AND d.preview", projectID, branch).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res, nil
+}
+
+// FindExpiredPreviewDeployments returns all the preview deployments that have not been used within the ttl
+func (c *connection) FindExpiredPreviewDeployments(ctx context.Context, ttl time.Duration) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT d.* FROM deployments d
+		WHERE d.preview AND d.used_on + $1 * interval '1 second' < now()
+	`, int64(ttl.Seconds()))
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertDeployment(ctx context.Context, opts *database.InsertDeploymentOptions) (*database.Deployment, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
@@ -542,9 +564,9 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, branch, runtime_host, runtime_instance_id, runtime_audience, status, status_message)
-		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`,
-		opts.ProjectID, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.Status, opts.StatusMessage,
+		INSERT INTO deployments (project_id, branch, preview, runtime_host, runtime_instance_id, runtime_audience, status, status_message)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`,
+		opts.ProjectID, opts.Branch, opts.Preview, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.Status, opts.StatusMessage,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
@@ -2313,6 +2335,138 @@ func (c *connection) DeleteProvisionerResource(ctx context.Context, id string) e
 	return checkDeleteRow("provisioner resource", res, err)
 }
 
+func (c *connection) FindProjectGitConnections(ctx context.Context, afterProjectID string, limit int) ([]*database.ProjectGitConnection, error) {
+	var qry strings.Builder
+	var args []any
+	qry.WriteString("SELECT * FROM project_git_connections ")
+	if afterProjectID != "" {
+		qry.WriteString("WHERE project_id > $1 ORDER BY project_id LIMIT $2")
+		args = []any{afterProjectID, limit}
+	} else {
+		qry.WriteString("ORDER BY project_id LIMIT $1")
+		args = []any{limit}
+	}
+
+	var res []*projectGitConnectionDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, qry.String(), args...)
+	if err != nil {
+		return nil, parseErr("project git connections", err)
+	}
+
+	conns := make([]*database.ProjectGitConnection, len(res))
+	for i, dto := range res {
+		conns[i], err = c.projectGitConnectionFromDTO(dto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return conns, nil
+}
+
+func (c *connection) FindProjectGitConnection(ctx context.Context, projectID string) (*database.ProjectGitConnection, error) {
+	res := &projectGitConnectionDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_git_connections WHERE project_id=$1", projectID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project git connection", err)
+	}
+	return c.projectGitConnectionFromDTO(res)
+}
+
+func (c *connection) UpsertProjectGitConnection(ctx context.Context, opts *database.UpsertProjectGitConnectionOptions) (*database.ProjectGitConnection, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	secrets, err := json.Marshal(projectGitConnectionSecrets{
+		Password:      opts.Password,
+		SSHPrivateKey: opts.SSHPrivateKey,
+		WebhookSecret: opts.WebhookSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	encSecrets, encKeyID, err := c.encrypt(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &projectGitConnectionDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO project_git_connections (project_id, provider, remote, username, secrets, secrets_encryption_key_id, ssh_host_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (project_id) DO UPDATE SET
+			provider = EXCLUDED.provider,
+			remote = EXCLUDED.remote,
+			username = EXCLUDED.username,
+			secrets = EXCLUDED.secrets,
+			secrets_encryption_key_id = EXCLUDED.secrets_encryption_key_id,
+			ssh_host_key = EXCLUDED.ssh_host_key,
+			branch_heads = '{}'::JSONB,
+			polled_on = NULL,
+			updated_on = now()
+		RETURNING *`,
+		opts.ProjectID, opts.Provider, opts.Remote, opts.Username, encSecrets, encKeyID, opts.SSHHostKey,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project git connection", err)
+	}
+	return c.projectGitConnectionFromDTO(res)
+}
+
+// UpdateProjectGitConnectionBranchHeads replaces the branch heads of a project's Git connection, but only if they still equal prevHeads.
+// It returns false without updating anything if the heads were changed concurrently (for example by a webhook).
+func (c *connection) UpdateProjectGitConnectionBranchHeads(ctx context.Context, projectID string, prevHeads, heads map[string]string, polled bool) (bool, error) {
+	if prevHeads == nil {
+		prevHeads = map[string]string{}
+	}
+	prevJSON, err := json.Marshal(prevHeads)
+	if err != nil {
+		return false, err
+	}
+	headsJSON, err := json.Marshal(heads)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := c.getDB(ctx).ExecContext(ctx, `
+		UPDATE project_git_connections SET branch_heads=$1, polled_on=CASE WHEN $2 THEN now() ELSE polled_on END WHERE project_id=$3 AND branch_heads=$4::JSONB`,
+		headsJSON, polled, projectID, prevJSON,
+	)
+	if err != nil {
+		return false, parseErr("project git connection", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// PatchProjectGitConnectionBranchHeads sets and removes individual branch heads of a project's Git connection in a single atomic update.
+func (c *connection) PatchProjectGitConnectionBranchHeads(ctx context.Context, projectID string, set map[string]string, unset []string) error {
+	if set == nil {
+		set = map[string]string{}
+	}
+	if unset == nil {
+		unset = []string{}
+	}
+	setJSON, err := json.Marshal(set)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.getDB(ctx).ExecContext(ctx, `
+		UPDATE project_git_connections SET branch_heads=(branch_heads || $1::JSONB) - $2::TEXT[] WHERE project_id=$3`,
+		setJSON, unset, projectID,
+	)
+	return checkUpdateRow("project git connection", res, err)
+}
+
+func (c *connection) DeleteProjectGitConnection(ctx context.Context, projectID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM project_git_connections WHERE project_id=$1", projectID)
+	return checkDeleteRow("project git connection", res, err)
+}
+
 // projectDTO wraps database.Project, using the pgtype package to handle types that pgx can't read directly into their native Go types.
 type projectDTO struct {
 	*database.Project
@@ -2377,6 +2531,43 @@ func (c *connection) provisionerResourcesFromDTOs(dtos []*provisionerResourceDTO
 	return res, nil
 }
 
+// projectGitConnectionDTO wraps database.ProjectGitConnection, using the pgtype package to handle types that pgx can't read directly into their native Go types.
+type projectGitConnectionDTO struct {
+	*database.ProjectGitConnection
+	Secrets                []byte      `db:"secrets"`
+	SecretsEncryptionKeyID string      `db:"secrets_encryption_key_id"`
+	BranchHeads            pgtype.JSON `db:"branch_heads"`
+}
+
+// projectGitConnectionSecrets is the JSON structure of the encrypted secrets column of the project_git_connections table.
+type projectGitConnectionSecrets struct {
+	Password      string `json:"password,omitempty"`
+	SSHPrivateKey string `json:"ssh_private_key,omitempty"`
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+}
+
+func (c *connection) projectGitConnectionFromDTO(dto *projectGitConnectionDTO) (*database.ProjectGitConnection, error) {
+	err := dto.BranchHeads.AssignTo(&dto.ProjectGitConnection.BranchHeads)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := c.decrypt(dto.Secrets, dto.SecretsEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	var secrets projectGitConnectionSecrets
+	err = json.Unmarshal(dec, &secrets)
+	if err != nil {
+		return nil, err
+	}
+	dto.ProjectGitConnection.Password = secrets.Password
+	dto.ProjectGitConnection.SSHPrivateKey = secrets.SSHPrivateKey
+	dto.ProjectGitConnection.WebhookSecret = secrets.WebhookSecret
+
+	return dto.ProjectGitConnection, nil
+}
+
 // magicAuthTokenDTO wraps database.MagicAuthToken, using the pgtype package to handly types that pgx can't read directly into their native Go types.
 type magicAuthTokenDTO struct {
 	*database.MagicAuthToken
@@ -39,6 +39,8 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestProjectsForUsersWithPagination", func(t *testing.T) { testProjectsForUserWithPagination(t, db) })
 	t.Run("TestMembersWithPagination", func(t *testing.T) { testOrgsMembersPagination(t, db) })
 	t.Run("TestUpsertProjectVariable", func(t *testing.T) { testUpsertProjectVariable(t, db) })
+	t.Run("TestPreviewDeployments", func(t *testing.T) { testPreviewDeployments(t, db) })
+	t.Run("TestProjectGitConnections", func(t *testing.T) { testProjectGitConnections(t, db) })
 	// Add new tests here
 
 	require.NoError(t, db.Close())
@@ -467,6 +469,134 @@ func testUpsertProjectVariable(t *testing.T, db database.DB) {
 	require.NoError(t, db.DeleteUser(ctx, userID))
 }
 
+func testPreviewDeployments(t *testing.T, db database.DB) {
+	_, projectID, userID := seed(t, db)
+
+	ctx := context.Background()
+
+	prod, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID: projectID,
+		Branch:    "main",
+		Status:    database.DeploymentStatusOK,
+	})
+	require.NoError(t, err)
+	require.False(t, prod.Preview)
+
+	// prod deployments are not returned as previews
+	_, err = db.FindPreviewDeployment(ctx, projectID, "main")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	preview, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID: projectID,
+		Branch:    "feature",
+		Preview:   true,
+		Status:    database.DeploymentStatusOK,
+	})
+	require.NoError(t, err)
+	require.True(t, preview.Preview)
+
+	// only one preview per branch
+	_, err = db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID: projectID,
+		Branch:    "feature",
+		Preview:   true,
+		Status:    database.DeploymentStatusOK,
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	depl, err := db.FindPreviewDeployment(ctx, projectID, "feature")
+	require.NoError(t, err)
+	require.Equal(t, preview.ID, depl.ID)
+
+	// expiry only applies to preview deployments
+	depls, err := db.FindExpiredPreviewDeployments(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, depls, 0)
+	time.Sleep(time.Second)
+	depls, err = db.FindExpiredPreviewDeployments(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, depls, 1)
+	require.Equal(t, preview.ID, depls[0].ID)
+
+	// cleanup
+	require.NoError(t, db.DeleteDeployment(ctx, preview.ID))
+	require.NoError(t, db.DeleteDeployment(ctx, prod.ID))
+	require.NoError(t, db.DeleteProject(ctx, projectID))
+	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
+	require.NoError(t, db.DeleteUser(ctx, userID))
+}
+
+func testProjectGitConnections(t *testing.T, db database.DB) {
+	_, projectID, userID := seed(t, db)
+
+	ctx := context.Background()
+
+	_, err := db.FindProjectGitConnection(ctx, projectID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	conn, err := db.UpsertProjectGitConnection(ctx, &database.UpsertProjectGitConnectionOptions{
+		ProjectID:     projectID,
+		Provider:      "gitlab",
+		Remote:        "https://gitlab.example.com/org/repo.git",
+		Password:      "token",
+		WebhookSecret: "secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "token", conn.Password)
+	require.Equal(t, "secret", conn.WebhookSecret)
+	require.Empty(t, conn.BranchHeads)
+	require.Nil(t, conn.PolledOn)
+
+	ok, err := db.UpdateProjectGitConnectionBranchHeads(ctx, projectID, conn.BranchHeads, map[string]string{"main": "abc"}, true)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	conn, err = db.FindProjectGitConnection(ctx, projectID)
+	require.NoError(t, err)
+	require.Equal(t, "token", conn.Password)
+	require.Equal(t, map[string]string{"main": "abc"}, conn.BranchHeads)
+	require.NotNil(t, conn.PolledOn)
+
+	// updating from stale heads is a no-op
+	ok, err = db.UpdateProjectGitConnectionBranchHeads(ctx, projectID, map[string]string{}, map[string]string{"main": "def"}, true)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// patching merges into the current heads
+	err = db.PatchProjectGitConnectionBranchHeads(ctx, projectID, map[string]string{"dev": "123"}, nil)
+	require.NoError(t, err)
+	err = db.PatchProjectGitConnectionBranchHeads(ctx, projectID, map[string]string{"feature": "456"}, []string{"dev"})
+	require.NoError(t, err)
+
+	conn, err = db.FindProjectGitConnection(ctx, projectID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"main": "abc", "feature": "456"}, conn.BranchHeads)
+
+	// replacing the connection resets the branch heads
+	conn, err = db.UpsertProjectGitConnection(ctx, &database.UpsertProjectGitConnectionOptions{
+		ProjectID:     projectID,
+		Provider:      "git",
+		Remote:        "git@git.example.com:org/repo.git",
+		SSHPrivateKey: "key",
+		SSHHostKey:    "host",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "git", conn.Provider)
+	require.Equal(t, "key", conn.SSHPrivateKey)
+	require.Empty(t, conn.Password)
+	require.Empty(t, conn.BranchHeads)
+
+	conns, err := db.FindProjectGitConnections(ctx, "", 10)
+	require.NoError(t, err)
+	require.Len(t, conns, 1)
+
+	// cleanup
+	require.NoError(t, db.DeleteProjectGitConnection(ctx, projectID))
+	require.NoError(t, db.DeleteProject(ctx, projectID))
+	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
+	require.NoError(t, db.DeleteUser(ctx, userID))
+}
+
 func seed(t *testing.T, db database.DB) (orgID, projectID, userID string) {
 	ctx := context.Background()
 
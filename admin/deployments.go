@@ -25,6 +25,7 @@ type CreateDeploymentOptions struct {
 	ProjectID   string
 	Annotations DeploymentAnnotations
 	Branch      string
+	Preview     bool
 	Provisioner string
 	Slots       int
 	Version     string
@@ -38,6 +39,7 @@ func (s *Service) CreateDeployment(ctx context.Context, opts *CreateDeploymentOp
 	depl, err := s.DB.InsertDeployment(ctx, &database.InsertDeploymentOptions{
 		ProjectID:         opts.ProjectID,
 		Branch:            opts.Branch,
+		Preview:           opts.Preview,
 		RuntimeHost:       "", // Will be populated after provisioning in createDeploymentInner
 		RuntimeInstanceID: "", // Will be populated after provisioning in createDeploymentInner
 		RuntimeAudience:   "", // Will be populated after provisioning in createDeploymentInner
@@ -0,0 +1,361 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/gitprovider"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// ErrProjectNotConnectedToGit is returned when a project is not connected to a Git repository.
+var ErrProjectNotConnectedToGit = errors.New("project is not connected to a git repository")
+
+// newGitProviders returns the Git providers that projects can be connected to, keyed by type.
+func newGitProviders(gh Github) map[string]gitprovider.Provider {
+	providers := []gitprovider.Provider{
+		gitprovider.NewGitlab(),
+		gitprovider.NewBitbucket(),
+		gitprovider.NewGeneric(),
+	}
+	if gh != nil {
+		providers = append(providers, gitprovider.NewGithub(gh.InstallationToken))
+	}
+
+	res := make(map[string]gitprovider.Provider, len(providers))
+	for _, p := range providers {
+		res[p.Type()] = p
+	}
+	return res
+}
+
+// GitProvider returns the Git provider of the given type.
+func (s *Service) GitProvider(typ string) (gitprovider.Provider, error) {
+	p, ok := s.GitProviders[typ]
+	if !ok {
+		return nil, fmt.Errorf("unsupported git provider %q", typ)
+	}
+	return p, nil
+}
+
+// ProjectGitCredentials returns credentials for cloning the project's Git repository.
+// It returns ErrProjectNotConnectedToGit if the project is not connected to a Git repository.
+func (s *Service) ProjectGitCredentials(ctx context.Context, proj *database.Project) (*gitprovider.Credentials, error) {
+	// Projects connected through the Github App
+	if proj.GithubURL != nil && proj.GithubInstallationID != nil {
+		p, err := s.GitProvider("github")
+		if err != nil {
+			return nil, err
+		}
+		return p.Credentials(ctx, &gitprovider.Connection{
+			Remote:         *proj.GithubURL,
+			InstallationID: *proj.GithubInstallationID,
+		})
+	}
+
+	conn, err := s.DB.FindProjectGitConnection(ctx, proj.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, ErrProjectNotConnectedToGit
+		}
+		return nil, err
+	}
+
+	p, err := s.GitProvider(conn.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return p.Credentials(ctx, gitConnection(conn))
+}
+
+// ConnectProjectToGitOptions defines options for ConnectProjectToGit.
+type ConnectProjectToGitOptions struct {
+	Provider string
+	Remote   string
+	Username string
+	Password string
+	// SSHPrivateKey is the private key to use for SSH remotes. If it is empty for an SSH remote, a new deploy key is generated.
+	SSHPrivateKey string
+	ProdBranch    string
+	Subpath       string
+}
+
+// ConnectProjectToGit connects a project to a Git repository on a provider other than the Github App.
+// It replaces the project's current Github repository or archive.
+//
+// If the remote uses SSH and no private key is provided, it generates a deploy key, which the user must add to the repository.
+// Since the deploy key is not added yet, access to the repository is only verified when a private key or password is provided.
+func (s *Service) ConnectProjectToGit(ctx context.Context, proj *database.Project, opts *ConnectProjectToGitOptions) (*database.Project, *database.ProjectGitConnection, error) {
+	if opts.Provider == "github" {
+		return nil, nil, errors.New("projects are connected to Github using the Github App")
+	}
+	p, err := s.GitProvider(opts.Provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ep, err := transport.NewEndpoint(opts.Remote)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid remote %q: %w", opts.Remote, err)
+	}
+	if ep.Protocol != "https" && ep.Protocol != "ssh" {
+		return nil, nil, fmt.Errorf("invalid remote %q: only HTTPS and SSH remotes are supported", opts.Remote)
+	}
+	err = gitprovider.CheckRemote(ctx, opts.Remote)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn := &gitprovider.Connection{
+		Remote:        opts.Remote,
+		Username:      opts.Username,
+		Password:      opts.Password,
+		SSHPrivateKey: opts.SSHPrivateKey,
+	}
+
+	verify := true
+	if ep.Protocol == "ssh" {
+		if conn.SSHPrivateKey == "" {
+			conn.SSHPrivateKey, _, err = gitprovider.GenerateDeployKey(fmt.Sprintf("rill-%s", proj.Name))
+			if err != nil {
+				return nil, nil, err
+			}
+			verify = false
+		}
+		conn.SSHHostKey, err = gitprovider.ScanHostKey(ctx, opts.Remote)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	conn.WebhookSecret, err = newWebhookSecret()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Verify that we can access the repository and the prod branch exists
+	var heads map[string]string
+	if verify {
+		heads, err = p.BranchHeads(ctx, conn)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to access repository: %w", err)
+		}
+		if _, ok := heads[opts.ProdBranch]; !ok {
+			return nil, nil, fmt.Errorf("branch %q not found in repository", opts.ProdBranch)
+		}
+	}
+
+	dbConn, err := s.DB.UpsertProjectGitConnection(ctx, &database.UpsertProjectGitConnectionOptions{
+		ProjectID:     proj.ID,
+		Provider:      opts.Provider,
+		Remote:        conn.Remote,
+		Username:      conn.Username,
+		Password:      conn.Password,
+		SSHPrivateKey: conn.SSHPrivateKey,
+		SSHHostKey:    conn.SSHHostKey,
+		WebhookSecret: conn.WebhookSecret,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Store the current heads so the first poll doesn't detect them as pushes
+	if heads != nil {
+		ok, err := s.DB.UpdateProjectGitConnectionBranchHeads(ctx, proj.ID, dbConn.BranchHeads, heads, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			dbConn.BranchHeads = heads
+		}
+	}
+
+	proj, err = s.DB.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
+		Name:                 proj.Name,
+		Description:          proj.Description,
+		Public:               proj.Public,
+		Provisioner:          proj.Provisioner,
+		ArchiveAssetID:       nil,
+		GithubURL:            nil,
+		GithubInstallationID: nil,
+		ProdVersion:          proj.ProdVersion,
+		ProdBranch:           opts.ProdBranch,
+		Subpath:              opts.Subpath,
+		ProdDeploymentID:     proj.ProdDeploymentID,
+		ProdSlots:            proj.ProdSlots,
+		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		Annotations:          proj.Annotations,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The deployments must reload the repo to pick up the new remote and credentials
+	err = s.reloadDeploymentRepos(ctx, proj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proj, dbConn, nil
+}
+
+// ProcessGitWebhook handles pushes received over a webhook for a project's Git connection.
+func (s *Service) ProcessGitWebhook(ctx context.Context, proj *database.Project, conn *database.ProjectGitConnection, pushes []*gitprovider.Push) error {
+	err := s.ProcessGitPushes(ctx, proj, pushes)
+	if err != nil {
+		return err
+	}
+
+	// Update the branch heads so the pushes are not detected again by polling.
+	// The update is applied as an atomic patch so it doesn't overwrite heads written concurrently by the poller or another webhook.
+	latest := make(map[string]*gitprovider.Push, len(pushes))
+	for _, push := range pushes {
+		latest[push.Branch] = push
+	}
+	set := make(map[string]string, len(latest))
+	var unset []string
+	for branch, push := range latest {
+		if push.Deleted {
+			unset = append(unset, branch)
+		} else {
+			set[branch] = push.Commit
+		}
+	}
+	return s.DB.PatchProjectGitConnectionBranchHeads(ctx, proj.ID, set, unset)
+}
+
+// PollGitConnection detects pushes to a project's Git repository by comparing its current branch heads to the previously seen heads.
+func (s *Service) PollGitConnection(ctx context.Context, conn *database.ProjectGitConnection) error {
+	proj, err := s.DB.FindProject(ctx, conn.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	// Skip connections that have been superseded by a Github or archive source
+	if proj.GithubURL != nil || proj.ArchiveAssetID != nil {
+		return nil
+	}
+
+	p, err := s.GitProvider(conn.Provider)
+	if err != nil {
+		return err
+	}
+
+	heads, err := p.BranchHeads(ctx, gitConnection(conn))
+	if err != nil {
+		return err
+	}
+
+	pushes := gitprovider.DiffBranchHeads(conn.BranchHeads, heads)
+	err = s.ProcessGitPushes(ctx, proj, pushes)
+	if err != nil {
+		return err
+	}
+
+	// Only store the new heads if they haven't changed since they were read.
+	// If a webhook updated them in the meantime, the next poll will diff against its heads instead.
+	ok, err := s.DB.UpdateProjectGitConnectionBranchHeads(ctx, proj.ID, conn.BranchHeads, heads, true)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.Logger.Info("poll git connection: branch heads changed concurrently, skipping update", zap.String("project_id", proj.ID), observability.ZapCtx(ctx))
+	}
+	return nil
+}
+
+// ProcessGitPushes handles pushes to a project's Git repository.
+// Pushes to the prod branch trigger a pull in the prod deployment, and deleted branches tear down their preview deployment (if any).
+// Preview deployments are only created and updated for projects connected to Github (see processGithubPullRequest),
+// but previews left over from before the project was connected to a different Git provider are still torn down.
+func (s *Service) ProcessGitPushes(ctx context.Context, proj *database.Project, pushes []*gitprovider.Push) error {
+	for _, push := range pushes {
+		if push.Branch == proj.ProdBranch {
+			if push.Deleted || proj.ProdDeploymentID == nil {
+				continue
+			}
+
+			depl, err := s.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+			if err != nil {
+				s.Logger.Error("process git push: could not find deployment", zap.String("project_id", proj.ID), zap.Error(err), observability.ZapCtx(ctx))
+				continue
+			}
+
+			err = s.TriggerParser(ctx, depl)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if push.Deleted {
+			_, err := s.Jobs.DeletePreviewDeployment(ctx, proj.ID, push.Branch)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// reloadDeploymentRepos makes the project's deployments reload their repo, which also renews their Git credentials.
+func (s *Service) reloadDeploymentRepos(ctx context.Context, proj *database.Project) error {
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return err
+	}
+	annotations := s.NewDeploymentAnnotations(org, proj)
+
+	ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range ds {
+		branch := proj.ProdBranch
+		if d.Preview {
+			branch = d.Branch
+		}
+		err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
+			Annotations:     annotations,
+			Branch:          branch,
+			Version:         proj.ProdVersion,
+			Variables:       nil,
+			EvictCachedRepo: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// gitConnection converts a database connection to a gitprovider connection.
+func gitConnection(c *database.ProjectGitConnection) *gitprovider.Connection {
+	return &gitprovider.Connection{
+		Remote:        c.Remote,
+		Username:      c.Username,
+		Password:      c.Password,
+		SSHPrivateKey: c.SSHPrivateKey,
+		SSHHostKey:    c.SSHHostKey,
+		WebhookSecret: c.WebhookSecret,
+	}
+}
+
+// newWebhookSecret generates a random secret for verifying webhook requests.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 24)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
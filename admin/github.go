@@ -175,6 +175,9 @@ func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
 	// Triggered on push to repository
 	case *github.PushEvent:
 		return s.processGithubPush(ctx, event)
+	// Triggered when a pull request is opened, updated or closed
+	case *github.PullRequestEvent:
+		return s.processGithubPullRequest(ctx, event)
 	// Triggered during first installation of app to an account (org or user) or one or more repos
 	case *github.InstallationEvent:
 		return s.processGithubInstallationEvent(ctx, event)
@@ -211,7 +214,14 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	// Iterate over all projects and trigger reconcile
 	for _, project := range projects {
 		if branch != project.ProdBranch {
-			// Ignore if push was not to production branch
+			// Tear down the branch's preview deployment (if any) when the branch is deleted.
+			// Pushes to branches with an open pull request are handled by processGithubPullRequest.
+			if event.GetDeleted() {
+				_, err = s.Jobs.DeletePreviewDeployment(ctx, project.ID, branch)
+				if err != nil {
+					return err
+				}
+			}
 			continue
 		}
 
@@ -233,6 +243,77 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	return nil
 }
 
+func (s *Service) processGithubPullRequest(ctx context.Context, event *github.PullRequestEvent) error {
+	// Find Rill projects matching the repo that the pull request targets
+	repo := event.GetRepo()
+	githubURL := repo.GetHTMLURL()
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, githubURL)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// App is installed on repo not currently deployed. Do nothing.
+			return nil
+		}
+		return err
+	}
+
+	// We only create previews for branches in the project's repo.
+	// Branches from forks are not accessible to the Github App and may contain untrusted changes.
+	head := event.GetPullRequest().GetHead()
+	if head.GetRepo().GetID() != repo.GetID() {
+		return nil
+	}
+	branch := head.GetRef()
+
+	for _, project := range projects {
+		if branch == project.ProdBranch {
+			continue
+		}
+
+		switch event.GetAction() {
+		case "opened", "reopened":
+			_, err = s.Jobs.CreatePreviewDeployment(ctx, project.ID, branch)
+			if err == nil {
+				s.commentGithubPreviewURL(ctx, event, project)
+			}
+		case "synchronize":
+			_, err = s.Jobs.CreatePreviewDeployment(ctx, project.ID, branch)
+		case "closed":
+			_, err = s.Jobs.DeletePreviewDeployment(ctx, project.ID, branch)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commentGithubPreviewURL comments on a pull request with the URL of the project's preview deployment.
+// Failures are logged, but not returned, since the comment is not required for the preview deployment to work.
+func (s *Service) commentGithubPreviewURL(ctx context.Context, event *github.PullRequestEvent, project *database.Project) {
+	org, err := s.DB.FindOrganization(ctx, project.OrganizationID)
+	if err != nil {
+		s.Logger.Error("process github event: could not find organization", zap.String("project_id", project.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	gh, err := s.Github.InstallationClient(event.GetInstallation().GetID())
+	if err != nil {
+		s.Logger.Error("process github event: could not create installation client", zap.String("project_id", project.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	branch := event.GetPullRequest().GetHead().GetRef()
+	previewURL := s.URLs.WithCustomDomain(org.CustomDomain).ProjectPreview(org.Name, project.Name, branch)
+	body := fmt.Sprintf("Rill is deploying a preview of project `%s` for this branch. It will be available at %s once it's ready.", project.Name, previewURL)
+
+	repo := event.GetRepo()
+	_, _, err = gh.Issues.CreateComment(ctx, repo.GetOwner().GetLogin(), repo.GetName(), event.GetNumber(), &github.IssueComment{Body: &body})
+	if err != nil {
+		s.Logger.Error("process github event: could not comment on pull request", zap.String("project_id", project.ID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
 func (s *Service) processGithubInstallationEvent(_ context.Context, event *github.InstallationEvent) error {
 	switch event.GetAction() {
 	case "created", "unsuspend", "new_permissions_accepted":
@@ -0,0 +1,99 @@
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Bitbucket is a provider for repositories hosted on Bitbucket Cloud.
+// HTTPS remotes authenticate with a repository access token, or with an app password and the corresponding username. SSH remotes authenticate with an access key.
+// Pushes are received through a repository webhook configured with the connection's webhook secret.
+type Bitbucket struct{}
+
+var _ Provider = (*Bitbucket)(nil)
+
+// NewBitbucket creates a Bitbucket provider.
+func NewBitbucket() *Bitbucket {
+	return &Bitbucket{}
+}
+
+// Type implements Provider.
+func (b *Bitbucket) Type() string {
+	return "bitbucket"
+}
+
+// Credentials implements Provider.
+func (b *Bitbucket) Credentials(ctx context.Context, c *Connection) (*Credentials, error) {
+	// Bitbucket requires the "x-token-auth" username for access tokens.
+	return staticCredentials(c, "x-token-auth")
+}
+
+// bitbucketPushEvent is the subset of a Bitbucket push event that we use.
+// See: https://support.atlassian.com/bitbucket-cloud/docs/event-payloads/#Push
+type bitbucketPushEvent struct {
+	Push struct {
+		Changes []struct {
+			New *bitbucketRef `json:"new"`
+			Old *bitbucketRef `json:"old"`
+		} `json:"changes"`
+	} `json:"push"`
+}
+
+type bitbucketRef struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+// ParseWebhook implements Provider.
+func (b *Bitbucket) ParseWebhook(r *http.Request, c *Connection) ([]*Push, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validSignature(r.Header.Get("X-Hub-Signature"), body, c.WebhookSecret) {
+		return nil, ErrInvalidWebhook
+	}
+
+	if r.Header.Get("X-Event-Key") != "repo:push" {
+		return []*Push{}, nil
+	}
+
+	var event bitbucketPushEvent
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		return nil, fmt.Errorf("gitprovider: invalid bitbucket push event: %w", err)
+	}
+
+	pushes := []*Push{}
+	for _, change := range event.Push.Changes {
+		if change.New == nil {
+			// The ref was deleted
+			if change.Old != nil && change.Old.Type == "branch" {
+				pushes = append(pushes, &Push{Branch: change.Old.Name, Deleted: true})
+			}
+			continue
+		}
+		if change.New.Type != "branch" {
+			// We ignore tag pushes
+			continue
+		}
+		pushes = append(pushes, &Push{Branch: change.New.Name, Commit: change.New.Target.Hash})
+	}
+	return pushes, nil
+}
+
+// BranchHeads implements Provider.
+func (b *Bitbucket) BranchHeads(ctx context.Context, c *Connection) (map[string]string, error) {
+	creds, err := b.Credentials(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return lsRemote(ctx, creds)
+}
@@ -0,0 +1,42 @@
+package gitprovider
+
+import (
+	"context"
+	"net/http"
+)
+
+// Generic is a provider for any Git server reachable over HTTPS or SSH.
+// HTTPS remotes authenticate with a username and password or token. SSH remotes authenticate with a deploy key.
+// Since there's no standard webhook format, pushes are detected by polling.
+type Generic struct{}
+
+var _ Provider = (*Generic)(nil)
+
+// NewGeneric creates a generic Git provider.
+func NewGeneric() *Generic {
+	return &Generic{}
+}
+
+// Type implements Provider.
+func (g *Generic) Type() string {
+	return "git"
+}
+
+// Credentials implements Provider.
+func (g *Generic) Credentials(ctx context.Context, c *Connection) (*Credentials, error) {
+	return staticCredentials(c, "git")
+}
+
+// ParseWebhook implements Provider.
+func (g *Generic) ParseWebhook(r *http.Request, c *Connection) ([]*Push, error) {
+	return nil, ErrWebhooksNotSupported
+}
+
+// BranchHeads implements Provider.
+func (g *Generic) BranchHeads(ctx context.Context, c *Connection) (map[string]string, error) {
+	creds, err := g.Credentials(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return lsRemote(ctx, creds)
+}
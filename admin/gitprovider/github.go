@@ -0,0 +1,107 @@
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// Github is a provider for repositories that the Rill Github App is installed on.
+// Pushes to Github repositories are usually received through the Github App's webhook (see admin.ProcessGithubEvent), but per-repository webhooks are also supported.
+type Github struct {
+	installationToken func(ctx context.Context, installationID int64) (string, error)
+}
+
+var _ Provider = (*Github)(nil)
+
+// NewGithub creates a Github provider that uses the given function to create access tokens for Github App installations.
+func NewGithub(installationToken func(ctx context.Context, installationID int64) (string, error)) *Github {
+	return &Github{installationToken: installationToken}
+}
+
+// Type implements Provider.
+func (g *Github) Type() string {
+	return "github"
+}
+
+// Credentials implements Provider.
+func (g *Github) Credentials(ctx context.Context, c *Connection) (*Credentials, error) {
+	if c.InstallationID == 0 {
+		return nil, errors.New("gitprovider: the Github App is not installed on the repository")
+	}
+
+	token, err := g.installationToken(ctx, c.InstallationID)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := c.Remote
+	if !strings.HasSuffix(remote, ".git") {
+		remote += ".git"
+	}
+	ep, err := transport.NewEndpoint(remote)
+	if err != nil {
+		return nil, fmt.Errorf("gitprovider: invalid remote %q: %w", c.Remote, err)
+	}
+	ep.User = "x-access-token"
+	ep.Password = token
+
+	return &Credentials{URL: ep.String()}, nil
+}
+
+// githubPushEvent is the subset of a Github push event that we use.
+// See: https://docs.github.com/en/webhooks/webhook-events-and-payloads#push
+type githubPushEvent struct {
+	Ref     string `json:"ref"`
+	After   string `json:"after"`
+	Deleted bool   `json:"deleted"`
+}
+
+// ParseWebhook implements Provider.
+// It handles repository webhooks configured with the connection's webhook secret. Events sent to the Github App's webhook are handled separately.
+func (g *Github) ParseWebhook(r *http.Request, c *Connection) ([]*Push, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validSignature(r.Header.Get("X-Hub-Signature-256"), body, c.WebhookSecret) {
+		return nil, ErrInvalidWebhook
+	}
+
+	if r.Header.Get("X-GitHub-Event") != "push" {
+		return []*Push{}, nil
+	}
+
+	var event githubPushEvent
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		return nil, fmt.Errorf("gitprovider: invalid github push event: %w", err)
+	}
+
+	branch, ok := branchFromRef(event.Ref)
+	if !ok {
+		// We ignore tag pushes
+		return []*Push{}, nil
+	}
+
+	if event.Deleted || isZeroCommit(event.After) {
+		return []*Push{{Branch: branch, Deleted: true}}, nil
+	}
+	return []*Push{{Branch: branch, Commit: event.After}}, nil
+}
+
+// BranchHeads implements Provider.
+func (g *Github) BranchHeads(ctx context.Context, c *Connection) (map[string]string, error) {
+	creds, err := g.Credentials(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return lsRemote(ctx, creds)
+}
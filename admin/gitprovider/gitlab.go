@@ -0,0 +1,83 @@
+package gitprovider
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Gitlab is a provider for repositories hosted on gitlab.com or a self-hosted GitLab instance.
+// HTTPS remotes authenticate with a project, group or personal access token. SSH remotes authenticate with a deploy key.
+// Pushes are received through a project webhook configured with the connection's webhook secret as its secret token.
+type Gitlab struct{}
+
+var _ Provider = (*Gitlab)(nil)
+
+// NewGitlab creates a GitLab provider.
+func NewGitlab() *Gitlab {
+	return &Gitlab{}
+}
+
+// Type implements Provider.
+func (g *Gitlab) Type() string {
+	return "gitlab"
+}
+
+// Credentials implements Provider.
+func (g *Gitlab) Credentials(ctx context.Context, c *Connection) (*Credentials, error) {
+	// GitLab accepts any non-empty username for access tokens. We use "oauth2", which also works for OAuth tokens.
+	return staticCredentials(c, "oauth2")
+}
+
+// gitlabPushEvent is the subset of a GitLab push event that we use.
+// See: https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#push-events
+type gitlabPushEvent struct {
+	Ref   string `json:"ref"`
+	After string `json:"after"`
+}
+
+// ParseWebhook implements Provider.
+func (g *Gitlab) ParseWebhook(r *http.Request, c *Connection) ([]*Push, error) {
+	token := r.Header.Get("X-Gitlab-Token")
+	if c.WebhookSecret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(c.WebhookSecret)) != 1 {
+		return nil, ErrInvalidWebhook
+	}
+
+	if r.Header.Get("X-Gitlab-Event") != "Push Hook" {
+		return []*Push{}, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var event gitlabPushEvent
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		return nil, fmt.Errorf("gitprovider: invalid gitlab push event: %w", err)
+	}
+
+	branch, ok := branchFromRef(event.Ref)
+	if !ok {
+		// We ignore tag pushes
+		return []*Push{}, nil
+	}
+
+	if isZeroCommit(event.After) {
+		return []*Push{{Branch: branch, Deleted: true}}, nil
+	}
+	return []*Push{{Branch: branch, Commit: event.After}}, nil
+}
+
+// BranchHeads implements Provider.
+func (g *Gitlab) BranchHeads(ctx context.Context, c *Connection) (map[string]string, error) {
+	creds, err := g.Credentials(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return lsRemote(ctx, creds)
+}
@@ -0,0 +1,233 @@
+package gitprovider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/rilldata/rill/runtime/pkg/gitutil"
+)
+
+// ErrWebhooksNotSupported is returned by providers that don't support receiving pushes over per-project webhooks.
+// Pushes to repositories on such providers are detected by polling.
+var ErrWebhooksNotSupported = errors.New("gitprovider: webhooks are not supported by the provider")
+
+// ErrInvalidWebhook is returned when a webhook request can't be verified to originate from the provider.
+var ErrInvalidWebhook = errors.New("gitprovider: invalid webhook signature or token")
+
+// Provider is a Git hosting provider that projects can be connected to.
+type Provider interface {
+	// Type returns the type of the provider.
+	Type() string
+	// Credentials returns credentials for cloning and pulling the connection's repository.
+	Credentials(ctx context.Context, c *Connection) (*Credentials, error)
+	// ParseWebhook verifies that a webhook request was sent by the provider for the connection's repository and returns the pushes it contains.
+	// It returns an empty slice for valid events that are not pushes.
+	ParseWebhook(r *http.Request, c *Connection) ([]*Push, error)
+	// BranchHeads returns the commit hash of the head of every branch in the connection's repository.
+	// It is used for detecting pushes by polling.
+	BranchHeads(ctx context.Context, c *Connection) (map[string]string, error)
+}
+
+// Connection contains the details needed to access a Git repository.
+type Connection struct {
+	// Remote is the HTTPS or SSH URL of the repository.
+	Remote string
+	// Username is the username for HTTPS authentication. If empty, the provider's default is used.
+	Username string
+	// Password is the access token or password for HTTPS authentication.
+	Password string
+	// SSHPrivateKey is a PEM-encoded private key (usually a deploy key) for SSH authentication.
+	SSHPrivateKey string
+	// SSHHostKey is the SSH server's host key in authorized_keys format. It is required for SSH remotes.
+	SSHHostKey string
+	// WebhookSecret is the secret used to verify webhook requests.
+	WebhookSecret string
+	// InstallationID is the ID of the Github App installation that has access to the repository (only used by the Github provider).
+	InstallationID int64
+}
+
+// IsSSH returns true if the connection's remote uses the SSH protocol.
+func (c *Connection) IsSSH() bool {
+	ep, err := transport.NewEndpoint(c.Remote)
+	if err != nil {
+		return false
+	}
+	return ep.Protocol == "ssh"
+}
+
+// Credentials contain what a Git client needs to clone and pull a repository.
+type Credentials struct {
+	// URL is the remote URL. For HTTPS remotes, it embeds the username and password.
+	URL string
+	// SSHPrivateKey is a PEM-encoded private key for SSH remotes.
+	SSHPrivateKey string
+	// SSHHostKey is the SSH server's host key in authorized_keys format.
+	SSHHostKey string
+}
+
+// AuthMethod returns a go-git auth method for the credentials.
+// It returns nil for HTTPS remotes, which carry their credentials in the URL.
+func (c *Credentials) AuthMethod() (transport.AuthMethod, error) {
+	if c.SSHPrivateKey == "" {
+		return nil, nil
+	}
+	return gitutil.SSHAuthMethod(c.URL, c.SSHPrivateKey, c.SSHHostKey)
+}
+
+// Push is a change to a branch in a repository.
+type Push struct {
+	// Branch is the name of the branch that was pushed to.
+	Branch string
+	// Commit is the new head of the branch. It is empty if the branch was deleted.
+	Commit string
+	// Deleted is true if the branch was deleted.
+	Deleted bool
+}
+
+// staticCredentials returns credentials for connections that authenticate with a static password or SSH key.
+// The defaultUsername is used for HTTPS remotes when the connection doesn't specify a username.
+func staticCredentials(c *Connection, defaultUsername string) (*Credentials, error) {
+	if c.IsSSH() {
+		if c.SSHPrivateKey == "" {
+			return nil, errors.New("gitprovider: a private key is required for SSH remotes")
+		}
+		return &Credentials{
+			URL:           c.Remote,
+			SSHPrivateKey: c.SSHPrivateKey,
+			SSHHostKey:    c.SSHHostKey,
+		}, nil
+	}
+
+	ep, err := transport.NewEndpoint(c.Remote)
+	if err != nil {
+		return nil, fmt.Errorf("gitprovider: invalid remote %q: %w", c.Remote, err)
+	}
+	if c.Password != "" {
+		ep.User = c.Username
+		if ep.User == "" {
+			ep.User = defaultUsername
+		}
+		ep.Password = c.Password
+	}
+	return &Credentials{URL: ep.String()}, nil
+}
+
+// lsRemote lists the branch heads of a remote repository without cloning it.
+// Since remotes are provided by users, HTTPS remotes are accessed with publicHTTPClient and SSH remotes must resolve to public addresses.
+func lsRemote(ctx context.Context, creds *Credentials) (map[string]string, error) {
+	ep, err := transport.NewEndpoint(creds.URL)
+	if err != nil {
+		return nil, fmt.Errorf("gitprovider: invalid remote: %w", err)
+	}
+
+	var auth transport.AuthMethod
+	var tr transport.Transport
+	switch ep.Protocol {
+	case "http", "https":
+		// Pass credentials embedded in the URL explicitly since go-git doesn't use them for listing remotes.
+		if ep.Password != "" {
+			auth = &githttp.BasicAuth{Username: ep.User, Password: ep.Password}
+		}
+		tr = githttp.NewClient(publicHTTPClient)
+	case "ssh":
+		auth, err = creds.AuthMethod()
+		if err != nil {
+			return nil, err
+		}
+		// The go-git SSH transport doesn't support a custom dialer. Since the host key is pinned, a rebound DNS record can't be used to access another server.
+		err = CheckRemote(ctx, creds.URL)
+		if err != nil {
+			return nil, err
+		}
+		tr = gitssh.DefaultClient
+	default:
+		tr, err = client.NewClient(ep)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sess, err := tr.NewUploadPackSession(ep, auth)
+	if err != nil {
+		return nil, err
+	}
+	defer sess.Close()
+
+	ar, err := sess.AdvertisedReferencesContext(ctx)
+	if err != nil {
+		if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	refs, err := ar.AllReferences()
+	if err != nil {
+		return nil, err
+	}
+
+	heads := make(map[string]string)
+	for name, ref := range refs {
+		if name.IsBranch() && ref.Type() == plumbing.HashReference {
+			heads[name.Short()] = ref.Hash().String()
+		}
+	}
+	return heads, nil
+}
+
+// DiffBranchHeads returns the pushes needed to go from the previous to the current branch heads.
+func DiffBranchHeads(prev, curr map[string]string) []*Push {
+	var pushes []*Push
+	for branch, commit := range curr {
+		if prev[branch] != commit {
+			pushes = append(pushes, &Push{Branch: branch, Commit: commit})
+		}
+	}
+	for branch := range prev {
+		if _, ok := curr[branch]; !ok {
+			pushes = append(pushes, &Push{Branch: branch, Deleted: true})
+		}
+	}
+	return pushes
+}
+
+// branchFromRef returns the branch name of a ref like "refs/heads/main".
+// It returns false if the ref is not a branch (e.g. a tag).
+func branchFromRef(ref string) (string, bool) {
+	return strings.CutPrefix(ref, "refs/heads/")
+}
+
+// isZeroCommit returns true for the all-zero commit hash that providers use to indicate a deleted branch.
+func isZeroCommit(hash string) bool {
+	return hash != "" && strings.Trim(hash, "0") == ""
+}
+
+// validSignature checks a webhook signature of the form "sha256=<hex HMAC of the body>", which is used by Github and Bitbucket.
+func validSignature(signature string, body []byte, secret string) bool {
+	if secret == "" {
+		return false
+	}
+
+	hexSig, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	sig, err := hex.DecodeString(hexSig)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(sig, mac.Sum(nil))
+}
@@ -0,0 +1,96 @@
+package gitprovider
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"golang.org/x/crypto/ssh"
+)
+
+// GenerateDeployKey generates a new SSH key pair for use as a deploy key.
+// It returns the PEM-encoded private key and the public key in authorized_keys format (which is the format providers expect when adding a deploy key).
+func GenerateDeployKey(comment string) (privateKey, publicKey string, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	block, err := ssh.MarshalPrivateKey(priv, comment)
+	if err != nil {
+		return "", "", err
+	}
+
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return "", "", err
+	}
+
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+	if comment != "" {
+		authorizedKey = fmt.Sprintf("%s %s", authorizedKey, comment)
+	}
+
+	return string(pem.EncodeToMemory(block)), authorizedKey, nil
+}
+
+// PublicKey returns the public key in authorized_keys format for a PEM-encoded private key.
+func PublicKey(privateKey string) (string, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", fmt.Errorf("gitprovider: invalid private key: %w", err)
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))), nil
+}
+
+// ScanHostKey connects to the SSH server of a remote and returns its host key in authorized_keys format.
+// The host key is trusted on first use, i.e. it's scanned when connecting a project and subsequent connections only accept the same key.
+// It returns ErrPrivateRemote if the remote's host resolves to a non-public address.
+func ScanHostKey(ctx context.Context, remote string) (string, error) {
+	ep, err := transport.NewEndpoint(remote)
+	if err != nil {
+		return "", fmt.Errorf("gitprovider: invalid remote %q: %w", remote, err)
+	}
+	if ep.Protocol != "ssh" {
+		return "", fmt.Errorf("gitprovider: remote %q does not use SSH", remote)
+	}
+	port := ep.Port
+	if port == 0 {
+		port = 22
+	}
+	addr := net.JoinHostPort(ep.Host, fmt.Sprint(port))
+
+	conn, err := publicDialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	// The handshake fails after the host key check since we don't offer any auth methods. We only need the host key.
+	var hostKey ssh.PublicKey
+	cfg := &ssh.ClientConfig{
+		User: "git",
+		HostKeyCallback: func(_ string, _ net.Addr, key ssh.PublicKey) error {
+			hostKey = key
+			return nil
+		},
+	}
+	c, _, _, err := ssh.NewClientConn(conn, addr, cfg)
+	if err == nil {
+		c.Close()
+	}
+	if hostKey == nil {
+		if err == nil {
+			err = errors.New("no host key received")
+		}
+		return "", fmt.Errorf("gitprovider: failed to scan host key of %q: %w", addr, err)
+	}
+
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey))), nil
+}
@@ -0,0 +1,86 @@
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// ErrPrivateRemote is returned when a remote resolves to a loopback, private or link-local address.
+// Remotes are provided by users, so connecting to such addresses would let users probe the admin service's internal network.
+var ErrPrivateRemote = errors.New("gitprovider: remotes on private networks are not supported")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which net.IP.IsPrivate doesn't cover.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// publicDialer is a dialer that refuses to connect to non-public addresses.
+// The check runs on the resolved address right before connecting, so it can't be bypassed with DNS rebinding.
+var publicDialer = &net.Dialer{
+	Timeout: 30 * time.Second,
+	Control: func(network, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		ip := net.ParseIP(host)
+		if ip == nil || !isPublicIP(ip) {
+			return ErrPrivateRemote
+		}
+		return nil
+	},
+}
+
+// publicHTTPClient is an HTTP client for accessing HTTPS remotes that only connects to public addresses.
+// It doesn't use proxies from the environment since the proxy's address would be checked instead of the remote's.
+var publicHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext:         publicDialer.DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+	Timeout: 2 * time.Minute,
+}
+
+// CheckRemote returns ErrPrivateRemote if the host of an HTTPS or SSH remote resolves to a non-public address.
+// It gives an early error when connecting a project, but connections to remotes must still use publicDialer since the DNS records may change.
+func CheckRemote(ctx context.Context, remote string) error {
+	ep, err := transport.NewEndpoint(remote)
+	if err != nil {
+		return fmt.Errorf("gitprovider: invalid remote %q: %w", remote, err)
+	}
+
+	host := strings.Trim(ep.Host, "[]") // IPv6 literals are enclosed in brackets
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		ips, err = net.DefaultResolver.LookupIP(ctx, "ip", host)
+		if err != nil {
+			return fmt.Errorf("gitprovider: failed to resolve host %q: %w", host, err)
+		}
+	}
+	for _, ip := range ips {
+		if !isPublicIP(ip) {
+			return ErrPrivateRemote
+		}
+	}
+	return nil
+}
+
+// isPublicIP returns true if the IP address is routable on the public internet.
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		!sharedAddressSpace.Contains(ip)
+}
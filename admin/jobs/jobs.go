@@ -12,6 +12,8 @@ type Client interface {
 
 	// NOTE: Add new job trigger functions here
 	ResetAllDeployments(ctx context.Context) (*InsertResult, error)
+	CreatePreviewDeployment(ctx context.Context, projectID, branch string) (*InsertResult, error)
+	DeletePreviewDeployment(ctx context.Context, projectID, branch string) (*InsertResult, error)
 
 	// payment provider related jobs
 	PaymentMethodAdded(ctx context.Context, methodID, paymentCustomerID, typ string, eventTime time.Time) (*InsertResult, error)
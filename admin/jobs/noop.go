@@ -28,6 +28,14 @@ func (n *noop) ResetAllDeployments(ctx context.Context) (*InsertResult, error) {
 	return nil, nil
 }
 
+func (n *noop) CreatePreviewDeployment(ctx context.Context, projectID, branch string) (*InsertResult, error) {
+	return nil, nil
+}
+
+func (n *noop) DeletePreviewDeployment(ctx context.Context, projectID, branch string) (*InsertResult, error) {
+	return nil, nil
+}
+
 func (n *noop) PaymentMethodAdded(ctx context.Context, methodID, paymentCustomerID, typ string, eventTime time.Time) (*InsertResult, error) {
 	return nil, nil
 }
@@ -0,0 +1,67 @@
+package river
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/riverqueue/river"
+)
+
+type CreatePreviewDeploymentArgs struct {
+	ProjectID string
+	Branch    string
+}
+
+func (CreatePreviewDeploymentArgs) Kind() string { return "create_preview_deployment" }
+
+type CreatePreviewDeploymentWorker struct {
+	river.WorkerDefaults[CreatePreviewDeploymentArgs]
+	admin *admin.Service
+}
+
+// Work creates or updates the preview deployment of a branch.
+func (w *CreatePreviewDeploymentWorker) Work(ctx context.Context, job *river.Job[CreatePreviewDeploymentArgs]) error {
+	proj, err := w.admin.DB.FindProject(ctx, job.Args.ProjectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// project got deleted, ignore
+			return nil
+		}
+		return err
+	}
+
+	_, err = w.admin.CreateOrUpdatePreviewDeployment(ctx, proj, job.Args.Branch)
+	if errors.Is(err, admin.ErrPreviewsNotSupported) {
+		// project is no longer connected to Github, ignore
+		return nil
+	}
+	return err
+}
+
+type DeletePreviewDeploymentArgs struct {
+	ProjectID string
+	Branch    string
+}
+
+func (DeletePreviewDeploymentArgs) Kind() string { return "delete_preview_deployment" }
+
+type DeletePreviewDeploymentWorker struct {
+	river.WorkerDefaults[DeletePreviewDeploymentArgs]
+	admin *admin.Service
+}
+
+// Work tears down the preview deployment of a branch.
+func (w *DeletePreviewDeploymentWorker) Work(ctx context.Context, job *river.Job[DeletePreviewDeploymentArgs]) error {
+	proj, err := w.admin.DB.FindProject(ctx, job.Args.ProjectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// project got deleted, ignore
+			return nil
+		}
+		return err
+	}
+
+	return w.admin.TeardownPreviewDeployment(ctx, proj, job.Args.Branch)
+}
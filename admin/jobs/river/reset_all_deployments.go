@@ -61,6 +61,11 @@ func (w *ResetAllDeploymentsWorker) resetAllDeploymentsForProject(ctx context.Co
 	}
 
 	for _, depl := range depls {
+		// Redeploying replaces the prod deployment, so we skip preview deployments
+		if depl.Preview {
+			continue
+		}
+
 		w.admin.Logger.Info("reset all deployments: redeploying deployment", zap.String("deployment_id", depl.ID), observability.ZapCtx(ctx))
 		_, err = w.admin.RedeployProject(ctx, proj, depl)
 		if err != nil {
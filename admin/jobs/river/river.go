@@ -71,6 +71,8 @@ func New(ctx context.Context, dsn string, adm *admin.Service) (jobs.Client, erro
 	// NOTE: Register new job workers here
 	river.AddWorker(workers, &ValidateDeploymentsWorker{admin: adm})
 	river.AddWorker(workers, &ResetAllDeploymentsWorker{admin: adm})
+	river.AddWorker(workers, &CreatePreviewDeploymentWorker{admin: adm})
+	river.AddWorker(workers, &DeletePreviewDeploymentWorker{admin: adm})
 
 	// payment provider event handlers
 	river.AddWorker(workers, &PaymentMethodAddedWorker{admin: adm})
@@ -167,6 +169,39 @@ func (c *Client) ResetAllDeployments(ctx context.Context) (*jobs.InsertResult, e
 	}, nil
 }
 
+func (c *Client) CreatePreviewDeployment(ctx context.Context, projectID, branch string) (*jobs.InsertResult, error) {
+	res, err := c.riverClient.Insert(ctx, CreatePreviewDeploymentArgs{
+		ProjectID: projectID,
+		Branch:    branch,
+	}, &river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:  true,
+			ByState: []rivertype.JobState{rivertype.JobStateAvailable, rivertype.JobStateScheduled, rivertype.JobStateRetryable}, // allows a new run while one is running to pick up later pushes
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &jobs.InsertResult{
+		ID:        res.Job.ID,
+		Duplicate: res.UniqueSkippedAsDuplicate,
+	}, nil
+}
+
+func (c *Client) DeletePreviewDeployment(ctx context.Context, projectID, branch string) (*jobs.InsertResult, error) {
+	res, err := c.riverClient.Insert(ctx, DeletePreviewDeploymentArgs{
+		ProjectID: projectID,
+		Branch:    branch,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &jobs.InsertResult{
+		ID:        res.Job.ID,
+		Duplicate: res.UniqueSkippedAsDuplicate,
+	}, nil
+}
+
 func (c *Client) PaymentMethodAdded(ctx context.Context, paymentMethodID, paymentCustomerID, paymentType string, eventTime time.Time) (*jobs.InsertResult, error) {
 	res, err := c.riverClient.Insert(ctx, PaymentMethodAddedArgs{
 		PaymentMethodID:   paymentMethodID,
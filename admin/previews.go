@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+const (
+	// previewDeploymentMaxSlots caps the slots provisioned for a preview deployment.
+	// Preview deployments are only used for reviewing changes, so they don't need the resources of the prod deployment.
+	previewDeploymentMaxSlots = 2
+	// PreviewDeploymentTTL is the duration after which an unused preview deployment is torn down.
+	PreviewDeploymentTTL = 3 * 24 * time.Hour
+)
+
+// ErrPreviewsNotSupported is returned when creating a preview deployment for a project that is not connected to Github.
+// Preview deployments are created for pull requests, which are currently only received from Github.
+var ErrPreviewsNotSupported = errors.New("preview deployments are only supported for projects connected to Github")
+
+// CreateOrUpdatePreviewDeployment ensures the project has a preview deployment for the branch.
+// If a preview deployment already exists for the branch, it triggers a pull of the latest changes instead.
+// Preview deployments belong to the project, so access to them follows the project's permissions.
+// It returns ErrPreviewsNotSupported if the project is not connected to Github.
+func (s *Service) CreateOrUpdatePreviewDeployment(ctx context.Context, proj *database.Project, branch string) (*database.Deployment, error) {
+	if proj.GithubURL == nil {
+		return nil, ErrPreviewsNotSupported
+	}
+	if branch == proj.ProdBranch {
+		return nil, errors.New("cannot create a preview deployment for the production branch")
+	}
+
+	depl, err := s.DB.FindPreviewDeployment(ctx, proj.ID, branch)
+	if err == nil {
+		err = s.TriggerParser(ctx, depl)
+		if err != nil {
+			return nil, err
+		}
+		return depl, nil
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := s.ResolveVariables(ctx, proj.ID, "prod", false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("preview: creating deployment", zap.String("project_id", proj.ID), zap.String("branch", branch), observability.ZapCtx(ctx))
+
+	return s.CreateDeployment(ctx, &CreateDeploymentOptions{
+		ProjectID:   proj.ID,
+		Annotations: s.NewDeploymentAnnotations(org, proj),
+		Branch:      branch,
+		Preview:     true,
+		Provisioner: proj.Provisioner,
+		Slots:       min(proj.ProdSlots, previewDeploymentMaxSlots),
+		Version:     proj.ProdVersion,
+		Variables:   vars,
+		OLAPDriver:  proj.ProdOLAPDriver,
+		OLAPDSN:     proj.ProdOLAPDSN,
+	})
+}
+
+// TeardownPreviewDeployment tears down the project's preview deployment for the branch (if any).
+func (s *Service) TeardownPreviewDeployment(ctx context.Context, proj *database.Project, branch string) error {
+	depl, err := s.DB.FindPreviewDeployment(ctx, proj.ID, branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	s.Logger.Info("preview: deleting deployment", zap.String("project_id", proj.ID), zap.String("branch", branch), zap.String("deployment_id", depl.ID), observability.ZapCtx(ctx))
+
+	return s.TeardownDeployment(ctx, depl)
+}
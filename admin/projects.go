@@ -191,12 +191,15 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		return nil, err
 	}
 
-	// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-	// It needs to be refactored when implementing preview deploys.
+	// NOTE: Preview deployments keep their own branch. All other deployments (almost always, there's just one) deploy the prod branch.
 	for _, d := range ds {
+		branch := opts.ProdBranch
+		if d.Preview {
+			branch = d.Branch
+		}
 		err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
 			Annotations:     annotations,
-			Branch:          opts.ProdBranch,
+			Branch:          branch,
 			Version:         opts.ProdVersion,
 			Variables:       nil,
 			EvictCachedRepo: true,
@@ -265,12 +268,15 @@ func (s *Service) UpdateProjectVariables(ctx context.Context, project *database.
 		return err
 	}
 
-	// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-	// It needs to be refactored when implementing preview deploys.
+	// NOTE: Preview deployments keep their own branch. All other deployments (almost always, there's just one) deploy the prod branch.
 	for _, d := range ds {
+		branch := project.ProdBranch
+		if d.Preview {
+			branch = d.Branch
+		}
 		err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
 			Annotations:     annotations,
-			Branch:          project.ProdBranch,
+			Branch:          branch,
 			Version:         project.ProdVersion,
 			Variables:       vars,
 			EvictCachedRepo: true,
@@ -303,9 +309,13 @@ func (s *Service) UpdateOrgDeploymentAnnotations(ctx context.Context, org *datab
 			}
 
 			for _, d := range ds {
+				branch := proj.ProdBranch
+				if d.Preview {
+					branch = d.Branch
+				}
 				err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
 					Annotations:     s.NewDeploymentAnnotations(org, proj),
-					Branch:          proj.ProdBranch,
+					Branch:          branch,
 					Version:         proj.ProdVersion,
 					Variables:       nil,
 					EvictCachedRepo: false,
@@ -12,14 +12,15 @@ import (
 func (s *Server) Complete(ctx context.Context, req *adminv1.CompleteRequest) (*adminv1.CompleteResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.Int("args.messages_len", len(req.Messages)),
+		attribute.Int("args.tools_len", len(req.Tools)),
 	)
 
-	msg, err := s.admin.AI.Complete(ctx, req.Messages)
+	msg, err := s.admin.AI.Complete(ctx, req.Messages, req.Tools)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Data == "" {
+	if msg.Data == "" && len(msg.ToolCalls) == 0 {
 		return nil, errors.New("the AI responded with an empty message")
 	}
 
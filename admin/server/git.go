@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/gitprovider"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gitWebhookTimeout bounds the time spent processing a single Git webhook event.
+const gitWebhookTimeout = 2 * time.Minute
+
+func (s *Server) ConnectProjectToGit(ctx context.Context, req *adminv1.ConnectProjectToGitRequest) (*adminv1.ConnectProjectToGitResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.provider", req.Provider),
+		attribute.String("args.remote", req.Remote),
+		attribute.String("args.branch", req.Branch),
+		attribute.String("args.subpath", req.Subpath),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project's git connection")
+	}
+
+	proj, conn, err := s.admin.ConnectProjectToGit(ctx, proj, &admin.ConnectProjectToGitOptions{
+		Provider:      req.Provider,
+		Remote:        req.Remote,
+		Username:      req.Username,
+		Password:      req.Password,
+		SSHPrivateKey: req.SshPrivateKey,
+		ProdBranch:    req.Branch,
+		Subpath:       req.Subpath,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &adminv1.ConnectProjectToGitResponse{}
+	if conn.SSHPrivateKey != "" {
+		res.SshPublicKey, err = gitprovider.PublicKey(conn.SSHPrivateKey)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if conn.Provider != "git" {
+		res.WebhookUrl = s.admin.URLs.GitWebhook(proj.ID)
+		res.WebhookSecret = conn.WebhookSecret
+	}
+
+	return res, nil
+}
+
+// registerGitEndpoints registers the non-gRPC endpoints for Git providers other than the Github App.
+func (s *Server) registerGitEndpoints(mux *http.ServeMux) {
+	inner := http.NewServeMux()
+	observability.MuxHandle(inner, "/git/webhook/{project_id}", http.HandlerFunc(s.gitWebhook))
+	mux.Handle("/git/", observability.Middleware("admin", s.logger, inner))
+}
+
+// gitWebhook receives push webhooks from Git providers for a project.
+// The provider is responsible for verifying the request using the project's webhook secret.
+func (s *Server) gitWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "expected a POST request", http.StatusBadRequest)
+		return
+	}
+
+	projectID := r.PathValue("project_id")
+	proj, err := s.admin.DB.FindProject(r.Context(), projectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			http.Error(w, "project not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to find project: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	conn, err := s.admin.DB.FindProjectGitConnection(r.Context(), proj.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			http.Error(w, "project is not connected to a git repository", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to find git connection: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	p, err := s.admin.GitProvider(conn.Provider)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	pushes, err := p.ParseWebhook(r, &gitprovider.Connection{WebhookSecret: conn.WebhookSecret})
+	if err != nil {
+		if errors.Is(err, gitprovider.ErrInvalidWebhook) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		http.Error(w, fmt.Sprintf("invalid webhook payload: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// Skip connections that have been superseded by a Github or archive source
+	if proj.GithubURL != nil || proj.ArchiveAssetID != nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// Don't abort processing if the sender disconnects, but bound how long it can take
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), gitWebhookTimeout)
+	defer cancel()
+
+	err = s.admin.ProcessGitWebhook(ctx, proj, conn, pushes)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to process event: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
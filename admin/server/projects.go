@@ -98,6 +98,7 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.OrganizationName),
 		attribute.String("args.project", req.Name),
+		attribute.String("args.branch", req.Branch),
 	)
 
 	org, err := s.admin.DB.FindOrganizationByName(ctx, req.OrganizationName)
@@ -130,16 +131,28 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project")
 	}
 
-	if proj.ProdDeploymentID == nil || !permissions.ReadProd {
+	// Preview deployments follow the same permissions as the prod deployment
+	if (proj.ProdDeploymentID == nil && req.Branch == "") || !permissions.ReadProd {
 		return &adminv1.GetProjectResponse{
 			Project:            s.projToDTO(proj, org.Name),
 			ProjectPermissions: permissions,
 		}, nil
 	}
 
-	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	var depl *database.Deployment
+	if req.Branch != "" {
+		depl, err = s.admin.DB.FindPreviewDeployment(ctx, proj.ID, req.Branch)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "no preview deployment found for branch %q", req.Branch)
+			}
+			return nil, err
+		}
+	} else {
+		depl, err = s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 	}
 
 	if !permissions.ReadProdStatus {
@@ -285,12 +298,17 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 
 	s.admin.Used.Deployment(depl.ID)
 
-	return &adminv1.GetProjectResponse{
+	res := &adminv1.GetProjectResponse{
 		Project:            s.projToDTO(proj, org.Name),
-		ProdDeployment:     deploymentToDTO(depl),
 		Jwt:                jwt,
 		ProjectPermissions: permissions,
-	}, nil
+	}
+	if depl.Preview {
+		res.PreviewDeployment = deploymentToDTO(depl)
+	} else {
+		res.ProdDeployment = deploymentToDTO(depl)
+	}
+	return res, nil
 }
 
 func (s *Server) GetProjectByID(ctx context.Context, req *adminv1.GetProjectByIDRequest) (*adminv1.GetProjectByIDResponse, error) {
@@ -1720,6 +1738,7 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		Id:                d.ID,
 		ProjectId:         d.ProjectID,
 		Branch:            d.Branch,
+		Preview:           d.Preview,
 		RuntimeHost:       d.RuntimeHost,
 		RuntimeInstanceId: d.RuntimeInstanceID,
 		Status:            s,
@@ -22,19 +22,29 @@ import (
 	"github.com/rilldata/rill/runtime/drivers/slack"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"gopkg.in/yaml.v3"
 )
 
+// reportSnapshotTokenTTL is the validity duration of the access tokens in report snapshot URLs.
+// The renderer loads the URLs right after the report metadata is fetched, so they can be short-lived.
+const reportSnapshotTokenTTL = 30 * time.Minute
+
 func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRequest) (*adminv1.GetReportMetaResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.project_id", req.ProjectId),
 		attribute.String("args.branch", req.Branch),
 		attribute.String("args.report", req.Report),
 		attribute.StringSlice("args.email_recipients", req.EmailRecipients),
+		attribute.Bool("args.recipient_attributes", req.RecipientAttributes),
+		attribute.String("args.snapshot_type", req.SnapshotType),
+		attribute.String("args.snapshot_resource", req.SnapshotResource),
 		attribute.String("args.execution_time", req.ExecutionTime.String()),
 	)
 
@@ -43,8 +53,12 @@ func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRe
 		return nil, err
 	}
 
-	permissions := auth.GetClaims(ctx).ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
-	if !permissions.ReadProdStatus {
+	// The response contains user attributes and access tokens for the report's owner and recipients,
+	// so only the project's deployments (which call it when running reports) and users who can manage reports can read it.
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	isDeployment := claims.OwnerType() == auth.OwnerTypeDeployment && permissions.ReadProdStatus
+	if !isDeployment && !permissions.ManageReports {
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read report meta")
 	}
 
@@ -52,29 +66,144 @@ func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRe
 		return nil, status.Error(codes.InvalidArgument, "branch not found")
 	}
 
+	// Snapshot URLs and recipient attributes are only issued for the owner and recipients of the report as defined in its spec
+	var reportDepl *database.Deployment
+	var reportRecipients map[string]bool
+	if req.SnapshotResource != "" || req.RecipientAttributes {
+		if proj.ProdDeploymentID == nil {
+			return nil, status.Error(codes.FailedPrecondition, "project does not have a production deployment")
+		}
+		reportDepl, err = s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+		if err != nil {
+			return nil, err
+		}
+		spec, err := s.admin.LookupReport(ctx, reportDepl, req.Report)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not get report: %s", err.Error())
+		}
+		reportRecipients, err = checkReportMetaRequest(spec, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	org, err := s.admin.DB.FindOrganization(ctx, proj.OrganizationID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Resolve the owner's attributes, which are used for queries that run on behalf of the owner (e.g. exports to Google Sheets)
+	var attrPB *structpb.Struct
+	if req.OwnerId != "" {
+		attr, err := s.getAttributesForUser(ctx, proj.OrganizationID, proj.ID, req.OwnerId, "")
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return nil, err
+		}
+		if attr != nil {
+			attrPB, err = structpb.NewStruct(attr)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	// Snapshots are rendered from an embed URL with a short-lived access token for the owner.
+	// If the report doesn't have an owner, the token doesn't have any user attributes.
+	var snapshotDepl *database.Deployment
+	var ownerSnapshotURL string
+	if req.SnapshotResource != "" {
+		snapshotDepl = reportDepl
+		var ownerAttr map[string]any
+		if attrPB != nil {
+			ownerAttr = attrPB.AsMap()
+		}
+		ownerSnapshotURL, err = s.reportSnapshotURL(org, snapshotDepl, req.SnapshotType, req.SnapshotResource, req.OwnerId, ownerAttr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Resolve the attributes of each recipient, which are used to run the report on behalf of each recipient when bursting.
+	// A failed lookup is recorded for the recipient instead of failing the request, so the report can still be sent to the other recipients.
+	var recipientAttrs map[string]*structpb.Struct
+	var recipientAttrErrs map[string]string
+	if req.RecipientAttributes {
+		recipientAttrs = make(map[string]*structpb.Struct, len(req.EmailRecipients))
+		for _, email := range req.EmailRecipients {
+			if !reportRecipients[email] {
+				if recipientAttrErrs == nil {
+					recipientAttrErrs = make(map[string]string)
+				}
+				recipientAttrErrs[email] = "not a recipient of the report"
+				continue
+			}
+			attr, err := s.getAttributesForUser(ctx, proj.OrganizationID, proj.ID, "", email)
+			if err == nil {
+				recipientAttrs[email], err = structpb.NewStruct(attr)
+			}
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				s.logger.Warn("failed to get attributes for report recipient", zap.String("project_id", proj.ID), zap.String("report", req.Report), zap.String("email", email), zap.Error(err))
+				if recipientAttrErrs == nil {
+					recipientAttrErrs = make(map[string]string)
+				}
+				recipientAttrErrs[email] = fmt.Sprintf("failed to get attributes: %s", err.Error())
+			}
+		}
+	}
+
 	if len(req.EmailRecipients) == 0 {
 		return &adminv1.GetReportMetaResponse{
 			BaseUrls: &adminv1.GetReportMetaResponse_URLs{
-				OpenUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
-				ExportUrl: s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportExport(org.Name, proj.Name, req.Report, ""),
-				EditUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportEdit(org.Name, proj.Name, req.Report, ""),
+				OpenUrl:     s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
+				ExportUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportExport(org.Name, proj.Name, req.Report, ""),
+				EditUrl:     s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportEdit(org.Name, proj.Name, req.Report, ""),
+				SnapshotUrl: ownerSnapshotURL,
 			},
+			QueryForAttributes:       attrPB,
+			RecipientAttributes:      recipientAttrs,
+			RecipientAttributeErrors: recipientAttrErrs,
 		}, nil
 	}
 
 	var externalEmails []string
+	var recipientSnapshotURLs map[string]string
+	if snapshotDepl != nil {
+		recipientSnapshotURLs = make(map[string]string, len(req.EmailRecipients))
+	}
 	for _, email := range req.EmailRecipients {
-		_, err := s.admin.DB.FindUserByEmail(ctx, email)
+		user, err := s.admin.DB.FindUserByEmail(ctx, email)
 		if err != nil {
 			if errors.Is(err, database.ErrNotFound) {
 				externalEmails = append(externalEmails, email)
-			} else {
-				return nil, fmt.Errorf("failed to find user by email: %w", err)
+				continue
+			}
+			return nil, fmt.Errorf("failed to find user by email: %w", err)
+		}
+
+		// Each member of the project gets a snapshot rendered with their own permissions.
+		// Recipients who are not members don't get a snapshot URL, so the runtime won't send them a snapshot.
+		if snapshotDepl != nil && reportRecipients[email] {
+			orgPerms, err := s.admin.OrganizationPermissionsForUser(ctx, proj.OrganizationID, user.ID)
+			if err != nil {
+				return nil, err
+			}
+			projPerms, err := s.admin.ProjectPermissionsForUser(ctx, proj.ID, user.ID, orgPerms)
+			if err != nil {
+				return nil, err
+			}
+			if !projPerms.ReadProd {
+				continue
+			}
+			attr, err := s.jwtAttributesForUser(ctx, user.ID, proj.OrganizationID, projPerms)
+			if err != nil {
+				return nil, err
+			}
+			recipientSnapshotURLs[email], err = s.reportSnapshotURL(org, snapshotDepl, req.SnapshotType, req.SnapshotResource, user.ID, attr)
+			if err != nil {
+				return nil, err
 			}
 		}
 	}
@@ -94,14 +223,92 @@ func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRe
 
 	return &adminv1.GetReportMetaResponse{
 		BaseUrls: &adminv1.GetReportMetaResponse_URLs{
-			OpenUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
-			ExportUrl: s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportExport(org.Name, proj.Name, req.Report, ""),
-			EditUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportEdit(org.Name, proj.Name, req.Report, ""),
+			OpenUrl:     s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
+			ExportUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportExport(org.Name, proj.Name, req.Report, ""),
+			EditUrl:     s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportEdit(org.Name, proj.Name, req.Report, ""),
+			SnapshotUrl: ownerSnapshotURL,
 		},
-		RecipientUrls: externalUrls,
+		RecipientUrls:            externalUrls,
+		QueryForAttributes:       attrPB,
+		RecipientAttributes:      recipientAttrs,
+		RecipientSnapshotUrls:    recipientSnapshotURLs,
+		RecipientAttributeErrors: recipientAttrErrs,
 	}, nil
 }
 
+// checkReportMetaRequest checks that the owner and snapshot target of a report meta request match the report's spec.
+// It returns the report's email recipients, which are the only emails that snapshot URLs and attributes are issued for.
+func checkReportMetaRequest(spec *runtimev1.ReportSpec, req *adminv1.GetReportMetaRequest) (map[string]bool, error) {
+	if req.OwnerId != parseReportAnnotations(spec.Annotations).AdminOwnerUserID {
+		return nil, status.Error(codes.PermissionDenied, "owner does not match the report's owner")
+	}
+
+	if req.SnapshotResource != "" {
+		var typ, resource string
+		switch {
+		case spec.ExportSnapshot.GetCanvas() != "":
+			typ, resource = runtime.ResourceKindCanvas, spec.ExportSnapshot.Canvas
+		case spec.ExportSnapshot.GetExplore() != "":
+			typ, resource = runtime.ResourceKindExplore, spec.ExportSnapshot.Explore
+		}
+		if req.SnapshotType != typ || req.SnapshotResource != resource {
+			return nil, status.Error(codes.InvalidArgument, "snapshot target does not match the report")
+		}
+	}
+
+	recipients := make(map[string]bool)
+	for _, n := range spec.Notifiers {
+		if n.Connector == "email" {
+			for _, email := range pbutil.ToSliceString(n.Properties.AsMap()["recipients"]) {
+				recipients[email] = true
+			}
+		}
+	}
+	for _, s := range spec.Burst.GetSlices() {
+		for _, email := range s.Recipients {
+			recipients[email] = true
+		}
+	}
+	return recipients, nil
+}
+
+// reportSnapshotURL returns an embed URL for rendering a snapshot of a canvas or explore.
+// The URL contains a short-lived access token with read-only permissions and the given user's attributes.
+func (s *Server) reportSnapshotURL(org *database.Organization, depl *database.Deployment, typ, resource, userID string, attr map[string]any) (string, error) {
+	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
+		AudienceURL: depl.RuntimeAudience,
+		Subject:     userID,
+		TTL:         reportSnapshotTokenTTL,
+		InstancePermissions: map[string][]runtimeauth.Permission{
+			depl.RuntimeInstanceID: {
+				runtimeauth.ReadObjects,
+				runtimeauth.ReadMetrics,
+				runtimeauth.ReadAPI,
+			},
+		},
+		Attributes: attr,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not issue jwt: %w", err)
+	}
+
+	if typ == "" {
+		typ = runtime.ResourceKindExplore
+	}
+	u, err := s.admin.URLs.WithCustomDomain(org.CustomDomain).Embed(map[string]string{
+		"runtime_host": depl.RuntimeHost,
+		"instance_id":  depl.RuntimeInstanceID,
+		"access_token": jwt,
+		"type":         typ,
+		"kind":         typ, // For backwards compatibility
+		"resource":     resource,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not construct snapshot url: %w", err)
+	}
+	return u, nil
+}
+
 func (s *Server) CreateReport(ctx context.Context, req *adminv1.CreateReportRequest) (*adminv1.CreateReportResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.organization", req.Organization),
@@ -479,6 +686,7 @@ func (s *Server) yamlForManagedReport(opts *adminv1.ReportOptions, ownerUserID s
 	res.Query.ArgsJSON = opts.QueryArgsJson
 	res.Export.Format = opts.ExportFormat.String()
 	res.Export.Limit = uint(opts.ExportLimit)
+	res.Export.GoogleSheets = newReportGoogleSheetsYAML(opts.ExportGoogleSheets)
 	res.Notify.Email.Recipients = opts.EmailRecipients
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
@@ -510,6 +718,12 @@ func (s *Server) yamlForCommittedReport(opts *adminv1.ReportOptions) ([]byte, er
 		exportFormat = "parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
 		exportFormat = "xlsx"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		exportFormat = "jsonl"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		exportFormat = "arrow"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS:
+		exportFormat = "google_sheets"
 	default:
 		exportFormat = opts.ExportFormat.String()
 	}
@@ -525,6 +739,7 @@ func (s *Server) yamlForCommittedReport(opts *adminv1.ReportOptions) ([]byte, er
 	res.Query.Args = args
 	res.Export.Format = exportFormat
 	res.Export.Limit = uint(opts.ExportLimit)
+	res.Export.GoogleSheets = newReportGoogleSheetsYAML(opts.ExportGoogleSheets)
 	res.Notify.Email.Recipients = opts.EmailRecipients
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
@@ -712,6 +927,7 @@ func recreateReportOptionsFromSpec(spec *runtimev1.ReportSpec) (*adminv1.ReportO
 	opts.QueryArgsJson = spec.QueryArgsJson
 	opts.ExportLimit = spec.ExportLimit
 	opts.ExportFormat = spec.ExportFormat
+	opts.ExportGoogleSheets = spec.ExportGoogleSheets
 	for _, notifier := range spec.Notifiers {
 		switch notifier.Connector {
 		case "email":
@@ -752,8 +968,9 @@ type reportYAML struct {
 		ArgsJSON string         `yaml:"args_json,omitempty"`
 	} `yaml:"query"`
 	Export struct {
-		Format string `yaml:"format"`
-		Limit  uint   `yaml:"limit"`
+		Format       string                  `yaml:"format"`
+		Limit        uint                    `yaml:"limit"`
+		GoogleSheets *reportGoogleSheetsYAML `yaml:"google_sheets,omitempty"`
 	} `yaml:"export"`
 	Notify struct {
 		Email struct {
@@ -768,6 +985,21 @@ type reportYAML struct {
 	Annotations reportAnnotations `yaml:"annotations,omitempty"`
 }
 
+type reportGoogleSheetsYAML struct {
+	SpreadsheetID string `yaml:"spreadsheet_id"`
+	Sheet         string `yaml:"sheet,omitempty"`
+}
+
+func newReportGoogleSheetsYAML(opts *runtimev1.GoogleSheetsExportOptions) *reportGoogleSheetsYAML {
+	if opts == nil {
+		return nil
+	}
+	return &reportGoogleSheetsYAML{
+		SpreadsheetID: opts.SpreadsheetId,
+		Sheet:         opts.Sheet,
+	}
+}
+
 type reportAnnotations struct {
 	AdminOwnerUserID string `yaml:"admin_owner_user_id"`
 	AdminManaged     bool   `yaml:"admin_managed"`
@@ -2,9 +2,10 @@ package server
 
 import (
 	"context"
+	"errors"
 	"time"
 
-	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -33,8 +34,9 @@ func (s *Server) GetRepoMeta(ctx context.Context, req *adminv1.GetRepoMetaReques
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project repo")
 	}
 
-	if proj.ProdBranch != req.Branch {
-		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	err = s.checkRepoBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, err
 	}
 
 	if proj.ArchiveAssetID != nil {
@@ -54,27 +56,20 @@ func (s *Server) GetRepoMeta(ctx context.Context, req *adminv1.GetRepoMetaReques
 		}, nil
 	}
 
-	if proj.GithubURL == nil || proj.GithubInstallationID == nil {
-		return nil, status.Error(codes.FailedPrecondition, "project does not have a github integration")
-	}
-
-	token, err := s.admin.Github.InstallationToken(ctx, *proj.GithubInstallationID)
+	creds, err := s.admin.ProjectGitCredentials(ctx, proj)
 	if err != nil {
+		if errors.Is(err, admin.ErrProjectNotConnectedToGit) {
+			return nil, status.Error(codes.FailedPrecondition, "project does not have a git integration")
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ep, err := transport.NewEndpoint(*proj.GithubURL + ".git") // TODO: Can the clone URL be different from the HTTP URL of a Github repo?
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to create endpoint from %q: %s", *proj.GithubURL, err.Error())
-	}
-	ep.User = "x-access-token"
-	ep.Password = token
-	gitURL := ep.String()
-
 	return &adminv1.GetRepoMetaResponse{
-		GitUrl:          gitURL,
-		GitUrlExpiresOn: timestamppb.New(time.Now().Add(gitURLTTL)),
-		GitSubpath:      proj.Subpath,
+		GitUrl:           creds.URL,
+		GitUrlExpiresOn:  timestamppb.New(time.Now().Add(gitURLTTL)),
+		GitSubpath:       proj.Subpath,
+		GitSshPrivateKey: creds.SSHPrivateKey,
+		GitSshHostKey:    creds.SSHHostKey,
 	}, nil
 }
 
@@ -91,8 +86,9 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 		return nil, err
 	}
 
-	if proj.ProdBranch != req.Branch {
-		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	err = s.checkRepoBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, err
 	}
 
 	permissions := auth.GetClaims(ctx).ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
@@ -130,6 +126,23 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 	}, nil
 }
 
+// checkRepoBranch returns an error if the branch is neither the project's prod branch nor the branch of one of its preview deployments.
+func (s *Server) checkRepoBranch(ctx context.Context, proj *database.Project, branch string) error {
+	if proj.ProdBranch == branch {
+		return nil
+	}
+
+	_, err := s.admin.DB.FindPreviewDeployment(ctx, proj.ID, branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return status.Error(codes.InvalidArgument, "branch not found")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func virtualFileToDTO(vf *database.VirtualFile) *adminv1.VirtualFile {
 	return &adminv1.VirtualFile{
 		Path:      vf.Path,
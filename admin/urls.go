@@ -213,6 +213,11 @@ func (u *URLs) GithubAuthCallback() string {
 	return urlutil.MustJoinURL(u.external, "/github/auth/callback") // NOTE: Always using the primary external URL.
 }
 
+// GitWebhook returns the URL that Git providers should send push webhooks for a project to.
+func (u *URLs) GitWebhook(projectID string) string {
+	return urlutil.MustJoinURL(u.external, "/git/webhook", projectID) // NOTE: Always using the primary external URL.
+}
+
 // GithubConnectUI returns the page in the Rill frontend for starting the Github connect flow.
 func (u *URLs) GithubConnectUI(redirect string) string {
 	res := urlutil.MustJoinURL(u.frontend, "/-/github/connect") // NOTE: Always using the primary frontend URL.
@@ -287,6 +292,11 @@ func (u *URLs) Project(org, project string) string {
 	return urlutil.MustJoinURL(u.Frontend(), org, project)
 }
 
+// ProjectPreview returns the URL for a project's preview deployment of a branch in the frontend.
+func (u *URLs) ProjectPreview(org, project, branch string) string {
+	return urlutil.MustWithQuery(urlutil.MustJoinURL(u.Frontend(), org, project), map[string]string{"branch": branch})
+}
+
 // ProjectInviteAccept returns the URL for accepting a project invite.
 func (u *URLs) ProjectInviteAccept(org, project string) string {
 	redirect := urlutil.MustJoinURL(u.Frontend(), org, project)                                                            // NOTE: Redirecting to the custom domain if set.
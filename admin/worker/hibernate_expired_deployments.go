@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.uber.org/zap"
@@ -13,6 +14,12 @@ func (w *Worker) hibernateExpiredDeployments(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	previews, err := w.admin.DB.FindExpiredPreviewDeployments(ctx, admin.PreviewDeploymentTTL)
+	if err != nil {
+		return err
+	}
+	depls = append(depls, previews...)
 	if len(depls) == 0 {
 		return nil
 	}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+const pollGitConnectionsPageSize = 100
+
+// pollGitConnections detects pushes to repositories connected through a Git provider other than the Github App.
+// It also catches pushes that were missed by webhooks.
+func (w *Worker) pollGitConnections(ctx context.Context) error {
+	afterProjectID := ""
+	for {
+		conns, err := w.admin.DB.FindProjectGitConnections(ctx, afterProjectID, pollGitConnectionsPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, conn := range conns {
+			err := w.admin.PollGitConnection(ctx, conn)
+			if err != nil {
+				w.logger.Warn("poll git connections: failed to poll repository", zap.String("project_id", conn.ProjectID), zap.String("provider", conn.Provider), zap.Error(err), observability.ZapCtx(ctx))
+			}
+		}
+
+		if len(conns) < pollGitConnectionsPageSize {
+			return nil
+		}
+		afterProjectID = conns[len(conns)-1].ProjectID
+	}
+}
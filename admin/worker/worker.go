@@ -78,6 +78,9 @@ func (w *Worker) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return w.schedule(ctx, "deployments_health_check", w.deploymentsHealthCheck, 10*time.Minute)
 	})
+	group.Go(func() error {
+		return w.schedule(ctx, "poll_git_connections", w.pollGitConnections, 2*time.Minute)
+	})
 
 	if w.admin.Biller.GetReportingWorkerCron() != "" {
 		group.Go(func() error {
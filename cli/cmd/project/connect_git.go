@@ -0,0 +1,88 @@
+package project
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ConnectGitCmd(ch *cmdutil.Helper) *cobra.Command {
+	var name, provider, remote, username, password, sshKeyFile, branch, subpath string
+
+	connectGitCmd := &cobra.Command{
+		Use:   "connect-git [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Connect a project to a GitLab, Bitbucket or other Git repository",
+		Long: `Connect a project to a Git repository hosted on GitLab, Bitbucket or any other Git server.
+For Github repositories, use "rill project connect-github" instead.
+
+For HTTPS remotes, pass an access token with --password (and --username if the provider requires it).
+For SSH remotes, pass a private key with --ssh-key-file, or omit it to generate a deploy key that you must add to the repository.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			var sshKey string
+			if sshKeyFile != "" {
+				data, err := os.ReadFile(sshKeyFile)
+				if err != nil {
+					return fmt.Errorf("failed to read SSH key file: %w", err)
+				}
+				sshKey = string(data)
+			}
+
+			res, err := client.ConnectProjectToGit(cmd.Context(), &adminv1.ConnectProjectToGitRequest{
+				Organization:  ch.Org,
+				Project:       name,
+				Provider:      provider,
+				Remote:        remote,
+				Username:      username,
+				Password:      password,
+				SshPrivateKey: sshKey,
+				Branch:        branch,
+				Subpath:       subpath,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Connected project %q to %s\n", name, remote)
+
+			if res.SshPublicKey != "" && sshKeyFile == "" {
+				ch.PrintfWarn("\nAdd this deploy key with read access to the repository:\n\n")
+				fmt.Printf("  %s\n", res.SshPublicKey)
+			}
+
+			if res.WebhookUrl != "" {
+				ch.PrintfWarn("\nTo deploy changes immediately, add a push webhook to the repository:\n\n")
+				fmt.Printf("  URL: %s\n", res.WebhookUrl)
+				fmt.Printf("  Secret: %s\n", res.WebhookSecret)
+				fmt.Printf("\nWithout a webhook, changes are detected by polling every few minutes.\n")
+			}
+
+			return nil
+		},
+	}
+
+	connectGitCmd.Flags().SortFlags = false
+	connectGitCmd.Flags().StringVar(&name, "project", "", "Project Name")
+	connectGitCmd.Flags().StringVar(&provider, "provider", "git", "Git provider (options: gitlab, bitbucket, git)")
+	connectGitCmd.Flags().StringVar(&remote, "remote", "", "Git remote URL (HTTPS or SSH)")
+	connectGitCmd.Flags().StringVar(&username, "username", "", "Username for HTTPS remotes")
+	connectGitCmd.Flags().StringVar(&password, "password", "", "Password or access token for HTTPS remotes")
+	connectGitCmd.Flags().StringVar(&sshKeyFile, "ssh-key-file", "", "Path to a private key for SSH remotes (generates a deploy key if not provided)")
+	connectGitCmd.Flags().StringVar(&branch, "prod-branch", "main", "Git branch to deploy from")
+	connectGitCmd.Flags().StringVar(&subpath, "subpath", "", "Relative path to project in the repository (for monorepos)")
+	_ = connectGitCmd.MarkFlagRequired("remote")
+
+	return connectGitCmd
+}
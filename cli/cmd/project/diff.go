@@ -0,0 +1,305 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	runtimeclient "github.com/rilldata/rill/runtime/client"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/spf13/cobra"
+)
+
+func DiffCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, compareProject, compareTable string
+	var local, compareLocal, all bool
+	var keys []string
+
+	diffCmd := &cobra.Command{
+		Use:   "diff [<project-name>] <model>",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Compare the output of a model between two deployments or tables",
+		Long: `Compare the output table of a model with another version of it.
+
+The other version can be:
+- the same model in another project deployment (--compare-project), for example a staging project,
+- the same model in a locally running Rill (--compare-local), for example to check the impact of a change before deploying it,
+- another table in the same deployment (--compare-table), for example a copy of the model with a changed SQL query.
+
+The diff reports schema changes, the row count delta and per-column drift of the null count, cardinality and numeric summary statistics.
+If --key is provided and the tables are in the same deployment, the rows are matched on the key columns to count added, removed and changed rows.`,
+		Example: `  rill project diff orders --compare-local
+  rill project diff my-project orders --compare-project my-project-staging
+  rill project diff orders --compare-table orders_v2 --key order_id`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if len(args) == 2 {
+				project = args[0]
+			}
+			if !local && !cmd.Flags().Changed("project") && len(args) == 1 && ch.Interactive {
+				var err error
+				project, err = ch.InferProjectName(ctx, ch.Org, path)
+				if err != nil {
+					return fmt.Errorf("unable to infer project name (use `--project` to explicitly specify the name): %w", err)
+				}
+			}
+			model := args[len(args)-1]
+
+			n := 0
+			for _, set := range []bool{compareProject != "", compareLocal, compareTable != ""} {
+				if set {
+					n++
+				}
+			}
+			if n != 1 {
+				return fmt.Errorf("exactly one of --compare-project, --compare-local or --compare-table must be set")
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(ctx, ch.Org, project, local)
+			if err != nil {
+				return err
+			}
+			defer rt.Close()
+
+			connector, table, err := modelTable(ctx, rt, instanceID, model)
+			if err != nil {
+				return err
+			}
+
+			var res *runtimev1.TableDiffResponse
+			if compareTable != "" {
+				res, err = rt.TableDiff(ctx, &runtimev1.TableDiffRequest{
+					InstanceId:       instanceID,
+					Connector:        connector,
+					BaseTableName:    table,
+					CompareTableName: compareTable,
+					Keys:             keys,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to diff tables: %w", err)
+				}
+			} else {
+				if len(keys) > 0 {
+					ch.PrintfWarn("Ignoring --key: matching rows on keys is only supported with --compare-table\n")
+				}
+
+				crt, compareInstanceID, err := ch.OpenRuntimeClient(ctx, ch.Org, compareProject, compareLocal)
+				if err != nil {
+					return err
+				}
+				defer crt.Close()
+
+				compareConnector, compareResultTable, err := modelTable(ctx, crt, compareInstanceID, model)
+				if err != nil {
+					return err
+				}
+
+				base, err := profileTable(ctx, rt, instanceID, connector, table)
+				if err != nil {
+					return fmt.Errorf("failed to profile model %q: %w", model, err)
+				}
+				compare, err := profileTable(ctx, crt, compareInstanceID, compareConnector, compareResultTable)
+				if err != nil {
+					return fmt.Errorf("failed to profile model %q in the compared deployment: %w", model, err)
+				}
+
+				res = queries.DiffTableProfiles(base, compare)
+			}
+
+			printDiff(ch, res, all)
+			return nil
+		},
+	}
+
+	diffCmd.Flags().SortFlags = false
+	diffCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	diffCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	diffCmd.Flags().BoolVar(&local, "local", false, "Use the model in locally running Rill as the base")
+	diffCmd.Flags().StringVar(&compareProject, "compare-project", "", "Compare with the model in another project")
+	diffCmd.Flags().BoolVar(&compareLocal, "compare-local", false, "Compare with the model in locally running Rill")
+	diffCmd.Flags().StringVar(&compareTable, "compare-table", "", "Compare with another table in the same deployment")
+	diffCmd.Flags().StringSliceVar(&keys, "key", nil, "Key columns used to match rows (only with --compare-table)")
+	diffCmd.Flags().BoolVar(&all, "all", false, "Show unchanged columns")
+
+	return diffCmd
+}
+
+type columnDiffRow struct {
+	Column      string `header:"column" json:"column"`
+	Change      string `header:"change" json:"change"`
+	Type        string `header:"type" json:"type"`
+	Nulls       string `header:"nulls" json:"nulls"`
+	Cardinality string `header:"cardinality" json:"cardinality"`
+	Min         string `header:"min" json:"min"`
+	Max         string `header:"max" json:"max"`
+	Mean        string `header:"mean" json:"mean"`
+	ChangedRows string `header:"changed rows" json:"changed_rows"`
+}
+
+// modelTable returns the connector and table that contain a model's output.
+func modelTable(ctx context.Context, rt *runtimeclient.Client, instanceID, model string) (string, string, error) {
+	res, err := rt.GetResource(ctx, &runtimev1.GetResourceRequest{
+		InstanceId: instanceID,
+		Name:       &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: model},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get model %q: %w", model, err)
+	}
+	state := res.Resource.GetModel().State
+	if state.ResultTable == "" {
+		return "", "", fmt.Errorf("model %q has no output table", model)
+	}
+	return state.ResultConnector, state.ResultTable, nil
+}
+
+// profileTable profiles a table using the column profiling APIs.
+func profileTable(ctx context.Context, rt *runtimeclient.Client, instanceID, connector, table string) (*queries.TableProfile, error) {
+	cols, err := rt.TableColumns(ctx, &runtimev1.TableColumnsRequest{
+		InstanceId: instanceID,
+		Connector:  connector,
+		TableName:  table,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := rt.TableCardinality(ctx, &runtimev1.TableCardinalityRequest{
+		InstanceId: instanceID,
+		Connector:  connector,
+		TableName:  table,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queries.TableProfile{
+		RowCount: card.Cardinality,
+		Columns:  cols.ProfileColumns,
+		Profiles: make(map[string]*runtimev1.TableDiffColumnProfile, len(cols.ProfileColumns)),
+	}
+	for _, c := range cols.ProfileColumns {
+		nulls, err := rt.ColumnNullCount(ctx, &runtimev1.ColumnNullCountRequest{
+			InstanceId: instanceID,
+			Connector:  connector,
+			TableName:  table,
+			ColumnName: c.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		cc, err := rt.ColumnCardinality(ctx, &runtimev1.ColumnCardinalityRequest{
+			InstanceId: instanceID,
+			Connector:  connector,
+			TableName:  table,
+			ColumnName: c.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		cp := &runtimev1.TableDiffColumnProfile{
+			NullCount:   nulls.Count,
+			Cardinality: cc.CategoricalSummary.GetCardinality(),
+		}
+
+		if queries.IsNumericType(c.Type) {
+			stats, err := rt.ColumnDescriptiveStatistics(ctx, &runtimev1.ColumnDescriptiveStatisticsRequest{
+				InstanceId: instanceID,
+				Connector:  connector,
+				TableName:  table,
+				ColumnName: c.Name,
+			})
+			if err != nil {
+				return nil, err
+			}
+			cp.NumericStatistics = stats.NumericSummary.GetNumericStatistics()
+		}
+
+		p.Profiles[c.Name] = cp
+	}
+
+	return p, nil
+}
+
+func printDiff(ch *cmdutil.Helper, res *runtimev1.TableDiffResponse, all bool) {
+	ch.Printf("Rows: %d -> %d (%s)\n", res.BaseRowCount, res.CompareRowCount, formatDelta(float64(res.BaseRowCount), float64(res.CompareRowCount)))
+	if res.Rows != nil {
+		ch.Printf("Matched on keys: %d added, %d removed, %d changed, %d unchanged\n", res.Rows.Added, res.Rows.Removed, res.Rows.Changed, res.Rows.Unchanged)
+	}
+	ch.Printf("\n")
+
+	var rows []*columnDiffRow
+	for _, c := range res.Columns {
+		row := &columnDiffRow{Column: c.Name}
+		switch {
+		case c.BaseType == "":
+			row.Change = "added"
+			row.Type = c.CompareType
+		case c.CompareType == "":
+			row.Change = "removed"
+			row.Type = c.BaseType
+		case c.BaseType != c.CompareType:
+			row.Change = "type changed"
+			row.Type = fmt.Sprintf("%s -> %s", c.BaseType, c.CompareType)
+		default:
+			row.Type = c.BaseType
+		}
+
+		if c.Base != nil && c.Compare != nil {
+			row.Nulls = formatDrift(c.Base.NullCount, c.Compare.NullCount)
+			row.Cardinality = formatDrift(c.Base.Cardinality, c.Compare.Cardinality)
+			if bs, cs := c.Base.NumericStatistics, c.Compare.NumericStatistics; bs != nil && cs != nil {
+				row.Min = formatDrift(bs.Min, cs.Min)
+				row.Max = formatDrift(bs.Max, cs.Max)
+				row.Mean = formatDrift(bs.Mean, cs.Mean)
+			}
+			if res.Rows != nil {
+				row.ChangedRows = fmt.Sprintf("%d", c.ChangedRows)
+			}
+			if row.Change == "" && (row.Nulls != "" || row.Cardinality != "" || row.Min != "" || row.Max != "" || row.Mean != "" || c.ChangedRows > 0) {
+				row.Change = "drift"
+			}
+		}
+
+		if row.Change == "" && !all {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		ch.PrintfSuccess("No schema changes or column drift found\n")
+		return
+	}
+	ch.PrintData(rows)
+}
+
+// formatDrift formats the change between two values, or returns an empty string if they are equal.
+func formatDrift(base, compare float64) string {
+	if base == compare || (math.IsNaN(base) && math.IsNaN(compare)) {
+		return ""
+	}
+	return fmt.Sprintf("%s -> %s (%s)", formatNumber(base), formatNumber(compare), formatDelta(base, compare))
+}
+
+func formatDelta(base, compare float64) string {
+	d := compare - base
+	s := formatNumber(d)
+	if d >= 0 {
+		s = "+" + s
+	}
+	if base != 0 {
+		s = fmt.Sprintf("%s, %+.1f%%", s, d/math.Abs(base)*100)
+	}
+	return s
+}
+
+func formatNumber(v float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.4f", v), "0"), ".")
+}
@@ -0,0 +1,117 @@
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/printer"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func ExplainSecurityCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, email, attributes string
+	var groups, resources []string
+	var admin, rules, local bool
+
+	explainCmd := &cobra.Command{
+		Use:   "explain-security [<project>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Explain what a user can access in a project",
+		Long: `Simulate the security policies of a project for a user with the given attributes.
+For each metrics view, explore, canvas and API, it shows the access decision, the visible fields,
+the effective row filter and the rules that produced each outcome.`,
+		Example: `  rill project explain-security --email john@example.com
+  rill project explain-security --email jane@partner.com --groups partners --rules
+  rill project explain-security --attributes '{"tenant_id": "acme"}' --resource metrics_view/orders --local`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			if !local && !cmd.Flags().Changed("project") && len(args) == 0 && ch.Interactive {
+				var err error
+				project, err = ch.InferProjectName(cmd.Context(), ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			attrs := map[string]any{}
+			if attributes != "" {
+				err := json.Unmarshal([]byte(attributes), &attrs)
+				if err != nil {
+					return fmt.Errorf("failed to parse attributes as JSON: %w", err)
+				}
+			}
+			if email != "" {
+				attrs["email"] = email
+			}
+			if len(groups) > 0 {
+				gs := make([]any, len(groups))
+				for i, g := range groups {
+					gs[i] = g
+				}
+				attrs["groups"] = gs
+			}
+			if cmd.Flags().Changed("admin") {
+				attrs["admin"] = admin
+			}
+			attrsPB, err := structpb.NewStruct(attrs)
+			if err != nil {
+				return fmt.Errorf("invalid attributes: %w", err)
+			}
+
+			var names []*runtimev1.ResourceName
+			for _, r := range resources {
+				kind, name, ok := strings.Cut(r, "/")
+				if !ok {
+					return fmt.Errorf("invalid resource %q: expected format <type>/<name>", r)
+				}
+				names = append(names, &runtimev1.ResourceName{Kind: parseResourceKind(kind), Name: name})
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(cmd.Context(), ch.Org, project, local)
+			if err != nil {
+				return err
+			}
+
+			res, err := rt.ExplainSecurity(cmd.Context(), &runtimev1.ExplainSecurityRequest{
+				InstanceId: instanceID,
+				Attributes: attrsPB,
+				Resources:  names,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to explain security: %w", err)
+			}
+
+			if ch.Printer.Format == printer.FormatJSON {
+				return ch.PrintProto(res)
+			}
+
+			ch.PrintSecurityExplanations(res.Resources)
+			if rules {
+				ch.Println()
+				ch.PrintSecurityRuleEvaluations(res.Resources)
+			}
+
+			return nil
+		},
+	}
+
+	explainCmd.Flags().SortFlags = false
+	explainCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	explainCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	explainCmd.Flags().StringVar(&email, "email", "", "Email of the simulated user")
+	explainCmd.Flags().StringSliceVar(&groups, "groups", nil, "Groups of the simulated user")
+	explainCmd.Flags().BoolVar(&admin, "admin", false, "Simulate an admin user")
+	explainCmd.Flags().StringVar(&attributes, "attributes", "", "Additional user attributes as JSON")
+	explainCmd.Flags().StringSliceVar(&resources, "resource", nil, "Only explain specific resources (format: <type>/<name>)")
+	explainCmd.Flags().BoolVar(&rules, "rules", false, "Show how each security rule was evaluated")
+	explainCmd.Flags().BoolVar(&local, "local", false, "Target locally running Rill")
+
+	return explainCmd
+}
@@ -0,0 +1,203 @@
+package project
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/spf13/cobra"
+)
+
+func LineageCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, column string
+	var local, columns bool
+
+	lineageCmd := &cobra.Command{
+		Use:   "lineage [<project-name>] <type> <name>",
+		Args:  cobra.MatchAll(cobra.MinimumNArgs(2), cobra.MaximumNArgs(3)),
+		Short: "Show the upstream and downstream resources of a resource",
+		Long: `Show the resources that a resource (source, model, metrics_view, ...) depends on, and the resources that depend on it.
+
+Use --columns to show which upstream columns feed each column of the models and metrics views in the graph.
+Use --column to trace a single column: it shows the upstream columns it is derived from, and the downstream columns, dimensions and measures that break if it is renamed or removed.
+Column-level lineage is best-effort and is only available for DuckDB models and metrics views.`,
+		Example: `  rill project lineage model orders
+  rill project lineage metrics_view orders_metrics --columns
+  rill project lineage source raw_orders --column customer_id --local`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 3 {
+				project = args[0]
+			}
+			if !local && !cmd.Flags().Changed("project") && len(args) == 2 && ch.Interactive {
+				var err error
+				project, err = ch.InferProjectName(cmd.Context(), ch.Org, path)
+				if err != nil {
+					return fmt.Errorf("unable to infer project name (use `--project` to explicitly specify the name): %w", err)
+				}
+			}
+
+			name := &runtimev1.ResourceName{
+				Kind: parseResourceKind(args[len(args)-2]),
+				Name: args[len(args)-1],
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(cmd.Context(), ch.Org, project, local)
+			if err != nil {
+				return err
+			}
+			defer rt.Close()
+
+			res, err := rt.GetResourceLineage(cmd.Context(), &runtimev1.GetResourceLineageRequest{
+				InstanceId: instanceID,
+				Name:       name,
+				Columns:    columns || column != "",
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get lineage: %w", err)
+			}
+
+			if column != "" {
+				rows := traceColumn(res.Columns, name, column)
+				if len(rows) == 0 {
+					ch.PrintfWarn("No column lineage found for column %q of %s %q\n", column, runtime.PrettifyResourceKind(name.Kind), name.Name)
+					return nil
+				}
+				ch.PrintData(rows)
+				return nil
+			}
+
+			if columns {
+				if len(res.Columns) == 0 {
+					ch.PrintfWarn("No column lineage found\n")
+					return nil
+				}
+				ch.PrintData(toColumnLineageRows(res.Columns))
+				return nil
+			}
+
+			var rows []*lineageRow
+			for _, n := range res.Upstream {
+				rows = append(rows, &lineageRow{Direction: "upstream", Type: runtime.PrettifyResourceKind(n.Kind), Name: n.Name})
+			}
+			for _, n := range res.Downstream {
+				rows = append(rows, &lineageRow{Direction: "downstream", Type: runtime.PrettifyResourceKind(n.Kind), Name: n.Name})
+			}
+			if len(rows) == 0 {
+				ch.PrintfWarn("%s %q has no upstream or downstream resources\n", runtime.PrettifyResourceKind(name.Kind), name.Name)
+				return nil
+			}
+			ch.PrintData(rows)
+			return nil
+		},
+	}
+
+	lineageCmd.Flags().SortFlags = false
+	lineageCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	lineageCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	lineageCmd.Flags().BoolVar(&local, "local", false, "Target locally running Rill")
+	lineageCmd.Flags().BoolVar(&columns, "columns", false, "Show column-level lineage")
+	lineageCmd.Flags().StringVar(&column, "column", "", "Trace the upstream sources and downstream dependents of a column")
+
+	return lineageCmd
+}
+
+type lineageRow struct {
+	Direction string `header:"direction" json:"direction"`
+	Type      string `header:"type" json:"type"`
+	Name      string `header:"name" json:"name"`
+}
+
+type columnLineageRow struct {
+	Type    string `header:"type" json:"type"`
+	Name    string `header:"name" json:"name"`
+	Column  string `header:"column" json:"column"`
+	Sources string `header:"sources" json:"sources"`
+}
+
+type columnTraceRow struct {
+	Direction string `header:"direction" json:"direction"`
+	Type      string `header:"type" json:"type"`
+	Name      string `header:"name" json:"name"`
+	Column    string `header:"column" json:"column"`
+}
+
+func toColumnLineageRows(cols []*runtimev1.ColumnLineage) []*columnLineageRow {
+	rows := make([]*columnLineageRow, 0, len(cols))
+	for _, c := range cols {
+		srcs := make([]string, 0, len(c.Sources))
+		for _, s := range c.Sources {
+			srcs = append(srcs, fmt.Sprintf("%s.%s", s.Resource.Name, s.Column))
+		}
+		rows = append(rows, &columnLineageRow{
+			Type:    runtime.PrettifyResourceKind(c.Resource.Kind),
+			Name:    c.Resource.Name,
+			Column:  c.Column,
+			Sources: strings.Join(srcs, ", "),
+		})
+	}
+	return rows
+}
+
+// traceColumn returns the columns that the given column is transitively derived from (upstream),
+// and the columns that are transitively derived from it (downstream).
+func traceColumn(cols []*runtimev1.ColumnLineage, name *runtimev1.ResourceName, column string) []*columnTraceRow {
+	type key struct{ kind, name, column string }
+	keyOf := func(n *runtimev1.ResourceName, col string) key {
+		return key{n.Kind, strings.ToLower(n.Name), strings.ToLower(col)}
+	}
+
+	byKey := make(map[key]*runtimev1.ColumnLineage, len(cols))
+	for _, c := range cols {
+		byKey[keyOf(c.Resource, c.Column)] = c
+	}
+
+	var rows []*columnTraceRow
+	start := keyOf(name, column)
+
+	// Walk upstream through the sources
+	seen := map[key]bool{start: true}
+	queue := []key{start}
+	for len(queue) > 0 {
+		c, ok := byKey[queue[0]]
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+		for _, s := range c.Sources {
+			k := keyOf(s.Resource, s.Column)
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			queue = append(queue, k)
+			rows = append(rows, &columnTraceRow{Direction: "upstream", Type: runtime.PrettifyResourceKind(s.Resource.Kind), Name: s.Resource.Name, Column: s.Column})
+		}
+	}
+
+	// Walk downstream through the columns that have a source in the frontier
+	seen = map[key]bool{start: true}
+	queue = []key{start}
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		for _, c := range cols {
+			ck := keyOf(c.Resource, c.Column)
+			if seen[ck] {
+				continue
+			}
+			for _, s := range c.Sources {
+				if keyOf(s.Resource, s.Column) != k {
+					continue
+				}
+				seen[ck] = true
+				queue = append(queue, ck)
+				rows = append(rows, &columnTraceRow{Direction: "downstream", Type: runtime.PrettifyResourceKind(c.Resource.Kind), Name: c.Resource.Name, Column: c.Column})
+				break
+			}
+		}
+	}
+
+	return rows
+}
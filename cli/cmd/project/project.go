@@ -25,11 +25,15 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 	projectCmd.AddCommand(DeleteCmd(ch))
 	projectCmd.AddCommand(StatusCmd(ch))
 	projectCmd.AddCommand(PartitionsCmd(ch))
+	projectCmd.AddCommand(ExplainSecurityCmd(ch))
 	projectCmd.AddCommand(LogsCmd(ch))
 	projectCmd.AddCommand(DescribeCmd(ch))
+	projectCmd.AddCommand(LineageCmd(ch))
+	projectCmd.AddCommand(DiffCmd(ch))
 	projectCmd.AddCommand(RefreshCmd(ch))
 	projectCmd.AddCommand(JwtCmd(ch))
 	projectCmd.AddCommand(GitPushCmd(ch))
+	projectCmd.AddCommand(ConnectGitCmd(ch))
 	projectCmd.AddCommand(DeployCmd(ch))
 
 	return projectCmd
@@ -2,6 +2,7 @@ package project
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -13,7 +14,7 @@ import (
 )
 
 func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
-	var name, path string
+	var name, path, branch string
 
 	statusCmd := &cobra.Command{
 		Use:   "status [<project-name>]",
@@ -39,6 +40,7 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 			proj, err := client.GetProject(cmd.Context(), &adminv1.GetProjectRequest{
 				OrganizationName: ch.Org,
 				Name:             name,
+				Branch:           branch,
 			})
 			if err != nil {
 				return err
@@ -54,13 +56,18 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 			fmt.Printf("  Updated: %s\n", proj.Project.UpdatedOn.AsTime().Local().Format(time.RFC3339))
 
 			depl := proj.ProdDeployment
+			webURL := proj.Project.FrontendUrl
+			if branch != "" {
+				depl = proj.PreviewDeployment
+				webURL = fmt.Sprintf("%s?branch=%s", webURL, url.QueryEscape(branch))
+			}
 			if depl == nil {
 				return nil
 			}
 
 			// 2. Print deployment info
 			ch.PrintfSuccess("\nDeployment info\n\n")
-			fmt.Printf("  Web: %s\n", proj.Project.FrontendUrl)
+			fmt.Printf("  Web: %s\n", webURL)
 			fmt.Printf("  Runtime: %s\n", depl.RuntimeHost)
 			fmt.Printf("  Instance: %s\n", depl.RuntimeInstanceId)
 			fmt.Printf("  Driver: %s\n", proj.Project.ProdOlapDriver)
@@ -141,6 +148,7 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	statusCmd.Flags().StringVar(&name, "project", "", "Project Name")
 	statusCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	statusCmd.Flags().StringVar(&branch, "branch", "", "Show the preview deployment for a branch instead of the production deployment")
 
 	return statusCmd
 }
@@ -0,0 +1,236 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	runtimeclient "github.com/rilldata/rill/runtime/client"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func QueryCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, sql, metricsSQL, resolver, properties, resolverArgs, connector string
+	var email, attributes, format string
+	var groups []string
+	var limit int
+	var admin, local bool
+
+	queryCmd := &cobra.Command{
+		Use:   "query [<project>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query data in a project",
+		Long: `Run an ad-hoc SQL or Metrics SQL query against a project deployed on Rill Cloud or running locally with "rill start".
+Only project admins can run ad-hoc queries. Pass user attributes to run the query with the security policies of another user.
+
+Results are printed as a table by default. Use --format to output JSON, CSV or Parquet.`,
+		Example: `  rill query --sql "SELECT * FROM my_model" --limit 10
+  rill query --metrics-sql "SELECT country, total_revenue FROM my_metrics ORDER BY total_revenue DESC" --email jane@example.com
+  rill query --resolver metrics --properties '{"metrics_view": "my_metrics", "measures": [{"name": "total_revenue"}]}' --local
+  rill query --sql "SELECT * FROM my_model" --limit 10000 --format parquet > my_model.parquet`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			// The command has its own --format flag since Parquet is only supported for query results
+			parquet := format == "parquet"
+			if !parquet {
+				err := ch.Printer.Format.Set(format)
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			resolver, props, err := queryResolver(sql, metricsSQL, connector, resolver, properties)
+			if err != nil {
+				return err
+			}
+
+			var argsPB *structpb.Struct
+			if resolverArgs != "" {
+				argsPB, err = parseJSONStruct(resolverArgs, "args")
+				if err != nil {
+					return err
+				}
+			}
+
+			// Build the attributes of the user to impersonate (if any)
+			var attrsPB *structpb.Struct
+			if email != "" || len(groups) > 0 || attributes != "" || cmd.Flags().Changed("admin") {
+				attrs := map[string]any{}
+				if attributes != "" {
+					err := json.Unmarshal([]byte(attributes), &attrs)
+					if err != nil {
+						return fmt.Errorf("failed to parse attributes as JSON: %w", err)
+					}
+				}
+				if email != "" {
+					attrs["email"] = email
+				}
+				if len(groups) > 0 {
+					gs := make([]any, len(groups))
+					for i, g := range groups {
+						gs[i] = g
+					}
+					attrs["groups"] = gs
+				}
+				if cmd.Flags().Changed("admin") {
+					attrs["admin"] = admin
+				}
+				attrsPB, err = structpb.NewStruct(attrs)
+				if err != nil {
+					return fmt.Errorf("invalid attributes: %w", err)
+				}
+			}
+
+			var rt *runtimeclient.Client
+			var instanceID string
+			if local {
+				rt, instanceID, err = ch.OpenRuntimeClient(ctx, "", "", true)
+			} else {
+				rt, instanceID, err = openCloudRuntimeClient(ctx, ch, cmd, project, path)
+			}
+			if err != nil {
+				return err
+			}
+			defer rt.Close()
+
+			res, err := rt.QueryResolver(ctx, &runtimev1.QueryResolverRequest{
+				InstanceId:         instanceID,
+				Resolver:           resolver,
+				ResolverProperties: props,
+				ResolverArgs:       argsPB,
+				Attributes:         attrsPB,
+				Limit:              int32(limit),
+			})
+			if err != nil {
+				return err
+			}
+
+			if parquet {
+				return ch.PrintQueryResultParquet(res.Schema, res.Data)
+			}
+			return ch.PrintQueryResult(res.Schema, res.Data)
+		},
+	}
+
+	queryCmd.Flags().SortFlags = false
+	queryCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization Name")
+	queryCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	queryCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	queryCmd.Flags().BoolVar(&local, "local", false, "Query the project running locally with \"rill start\"")
+	queryCmd.Flags().StringVar(&sql, "sql", "", "SQL query to run against the OLAP connector")
+	queryCmd.Flags().StringVar(&metricsSQL, "metrics-sql", "", "Metrics SQL query to run against a metrics view")
+	queryCmd.Flags().StringVar(&connector, "connector", "", "OLAP connector to run the SQL query against (defaults to the project's OLAP connector)")
+	queryCmd.Flags().StringVar(&resolver, "resolver", "", "Resolver to use for the query (for example \"sql\", \"metrics_sql\" or \"metrics\")")
+	queryCmd.Flags().StringVar(&properties, "properties", "", "Resolver properties as JSON (used with --resolver)")
+	queryCmd.Flags().StringVar(&resolverArgs, "args", "", "Resolver args as JSON")
+	queryCmd.Flags().IntVar(&limit, "limit", 100, "Maximum number of rows to return (max 10000)")
+	queryCmd.Flags().StringVar(&email, "email", "", "Run the query as a user with this email")
+	queryCmd.Flags().StringSliceVar(&groups, "groups", nil, "Run the query as a user in these groups")
+	queryCmd.Flags().BoolVar(&admin, "admin", false, "Run the query as an admin user")
+	queryCmd.Flags().StringVar(&attributes, "attributes", "", "Run the query as a user with these attributes (JSON)")
+	queryCmd.Flags().StringVar(&format, "format", ch.Printer.Format.String(), `Output format (options: "human", "json", "csv", "parquet")`)
+
+	return queryCmd
+}
+
+// queryResolver returns the resolver and resolver properties for the query flags.
+func queryResolver(sql, metricsSQL, connector, resolver, properties string) (string, *structpb.Struct, error) {
+	n := 0
+	for _, s := range []string{sql, metricsSQL, resolver} {
+		if s != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return "", nil, errors.New("exactly one of --sql, --metrics-sql or --resolver must be provided")
+	}
+	if properties != "" && resolver == "" {
+		return "", nil, errors.New("--properties can only be used with --resolver")
+	}
+	if connector != "" && sql == "" {
+		return "", nil, errors.New("--connector can only be used with --sql")
+	}
+
+	switch {
+	case sql != "":
+		props, err := structpb.NewStruct(map[string]any{"sql": sql, "connector": connector})
+		return "sql", props, err
+	case metricsSQL != "":
+		props, err := structpb.NewStruct(map[string]any{"sql": metricsSQL})
+		return "metrics_sql", props, err
+	}
+
+	if properties == "" {
+		return resolver, nil, nil
+	}
+	props, err := parseJSONStruct(properties, "properties")
+	return resolver, props, err
+}
+
+// openCloudRuntimeClient opens a runtime client for the prod deployment of a project on Rill Cloud.
+// It uses credentials issued on behalf of the current user, so the query runs with their attributes.
+func openCloudRuntimeClient(ctx context.Context, ch *cmdutil.Helper, cmd *cobra.Command, project, path string) (*runtimeclient.Client, string, error) {
+	err := cmdutil.CheckChain(cmdutil.CheckAuth(ch), cmdutil.CheckOrganization(ch))(cmd, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if project == "" {
+		project, err = ch.InferProjectName(ctx, ch.Org, path)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to infer project name (use `--project` to explicitly specify the name): %w", err)
+		}
+	}
+
+	client, err := ch.Client()
+	if err != nil {
+		return nil, "", err
+	}
+
+	user, err := client.GetCurrentUser(ctx, &adminv1.GetCurrentUserRequest{})
+	if err != nil {
+		return nil, "", err
+	}
+	if user.User == nil {
+		return nil, "", errors.New("querying a project on Rill Cloud requires a user token")
+	}
+
+	creds, err := client.GetDeploymentCredentials(ctx, &adminv1.GetDeploymentCredentialsRequest{
+		Organization: ch.Org,
+		Project:      project,
+		For:          &adminv1.GetDeploymentCredentialsRequest_UserId{UserId: user.User.Id},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	rt, err := runtimeclient.New(creds.RuntimeHost, creds.AccessToken)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to connect to runtime: %w", err)
+	}
+
+	return rt, creds.InstanceId, nil
+}
+
+func parseJSONStruct(s, name string) (*structpb.Struct, error) {
+	m := map[string]any{}
+	err := json.Unmarshal([]byte(s), &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s as JSON: %w", name, err)
+	}
+	res, err := structpb.NewStruct(m)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return res, nil
+}
@@ -16,14 +16,17 @@ import (
 	"github.com/rilldata/rill/cli/cmd/org"
 	"github.com/rilldata/rill/cli/cmd/project"
 	"github.com/rilldata/rill/cli/cmd/publicurl"
+	"github.com/rilldata/rill/cli/cmd/query"
 	"github.com/rilldata/rill/cli/cmd/runtime"
 	"github.com/rilldata/rill/cli/cmd/service"
 	"github.com/rilldata/rill/cli/cmd/start"
 	"github.com/rilldata/rill/cli/cmd/sudo"
+	"github.com/rilldata/rill/cli/cmd/test"
 	"github.com/rilldata/rill/cli/cmd/uninstall"
 	"github.com/rilldata/rill/cli/cmd/upgrade"
 	"github.com/rilldata/rill/cli/cmd/user"
 	"github.com/rilldata/rill/cli/cmd/usergroup"
+	"github.com/rilldata/rill/cli/cmd/validate"
 	versioncmd "github.com/rilldata/rill/cli/cmd/version"
 	"github.com/rilldata/rill/cli/cmd/whoami"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -136,6 +139,9 @@ func runCmd(ctx context.Context, ver cmdutil.Version) error {
 		project.ProjectCmd(ch),
 		publicurl.PublicURLCmd(ch),
 		env.EnvCmd(ch),
+		query.QueryCmd(ch),
+		validate.ValidateCmd(ch),
+		test.TestCmd(ch),
 	)
 
 	// Organization commands
@@ -38,6 +38,7 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
 	_ "github.com/rilldata/rill/runtime/drivers/mysql"
+	_ "github.com/rilldata/rill/runtime/drivers/openai"
 	_ "github.com/rilldata/rill/runtime/drivers/pinot"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/redshift"
@@ -62,6 +63,10 @@ type Config struct {
 	LogLevel                zapcore.Level          `default:"info" split_words:"true"`
 	HTTPPort                int                    `default:"8080" split_words:"true"`
 	GRPCPort                int                    `default:"9090" split_words:"true"`
+	FlightSQLPort           int                    `split_words:"true"`
+	PGWirePort              int                    `envconfig:"pgwire_port"`
+	PGWireTLSCertPath       string                 `envconfig:"pgwire_tls_cert_path"`
+	PGWireTLSKeyPath        string                 `envconfig:"pgwire_tls_key_path"`
 	DebugPort               int                    `default:"6060" split_words:"true"`
 	AllowedOrigins          []string               `default:"*" split_words:"true"`
 	SessionKeyPairs         []string               `split_words:"true"`
@@ -75,6 +80,8 @@ type Config struct {
 	EmailSenderEmail        string                 `split_words:"true"`
 	EmailSenderName         string                 `split_words:"true"`
 	EmailBCC                string                 `split_words:"true"`
+	SnapshotsRendererURL    string                 `split_words:"true"`
+	SnapshotsRendererToken  string                 `split_words:"true"`
 	ConnectionCacheSize     int                    `default:"100" split_words:"true"`
 	QueryCacheSizeBytes     int64                  `default:"104857600" split_words:"true"` // 100MB by default
 	SecurityEngineCacheSize int                    `default:"1000" split_words:"true"`
@@ -227,6 +234,8 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				ControllerLogBufferCapacity:  conf.LogBufferCapacity,
 				ControllerLogBufferSizeBytes: conf.LogBufferSizeBytes,
 				AllowHostAccess:              conf.AllowHostAccess,
+				SnapshotsRendererURL:         conf.SnapshotsRendererURL,
+				SnapshotsRendererToken:       conf.SnapshotsRendererToken,
 				SystemConnectors: []*runtimev1.Connector{
 					{
 						Type:   conf.MetastoreDriver,
@@ -254,14 +263,18 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 
 			// Init server
 			srvOpts := &server.Options{
-				HTTPPort:        conf.HTTPPort,
-				GRPCPort:        conf.GRPCPort,
-				AllowedOrigins:  conf.AllowedOrigins,
-				ServePrometheus: conf.MetricsExporter == observability.PrometheusExporter,
-				SessionKeyPairs: keyPairs,
-				AuthEnable:      conf.AuthEnable,
-				AuthIssuerURL:   conf.AuthIssuerURL,
-				AuthAudienceURL: conf.AuthAudienceURL,
+				HTTPPort:          conf.HTTPPort,
+				GRPCPort:          conf.GRPCPort,
+				FlightSQLPort:     conf.FlightSQLPort,
+				PGWirePort:        conf.PGWirePort,
+				PGWireTLSCertPath: conf.PGWireTLSCertPath,
+				PGWireTLSKeyPath:  conf.PGWireTLSKeyPath,
+				AllowedOrigins:    conf.AllowedOrigins,
+				ServePrometheus:   conf.MetricsExporter == observability.PrometheusExporter,
+				SessionKeyPairs:   keyPairs,
+				AuthEnable:        conf.AuthEnable,
+				AuthIssuerURL:     conf.AuthIssuerURL,
+				AuthAudienceURL:   conf.AuthAudienceURL,
 			}
 			s, err := server.NewServer(ctx, srvOpts, rt, logger, limiter, activityClient)
 			if err != nil {
@@ -272,6 +285,12 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 			group, cctx := errgroup.WithContext(ctx)
 			group.Go(func() error { return s.ServeGRPC(cctx) })
 			group.Go(func() error { return s.ServeHTTP(cctx, nil) })
+			if conf.FlightSQLPort != 0 {
+				group.Go(func() error { return s.ServeFlightSQL(cctx) })
+			}
+			if conf.PGWirePort != 0 {
+				group.Go(func() error { return s.ServePGWire(cctx) })
+			}
 			if conf.DebugPort != 0 {
 				group.Go(func() error { return debugserver.ServeHTTP(cctx, conf.DebugPort) })
 			}
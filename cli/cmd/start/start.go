@@ -21,6 +21,8 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 	var olapDSN string
 	var httpPort int
 	var grpcPort int
+	var flightSQLPort int
+	var pgWirePort int
 	var verbose bool
 	var debug bool
 	var readonly bool
@@ -163,7 +165,7 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 
 			userID, _ := ch.CurrentUserID(cmd.Context())
 
-			err = app.Serve(httpPort, grpcPort, !noUI, !noOpen, readonly, userID, tlsCertPath, tlsKeyPath)
+			err = app.Serve(httpPort, grpcPort, flightSQLPort, pgWirePort, !noUI, !noOpen, readonly, userID, tlsCertPath, tlsKeyPath)
 			if err != nil {
 				return fmt.Errorf("serve: %w", err)
 			}
@@ -181,6 +183,8 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 	startCmd.Flags().BoolVar(&readonly, "readonly", false, "Show only dashboards in UI")
 	startCmd.Flags().IntVar(&httpPort, "port", 9009, "Port for HTTP")
 	startCmd.Flags().IntVar(&grpcPort, "port-grpc", 49009, "Port for gRPC (internal)")
+	startCmd.Flags().IntVar(&flightSQLPort, "port-flight-sql", 0, "Port for Arrow Flight SQL (disabled if 0)")
+	startCmd.Flags().IntVar(&pgWirePort, "port-pgwire", 0, "Port for the PostgreSQL wire protocol (disabled if 0)")
 	startCmd.Flags().BoolVar(&noUI, "no-ui", false, "Serve only the backend")
 	startCmd.Flags().BoolVar(&debug, "debug", false, "Collect additional debug info")
 	startCmd.Flags().StringVar(&logFormat, "log-format", "console", "Log format (options: \"console\", \"json\")")
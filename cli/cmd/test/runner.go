@@ -0,0 +1,454 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/local"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// mocksDir is the directory in the temporary copy of the project where mock models are written.
+const mocksDir = "__rill_test_mocks"
+
+// runner runs project test files.
+type runner struct {
+	projectPath string
+	environment string
+	variables   map[string]string
+	filter      *regexp.Regexp
+	update      bool
+	logger      *zap.Logger
+}
+
+// testResult is the outcome of a single test case.
+// If Name is empty, the result represents an error that prevented the whole file from running.
+type testResult struct {
+	File  string `json:"file"`
+	Name  string `json:"name,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (r *testResult) passed() bool {
+	return r.Error == ""
+}
+
+// testFiles returns the paths of the test files in the project, relative to the project root.
+// Test files are YAML files anywhere in the project that are marked with "type: test".
+func (r *runner) testFiles(ctx context.Context) ([]string, error) {
+	repo, _, err := cmdutil.RepoForProjectPath(r.projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := repo.ListRecursive(ctx, "**/*.{yaml,yml}", true)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, e := range entries {
+		data, err := repo.Get(ctx, e.Path)
+		if err != nil {
+			return nil, err
+		}
+		var tmp struct {
+			Type string `yaml:"type"`
+		}
+		if err := yaml.Unmarshal([]byte(data), &tmp); err != nil {
+			// Invalid YAML files are reported by the project parser
+			continue
+		}
+		if rillv1.IsTestFileType(tmp.Type) {
+			res = append(res, strings.TrimPrefix(e.Path, "/"))
+		}
+	}
+	return res, nil
+}
+
+// runFile runs the tests in a test file against a new ephemeral runtime with the file's mocks applied.
+func (r *runner) runFile(ctx context.Context, file string) []*testResult {
+	fileErr := func(err error) []*testResult {
+		return []*testResult{{File: file, Error: err.Error()}}
+	}
+
+	path := filepath.Join(r.projectPath, file)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fileErr(err)
+	}
+	tf := &testFileYAML{}
+	err = yaml.Unmarshal(data, tf)
+	if err != nil {
+		return fileErr(fmt.Errorf("failed to parse test file: %w", err))
+	}
+
+	// Apply the filter
+	var tests []*testYAML
+	for _, tc := range tf.Tests {
+		if tc.Name == "" {
+			return fileErr(errors.New("all tests must have a name"))
+		}
+		if r.filter == nil || r.filter.MatchString(fmt.Sprintf("%s/%s", file, tc.Name)) {
+			tests = append(tests, tc)
+		}
+	}
+	if len(tests) == 0 {
+		return nil
+	}
+
+	mocks := make(map[string]*mockYAML)
+	if !tf.Mocks.IsZero() {
+		err = tf.Mocks.Decode(&mocks)
+		if err != nil {
+			return fileErr(fmt.Errorf("failed to decode mocks: %w", err))
+		}
+	}
+
+	// Create a copy of the project with the mocks applied
+	tmpDir, err := os.MkdirTemp("", "rill_test")
+	if err != nil {
+		return fileErr(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	err = r.prepareProject(ctx, tmpDir, filepath.Dir(path), mocks)
+	if err != nil {
+		return fileErr(err)
+	}
+
+	vars := make(map[string]string)
+	for k, v := range tf.Variables {
+		vars[k] = v
+	}
+	for k, v := range r.variables {
+		vars[k] = v
+	}
+
+	h, err := local.NewHeadless(ctx, &local.HeadlessOptions{
+		ProjectPath: tmpDir,
+		Environment: r.environment,
+		Variables:   vars,
+		Logger:      r.logger,
+	})
+	if err != nil {
+		return fileErr(fmt.Errorf("failed to start runtime: %w", err))
+	}
+	defer h.Close()
+
+	res := make([]*testResult, 0, len(tests))
+	for _, tc := range tests {
+		tr := &testResult{File: file, Name: tc.Name}
+		err := r.runTest(ctx, h, tc)
+		if err != nil {
+			tr.Error = err.Error()
+		}
+		res = append(res, tr)
+	}
+
+	// If --update is set, the test cases have been updated with the actual results.
+	if r.update {
+		buf := &bytes.Buffer{}
+		enc := yaml.NewEncoder(buf)
+		enc.SetIndent(2)
+		err := enc.Encode(tf)
+		if err != nil {
+			return fileErr(err)
+		}
+		err = os.WriteFile(path, buf.Bytes(), 0o644)
+		if err != nil {
+			return fileErr(err)
+		}
+	}
+
+	return res
+}
+
+// prepareProject copies the project to dst, replacing the models and sources that have mocks with models that read the mock data.
+func (r *runner) prepareProject(ctx context.Context, dst, testDir string, mocks map[string]*mockYAML) error {
+	repo, instanceID, err := cmdutil.RepoForProjectPath(r.projectPath)
+	if err != nil {
+		return err
+	}
+
+	// Find the files that define the mocked resources
+	skip := make(map[string]bool)
+	if len(mocks) > 0 {
+		p, err := rillv1.Parse(ctx, repo, instanceID, r.environment, local.DefaultOLAPDriver)
+		if err != nil {
+			return err
+		}
+		for name := range mocks {
+			found := false
+			for _, kind := range []rillv1.ResourceKind{rillv1.ResourceKindModel, rillv1.ResourceKindSource} {
+				res, ok := p.Resources[rillv1.ResourceName{Kind: kind, Name: name}.Normalized()]
+				if !ok {
+					continue
+				}
+				for _, path := range res.Paths {
+					skip[path] = true
+				}
+				found = true
+			}
+			if !found {
+				if len(p.Errors) > 0 {
+					return fmt.Errorf("mock %q does not match a model or source in the project (the project has parse errors, run `rill validate --parse-only` for details)", name)
+				}
+				return fmt.Errorf("mock %q does not match a model or source in the project", name)
+			}
+		}
+	}
+
+	// Copy the project files
+	entries, err := repo.ListRecursive(ctx, "**", true)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if skip[e.Path] {
+			continue
+		}
+		err := copyFile(filepath.Join(r.projectPath, e.Path), filepath.Join(dst, e.Path))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Write the mock models
+	if len(mocks) == 0 {
+		return nil
+	}
+	err = os.MkdirAll(filepath.Join(dst, mocksDir), 0o755)
+	if err != nil {
+		return err
+	}
+	for name, m := range mocks {
+		var csvPath string
+		switch {
+		case m.CSV != "" && len(m.Rows) > 0:
+			return fmt.Errorf("mock %q: only one of rows or csv can be set", name)
+		case m.CSV != "":
+			csvPath = m.CSV
+			if !filepath.IsAbs(csvPath) {
+				csvPath = filepath.Join(testDir, csvPath)
+			}
+			if _, err := os.Stat(csvPath); err != nil {
+				return fmt.Errorf("mock %q: %w", name, err)
+			}
+		default:
+			csvPath = filepath.Join(dst, mocksDir, name+".csv")
+			f, err := os.Create(csvPath)
+			if err != nil {
+				return err
+			}
+			err = writeRowsCSV(m.Rows, f)
+			_ = f.Close()
+			if err != nil {
+				return fmt.Errorf("mock %q: %w", name, err)
+			}
+		}
+
+		model, err := yaml.Marshal(map[string]any{
+			"type":        "model",
+			"name":        name,
+			"materialize": true,
+			"sql":         fmt.Sprintf("SELECT * FROM read_csv('%s', header=true, auto_detect=true)", strings.ReplaceAll(csvPath, "'", "''")),
+		})
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(filepath.Join(dst, mocksDir, name+".yaml"), model, 0o644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runTest runs a single test case and checks the result.
+// If --update is set, it updates the expected result of the test case instead.
+func (r *runner) runTest(ctx context.Context, h *local.Headless, tc *testYAML) error {
+	resolver, props, err := tc.resolver()
+	if err != nil {
+		return err
+	}
+
+	args := make(map[string]any)
+	err = tc.Args.Decode(&args)
+	if err != nil {
+		return fmt.Errorf("failed to decode args: %w", err)
+	}
+
+	// Tests without user attributes run with security checks skipped (like an admin).
+	claims := &runtime.SecurityClaims{SkipChecks: true}
+	if !tc.UserAttributes.IsZero() {
+		attrs := make(map[string]any)
+		err = tc.UserAttributes.Decode(&attrs)
+		if err != nil {
+			return fmt.Errorf("failed to decode user_attributes: %w", err)
+		}
+		claims = &runtime.SecurityClaims{UserAttributes: attrs}
+	}
+
+	res, err := h.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         h.InstanceID,
+		Resolver:           resolver,
+		ResolverProperties: props,
+		Args:               args,
+		Claims:             claims,
+	})
+
+	// If it succeeded, get the result rows.
+	// Does a JSON roundtrip to coerce to simple types (easier to compare).
+	var rows []map[string]any
+	var schema *runtimev1.StructType
+	if err == nil {
+		schema = res.Schema()
+		data, err2 := res.MarshalJSON()
+		_ = res.Close()
+		if err2 != nil {
+			err = err2
+		} else {
+			err = json.Unmarshal(data, &rows)
+		}
+	}
+
+	if r.update {
+		tc.ErrorContains = ""
+		tc.Result = nil
+		if err != nil {
+			tc.ErrorContains = err.Error()
+			tc.ResultCSV = ""
+			return nil
+		}
+		if tc.ResultCSV != "" {
+			tc.ResultCSV, err = resultToCSV(rows, schema)
+			return err
+		}
+		tc.Result = rows
+		return nil
+	}
+
+	if tc.ErrorContains != "" {
+		if err == nil {
+			return fmt.Errorf("expected an error containing %q, but the query succeeded", tc.ErrorContains)
+		}
+		if !strings.Contains(err.Error(), tc.ErrorContains) {
+			return fmt.Errorf("expected an error containing %q, got: %w", tc.ErrorContains, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if tc.ResultCSV != "" {
+		actual, err := resultToCSV(rows, schema)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(tc.ResultCSV) != strings.TrimSpace(actual) {
+			return fmt.Errorf("unexpected result:\nexpected:\n%s\nactual:\n%s", strings.TrimSpace(tc.ResultCSV), strings.TrimSpace(actual))
+		}
+		return nil
+	}
+
+	// Like for rows, we do a JSON roundtrip on the expected result (parsed from YAML) to coerce to simple types.
+	var expected []map[string]any
+	data, err := json.Marshal(tc.Result)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(data, &expected)
+	if err != nil {
+		return err
+	}
+	if len(expected) == 0 && len(rows) == 0 {
+		return nil
+	}
+	if !reflect.DeepEqual(expected, rows) {
+		actual, err := json.Marshal(rows)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("unexpected result:\nexpected: %s\nactual:   %s", data, actual)
+	}
+	return nil
+}
+
+// resultToCSV serializes the rows to a CSV formatted string.
+// It matches the format used for result_csv in runtime/resolvers/testdata.
+func resultToCSV(rows []map[string]any, schema *runtimev1.StructType) (string, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	strs := make([]string, len(schema.Fields))
+	for i, f := range schema.Fields {
+		strs[i] = f.Name
+	}
+	err := w.Write(strs)
+	if err != nil {
+		return "", err
+	}
+
+	for _, row := range rows {
+		for i, f := range schema.Fields {
+			var s string
+			if v := row[f.Name]; v != nil {
+				if v2, ok := v.(string); ok {
+					s = v2
+				} else {
+					tmp, err := json.Marshal(v)
+					if err != nil {
+						return "", err
+					}
+					s = string(tmp)
+				}
+			}
+			strs[i] = s
+		}
+		err = w.Write(strs)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+func copyFile(src, dst string) error {
+	err := os.MkdirAll(filepath.Dir(dst), 0o755)
+	if err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if err2 := out.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
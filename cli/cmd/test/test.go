@@ -0,0 +1,170 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/printer"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func TestCmd(ch *cmdutil.Helper) *cobra.Command {
+	var environment, run string
+	var envVars []string
+	var update, verbose bool
+	var timeout time.Duration
+
+	testCmd := &cobra.Command{
+		Use:   "test [<path>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Run the tests in a project",
+		Long: `Run the test files in a project. Test files are YAML files marked with "type: test", usually placed in a "tests" directory.
+
+Each test file declares mock data for models or sources, and a list of metrics view queries, Metrics SQL queries or API calls along with their expected results.
+The tests in a file run against an ephemeral copy of the project in an isolated DuckDB instance, where the mocked models and sources are replaced with the mock data.
+See https://docs.rilldata.com/build/metrics-view/tests for the test file format.
+
+Use --update to write the actual results to the test files (check the changes carefully before committing them).`,
+		Example: `  rill test
+  rill test path/to/project --run "orders.yaml/revenue"
+  rill test --update`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			projectPath := "."
+			if len(args) > 0 {
+				projectPath = args[0]
+			}
+			projectPath, err := filepath.Abs(projectPath)
+			if err != nil {
+				return err
+			}
+			if !cmdutil.HasRillProject(projectPath) {
+				return fmt.Errorf("not a valid Rill project (missing a rill.yaml file)")
+			}
+
+			vars, err := parseVariables(envVars)
+			if err != nil {
+				return err
+			}
+
+			r := &runner{
+				projectPath: projectPath,
+				environment: environment,
+				variables:   vars,
+				update:      update,
+				logger:      zap.NewNop(),
+			}
+			if run != "" {
+				r.filter, err = regexp.Compile(run)
+				if err != nil {
+					return fmt.Errorf("invalid --run pattern: %w", err)
+				}
+			}
+			if verbose {
+				r.logger, err = zap.NewDevelopment()
+				if err != nil {
+					return err
+				}
+			}
+
+			files, err := r.testFiles(ctx)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				ch.PrintfWarn("No test files found (test files are YAML files with \"type: test\")\n")
+				return nil
+			}
+
+			var results []*testResult
+			for _, f := range files {
+				res := r.runFile(ctx, f)
+				if ch.Printer.Format != printer.FormatJSON {
+					printResults(ch, res)
+				}
+				results = append(results, res...)
+			}
+
+			if len(results) == 0 {
+				ch.PrintfWarn("No tests matched %q\n", run)
+				return nil
+			}
+
+			failed := 0
+			for _, res := range results {
+				if !res.passed() {
+					failed++
+				}
+			}
+
+			if ch.Printer.Format == printer.FormatJSON {
+				data, err := json.MarshalIndent(results, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal JSON: %w", err)
+				}
+				fmt.Println(string(data))
+			} else {
+				ch.Printf("\n%d passed, %d failed\n", len(results)-failed, failed)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d test(s) failed", failed)
+			}
+			if update {
+				ch.PrintfSuccess("Updated the expected results in %d test file(s)\n", len(files))
+			}
+			return nil
+		},
+	}
+
+	testCmd.Flags().SortFlags = false
+	testCmd.Flags().StringVar(&environment, "environment", "dev", "Environment name")
+	testCmd.Flags().StringSliceVarP(&envVars, "env", "e", []string{}, "Set environment variables")
+	testCmd.Flags().StringVar(&run, "run", "", "Only run tests matching this regular expression (matched against \"<file>/<test name>\")")
+	testCmd.Flags().BoolVar(&update, "update", false, "Update the expected results in the test files instead of checking them")
+	testCmd.Flags().DurationVar(&timeout, "timeout", 0, "Maximum time to run the tests (e.g. 10m)")
+	testCmd.Flags().BoolVar(&verbose, "verbose", false, "Print runtime logs")
+
+	return testCmd
+}
+
+func printResults(ch *cmdutil.Helper, results []*testResult) {
+	for _, res := range results {
+		name := res.File
+		if res.Name != "" {
+			name = fmt.Sprintf("%s/%s", res.File, res.Name)
+		}
+		if res.passed() {
+			ch.PrintfSuccess("PASS %s\n", name)
+		} else {
+			ch.PrintfError("FAIL %s: %s\n", name, res.Error)
+		}
+	}
+}
+
+func parseVariables(vals []string) (map[string]string, error) {
+	res := make(map[string]string)
+	for _, v := range vals {
+		v, err := godotenv.Unmarshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse variable %q: %w", v, err)
+		}
+		for k, v := range v {
+			res[k] = v
+		}
+	}
+	return res, nil
+}
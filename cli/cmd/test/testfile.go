@@ -0,0 +1,158 @@
+package test
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// testFileYAML is the structure of a project test file.
+// Test files can be placed anywhere in a project and are identified by "type: test".
+// Each test file is run against a separate ephemeral runtime instance with the mocks applied.
+//
+// Example:
+//
+//	type: test
+//	mocks:
+//	  orders:
+//	    rows:
+//	      - { id: 1, country: US, amount: 10, created_at: 2024-01-01T00:00:00Z }
+//	      - { id: 2, country: DK, amount: 20, created_at: 2024-01-02T00:00:00Z }
+//	  customers:
+//	    csv: fixtures/customers.csv
+//	tests:
+//	  - name: revenue_by_country
+//	    metrics_view: orders_metrics
+//	    query:
+//	      dimensions: [{ name: country }]
+//	      measures: [{ name: total_amount }]
+//	      sort: [{ name: country }]
+//	    result:
+//	      - { country: DK, total_amount: 20 }
+//	      - { country: US, total_amount: 10 }
+type testFileYAML struct {
+	Type      string            `yaml:"type"` // Must be "test"
+	Variables map[string]string `yaml:"variables,omitempty"`
+	Mocks     yaml.Node         `yaml:"mocks,omitempty"` // Expects map[string]*mockYAML, but using yaml.Node to preserve order for --update.
+	Tests     []*testYAML       `yaml:"tests"`
+}
+
+// mockYAML replaces the data of a model or source in the project.
+// Only one of Rows or CSV should be set.
+type mockYAML struct {
+	// Rows are inline rows of data. Column types are inferred from the values.
+	Rows []yaml.Node `yaml:"rows"` // Using yaml.Node to preserve the column order.
+	// CSV is the path of a CSV file with a header row, relative to the test file.
+	CSV string `yaml:"csv"`
+}
+
+// testYAML is a single test case in a test file.
+// Exactly one of Query, MetricsSQL or API should be set.
+type testYAML struct {
+	Name           string           `yaml:"name"`
+	MetricsView    string           `yaml:"metrics_view,omitempty"`
+	Query          yaml.Node        `yaml:"query,omitempty"` // Expects a metricsview.Query, but using yaml.Node to preserve order for --update.
+	MetricsSQL     string           `yaml:"metrics_sql,omitempty"`
+	API            string           `yaml:"api,omitempty"`
+	Args           yaml.Node        `yaml:"args,omitempty"`            // Expects map[string]any, but using yaml.Node to preserve order for --update.
+	UserAttributes yaml.Node        `yaml:"user_attributes,omitempty"` // Expects map[string]any, but using yaml.Node to preserve order for --update.
+	Result         []map[string]any `yaml:"result,omitempty"`
+	ResultCSV      string           `yaml:"result_csv,omitempty"`
+	ErrorContains  string           `yaml:"error_contains,omitempty"`
+}
+
+// resolver returns the resolver and resolver properties for the test case.
+func (t *testYAML) resolver() (string, map[string]any, error) {
+	n := 0
+	if !t.Query.IsZero() || t.MetricsView != "" {
+		n++
+	}
+	if t.MetricsSQL != "" {
+		n++
+	}
+	if t.API != "" {
+		n++
+	}
+	if n != 1 {
+		return "", nil, errors.New("exactly one of query, metrics_sql or api must be set")
+	}
+
+	switch {
+	case t.MetricsSQL != "":
+		return "metrics_sql", map[string]any{"sql": t.MetricsSQL}, nil
+	case t.API != "":
+		return "api", map[string]any{"api": t.API}, nil
+	}
+
+	props := make(map[string]any)
+	if err := t.Query.Decode(&props); err != nil {
+		return "", nil, fmt.Errorf("failed to decode query: %w", err)
+	}
+	if t.MetricsView != "" {
+		props["metrics_view"] = t.MetricsView
+	}
+	if props["metrics_view"] == nil {
+		return "", nil, errors.New("metrics_view must be set for a query")
+	}
+	return "metrics", props, nil
+}
+
+// writeRowsCSV writes inline mock rows as CSV.
+// The columns are ordered by first appearance in the rows.
+func writeRowsCSV(rows []yaml.Node, w io.Writer) error {
+	var cols []string
+	idx := make(map[string]int)
+	vals := make([]map[string]any, len(rows))
+	for i, row := range rows {
+		if row.Kind != yaml.MappingNode {
+			return fmt.Errorf("row %d is not a mapping", i+1)
+		}
+		vals[i] = make(map[string]any)
+		for j := 0; j+1 < len(row.Content); j += 2 {
+			k := row.Content[j].Value
+			var v any
+			if err := row.Content[j+1].Decode(&v); err != nil {
+				return fmt.Errorf("row %d: failed to decode %q: %w", i+1, k, err)
+			}
+			if _, ok := idx[k]; !ok {
+				idx[k] = len(cols)
+				cols = append(cols, k)
+			}
+			vals[i][k] = v
+		}
+	}
+	if len(cols) == 0 {
+		return errors.New("rows must have at least one column")
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(cols); err != nil {
+		return err
+	}
+	strs := make([]string, len(cols))
+	for _, row := range vals {
+		for i, c := range cols {
+			switch v := row[c].(type) {
+			case nil:
+				strs[i] = ""
+			case time.Time:
+				strs[i] = v.Format(time.RFC3339Nano)
+			case string:
+				strs[i] = v
+			case map[string]any, []any:
+				return fmt.Errorf("column %q: nested values are not supported in mock rows", c)
+			default:
+				strs[i] = fmt.Sprint(v)
+			}
+		}
+		if err := cw.Write(strs); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestWriteRowsCSV(t *testing.T) {
+	var m mockYAML
+	err := yaml.Unmarshal([]byte(`
+rows:
+  - { id: 1, country: US, created_at: 2024-01-01T00:00:00Z }
+  - { id: 2, amount: 2.5, country: null }
+`), &m)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	err = writeRowsCSV(m.Rows, buf)
+	require.NoError(t, err)
+	require.Equal(t, "id,country,created_at,amount\n1,US,2024-01-01T00:00:00Z,\n2,,,2.5\n", buf.String())
+
+	err = yaml.Unmarshal([]byte(`rows: [{ id: 1, nested: { a: 1 } }]`), &m)
+	require.NoError(t, err)
+	err = writeRowsCSV(m.Rows, &bytes.Buffer{})
+	require.ErrorContains(t, err, "nested values are not supported")
+}
+
+func TestTestResolver(t *testing.T) {
+	var tf testFileYAML
+	err := yaml.Unmarshal([]byte(`
+tests:
+  - name: query
+    metrics_view: mv
+    query:
+      measures: [{ name: total }]
+  - name: metrics_sql
+    metrics_sql: SELECT total FROM mv
+  - name: api
+    api: my_api
+  - name: none
+  - name: multiple
+    api: my_api
+    metrics_sql: SELECT total FROM mv
+  - name: missing_metrics_view
+    query:
+      measures: [{ name: total }]
+`), &tf)
+	require.NoError(t, err)
+	require.Len(t, tf.Tests, 6)
+
+	resolver, props, err := tf.Tests[0].resolver()
+	require.NoError(t, err)
+	require.Equal(t, "metrics", resolver)
+	require.Equal(t, map[string]any{"metrics_view": "mv", "measures": []any{map[string]any{"name": "total"}}}, props)
+
+	resolver, props, err = tf.Tests[1].resolver()
+	require.NoError(t, err)
+	require.Equal(t, "metrics_sql", resolver)
+	require.Equal(t, map[string]any{"sql": "SELECT total FROM mv"}, props)
+
+	resolver, props, err = tf.Tests[2].resolver()
+	require.NoError(t, err)
+	require.Equal(t, "api", resolver)
+	require.Equal(t, map[string]any{"api": "my_api"}, props)
+
+	_, _, err = tf.Tests[3].resolver()
+	require.ErrorContains(t, err, "exactly one of")
+
+	_, _, err = tf.Tests[4].resolver()
+	require.ErrorContains(t, err, "exactly one of")
+
+	_, _, err = tf.Tests[5].resolver()
+	require.ErrorContains(t, err, "metrics_view must be set")
+}
@@ -0,0 +1,127 @@
+package validate
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// report is the result of validating a project.
+type report struct {
+	ProjectPath string            `json:"project_path"`
+	Environment string            `json:"environment"`
+	ParseErrors []*parseError     `json:"parse_errors"`
+	Resources   []*resourceReport `json:"resources"`
+}
+
+type parseError struct {
+	Path    string `json:"path"`
+	Line    uint32 `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+type resourceReport struct {
+	Kind  string   `json:"kind"`
+	Name  string   `json:"name"`
+	Paths []string `json:"paths"`
+	Error string   `json:"error,omitempty"`
+}
+
+// failures returns the number of parse and reconcile errors.
+func (r *report) failures() int {
+	n := len(r.ParseErrors)
+	for _, res := range r.Resources {
+		if res.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// JUnit XML types. See https://github.com/testmoapp/junitxml for the format.
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// writeJUnit writes the report as JUnit XML.
+// It contains a "parse" suite with a test case per parse error (or a single passing test case),
+// and a "reconcile" suite with a test case per resource.
+func (r *report) writeJUnit(w io.Writer) error {
+	parse := &junitTestSuite{Name: "parse"}
+	if len(r.ParseErrors) == 0 {
+		parse.TestCases = append(parse.TestCases, &junitTestCase{Name: "project", ClassName: "parse"})
+	}
+	for _, pe := range r.ParseErrors {
+		name := pe.Path
+		if pe.Line > 0 {
+			name = fmt.Sprintf("%s:%d", pe.Path, pe.Line)
+		}
+		parse.TestCases = append(parse.TestCases, &junitTestCase{
+			Name:      name,
+			ClassName: "parse",
+			File:      pe.Path,
+			Failure:   &junitFailure{Message: pe.Message, Body: pe.Message},
+		})
+		parse.Failures++
+	}
+	parse.Tests = len(parse.TestCases)
+
+	reconcile := &junitTestSuite{Name: "reconcile"}
+	for _, res := range r.Resources {
+		tc := &junitTestCase{
+			Name:      res.Name,
+			ClassName: res.Kind,
+			File:      strings.Join(res.Paths, ","),
+		}
+		if res.Error != "" {
+			tc.Failure = &junitFailure{Message: res.Error, Body: res.Error}
+			reconcile.Failures++
+		}
+		reconcile.TestCases = append(reconcile.TestCases, tc)
+	}
+	reconcile.Tests = len(reconcile.TestCases)
+
+	suites := &junitTestSuites{
+		Name:     r.ProjectPath,
+		Tests:    parse.Tests + reconcile.Tests,
+		Failures: parse.Failures + reconcile.Failures,
+		Suites:   []*junitTestSuite{parse, reconcile},
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(suites)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,244 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/local"
+	"github.com/rilldata/rill/cli/pkg/printer"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func ValidateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var environment, junitPath string
+	var envVars []string
+	var parseOnly, verbose bool
+	var timeout time.Duration
+
+	validateCmd := &cobra.Command{
+		Use:     "validate [<path>]",
+		Aliases: []string{"build"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Validate a project without starting the UI",
+		Long: `Parse a project and reconcile all its resources in an ephemeral runtime with DuckDB, then report parse and reconcile errors.
+The command exits with a non-zero status if any errors are found, which makes it suitable for CI pipelines.
+
+By default, the project is built in the "dev" environment, so "dev:" overrides in your project files can be used to limit the amount of source data ingested.
+Use --parse-only to skip ingesting data and only check that the project files are valid.`,
+		Example: `  rill validate
+  rill validate path/to/project --parse-only
+  rill validate --format json
+  rill validate --junit validate.xml --env token=$API_TOKEN`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			projectPath := "."
+			if len(args) > 0 {
+				projectPath = args[0]
+			}
+			projectPath, err := filepath.Abs(projectPath)
+			if err != nil {
+				return err
+			}
+
+			vars, err := parseVariables(envVars)
+			if err != nil {
+				return err
+			}
+
+			rep, err := validateProject(ctx, projectPath, environment, vars, parseOnly, verbose)
+			if err != nil {
+				return err
+			}
+
+			if junitPath != "" {
+				f, err := os.Create(junitPath)
+				if err != nil {
+					return fmt.Errorf("failed to create JUnit report: %w", err)
+				}
+				err = rep.writeJUnit(f)
+				_ = f.Close()
+				if err != nil {
+					return fmt.Errorf("failed to write JUnit report: %w", err)
+				}
+			}
+
+			printReport(ch, rep)
+
+			if n := rep.failures(); n > 0 {
+				return fmt.Errorf("validation failed with %d error(s)", n)
+			}
+			return nil
+		},
+	}
+
+	validateCmd.Flags().SortFlags = false
+	validateCmd.Flags().StringVar(&environment, "environment", "dev", "Environment name")
+	validateCmd.Flags().StringSliceVarP(&envVars, "env", "e", []string{}, "Set environment variables")
+	validateCmd.Flags().BoolVar(&parseOnly, "parse-only", false, "Only parse the project files without reconciling resources")
+	validateCmd.Flags().StringVar(&junitPath, "junit", "", "Write a JUnit XML report to this file")
+	validateCmd.Flags().DurationVar(&timeout, "timeout", 0, "Maximum time to wait for the project to reconcile (e.g. 10m)")
+	validateCmd.Flags().BoolVar(&verbose, "verbose", false, "Print runtime logs")
+
+	return validateCmd
+}
+
+// validateProject parses the project and, unless parseOnly is set, reconciles it in a headless runtime.
+func validateProject(ctx context.Context, projectPath, environment string, vars map[string]string, parseOnly, verbose bool) (*report, error) {
+	repo, instanceID, err := cmdutil.RepoForProjectPath(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := rillv1.Parse(ctx, repo, instanceID, environment, local.DefaultOLAPDriver)
+	if err != nil {
+		return nil, err
+	}
+	if p.RillYAML == nil && len(p.Errors) == 0 {
+		return nil, fmt.Errorf("not a valid Rill project (missing a rill.yaml file)")
+	}
+
+	rep := &report{
+		ProjectPath: projectPath,
+		Environment: environment,
+		ParseErrors: []*parseError{},
+		Resources:   []*resourceReport{},
+	}
+	for _, pe := range p.Errors {
+		rep.ParseErrors = append(rep.ParseErrors, parseErrorFromPB(pe))
+	}
+
+	if parseOnly {
+		for _, r := range p.Resources {
+			rep.Resources = append(rep.Resources, &resourceReport{
+				Kind:  r.Name.Kind.String(),
+				Name:  r.Name.Name,
+				Paths: r.Paths,
+			})
+		}
+		slices.SortFunc(rep.Resources, func(a, b *resourceReport) int {
+			return strings.Compare(a.Kind+"/"+a.Name, b.Kind+"/"+b.Name)
+		})
+		return rep, nil
+	}
+
+	logger := zap.NewNop()
+	if verbose {
+		logger, err = zap.NewDevelopment()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	h, err := local.NewHeadless(ctx, &local.HeadlessOptions{
+		ProjectPath: projectPath,
+		Environment: environment,
+		Variables:   vars,
+		Logger:      logger,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("timed out waiting for the project to reconcile")
+		}
+		return nil, err
+	}
+	defer h.Close()
+
+	ctrl, err := h.Runtime.Controller(ctx, h.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := ctrl.List(ctx, "", "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rs {
+		// Parse errors are reported separately
+		if r.Meta.Name.Kind == runtime.ResourceKindProjectParser {
+			continue
+		}
+		rep.Resources = append(rep.Resources, &resourceReport{
+			Kind:  runtime.PrettifyResourceKind(r.Meta.Name.Kind),
+			Name:  r.Meta.Name.Name,
+			Paths: r.Meta.FilePaths,
+			Error: r.Meta.ReconcileError,
+		})
+	}
+
+	return rep, nil
+}
+
+// printReport prints the validation report in the format requested by the user.
+func printReport(ch *cmdutil.Helper, rep *report) {
+	if ch.Printer.Format == printer.FormatJSON {
+		ch.PrintData(rep)
+		return
+	}
+
+	for _, pe := range rep.ParseErrors {
+		if pe.Line > 0 {
+			ch.PrintfError("Parse error in %s:%d: %s\n", pe.Path, pe.Line, pe.Message)
+		} else {
+			ch.PrintfError("Parse error in %s: %s\n", pe.Path, pe.Message)
+		}
+	}
+
+	var errs int
+	for _, r := range rep.Resources {
+		if r.Error == "" {
+			continue
+		}
+		errs++
+		ch.PrintfError("Reconcile error in %s %q: %s\n", r.Kind, r.Name, r.Error)
+	}
+
+	if rep.failures() == 0 {
+		ch.PrintfSuccess("Validated %d resource(s) with no errors\n", len(rep.Resources))
+		return
+	}
+	ch.Printf("\nFound %d parse error(s) and %d reconcile error(s) in %d resource(s)\n", len(rep.ParseErrors), errs, len(rep.Resources))
+}
+
+func parseErrorFromPB(pe *runtimev1.ParseError) *parseError {
+	res := &parseError{
+		Path:    pe.FilePath,
+		Message: pe.Message,
+	}
+	if pe.StartLocation != nil {
+		res.Line = pe.StartLocation.Line
+	}
+	return res
+}
+
+func parseVariables(vals []string) (map[string]string, error) {
+	res := make(map[string]string)
+	for _, v := range vals {
+		v, err := godotenv.Unmarshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse variable %q: %w", v, err)
+		}
+		for k, v := range v {
+			res[k] = v
+		}
+	}
+	return res, nil
+}
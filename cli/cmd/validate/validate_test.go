@@ -0,0 +1,102 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/printer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParseOnly(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"rill.yaml": "",
+		"models/orders.sql": `
+SELECT 1 AS id, 'US' AS country
+`,
+		"metrics/orders.yaml": `
+type: metrics_view
+version: 1
+model: orders
+dimensions:
+- column: country
+measures:
+- name: records
+  expression: COUNT(*)
+`,
+	})
+
+	// A valid project passes and lists its resources
+	out, err := runValidate(t, dir, "--parse-only")
+	require.NoError(t, err)
+
+	var rep report
+	require.NoError(t, json.Unmarshal(out, &rep))
+	require.Equal(t, dir, rep.ProjectPath)
+	require.Equal(t, "dev", rep.Environment)
+	require.Empty(t, rep.ParseErrors)
+	require.Len(t, rep.Resources, 2)
+	require.Equal(t, "orders", rep.Resources[0].Name)
+	require.Equal(t, []string{"/metrics/orders.yaml"}, rep.Resources[0].Paths)
+
+	// A parse error fails the command and is included in the reports
+	writeFiles(t, dir, map[string]string{
+		"metrics/broken.yaml": `
+type: metrics_view
+version: 1
+model: orders
+measures: [
+`,
+	})
+	junitPath := filepath.Join(t.TempDir(), "validate.xml")
+	out, err = runValidate(t, dir, "--parse-only", "--junit", junitPath)
+	require.ErrorContains(t, err, "validation failed with 1 error(s)")
+
+	rep = report{}
+	require.NoError(t, json.Unmarshal(out, &rep))
+	require.Len(t, rep.ParseErrors, 1)
+	require.Equal(t, "/metrics/broken.yaml", rep.ParseErrors[0].Path)
+
+	junit, err := os.ReadFile(junitPath)
+	require.NoError(t, err)
+	require.Contains(t, string(junit), `<testsuite name="parse" tests="1" failures="1">`)
+	require.Contains(t, string(junit), `file="/metrics/broken.yaml"`)
+}
+
+func TestValidateMissingRillYAML(t *testing.T) {
+	out, err := runValidate(t, t.TempDir(), "--parse-only")
+	require.Error(t, err)
+
+	var rep report
+	require.NoError(t, json.Unmarshal(out, &rep))
+	require.Len(t, rep.ParseErrors, 1)
+	require.Equal(t, "/rill.yaml", rep.ParseErrors[0].Path)
+}
+
+// runValidate runs the validate command with JSON output and returns the data written to the printer.
+func runValidate(t *testing.T, dir string, args ...string) ([]byte, error) {
+	var buf bytes.Buffer
+	p := printer.NewPrinter(printer.FormatJSON)
+	p.OverrideDataOutput(&buf)
+	p.OverrideHumanOutput(&bytes.Buffer{})
+
+	cmd := ValidateCmd(&cmdutil.Helper{Printer: p})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(append([]string{dir}, args...))
+	err := cmd.Execute()
+	return buf.Bytes(), err
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
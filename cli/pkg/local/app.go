@@ -302,7 +302,7 @@ func (a *App) Close() error {
 	return nil
 }
 
-func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser, readonly bool, userID, tlsCertPath, tlsKeyPath string) error {
+func (a *App) Serve(httpPort, grpcPort, flightSQLPort, pgWirePort int, enableUI, openBrowser, readonly bool, userID, tlsCertPath, tlsKeyPath string) error {
 	// Get analytics info
 	installID, enabled, err := dotrill.AnalyticsInfo()
 	if err != nil {
@@ -346,6 +346,8 @@ func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser, readonly bool
 	opts := &runtimeserver.Options{
 		HTTPPort:        httpPort,
 		GRPCPort:        grpcPort,
+		FlightSQLPort:   flightSQLPort,
+		PGWirePort:      pgWirePort,
 		TLSCertPath:     tlsCertPath,
 		TLSKeyPath:      tlsKeyPath,
 		AllowedOrigins:  a.allowedOrigins,
@@ -361,6 +363,20 @@ func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser, readonly bool
 		return runtimeServer.ServeGRPC(ctx)
 	})
 
+	// Start the Flight SQL server if a port was provided
+	if flightSQLPort != 0 {
+		group.Go(func() error {
+			return runtimeServer.ServeFlightSQL(ctx)
+		})
+	}
+
+	// Start the PostgreSQL wire protocol server if a port was provided
+	if pgWirePort != 0 {
+		group.Go(func() error {
+			return runtimeServer.ServePGWire(ctx)
+		})
+	}
+
 	// if keypath and certpath are provided
 	secure := tlsCertPath != "" && tlsKeyPath != ""
 
@@ -0,0 +1,151 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/c2h5oh/datasize"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/storage"
+	"go.uber.org/zap"
+)
+
+// HeadlessOptions configures NewHeadless.
+type HeadlessOptions struct {
+	ProjectPath string
+	Environment string
+	Variables   map[string]string
+	Logger      *zap.Logger
+}
+
+// Headless is an ephemeral runtime with a single instance for a project.
+// Unlike App, it does not serve the UI or APIs, does not watch the project for changes, and stores all data in a temporary directory.
+// It is used to build and check projects non-interactively, for example in CI pipelines.
+type Headless struct {
+	Runtime    *runtime.Runtime
+	InstanceID string
+	tmpDir     string
+}
+
+// NewHeadless creates an ephemeral runtime and instance for the project and waits for the project to finish reconciling.
+func NewHeadless(ctx context.Context, opts *HeadlessOptions) (*Headless, error) {
+	projectPath, err := filepath.Abs(opts.ProjectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	tmpDir, err := os.MkdirTemp("", "rill_headless")
+	if err != nil {
+		return nil, err
+	}
+
+	// The in-memory SQLite databases are named after the temp dir to keep concurrent runs isolated.
+	name := filepath.Base(tmpDir)
+	rt, err := runtime.New(ctx, &runtime.Options{
+		ConnectionCacheSize: 100,
+		MetastoreConnector:  "metastore",
+		QueryCacheSizeBytes: int64(datasize.MB * 100),
+		AllowHostAccess:     true,
+		SystemConnectors: []*runtimev1.Connector{
+			{
+				Type:   "sqlite",
+				Name:   "metastore",
+				Config: map[string]string{"dsn": fmt.Sprintf("file:%s_metastore?mode=memory&cache=shared", name)},
+			},
+		},
+		SecurityEngineCacheSize:      1000,
+		ControllerLogBufferCapacity:  10000,
+		ControllerLogBufferSizeBytes: int64(datasize.MB * 16),
+	}, logger, storage.MustNew(tmpDir, nil), activity.NewNoopClient(), email.New(email.NewNoopSender()))
+	if err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return nil, err
+	}
+
+	h := &Headless{
+		Runtime: rt,
+		tmpDir:  tmpDir,
+	}
+
+	// Merge opts.Variables with the same local overrides as App
+	vars := map[string]string{
+		"rill.download_limit_bytes": "0", // 0 means unlimited
+		"rill.stage_changes":        "false",
+	}
+	for k, v := range opts.Variables {
+		vars[k] = v
+	}
+
+	inst := &drivers.Instance{
+		ID:               DefaultInstanceID,
+		Environment:      opts.Environment,
+		OLAPConnector:    DefaultOLAPDriver,
+		RepoConnector:    "repo",
+		CatalogConnector: "catalog",
+		Connectors: []*runtimev1.Connector{
+			{
+				Type:   DefaultOLAPDriver,
+				Name:   DefaultOLAPDriver,
+				Config: map[string]string{"pool_size": "4"},
+			},
+			{
+				Type:   "file",
+				Name:   "repo",
+				Config: map[string]string{"dsn": projectPath},
+			},
+			{
+				Type:   "sqlite",
+				Name:   "catalog",
+				Config: map[string]string{"dsn": fmt.Sprintf("file:%s_catalog?mode=memory&cache=shared", name)},
+			},
+		},
+		Variables:   vars,
+		Annotations: map[string]string{},
+		WatchRepo:   false,
+	}
+	err = rt.CreateInstance(ctx, inst)
+	if err != nil {
+		_ = h.Close()
+		return nil, err
+	}
+	h.InstanceID = inst.ID
+
+	ctrl, err := rt.Controller(ctx, inst.ID)
+	if err != nil {
+		_ = h.Close()
+		return nil, err
+	}
+
+	// Ensure the project parser has been created before waiting, so we don't return before the initial reconcile has started
+	_, err = ctrl.Get(ctx, runtime.GlobalProjectParserName, false)
+	if err != nil {
+		_ = h.Close()
+		return nil, err
+	}
+
+	err = ctrl.WaitUntilIdle(ctx, false)
+	if err != nil {
+		_ = h.Close()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Close closes the runtime and removes its temporary data.
+func (h *Headless) Close() error {
+	err := h.Runtime.Close()
+	_ = os.RemoveAll(h.tmpDir)
+	return err
+}
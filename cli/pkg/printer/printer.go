@@ -10,6 +10,8 @@ import (
 	"github.com/fatih/color"
 	"github.com/gocarina/gocsv"
 	"github.com/lensesio/tableprinter"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type Format int
@@ -101,6 +103,17 @@ func (p *Printer) PrintData(v interface{}) {
 	}
 }
 
+// PrintProto prints a protobuf message as JSON to the data output.
+func (p *Printer) PrintProto(msg proto.Message) error {
+	enc := protojson.MarshalOptions{Multiline: true}
+	data, err := enc.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Fprintln(p.dataOut(), string(data))
+	return nil
+}
+
 func (p *Printer) PrintDataWithTitle(v interface{}, title string) {
 	if p.Format == FormatHuman {
 		p.Printf("  %s\n", strings.ToUpper(title))
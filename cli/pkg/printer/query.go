@@ -0,0 +1,116 @@
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lensesio/tableprinter"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/queries"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PrintQueryResult prints the rows returned by a query.
+func (p *Printer) PrintQueryResult(schema *runtimev1.StructType, data []*structpb.Struct) error {
+	out := p.dataOut()
+	switch p.Format {
+	case FormatHuman:
+		if len(data) == 0 {
+			p.PrintfWarn("No rows returned\n")
+			return nil
+		}
+		headers, rows, nums, err := toQueryResultTable(schema, data)
+		if err != nil {
+			return err
+		}
+		t := tableprinter.New(out)
+		t.RowCharLimit = 120
+		t.Render(headers, rows, nums, false)
+		p.Printf("\n%d row(s)\n", len(data))
+		return nil
+	case FormatJSON:
+		rows := make([]map[string]any, len(data))
+		for i, row := range data {
+			rows[i] = row.AsMap()
+		}
+		buf, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Fprintln(out, string(buf))
+		return nil
+	case FormatCSV:
+		return queries.WriteCSV(toQueryResultColumns(schema), data, out)
+	default:
+		return fmt.Errorf("unexpected print format <%v>", p.Format)
+	}
+}
+
+// PrintQueryResultParquet writes the rows returned by a query as a Parquet file to the data output.
+// It is separate from PrintQueryResult since Parquet is only supported as an output format for query results.
+func (p *Printer) PrintQueryResultParquet(schema *runtimev1.StructType, data []*structpb.Struct) error {
+	return queries.WriteParquet(toQueryResultColumns(schema), data, p.dataOut())
+}
+
+// toQueryResultColumns converts a result schema to the column format used by the export writers.
+func toQueryResultColumns(schema *runtimev1.StructType) []*runtimev1.MetricsViewColumn {
+	cols := make([]*runtimev1.MetricsViewColumn, len(schema.Fields))
+	for i, f := range schema.Fields {
+		cols[i] = &runtimev1.MetricsViewColumn{
+			Name: f.Name,
+			Type: f.Type.Code.String(),
+		}
+	}
+	return cols
+}
+
+// toQueryResultTable converts query rows to string cells for table output.
+// It also returns the positions of numeric columns, which are right-aligned.
+func toQueryResultTable(schema *runtimev1.StructType, data []*structpb.Struct) ([]string, [][]string, []int, error) {
+	headers := make([]string, len(schema.Fields))
+	var nums []int
+	for i, f := range schema.Fields {
+		headers[i] = f.Name
+		if isNumericType(f.Type.Code) {
+			nums = append(nums, i)
+		}
+	}
+
+	rows := make([][]string, len(data))
+	for i, row := range data {
+		cells := make([]string, len(schema.Fields))
+		for j, f := range schema.Fields {
+			v, ok := row.Fields[f.Name]
+			if !ok {
+				continue
+			}
+			switch v.GetKind().(type) {
+			case *structpb.Value_NullValue:
+				cells[j] = "null"
+			case *structpb.Value_StructValue, *structpb.Value_ListValue:
+				buf, err := protojson.Marshal(v)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				cells[j] = string(buf)
+			default:
+				cells[j] = strings.TrimSpace(fmt.Sprintf("%v", v.AsInterface()))
+			}
+		}
+		rows[i] = cells
+	}
+
+	return headers, rows, nums, nil
+}
+
+func isNumericType(code runtimev1.Type_Code) bool {
+	switch code {
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256,
+		runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256,
+		runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_DECIMAL:
+		return true
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package printer
 
 import (
 	"encoding/json"
+	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -540,3 +541,156 @@ type billingIssue struct {
 	Metadata     string `header:"metadata" json:"metadata"`
 	EventTime    string `header:"event_time,timestamp(ms|utc|human)" json:"event_time"`
 }
+
+func (p *Printer) PrintSecurityExplanations(exps []*runtimev1.ResourceSecurityExplanation) {
+	if len(exps) == 0 {
+		p.PrintfWarn("No resources found\n")
+		return
+	}
+
+	p.PrintData(toSecurityExplanationsTable(exps))
+}
+
+func toSecurityExplanationsTable(exps []*runtimev1.ResourceSecurityExplanation) []*securityExplanation {
+	res := make([]*securityExplanation, 0, len(exps))
+	for _, e := range exps {
+		res = append(res, toSecurityExplanationRow(e))
+	}
+	return res
+}
+
+func toSecurityExplanationRow(e *runtimev1.ResourceSecurityExplanation) *securityExplanation {
+	row := &securityExplanation{
+		Kind:      e.Resource.Kind,
+		Name:      e.Resource.Name,
+		Access:    e.Access,
+		RowFilter: e.RowFilter,
+		Error:     e.Error,
+	}
+
+	if e.AccessRuleIndex >= 0 {
+		row.AccessRule = strconv.Itoa(int(e.AccessRuleIndex))
+	}
+
+	if e.Access {
+		if e.AllFields {
+			row.Fields = "*"
+		} else {
+			var fields []string
+			for _, f := range e.Fields {
+				if f.Allowed {
+					fields = append(fields, f.Field)
+				}
+			}
+			row.Fields = strings.Join(fields, ", ")
+		}
+	}
+
+	if e.QueryFilter != nil {
+		expr := metricsview.NewExpressionFromProto(e.QueryFilter)
+		filter, err := metricsview.ExpressionToString(expr)
+		if err != nil {
+			panic(err)
+		}
+		if row.RowFilter == "" {
+			row.RowFilter = filter
+		} else {
+			row.RowFilter = fmt.Sprintf("(%s) AND (%s)", row.RowFilter, filter)
+		}
+	}
+
+	return row
+}
+
+type securityExplanation struct {
+	Kind       string `header:"kind" json:"kind"`
+	Name       string `header:"name" json:"name"`
+	Access     bool   `header:"access" json:"access"`
+	AccessRule string `header:"access rule" json:"access_rule"`
+	Fields     string `header:"fields" json:"fields"`
+	RowFilter  string `header:"row filter" json:"row_filter"`
+	Error      string `header:"error" json:"error"`
+}
+
+func (p *Printer) PrintSecurityRuleEvaluations(exps []*runtimev1.ResourceSecurityExplanation) {
+	p.PrintData(toSecurityRuleEvaluationsTable(exps))
+}
+
+func toSecurityRuleEvaluationsTable(exps []*runtimev1.ResourceSecurityExplanation) []*securityRuleEvaluation {
+	var res []*securityRuleEvaluation
+	for _, e := range exps {
+		for i, r := range e.Rules {
+			res = append(res, toSecurityRuleEvaluationRow(e.Resource, i, r))
+		}
+	}
+	return res
+}
+
+func toSecurityRuleEvaluationRow(n *runtimev1.ResourceName, idx int, r *runtimev1.SecurityRuleEvaluation) *securityRuleEvaluation {
+	row := &securityRuleEvaluation{
+		Resource: n.Name,
+		Index:    idx,
+		Source:   strings.ToLower(strings.TrimPrefix(r.Source.String(), "SECURITY_RULE_SOURCE_")),
+		Matched:  r.ConditionMatched,
+		Skipped:  r.Skipped,
+	}
+
+	switch rule := r.Rule.Rule.(type) {
+	case *runtimev1.SecurityRule_Access:
+		row.Type = "access"
+		row.Condition = rule.Access.Condition
+		if rule.Access.Allow {
+			row.Effect = "allow"
+		} else {
+			row.Effect = "deny"
+		}
+	case *runtimev1.SecurityRule_FieldAccess:
+		row.Type = "field_access"
+		row.Condition = rule.FieldAccess.Condition
+		fields := "*"
+		if !rule.FieldAccess.AllFields {
+			fields = strings.Join(rule.FieldAccess.Fields, ", ")
+		}
+		if rule.FieldAccess.Allow {
+			row.Effect = fmt.Sprintf("allow %s", fields)
+		} else {
+			row.Effect = fmt.Sprintf("deny %s", fields)
+		}
+	case *runtimev1.SecurityRule_RowFilter:
+		row.Type = "row_filter"
+		row.Condition = rule.RowFilter.Condition
+		row.Effect = rule.RowFilter.Sql
+		if rule.RowFilter.Expression != nil {
+			expr := metricsview.NewExpressionFromProto(rule.RowFilter.Expression)
+			filter, err := metricsview.ExpressionToString(expr)
+			if err != nil {
+				panic(err)
+			}
+			if row.Effect == "" {
+				row.Effect = filter
+			} else {
+				row.Effect = fmt.Sprintf("(%s) AND (%s)", row.Effect, filter)
+			}
+		}
+	case *runtimev1.SecurityRule_MinGroupSize:
+		row.Type = "min_group_size"
+		row.Condition = rule.MinGroupSize.Condition
+		row.Effect = fmt.Sprintf("min %d rows", rule.MinGroupSize.MinGroupSize)
+		if rule.MinGroupSize.Noise != 0 {
+			row.Effect = fmt.Sprintf("%s, noise %g", row.Effect, rule.MinGroupSize.Noise)
+		}
+	}
+
+	return row
+}
+
+type securityRuleEvaluation struct {
+	Resource  string `header:"resource" json:"resource"`
+	Index     int    `header:"rule" json:"rule"`
+	Source    string `header:"source" json:"source"`
+	Type      string `header:"type" json:"type"`
+	Condition string `header:"condition" json:"condition"`
+	Effect    string `header:"effect" json:"effect"`
+	Matched   bool   `header:"matched" json:"matched"`
+	Skipped   bool   `header:"skipped" json:"skipped"`
+}
@@ -91,6 +91,40 @@ func (m *CompleteRequest) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetTools() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompleteRequestValidationError{
+						field:  fmt.Sprintf("Tools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompleteRequestValidationError{
+						field:  fmt.Sprintf("Tools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompleteRequestValidationError{
+					field:  fmt.Sprintf("Tools[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CompleteRequestMultiError(errors)
 	}
@@ -324,6 +358,42 @@ func (m *CompletionMessage) validate(all bool) error {
 
 	// no validation rules for Data
 
+	for idx, item := range m.GetToolCalls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompletionMessageValidationError{
+						field:  fmt.Sprintf("ToolCalls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompletionMessageValidationError{
+						field:  fmt.Sprintf("ToolCalls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompletionMessageValidationError{
+					field:  fmt.Sprintf("ToolCalls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ToolCallId
+
 	if len(errors) > 0 {
 		return CompletionMessageMultiError(errors)
 	}
@@ -403,3 +473,212 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CompletionMessageValidationError{}
+
+// Validate checks the field values on Tool with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tool) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tool with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ToolMultiError, or nil if none found.
+func (m *Tool) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tool) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for InputSchema
+
+	if len(errors) > 0 {
+		return ToolMultiError(errors)
+	}
+
+	return nil
+}
+
+// ToolMultiError is an error wrapping multiple validation errors returned by
+// Tool.ValidateAll() if the designated constraints aren't met.
+type ToolMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ToolMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ToolMultiError) AllErrors() []error { return m }
+
+// ToolValidationError is the validation error returned by Tool.Validate if the
+// designated constraints aren't met.
+type ToolValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ToolValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ToolValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ToolValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ToolValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ToolValidationError) ErrorName() string { return "ToolValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ToolValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTool.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ToolValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ToolValidationError{}
+
+// Validate checks the field values on ToolCall with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ToolCall) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ToolCall with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ToolCallMultiError, or nil
+// if none found.
+func (m *ToolCall) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ToolCall) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Input
+
+	if len(errors) > 0 {
+		return ToolCallMultiError(errors)
+	}
+
+	return nil
+}
+
+// ToolCallMultiError is an error wrapping multiple validation errors returned
+// by ToolCall.ValidateAll() if the designated constraints aren't met.
+type ToolCallMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ToolCallMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ToolCallMultiError) AllErrors() []error { return m }
+
+// ToolCallValidationError is the validation error returned by
+// ToolCall.Validate if the designated constraints aren't met.
+type ToolCallValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ToolCallValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ToolCallValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ToolCallValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ToolCallValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ToolCallValidationError) ErrorName() string { return "ToolCallValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ToolCallValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sToolCall.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ToolCallValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ToolCallValidationError{}
@@ -2098,6 +2098,8 @@ func (m *GetProjectRequest) validate(all bool) error {
 
 	// no validation rules for IssueSuperuserToken
 
+	// no validation rules for Branch
+
 	if len(errors) > 0 {
 		return GetProjectRequestMultiError(errors)
 	}
@@ -2289,6 +2291,35 @@ func (m *GetProjectResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetPreviewDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "PreviewDeployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "PreviewDeployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreviewDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectResponseValidationError{
+				field:  "PreviewDeployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetProjectResponseMultiError(errors)
 	}
@@ -23872,6 +23903,286 @@ var _ interface {
 	ErrorName() string
 } = ConnectProjectToGithubResponseValidationError{}
 
+// Validate checks the field values on ConnectProjectToGitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConnectProjectToGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectProjectToGitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectProjectToGitRequestMultiError, or nil if none found.
+func (m *ConnectProjectToGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectProjectToGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ConnectProjectToGitRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ConnectProjectToGitRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ConnectProjectToGitRequest_Provider_InLookup[m.GetProvider()]; !ok {
+		err := ConnectProjectToGitRequestValidationError{
+			field:  "Provider",
+			reason: "value must be in list [gitlab bitbucket git]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRemote()) < 1 {
+		err := ConnectProjectToGitRequestValidationError{
+			field:  "Remote",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for SshPrivateKey
+
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := ConnectProjectToGitRequestValidationError{
+			field:  "Branch",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Subpath
+
+	if len(errors) > 0 {
+		return ConnectProjectToGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectProjectToGitRequestMultiError is an error wrapping multiple
+// validation errors returned by ConnectProjectToGitRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConnectProjectToGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectProjectToGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectProjectToGitRequestMultiError) AllErrors() []error { return m }
+
+// ConnectProjectToGitRequestValidationError is the validation error returned
+// by ConnectProjectToGitRequest.Validate if the designated constraints aren't met.
+type ConnectProjectToGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectProjectToGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectProjectToGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectProjectToGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectProjectToGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectProjectToGitRequestValidationError) ErrorName() string {
+	return "ConnectProjectToGitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectProjectToGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectProjectToGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectProjectToGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectProjectToGitRequestValidationError{}
+
+var _ConnectProjectToGitRequest_Provider_InLookup = map[string]struct{}{
+	"gitlab":    {},
+	"bitbucket": {},
+	"git":       {},
+}
+
+// Validate checks the field values on ConnectProjectToGitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConnectProjectToGitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectProjectToGitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectProjectToGitResponseMultiError, or nil if none found.
+func (m *ConnectProjectToGitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectProjectToGitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SshPublicKey
+
+	// no validation rules for WebhookUrl
+
+	// no validation rules for WebhookSecret
+
+	if len(errors) > 0 {
+		return ConnectProjectToGitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectProjectToGitResponseMultiError is an error wrapping multiple
+// validation errors returned by ConnectProjectToGitResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ConnectProjectToGitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectProjectToGitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectProjectToGitResponseMultiError) AllErrors() []error { return m }
+
+// ConnectProjectToGitResponseValidationError is the validation error returned
+// by ConnectProjectToGitResponse.Validate if the designated constraints
+// aren't met.
+type ConnectProjectToGitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectProjectToGitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectProjectToGitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectProjectToGitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectProjectToGitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectProjectToGitResponseValidationError) ErrorName() string {
+	return "ConnectProjectToGitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectProjectToGitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectProjectToGitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectProjectToGitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectProjectToGitResponseValidationError{}
+
 // Validate checks the field values on UploadProjectAssetsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -25997,6 +26308,10 @@ func (m *GetRepoMetaResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for GitSshPrivateKey
+
+	// no validation rules for GitSshHostKey
+
 	if len(errors) > 0 {
 		return GetRepoMetaResponseMultiError(errors)
 	}
@@ -26397,6 +26712,12 @@ func (m *GetReportMetaRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for RecipientAttributes
+
+	// no validation rules for SnapshotType
+
+	// no validation rules for SnapshotResource
+
 	if len(errors) > 0 {
 		return GetReportMetaRequestMultiError(errors)
 	}
@@ -26574,6 +26895,85 @@ func (m *GetReportMetaResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetQueryForAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReportMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportMetaResponseValidationError{
+				field:  "QueryForAttributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetRecipientAttributes()))
+		i := 0
+		for key := range m.GetRecipientAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetRecipientAttributes()[key]
+			_ = val
+
+			// no validation rules for RecipientAttributes[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetReportMetaResponseValidationError{
+							field:  fmt.Sprintf("RecipientAttributes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetReportMetaResponseValidationError{
+							field:  fmt.Sprintf("RecipientAttributes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetReportMetaResponseValidationError{
+						field:  fmt.Sprintf("RecipientAttributes[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for RecipientSnapshotUrls
+
+	// no validation rules for RecipientAttributeErrors
+
 	if len(errors) > 0 {
 		return GetReportMetaResponseMultiError(errors)
 	}
@@ -34129,6 +34529,8 @@ func (m *Deployment) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Preview
+
 	if len(errors) > 0 {
 		return DeploymentMultiError(errors)
 	}
@@ -35783,6 +36185,35 @@ func (m *ReportOptions) validate(all bool) error {
 
 	// no validation rules for ExportFormat
 
+	if all {
+		switch v := interface{}(m.GetExportGoogleSheets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportOptionsValidationError{
+					field:  "ExportGoogleSheets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportOptionsValidationError{
+					field:  "ExportGoogleSheets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportGoogleSheets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportOptionsValidationError{
+				field:  "ExportGoogleSheets",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for WebOpenPath
 
 	// no validation rules for WebOpenState
@@ -38436,6 +38867,8 @@ func (m *GetReportMetaResponse_URLs) validate(all bool) error {
 
 	// no validation rules for EditUrl
 
+	// no validation rules for SnapshotUrl
+
 	if len(errors) > 0 {
 		return GetReportMetaResponse_URLsMultiError(errors)
 	}
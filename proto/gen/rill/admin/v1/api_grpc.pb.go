@@ -77,6 +77,7 @@ const (
 	AdminService_GetGithubUserStatus_FullMethodName                   = "/rill.admin.v1.AdminService/GetGithubUserStatus"
 	AdminService_ListGithubUserRepos_FullMethodName                   = "/rill.admin.v1.AdminService/ListGithubUserRepos"
 	AdminService_ConnectProjectToGithub_FullMethodName                = "/rill.admin.v1.AdminService/ConnectProjectToGithub"
+	AdminService_ConnectProjectToGit_FullMethodName                   = "/rill.admin.v1.AdminService/ConnectProjectToGit"
 	AdminService_UploadProjectAssets_FullMethodName                   = "/rill.admin.v1.AdminService/UploadProjectAssets"
 	AdminService_GetCloneCredentials_FullMethodName                   = "/rill.admin.v1.AdminService/GetCloneCredentials"
 	AdminService_CreateWhitelistedDomain_FullMethodName               = "/rill.admin.v1.AdminService/CreateWhitelistedDomain"
@@ -279,6 +280,9 @@ type AdminServiceClient interface {
 	// Connects a rill managed project to github.
 	// Replaces the contents of the remote repo with the contents of the project.
 	ConnectProjectToGithub(ctx context.Context, in *ConnectProjectToGithubRequest, opts ...grpc.CallOption) (*ConnectProjectToGithubResponse, error)
+	// Connects a project to a Git repository on GitLab, Bitbucket or a generic Git server.
+	// Replaces the project's current Github repository or archive.
+	ConnectProjectToGit(ctx context.Context, in *ConnectProjectToGitRequest, opts ...grpc.CallOption) (*ConnectProjectToGitResponse, error)
 	// Converts a project connected to github to a rill managed project.
 	// Uploads the current project to assets.
 	UploadProjectAssets(ctx context.Context, in *UploadProjectAssetsRequest, opts ...grpc.CallOption) (*UploadProjectAssetsResponse, error)
@@ -1008,6 +1012,16 @@ func (c *adminServiceClient) ConnectProjectToGithub(ctx context.Context, in *Con
 	return out, nil
 }
 
+func (c *adminServiceClient) ConnectProjectToGit(ctx context.Context, in *ConnectProjectToGitRequest, opts ...grpc.CallOption) (*ConnectProjectToGitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectProjectToGitResponse)
+	err := c.cc.Invoke(ctx, AdminService_ConnectProjectToGit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) UploadProjectAssets(ctx context.Context, in *UploadProjectAssetsRequest, opts ...grpc.CallOption) (*UploadProjectAssetsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadProjectAssetsResponse)
@@ -1838,6 +1852,9 @@ type AdminServiceServer interface {
 	// Connects a rill managed project to github.
 	// Replaces the contents of the remote repo with the contents of the project.
 	ConnectProjectToGithub(context.Context, *ConnectProjectToGithubRequest) (*ConnectProjectToGithubResponse, error)
+	// Connects a project to a Git repository on GitLab, Bitbucket or a generic Git server.
+	// Replaces the project's current Github repository or archive.
+	ConnectProjectToGit(context.Context, *ConnectProjectToGitRequest) (*ConnectProjectToGitResponse, error)
 	// Converts a project connected to github to a rill managed project.
 	// Uploads the current project to assets.
 	UploadProjectAssets(context.Context, *UploadProjectAssetsRequest) (*UploadProjectAssetsResponse, error)
@@ -2161,6 +2178,9 @@ func (UnimplementedAdminServiceServer) ListGithubUserRepos(context.Context, *Lis
 func (UnimplementedAdminServiceServer) ConnectProjectToGithub(context.Context, *ConnectProjectToGithubRequest) (*ConnectProjectToGithubResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectProjectToGithub not implemented")
 }
+func (UnimplementedAdminServiceServer) ConnectProjectToGit(context.Context, *ConnectProjectToGitRequest) (*ConnectProjectToGitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectProjectToGit not implemented")
+}
 func (UnimplementedAdminServiceServer) UploadProjectAssets(context.Context, *UploadProjectAssetsRequest) (*UploadProjectAssetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadProjectAssets not implemented")
 }
@@ -3436,6 +3456,24 @@ func _AdminService_ConnectProjectToGithub_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ConnectProjectToGit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectProjectToGitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ConnectProjectToGit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ConnectProjectToGit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ConnectProjectToGit(ctx, req.(*ConnectProjectToGitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_UploadProjectAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadProjectAssetsRequest)
 	if err := dec(in); err != nil {
@@ -4935,6 +4973,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectProjectToGithub",
 			Handler:    _AdminService_ConnectProjectToGithub_Handler,
 		},
+		{
+			MethodName: "ConnectProjectToGit",
+			Handler:    _AdminService_ConnectProjectToGit_Handler,
+		},
 		{
 			MethodName: "UploadProjectAssets",
 			Handler:    _AdminService_UploadProjectAssets_Handler,
@@ -5925,75 +5925,79 @@ var _ interface {
 	ErrorName() string
 } = GenerateRendererResponseValidationError{}
 
-// Validate checks the field values on Log with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Log) Validate() error {
+// Validate checks the field values on AskMetricsViewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AskMetricsViewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Log with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in LogMultiError, or nil if none found.
-func (m *Log) ValidateAll() error {
+// ValidateAll checks the field values on AskMetricsViewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AskMetricsViewRequestMultiError, or nil if none found.
+func (m *AskMetricsViewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Log) validate(all bool) error {
+func (m *AskMetricsViewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Level
+	if !_AskMetricsViewRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := AskMetricsViewRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LogValidationError{
-					field:  "Time",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, LogValidationError{
-					field:  "Time",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetMetricsView()) < 1 {
+		err := AskMetricsViewRequestValidationError{
+			field:  "MetricsView",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return LogValidationError{
-				field:  "Time",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Message
+	if utf8.RuneCountInString(m.GetQuestion()) < 1 {
+		err := AskMetricsViewRequestValidationError{
+			field:  "Question",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for JsonPayload
+	// no validation rules for TimeZone
+
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return LogMultiError(errors)
+		return AskMetricsViewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LogMultiError is an error wrapping multiple validation errors returned by
-// Log.ValidateAll() if the designated constraints aren't met.
-type LogMultiError []error
+// AskMetricsViewRequestMultiError is an error wrapping multiple validation
+// errors returned by AskMetricsViewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AskMetricsViewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LogMultiError) Error() string {
+func (m AskMetricsViewRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6002,11 +6006,11 @@ func (m LogMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LogMultiError) AllErrors() []error { return m }
+func (m AskMetricsViewRequestMultiError) AllErrors() []error { return m }
 
-// LogValidationError is the validation error returned by Log.Validate if the
-// designated constraints aren't met.
-type LogValidationError struct {
+// AskMetricsViewRequestValidationError is the validation error returned by
+// AskMetricsViewRequest.Validate if the designated constraints aren't met.
+type AskMetricsViewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6014,22 +6018,24 @@ type LogValidationError struct {
 }
 
 // Field function returns field value.
-func (e LogValidationError) Field() string { return e.field }
+func (e AskMetricsViewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LogValidationError) Reason() string { return e.reason }
+func (e AskMetricsViewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LogValidationError) Cause() error { return e.cause }
+func (e AskMetricsViewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LogValidationError) Key() bool { return e.key }
+func (e AskMetricsViewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LogValidationError) ErrorName() string { return "LogValidationError" }
+func (e AskMetricsViewRequestValidationError) ErrorName() string {
+	return "AskMetricsViewRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LogValidationError) Error() string {
+func (e AskMetricsViewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6041,14 +6047,14 @@ func (e LogValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLog.%s: %s%s",
+		"invalid %sAskMetricsViewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LogValidationError{}
+var _ error = AskMetricsViewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6056,137 +6062,140 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LogValidationError{}
+} = AskMetricsViewRequestValidationError{}
 
-// Validate checks the field values on ModelPartition with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ModelPartition) Validate() error {
+var _AskMetricsViewRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on AskMetricsViewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AskMetricsViewResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ModelPartition with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ModelPartitionMultiError,
-// or nil if none found.
-func (m *ModelPartition) ValidateAll() error {
+// ValidateAll checks the field values on AskMetricsViewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AskMetricsViewResponseMultiError, or nil if none found.
+func (m *AskMetricsViewResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ModelPartition) validate(all bool) error {
+func (m *AskMetricsViewResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
-
 	if all {
-		switch v := interface{}(m.GetData()).(type) {
+		switch v := interface{}(m.GetQuery()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelPartitionValidationError{
-					field:  "Data",
+				errors = append(errors, AskMetricsViewResponseValidationError{
+					field:  "Query",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelPartitionValidationError{
-					field:  "Data",
+				errors = append(errors, AskMetricsViewResponseValidationError{
+					field:  "Query",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ModelPartitionValidationError{
-				field:  "Data",
+			return AskMetricsViewResponseValidationError{
+				field:  "Query",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Explanation
+
 	if all {
-		switch v := interface{}(m.GetWatermark()).(type) {
+		switch v := interface{}(m.GetSchema()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelPartitionValidationError{
-					field:  "Watermark",
+				errors = append(errors, AskMetricsViewResponseValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelPartitionValidationError{
-					field:  "Watermark",
+				errors = append(errors, AskMetricsViewResponseValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetWatermark()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ModelPartitionValidationError{
-				field:  "Watermark",
+			return AskMetricsViewResponseValidationError{
+				field:  "Schema",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetExecutedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelPartitionValidationError{
-					field:  "ExecutedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AskMetricsViewResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AskMetricsViewResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelPartitionValidationError{
-					field:  "ExecutedOn",
+				return AskMetricsViewResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExecutedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModelPartitionValidationError{
-				field:  "ExecutedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
-
-	// no validation rules for Error
 
-	// no validation rules for ElapsedMs
+	}
 
 	if len(errors) > 0 {
-		return ModelPartitionMultiError(errors)
+		return AskMetricsViewResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ModelPartitionMultiError is an error wrapping multiple validation errors
-// returned by ModelPartition.ValidateAll() if the designated constraints
-// aren't met.
-type ModelPartitionMultiError []error
+// AskMetricsViewResponseMultiError is an error wrapping multiple validation
+// errors returned by AskMetricsViewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AskMetricsViewResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ModelPartitionMultiError) Error() string {
+func (m AskMetricsViewResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6195,11 +6204,11 @@ func (m ModelPartitionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ModelPartitionMultiError) AllErrors() []error { return m }
+func (m AskMetricsViewResponseMultiError) AllErrors() []error { return m }
 
-// ModelPartitionValidationError is the validation error returned by
-// ModelPartition.Validate if the designated constraints aren't met.
-type ModelPartitionValidationError struct {
+// AskMetricsViewResponseValidationError is the validation error returned by
+// AskMetricsViewResponse.Validate if the designated constraints aren't met.
+type AskMetricsViewResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6207,22 +6216,24 @@ type ModelPartitionValidationError struct {
 }
 
 // Field function returns field value.
-func (e ModelPartitionValidationError) Field() string { return e.field }
+func (e AskMetricsViewResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ModelPartitionValidationError) Reason() string { return e.reason }
+func (e AskMetricsViewResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ModelPartitionValidationError) Cause() error { return e.cause }
+func (e AskMetricsViewResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ModelPartitionValidationError) Key() bool { return e.key }
+func (e AskMetricsViewResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ModelPartitionValidationError) ErrorName() string { return "ModelPartitionValidationError" }
+func (e AskMetricsViewResponseValidationError) ErrorName() string {
+	return "AskMetricsViewResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ModelPartitionValidationError) Error() string {
+func (e AskMetricsViewResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6234,14 +6245,14 @@ func (e ModelPartitionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sModelPartition.%s: %s%s",
+		"invalid %sAskMetricsViewResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ModelPartitionValidationError{}
+var _ error = AskMetricsViewResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6249,38 +6260,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ModelPartitionValidationError{}
+} = AskMetricsViewResponseValidationError{}
 
-// Validate checks the field values on GetLogsRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetLogsRequest) Validate() error {
+// Validate checks the field values on ListConversationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLogsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetLogsRequestMultiError,
-// or nil if none found.
-func (m *GetLogsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListConversationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConversationsRequestMultiError, or nil if none found.
+func (m *ListConversationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLogsRequest) validate(all bool) error {
+func (m *ListConversationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Ascending
-
-	if m.GetLimit() < -1 {
-		err := GetLogsRequestValidationError{
-			field:  "Limit",
-			reason: "value must be greater than or equal to -1",
+	if !_ListConversationsRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ListConversationsRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
 		if !all {
 			return err
@@ -6288,22 +6295,37 @@ func (m *GetLogsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Level
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListConversationsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return GetLogsRequestMultiError(errors)
+		return ListConversationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLogsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetLogsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetLogsRequestMultiError []error
+// ListConversationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListConversationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLogsRequestMultiError) Error() string {
+func (m ListConversationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6312,11 +6334,11 @@ func (m GetLogsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLogsRequestMultiError) AllErrors() []error { return m }
+func (m ListConversationsRequestMultiError) AllErrors() []error { return m }
 
-// GetLogsRequestValidationError is the validation error returned by
-// GetLogsRequest.Validate if the designated constraints aren't met.
-type GetLogsRequestValidationError struct {
+// ListConversationsRequestValidationError is the validation error returned by
+// ListConversationsRequest.Validate if the designated constraints aren't met.
+type ListConversationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6324,22 +6346,24 @@ type GetLogsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLogsRequestValidationError) Field() string { return e.field }
+func (e ListConversationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLogsRequestValidationError) Reason() string { return e.reason }
+func (e ListConversationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLogsRequestValidationError) Cause() error { return e.cause }
+func (e ListConversationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLogsRequestValidationError) Key() bool { return e.key }
+func (e ListConversationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLogsRequestValidationError) ErrorName() string { return "GetLogsRequestValidationError" }
+func (e ListConversationsRequestValidationError) ErrorName() string {
+	return "ListConversationsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetLogsRequestValidationError) Error() string {
+func (e ListConversationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6351,14 +6375,14 @@ func (e GetLogsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLogsRequest.%s: %s%s",
+		"invalid %sListConversationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLogsRequestValidationError{}
+var _ error = ListConversationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6366,47 +6390,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLogsRequestValidationError{}
+} = ListConversationsRequestValidationError{}
 
-// Validate checks the field values on GetLogsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetLogsResponse) Validate() error {
+var _ListConversationsRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on ListConversationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLogsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListConversationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetLogsResponseMultiError, or nil if none found.
-func (m *GetLogsResponse) ValidateAll() error {
+// ListConversationsResponseMultiError, or nil if none found.
+func (m *ListConversationsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLogsResponse) validate(all bool) error {
+func (m *ListConversationsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLogs() {
+	for idx, item := range m.GetConversations() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetLogsResponseValidationError{
-						field:  fmt.Sprintf("Logs[%v]", idx),
+					errors = append(errors, ListConversationsResponseValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetLogsResponseValidationError{
-						field:  fmt.Sprintf("Logs[%v]", idx),
+					errors = append(errors, ListConversationsResponseValidationError{
+						field:  fmt.Sprintf("Conversations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6414,8 +6440,8 @@ func (m *GetLogsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetLogsResponseValidationError{
-					field:  fmt.Sprintf("Logs[%v]", idx),
+				return ListConversationsResponseValidationError{
+					field:  fmt.Sprintf("Conversations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6424,20 +6450,22 @@ func (m *GetLogsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return GetLogsResponseMultiError(errors)
+		return ListConversationsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLogsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetLogsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetLogsResponseMultiError []error
+// ListConversationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListConversationsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLogsResponseMultiError) Error() string {
+func (m ListConversationsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6446,11 +6474,11 @@ func (m GetLogsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLogsResponseMultiError) AllErrors() []error { return m }
+func (m ListConversationsResponseMultiError) AllErrors() []error { return m }
 
-// GetLogsResponseValidationError is the validation error returned by
-// GetLogsResponse.Validate if the designated constraints aren't met.
-type GetLogsResponseValidationError struct {
+// ListConversationsResponseValidationError is the validation error returned by
+// ListConversationsResponse.Validate if the designated constraints aren't met.
+type ListConversationsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6458,22 +6486,24 @@ type GetLogsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLogsResponseValidationError) Field() string { return e.field }
+func (e ListConversationsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLogsResponseValidationError) Reason() string { return e.reason }
+func (e ListConversationsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLogsResponseValidationError) Cause() error { return e.cause }
+func (e ListConversationsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLogsResponseValidationError) Key() bool { return e.key }
+func (e ListConversationsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLogsResponseValidationError) ErrorName() string { return "GetLogsResponseValidationError" }
+func (e ListConversationsResponseValidationError) ErrorName() string {
+	return "ListConversationsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetLogsResponseValidationError) Error() string {
+func (e ListConversationsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6485,14 +6515,14 @@ func (e GetLogsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLogsResponse.%s: %s%s",
+		"invalid %sListConversationsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLogsResponseValidationError{}
+var _ error = ListConversationsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6500,38 +6530,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLogsResponseValidationError{}
+} = ListConversationsResponseValidationError{}
 
-// Validate checks the field values on WatchLogsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WatchLogsRequest) Validate() error {
+// Validate checks the field values on GetConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConversationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchLogsRequest with the rules
+// ValidateAll checks the field values on GetConversationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchLogsRequestMultiError, or nil if none found.
-func (m *WatchLogsRequest) ValidateAll() error {
+// GetConversationRequestMultiError, or nil if none found.
+func (m *GetConversationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchLogsRequest) validate(all bool) error {
+func (m *GetConversationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Replay
-
-	if m.GetReplayLimit() < -1 {
-		err := WatchLogsRequestValidationError{
-			field:  "ReplayLimit",
-			reason: "value must be greater than or equal to -1",
+	if !_GetConversationRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GetConversationRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
 		if !all {
 			return err
@@ -6539,22 +6565,31 @@ func (m *WatchLogsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Level
+	if utf8.RuneCountInString(m.GetConversationId()) < 1 {
+		err := GetConversationRequestValidationError{
+			field:  "ConversationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return WatchLogsRequestMultiError(errors)
+		return GetConversationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchLogsRequestMultiError is an error wrapping multiple validation errors
-// returned by WatchLogsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WatchLogsRequestMultiError []error
+// GetConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetConversationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConversationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchLogsRequestMultiError) Error() string {
+func (m GetConversationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6563,11 +6598,11 @@ func (m WatchLogsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchLogsRequestMultiError) AllErrors() []error { return m }
+func (m GetConversationRequestMultiError) AllErrors() []error { return m }
 
-// WatchLogsRequestValidationError is the validation error returned by
-// WatchLogsRequest.Validate if the designated constraints aren't met.
-type WatchLogsRequestValidationError struct {
+// GetConversationRequestValidationError is the validation error returned by
+// GetConversationRequest.Validate if the designated constraints aren't met.
+type GetConversationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6575,22 +6610,24 @@ type WatchLogsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchLogsRequestValidationError) Field() string { return e.field }
+func (e GetConversationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchLogsRequestValidationError) Reason() string { return e.reason }
+func (e GetConversationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchLogsRequestValidationError) Cause() error { return e.cause }
+func (e GetConversationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchLogsRequestValidationError) Key() bool { return e.key }
+func (e GetConversationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchLogsRequestValidationError) ErrorName() string { return "WatchLogsRequestValidationError" }
+func (e GetConversationRequestValidationError) ErrorName() string {
+	return "GetConversationRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WatchLogsRequestValidationError) Error() string {
+func (e GetConversationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6602,14 +6639,14 @@ func (e WatchLogsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchLogsRequest.%s: %s%s",
+		"invalid %sGetConversationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchLogsRequestValidationError{}
+var _ error = GetConversationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6617,24 +6654,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchLogsRequestValidationError{}
+} = GetConversationRequestValidationError{}
 
-// Validate checks the field values on WatchLogsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WatchLogsResponse) Validate() error {
+var _GetConversationRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on GetConversationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConversationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchLogsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchLogsResponseMultiError, or nil if none found.
-func (m *WatchLogsResponse) ValidateAll() error {
+// GetConversationResponseMultiError, or nil if none found.
+func (m *GetConversationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchLogsResponse) validate(all bool) error {
+func (m *GetConversationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6642,28 +6681,28 @@ func (m *WatchLogsResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetLog()).(type) {
+		switch v := interface{}(m.GetConversation()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchLogsResponseValidationError{
-					field:  "Log",
+				errors = append(errors, GetConversationResponseValidationError{
+					field:  "Conversation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchLogsResponseValidationError{
-					field:  "Log",
+				errors = append(errors, GetConversationResponseValidationError{
+					field:  "Conversation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConversation()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WatchLogsResponseValidationError{
-				field:  "Log",
+			return GetConversationResponseValidationError{
+				field:  "Conversation",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -6671,19 +6710,19 @@ func (m *WatchLogsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WatchLogsResponseMultiError(errors)
+		return GetConversationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchLogsResponseMultiError is an error wrapping multiple validation errors
-// returned by WatchLogsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type WatchLogsResponseMultiError []error
+// GetConversationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetConversationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConversationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchLogsResponseMultiError) Error() string {
+func (m GetConversationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6692,11 +6731,11 @@ func (m WatchLogsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchLogsResponseMultiError) AllErrors() []error { return m }
+func (m GetConversationResponseMultiError) AllErrors() []error { return m }
 
-// WatchLogsResponseValidationError is the validation error returned by
-// WatchLogsResponse.Validate if the designated constraints aren't met.
-type WatchLogsResponseValidationError struct {
+// GetConversationResponseValidationError is the validation error returned by
+// GetConversationResponse.Validate if the designated constraints aren't met.
+type GetConversationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6704,24 +6743,24 @@ type WatchLogsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchLogsResponseValidationError) Field() string { return e.field }
+func (e GetConversationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchLogsResponseValidationError) Reason() string { return e.reason }
+func (e GetConversationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchLogsResponseValidationError) Cause() error { return e.cause }
+func (e GetConversationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchLogsResponseValidationError) Key() bool { return e.key }
+func (e GetConversationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchLogsResponseValidationError) ErrorName() string {
-	return "WatchLogsResponseValidationError"
+func (e GetConversationResponseValidationError) ErrorName() string {
+	return "GetConversationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchLogsResponseValidationError) Error() string {
+func (e GetConversationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6733,14 +6772,14 @@ func (e WatchLogsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchLogsResponse.%s: %s%s",
+		"invalid %sGetConversationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchLogsResponseValidationError{}
+var _ error = GetConversationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6748,52 +6787,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchLogsResponseValidationError{}
+} = GetConversationResponseValidationError{}
 
-// Validate checks the field values on ListResourcesRequest with the rules
+// Validate checks the field values on DeleteConversationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesRequest) Validate() error {
+func (m *DeleteConversationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteConversationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesRequestMultiError, or nil if none found.
-func (m *ListResourcesRequest) ValidateAll() error {
+// DeleteConversationRequestMultiError, or nil if none found.
+func (m *DeleteConversationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesRequest) validate(all bool) error {
+func (m *DeleteConversationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Kind
-
-	// no validation rules for Path
+	if !_DeleteConversationRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := DeleteConversationRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for SkipSecurityChecks
+	if utf8.RuneCountInString(m.GetConversationId()) < 1 {
+		err := DeleteConversationRequestValidationError{
+			field:  "ConversationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListResourcesRequestMultiError(errors)
+		return DeleteConversationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListResourcesRequestMultiError []error
+// DeleteConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteConversationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteConversationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesRequestMultiError) Error() string {
+func (m DeleteConversationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6802,11 +6855,11 @@ func (m ListResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteConversationRequestMultiError) AllErrors() []error { return m }
 
-// ListResourcesRequestValidationError is the validation error returned by
-// ListResourcesRequest.Validate if the designated constraints aren't met.
-type ListResourcesRequestValidationError struct {
+// DeleteConversationRequestValidationError is the validation error returned by
+// DeleteConversationRequest.Validate if the designated constraints aren't met.
+type DeleteConversationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6814,24 +6867,24 @@ type ListResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesRequestValidationError) Field() string { return e.field }
+func (e DeleteConversationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteConversationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteConversationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+func (e DeleteConversationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesRequestValidationError) ErrorName() string {
-	return "ListResourcesRequestValidationError"
+func (e DeleteConversationRequestValidationError) ErrorName() string {
+	return "DeleteConversationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesRequestValidationError) Error() string {
+func (e DeleteConversationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6843,14 +6896,14 @@ func (e ListResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesRequest.%s: %s%s",
+		"invalid %sDeleteConversationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesRequestValidationError{}
+var _ error = DeleteConversationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6858,78 +6911,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesRequestValidationError{}
+} = DeleteConversationRequestValidationError{}
 
-// Validate checks the field values on ListResourcesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+var _DeleteConversationRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on DeleteConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesResponse) Validate() error {
+func (m *DeleteConversationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesResponseMultiError, or nil if none found.
-func (m *ListResourcesResponse) ValidateAll() error {
+// DeleteConversationResponseMultiError, or nil if none found.
+func (m *DeleteConversationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesResponse) validate(all bool) error {
+func (m *DeleteConversationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResources() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListResourcesResponseValidationError{
-					field:  fmt.Sprintf("Resources[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListResourcesResponseMultiError(errors)
+		return DeleteConversationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListResourcesResponseMultiError []error
+// DeleteConversationResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteConversationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteConversationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesResponseMultiError) Error() string {
+func (m DeleteConversationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6938,11 +6959,11 @@ func (m ListResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteConversationResponseMultiError) AllErrors() []error { return m }
 
-// ListResourcesResponseValidationError is the validation error returned by
-// ListResourcesResponse.Validate if the designated constraints aren't met.
-type ListResourcesResponseValidationError struct {
+// DeleteConversationResponseValidationError is the validation error returned
+// by DeleteConversationResponse.Validate if the designated constraints aren't met.
+type DeleteConversationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6950,24 +6971,24 @@ type ListResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesResponseValidationError) Field() string { return e.field }
+func (e DeleteConversationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteConversationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteConversationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+func (e DeleteConversationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesResponseValidationError) ErrorName() string {
-	return "ListResourcesResponseValidationError"
+func (e DeleteConversationResponseValidationError) ErrorName() string {
+	return "DeleteConversationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesResponseValidationError) Error() string {
+func (e DeleteConversationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6979,14 +7000,14 @@ func (e ListResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesResponse.%s: %s%s",
+		"invalid %sDeleteConversationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesResponseValidationError{}
+var _ error = DeleteConversationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6994,52 +7015,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesResponseValidationError{}
+} = DeleteConversationResponseValidationError{}
 
-// Validate checks the field values on WatchResourcesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchResourcesRequest) Validate() error {
+// Validate checks the field values on CompleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CompleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchResourcesRequest with the rules
+// ValidateAll checks the field values on CompleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchResourcesRequestMultiError, or nil if none found.
-func (m *WatchResourcesRequest) ValidateAll() error {
+// CompleteRequestMultiError, or nil if none found.
+func (m *CompleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchResourcesRequest) validate(all bool) error {
+func (m *CompleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Kind
+	if !_CompleteRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := CompleteRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Replay
+	// no validation rules for ConversationId
 
-	// no validation rules for Level
+	if utf8.RuneCountInString(m.GetMessage()) < 1 {
+		err := CompleteRequestValidationError{
+			field:  "Message",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return WatchResourcesRequestMultiError(errors)
+		return CompleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchResourcesRequestMultiError is an error wrapping multiple validation
-// errors returned by WatchResourcesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type WatchResourcesRequestMultiError []error
+// CompleteRequestMultiError is an error wrapping multiple validation errors
+// returned by CompleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CompleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchResourcesRequestMultiError) Error() string {
+func (m CompleteRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7048,11 +7087,11 @@ func (m WatchResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchResourcesRequestMultiError) AllErrors() []error { return m }
+func (m CompleteRequestMultiError) AllErrors() []error { return m }
 
-// WatchResourcesRequestValidationError is the validation error returned by
-// WatchResourcesRequest.Validate if the designated constraints aren't met.
-type WatchResourcesRequestValidationError struct {
+// CompleteRequestValidationError is the validation error returned by
+// CompleteRequest.Validate if the designated constraints aren't met.
+type CompleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7060,24 +7099,22 @@ type WatchResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchResourcesRequestValidationError) Field() string { return e.field }
+func (e CompleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchResourcesRequestValidationError) Reason() string { return e.reason }
+func (e CompleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchResourcesRequestValidationError) Cause() error { return e.cause }
+func (e CompleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchResourcesRequestValidationError) Key() bool { return e.key }
+func (e CompleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchResourcesRequestValidationError) ErrorName() string {
-	return "WatchResourcesRequestValidationError"
-}
+func (e CompleteRequestValidationError) ErrorName() string { return "CompleteRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WatchResourcesRequestValidationError) Error() string {
+func (e CompleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7089,14 +7126,14 @@ func (e WatchResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchResourcesRequest.%s: %s%s",
+		"invalid %sCompleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchResourcesRequestValidationError{}
+var _ error = CompleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7104,104 +7141,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchResourcesRequestValidationError{}
+} = CompleteRequestValidationError{}
 
-// Validate checks the field values on WatchResourcesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchResourcesResponse) Validate() error {
+var _CompleteRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on CompleteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CompleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchResourcesResponse with the rules
+// ValidateAll checks the field values on CompleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchResourcesResponseMultiError, or nil if none found.
-func (m *WatchResourcesResponse) ValidateAll() error {
+// CompleteResponseMultiError, or nil if none found.
+func (m *CompleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchResourcesResponse) validate(all bool) error {
+func (m *CompleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Event
+	// no validation rules for ConversationId
 
-	if all {
-		switch v := interface{}(m.GetName()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Name",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Name",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchResourcesResponseValidationError{
-				field:  "Name",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompleteResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompleteResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Resource",
+				return CompleteResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchResourcesResponseValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return WatchResourcesResponseMultiError(errors)
+		return CompleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchResourcesResponseMultiError is an error wrapping multiple validation
-// errors returned by WatchResourcesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type WatchResourcesResponseMultiError []error
+// CompleteResponseMultiError is an error wrapping multiple validation errors
+// returned by CompleteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CompleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchResourcesResponseMultiError) Error() string {
+func (m CompleteResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7210,11 +7225,11 @@ func (m WatchResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchResourcesResponseMultiError) AllErrors() []error { return m }
+func (m CompleteResponseMultiError) AllErrors() []error { return m }
 
-// WatchResourcesResponseValidationError is the validation error returned by
-// WatchResourcesResponse.Validate if the designated constraints aren't met.
-type WatchResourcesResponseValidationError struct {
+// CompleteResponseValidationError is the validation error returned by
+// CompleteResponse.Validate if the designated constraints aren't met.
+type CompleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7222,24 +7237,22 @@ type WatchResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchResourcesResponseValidationError) Field() string { return e.field }
+func (e CompleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchResourcesResponseValidationError) Reason() string { return e.reason }
+func (e CompleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchResourcesResponseValidationError) Cause() error { return e.cause }
+func (e CompleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchResourcesResponseValidationError) Key() bool { return e.key }
+func (e CompleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchResourcesResponseValidationError) ErrorName() string {
-	return "WatchResourcesResponseValidationError"
-}
+func (e CompleteResponseValidationError) ErrorName() string { return "CompleteResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WatchResourcesResponseValidationError) Error() string {
+func (e CompleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7251,14 +7264,14 @@ func (e WatchResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchResourcesResponse.%s: %s%s",
+		"invalid %sCompleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchResourcesResponseValidationError{}
+var _ error = CompleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7266,77 +7279,141 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchResourcesResponseValidationError{}
+} = CompleteResponseValidationError{}
 
-// Validate checks the field values on GetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceRequest) Validate() error {
+// Validate checks the field values on Conversation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Conversation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetResourceRequestMultiError, or nil if none found.
-func (m *GetResourceRequest) ValidateAll() error {
+// ValidateAll checks the field values on Conversation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConversationMultiError, or
+// nil if none found.
+func (m *Conversation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceRequest) validate(all bool) error {
+func (m *Conversation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for Id
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Title
 
 	if all {
-		switch v := interface{}(m.GetName()).(type) {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetResourceRequestValidationError{
-					field:  "Name",
+				errors = append(errors, ConversationValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetResourceRequestValidationError{
-					field:  "Name",
+				errors = append(errors, ConversationValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetResourceRequestValidationError{
-				field:  "Name",
+			return ConversationValidationError{
+				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for SkipSecurityChecks
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConversationValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConversationValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConversationValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetResourceRequestMultiError(errors)
+		return ConversationMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResourceRequestMultiError is an error wrapping multiple validation errors
-// returned by GetResourceRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetResourceRequestMultiError []error
+// ConversationMultiError is an error wrapping multiple validation errors
+// returned by Conversation.ValidateAll() if the designated constraints aren't met.
+type ConversationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceRequestMultiError) Error() string {
+func (m ConversationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7345,11 +7422,11 @@ func (m GetResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceRequestMultiError) AllErrors() []error { return m }
+func (m ConversationMultiError) AllErrors() []error { return m }
 
-// GetResourceRequestValidationError is the validation error returned by
-// GetResourceRequest.Validate if the designated constraints aren't met.
-type GetResourceRequestValidationError struct {
+// ConversationValidationError is the validation error returned by
+// Conversation.Validate if the designated constraints aren't met.
+type ConversationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7357,24 +7434,22 @@ type GetResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceRequestValidationError) Field() string { return e.field }
+func (e ConversationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceRequestValidationError) Reason() string { return e.reason }
+func (e ConversationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceRequestValidationError) Cause() error { return e.cause }
+func (e ConversationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceRequestValidationError) Key() bool { return e.key }
+func (e ConversationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceRequestValidationError) ErrorName() string {
-	return "GetResourceRequestValidationError"
-}
+func (e ConversationValidationError) ErrorName() string { return "ConversationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetResourceRequestValidationError) Error() string {
+func (e ConversationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7386,14 +7461,14 @@ func (e GetResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceRequest.%s: %s%s",
+		"invalid %sConversation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceRequestValidationError{}
+var _ error = ConversationValidationError{}
 
 var _ interface {
 	Field() string
@@ -7401,53 +7476,94 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceRequestValidationError{}
+} = ConversationValidationError{}
 
-// Validate checks the field values on GetResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceResponse) Validate() error {
+// Validate checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Message) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetResourceResponseMultiError, or nil if none found.
-func (m *GetResourceResponse) ValidateAll() error {
+// ValidateAll checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MessageMultiError, or nil if none found.
+func (m *Message) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceResponse) validate(all bool) error {
+func (m *Message) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
+	// no validation rules for Id
+
+	// no validation rules for Role
+
+	// no validation rules for Content
+
+	for idx, item := range m.GetToolCalls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("ToolCalls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("ToolCalls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("ToolCalls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ToolCallId
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
-					field:  "Resource",
+				errors = append(errors, MessageValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetResourceResponseValidationError{
-				field:  "Resource",
+			return MessageValidationError{
+				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7455,19 +7571,18 @@ func (m *GetResourceResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetResourceResponseMultiError(errors)
+		return MessageMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by GetResourceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetResourceResponseMultiError []error
+// MessageMultiError is an error wrapping multiple validation errors returned
+// by Message.ValidateAll() if the designated constraints aren't met.
+type MessageMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceResponseMultiError) Error() string {
+func (m MessageMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7476,11 +7591,11 @@ func (m GetResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceResponseMultiError) AllErrors() []error { return m }
+func (m MessageMultiError) AllErrors() []error { return m }
 
-// GetResourceResponseValidationError is the validation error returned by
-// GetResourceResponse.Validate if the designated constraints aren't met.
-type GetResourceResponseValidationError struct {
+// MessageValidationError is the validation error returned by Message.Validate
+// if the designated constraints aren't met.
+type MessageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7488,24 +7603,22 @@ type GetResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceResponseValidationError) Field() string { return e.field }
+func (e MessageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceResponseValidationError) Reason() string { return e.reason }
+func (e MessageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceResponseValidationError) Cause() error { return e.cause }
+func (e MessageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceResponseValidationError) Key() bool { return e.key }
+func (e MessageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceResponseValidationError) ErrorName() string {
-	return "GetResourceResponseValidationError"
-}
+func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetResourceResponseValidationError) Error() string {
+func (e MessageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7517,14 +7630,14 @@ func (e GetResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceResponse.%s: %s%s",
+		"invalid %sMessage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceResponseValidationError{}
+var _ error = MessageValidationError{}
 
 var _ interface {
 	Field() string
@@ -7532,48 +7645,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceResponseValidationError{}
+} = MessageValidationError{}
 
-// Validate checks the field values on GetExploreRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetExploreRequest) Validate() error {
+// Validate checks the field values on ToolCall with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ToolCall) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExploreRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetExploreRequestMultiError, or nil if none found.
-func (m *GetExploreRequest) ValidateAll() error {
+// ValidateAll checks the field values on ToolCall with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ToolCallMultiError, or nil
+// if none found.
+func (m *ToolCall) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExploreRequest) validate(all bool) error {
+func (m *ToolCall) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for Id
 
 	// no validation rules for Name
 
+	if all {
+		switch v := interface{}(m.GetInput()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ToolCallValidationError{
+					field:  "Input",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ToolCallValidationError{
+					field:  "Input",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInput()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ToolCallValidationError{
+				field:  "Input",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetExploreRequestMultiError(errors)
+		return ToolCallMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExploreRequestMultiError is an error wrapping multiple validation errors
-// returned by GetExploreRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetExploreRequestMultiError []error
+// ToolCallMultiError is an error wrapping multiple validation errors returned
+// by ToolCall.ValidateAll() if the designated constraints aren't met.
+type ToolCallMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExploreRequestMultiError) Error() string {
+func (m ToolCallMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7582,11 +7723,11 @@ func (m GetExploreRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExploreRequestMultiError) AllErrors() []error { return m }
+func (m ToolCallMultiError) AllErrors() []error { return m }
 
-// GetExploreRequestValidationError is the validation error returned by
-// GetExploreRequest.Validate if the designated constraints aren't met.
-type GetExploreRequestValidationError struct {
+// ToolCallValidationError is the validation error returned by
+// ToolCall.Validate if the designated constraints aren't met.
+type ToolCallValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7594,24 +7735,22 @@ type GetExploreRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExploreRequestValidationError) Field() string { return e.field }
+func (e ToolCallValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExploreRequestValidationError) Reason() string { return e.reason }
+func (e ToolCallValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExploreRequestValidationError) Cause() error { return e.cause }
+func (e ToolCallValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExploreRequestValidationError) Key() bool { return e.key }
+func (e ToolCallValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExploreRequestValidationError) ErrorName() string {
-	return "GetExploreRequestValidationError"
-}
+func (e ToolCallValidationError) ErrorName() string { return "ToolCallValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetExploreRequestValidationError) Error() string {
+func (e ToolCallValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7623,14 +7762,14 @@ func (e GetExploreRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExploreRequest.%s: %s%s",
+		"invalid %sToolCall.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExploreRequestValidationError{}
+var _ error = ToolCallValidationError{}
 
 var _ interface {
 	Field() string
@@ -7638,102 +7777,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExploreRequestValidationError{}
+} = ToolCallValidationError{}
 
-// Validate checks the field values on GetExploreResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetExploreResponse) Validate() error {
+// Validate checks the field values on Log with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Log) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetExploreResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetExploreResponseMultiError, or nil if none found.
-func (m *GetExploreResponse) ValidateAll() error {
+// ValidateAll checks the field values on Log with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogMultiError, or nil if none found.
+func (m *Log) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetExploreResponse) validate(all bool) error {
+func (m *Log) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetExplore()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetExploreResponseValidationError{
-					field:  "Explore",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetExploreResponseValidationError{
-					field:  "Explore",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExplore()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetExploreResponseValidationError{
-				field:  "Explore",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Level
 
 	if all {
-		switch v := interface{}(m.GetMetricsView()).(type) {
+		switch v := interface{}(m.GetTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetExploreResponseValidationError{
-					field:  "MetricsView",
+				errors = append(errors, LogValidationError{
+					field:  "Time",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetExploreResponseValidationError{
-					field:  "MetricsView",
+				errors = append(errors, LogValidationError{
+					field:  "Time",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetricsView()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetExploreResponseValidationError{
-				field:  "MetricsView",
+			return LogValidationError{
+				field:  "Time",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Message
+
+	// no validation rules for JsonPayload
+
 	if len(errors) > 0 {
-		return GetExploreResponseMultiError(errors)
+		return LogMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetExploreResponseMultiError is an error wrapping multiple validation errors
-// returned by GetExploreResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetExploreResponseMultiError []error
+// LogMultiError is an error wrapping multiple validation errors returned by
+// Log.ValidateAll() if the designated constraints aren't met.
+type LogMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetExploreResponseMultiError) Error() string {
+func (m LogMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7742,11 +7856,11 @@ func (m GetExploreResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetExploreResponseMultiError) AllErrors() []error { return m }
+func (m LogMultiError) AllErrors() []error { return m }
 
-// GetExploreResponseValidationError is the validation error returned by
-// GetExploreResponse.Validate if the designated constraints aren't met.
-type GetExploreResponseValidationError struct {
+// LogValidationError is the validation error returned by Log.Validate if the
+// designated constraints aren't met.
+type LogValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7754,24 +7868,22 @@ type GetExploreResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetExploreResponseValidationError) Field() string { return e.field }
+func (e LogValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetExploreResponseValidationError) Reason() string { return e.reason }
+func (e LogValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetExploreResponseValidationError) Cause() error { return e.cause }
+func (e LogValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetExploreResponseValidationError) Key() bool { return e.key }
+func (e LogValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetExploreResponseValidationError) ErrorName() string {
-	return "GetExploreResponseValidationError"
-}
+func (e LogValidationError) ErrorName() string { return "LogValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetExploreResponseValidationError) Error() string {
+func (e LogValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7783,14 +7895,14 @@ func (e GetExploreResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetExploreResponse.%s: %s%s",
+		"invalid %sLog.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetExploreResponseValidationError{}
+var _ error = LogValidationError{}
 
 var _ interface {
 	Field() string
@@ -7798,69 +7910,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetExploreResponseValidationError{}
+} = LogValidationError{}
 
-// Validate checks the field values on GetModelPartitionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetModelPartitionsRequest) Validate() error {
+// Validate checks the field values on ModelPartition with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelPartition) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetModelPartitionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetModelPartitionsRequestMultiError, or nil if none found.
-func (m *GetModelPartitionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ModelPartition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelPartitionMultiError,
+// or nil if none found.
+func (m *ModelPartition) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetModelPartitionsRequest) validate(all bool) error {
+func (m *ModelPartition) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Model
+	// no validation rules for Key
 
-	// no validation rules for Pending
-
-	// no validation rules for Errored
-
-	if m.GetPageSize() != 0 {
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-		if m.GetPageSize() > 10000 {
-			err := GetModelPartitionsRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 10000",
+	if all {
+		switch v := interface{}(m.GetWatermark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Watermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Watermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWatermark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "Watermark",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetExecutedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "ExecutedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "ExecutedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecutedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "ExecutedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	// no validation rules for PageToken
+	// no validation rules for Error
+
+	// no validation rules for ElapsedMs
 
 	if len(errors) > 0 {
-		return GetModelPartitionsRequestMultiError(errors)
+		return ModelPartitionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetModelPartitionsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetModelPartitionsRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetModelPartitionsRequestMultiError []error
+// ModelPartitionMultiError is an error wrapping multiple validation errors
+// returned by ModelPartition.ValidateAll() if the designated constraints
+// aren't met.
+type ModelPartitionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetModelPartitionsRequestMultiError) Error() string {
+func (m ModelPartitionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7869,11 +8049,11 @@ func (m GetModelPartitionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetModelPartitionsRequestMultiError) AllErrors() []error { return m }
+func (m ModelPartitionMultiError) AllErrors() []error { return m }
 
-// GetModelPartitionsRequestValidationError is the validation error returned by
-// GetModelPartitionsRequest.Validate if the designated constraints aren't met.
-type GetModelPartitionsRequestValidationError struct {
+// ModelPartitionValidationError is the validation error returned by
+// ModelPartition.Validate if the designated constraints aren't met.
+type ModelPartitionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7881,24 +8061,22 @@ type GetModelPartitionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetModelPartitionsRequestValidationError) Field() string { return e.field }
+func (e ModelPartitionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetModelPartitionsRequestValidationError) Reason() string { return e.reason }
+func (e ModelPartitionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetModelPartitionsRequestValidationError) Cause() error { return e.cause }
+func (e ModelPartitionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetModelPartitionsRequestValidationError) Key() bool { return e.key }
+func (e ModelPartitionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetModelPartitionsRequestValidationError) ErrorName() string {
-	return "GetModelPartitionsRequestValidationError"
-}
+func (e ModelPartitionValidationError) ErrorName() string { return "ModelPartitionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetModelPartitionsRequestValidationError) Error() string {
+func (e ModelPartitionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7910,14 +8088,14 @@ func (e GetModelPartitionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetModelPartitionsRequest.%s: %s%s",
+		"invalid %sModelPartition.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetModelPartitionsRequestValidationError{}
+var _ error = ModelPartitionValidationError{}
 
 var _ interface {
 	Field() string
@@ -7925,80 +8103,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetModelPartitionsRequestValidationError{}
+} = ModelPartitionValidationError{}
 
-// Validate checks the field values on GetModelPartitionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetModelPartitionsResponse) Validate() error {
+// Validate checks the field values on GetLogsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetLogsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetModelPartitionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetModelPartitionsResponseMultiError, or nil if none found.
-func (m *GetModelPartitionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetLogsRequestMultiError,
+// or nil if none found.
+func (m *GetLogsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetModelPartitionsResponse) validate(all bool) error {
+func (m *GetLogsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPartitions() {
-		_, _ = idx, item
+	// no validation rules for InstanceId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetModelPartitionsResponseValidationError{
-						field:  fmt.Sprintf("Partitions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetModelPartitionsResponseValidationError{
-						field:  fmt.Sprintf("Partitions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetModelPartitionsResponseValidationError{
-					field:  fmt.Sprintf("Partitions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Ascending
 
+	if m.GetLimit() < -1 {
+		err := GetLogsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for NextPageToken
+	// no validation rules for Level
 
 	if len(errors) > 0 {
-		return GetModelPartitionsResponseMultiError(errors)
+		return GetLogsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetModelPartitionsResponseMultiError is an error wrapping multiple
-// validation errors returned by GetModelPartitionsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetModelPartitionsResponseMultiError []error
+// GetLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetLogsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetModelPartitionsResponseMultiError) Error() string {
+func (m GetLogsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8007,11 +8166,11 @@ func (m GetModelPartitionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetModelPartitionsResponseMultiError) AllErrors() []error { return m }
+func (m GetLogsRequestMultiError) AllErrors() []error { return m }
 
-// GetModelPartitionsResponseValidationError is the validation error returned
-// by GetModelPartitionsResponse.Validate if the designated constraints aren't met.
-type GetModelPartitionsResponseValidationError struct {
+// GetLogsRequestValidationError is the validation error returned by
+// GetLogsRequest.Validate if the designated constraints aren't met.
+type GetLogsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8019,24 +8178,22 @@ type GetModelPartitionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetModelPartitionsResponseValidationError) Field() string { return e.field }
+func (e GetLogsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetModelPartitionsResponseValidationError) Reason() string { return e.reason }
+func (e GetLogsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetModelPartitionsResponseValidationError) Cause() error { return e.cause }
+func (e GetLogsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetModelPartitionsResponseValidationError) Key() bool { return e.key }
+func (e GetLogsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetModelPartitionsResponseValidationError) ErrorName() string {
-	return "GetModelPartitionsResponseValidationError"
-}
+func (e GetLogsRequestValidationError) ErrorName() string { return "GetLogsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetModelPartitionsResponseValidationError) Error() string {
+func (e GetLogsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8048,14 +8205,14 @@ func (e GetModelPartitionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetModelPartitionsResponse.%s: %s%s",
+		"invalid %sGetLogsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetModelPartitionsResponseValidationError{}
+var _ error = GetLogsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8063,83 +8220,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetModelPartitionsResponseValidationError{}
+} = GetLogsRequestValidationError{}
 
-// Validate checks the field values on CreateTriggerRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTriggerRequest) Validate() error {
+// Validate checks the field values on GetLogsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetLogsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTriggerRequest with the rules
+// ValidateAll checks the field values on GetLogsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTriggerRequestMultiError, or nil if none found.
-func (m *CreateTriggerRequest) ValidateAll() error {
+// GetLogsResponseMultiError, or nil if none found.
+func (m *GetLogsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTriggerRequest) validate(all bool) error {
+func (m *GetLogsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	for idx, item := range m.GetResources() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateTriggerRequestValidationError{
-					field:  fmt.Sprintf("Resources[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	for idx, item := range m.GetModels() {
+	for idx, item := range m.GetLogs() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Models[%v]", idx),
+					errors = append(errors, GetLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  fmt.Sprintf("Models[%v]", idx),
+					errors = append(errors, GetLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8147,8 +8268,8 @@ func (m *CreateTriggerRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateTriggerRequestValidationError{
-					field:  fmt.Sprintf("Models[%v]", idx),
+				return GetLogsResponseValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -8157,26 +8278,20 @@ func (m *CreateTriggerRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for Parser
-
-	// no validation rules for AllSourcesModels
-
-	// no validation rules for AllSourcesModelsFull
-
 	if len(errors) > 0 {
-		return CreateTriggerRequestMultiError(errors)
+		return GetLogsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTriggerRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateTriggerRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTriggerRequestMultiError []error
+// GetLogsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetLogsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetLogsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTriggerRequestMultiError) Error() string {
+func (m GetLogsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8185,11 +8300,11 @@ func (m CreateTriggerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTriggerRequestMultiError) AllErrors() []error { return m }
+func (m GetLogsResponseMultiError) AllErrors() []error { return m }
 
-// CreateTriggerRequestValidationError is the validation error returned by
-// CreateTriggerRequest.Validate if the designated constraints aren't met.
-type CreateTriggerRequestValidationError struct {
+// GetLogsResponseValidationError is the validation error returned by
+// GetLogsResponse.Validate if the designated constraints aren't met.
+type GetLogsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8197,24 +8312,22 @@ type CreateTriggerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTriggerRequestValidationError) Field() string { return e.field }
+func (e GetLogsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTriggerRequestValidationError) Reason() string { return e.reason }
+func (e GetLogsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTriggerRequestValidationError) Cause() error { return e.cause }
+func (e GetLogsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTriggerRequestValidationError) Key() bool { return e.key }
+func (e GetLogsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTriggerRequestValidationError) ErrorName() string {
-	return "CreateTriggerRequestValidationError"
-}
+func (e GetLogsResponseValidationError) ErrorName() string { return "GetLogsResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateTriggerRequestValidationError) Error() string {
+func (e GetLogsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8226,14 +8339,14 @@ func (e CreateTriggerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTriggerRequest.%s: %s%s",
+		"invalid %sGetLogsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTriggerRequestValidationError{}
+var _ error = GetLogsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8241,44 +8354,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTriggerRequestValidationError{}
+} = GetLogsResponseValidationError{}
 
-// Validate checks the field values on CreateTriggerResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTriggerResponse) Validate() error {
+// Validate checks the field values on WatchLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchLogsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTriggerResponse with the rules
+// ValidateAll checks the field values on WatchLogsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTriggerResponseMultiError, or nil if none found.
-func (m *CreateTriggerResponse) ValidateAll() error {
+// WatchLogsRequestMultiError, or nil if none found.
+func (m *WatchLogsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTriggerResponse) validate(all bool) error {
+func (m *WatchLogsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for InstanceId
+
+	// no validation rules for Replay
+
+	if m.GetReplayLimit() < -1 {
+		err := WatchLogsRequestValidationError{
+			field:  "ReplayLimit",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Level
+
 	if len(errors) > 0 {
-		return CreateTriggerResponseMultiError(errors)
+		return WatchLogsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTriggerResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateTriggerResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTriggerResponseMultiError []error
+// WatchLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchLogsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTriggerResponseMultiError) Error() string {
+func (m WatchLogsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8287,11 +8417,11 @@ func (m CreateTriggerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTriggerResponseMultiError) AllErrors() []error { return m }
+func (m WatchLogsRequestMultiError) AllErrors() []error { return m }
 
-// CreateTriggerResponseValidationError is the validation error returned by
-// CreateTriggerResponse.Validate if the designated constraints aren't met.
-type CreateTriggerResponseValidationError struct {
+// WatchLogsRequestValidationError is the validation error returned by
+// WatchLogsRequest.Validate if the designated constraints aren't met.
+type WatchLogsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8299,24 +8429,22 @@ type CreateTriggerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTriggerResponseValidationError) Field() string { return e.field }
+func (e WatchLogsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTriggerResponseValidationError) Reason() string { return e.reason }
+func (e WatchLogsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTriggerResponseValidationError) Cause() error { return e.cause }
+func (e WatchLogsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTriggerResponseValidationError) Key() bool { return e.key }
+func (e WatchLogsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTriggerResponseValidationError) ErrorName() string {
-	return "CreateTriggerResponseValidationError"
-}
+func (e WatchLogsRequestValidationError) ErrorName() string { return "WatchLogsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateTriggerResponseValidationError) Error() string {
+func (e WatchLogsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8328,14 +8456,14 @@ func (e CreateTriggerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTriggerResponse.%s: %s%s",
+		"invalid %sWatchLogsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTriggerResponseValidationError{}
+var _ error = WatchLogsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8343,90 +8471,2647 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTriggerResponseValidationError{}
+} = WatchLogsRequestValidationError{}
 
-// Validate checks the field values on ConnectorDriver with the rules defined
+// Validate checks the field values on WatchLogsResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ConnectorDriver) Validate() error {
+func (m *WatchLogsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectorDriver with the rules
+// ValidateAll checks the field values on WatchLogsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ConnectorDriverMultiError, or nil if none found.
-func (m *ConnectorDriver) ValidateAll() error {
+// WatchLogsResponseMultiError, or nil if none found.
+func (m *WatchLogsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectorDriver) validate(all bool) error {
+func (m *WatchLogsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	for idx, item := range m.GetConfigProperties() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetLog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchLogsResponseValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ConnectorDriverValidationError{
-					field:  fmt.Sprintf("ConfigProperties[%v]", idx),
+				errors = append(errors, WatchLogsResponseValidationError{
+					field:  "Log",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchLogsResponseValidationError{
+				field:  "Log",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return WatchLogsResponseMultiError(errors)
 	}
 
-	for idx, item := range m.GetSourceProperties() {
-		_, _ = idx, item
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("SourceProperties[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("SourceProperties[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
+// WatchLogsResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchLogsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchLogsResponseMultiError) AllErrors() []error { return m }
+
+// WatchLogsResponseValidationError is the validation error returned by
+// WatchLogsResponse.Validate if the designated constraints aren't met.
+type WatchLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchLogsResponseValidationError) ErrorName() string {
+	return "WatchLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchLogsResponseValidationError{}
+
+// Validate checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesRequestMultiError, or nil if none found.
+func (m *ListResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Kind
+
+	// no validation rules for Path
+
+	// no validation rules for SkipSecurityChecks
+
+	if len(errors) > 0 {
+		return ListResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListResourcesRequestValidationError is the validation error returned by
+// ListResourcesRequest.Validate if the designated constraints aren't met.
+type ListResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesRequestValidationError) ErrorName() string {
+	return "ListResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesRequestValidationError{}
+
+// Validate checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesResponseMultiError, or nil if none found.
+func (m *ListResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+
+// ListResourcesResponseValidationError is the validation error returned by
+// ListResourcesResponse.Validate if the designated constraints aren't met.
+type ListResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesResponseValidationError) ErrorName() string {
+	return "ListResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesResponseValidationError{}
+
+// Validate checks the field values on WatchResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchResourcesRequestMultiError, or nil if none found.
+func (m *WatchResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Kind
+
+	// no validation rules for Replay
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return WatchResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchResourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchResourcesRequestMultiError) AllErrors() []error { return m }
+
+// WatchResourcesRequestValidationError is the validation error returned by
+// WatchResourcesRequest.Validate if the designated constraints aren't met.
+type WatchResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchResourcesRequestValidationError) ErrorName() string {
+	return "WatchResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchResourcesRequestValidationError{}
+
+// Validate checks the field values on WatchResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchResourcesResponseMultiError, or nil if none found.
+func (m *WatchResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Event
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchResourcesResponseValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchResourcesResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchResourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchResourcesResponseMultiError) AllErrors() []error { return m }
+
+// WatchResourcesResponseValidationError is the validation error returned by
+// WatchResourcesResponse.Validate if the designated constraints aren't met.
+type WatchResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchResourcesResponseValidationError) ErrorName() string {
+	return "WatchResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchResourcesResponseValidationError{}
+
+// Validate checks the field values on GetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceRequestMultiError, or nil if none found.
+func (m *GetResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResourceRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResourceRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResourceRequestValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SkipSecurityChecks
+
+	if len(errors) > 0 {
+		return GetResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetResourceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceRequestMultiError) AllErrors() []error { return m }
+
+// GetResourceRequestValidationError is the validation error returned by
+// GetResourceRequest.Validate if the designated constraints aren't met.
+type GetResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceRequestValidationError) ErrorName() string {
+	return "GetResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceRequestValidationError{}
+
+// Validate checks the field values on GetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceResponseMultiError, or nil if none found.
+func (m *GetResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceResponseMultiError) AllErrors() []error { return m }
+
+// GetResourceResponseValidationError is the validation error returned by
+// GetResourceResponse.Validate if the designated constraints aren't met.
+type GetResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceResponseValidationError) ErrorName() string {
+	return "GetResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceResponseValidationError{}
+
+// Validate checks the field values on GetResourceLineageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceLineageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceLineageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceLineageRequestMultiError, or nil if none found.
+func (m *GetResourceLineageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceLineageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResourceLineageRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResourceLineageRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResourceLineageRequestValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Columns
+
+	if len(errors) > 0 {
+		return GetResourceLineageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceLineageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetResourceLineageRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetResourceLineageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceLineageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceLineageRequestMultiError) AllErrors() []error { return m }
+
+// GetResourceLineageRequestValidationError is the validation error returned by
+// GetResourceLineageRequest.Validate if the designated constraints aren't met.
+type GetResourceLineageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceLineageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceLineageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceLineageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceLineageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceLineageRequestValidationError) ErrorName() string {
+	return "GetResourceLineageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceLineageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceLineageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceLineageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceLineageRequestValidationError{}
+
+// Validate checks the field values on GetResourceLineageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceLineageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceLineageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceLineageResponseMultiError, or nil if none found.
+func (m *GetResourceLineageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceLineageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUpstream() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Upstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Upstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetResourceLineageResponseValidationError{
+					field:  fmt.Sprintf("Upstream[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDownstream() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Downstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Downstream[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetResourceLineageResponseValidationError{
+					field:  fmt.Sprintf("Downstream[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEdges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetResourceLineageResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetResourceLineageResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetResourceLineageResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetResourceLineageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceLineageResponseMultiError is an error wrapping multiple
+// validation errors returned by GetResourceLineageResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetResourceLineageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceLineageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceLineageResponseMultiError) AllErrors() []error { return m }
+
+// GetResourceLineageResponseValidationError is the validation error returned
+// by GetResourceLineageResponse.Validate if the designated constraints aren't met.
+type GetResourceLineageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceLineageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceLineageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceLineageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceLineageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceLineageResponseValidationError) ErrorName() string {
+	return "GetResourceLineageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceLineageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceLineageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceLineageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceLineageResponseValidationError{}
+
+// Validate checks the field values on ResourceLineageEdge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResourceLineageEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceLineageEdge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceLineageEdgeMultiError, or nil if none found.
+func (m *ResourceLineageEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceLineageEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceLineageEdgeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceLineageEdgeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceLineageEdgeValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceLineageEdgeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceLineageEdgeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceLineageEdgeValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResourceLineageEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceLineageEdgeMultiError is an error wrapping multiple validation
+// errors returned by ResourceLineageEdge.ValidateAll() if the designated
+// constraints aren't met.
+type ResourceLineageEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceLineageEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceLineageEdgeMultiError) AllErrors() []error { return m }
+
+// ResourceLineageEdgeValidationError is the validation error returned by
+// ResourceLineageEdge.Validate if the designated constraints aren't met.
+type ResourceLineageEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceLineageEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceLineageEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceLineageEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceLineageEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceLineageEdgeValidationError) ErrorName() string {
+	return "ResourceLineageEdgeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceLineageEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceLineageEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceLineageEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceLineageEdgeValidationError{}
+
+// Validate checks the field values on ColumnLineage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnLineage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnLineage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnLineageMultiError, or
+// nil if none found.
+func (m *ColumnLineage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnLineage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnLineageValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnLineageValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnLineageValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Column
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnLineageValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnLineageValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnLineageValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ColumnLineageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnLineageMultiError is an error wrapping multiple validation errors
+// returned by ColumnLineage.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnLineageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnLineageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnLineageMultiError) AllErrors() []error { return m }
+
+// ColumnLineageValidationError is the validation error returned by
+// ColumnLineage.Validate if the designated constraints aren't met.
+type ColumnLineageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnLineageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnLineageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnLineageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnLineageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnLineageValidationError) ErrorName() string { return "ColumnLineageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnLineageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnLineage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnLineageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnLineageValidationError{}
+
+// Validate checks the field values on ColumnLineageSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnLineageSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnLineageSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnLineageSourceMultiError, or nil if none found.
+func (m *ColumnLineageSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnLineageSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnLineageSourceValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnLineageSourceValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnLineageSourceValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return ColumnLineageSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnLineageSourceMultiError is an error wrapping multiple validation
+// errors returned by ColumnLineageSource.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnLineageSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnLineageSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnLineageSourceMultiError) AllErrors() []error { return m }
+
+// ColumnLineageSourceValidationError is the validation error returned by
+// ColumnLineageSource.Validate if the designated constraints aren't met.
+type ColumnLineageSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnLineageSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnLineageSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnLineageSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnLineageSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnLineageSourceValidationError) ErrorName() string {
+	return "ColumnLineageSourceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnLineageSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnLineageSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnLineageSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnLineageSourceValidationError{}
+
+// Validate checks the field values on GetExploreRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetExploreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExploreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExploreRequestMultiError, or nil if none found.
+func (m *GetExploreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExploreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetExploreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExploreRequestMultiError is an error wrapping multiple validation errors
+// returned by GetExploreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetExploreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExploreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExploreRequestMultiError) AllErrors() []error { return m }
+
+// GetExploreRequestValidationError is the validation error returned by
+// GetExploreRequest.Validate if the designated constraints aren't met.
+type GetExploreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExploreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExploreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExploreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExploreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExploreRequestValidationError) ErrorName() string {
+	return "GetExploreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExploreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExploreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExploreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExploreRequestValidationError{}
+
+// Validate checks the field values on GetExploreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExploreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExploreResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExploreResponseMultiError, or nil if none found.
+func (m *GetExploreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExploreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExplore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExploreResponseValidationError{
+					field:  "Explore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExploreResponseValidationError{
+					field:  "Explore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExplore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExploreResponseValidationError{
+				field:  "Explore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetricsView()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExploreResponseValidationError{
+					field:  "MetricsView",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExploreResponseValidationError{
+					field:  "MetricsView",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsView()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExploreResponseValidationError{
+				field:  "MetricsView",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetExploreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExploreResponseMultiError is an error wrapping multiple validation errors
+// returned by GetExploreResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetExploreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExploreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExploreResponseMultiError) AllErrors() []error { return m }
+
+// GetExploreResponseValidationError is the validation error returned by
+// GetExploreResponse.Validate if the designated constraints aren't met.
+type GetExploreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExploreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExploreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExploreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExploreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExploreResponseValidationError) ErrorName() string {
+	return "GetExploreResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExploreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExploreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExploreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExploreResponseValidationError{}
+
+// Validate checks the field values on GetModelPartitionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetModelPartitionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModelPartitionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetModelPartitionsRequestMultiError, or nil if none found.
+func (m *GetModelPartitionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModelPartitionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Model
+
+	// no validation rules for Pending
+
+	// no validation rules for Errored
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 10000 {
+			err := GetModelPartitionsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 10000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetModelPartitionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModelPartitionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetModelPartitionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetModelPartitionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModelPartitionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModelPartitionsRequestMultiError) AllErrors() []error { return m }
+
+// GetModelPartitionsRequestValidationError is the validation error returned by
+// GetModelPartitionsRequest.Validate if the designated constraints aren't met.
+type GetModelPartitionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModelPartitionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModelPartitionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModelPartitionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModelPartitionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModelPartitionsRequestValidationError) ErrorName() string {
+	return "GetModelPartitionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetModelPartitionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModelPartitionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModelPartitionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModelPartitionsRequestValidationError{}
+
+// Validate checks the field values on GetModelPartitionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetModelPartitionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModelPartitionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetModelPartitionsResponseMultiError, or nil if none found.
+func (m *GetModelPartitionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModelPartitionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPartitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetModelPartitionsResponseValidationError{
+						field:  fmt.Sprintf("Partitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetModelPartitionsResponseValidationError{
+						field:  fmt.Sprintf("Partitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetModelPartitionsResponseValidationError{
+					field:  fmt.Sprintf("Partitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetModelPartitionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModelPartitionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetModelPartitionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetModelPartitionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModelPartitionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModelPartitionsResponseMultiError) AllErrors() []error { return m }
+
+// GetModelPartitionsResponseValidationError is the validation error returned
+// by GetModelPartitionsResponse.Validate if the designated constraints aren't met.
+type GetModelPartitionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModelPartitionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModelPartitionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModelPartitionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModelPartitionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModelPartitionsResponseValidationError) ErrorName() string {
+	return "GetModelPartitionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetModelPartitionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModelPartitionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModelPartitionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModelPartitionsResponseValidationError{}
+
+// Validate checks the field values on CreateTriggerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTriggerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTriggerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTriggerRequestMultiError, or nil if none found.
+func (m *CreateTriggerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTriggerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTriggerRequestValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetModels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTriggerRequestValidationError{
+					field:  fmt.Sprintf("Models[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Parser
+
+	// no validation rules for AllSourcesModels
+
+	// no validation rules for AllSourcesModelsFull
+
+	if len(errors) > 0 {
+		return CreateTriggerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTriggerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTriggerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTriggerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTriggerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTriggerRequestMultiError) AllErrors() []error { return m }
+
+// CreateTriggerRequestValidationError is the validation error returned by
+// CreateTriggerRequest.Validate if the designated constraints aren't met.
+type CreateTriggerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTriggerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTriggerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTriggerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTriggerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTriggerRequestValidationError) ErrorName() string {
+	return "CreateTriggerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTriggerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTriggerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTriggerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTriggerRequestValidationError{}
+
+// Validate checks the field values on CreateTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTriggerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTriggerResponseMultiError, or nil if none found.
+func (m *CreateTriggerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTriggerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateTriggerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTriggerResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTriggerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTriggerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTriggerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTriggerResponseMultiError) AllErrors() []error { return m }
+
+// CreateTriggerResponseValidationError is the validation error returned by
+// CreateTriggerResponse.Validate if the designated constraints aren't met.
+type CreateTriggerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTriggerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTriggerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTriggerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTriggerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTriggerResponseValidationError) ErrorName() string {
+	return "CreateTriggerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTriggerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTriggerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTriggerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTriggerResponseValidationError{}
+
+// Validate checks the field values on ConnectorDriver with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConnectorDriver) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectorDriver with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectorDriverMultiError, or nil if none found.
+func (m *ConnectorDriver) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectorDriver) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetConfigProperties() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectorDriverValidationError{
+					field:  fmt.Sprintf("ConfigProperties[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSourceProperties() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("SourceProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("SourceProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
 				return ConnectorDriverValidationError{
 					field:  fmt.Sprintf("SourceProperties[%v]", idx),
 					reason: "embedded message failed validation",
@@ -8437,46 +11122,598 @@ func (m *ConnectorDriver) validate(all bool) error {
 
 	}
 
-	// no validation rules for DisplayName
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for ImplementsRegistry
+
+	// no validation rules for ImplementsCatalog
+
+	// no validation rules for ImplementsRepo
+
+	// no validation rules for ImplementsAdmin
+
+	// no validation rules for ImplementsAi
+
+	// no validation rules for ImplementsSqlStore
+
+	// no validation rules for ImplementsOlap
+
+	// no validation rules for ImplementsObjectStore
+
+	// no validation rules for ImplementsFileStore
+
+	// no validation rules for ImplementsNotifier
+
+	// no validation rules for ImplementsWarehouse
+
+	if len(errors) > 0 {
+		return ConnectorDriverMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectorDriverMultiError is an error wrapping multiple validation errors
+// returned by ConnectorDriver.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectorDriverMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectorDriverMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectorDriverMultiError) AllErrors() []error { return m }
+
+// ConnectorDriverValidationError is the validation error returned by
+// ConnectorDriver.Validate if the designated constraints aren't met.
+type ConnectorDriverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectorDriverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectorDriverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectorDriverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectorDriverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectorDriverValidationError) ErrorName() string { return "ConnectorDriverValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectorDriverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectorDriver.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectorDriverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectorDriverValidationError{}
+
+// Validate checks the field values on AnalyzedConnector with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzedConnector) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzedConnector with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzedConnectorMultiError, or nil if none found.
+func (m *AnalyzedConnector) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzedConnector) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetDriver()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnalyzedConnectorValidationError{
+					field:  "Driver",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnalyzedConnectorValidationError{
+					field:  "Driver",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDriver()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnalyzedConnectorValidationError{
+				field:  "Driver",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Config
+
+	// no validation rules for PresetConfig
+
+	// no validation rules for ProjectConfig
+
+	// no validation rules for EnvConfig
+
+	// no validation rules for Provision
+
+	if all {
+		switch v := interface{}(m.GetProvisionArgs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnalyzedConnectorValidationError{
+					field:  "ProvisionArgs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnalyzedConnectorValidationError{
+					field:  "ProvisionArgs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProvisionArgs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnalyzedConnectorValidationError{
+				field:  "ProvisionArgs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HasAnonymousAccess
+
+	for idx, item := range m.GetUsedBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzedConnectorValidationError{
+						field:  fmt.Sprintf("UsedBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzedConnectorValidationError{
+						field:  fmt.Sprintf("UsedBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzedConnectorValidationError{
+					field:  fmt.Sprintf("UsedBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return AnalyzedConnectorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzedConnectorMultiError is an error wrapping multiple validation errors
+// returned by AnalyzedConnector.ValidateAll() if the designated constraints
+// aren't met.
+type AnalyzedConnectorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzedConnectorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzedConnectorMultiError) AllErrors() []error { return m }
+
+// AnalyzedConnectorValidationError is the validation error returned by
+// AnalyzedConnector.Validate if the designated constraints aren't met.
+type AnalyzedConnectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzedConnectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzedConnectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzedConnectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzedConnectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzedConnectorValidationError) ErrorName() string {
+	return "AnalyzedConnectorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalyzedConnectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzedConnector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzedConnectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzedConnectorValidationError{}
+
+// Validate checks the field values on ListConnectorDriversRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConnectorDriversRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConnectorDriversRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConnectorDriversRequestMultiError, or nil if none found.
+func (m *ListConnectorDriversRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConnectorDriversRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListConnectorDriversRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConnectorDriversRequestMultiError is an error wrapping multiple
+// validation errors returned by ListConnectorDriversRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListConnectorDriversRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConnectorDriversRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConnectorDriversRequestMultiError) AllErrors() []error { return m }
+
+// ListConnectorDriversRequestValidationError is the validation error returned
+// by ListConnectorDriversRequest.Validate if the designated constraints
+// aren't met.
+type ListConnectorDriversRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConnectorDriversRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConnectorDriversRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConnectorDriversRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConnectorDriversRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConnectorDriversRequestValidationError) ErrorName() string {
+	return "ListConnectorDriversRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConnectorDriversRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConnectorDriversRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConnectorDriversRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConnectorDriversRequestValidationError{}
+
+// Validate checks the field values on ListConnectorDriversResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConnectorDriversResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConnectorDriversResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConnectorDriversResponseMultiError, or nil if none found.
+func (m *ListConnectorDriversResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConnectorDriversResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConnectors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConnectorDriversResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConnectorDriversResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConnectorDriversResponseValidationError{
+					field:  fmt.Sprintf("Connectors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListConnectorDriversResponseMultiError(errors)
+	}
 
-	// no validation rules for Description
+	return nil
+}
 
-	// no validation rules for ImplementsRegistry
+// ListConnectorDriversResponseMultiError is an error wrapping multiple
+// validation errors returned by ListConnectorDriversResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListConnectorDriversResponseMultiError []error
 
-	// no validation rules for ImplementsCatalog
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConnectorDriversResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for ImplementsRepo
+// AllErrors returns a list of validation violation errors.
+func (m ListConnectorDriversResponseMultiError) AllErrors() []error { return m }
 
-	// no validation rules for ImplementsAdmin
+// ListConnectorDriversResponseValidationError is the validation error returned
+// by ListConnectorDriversResponse.Validate if the designated constraints
+// aren't met.
+type ListConnectorDriversResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for ImplementsAi
+// Field function returns field value.
+func (e ListConnectorDriversResponseValidationError) Field() string { return e.field }
 
-	// no validation rules for ImplementsSqlStore
+// Reason function returns reason value.
+func (e ListConnectorDriversResponseValidationError) Reason() string { return e.reason }
 
-	// no validation rules for ImplementsOlap
+// Cause function returns cause value.
+func (e ListConnectorDriversResponseValidationError) Cause() error { return e.cause }
 
-	// no validation rules for ImplementsObjectStore
+// Key function returns key value.
+func (e ListConnectorDriversResponseValidationError) Key() bool { return e.key }
 
-	// no validation rules for ImplementsFileStore
+// ErrorName returns error name.
+func (e ListConnectorDriversResponseValidationError) ErrorName() string {
+	return "ListConnectorDriversResponseValidationError"
+}
 
-	// no validation rules for ImplementsNotifier
+// Error satisfies the builtin error interface
+func (e ListConnectorDriversResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for ImplementsWarehouse
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConnectorDriversResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConnectorDriversResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConnectorDriversResponseValidationError{}
+
+// Validate checks the field values on AnalyzeConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeConnectorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeConnectorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzeConnectorsRequestMultiError, or nil if none found.
+func (m *AnalyzeConnectorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeConnectorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
 
 	if len(errors) > 0 {
-		return ConnectorDriverMultiError(errors)
+		return AnalyzeConnectorsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectorDriverMultiError is an error wrapping multiple validation errors
-// returned by ConnectorDriver.ValidateAll() if the designated constraints
-// aren't met.
-type ConnectorDriverMultiError []error
+// AnalyzeConnectorsRequestMultiError is an error wrapping multiple validation
+// errors returned by AnalyzeConnectorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AnalyzeConnectorsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectorDriverMultiError) Error() string {
+func (m AnalyzeConnectorsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8485,11 +11722,11 @@ func (m ConnectorDriverMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectorDriverMultiError) AllErrors() []error { return m }
+func (m AnalyzeConnectorsRequestMultiError) AllErrors() []error { return m }
 
-// ConnectorDriverValidationError is the validation error returned by
-// ConnectorDriver.Validate if the designated constraints aren't met.
-type ConnectorDriverValidationError struct {
+// AnalyzeConnectorsRequestValidationError is the validation error returned by
+// AnalyzeConnectorsRequest.Validate if the designated constraints aren't met.
+type AnalyzeConnectorsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8497,22 +11734,24 @@ type ConnectorDriverValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectorDriverValidationError) Field() string { return e.field }
+func (e AnalyzeConnectorsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectorDriverValidationError) Reason() string { return e.reason }
+func (e AnalyzeConnectorsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectorDriverValidationError) Cause() error { return e.cause }
+func (e AnalyzeConnectorsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectorDriverValidationError) Key() bool { return e.key }
+func (e AnalyzeConnectorsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectorDriverValidationError) ErrorName() string { return "ConnectorDriverValidationError" }
+func (e AnalyzeConnectorsRequestValidationError) ErrorName() string {
+	return "AnalyzeConnectorsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConnectorDriverValidationError) Error() string {
+func (e AnalyzeConnectorsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8524,14 +11763,14 @@ func (e ConnectorDriverValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectorDriver.%s: %s%s",
+		"invalid %sAnalyzeConnectorsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectorDriverValidationError{}
+var _ error = AnalyzeConnectorsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8539,119 +11778,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectorDriverValidationError{}
+} = AnalyzeConnectorsRequestValidationError{}
 
-// Validate checks the field values on AnalyzedConnector with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AnalyzedConnector) Validate() error {
+// Validate checks the field values on AnalyzeConnectorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeConnectorsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AnalyzedConnector with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AnalyzeConnectorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AnalyzedConnectorMultiError, or nil if none found.
-func (m *AnalyzedConnector) ValidateAll() error {
+// AnalyzeConnectorsResponseMultiError, or nil if none found.
+func (m *AnalyzeConnectorsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AnalyzedConnector) validate(all bool) error {
+func (m *AnalyzeConnectorsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	if all {
-		switch v := interface{}(m.GetDriver()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AnalyzedConnectorValidationError{
-					field:  "Driver",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AnalyzedConnectorValidationError{
-					field:  "Driver",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDriver()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AnalyzedConnectorValidationError{
-				field:  "Driver",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Config
-
-	// no validation rules for PresetConfig
-
-	// no validation rules for ProjectConfig
-
-	// no validation rules for EnvConfig
-
-	// no validation rules for Provision
-
-	if all {
-		switch v := interface{}(m.GetProvisionArgs()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AnalyzedConnectorValidationError{
-					field:  "ProvisionArgs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AnalyzedConnectorValidationError{
-					field:  "ProvisionArgs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProvisionArgs()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AnalyzedConnectorValidationError{
-				field:  "ProvisionArgs",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for HasAnonymousAccess
-
-	for idx, item := range m.GetUsedBy() {
+	for idx, item := range m.GetConnectors() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AnalyzedConnectorValidationError{
-						field:  fmt.Sprintf("UsedBy[%v]", idx),
+					errors = append(errors, AnalyzeConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AnalyzedConnectorValidationError{
-						field:  fmt.Sprintf("UsedBy[%v]", idx),
+					errors = append(errors, AnalyzeConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8659,8 +11826,8 @@ func (m *AnalyzedConnector) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AnalyzedConnectorValidationError{
-					field:  fmt.Sprintf("UsedBy[%v]", idx),
+				return AnalyzeConnectorsResponseValidationError{
+					field:  fmt.Sprintf("Connectors[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -8669,22 +11836,20 @@ func (m *AnalyzedConnector) validate(all bool) error {
 
 	}
 
-	// no validation rules for ErrorMessage
-
 	if len(errors) > 0 {
-		return AnalyzedConnectorMultiError(errors)
+		return AnalyzeConnectorsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AnalyzedConnectorMultiError is an error wrapping multiple validation errors
-// returned by AnalyzedConnector.ValidateAll() if the designated constraints
-// aren't met.
-type AnalyzedConnectorMultiError []error
+// AnalyzeConnectorsResponseMultiError is an error wrapping multiple validation
+// errors returned by AnalyzeConnectorsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AnalyzeConnectorsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AnalyzedConnectorMultiError) Error() string {
+func (m AnalyzeConnectorsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8693,11 +11858,11 @@ func (m AnalyzedConnectorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AnalyzedConnectorMultiError) AllErrors() []error { return m }
+func (m AnalyzeConnectorsResponseMultiError) AllErrors() []error { return m }
 
-// AnalyzedConnectorValidationError is the validation error returned by
-// AnalyzedConnector.Validate if the designated constraints aren't met.
-type AnalyzedConnectorValidationError struct {
+// AnalyzeConnectorsResponseValidationError is the validation error returned by
+// AnalyzeConnectorsResponse.Validate if the designated constraints aren't met.
+type AnalyzeConnectorsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8705,24 +11870,24 @@ type AnalyzedConnectorValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnalyzedConnectorValidationError) Field() string { return e.field }
+func (e AnalyzeConnectorsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnalyzedConnectorValidationError) Reason() string { return e.reason }
+func (e AnalyzeConnectorsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnalyzedConnectorValidationError) Cause() error { return e.cause }
+func (e AnalyzeConnectorsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnalyzedConnectorValidationError) Key() bool { return e.key }
+func (e AnalyzeConnectorsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnalyzedConnectorValidationError) ErrorName() string {
-	return "AnalyzedConnectorValidationError"
+func (e AnalyzeConnectorsResponseValidationError) ErrorName() string {
+	return "AnalyzeConnectorsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AnalyzedConnectorValidationError) Error() string {
+func (e AnalyzeConnectorsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8734,14 +11899,14 @@ func (e AnalyzedConnectorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnalyzedConnector.%s: %s%s",
+		"invalid %sAnalyzeConnectorsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnalyzedConnectorValidationError{}
+var _ error = AnalyzeConnectorsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8749,44 +11914,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnalyzedConnectorValidationError{}
+} = AnalyzeConnectorsResponseValidationError{}
 
-// Validate checks the field values on ListConnectorDriversRequest with the
+// Validate checks the field values on ListNotifierConnectorsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConnectorDriversRequest) Validate() error {
+func (m *ListNotifierConnectorsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConnectorDriversRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListConnectorDriversRequestMultiError, or nil if none found.
-func (m *ListConnectorDriversRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListNotifierConnectorsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListNotifierConnectorsRequestMultiError, or nil if none found.
+func (m *ListNotifierConnectorsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConnectorDriversRequest) validate(all bool) error {
+func (m *ListNotifierConnectorsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for InstanceId
+
 	if len(errors) > 0 {
-		return ListConnectorDriversRequestMultiError(errors)
+		return ListNotifierConnectorsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConnectorDriversRequestMultiError is an error wrapping multiple
-// validation errors returned by ListConnectorDriversRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListConnectorDriversRequestMultiError []error
+// ListNotifierConnectorsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListNotifierConnectorsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListNotifierConnectorsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConnectorDriversRequestMultiError) Error() string {
+func (m ListNotifierConnectorsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8795,12 +11962,12 @@ func (m ListConnectorDriversRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConnectorDriversRequestMultiError) AllErrors() []error { return m }
+func (m ListNotifierConnectorsRequestMultiError) AllErrors() []error { return m }
 
-// ListConnectorDriversRequestValidationError is the validation error returned
-// by ListConnectorDriversRequest.Validate if the designated constraints
-// aren't met.
-type ListConnectorDriversRequestValidationError struct {
+// ListNotifierConnectorsRequestValidationError is the validation error
+// returned by ListNotifierConnectorsRequest.Validate if the designated
+// constraints aren't met.
+type ListNotifierConnectorsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8808,24 +11975,24 @@ type ListConnectorDriversRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConnectorDriversRequestValidationError) Field() string { return e.field }
+func (e ListNotifierConnectorsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConnectorDriversRequestValidationError) Reason() string { return e.reason }
+func (e ListNotifierConnectorsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConnectorDriversRequestValidationError) Cause() error { return e.cause }
+func (e ListNotifierConnectorsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConnectorDriversRequestValidationError) Key() bool { return e.key }
+func (e ListNotifierConnectorsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConnectorDriversRequestValidationError) ErrorName() string {
-	return "ListConnectorDriversRequestValidationError"
+func (e ListNotifierConnectorsRequestValidationError) ErrorName() string {
+	return "ListNotifierConnectorsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListConnectorDriversRequestValidationError) Error() string {
+func (e ListNotifierConnectorsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8837,14 +12004,14 @@ func (e ListConnectorDriversRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConnectorDriversRequest.%s: %s%s",
+		"invalid %sListNotifierConnectorsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConnectorDriversRequestValidationError{}
+var _ error = ListNotifierConnectorsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8852,24 +12019,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConnectorDriversRequestValidationError{}
+} = ListNotifierConnectorsRequestValidationError{}
 
-// Validate checks the field values on ListConnectorDriversResponse with the
+// Validate checks the field values on ListNotifierConnectorsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConnectorDriversResponse) Validate() error {
+func (m *ListNotifierConnectorsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConnectorDriversResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListConnectorDriversResponseMultiError, or nil if none found.
-func (m *ListConnectorDriversResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListNotifierConnectorsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListNotifierConnectorsResponseMultiError, or nil if none found.
+func (m *ListNotifierConnectorsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConnectorDriversResponse) validate(all bool) error {
+func (m *ListNotifierConnectorsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8883,7 +12050,7 @@ func (m *ListConnectorDriversResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListConnectorDriversResponseValidationError{
+					errors = append(errors, ListNotifierConnectorsResponseValidationError{
 						field:  fmt.Sprintf("Connectors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -8891,39 +12058,195 @@ func (m *ListConnectorDriversResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListConnectorDriversResponseValidationError{
+					errors = append(errors, ListNotifierConnectorsResponseValidationError{
 						field:  fmt.Sprintf("Connectors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotifierConnectorsResponseValidationError{
+					field:  fmt.Sprintf("Connectors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNotifierConnectorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotifierConnectorsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListNotifierConnectorsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListNotifierConnectorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotifierConnectorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotifierConnectorsResponseMultiError) AllErrors() []error { return m }
+
+// ListNotifierConnectorsResponseValidationError is the validation error
+// returned by ListNotifierConnectorsResponse.Validate if the designated
+// constraints aren't met.
+type ListNotifierConnectorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotifierConnectorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotifierConnectorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotifierConnectorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotifierConnectorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotifierConnectorsResponseValidationError) ErrorName() string {
+	return "ListNotifierConnectorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotifierConnectorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotifierConnectorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotifierConnectorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotifierConnectorsResponseValidationError{}
+
+// Validate checks the field values on IssueDevJWTRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueDevJWTRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueDevJWTRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueDevJWTRequestMultiError, or nil if none found.
+func (m *IssueDevJWTRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueDevJWTRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := IssueDevJWTRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmail()) < 1 {
+		err := IssueDevJWTRequestValidationError{
+			field:  "Email",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Admin
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueDevJWTRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListConnectorDriversResponseValidationError{
-					field:  fmt.Sprintf("Connectors[%v]", idx),
+				errors = append(errors, IssueDevJWTRequestValidationError{
+					field:  "Attributes",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueDevJWTRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListConnectorDriversResponseMultiError(errors)
+		return IssueDevJWTRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConnectorDriversResponseMultiError is an error wrapping multiple
-// validation errors returned by ListConnectorDriversResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListConnectorDriversResponseMultiError []error
+// IssueDevJWTRequestMultiError is an error wrapping multiple validation errors
+// returned by IssueDevJWTRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IssueDevJWTRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConnectorDriversResponseMultiError) Error() string {
+func (m IssueDevJWTRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8932,12 +12255,11 @@ func (m ListConnectorDriversResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConnectorDriversResponseMultiError) AllErrors() []error { return m }
+func (m IssueDevJWTRequestMultiError) AllErrors() []error { return m }
 
-// ListConnectorDriversResponseValidationError is the validation error returned
-// by ListConnectorDriversResponse.Validate if the designated constraints
-// aren't met.
-type ListConnectorDriversResponseValidationError struct {
+// IssueDevJWTRequestValidationError is the validation error returned by
+// IssueDevJWTRequest.Validate if the designated constraints aren't met.
+type IssueDevJWTRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8945,24 +12267,24 @@ type ListConnectorDriversResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConnectorDriversResponseValidationError) Field() string { return e.field }
+func (e IssueDevJWTRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConnectorDriversResponseValidationError) Reason() string { return e.reason }
+func (e IssueDevJWTRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConnectorDriversResponseValidationError) Cause() error { return e.cause }
+func (e IssueDevJWTRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConnectorDriversResponseValidationError) Key() bool { return e.key }
+func (e IssueDevJWTRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConnectorDriversResponseValidationError) ErrorName() string {
-	return "ListConnectorDriversResponseValidationError"
+func (e IssueDevJWTRequestValidationError) ErrorName() string {
+	return "IssueDevJWTRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListConnectorDriversResponseValidationError) Error() string {
+func (e IssueDevJWTRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8974,14 +12296,14 @@ func (e ListConnectorDriversResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConnectorDriversResponse.%s: %s%s",
+		"invalid %sIssueDevJWTRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConnectorDriversResponseValidationError{}
+var _ error = IssueDevJWTRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8989,46 +12311,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConnectorDriversResponseValidationError{}
+} = IssueDevJWTRequestValidationError{}
 
-// Validate checks the field values on AnalyzeConnectorsRequest with the rules
+// Validate checks the field values on IssueDevJWTResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AnalyzeConnectorsRequest) Validate() error {
+func (m *IssueDevJWTResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AnalyzeConnectorsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueDevJWTResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AnalyzeConnectorsRequestMultiError, or nil if none found.
-func (m *AnalyzeConnectorsRequest) ValidateAll() error {
+// IssueDevJWTResponseMultiError, or nil if none found.
+func (m *IssueDevJWTResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AnalyzeConnectorsRequest) validate(all bool) error {
+func (m *IssueDevJWTResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for Jwt
 
 	if len(errors) > 0 {
-		return AnalyzeConnectorsRequestMultiError(errors)
+		return IssueDevJWTResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AnalyzeConnectorsRequestMultiError is an error wrapping multiple validation
-// errors returned by AnalyzeConnectorsRequest.ValidateAll() if the designated
+// IssueDevJWTResponseMultiError is an error wrapping multiple validation
+// errors returned by IssueDevJWTResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AnalyzeConnectorsRequestMultiError []error
+type IssueDevJWTResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AnalyzeConnectorsRequestMultiError) Error() string {
+func (m IssueDevJWTResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9037,11 +12359,11 @@ func (m AnalyzeConnectorsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AnalyzeConnectorsRequestMultiError) AllErrors() []error { return m }
+func (m IssueDevJWTResponseMultiError) AllErrors() []error { return m }
 
-// AnalyzeConnectorsRequestValidationError is the validation error returned by
-// AnalyzeConnectorsRequest.Validate if the designated constraints aren't met.
-type AnalyzeConnectorsRequestValidationError struct {
+// IssueDevJWTResponseValidationError is the validation error returned by
+// IssueDevJWTResponse.Validate if the designated constraints aren't met.
+type IssueDevJWTResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9049,24 +12371,24 @@ type AnalyzeConnectorsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnalyzeConnectorsRequestValidationError) Field() string { return e.field }
+func (e IssueDevJWTResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnalyzeConnectorsRequestValidationError) Reason() string { return e.reason }
+func (e IssueDevJWTResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnalyzeConnectorsRequestValidationError) Cause() error { return e.cause }
+func (e IssueDevJWTResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnalyzeConnectorsRequestValidationError) Key() bool { return e.key }
+func (e IssueDevJWTResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnalyzeConnectorsRequestValidationError) ErrorName() string {
-	return "AnalyzeConnectorsRequestValidationError"
+func (e IssueDevJWTResponseValidationError) ErrorName() string {
+	return "IssueDevJWTResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AnalyzeConnectorsRequestValidationError) Error() string {
+func (e IssueDevJWTResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9078,14 +12400,14 @@ func (e AnalyzeConnectorsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnalyzeConnectorsRequest.%s: %s%s",
+		"invalid %sIssueDevJWTResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnalyzeConnectorsRequestValidationError{}
+var _ error = IssueDevJWTResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9093,47 +12415,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnalyzeConnectorsRequestValidationError{}
+} = IssueDevJWTResponseValidationError{}
 
-// Validate checks the field values on AnalyzeConnectorsResponse with the rules
+// Validate checks the field values on ExplainSecurityRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AnalyzeConnectorsResponse) Validate() error {
+func (m *ExplainSecurityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AnalyzeConnectorsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExplainSecurityRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AnalyzeConnectorsResponseMultiError, or nil if none found.
-func (m *AnalyzeConnectorsResponse) ValidateAll() error {
+// ExplainSecurityRequestMultiError, or nil if none found.
+func (m *ExplainSecurityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AnalyzeConnectorsResponse) validate(all bool) error {
+func (m *ExplainSecurityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConnectors() {
+	// no validation rules for InstanceId
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainSecurityRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainSecurityRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainSecurityRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetResources() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AnalyzeConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
+					errors = append(errors, ExplainSecurityRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AnalyzeConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
+					errors = append(errors, ExplainSecurityRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9141,8 +12494,8 @@ func (m *AnalyzeConnectorsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AnalyzeConnectorsResponseValidationError{
-					field:  fmt.Sprintf("Connectors[%v]", idx),
+				return ExplainSecurityRequestValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9152,19 +12505,19 @@ func (m *AnalyzeConnectorsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AnalyzeConnectorsResponseMultiError(errors)
+		return ExplainSecurityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AnalyzeConnectorsResponseMultiError is an error wrapping multiple validation
-// errors returned by AnalyzeConnectorsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AnalyzeConnectorsResponseMultiError []error
+// ExplainSecurityRequestMultiError is an error wrapping multiple validation
+// errors returned by ExplainSecurityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainSecurityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AnalyzeConnectorsResponseMultiError) Error() string {
+func (m ExplainSecurityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9173,11 +12526,11 @@ func (m AnalyzeConnectorsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AnalyzeConnectorsResponseMultiError) AllErrors() []error { return m }
+func (m ExplainSecurityRequestMultiError) AllErrors() []error { return m }
 
-// AnalyzeConnectorsResponseValidationError is the validation error returned by
-// AnalyzeConnectorsResponse.Validate if the designated constraints aren't met.
-type AnalyzeConnectorsResponseValidationError struct {
+// ExplainSecurityRequestValidationError is the validation error returned by
+// ExplainSecurityRequest.Validate if the designated constraints aren't met.
+type ExplainSecurityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9185,24 +12538,24 @@ type AnalyzeConnectorsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnalyzeConnectorsResponseValidationError) Field() string { return e.field }
+func (e ExplainSecurityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnalyzeConnectorsResponseValidationError) Reason() string { return e.reason }
+func (e ExplainSecurityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnalyzeConnectorsResponseValidationError) Cause() error { return e.cause }
+func (e ExplainSecurityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnalyzeConnectorsResponseValidationError) Key() bool { return e.key }
+func (e ExplainSecurityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnalyzeConnectorsResponseValidationError) ErrorName() string {
-	return "AnalyzeConnectorsResponseValidationError"
+func (e ExplainSecurityRequestValidationError) ErrorName() string {
+	return "ExplainSecurityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AnalyzeConnectorsResponseValidationError) Error() string {
+func (e ExplainSecurityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9214,14 +12567,14 @@ func (e AnalyzeConnectorsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnalyzeConnectorsResponse.%s: %s%s",
+		"invalid %sExplainSecurityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnalyzeConnectorsResponseValidationError{}
+var _ error = ExplainSecurityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9229,46 +12582,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnalyzeConnectorsResponseValidationError{}
+} = ExplainSecurityRequestValidationError{}
 
-// Validate checks the field values on ListNotifierConnectorsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ExplainSecurityResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListNotifierConnectorsRequest) Validate() error {
+func (m *ExplainSecurityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListNotifierConnectorsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListNotifierConnectorsRequestMultiError, or nil if none found.
-func (m *ListNotifierConnectorsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ExplainSecurityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainSecurityResponseMultiError, or nil if none found.
+func (m *ExplainSecurityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListNotifierConnectorsRequest) validate(all bool) error {
+func (m *ExplainSecurityResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplainSecurityResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplainSecurityResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplainSecurityResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ListNotifierConnectorsRequestMultiError(errors)
+		return ExplainSecurityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListNotifierConnectorsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListNotifierConnectorsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListNotifierConnectorsRequestMultiError []error
+// ExplainSecurityResponseMultiError is an error wrapping multiple validation
+// errors returned by ExplainSecurityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainSecurityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListNotifierConnectorsRequestMultiError) Error() string {
+func (m ExplainSecurityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9277,12 +12662,11 @@ func (m ListNotifierConnectorsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListNotifierConnectorsRequestMultiError) AllErrors() []error { return m }
+func (m ExplainSecurityResponseMultiError) AllErrors() []error { return m }
 
-// ListNotifierConnectorsRequestValidationError is the validation error
-// returned by ListNotifierConnectorsRequest.Validate if the designated
-// constraints aren't met.
-type ListNotifierConnectorsRequestValidationError struct {
+// ExplainSecurityResponseValidationError is the validation error returned by
+// ExplainSecurityResponse.Validate if the designated constraints aren't met.
+type ExplainSecurityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9290,24 +12674,24 @@ type ListNotifierConnectorsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNotifierConnectorsRequestValidationError) Field() string { return e.field }
+func (e ExplainSecurityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNotifierConnectorsRequestValidationError) Reason() string { return e.reason }
+func (e ExplainSecurityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNotifierConnectorsRequestValidationError) Cause() error { return e.cause }
+func (e ExplainSecurityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNotifierConnectorsRequestValidationError) Key() bool { return e.key }
+func (e ExplainSecurityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNotifierConnectorsRequestValidationError) ErrorName() string {
-	return "ListNotifierConnectorsRequestValidationError"
+func (e ExplainSecurityResponseValidationError) ErrorName() string {
+	return "ExplainSecurityResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListNotifierConnectorsRequestValidationError) Error() string {
+func (e ExplainSecurityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9319,14 +12703,14 @@ func (e ListNotifierConnectorsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNotifierConnectorsRequest.%s: %s%s",
+		"invalid %sExplainSecurityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNotifierConnectorsRequestValidationError{}
+var _ error = ExplainSecurityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9334,47 +12718,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNotifierConnectorsRequestValidationError{}
+} = ExplainSecurityResponseValidationError{}
 
-// Validate checks the field values on ListNotifierConnectorsResponse with the
+// Validate checks the field values on ResourceSecurityExplanation with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListNotifierConnectorsResponse) Validate() error {
+func (m *ResourceSecurityExplanation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListNotifierConnectorsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListNotifierConnectorsResponseMultiError, or nil if none found.
-func (m *ListNotifierConnectorsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ResourceSecurityExplanation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceSecurityExplanationMultiError, or nil if none found.
+func (m *ResourceSecurityExplanation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListNotifierConnectorsResponse) validate(all bool) error {
+func (m *ResourceSecurityExplanation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConnectors() {
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceSecurityExplanationValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceSecurityExplanationValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceSecurityExplanationValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Access
+
+	// no validation rules for AccessRuleIndex
+
+	// no validation rules for AllFields
+
+	for idx, item := range m.GetFields() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListNotifierConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
+					errors = append(errors, ResourceSecurityExplanationValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListNotifierConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
+					errors = append(errors, ResourceSecurityExplanationValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9382,8 +12801,73 @@ func (m *ListNotifierConnectorsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListNotifierConnectorsResponseValidationError{
-					field:  fmt.Sprintf("Connectors[%v]", idx),
+				return ResourceSecurityExplanationValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RowFilter
+
+	if all {
+		switch v := interface{}(m.GetQueryFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceSecurityExplanationValidationError{
+					field:  "QueryFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceSecurityExplanationValidationError{
+					field:  "QueryFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceSecurityExplanationValidationError{
+				field:  "QueryFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceSecurityExplanationValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceSecurityExplanationValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceSecurityExplanationValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9392,20 +12876,22 @@ func (m *ListNotifierConnectorsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Error
+
 	if len(errors) > 0 {
-		return ListNotifierConnectorsResponseMultiError(errors)
+		return ResourceSecurityExplanationMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListNotifierConnectorsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListNotifierConnectorsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListNotifierConnectorsResponseMultiError []error
+// ResourceSecurityExplanationMultiError is an error wrapping multiple
+// validation errors returned by ResourceSecurityExplanation.ValidateAll() if
+// the designated constraints aren't met.
+type ResourceSecurityExplanationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListNotifierConnectorsResponseMultiError) Error() string {
+func (m ResourceSecurityExplanationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9414,12 +12900,12 @@ func (m ListNotifierConnectorsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListNotifierConnectorsResponseMultiError) AllErrors() []error { return m }
+func (m ResourceSecurityExplanationMultiError) AllErrors() []error { return m }
 
-// ListNotifierConnectorsResponseValidationError is the validation error
-// returned by ListNotifierConnectorsResponse.Validate if the designated
-// constraints aren't met.
-type ListNotifierConnectorsResponseValidationError struct {
+// ResourceSecurityExplanationValidationError is the validation error returned
+// by ResourceSecurityExplanation.Validate if the designated constraints
+// aren't met.
+type ResourceSecurityExplanationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9427,24 +12913,24 @@ type ListNotifierConnectorsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNotifierConnectorsResponseValidationError) Field() string { return e.field }
+func (e ResourceSecurityExplanationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNotifierConnectorsResponseValidationError) Reason() string { return e.reason }
+func (e ResourceSecurityExplanationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNotifierConnectorsResponseValidationError) Cause() error { return e.cause }
+func (e ResourceSecurityExplanationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNotifierConnectorsResponseValidationError) Key() bool { return e.key }
+func (e ResourceSecurityExplanationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNotifierConnectorsResponseValidationError) ErrorName() string {
-	return "ListNotifierConnectorsResponseValidationError"
+func (e ResourceSecurityExplanationValidationError) ErrorName() string {
+	return "ResourceSecurityExplanationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListNotifierConnectorsResponseValidationError) Error() string {
+func (e ResourceSecurityExplanationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9456,14 +12942,14 @@ func (e ListNotifierConnectorsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNotifierConnectorsResponse.%s: %s%s",
+		"invalid %sResourceSecurityExplanation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNotifierConnectorsResponseValidationError{}
+var _ error = ResourceSecurityExplanationValidationError{}
 
 var _ interface {
 	Field() string
@@ -9471,97 +12957,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNotifierConnectorsResponseValidationError{}
+} = ResourceSecurityExplanationValidationError{}
 
-// Validate checks the field values on IssueDevJWTRequest with the rules
+// Validate checks the field values on FieldSecurityExplanation with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueDevJWTRequest) Validate() error {
+func (m *FieldSecurityExplanation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueDevJWTRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on FieldSecurityExplanation with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueDevJWTRequestMultiError, or nil if none found.
-func (m *IssueDevJWTRequest) ValidateAll() error {
+// FieldSecurityExplanationMultiError, or nil if none found.
+func (m *FieldSecurityExplanation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueDevJWTRequest) validate(all bool) error {
+func (m *FieldSecurityExplanation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := IssueDevJWTRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetEmail()) < 1 {
-		err := IssueDevJWTRequestValidationError{
-			field:  "Email",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Field
 
-	// no validation rules for Admin
+	// no validation rules for Allowed
 
-	if all {
-		switch v := interface{}(m.GetAttributes()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, IssueDevJWTRequestValidationError{
-					field:  "Attributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, IssueDevJWTRequestValidationError{
-					field:  "Attributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return IssueDevJWTRequestValidationError{
-				field:  "Attributes",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for RuleIndex
 
 	if len(errors) > 0 {
-		return IssueDevJWTRequestMultiError(errors)
+		return FieldSecurityExplanationMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueDevJWTRequestMultiError is an error wrapping multiple validation errors
-// returned by IssueDevJWTRequest.ValidateAll() if the designated constraints
-// aren't met.
-type IssueDevJWTRequestMultiError []error
+// FieldSecurityExplanationMultiError is an error wrapping multiple validation
+// errors returned by FieldSecurityExplanation.ValidateAll() if the designated
+// constraints aren't met.
+type FieldSecurityExplanationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueDevJWTRequestMultiError) Error() string {
+func (m FieldSecurityExplanationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9570,11 +13009,11 @@ func (m IssueDevJWTRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueDevJWTRequestMultiError) AllErrors() []error { return m }
+func (m FieldSecurityExplanationMultiError) AllErrors() []error { return m }
 
-// IssueDevJWTRequestValidationError is the validation error returned by
-// IssueDevJWTRequest.Validate if the designated constraints aren't met.
-type IssueDevJWTRequestValidationError struct {
+// FieldSecurityExplanationValidationError is the validation error returned by
+// FieldSecurityExplanation.Validate if the designated constraints aren't met.
+type FieldSecurityExplanationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9582,24 +13021,24 @@ type IssueDevJWTRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueDevJWTRequestValidationError) Field() string { return e.field }
+func (e FieldSecurityExplanationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueDevJWTRequestValidationError) Reason() string { return e.reason }
+func (e FieldSecurityExplanationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueDevJWTRequestValidationError) Cause() error { return e.cause }
+func (e FieldSecurityExplanationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueDevJWTRequestValidationError) Key() bool { return e.key }
+func (e FieldSecurityExplanationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueDevJWTRequestValidationError) ErrorName() string {
-	return "IssueDevJWTRequestValidationError"
+func (e FieldSecurityExplanationValidationError) ErrorName() string {
+	return "FieldSecurityExplanationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueDevJWTRequestValidationError) Error() string {
+func (e FieldSecurityExplanationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9611,14 +13050,14 @@ func (e IssueDevJWTRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueDevJWTRequest.%s: %s%s",
+		"invalid %sFieldSecurityExplanation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueDevJWTRequestValidationError{}
+var _ error = FieldSecurityExplanationValidationError{}
 
 var _ interface {
 	Field() string
@@ -9626,46 +13065,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueDevJWTRequestValidationError{}
+} = FieldSecurityExplanationValidationError{}
 
-// Validate checks the field values on IssueDevJWTResponse with the rules
+// Validate checks the field values on SecurityRuleEvaluation with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueDevJWTResponse) Validate() error {
+func (m *SecurityRuleEvaluation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueDevJWTResponse with the rules
+// ValidateAll checks the field values on SecurityRuleEvaluation with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueDevJWTResponseMultiError, or nil if none found.
-func (m *IssueDevJWTResponse) ValidateAll() error {
+// SecurityRuleEvaluationMultiError, or nil if none found.
+func (m *SecurityRuleEvaluation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueDevJWTResponse) validate(all bool) error {
+func (m *SecurityRuleEvaluation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Jwt
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecurityRuleEvaluationValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecurityRuleEvaluationValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecurityRuleEvaluationValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	// no validation rules for ConditionMatched
+
+	// no validation rules for Skipped
 
 	if len(errors) > 0 {
-		return IssueDevJWTResponseMultiError(errors)
+		return SecurityRuleEvaluationMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueDevJWTResponseMultiError is an error wrapping multiple validation
-// errors returned by IssueDevJWTResponse.ValidateAll() if the designated
+// SecurityRuleEvaluationMultiError is an error wrapping multiple validation
+// errors returned by SecurityRuleEvaluation.ValidateAll() if the designated
 // constraints aren't met.
-type IssueDevJWTResponseMultiError []error
+type SecurityRuleEvaluationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueDevJWTResponseMultiError) Error() string {
+func (m SecurityRuleEvaluationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9674,11 +13146,11 @@ func (m IssueDevJWTResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueDevJWTResponseMultiError) AllErrors() []error { return m }
+func (m SecurityRuleEvaluationMultiError) AllErrors() []error { return m }
 
-// IssueDevJWTResponseValidationError is the validation error returned by
-// IssueDevJWTResponse.Validate if the designated constraints aren't met.
-type IssueDevJWTResponseValidationError struct {
+// SecurityRuleEvaluationValidationError is the validation error returned by
+// SecurityRuleEvaluation.Validate if the designated constraints aren't met.
+type SecurityRuleEvaluationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9686,24 +13158,24 @@ type IssueDevJWTResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueDevJWTResponseValidationError) Field() string { return e.field }
+func (e SecurityRuleEvaluationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueDevJWTResponseValidationError) Reason() string { return e.reason }
+func (e SecurityRuleEvaluationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueDevJWTResponseValidationError) Cause() error { return e.cause }
+func (e SecurityRuleEvaluationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueDevJWTResponseValidationError) Key() bool { return e.key }
+func (e SecurityRuleEvaluationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueDevJWTResponseValidationError) ErrorName() string {
-	return "IssueDevJWTResponseValidationError"
+func (e SecurityRuleEvaluationValidationError) ErrorName() string {
+	return "SecurityRuleEvaluationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueDevJWTResponseValidationError) Error() string {
+func (e SecurityRuleEvaluationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9715,14 +13187,14 @@ func (e IssueDevJWTResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueDevJWTResponse.%s: %s%s",
+		"invalid %sSecurityRuleEvaluation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueDevJWTResponseValidationError{}
+var _ error = SecurityRuleEvaluationValidationError{}
 
 var _ interface {
 	Field() string
@@ -9730,7 +13202,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueDevJWTResponseValidationError{}
+} = SecurityRuleEvaluationValidationError{}
 
 // Validate checks the field values on AnalyzeVariablesRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -40,11 +40,17 @@ const (
 	RuntimeService_GenerateMetricsViewFile_FullMethodName = "/rill.runtime.v1.RuntimeService/GenerateMetricsViewFile"
 	RuntimeService_GenerateResolver_FullMethodName        = "/rill.runtime.v1.RuntimeService/GenerateResolver"
 	RuntimeService_GenerateRenderer_FullMethodName        = "/rill.runtime.v1.RuntimeService/GenerateRenderer"
+	RuntimeService_AskMetricsView_FullMethodName          = "/rill.runtime.v1.RuntimeService/AskMetricsView"
+	RuntimeService_ListConversations_FullMethodName       = "/rill.runtime.v1.RuntimeService/ListConversations"
+	RuntimeService_GetConversation_FullMethodName         = "/rill.runtime.v1.RuntimeService/GetConversation"
+	RuntimeService_DeleteConversation_FullMethodName      = "/rill.runtime.v1.RuntimeService/DeleteConversation"
+	RuntimeService_Complete_FullMethodName                = "/rill.runtime.v1.RuntimeService/Complete"
 	RuntimeService_GetLogs_FullMethodName                 = "/rill.runtime.v1.RuntimeService/GetLogs"
 	RuntimeService_WatchLogs_FullMethodName               = "/rill.runtime.v1.RuntimeService/WatchLogs"
 	RuntimeService_ListResources_FullMethodName           = "/rill.runtime.v1.RuntimeService/ListResources"
 	RuntimeService_WatchResources_FullMethodName          = "/rill.runtime.v1.RuntimeService/WatchResources"
 	RuntimeService_GetResource_FullMethodName             = "/rill.runtime.v1.RuntimeService/GetResource"
+	RuntimeService_GetResourceLineage_FullMethodName      = "/rill.runtime.v1.RuntimeService/GetResourceLineage"
 	RuntimeService_GetExplore_FullMethodName              = "/rill.runtime.v1.RuntimeService/GetExplore"
 	RuntimeService_GetModelPartitions_FullMethodName      = "/rill.runtime.v1.RuntimeService/GetModelPartitions"
 	RuntimeService_CreateTrigger_FullMethodName           = "/rill.runtime.v1.RuntimeService/CreateTrigger"
@@ -52,6 +58,7 @@ const (
 	RuntimeService_AnalyzeConnectors_FullMethodName       = "/rill.runtime.v1.RuntimeService/AnalyzeConnectors"
 	RuntimeService_ListNotifierConnectors_FullMethodName  = "/rill.runtime.v1.RuntimeService/ListNotifierConnectors"
 	RuntimeService_IssueDevJWT_FullMethodName             = "/rill.runtime.v1.RuntimeService/IssueDevJWT"
+	RuntimeService_ExplainSecurity_FullMethodName         = "/rill.runtime.v1.RuntimeService/ExplainSecurity"
 	RuntimeService_AnalyzeVariables_FullMethodName        = "/rill.runtime.v1.RuntimeService/AnalyzeVariables"
 )
 
@@ -105,6 +112,19 @@ type RuntimeServiceClient interface {
 	GenerateResolver(ctx context.Context, in *GenerateResolverRequest, opts ...grpc.CallOption) (*GenerateResolverResponse, error)
 	// GenerateRenderer generates a component renderer and renderer properties from a resolver and resolver properties
 	GenerateRenderer(ctx context.Context, in *GenerateRendererRequest, opts ...grpc.CallOption) (*GenerateRendererResponse, error)
+	// AskMetricsView answers a natural language question about a metrics view.
+	// It uses AI to translate the question to a metrics query, and returns the query's result along with an explanation.
+	AskMetricsView(ctx context.Context, in *AskMetricsViewRequest, opts ...grpc.CallOption) (*AskMetricsViewResponse, error)
+	// ListConversations lists the current user's AI conversations.
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	// GetConversation returns an AI conversation of the current user including its messages.
+	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
+	// DeleteConversation deletes an AI conversation of the current user.
+	DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error)
+	// Complete adds a user message to an AI conversation and runs the AI agent until it has responded.
+	// The agent can call tools that list metrics views and query them on behalf of the user.
+	// If no conversation ID is provided, a new conversation is started.
+	Complete(ctx context.Context, in *CompleteRequest, opts ...grpc.CallOption) (*CompleteResponse, error)
 	// GetLogs returns recent logs from a controller
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
 	// WatchLogs streams new logs emitted from a controller
@@ -115,6 +135,9 @@ type RuntimeServiceClient interface {
 	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResourcesResponse], error)
 	// GetResource looks up a specific catalog resource
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
+	// GetResourceLineage returns the upstream and downstream resources of a resource.
+	// It can also return best-effort column-level lineage for the models and metrics views in the graph.
+	GetResourceLineage(ctx context.Context, in *GetResourceLineageRequest, opts ...grpc.CallOption) (*GetResourceLineageResponse, error)
 	// GetExplore is a convenience RPC that combines looking up an Explore resource and its underlying MetricsView into one network call.
 	GetExplore(ctx context.Context, in *GetExploreRequest, opts ...grpc.CallOption) (*GetExploreResponse, error)
 	// GetModelPartitions returns the partitions of a model
@@ -132,6 +155,9 @@ type RuntimeServiceClient interface {
 	ListNotifierConnectors(ctx context.Context, in *ListNotifierConnectorsRequest, opts ...grpc.CallOption) (*ListNotifierConnectorsResponse, error)
 	// IssueDevJWT issues a JWT for mimicking a user in local development.
 	IssueDevJWT(ctx context.Context, in *IssueDevJWTRequest, opts ...grpc.CallOption) (*IssueDevJWTResponse, error)
+	// ExplainSecurity simulates the security policies of the project's resources for a user with the provided attributes.
+	// For each resource, it returns the access decision, the visible fields, the effective row filter and the rules that produced each outcome.
+	ExplainSecurity(ctx context.Context, in *ExplainSecurityRequest, opts ...grpc.CallOption) (*ExplainSecurityResponse, error)
 	// AnalyzeVariables scans `Source`, `Model` and `Connector` resources in the catalog for use of an environment variable
 	AnalyzeVariables(ctx context.Context, in *AnalyzeVariablesRequest, opts ...grpc.CallOption) (*AnalyzeVariablesResponse, error)
 }
@@ -363,6 +389,56 @@ func (c *runtimeServiceClient) GenerateRenderer(ctx context.Context, in *Generat
 	return out, nil
 }
 
+func (c *runtimeServiceClient) AskMetricsView(ctx context.Context, in *AskMetricsViewRequest, opts ...grpc.CallOption) (*AskMetricsViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AskMetricsViewResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_AskMetricsView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteConversationResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_DeleteConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) Complete(ctx context.Context, in *CompleteRequest, opts ...grpc.CallOption) (*CompleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_Complete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLogsResponse)
@@ -431,6 +507,16 @@ func (c *runtimeServiceClient) GetResource(ctx context.Context, in *GetResourceR
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetResourceLineage(ctx context.Context, in *GetResourceLineageRequest, opts ...grpc.CallOption) (*GetResourceLineageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceLineageResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetResourceLineage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) GetExplore(ctx context.Context, in *GetExploreRequest, opts ...grpc.CallOption) (*GetExploreResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetExploreResponse)
@@ -501,6 +587,16 @@ func (c *runtimeServiceClient) IssueDevJWT(ctx context.Context, in *IssueDevJWTR
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ExplainSecurity(ctx context.Context, in *ExplainSecurityRequest, opts ...grpc.CallOption) (*ExplainSecurityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainSecurityResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ExplainSecurity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) AnalyzeVariables(ctx context.Context, in *AnalyzeVariablesRequest, opts ...grpc.CallOption) (*AnalyzeVariablesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AnalyzeVariablesResponse)
@@ -561,6 +657,19 @@ type RuntimeServiceServer interface {
 	GenerateResolver(context.Context, *GenerateResolverRequest) (*GenerateResolverResponse, error)
 	// GenerateRenderer generates a component renderer and renderer properties from a resolver and resolver properties
 	GenerateRenderer(context.Context, *GenerateRendererRequest) (*GenerateRendererResponse, error)
+	// AskMetricsView answers a natural language question about a metrics view.
+	// It uses AI to translate the question to a metrics query, and returns the query's result along with an explanation.
+	AskMetricsView(context.Context, *AskMetricsViewRequest) (*AskMetricsViewResponse, error)
+	// ListConversations lists the current user's AI conversations.
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	// GetConversation returns an AI conversation of the current user including its messages.
+	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
+	// DeleteConversation deletes an AI conversation of the current user.
+	DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error)
+	// Complete adds a user message to an AI conversation and runs the AI agent until it has responded.
+	// The agent can call tools that list metrics views and query them on behalf of the user.
+	// If no conversation ID is provided, a new conversation is started.
+	Complete(context.Context, *CompleteRequest) (*CompleteResponse, error)
 	// GetLogs returns recent logs from a controller
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
 	// WatchLogs streams new logs emitted from a controller
@@ -571,6 +680,9 @@ type RuntimeServiceServer interface {
 	WatchResources(*WatchResourcesRequest, grpc.ServerStreamingServer[WatchResourcesResponse]) error
 	// GetResource looks up a specific catalog resource
 	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
+	// GetResourceLineage returns the upstream and downstream resources of a resource.
+	// It can also return best-effort column-level lineage for the models and metrics views in the graph.
+	GetResourceLineage(context.Context, *GetResourceLineageRequest) (*GetResourceLineageResponse, error)
 	// GetExplore is a convenience RPC that combines looking up an Explore resource and its underlying MetricsView into one network call.
 	GetExplore(context.Context, *GetExploreRequest) (*GetExploreResponse, error)
 	// GetModelPartitions returns the partitions of a model
@@ -588,6 +700,9 @@ type RuntimeServiceServer interface {
 	ListNotifierConnectors(context.Context, *ListNotifierConnectorsRequest) (*ListNotifierConnectorsResponse, error)
 	// IssueDevJWT issues a JWT for mimicking a user in local development.
 	IssueDevJWT(context.Context, *IssueDevJWTRequest) (*IssueDevJWTResponse, error)
+	// ExplainSecurity simulates the security policies of the project's resources for a user with the provided attributes.
+	// For each resource, it returns the access decision, the visible fields, the effective row filter and the rules that produced each outcome.
+	ExplainSecurity(context.Context, *ExplainSecurityRequest) (*ExplainSecurityResponse, error)
 	// AnalyzeVariables scans `Source`, `Model` and `Connector` resources in the catalog for use of an environment variable
 	AnalyzeVariables(context.Context, *AnalyzeVariablesRequest) (*AnalyzeVariablesResponse, error)
 	mustEmbedUnimplementedRuntimeServiceServer()
@@ -663,6 +778,21 @@ func (UnimplementedRuntimeServiceServer) GenerateResolver(context.Context, *Gene
 func (UnimplementedRuntimeServiceServer) GenerateRenderer(context.Context, *GenerateRendererRequest) (*GenerateRendererResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateRenderer not implemented")
 }
+func (UnimplementedRuntimeServiceServer) AskMetricsView(context.Context, *AskMetricsViewRequest) (*AskMetricsViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AskMetricsView not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedRuntimeServiceServer) GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (UnimplementedRuntimeServiceServer) DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConversation not implemented")
+}
+func (UnimplementedRuntimeServiceServer) Complete(context.Context, *CompleteRequest) (*CompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Complete not implemented")
+}
 func (UnimplementedRuntimeServiceServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
@@ -678,6 +808,9 @@ func (UnimplementedRuntimeServiceServer) WatchResources(*WatchResourcesRequest,
 func (UnimplementedRuntimeServiceServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetResourceLineage(context.Context, *GetResourceLineageRequest) (*GetResourceLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceLineage not implemented")
+}
 func (UnimplementedRuntimeServiceServer) GetExplore(context.Context, *GetExploreRequest) (*GetExploreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExplore not implemented")
 }
@@ -699,6 +832,9 @@ func (UnimplementedRuntimeServiceServer) ListNotifierConnectors(context.Context,
 func (UnimplementedRuntimeServiceServer) IssueDevJWT(context.Context, *IssueDevJWTRequest) (*IssueDevJWTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueDevJWT not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ExplainSecurity(context.Context, *ExplainSecurityRequest) (*ExplainSecurityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSecurity not implemented")
+}
 func (UnimplementedRuntimeServiceServer) AnalyzeVariables(context.Context, *AnalyzeVariablesRequest) (*AnalyzeVariablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeVariables not implemented")
 }
@@ -1094,6 +1230,96 @@ func _RuntimeService_GenerateRenderer_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_AskMetricsView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskMetricsViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).AskMetricsView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_AskMetricsView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).AskMetricsView(ctx, req.(*AskMetricsViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_DeleteConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).DeleteConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_DeleteConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).DeleteConversation(ctx, req.(*DeleteConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_Complete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).Complete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_Complete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).Complete(ctx, req.(*CompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLogsRequest)
 	if err := dec(in); err != nil {
@@ -1170,6 +1396,24 @@ func _RuntimeService_GetResource_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetResourceLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetResourceLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetResourceLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetResourceLineage(ctx, req.(*GetResourceLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_GetExplore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetExploreRequest)
 	if err := dec(in); err != nil {
@@ -1296,6 +1540,24 @@ func _RuntimeService_IssueDevJWT_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ExplainSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainSecurityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ExplainSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ExplainSecurity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ExplainSecurity(ctx, req.(*ExplainSecurityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_AnalyzeVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AnalyzeVariablesRequest)
 	if err := dec(in); err != nil {
@@ -1401,6 +1663,26 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateRenderer",
 			Handler:    _RuntimeService_GenerateRenderer_Handler,
 		},
+		{
+			MethodName: "AskMetricsView",
+			Handler:    _RuntimeService_AskMetricsView_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _RuntimeService_ListConversations_Handler,
+		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _RuntimeService_GetConversation_Handler,
+		},
+		{
+			MethodName: "DeleteConversation",
+			Handler:    _RuntimeService_DeleteConversation_Handler,
+		},
+		{
+			MethodName: "Complete",
+			Handler:    _RuntimeService_Complete_Handler,
+		},
 		{
 			MethodName: "GetLogs",
 			Handler:    _RuntimeService_GetLogs_Handler,
@@ -1413,6 +1695,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResource",
 			Handler:    _RuntimeService_GetResource_Handler,
 		},
+		{
+			MethodName: "GetResourceLineage",
+			Handler:    _RuntimeService_GetResourceLineage_Handler,
+		},
 		{
 			MethodName: "GetExplore",
 			Handler:    _RuntimeService_GetExplore_Handler,
@@ -1441,6 +1727,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueDevJWT",
 			Handler:    _RuntimeService_IssueDevJWT_Handler,
 		},
+		{
+			MethodName: "ExplainSecurity",
+			Handler:    _RuntimeService_ExplainSecurity_Handler,
+		},
 		{
 			MethodName: "AnalyzeVariables",
 			Handler:    _RuntimeService_AnalyzeVariables_Handler,
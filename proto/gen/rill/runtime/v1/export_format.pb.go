@@ -27,6 +27,19 @@ const (
 	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
 	ExportFormat_EXPORT_FORMAT_XLSX        ExportFormat = 2
 	ExportFormat_EXPORT_FORMAT_PARQUET     ExportFormat = 3
+	// Newline-delimited JSON, with one JSON object per row.
+	ExportFormat_EXPORT_FORMAT_JSONL ExportFormat = 4
+	// Arrow IPC stream.
+	ExportFormat_EXPORT_FORMAT_ARROW ExportFormat = 5
+	// Writes the result to a tab in a Google Sheet instead of a file.
+	// It requires the export request to provide GoogleSheetsExportOptions.
+	ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS ExportFormat = 6
+	// Rendered snapshot of a canvas or explore dashboard as a PDF document.
+	// It is only supported for reports, which must provide ReportSnapshotOptions.
+	ExportFormat_EXPORT_FORMAT_PDF ExportFormat = 7
+	// Rendered snapshot of a canvas or explore dashboard as a PNG image.
+	// It is only supported for reports, which must provide ReportSnapshotOptions.
+	ExportFormat_EXPORT_FORMAT_PNG ExportFormat = 8
 )
 
 // Enum value maps for ExportFormat.
@@ -36,12 +49,22 @@ var (
 		1: "EXPORT_FORMAT_CSV",
 		2: "EXPORT_FORMAT_XLSX",
 		3: "EXPORT_FORMAT_PARQUET",
+		4: "EXPORT_FORMAT_JSONL",
+		5: "EXPORT_FORMAT_ARROW",
+		6: "EXPORT_FORMAT_GOOGLE_SHEETS",
+		7: "EXPORT_FORMAT_PDF",
+		8: "EXPORT_FORMAT_PNG",
 	}
 	ExportFormat_value = map[string]int32{
-		"EXPORT_FORMAT_UNSPECIFIED": 0,
-		"EXPORT_FORMAT_CSV":         1,
-		"EXPORT_FORMAT_XLSX":        2,
-		"EXPORT_FORMAT_PARQUET":     3,
+		"EXPORT_FORMAT_UNSPECIFIED":   0,
+		"EXPORT_FORMAT_CSV":           1,
+		"EXPORT_FORMAT_XLSX":          2,
+		"EXPORT_FORMAT_PARQUET":       3,
+		"EXPORT_FORMAT_JSONL":         4,
+		"EXPORT_FORMAT_ARROW":         5,
+		"EXPORT_FORMAT_GOOGLE_SHEETS": 6,
+		"EXPORT_FORMAT_PDF":           7,
+		"EXPORT_FORMAT_PNG":           8,
 	}
 )
 
@@ -72,33 +95,105 @@ func (ExportFormat) EnumDescriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_export_format_proto_rawDescGZIP(), []int{0}
 }
 
+// GoogleSheetsExportOptions configures the destination of an export in EXPORT_FORMAT_GOOGLE_SHEETS.
+type GoogleSheetsExportOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the spreadsheet, as found in its URL.
+	SpreadsheetId string `protobuf:"bytes,1,opt,name=spreadsheet_id,json=spreadsheetId,proto3" json:"spreadsheet_id,omitempty"`
+	// Name of the tab to write to. It is created if it doesn't exist, and its contents are replaced if it does.
+	Sheet string `protobuf:"bytes,2,opt,name=sheet,proto3" json:"sheet,omitempty"`
+}
+
+func (x *GoogleSheetsExportOptions) Reset() {
+	*x = GoogleSheetsExportOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_export_format_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoogleSheetsExportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleSheetsExportOptions) ProtoMessage() {}
+
+func (x *GoogleSheetsExportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_export_format_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleSheetsExportOptions.ProtoReflect.Descriptor instead.
+func (*GoogleSheetsExportOptions) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_export_format_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GoogleSheetsExportOptions) GetSpreadsheetId() string {
+	if x != nil {
+		return x.SpreadsheetId
+	}
+	return ""
+}
+
+func (x *GoogleSheetsExportOptions) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
 var File_rill_runtime_v1_export_format_proto protoreflect.FileDescriptor
 
 var file_rill_runtime_v1_export_format_proto_rawDesc = []byte{
 	0x0a, 0x23, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76,
 	0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2a, 0x77, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
-	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
-	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c,
-	0x53, 0x58, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x42,
-	0xc4, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64, 0x61, 0x74,
-	0x61, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x52, 0x58,
-	0xaa, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x69, 0x6c, 0x6c, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x58, 0x0a, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x53, 0x68, 0x65, 0x65, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74,
+	0x2a, 0xf8, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x02, 0x12,
+	0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e,
+	0x4c, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x47, 0x4f,
+	0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x53, 0x48, 0x45, 0x45, 0x54, 0x53, 0x10, 0x06, 0x12, 0x15, 0x0a,
+	0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50,
+	0x44, 0x46, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x4e, 0x47, 0x10, 0x08, 0x42, 0xc4, 0x01, 0x0a, 0x13,
+	0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x69,
+	0x6c, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x72, 0x69, 0x6c,
+	0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x52, 0x58, 0xaa, 0x02, 0x0f, 0x52,
+	0x69, 0x6c, 0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1b, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x11, 0x52, 0x69, 0x6c, 0x6c, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -114,8 +209,10 @@ func file_rill_runtime_v1_export_format_proto_rawDescGZIP() []byte {
 }
 
 var file_rill_runtime_v1_export_format_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rill_runtime_v1_export_format_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_rill_runtime_v1_export_format_proto_goTypes = []any{
-	(ExportFormat)(0), // 0: rill.runtime.v1.ExportFormat
+	(ExportFormat)(0),                 // 0: rill.runtime.v1.ExportFormat
+	(*GoogleSheetsExportOptions)(nil), // 1: rill.runtime.v1.GoogleSheetsExportOptions
 }
 var file_rill_runtime_v1_export_format_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -130,19 +227,34 @@ func file_rill_runtime_v1_export_format_proto_init() {
 	if File_rill_runtime_v1_export_format_proto != nil {
 		return
 	}
+	if !protoimpl.UnsafeEnabled {
+		file_rill_runtime_v1_export_format_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GoogleSheetsExportOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rill_runtime_v1_export_format_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   0,
+			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_rill_runtime_v1_export_format_proto_goTypes,
 		DependencyIndexes: file_rill_runtime_v1_export_format_proto_depIdxs,
 		EnumInfos:         file_rill_runtime_v1_export_format_proto_enumTypes,
+		MessageInfos:      file_rill_runtime_v1_export_format_proto_msgTypes,
 	}.Build()
 	File_rill_runtime_v1_export_format_proto = out.File
 	file_rill_runtime_v1_export_format_proto_rawDesc = nil
@@ -34,3 +34,109 @@ var (
 	_ = anypb.Any{}
 	_ = sort.Sort
 )
+
+// Validate checks the field values on GoogleSheetsExportOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoogleSheetsExportOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoogleSheetsExportOptions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoogleSheetsExportOptionsMultiError, or nil if none found.
+func (m *GoogleSheetsExportOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoogleSheetsExportOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SpreadsheetId
+
+	// no validation rules for Sheet
+
+	if len(errors) > 0 {
+		return GoogleSheetsExportOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoogleSheetsExportOptionsMultiError is an error wrapping multiple validation
+// errors returned by GoogleSheetsExportOptions.ValidateAll() if the
+// designated constraints aren't met.
+type GoogleSheetsExportOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoogleSheetsExportOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoogleSheetsExportOptionsMultiError) AllErrors() []error { return m }
+
+// GoogleSheetsExportOptionsValidationError is the validation error returned by
+// GoogleSheetsExportOptions.Validate if the designated constraints aren't met.
+type GoogleSheetsExportOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoogleSheetsExportOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoogleSheetsExportOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoogleSheetsExportOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoogleSheetsExportOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoogleSheetsExportOptionsValidationError) ErrorName() string {
+	return "GoogleSheetsExportOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoogleSheetsExportOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoogleSheetsExportOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoogleSheetsExportOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoogleSheetsExportOptionsValidationError{}
@@ -363,78 +363,162 @@ var _ interface {
 	ErrorName() string
 } = QueryResponseValidationError{}
 
-// Validate checks the field values on QueryBatchRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *QueryBatchRequest) Validate() error {
+// Validate checks the field values on QueryResolverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryResolverRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryBatchRequest with the rules
+// ValidateAll checks the field values on QueryResolverRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// QueryBatchRequestMultiError, or nil if none found.
-func (m *QueryBatchRequest) ValidateAll() error {
+// QueryResolverRequestMultiError, or nil if none found.
+func (m *QueryResolverRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryBatchRequest) validate(all bool) error {
+func (m *QueryResolverRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	if !_QueryResolverRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := QueryResolverRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	for idx, item := range m.GetQueries() {
-		_, _ = idx, item
+	if utf8.RuneCountInString(m.GetResolver()) < 1 {
+		err := QueryResolverRequestValidationError{
+			field:  "Resolver",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryBatchRequestValidationError{
-						field:  fmt.Sprintf("Queries[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryBatchRequestValidationError{
-						field:  fmt.Sprintf("Queries[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryResolverRequestValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return QueryBatchRequestValidationError{
-					field:  fmt.Sprintf("Queries[%v]", idx),
+				errors = append(errors, QueryResolverRequestValidationError{
+					field:  "ResolverProperties",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryResolverRequestValidationError{
+				field:  "ResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResolverArgs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryResolverRequestValidationError{
+					field:  "ResolverArgs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryResolverRequestValidationError{
+					field:  "ResolverArgs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolverArgs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryResolverRequestValidationError{
+				field:  "ResolverArgs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryResolverRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryResolverRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryResolverRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if val := m.GetLimit(); val < 0 || val > 10000 {
+		err := QueryResolverRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return QueryBatchRequestMultiError(errors)
+		return QueryResolverRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryBatchRequestMultiError is an error wrapping multiple validation errors
-// returned by QueryBatchRequest.ValidateAll() if the designated constraints
-// aren't met.
-type QueryBatchRequestMultiError []error
+// QueryResolverRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryResolverRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryResolverRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryBatchRequestMultiError) Error() string {
+func (m QueryResolverRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -443,11 +527,11 @@ func (m QueryBatchRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryBatchRequestMultiError) AllErrors() []error { return m }
+func (m QueryResolverRequestMultiError) AllErrors() []error { return m }
 
-// QueryBatchRequestValidationError is the validation error returned by
-// QueryBatchRequest.Validate if the designated constraints aren't met.
-type QueryBatchRequestValidationError struct {
+// QueryResolverRequestValidationError is the validation error returned by
+// QueryResolverRequest.Validate if the designated constraints aren't met.
+type QueryResolverRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -455,24 +539,24 @@ type QueryBatchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryBatchRequestValidationError) Field() string { return e.field }
+func (e QueryResolverRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryBatchRequestValidationError) Reason() string { return e.reason }
+func (e QueryResolverRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryBatchRequestValidationError) Cause() error { return e.cause }
+func (e QueryResolverRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryBatchRequestValidationError) Key() bool { return e.key }
+func (e QueryResolverRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryBatchRequestValidationError) ErrorName() string {
-	return "QueryBatchRequestValidationError"
+func (e QueryResolverRequestValidationError) ErrorName() string {
+	return "QueryResolverRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryBatchRequestValidationError) Error() string {
+func (e QueryResolverRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -484,14 +568,14 @@ func (e QueryBatchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryBatchRequest.%s: %s%s",
+		"invalid %sQueryResolverRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryBatchRequestValidationError{}
+var _ error = QueryResolverRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -499,77 +583,109 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryBatchRequestValidationError{}
+} = QueryResolverRequestValidationError{}
 
-// Validate checks the field values on QueryBatchResponse with the rules
+var _QueryResolverRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on QueryResolverResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryBatchResponse) Validate() error {
+func (m *QueryResolverResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryBatchResponse with the rules
+// ValidateAll checks the field values on QueryResolverResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// QueryBatchResponseMultiError, or nil if none found.
-func (m *QueryBatchResponse) ValidateAll() error {
+// QueryResolverResponseMultiError, or nil if none found.
+func (m *QueryResolverResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryBatchResponse) validate(all bool) error {
+func (m *QueryResolverResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Index
-
 	if all {
-		switch v := interface{}(m.GetResult()).(type) {
+		switch v := interface{}(m.GetSchema()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, QueryBatchResponseValidationError{
-					field:  "Result",
+				errors = append(errors, QueryResolverResponseValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, QueryBatchResponseValidationError{
-					field:  "Result",
+				errors = append(errors, QueryResolverResponseValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return QueryBatchResponseValidationError{
-				field:  "Result",
+			return QueryResolverResponseValidationError{
+				field:  "Schema",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Error
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryResolverResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryResolverResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryResolverResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return QueryBatchResponseMultiError(errors)
+		return QueryResolverResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryBatchResponseMultiError is an error wrapping multiple validation errors
-// returned by QueryBatchResponse.ValidateAll() if the designated constraints
-// aren't met.
-type QueryBatchResponseMultiError []error
+// QueryResolverResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryResolverResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueryResolverResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryBatchResponseMultiError) Error() string {
+func (m QueryResolverResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -578,11 +694,11 @@ func (m QueryBatchResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryBatchResponseMultiError) AllErrors() []error { return m }
+func (m QueryResolverResponseMultiError) AllErrors() []error { return m }
 
-// QueryBatchResponseValidationError is the validation error returned by
-// QueryBatchResponse.Validate if the designated constraints aren't met.
-type QueryBatchResponseValidationError struct {
+// QueryResolverResponseValidationError is the validation error returned by
+// QueryResolverResponse.Validate if the designated constraints aren't met.
+type QueryResolverResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -590,24 +706,24 @@ type QueryBatchResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryBatchResponseValidationError) Field() string { return e.field }
+func (e QueryResolverResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryBatchResponseValidationError) Reason() string { return e.reason }
+func (e QueryResolverResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryBatchResponseValidationError) Cause() error { return e.cause }
+func (e QueryResolverResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryBatchResponseValidationError) Key() bool { return e.key }
+func (e QueryResolverResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryBatchResponseValidationError) ErrorName() string {
-	return "QueryBatchResponseValidationError"
+func (e QueryResolverResponseValidationError) ErrorName() string {
+	return "QueryResolverResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryBatchResponseValidationError) Error() string {
+func (e QueryResolverResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -619,14 +735,14 @@ func (e QueryBatchResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryBatchResponse.%s: %s%s",
+		"invalid %sQueryResolverResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryBatchResponseValidationError{}
+var _ error = QueryResolverResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -634,24 +750,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryBatchResponseValidationError{}
+} = QueryResolverResponseValidationError{}
 
-// Validate checks the field values on ExportRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ExportRequest) Validate() error {
+// Validate checks the field values on QueryBatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryBatchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExportRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExportRequestMultiError, or
-// nil if none found.
-func (m *ExportRequest) ValidateAll() error {
+// ValidateAll checks the field values on QueryBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryBatchRequestMultiError, or nil if none found.
+func (m *QueryBatchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExportRequest) validate(all bool) error {
+func (m *QueryBatchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -660,55 +776,54 @@ func (m *ExportRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
-	// no validation rules for Limit
-
-	// no validation rules for Format
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetQuery()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExportRequestValidationError{
-					field:  "Query",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExportRequestValidationError{
-					field:  "Query",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExportRequestValidationError{
-				field:  "Query",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryBatchRequestValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryBatchRequestValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryBatchRequestValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for BakedQuery
+	}
 
 	if len(errors) > 0 {
-		return ExportRequestMultiError(errors)
+		return QueryBatchRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExportRequestMultiError is an error wrapping multiple validation errors
-// returned by ExportRequest.ValidateAll() if the designated constraints
+// QueryBatchRequestMultiError is an error wrapping multiple validation errors
+// returned by QueryBatchRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ExportRequestMultiError []error
+type QueryBatchRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExportRequestMultiError) Error() string {
+func (m QueryBatchRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -717,11 +832,11 @@ func (m ExportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExportRequestMultiError) AllErrors() []error { return m }
+func (m QueryBatchRequestMultiError) AllErrors() []error { return m }
 
-// ExportRequestValidationError is the validation error returned by
-// ExportRequest.Validate if the designated constraints aren't met.
-type ExportRequestValidationError struct {
+// QueryBatchRequestValidationError is the validation error returned by
+// QueryBatchRequest.Validate if the designated constraints aren't met.
+type QueryBatchRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -729,22 +844,24 @@ type ExportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExportRequestValidationError) Field() string { return e.field }
+func (e QueryBatchRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExportRequestValidationError) Reason() string { return e.reason }
+func (e QueryBatchRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExportRequestValidationError) Cause() error { return e.cause }
+func (e QueryBatchRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExportRequestValidationError) Key() bool { return e.key }
+func (e QueryBatchRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExportRequestValidationError) ErrorName() string { return "ExportRequestValidationError" }
+func (e QueryBatchRequestValidationError) ErrorName() string {
+	return "QueryBatchRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExportRequestValidationError) Error() string {
+func (e QueryBatchRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -756,14 +873,14 @@ func (e ExportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExportRequest.%s: %s%s",
+		"invalid %sQueryBatchRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExportRequestValidationError{}
+var _ error = QueryBatchRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -771,46 +888,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExportRequestValidationError{}
+} = QueryBatchRequestValidationError{}
 
-// Validate checks the field values on ExportResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ExportResponse) Validate() error {
+// Validate checks the field values on QueryBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryBatchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExportResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExportResponseMultiError,
-// or nil if none found.
-func (m *ExportResponse) ValidateAll() error {
+// ValidateAll checks the field values on QueryBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryBatchResponseMultiError, or nil if none found.
+func (m *QueryBatchResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExportResponse) validate(all bool) error {
+func (m *QueryBatchResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadUrlPath
+	// no validation rules for Index
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryBatchResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryBatchResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryBatchResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return ExportResponseMultiError(errors)
+		return QueryBatchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExportResponseMultiError is an error wrapping multiple validation errors
-// returned by ExportResponse.ValidateAll() if the designated constraints
+// QueryBatchResponseMultiError is an error wrapping multiple validation errors
+// returned by QueryBatchResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ExportResponseMultiError []error
+type QueryBatchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExportResponseMultiError) Error() string {
+func (m QueryBatchResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -819,11 +967,11 @@ func (m ExportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExportResponseMultiError) AllErrors() []error { return m }
+func (m QueryBatchResponseMultiError) AllErrors() []error { return m }
 
-// ExportResponseValidationError is the validation error returned by
-// ExportResponse.Validate if the designated constraints aren't met.
-type ExportResponseValidationError struct {
+// QueryBatchResponseValidationError is the validation error returned by
+// QueryBatchResponse.Validate if the designated constraints aren't met.
+type QueryBatchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -831,22 +979,24 @@ type ExportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExportResponseValidationError) Field() string { return e.field }
+func (e QueryBatchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExportResponseValidationError) Reason() string { return e.reason }
+func (e QueryBatchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExportResponseValidationError) Cause() error { return e.cause }
+func (e QueryBatchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExportResponseValidationError) Key() bool { return e.key }
+func (e QueryBatchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExportResponseValidationError) ErrorName() string { return "ExportResponseValidationError" }
+func (e QueryBatchResponseValidationError) ErrorName() string {
+	return "QueryBatchResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExportResponseValidationError) Error() string {
+func (e QueryBatchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -858,14 +1008,14 @@ func (e ExportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExportResponse.%s: %s%s",
+		"invalid %sQueryBatchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExportResponseValidationError{}
+var _ error = QueryBatchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -873,24 +1023,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExportResponseValidationError{}
+} = QueryBatchResponseValidationError{}
 
-// Validate checks the field values on ExportReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExportReportRequest) Validate() error {
+// Validate checks the field values on ExportRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExportReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ExportReportRequestMultiError, or nil if none found.
-func (m *ExportReportRequest) ValidateAll() error {
+// ValidateAll checks the field values on ExportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportRequestMultiError, or
+// nil if none found.
+func (m *ExportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExportReportRequest) validate(all bool) error {
+func (m *ExportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -899,35 +1049,64 @@ func (m *ExportReportRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
-	// no validation rules for Report
-
 	// no validation rules for Limit
 
 	// no validation rules for Format
 
 	if all {
-		switch v := interface{}(m.GetExecutionTime()).(type) {
+		switch v := interface{}(m.GetQuery()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExportReportRequestValidationError{
-					field:  "ExecutionTime",
+				errors = append(errors, ExportRequestValidationError{
+					field:  "Query",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExportReportRequestValidationError{
-					field:  "ExecutionTime",
+				errors = append(errors, ExportRequestValidationError{
+					field:  "Query",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExportReportRequestValidationError{
-				field:  "ExecutionTime",
+			return ExportRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BakedQuery
+
+	if all {
+		switch v := interface{}(m.GetGoogleSheets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportRequestValidationError{
+					field:  "GoogleSheets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportRequestValidationError{
+					field:  "GoogleSheets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGoogleSheets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportRequestValidationError{
+				field:  "GoogleSheets",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -935,19 +1114,19 @@ func (m *ExportReportRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ExportReportRequestMultiError(errors)
+		return ExportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExportReportRequestMultiError is an error wrapping multiple validation
-// errors returned by ExportReportRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ExportReportRequestMultiError []error
+// ExportRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExportReportRequestMultiError) Error() string {
+func (m ExportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -956,11 +1135,11 @@ func (m ExportReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExportReportRequestMultiError) AllErrors() []error { return m }
+func (m ExportRequestMultiError) AllErrors() []error { return m }
 
-// ExportReportRequestValidationError is the validation error returned by
-// ExportReportRequest.Validate if the designated constraints aren't met.
-type ExportReportRequestValidationError struct {
+// ExportRequestValidationError is the validation error returned by
+// ExportRequest.Validate if the designated constraints aren't met.
+type ExportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -968,21 +1147,262 @@ type ExportReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExportReportRequestValidationError) Field() string { return e.field }
+func (e ExportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExportReportRequestValidationError) Reason() string { return e.reason }
+func (e ExportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExportReportRequestValidationError) Cause() error { return e.cause }
+func (e ExportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExportReportRequestValidationError) Key() bool { return e.key }
+func (e ExportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExportReportRequestValidationError) ErrorName() string {
-	return "ExportReportRequestValidationError"
-}
+func (e ExportRequestValidationError) ErrorName() string { return "ExportRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRequestValidationError{}
+
+// Validate checks the field values on ExportResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportResponseMultiError,
+// or nil if none found.
+func (m *ExportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadUrlPath
+
+	// no validation rules for SpreadsheetUrl
+
+	if len(errors) > 0 {
+		return ExportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportResponseMultiError is an error wrapping multiple validation errors
+// returned by ExportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ExportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportResponseMultiError) AllErrors() []error { return m }
+
+// ExportResponseValidationError is the validation error returned by
+// ExportResponse.Validate if the designated constraints aren't met.
+type ExportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportResponseValidationError) ErrorName() string { return "ExportResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportResponseValidationError{}
+
+// Validate checks the field values on ExportReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReportRequestMultiError, or nil if none found.
+func (m *ExportReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Report
+
+	// no validation rules for Limit
+
+	// no validation rules for Format
+
+	if all {
+		switch v := interface{}(m.GetExecutionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReportRequestValidationError{
+				field:  "ExecutionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReportRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReportRequestMultiError) AllErrors() []error { return m }
+
+// ExportReportRequestValidationError is the validation error returned by
+// ExportReportRequest.Validate if the designated constraints aren't met.
+type ExportReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReportRequestValidationError) ErrorName() string {
+	return "ExportReportRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
 func (e ExportReportRequestValidationError) Error() string {
@@ -1919,9 +2339,50 @@ func (m *Query) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
+	case *Query_MetricsViewContributionRequest:
+		if v == nil {
+			err := QueryValidationError{
+				field:  "Query",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetricsViewContributionRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryValidationError{
+						field:  "MetricsViewContributionRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryValidationError{
+						field:  "MetricsViewContributionRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetricsViewContributionRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryValidationError{
+					field:  "MetricsViewContributionRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
 		return QueryMultiError(errors)
@@ -2802,6 +3263,47 @@ func (m *QueryResult) validate(all bool) error {
 			}
 		}
 
+	case *QueryResult_MetricsViewContributionResponse:
+		if v == nil {
+			err := QueryResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetricsViewContributionResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryResultValidationError{
+						field:  "MetricsViewContributionResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryResultValidationError{
+						field:  "MetricsViewContributionResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetricsViewContributionResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryResultValidationError{
+					field:  "MetricsViewContributionResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -6090,51 +6592,77 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewComparisonResponseValidationError{}
 
-// Validate checks the field values on TimeRange with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TimeRange) Validate() error {
+// Validate checks the field values on MetricsViewContributionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewContributionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TimeRange with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TimeRangeMultiError, or nil
-// if none found.
-func (m *TimeRange) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewContributionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewContributionRequestMultiError, or nil if none found.
+func (m *MetricsViewContributionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TimeRange) validate(all bool) error {
+func (m *MetricsViewContributionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMeasure()) < 1 {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "Measure",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VolumeMeasure
+
 	if all {
-		switch v := interface{}(m.GetStart()).(type) {
+		switch v := interface{}(m.GetTimeRange()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeValidationError{
-					field:  "Start",
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "TimeRange",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeValidationError{
-					field:  "Start",
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "TimeRange",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TimeRangeValidationError{
-				field:  "Start",
+			return MetricsViewContributionRequestValidationError{
+				field:  "TimeRange",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -6142,57 +6670,92 @@ func (m *TimeRange) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetEnd()).(type) {
+		switch v := interface{}(m.GetComparisonTimeRange()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeValidationError{
-					field:  "End",
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "ComparisonTimeRange",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeValidationError{
-					field:  "End",
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "ComparisonTimeRange",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetComparisonTimeRange()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TimeRangeValidationError{
-				field:  "End",
+			return MetricsViewContributionRequestValidationError{
+				field:  "ComparisonTimeRange",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for IsoDuration
-
-	// no validation rules for IsoOffset
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewContributionRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for RoundToGrain
+	if m.GetLimit() < 0 {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for TimeZone
+	// no validation rules for Narrative
 
-	// no validation rules for Expression
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return TimeRangeMultiError(errors)
+		return MetricsViewContributionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeRangeMultiError is an error wrapping multiple validation errors returned
-// by TimeRange.ValidateAll() if the designated constraints aren't met.
-type TimeRangeMultiError []error
+// MetricsViewContributionRequestMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewContributionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewContributionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeRangeMultiError) Error() string {
+func (m MetricsViewContributionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6201,11 +6764,12 @@ func (m TimeRangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeRangeMultiError) AllErrors() []error { return m }
+func (m MetricsViewContributionRequestMultiError) AllErrors() []error { return m }
 
-// TimeRangeValidationError is the validation error returned by
-// TimeRange.Validate if the designated constraints aren't met.
-type TimeRangeValidationError struct {
+// MetricsViewContributionRequestValidationError is the validation error
+// returned by MetricsViewContributionRequest.Validate if the designated
+// constraints aren't met.
+type MetricsViewContributionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6213,22 +6777,24 @@ type TimeRangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeRangeValidationError) Field() string { return e.field }
+func (e MetricsViewContributionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeRangeValidationError) Reason() string { return e.reason }
+func (e MetricsViewContributionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeRangeValidationError) Cause() error { return e.cause }
+func (e MetricsViewContributionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeRangeValidationError) Key() bool { return e.key }
+func (e MetricsViewContributionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeRangeValidationError) ErrorName() string { return "TimeRangeValidationError" }
+func (e MetricsViewContributionRequestValidationError) ErrorName() string {
+	return "MetricsViewContributionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TimeRangeValidationError) Error() string {
+func (e MetricsViewContributionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6240,14 +6806,14 @@ func (e TimeRangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeRange.%s: %s%s",
+		"invalid %sMetricsViewContributionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeRangeValidationError{}
+var _ error = MetricsViewContributionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6255,61 +6821,109 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeRangeValidationError{}
+} = MetricsViewContributionRequestValidationError{}
 
-// Validate checks the field values on MetricsViewComparisonSort with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewContributionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewComparisonSort) Validate() error {
+func (m *MetricsViewContributionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewComparisonSort with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewComparisonSortMultiError, or nil if none found.
-func (m *MetricsViewComparisonSort) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewContributionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewContributionResponseMultiError, or nil if none found.
+func (m *MetricsViewContributionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewComparisonSort) validate(all bool) error {
+func (m *MetricsViewContributionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := MetricsViewComparisonSortValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetTotals()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewContributionResponseValidationError{
+					field:  "Totals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewContributionResponseValidationError{
+					field:  "Totals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTotals()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewContributionResponseValidationError{
+				field:  "Totals",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Desc
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
 
-	// no validation rules for Type
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewContributionResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewContributionResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewContributionResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for SortType
+	}
+
+	// no validation rules for Narrative
 
 	if len(errors) > 0 {
-		return MetricsViewComparisonSortMultiError(errors)
+		return MetricsViewContributionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewComparisonSortMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewComparisonSort.ValidateAll() if the
-// designated constraints aren't met.
-type MetricsViewComparisonSortMultiError []error
+// MetricsViewContributionResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewContributionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewContributionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewComparisonSortMultiError) Error() string {
+func (m MetricsViewContributionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6318,11 +6932,12 @@ func (m MetricsViewComparisonSortMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewComparisonSortMultiError) AllErrors() []error { return m }
+func (m MetricsViewContributionResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewComparisonSortValidationError is the validation error returned by
-// MetricsViewComparisonSort.Validate if the designated constraints aren't met.
-type MetricsViewComparisonSortValidationError struct {
+// MetricsViewContributionResponseValidationError is the validation error
+// returned by MetricsViewContributionResponse.Validate if the designated
+// constraints aren't met.
+type MetricsViewContributionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6330,24 +6945,24 @@ type MetricsViewComparisonSortValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewComparisonSortValidationError) Field() string { return e.field }
+func (e MetricsViewContributionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewComparisonSortValidationError) Reason() string { return e.reason }
+func (e MetricsViewContributionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewComparisonSortValidationError) Cause() error { return e.cause }
+func (e MetricsViewContributionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewComparisonSortValidationError) Key() bool { return e.key }
+func (e MetricsViewContributionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewComparisonSortValidationError) ErrorName() string {
-	return "MetricsViewComparisonSortValidationError"
+func (e MetricsViewContributionResponseValidationError) ErrorName() string {
+	return "MetricsViewContributionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewComparisonSortValidationError) Error() string {
+func (e MetricsViewContributionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6359,14 +6974,14 @@ func (e MetricsViewComparisonSortValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewComparisonSort.%s: %s%s",
+		"invalid %sMetricsViewContributionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewComparisonSortValidationError{}
+var _ error = MetricsViewContributionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6374,107 +6989,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewComparisonSortValidationError{}
+} = MetricsViewContributionResponseValidationError{}
 
-// Validate checks the field values on MetricsViewComparisonRow with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewContributionTotals with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewComparisonRow) Validate() error {
+func (m *MetricsViewContributionTotals) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewComparisonRow with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewComparisonRowMultiError, or nil if none found.
-func (m *MetricsViewComparisonRow) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewContributionTotals with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewContributionTotalsMultiError, or nil if none found.
+func (m *MetricsViewContributionTotals) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewComparisonRow) validate(all bool) error {
+func (m *MetricsViewContributionTotals) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for BaseValue
+
+	// no validation rules for ComparisonValue
+
+	// no validation rules for DeltaAbs
+
 	if all {
-		switch v := interface{}(m.GetDimensionValue()).(type) {
+		switch v := interface{}(m.GetDeltaRel()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewComparisonRowValidationError{
-					field:  "DimensionValue",
+				errors = append(errors, MetricsViewContributionTotalsValidationError{
+					field:  "DeltaRel",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewComparisonRowValidationError{
-					field:  "DimensionValue",
+				errors = append(errors, MetricsViewContributionTotalsValidationError{
+					field:  "DeltaRel",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDimensionValue()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDeltaRel()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewComparisonRowValidationError{
-				field:  "DimensionValue",
+			return MetricsViewContributionTotalsValidationError{
+				field:  "DeltaRel",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetMeasureValues() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewComparisonRowValidationError{
-						field:  fmt.Sprintf("MeasureValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewComparisonRowValidationError{
-						field:  fmt.Sprintf("MeasureValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewComparisonRowValidationError{
-					field:  fmt.Sprintf("MeasureValues[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for BaseVolume
 
-	}
+	// no validation rules for ComparisonVolume
 
 	if len(errors) > 0 {
-		return MetricsViewComparisonRowMultiError(errors)
+		return MetricsViewContributionTotalsMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewComparisonRowMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewComparisonRow.ValidateAll() if the designated
-// constraints aren't met.
-type MetricsViewComparisonRowMultiError []error
+// MetricsViewContributionTotalsMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewContributionTotals.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewContributionTotalsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewComparisonRowMultiError) Error() string {
+func (m MetricsViewContributionTotalsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6483,11 +7074,12 @@ func (m MetricsViewComparisonRowMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewComparisonRowMultiError) AllErrors() []error { return m }
+func (m MetricsViewContributionTotalsMultiError) AllErrors() []error { return m }
 
-// MetricsViewComparisonRowValidationError is the validation error returned by
-// MetricsViewComparisonRow.Validate if the designated constraints aren't met.
-type MetricsViewComparisonRowValidationError struct {
+// MetricsViewContributionTotalsValidationError is the validation error
+// returned by MetricsViewContributionTotals.Validate if the designated
+// constraints aren't met.
+type MetricsViewContributionTotalsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6495,24 +7087,24 @@ type MetricsViewComparisonRowValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewComparisonRowValidationError) Field() string { return e.field }
+func (e MetricsViewContributionTotalsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewComparisonRowValidationError) Reason() string { return e.reason }
+func (e MetricsViewContributionTotalsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewComparisonRowValidationError) Cause() error { return e.cause }
+func (e MetricsViewContributionTotalsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewComparisonRowValidationError) Key() bool { return e.key }
+func (e MetricsViewContributionTotalsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewComparisonRowValidationError) ErrorName() string {
-	return "MetricsViewComparisonRowValidationError"
+func (e MetricsViewContributionTotalsValidationError) ErrorName() string {
+	return "MetricsViewContributionTotalsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewComparisonRowValidationError) Error() string {
+func (e MetricsViewContributionTotalsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6524,14 +7116,14 @@ func (e MetricsViewComparisonRowValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewComparisonRow.%s: %s%s",
+		"invalid %sMetricsViewContributionTotals.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewComparisonRowValidationError{}
+var _ error = MetricsViewContributionTotalsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6539,162 +7131,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewComparisonRowValidationError{}
+} = MetricsViewContributionTotalsValidationError{}
 
-// Validate checks the field values on MetricsViewComparisonValue with the
+// Validate checks the field values on MetricsViewContributionRow with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewComparisonValue) Validate() error {
+func (m *MetricsViewContributionRow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewComparisonValue with the
+// ValidateAll checks the field values on MetricsViewContributionRow with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewComparisonValueMultiError, or nil if none found.
-func (m *MetricsViewComparisonValue) ValidateAll() error {
+// MetricsViewContributionRowMultiError, or nil if none found.
+func (m *MetricsViewContributionRow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewComparisonValue) validate(all bool) error {
+func (m *MetricsViewContributionRow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for MeasureName
+	// no validation rules for Dimension
 
 	if all {
-		switch v := interface{}(m.GetBaseValue()).(type) {
+		switch v := interface{}(m.GetDimensionValue()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "BaseValue",
+				errors = append(errors, MetricsViewContributionRowValidationError{
+					field:  "DimensionValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "BaseValue",
+				errors = append(errors, MetricsViewContributionRowValidationError{
+					field:  "DimensionValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBaseValue()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDimensionValue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewComparisonValueValidationError{
-				field:  "BaseValue",
+			return MetricsViewContributionRowValidationError{
+				field:  "DimensionValue",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetComparisonValue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "ComparisonValue",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "ComparisonValue",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetComparisonValue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewComparisonValueValidationError{
-				field:  "ComparisonValue",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BaseValue
 
-	if all {
-		switch v := interface{}(m.GetDeltaAbs()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "DeltaAbs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "DeltaAbs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeltaAbs()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewComparisonValueValidationError{
-				field:  "DeltaAbs",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ComparisonValue
 
-	if all {
-		switch v := interface{}(m.GetDeltaRel()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "DeltaRel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewComparisonValueValidationError{
-					field:  "DeltaRel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeltaRel()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewComparisonValueValidationError{
-				field:  "DeltaRel",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for DeltaAbs
+
+	// no validation rules for Contribution
+
+	// no validation rules for BaseVolume
+
+	// no validation rules for ComparisonVolume
+
+	// no validation rules for VolumeEffect
+
+	// no validation rules for MixEffect
+
+	// no validation rules for RateEffect
 
 	if len(errors) > 0 {
-		return MetricsViewComparisonValueMultiError(errors)
+		return MetricsViewContributionRowMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewComparisonValueMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewComparisonValue.ValidateAll() if
+// MetricsViewContributionRowMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewContributionRow.ValidateAll() if
 // the designated constraints aren't met.
-type MetricsViewComparisonValueMultiError []error
+type MetricsViewContributionRowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewComparisonValueMultiError) Error() string {
+func (m MetricsViewContributionRowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6703,11 +7226,11 @@ func (m MetricsViewComparisonValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewComparisonValueMultiError) AllErrors() []error { return m }
+func (m MetricsViewContributionRowMultiError) AllErrors() []error { return m }
 
-// MetricsViewComparisonValueValidationError is the validation error returned
-// by MetricsViewComparisonValue.Validate if the designated constraints aren't met.
-type MetricsViewComparisonValueValidationError struct {
+// MetricsViewContributionRowValidationError is the validation error returned
+// by MetricsViewContributionRow.Validate if the designated constraints aren't met.
+type MetricsViewContributionRowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6715,24 +7238,24 @@ type MetricsViewComparisonValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewComparisonValueValidationError) Field() string { return e.field }
+func (e MetricsViewContributionRowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewComparisonValueValidationError) Reason() string { return e.reason }
+func (e MetricsViewContributionRowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewComparisonValueValidationError) Cause() error { return e.cause }
+func (e MetricsViewContributionRowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewComparisonValueValidationError) Key() bool { return e.key }
+func (e MetricsViewContributionRowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewComparisonValueValidationError) ErrorName() string {
-	return "MetricsViewComparisonValueValidationError"
+func (e MetricsViewContributionRowValidationError) ErrorName() string {
+	return "MetricsViewContributionRowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewComparisonValueValidationError) Error() string {
+func (e MetricsViewContributionRowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6744,14 +7267,14 @@ func (e MetricsViewComparisonValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewComparisonValue.%s: %s%s",
+		"invalid %sMetricsViewContributionRow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewComparisonValueValidationError{}
+var _ error = MetricsViewContributionRowValidationError{}
 
 var _ interface {
 	Field() string
@@ -6759,52 +7282,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewComparisonValueValidationError{}
+} = MetricsViewContributionRowValidationError{}
 
-// Validate checks the field values on MetricsViewComparisonMeasureAlias with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *MetricsViewComparisonMeasureAlias) Validate() error {
+// Validate checks the field values on TimeRange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeRange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewComparisonMeasureAlias
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// MetricsViewComparisonMeasureAliasMultiError, or nil if none found.
-func (m *MetricsViewComparisonMeasureAlias) ValidateAll() error {
+// ValidateAll checks the field values on TimeRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeRangeMultiError, or nil
+// if none found.
+func (m *TimeRange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewComparisonMeasureAlias) validate(all bool) error {
+func (m *TimeRange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for Type
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeRangeValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeRangeValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeRangeValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Alias
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeRangeValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeRangeValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeRangeValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsoDuration
+
+	// no validation rules for IsoOffset
+
+	// no validation rules for RoundToGrain
+
+	// no validation rules for TimeZone
+
+	// no validation rules for Expression
 
 	if len(errors) > 0 {
-		return MetricsViewComparisonMeasureAliasMultiError(errors)
+		return TimeRangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewComparisonMeasureAliasMultiError is an error wrapping multiple
-// validation errors returned by
-// MetricsViewComparisonMeasureAlias.ValidateAll() if the designated
-// constraints aren't met.
-type MetricsViewComparisonMeasureAliasMultiError []error
+// TimeRangeMultiError is an error wrapping multiple validation errors returned
+// by TimeRange.ValidateAll() if the designated constraints aren't met.
+type TimeRangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewComparisonMeasureAliasMultiError) Error() string {
+func (m TimeRangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6813,12 +7395,11 @@ func (m MetricsViewComparisonMeasureAliasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewComparisonMeasureAliasMultiError) AllErrors() []error { return m }
+func (m TimeRangeMultiError) AllErrors() []error { return m }
 
-// MetricsViewComparisonMeasureAliasValidationError is the validation error
-// returned by MetricsViewComparisonMeasureAlias.Validate if the designated
-// constraints aren't met.
-type MetricsViewComparisonMeasureAliasValidationError struct {
+// TimeRangeValidationError is the validation error returned by
+// TimeRange.Validate if the designated constraints aren't met.
+type TimeRangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6826,24 +7407,22 @@ type MetricsViewComparisonMeasureAliasValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewComparisonMeasureAliasValidationError) Field() string { return e.field }
+func (e TimeRangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewComparisonMeasureAliasValidationError) Reason() string { return e.reason }
+func (e TimeRangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewComparisonMeasureAliasValidationError) Cause() error { return e.cause }
+func (e TimeRangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewComparisonMeasureAliasValidationError) Key() bool { return e.key }
+func (e TimeRangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewComparisonMeasureAliasValidationError) ErrorName() string {
-	return "MetricsViewComparisonMeasureAliasValidationError"
-}
+func (e TimeRangeValidationError) ErrorName() string { return "TimeRangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewComparisonMeasureAliasValidationError) Error() string {
+func (e TimeRangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6855,14 +7434,14 @@ func (e MetricsViewComparisonMeasureAliasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewComparisonMeasureAlias.%s: %s%s",
+		"invalid %sTimeRange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewComparisonMeasureAliasValidationError{}
+var _ error = TimeRangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -6870,35 +7449,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewComparisonMeasureAliasValidationError{}
+} = TimeRangeValidationError{}
 
-// Validate checks the field values on MetricsViewTimeSeriesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewComparisonSort with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTimeSeriesRequest) Validate() error {
+func (m *MetricsViewComparisonSort) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTimeSeriesRequest with the
+// ValidateAll checks the field values on MetricsViewComparisonSort with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewTimeSeriesRequestMultiError, or nil if none found.
-func (m *MetricsViewTimeSeriesRequest) ValidateAll() error {
+// MetricsViewComparisonSortMultiError, or nil if none found.
+func (m *MetricsViewComparisonSort) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
+func (m *MetricsViewComparisonSort) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewTimeSeriesRequestValidationError{
-			field:  "MetricsViewName",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := MetricsViewComparisonSortValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -6907,175 +7484,391 @@ func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetMeasureNames()) < 1 {
-		err := MetricsViewTimeSeriesRequestValidationError{
-			field:  "MeasureNames",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for Desc
+
+	// no validation rules for Type
+
+	// no validation rules for SortType
+
+	if len(errors) > 0 {
+		return MetricsViewComparisonSortMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewComparisonSortMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewComparisonSort.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewComparisonSortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewComparisonSortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewComparisonSortMultiError) AllErrors() []error { return m }
+
+// MetricsViewComparisonSortValidationError is the validation error returned by
+// MetricsViewComparisonSort.Validate if the designated constraints aren't met.
+type MetricsViewComparisonSortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewComparisonSortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewComparisonSortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewComparisonSortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewComparisonSortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewComparisonSortValidationError) ErrorName() string {
+	return "MetricsViewComparisonSortValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewComparisonSortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewComparisonSort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewComparisonSortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewComparisonSortValidationError{}
+
+// Validate checks the field values on MetricsViewComparisonRow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewComparisonRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewComparisonRow with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewComparisonRowMultiError, or nil if none found.
+func (m *MetricsViewComparisonRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewComparisonRow) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetTimeStart()).(type) {
+		switch v := interface{}(m.GetDimensionValue()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "TimeStart",
+				errors = append(errors, MetricsViewComparisonRowValidationError{
+					field:  "DimensionValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "TimeStart",
+				errors = append(errors, MetricsViewComparisonRowValidationError{
+					field:  "DimensionValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeStart()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDimensionValue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTimeSeriesRequestValidationError{
-				field:  "TimeStart",
+			return MetricsViewComparisonRowValidationError{
+				field:  "DimensionValue",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	for idx, item := range m.GetMeasureValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewComparisonRowValidationError{
+						field:  fmt.Sprintf("MeasureValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewComparisonRowValidationError{
+						field:  fmt.Sprintf("MeasureValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewComparisonRowValidationError{
+					field:  fmt.Sprintf("MeasureValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewComparisonRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewComparisonRowMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewComparisonRow.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewComparisonRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewComparisonRowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewComparisonRowMultiError) AllErrors() []error { return m }
+
+// MetricsViewComparisonRowValidationError is the validation error returned by
+// MetricsViewComparisonRow.Validate if the designated constraints aren't met.
+type MetricsViewComparisonRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewComparisonRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewComparisonRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewComparisonRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewComparisonRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewComparisonRowValidationError) ErrorName() string {
+	return "MetricsViewComparisonRowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewComparisonRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewComparisonRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewComparisonRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewComparisonRowValidationError{}
+
+// Validate checks the field values on MetricsViewComparisonValue with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewComparisonValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewComparisonValue with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewComparisonValueMultiError, or nil if none found.
+func (m *MetricsViewComparisonValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewComparisonValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MeasureName
+
 	if all {
-		switch v := interface{}(m.GetTimeEnd()).(type) {
+		switch v := interface{}(m.GetBaseValue()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "TimeEnd",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "BaseValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "TimeEnd",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "BaseValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeEnd()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBaseValue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTimeSeriesRequestValidationError{
-				field:  "TimeEnd",
+			return MetricsViewComparisonValueValidationError{
+				field:  "BaseValue",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if _, ok := _MetricsViewTimeSeriesRequest_TimeGranularity_NotInLookup[m.GetTimeGranularity()]; ok {
-		err := MetricsViewTimeSeriesRequestValidationError{
-			field:  "TimeGranularity",
-			reason: "value must not be in list [TIME_GRAIN_UNSPECIFIED]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetWhere()).(type) {
+		switch v := interface{}(m.GetComparisonValue()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "Where",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "ComparisonValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "Where",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "ComparisonValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetComparisonValue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTimeSeriesRequestValidationError{
-				field:  "Where",
+			return MetricsViewComparisonValueValidationError{
+				field:  "ComparisonValue",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for WhereSql
-
 	if all {
-		switch v := interface{}(m.GetHaving()).(type) {
+		switch v := interface{}(m.GetDeltaAbs()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "Having",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "DeltaAbs",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "Having",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "DeltaAbs",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDeltaAbs()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTimeSeriesRequestValidationError{
-				field:  "Having",
+			return MetricsViewComparisonValueValidationError{
+				field:  "DeltaAbs",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for HavingSql
-
-	// no validation rules for TimeZone
-
-	// no validation rules for Priority
-
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetDeltaRel()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "DeltaRel",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, MetricsViewComparisonValueValidationError{
+					field:  "DeltaRel",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDeltaRel()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTimeSeriesRequestValidationError{
-				field:  "Filter",
+			return MetricsViewComparisonValueValidationError{
+				field:  "DeltaRel",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7083,19 +7876,19 @@ func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewTimeSeriesRequestMultiError(errors)
+		return MetricsViewComparisonValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewTimeSeriesRequestMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewTimeSeriesRequest.ValidateAll() if
+// MetricsViewComparisonValueMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewComparisonValue.ValidateAll() if
 // the designated constraints aren't met.
-type MetricsViewTimeSeriesRequestMultiError []error
+type MetricsViewComparisonValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTimeSeriesRequestMultiError) Error() string {
+func (m MetricsViewComparisonValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7104,12 +7897,11 @@ func (m MetricsViewTimeSeriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTimeSeriesRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewComparisonValueMultiError) AllErrors() []error { return m }
 
-// MetricsViewTimeSeriesRequestValidationError is the validation error returned
-// by MetricsViewTimeSeriesRequest.Validate if the designated constraints
-// aren't met.
-type MetricsViewTimeSeriesRequestValidationError struct {
+// MetricsViewComparisonValueValidationError is the validation error returned
+// by MetricsViewComparisonValue.Validate if the designated constraints aren't met.
+type MetricsViewComparisonValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7117,24 +7909,24 @@ type MetricsViewTimeSeriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTimeSeriesRequestValidationError) Field() string { return e.field }
+func (e MetricsViewComparisonValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTimeSeriesRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewComparisonValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTimeSeriesRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewComparisonValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTimeSeriesRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewComparisonValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTimeSeriesRequestValidationError) ErrorName() string {
-	return "MetricsViewTimeSeriesRequestValidationError"
-}
+func (e MetricsViewComparisonValueValidationError) ErrorName() string {
+	return "MetricsViewComparisonValueValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTimeSeriesRequestValidationError) Error() string {
+func (e MetricsViewComparisonValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7146,14 +7938,14 @@ func (e MetricsViewTimeSeriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTimeSeriesRequest.%s: %s%s",
+		"invalid %sMetricsViewComparisonValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTimeSeriesRequestValidationError{}
+var _ error = MetricsViewComparisonValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -7161,116 +7953,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTimeSeriesRequestValidationError{}
-
-var _MetricsViewTimeSeriesRequest_TimeGranularity_NotInLookup = map[TimeGrain]struct{}{
-	0: {},
-}
+} = MetricsViewComparisonValueValidationError{}
 
-// Validate checks the field values on MetricsViewTimeSeriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTimeSeriesResponse) Validate() error {
+// Validate checks the field values on MetricsViewComparisonMeasureAlias with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MetricsViewComparisonMeasureAlias) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTimeSeriesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// MetricsViewTimeSeriesResponseMultiError, or nil if none found.
-func (m *MetricsViewTimeSeriesResponse) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewComparisonMeasureAlias
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MetricsViewComparisonMeasureAliasMultiError, or nil if none found.
+func (m *MetricsViewComparisonMeasureAlias) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTimeSeriesResponse) validate(all bool) error {
+func (m *MetricsViewComparisonMeasureAlias) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMeta() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Meta[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Meta[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewTimeSeriesResponseValidationError{
-					field:  fmt.Sprintf("Meta[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
+	// no validation rules for Name
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewTimeSeriesResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Type
 
-	}
+	// no validation rules for Alias
 
 	if len(errors) > 0 {
-		return MetricsViewTimeSeriesResponseMultiError(errors)
+		return MetricsViewComparisonMeasureAliasMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewTimeSeriesResponseMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewTimeSeriesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type MetricsViewTimeSeriesResponseMultiError []error
+// MetricsViewComparisonMeasureAliasMultiError is an error wrapping multiple
+// validation errors returned by
+// MetricsViewComparisonMeasureAlias.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewComparisonMeasureAliasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTimeSeriesResponseMultiError) Error() string {
+func (m MetricsViewComparisonMeasureAliasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7279,12 +8007,12 @@ func (m MetricsViewTimeSeriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTimeSeriesResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewComparisonMeasureAliasMultiError) AllErrors() []error { return m }
 
-// MetricsViewTimeSeriesResponseValidationError is the validation error
-// returned by MetricsViewTimeSeriesResponse.Validate if the designated
+// MetricsViewComparisonMeasureAliasValidationError is the validation error
+// returned by MetricsViewComparisonMeasureAlias.Validate if the designated
 // constraints aren't met.
-type MetricsViewTimeSeriesResponseValidationError struct {
+type MetricsViewComparisonMeasureAliasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7292,24 +8020,24 @@ type MetricsViewTimeSeriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTimeSeriesResponseValidationError) Field() string { return e.field }
+func (e MetricsViewComparisonMeasureAliasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTimeSeriesResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewComparisonMeasureAliasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTimeSeriesResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewComparisonMeasureAliasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTimeSeriesResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewComparisonMeasureAliasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTimeSeriesResponseValidationError) ErrorName() string {
-	return "MetricsViewTimeSeriesResponseValidationError"
+func (e MetricsViewComparisonMeasureAliasValidationError) ErrorName() string {
+	return "MetricsViewComparisonMeasureAliasValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTimeSeriesResponseValidationError) Error() string {
+func (e MetricsViewComparisonMeasureAliasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7321,14 +8049,14 @@ func (e MetricsViewTimeSeriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTimeSeriesResponse.%s: %s%s",
+		"invalid %sMetricsViewComparisonMeasureAlias.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTimeSeriesResponseValidationError{}
+var _ error = MetricsViewComparisonMeasureAliasValidationError{}
 
 var _ interface {
 	Field() string
@@ -7336,24 +8064,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTimeSeriesResponseValidationError{}
+} = MetricsViewComparisonMeasureAliasValidationError{}
 
-// Validate checks the field values on MetricsViewTotalsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTotalsRequest) Validate() error {
+func (m *MetricsViewTimeSeriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTotalsRequest with the
+// ValidateAll checks the field values on MetricsViewTimeSeriesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewTotalsRequestMultiError, or nil if none found.
-func (m *MetricsViewTotalsRequest) ValidateAll() error {
+// MetricsViewTimeSeriesRequestMultiError, or nil if none found.
+func (m *MetricsViewTimeSeriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTotalsRequest) validate(all bool) error {
+func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7363,7 +8091,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 	// no validation rules for InstanceId
 
 	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewTotalsRequestValidationError{
+		err := MetricsViewTimeSeriesRequestValidationError{
 			field:  "MetricsViewName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -7374,7 +8102,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 	}
 
 	if len(m.GetMeasureNames()) < 1 {
-		err := MetricsViewTotalsRequestValidationError{
+		err := MetricsViewTimeSeriesRequestValidationError{
 			field:  "MeasureNames",
 			reason: "value must contain at least 1 item(s)",
 		}
@@ -7388,7 +8116,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		switch v := interface{}(m.GetTimeStart()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "TimeStart",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7396,7 +8124,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "TimeStart",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7405,7 +8133,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTimeStart()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTotalsRequestValidationError{
+			return MetricsViewTimeSeriesRequestValidationError{
 				field:  "TimeStart",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7417,7 +8145,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		switch v := interface{}(m.GetTimeEnd()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "TimeEnd",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7425,7 +8153,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "TimeEnd",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7434,7 +8162,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTimeEnd()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTotalsRequestValidationError{
+			return MetricsViewTimeSeriesRequestValidationError{
 				field:  "TimeEnd",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7442,11 +8170,22 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		}
 	}
 
+	if _, ok := _MetricsViewTimeSeriesRequest_TimeGranularity_NotInLookup[m.GetTimeGranularity()]; ok {
+		err := MetricsViewTimeSeriesRequestValidationError{
+			field:  "TimeGranularity",
+			reason: "value must not be in list [TIME_GRAIN_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetWhere()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "Where",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7454,7 +8193,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "Where",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7463,7 +8202,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTotalsRequestValidationError{
+			return MetricsViewTimeSeriesRequestValidationError{
 				field:  "Where",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7473,13 +8212,46 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 
 	// no validation rules for WhereSql
 
+	if all {
+		switch v := interface{}(m.GetHaving()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTimeSeriesRequestValidationError{
+				field:  "Having",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HavingSql
+
+	// no validation rules for TimeZone
+
 	// no validation rules for Priority
 
 	if all {
 		switch v := interface{}(m.GetFilter()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "Filter",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7487,7 +8259,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTotalsRequestValidationError{
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
 					field:  "Filter",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7496,7 +8268,7 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewTotalsRequestValidationError{
+			return MetricsViewTimeSeriesRequestValidationError{
 				field:  "Filter",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7504,20 +8276,49 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return MetricsViewTotalsRequestMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetForecast()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
+					field:  "Forecast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
+					field:  "Forecast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForecast()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTimeSeriesRequestValidationError{
+				field:  "Forecast",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return nil
+	if len(errors) > 0 {
+		return MetricsViewTimeSeriesRequestMultiError(errors)
+	}
+
+	return nil
 }
 
-// MetricsViewTotalsRequestMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewTotalsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type MetricsViewTotalsRequestMultiError []error
+// MetricsViewTimeSeriesRequestMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewTimeSeriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewTimeSeriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTotalsRequestMultiError) Error() string {
+func (m MetricsViewTimeSeriesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7526,11 +8327,12 @@ func (m MetricsViewTotalsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTotalsRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewTimeSeriesRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewTotalsRequestValidationError is the validation error returned by
-// MetricsViewTotalsRequest.Validate if the designated constraints aren't met.
-type MetricsViewTotalsRequestValidationError struct {
+// MetricsViewTimeSeriesRequestValidationError is the validation error returned
+// by MetricsViewTimeSeriesRequest.Validate if the designated constraints
+// aren't met.
+type MetricsViewTimeSeriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7538,24 +8340,24 @@ type MetricsViewTotalsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTotalsRequestValidationError) Field() string { return e.field }
+func (e MetricsViewTimeSeriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTotalsRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewTimeSeriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTotalsRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewTimeSeriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTotalsRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewTimeSeriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTotalsRequestValidationError) ErrorName() string {
-	return "MetricsViewTotalsRequestValidationError"
+func (e MetricsViewTimeSeriesRequestValidationError) ErrorName() string {
+	return "MetricsViewTimeSeriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTotalsRequestValidationError) Error() string {
+func (e MetricsViewTimeSeriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7567,14 +8369,14 @@ func (e MetricsViewTotalsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTotalsRequest.%s: %s%s",
+		"invalid %sMetricsViewTimeSeriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTotalsRequestValidationError{}
+var _ error = MetricsViewTimeSeriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7582,24 +8384,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTotalsRequestValidationError{}
+} = MetricsViewTimeSeriesRequestValidationError{}
 
-// Validate checks the field values on MetricsViewTotalsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+var _MetricsViewTimeSeriesRequest_TimeGranularity_NotInLookup = map[TimeGrain]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on MetricsViewTimeSeriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTotalsResponse) Validate() error {
+func (m *MetricsViewTimeSeriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTotalsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewTotalsResponseMultiError, or nil if none found.
-func (m *MetricsViewTotalsResponse) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewTimeSeriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewTimeSeriesResponseMultiError, or nil if none found.
+func (m *MetricsViewTimeSeriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTotalsResponse) validate(all bool) error {
+func (m *MetricsViewTimeSeriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7613,7 +8419,7 @@ func (m *MetricsViewTotalsResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewTotalsResponseValidationError{
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
 						field:  fmt.Sprintf("Meta[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -7621,7 +8427,7 @@ func (m *MetricsViewTotalsResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewTotalsResponseValidationError{
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
 						field:  fmt.Sprintf("Meta[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -7630,7 +8436,7 @@ func (m *MetricsViewTotalsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MetricsViewTotalsResponseValidationError{
+				return MetricsViewTimeSeriesResponseValidationError{
 					field:  fmt.Sprintf("Meta[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7640,49 +8446,88 @@ func (m *MetricsViewTotalsResponse) validate(all bool) error {
 
 	}
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTotalsResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTotalsResponseValidationError{
-					field:  "Data",
+				return MetricsViewTimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewTotalsResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	for idx, item := range m.GetForecast() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Forecast[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Forecast[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewTimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Forecast[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewTotalsResponseMultiError(errors)
+		return MetricsViewTimeSeriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewTotalsResponseMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewTotalsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type MetricsViewTotalsResponseMultiError []error
+// MetricsViewTimeSeriesResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewTimeSeriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewTimeSeriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTotalsResponseMultiError) Error() string {
+func (m MetricsViewTimeSeriesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7691,11 +8536,12 @@ func (m MetricsViewTotalsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTotalsResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewTimeSeriesResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewTotalsResponseValidationError is the validation error returned by
-// MetricsViewTotalsResponse.Validate if the designated constraints aren't met.
-type MetricsViewTotalsResponseValidationError struct {
+// MetricsViewTimeSeriesResponseValidationError is the validation error
+// returned by MetricsViewTimeSeriesResponse.Validate if the designated
+// constraints aren't met.
+type MetricsViewTimeSeriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7703,24 +8549,24 @@ type MetricsViewTotalsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTotalsResponseValidationError) Field() string { return e.field }
+func (e MetricsViewTimeSeriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTotalsResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewTimeSeriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTotalsResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewTimeSeriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTotalsResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewTimeSeriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTotalsResponseValidationError) ErrorName() string {
-	return "MetricsViewTotalsResponseValidationError"
+func (e MetricsViewTimeSeriesResponseValidationError) ErrorName() string {
+	return "MetricsViewTimeSeriesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTotalsResponseValidationError) Error() string {
+func (e MetricsViewTimeSeriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7732,14 +8578,14 @@ func (e MetricsViewTotalsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTotalsResponse.%s: %s%s",
+		"invalid %sMetricsViewTimeSeriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTotalsResponseValidationError{}
+var _ error = MetricsViewTimeSeriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7747,36 +8593,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTotalsResponseValidationError{}
+} = MetricsViewTimeSeriesResponseValidationError{}
 
-// Validate checks the field values on MetricsViewRowsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewTimeSeriesForecast with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewRowsRequest) Validate() error {
+func (m *MetricsViewTimeSeriesForecast) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewRowsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewRowsRequestMultiError, or nil if none found.
-func (m *MetricsViewRowsRequest) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewTimeSeriesForecast with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewTimeSeriesForecastMultiError, or nil if none found.
+func (m *MetricsViewTimeSeriesForecast) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewRowsRequest) validate(all bool) error {
+func (m *MetricsViewTimeSeriesForecast) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewRowsRequestValidationError{
-			field:  "MetricsViewName",
-			reason: "value length must be at least 1 runes",
+	if val := m.GetPeriods(); val < 1 || val > 1000 {
+		err := MetricsViewTimeSeriesForecastValidationError{
+			field:  "Periods",
+			reason: "value must be inside range [1, 1000]",
 		}
 		if !all {
 			return err
@@ -7784,178 +8628,243 @@ func (m *MetricsViewRowsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetTimeStart()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "TimeStart",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "TimeStart",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for Method
+
+	if m.GetSeasonLength() < 0 {
+		err := MetricsViewTimeSeriesForecastValidationError{
+			field:  "SeasonLength",
+			reason: "value must be greater than or equal to 0",
 		}
-	} else if v, ok := interface{}(m.GetTimeStart()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewRowsRequestValidationError{
-				field:  "TimeStart",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetTimeEnd()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "TimeEnd",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "TimeEnd",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if val := m.GetConfidence(); val < 0 || val >= 1 {
+		err := MetricsViewTimeSeriesForecastValidationError{
+			field:  "Confidence",
+			reason: "value must be inside range [0, 1)",
 		}
-	} else if v, ok := interface{}(m.GetTimeEnd()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewRowsRequestValidationError{
-				field:  "TimeEnd",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for TimeGranularity
+	if len(errors) > 0 {
+		return MetricsViewTimeSeriesForecastMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewTimeSeriesForecastMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewTimeSeriesForecast.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewTimeSeriesForecastMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewTimeSeriesForecastMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewTimeSeriesForecastMultiError) AllErrors() []error { return m }
+
+// MetricsViewTimeSeriesForecastValidationError is the validation error
+// returned by MetricsViewTimeSeriesForecast.Validate if the designated
+// constraints aren't met.
+type MetricsViewTimeSeriesForecastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewTimeSeriesForecastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewTimeSeriesForecastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewTimeSeriesForecastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewTimeSeriesForecastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewTimeSeriesForecastValidationError) ErrorName() string {
+	return "MetricsViewTimeSeriesForecastValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewTimeSeriesForecastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewTimeSeriesForecast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewTimeSeriesForecastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewTimeSeriesForecastValidationError{}
+
+// Validate checks the field values on TimeSeriesForecastValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesForecastValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesForecastValue with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesForecastValueMultiError, or nil if none found.
+func (m *TimeSeriesForecastValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesForecastValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetWhere()).(type) {
+		switch v := interface{}(m.GetTs()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "Where",
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Ts",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "Where",
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Ts",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTs()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewRowsRequestValidationError{
-				field:  "Where",
+			return TimeSeriesForecastValueValidationError{
+				field:  "Ts",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetSort() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewRowsRequestValidationError{
-						field:  fmt.Sprintf("Sort[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewRowsRequestValidationError{
-						field:  fmt.Sprintf("Sort[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetRecords()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Records",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewRowsRequestValidationError{
-					field:  fmt.Sprintf("Sort[%v]", idx),
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Records",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	}
-
-	if m.GetLimit() < 0 {
-		err := MetricsViewRowsRequestValidationError{
-			field:  "Limit",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetRecords()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesForecastValueValidationError{
+				field:  "Records",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if m.GetOffset() < 0 {
-		err := MetricsViewRowsRequestValidationError{
-			field:  "Offset",
-			reason: "value must be greater than or equal to 0",
+	if all {
+		switch v := interface{}(m.GetLower()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Lower",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Lower",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetLower()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesForecastValueValidationError{
+				field:  "Lower",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
-	// no validation rules for TimeZone
-
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetUpper()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Upper",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewRowsRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, TimeSeriesForecastValueValidationError{
+					field:  "Upper",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpper()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewRowsRequestValidationError{
-				field:  "Filter",
+			return TimeSeriesForecastValueValidationError{
+				field:  "Upper",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7963,19 +8872,19 @@ func (m *MetricsViewRowsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewRowsRequestMultiError(errors)
+		return TimeSeriesForecastValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewRowsRequestMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewRowsRequest.ValidateAll() if the designated
+// TimeSeriesForecastValueMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesForecastValue.ValidateAll() if the designated
 // constraints aren't met.
-type MetricsViewRowsRequestMultiError []error
+type TimeSeriesForecastValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewRowsRequestMultiError) Error() string {
+func (m TimeSeriesForecastValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7984,11 +8893,11 @@ func (m MetricsViewRowsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewRowsRequestMultiError) AllErrors() []error { return m }
+func (m TimeSeriesForecastValueMultiError) AllErrors() []error { return m }
 
-// MetricsViewRowsRequestValidationError is the validation error returned by
-// MetricsViewRowsRequest.Validate if the designated constraints aren't met.
-type MetricsViewRowsRequestValidationError struct {
+// TimeSeriesForecastValueValidationError is the validation error returned by
+// TimeSeriesForecastValue.Validate if the designated constraints aren't met.
+type TimeSeriesForecastValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7996,24 +8905,24 @@ type MetricsViewRowsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewRowsRequestValidationError) Field() string { return e.field }
+func (e TimeSeriesForecastValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewRowsRequestValidationError) Reason() string { return e.reason }
+func (e TimeSeriesForecastValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewRowsRequestValidationError) Cause() error { return e.cause }
+func (e TimeSeriesForecastValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewRowsRequestValidationError) Key() bool { return e.key }
+func (e TimeSeriesForecastValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewRowsRequestValidationError) ErrorName() string {
-	return "MetricsViewRowsRequestValidationError"
+func (e TimeSeriesForecastValueValidationError) ErrorName() string {
+	return "TimeSeriesForecastValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewRowsRequestValidationError) Error() string {
+func (e TimeSeriesForecastValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8025,14 +8934,14 @@ func (e MetricsViewRowsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewRowsRequest.%s: %s%s",
+		"invalid %sTimeSeriesForecastValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewRowsRequestValidationError{}
+var _ error = TimeSeriesForecastValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -8040,112 +8949,1578 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewRowsRequestValidationError{}
+} = TimeSeriesForecastValueValidationError{}
 
-// Validate checks the field values on MetricsViewRowsResponse with the rules
+// Validate checks the field values on MetricsViewTotalsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewRowsResponse) Validate() error {
+func (m *MetricsViewTotalsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewRowsResponse with the
+// ValidateAll checks the field values on MetricsViewTotalsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewRowsResponseMultiError, or nil if none found.
-func (m *MetricsViewRowsResponse) ValidateAll() error {
+// MetricsViewTotalsRequestMultiError, or nil if none found.
+func (m *MetricsViewTotalsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewRowsResponse) validate(all bool) error {
+func (m *MetricsViewTotalsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMeta() {
-		_, _ = idx, item
+	// no validation rules for InstanceId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewRowsResponseValidationError{
-						field:  fmt.Sprintf("Meta[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewRowsResponseValidationError{
-						field:  fmt.Sprintf("Meta[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewRowsResponseValidationError{
-					field:  fmt.Sprintf("Meta[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewTotalsRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMeasureNames()) < 1 {
+		err := MetricsViewTotalsRequestValidationError{
+			field:  "MeasureNames",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "TimeStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "TimeStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTotalsRequestValidationError{
+				field:  "TimeStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "TimeEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "TimeEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTotalsRequestValidationError{
+				field:  "TimeEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTotalsRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WhereSql
+
+	// no validation rules for Priority
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTotalsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTotalsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewTotalsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewTotalsRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewTotalsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewTotalsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewTotalsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewTotalsRequestMultiError) AllErrors() []error { return m }
+
+// MetricsViewTotalsRequestValidationError is the validation error returned by
+// MetricsViewTotalsRequest.Validate if the designated constraints aren't met.
+type MetricsViewTotalsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewTotalsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewTotalsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewTotalsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewTotalsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewTotalsRequestValidationError) ErrorName() string {
+	return "MetricsViewTotalsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewTotalsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewTotalsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewTotalsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewTotalsRequestValidationError{}
+
+// Validate checks the field values on MetricsViewTotalsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewTotalsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewTotalsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewTotalsResponseMultiError, or nil if none found.
+func (m *MetricsViewTotalsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewTotalsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMeta() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewTotalsResponseValidationError{
+						field:  fmt.Sprintf("Meta[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewTotalsResponseValidationError{
+						field:  fmt.Sprintf("Meta[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewTotalsResponseValidationError{
+					field:  fmt.Sprintf("Meta[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTotalsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTotalsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTotalsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewTotalsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewTotalsResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewTotalsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewTotalsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewTotalsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewTotalsResponseMultiError) AllErrors() []error { return m }
+
+// MetricsViewTotalsResponseValidationError is the validation error returned by
+// MetricsViewTotalsResponse.Validate if the designated constraints aren't met.
+type MetricsViewTotalsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewTotalsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewTotalsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewTotalsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewTotalsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewTotalsResponseValidationError) ErrorName() string {
+	return "MetricsViewTotalsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewTotalsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewTotalsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewTotalsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewTotalsResponseValidationError{}
+
+// Validate checks the field values on MetricsViewRowsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewRowsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewRowsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewRowsRequestMultiError, or nil if none found.
+func (m *MetricsViewRowsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewRowsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewRowsRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "TimeStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "TimeStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewRowsRequestValidationError{
+				field:  "TimeStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "TimeEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "TimeEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewRowsRequestValidationError{
+				field:  "TimeEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeGranularity
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewRowsRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSort() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewRowsRequestValidationError{
+						field:  fmt.Sprintf("Sort[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewRowsRequestValidationError{
+						field:  fmt.Sprintf("Sort[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewRowsRequestValidationError{
+					field:  fmt.Sprintf("Sort[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetLimit() < 0 {
+		err := MetricsViewRowsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := MetricsViewRowsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for TimeZone
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewRowsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewRowsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewRowsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewRowsRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewRowsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewRowsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewRowsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewRowsRequestMultiError) AllErrors() []error { return m }
+
+// MetricsViewRowsRequestValidationError is the validation error returned by
+// MetricsViewRowsRequest.Validate if the designated constraints aren't met.
+type MetricsViewRowsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewRowsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewRowsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewRowsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewRowsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewRowsRequestValidationError) ErrorName() string {
+	return "MetricsViewRowsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewRowsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewRowsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewRowsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewRowsRequestValidationError{}
+
+// Validate checks the field values on MetricsViewRowsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewRowsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewRowsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewRowsResponseMultiError, or nil if none found.
+func (m *MetricsViewRowsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewRowsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMeta() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewRowsResponseValidationError{
+						field:  fmt.Sprintf("Meta[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewRowsResponseValidationError{
+						field:  fmt.Sprintf("Meta[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewRowsResponseValidationError{
+					field:  fmt.Sprintf("Meta[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewRowsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewRowsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewRowsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewRowsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewRowsResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewRowsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewRowsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewRowsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewRowsResponseMultiError) AllErrors() []error { return m }
+
+// MetricsViewRowsResponseValidationError is the validation error returned by
+// MetricsViewRowsResponse.Validate if the designated constraints aren't met.
+type MetricsViewRowsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewRowsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewRowsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewRowsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewRowsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewRowsResponseValidationError) ErrorName() string {
+	return "MetricsViewRowsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewRowsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewRowsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewRowsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewRowsResponseValidationError{}
+
+// Validate checks the field values on MetricsViewSort with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSort with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSortMultiError, or nil if none found.
+func (m *MetricsViewSort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := MetricsViewSortValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Ascending
+
+	if len(errors) > 0 {
+		return MetricsViewSortMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSortMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewSort.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewSortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSortMultiError) AllErrors() []error { return m }
+
+// MetricsViewSortValidationError is the validation error returned by
+// MetricsViewSort.Validate if the designated constraints aren't met.
+type MetricsViewSortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSortValidationError) ErrorName() string { return "MetricsViewSortValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSortValidationError{}
+
+// Validate checks the field values on MetricsViewFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewFilterMultiError, or nil if none found.
+func (m *MetricsViewFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewFilterValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewFilterValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewFilterValidationError{
+					field:  fmt.Sprintf("Include[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewFilterValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewFilterValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewFilterValidationError{
+					field:  fmt.Sprintf("Exclude[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewFilterMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewFilter.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewFilterMultiError) AllErrors() []error { return m }
+
+// MetricsViewFilterValidationError is the validation error returned by
+// MetricsViewFilter.Validate if the designated constraints aren't met.
+type MetricsViewFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewFilterValidationError) ErrorName() string {
+	return "MetricsViewFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewFilterValidationError{}
+
+// Validate checks the field values on MetricsViewColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewColumn with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewColumnMultiError, or nil if none found.
+func (m *MetricsViewColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Nullable
+
+	if len(errors) > 0 {
+		return MetricsViewColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewColumnMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewColumn.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewColumnMultiError) AllErrors() []error { return m }
+
+// MetricsViewColumnValidationError is the validation error returned by
+// MetricsViewColumn.Validate if the designated constraints aren't met.
+type MetricsViewColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewColumnValidationError) ErrorName() string {
+	return "MetricsViewColumnValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewColumnValidationError{}
+
+// Validate checks the field values on InlineMeasure with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InlineMeasure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InlineMeasure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InlineMeasureMultiError, or
+// nil if none found.
+func (m *InlineMeasure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InlineMeasure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := InlineMeasureValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetExpression()) < 1 {
+		err := InlineMeasureValidationError{
+			field:  "Expression",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InlineMeasureMultiError(errors)
+	}
+
+	return nil
+}
+
+// InlineMeasureMultiError is an error wrapping multiple validation errors
+// returned by InlineMeasure.ValidateAll() if the designated constraints
+// aren't met.
+type InlineMeasureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InlineMeasureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InlineMeasureMultiError) AllErrors() []error { return m }
+
+// InlineMeasureValidationError is the validation error returned by
+// InlineMeasure.Validate if the designated constraints aren't met.
+type InlineMeasureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InlineMeasureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InlineMeasureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InlineMeasureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InlineMeasureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InlineMeasureValidationError) ErrorName() string { return "InlineMeasureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InlineMeasureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInlineMeasure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InlineMeasureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InlineMeasureValidationError{}
+
+// Validate checks the field values on MetricsViewTimeRangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewTimeRangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewTimeRangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewTimeRangeRequestMultiError, or nil if none found.
+func (m *MetricsViewTimeRangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewTimeRangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewTimeRangeRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return MetricsViewTimeRangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewTimeRangeRequestMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewTimeRangeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewTimeRangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewTimeRangeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewTimeRangeRequestMultiError) AllErrors() []error { return m }
+
+// MetricsViewTimeRangeRequestValidationError is the validation error returned
+// by MetricsViewTimeRangeRequest.Validate if the designated constraints
+// aren't met.
+type MetricsViewTimeRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewTimeRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewTimeRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewTimeRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewTimeRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewTimeRangeRequestValidationError) ErrorName() string {
+	return "MetricsViewTimeRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewTimeRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewTimeRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewTimeRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewTimeRangeRequestValidationError{}
+
+// Validate checks the field values on MetricsViewTimeRangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewTimeRangeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewTimeRangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewTimeRangeResponseMultiError, or nil if none found.
+func (m *MetricsViewTimeRangeResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *MetricsViewTimeRangeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
+	var errors []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewRowsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewRowsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetTimeRangeSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTimeRangeResponseValidationError{
+					field:  "TimeRangeSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewRowsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				errors = append(errors, MetricsViewTimeRangeResponseValidationError{
+					field:  "TimeRangeSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRangeSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTimeRangeResponseValidationError{
+				field:  "TimeRangeSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewRowsResponseMultiError(errors)
+		return MetricsViewTimeRangeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewRowsResponseMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewRowsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type MetricsViewRowsResponseMultiError []error
+// MetricsViewTimeRangeResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewTimeRangeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewTimeRangeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewRowsResponseMultiError) Error() string {
+func (m MetricsViewTimeRangeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8154,11 +10529,12 @@ func (m MetricsViewRowsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewRowsResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewTimeRangeResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewRowsResponseValidationError is the validation error returned by
-// MetricsViewRowsResponse.Validate if the designated constraints aren't met.
-type MetricsViewRowsResponseValidationError struct {
+// MetricsViewTimeRangeResponseValidationError is the validation error returned
+// by MetricsViewTimeRangeResponse.Validate if the designated constraints
+// aren't met.
+type MetricsViewTimeRangeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8166,24 +10542,24 @@ type MetricsViewRowsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewRowsResponseValidationError) Field() string { return e.field }
+func (e MetricsViewTimeRangeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewRowsResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewTimeRangeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewRowsResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewTimeRangeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewRowsResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewTimeRangeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewRowsResponseValidationError) ErrorName() string {
-	return "MetricsViewRowsResponseValidationError"
+func (e MetricsViewTimeRangeResponseValidationError) ErrorName() string {
+	return "MetricsViewTimeRangeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewRowsResponseValidationError) Error() string {
+func (e MetricsViewTimeRangeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8195,14 +10571,14 @@ func (e MetricsViewRowsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewRowsResponse.%s: %s%s",
+		"invalid %sMetricsViewTimeRangeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewRowsResponseValidationError{}
+var _ error = MetricsViewTimeRangeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8210,33 +10586,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewRowsResponseValidationError{}
+} = MetricsViewTimeRangeResponseValidationError{}
 
-// Validate checks the field values on MetricsViewSort with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSort) Validate() error {
+// Validate checks the field values on MetricsViewSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSchemaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSort with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSortMultiError, or nil if none found.
-func (m *MetricsViewSort) ValidateAll() error {
+// MetricsViewSchemaRequestMultiError, or nil if none found.
+func (m *MetricsViewSchemaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSort) validate(all bool) error {
+func (m *MetricsViewSchemaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := MetricsViewSortValidationError{
-			field:  "Name",
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewSchemaRequestValidationError{
+			field:  "MetricsViewName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -8245,22 +10623,22 @@ func (m *MetricsViewSort) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Ascending
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return MetricsViewSortMultiError(errors)
+		return MetricsViewSchemaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSortMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewSort.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewSortMultiError []error
+// MetricsViewSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSchemaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSortMultiError) Error() string {
+func (m MetricsViewSchemaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8269,11 +10647,11 @@ func (m MetricsViewSortMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSortMultiError) AllErrors() []error { return m }
+func (m MetricsViewSchemaRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewSortValidationError is the validation error returned by
-// MetricsViewSort.Validate if the designated constraints aren't met.
-type MetricsViewSortValidationError struct {
+// MetricsViewSchemaRequestValidationError is the validation error returned by
+// MetricsViewSchemaRequest.Validate if the designated constraints aren't met.
+type MetricsViewSchemaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8281,22 +10659,24 @@ type MetricsViewSortValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSortValidationError) Field() string { return e.field }
+func (e MetricsViewSchemaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSortValidationError) Reason() string { return e.reason }
+func (e MetricsViewSchemaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSortValidationError) Cause() error { return e.cause }
+func (e MetricsViewSchemaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSortValidationError) Key() bool { return e.key }
+func (e MetricsViewSchemaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSortValidationError) ErrorName() string { return "MetricsViewSortValidationError" }
+func (e MetricsViewSchemaRequestValidationError) ErrorName() string {
+	return "MetricsViewSchemaRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSortValidationError) Error() string {
+func (e MetricsViewSchemaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8308,14 +10688,14 @@ func (e MetricsViewSortValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSort.%s: %s%s",
+		"invalid %sMetricsViewSchemaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSortValidationError{}
+var _ error = MetricsViewSchemaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8323,112 +10703,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSortValidationError{}
+} = MetricsViewSchemaRequestValidationError{}
 
-// Validate checks the field values on MetricsViewFilter with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewFilter) Validate() error {
+// Validate checks the field values on MetricsViewSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSchemaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewFilter with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewFilterMultiError, or nil if none found.
-func (m *MetricsViewFilter) ValidateAll() error {
+// MetricsViewSchemaResponseMultiError, or nil if none found.
+func (m *MetricsViewSchemaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewFilter) validate(all bool) error {
+func (m *MetricsViewSchemaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInclude() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewFilterValidationError{
-						field:  fmt.Sprintf("Include[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewFilterValidationError{
-						field:  fmt.Sprintf("Include[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewFilterValidationError{
-					field:  fmt.Sprintf("Include[%v]", idx),
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSchemaResponseValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	for idx, item := range m.GetExclude() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewFilterValidationError{
-						field:  fmt.Sprintf("Exclude[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewFilterValidationError{
-						field:  fmt.Sprintf("Exclude[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewFilterValidationError{
-					field:  fmt.Sprintf("Exclude[%v]", idx),
+				errors = append(errors, MetricsViewSchemaResponseValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSchemaResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewFilterMultiError(errors)
+		return MetricsViewSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewFilterMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewFilter.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewFilterMultiError []error
+// MetricsViewSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSchemaResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewFilterMultiError) Error() string {
+func (m MetricsViewSchemaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8437,11 +10778,11 @@ func (m MetricsViewFilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewFilterMultiError) AllErrors() []error { return m }
+func (m MetricsViewSchemaResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewFilterValidationError is the validation error returned by
-// MetricsViewFilter.Validate if the designated constraints aren't met.
-type MetricsViewFilterValidationError struct {
+// MetricsViewSchemaResponseValidationError is the validation error returned by
+// MetricsViewSchemaResponse.Validate if the designated constraints aren't met.
+type MetricsViewSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8449,24 +10790,24 @@ type MetricsViewFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewFilterValidationError) Field() string { return e.field }
+func (e MetricsViewSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewFilterValidationError) Reason() string { return e.reason }
+func (e MetricsViewSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewFilterValidationError) Cause() error { return e.cause }
+func (e MetricsViewSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewFilterValidationError) Key() bool { return e.key }
+func (e MetricsViewSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewFilterValidationError) ErrorName() string {
-	return "MetricsViewFilterValidationError"
+func (e MetricsViewSchemaResponseValidationError) ErrorName() string {
+	return "MetricsViewSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewFilterValidationError) Error() string {
+func (e MetricsViewSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8478,14 +10819,14 @@ func (e MetricsViewFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewFilter.%s: %s%s",
+		"invalid %sMetricsViewSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewFilterValidationError{}
+var _ error = MetricsViewSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8493,50 +10834,159 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewFilterValidationError{}
+} = MetricsViewSchemaResponseValidationError{}
 
-// Validate checks the field values on MetricsViewColumn with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewColumn) Validate() error {
+// Validate checks the field values on MetricsViewSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSearchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewColumn with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewColumnMultiError, or nil if none found.
-func (m *MetricsViewColumn) ValidateAll() error {
+// MetricsViewSearchRequestMultiError, or nil if none found.
+func (m *MetricsViewSearchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewColumn) validate(all bool) error {
+func (m *MetricsViewSearchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for InstanceId
 
-	// no validation rules for Type
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewSearchRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Nullable
+	// no validation rules for Search
+
+	if all {
+		switch v := interface{}(m.GetTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSearchRequestValidationError{
+				field:  "TimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSearchRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHaving()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSearchRequestValidationError{
+				field:  "Having",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLimit() < 0 {
+		err := MetricsViewSearchRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return MetricsViewColumnMultiError(errors)
+		return MetricsViewSearchRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewColumnMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewColumn.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewColumnMultiError []error
+// MetricsViewSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSearchRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewColumnMultiError) Error() string {
+func (m MetricsViewSearchRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8545,11 +10995,11 @@ func (m MetricsViewColumnMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewColumnMultiError) AllErrors() []error { return m }
+func (m MetricsViewSearchRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewColumnValidationError is the validation error returned by
-// MetricsViewColumn.Validate if the designated constraints aren't met.
-type MetricsViewColumnValidationError struct {
+// MetricsViewSearchRequestValidationError is the validation error returned by
+// MetricsViewSearchRequest.Validate if the designated constraints aren't met.
+type MetricsViewSearchRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8557,24 +11007,24 @@ type MetricsViewColumnValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewColumnValidationError) Field() string { return e.field }
+func (e MetricsViewSearchRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewColumnValidationError) Reason() string { return e.reason }
+func (e MetricsViewSearchRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewColumnValidationError) Cause() error { return e.cause }
+func (e MetricsViewSearchRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewColumnValidationError) Key() bool { return e.key }
+func (e MetricsViewSearchRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewColumnValidationError) ErrorName() string {
-	return "MetricsViewColumnValidationError"
+func (e MetricsViewSearchRequestValidationError) ErrorName() string {
+	return "MetricsViewSearchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewColumnValidationError) Error() string {
+func (e MetricsViewSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8586,14 +11036,14 @@ func (e MetricsViewColumnValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewColumn.%s: %s%s",
+		"invalid %sMetricsViewSearchRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewColumnValidationError{}
+var _ error = MetricsViewSearchRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8601,66 +11051,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewColumnValidationError{}
+} = MetricsViewSearchRequestValidationError{}
 
-// Validate checks the field values on InlineMeasure with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *InlineMeasure) Validate() error {
+// Validate checks the field values on MetricsViewSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSearchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InlineMeasure with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InlineMeasureMultiError, or
-// nil if none found.
-func (m *InlineMeasure) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSearchResponseMultiError, or nil if none found.
+func (m *MetricsViewSearchResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InlineMeasure) validate(all bool) error {
+func (m *MetricsViewSearchResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := InlineMeasureValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSearchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetExpression()) < 1 {
-		err := InlineMeasureValidationError{
-			field:  "Expression",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return InlineMeasureMultiError(errors)
+		return MetricsViewSearchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InlineMeasureMultiError is an error wrapping multiple validation errors
-// returned by InlineMeasure.ValidateAll() if the designated constraints
-// aren't met.
-type InlineMeasureMultiError []error
+// MetricsViewSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSearchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewSearchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InlineMeasureMultiError) Error() string {
+func (m MetricsViewSearchResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8669,11 +11131,11 @@ func (m InlineMeasureMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InlineMeasureMultiError) AllErrors() []error { return m }
+func (m MetricsViewSearchResponseMultiError) AllErrors() []error { return m }
 
-// InlineMeasureValidationError is the validation error returned by
-// InlineMeasure.Validate if the designated constraints aren't met.
-type InlineMeasureValidationError struct {
+// MetricsViewSearchResponseValidationError is the validation error returned by
+// MetricsViewSearchResponse.Validate if the designated constraints aren't met.
+type MetricsViewSearchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8681,22 +11143,24 @@ type InlineMeasureValidationError struct {
 }
 
 // Field function returns field value.
-func (e InlineMeasureValidationError) Field() string { return e.field }
+func (e MetricsViewSearchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InlineMeasureValidationError) Reason() string { return e.reason }
+func (e MetricsViewSearchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InlineMeasureValidationError) Cause() error { return e.cause }
+func (e MetricsViewSearchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InlineMeasureValidationError) Key() bool { return e.key }
+func (e MetricsViewSearchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InlineMeasureValidationError) ErrorName() string { return "InlineMeasureValidationError" }
+func (e MetricsViewSearchResponseValidationError) ErrorName() string {
+	return "MetricsViewSearchResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e InlineMeasureValidationError) Error() string {
+func (e MetricsViewSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8708,14 +11172,14 @@ func (e InlineMeasureValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInlineMeasure.%s: %s%s",
+		"invalid %sMetricsViewSearchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InlineMeasureValidationError{}
+var _ error = MetricsViewSearchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8723,24 +11187,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InlineMeasureValidationError{}
+} = MetricsViewSearchResponseValidationError{}
 
-// Validate checks the field values on MetricsViewTimeRangeRequest with the
+// Validate checks the field values on MetricsViewTimeRangesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTimeRangeRequest) Validate() error {
+func (m *MetricsViewTimeRangesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTimeRangeRequest with the
+// ValidateAll checks the field values on MetricsViewTimeRangesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewTimeRangeRequestMultiError, or nil if none found.
-func (m *MetricsViewTimeRangeRequest) ValidateAll() error {
+// MetricsViewTimeRangesRequestMultiError, or nil if none found.
+func (m *MetricsViewTimeRangesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTimeRangeRequest) validate(all bool) error {
+func (m *MetricsViewTimeRangesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8750,7 +11214,7 @@ func (m *MetricsViewTimeRangeRequest) validate(all bool) error {
 	// no validation rules for InstanceId
 
 	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewTimeRangeRequestValidationError{
+		err := MetricsViewTimeRangesRequestValidationError{
 			field:  "MetricsViewName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8763,19 +11227,19 @@ func (m *MetricsViewTimeRangeRequest) validate(all bool) error {
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return MetricsViewTimeRangeRequestMultiError(errors)
+		return MetricsViewTimeRangesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewTimeRangeRequestMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewTimeRangeRequest.ValidateAll() if
+// MetricsViewTimeRangesRequestMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewTimeRangesRequest.ValidateAll() if
 // the designated constraints aren't met.
-type MetricsViewTimeRangeRequestMultiError []error
+type MetricsViewTimeRangesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTimeRangeRequestMultiError) Error() string {
+func (m MetricsViewTimeRangesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8784,12 +11248,12 @@ func (m MetricsViewTimeRangeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTimeRangeRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewTimeRangesRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewTimeRangeRequestValidationError is the validation error returned
-// by MetricsViewTimeRangeRequest.Validate if the designated constraints
+// MetricsViewTimeRangesRequestValidationError is the validation error returned
+// by MetricsViewTimeRangesRequest.Validate if the designated constraints
 // aren't met.
-type MetricsViewTimeRangeRequestValidationError struct {
+type MetricsViewTimeRangesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8797,24 +11261,24 @@ type MetricsViewTimeRangeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTimeRangeRequestValidationError) Field() string { return e.field }
+func (e MetricsViewTimeRangesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTimeRangeRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewTimeRangesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTimeRangeRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewTimeRangesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTimeRangeRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewTimeRangesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTimeRangeRequestValidationError) ErrorName() string {
-	return "MetricsViewTimeRangeRequestValidationError"
+func (e MetricsViewTimeRangesRequestValidationError) ErrorName() string {
+	return "MetricsViewTimeRangesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTimeRangeRequestValidationError) Error() string {
+func (e MetricsViewTimeRangesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8826,14 +11290,14 @@ func (e MetricsViewTimeRangeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTimeRangeRequest.%s: %s%s",
+		"invalid %sMetricsViewTimeRangesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTimeRangeRequestValidationError{}
+var _ error = MetricsViewTimeRangesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8841,73 +11305,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTimeRangeRequestValidationError{}
+} = MetricsViewTimeRangesRequestValidationError{}
 
-// Validate checks the field values on MetricsViewTimeRangeResponse with the
+// Validate checks the field values on MetricsViewTimeRangesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTimeRangeResponse) Validate() error {
+func (m *MetricsViewTimeRangesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTimeRangeResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewTimeRangeResponseMultiError, or nil if none found.
-func (m *MetricsViewTimeRangeResponse) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewTimeRangesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewTimeRangesResponseMultiError, or nil if none found.
+func (m *MetricsViewTimeRangesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTimeRangeResponse) validate(all bool) error {
+func (m *MetricsViewTimeRangesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTimeRangeSummary()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewTimeRangeResponseValidationError{
-					field:  "TimeRangeSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetTimeRanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewTimeRangesResponseValidationError{
+						field:  fmt.Sprintf("TimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewTimeRangesResponseValidationError{
+						field:  fmt.Sprintf("TimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewTimeRangeResponseValidationError{
-					field:  "TimeRangeSummary",
+				return MetricsViewTimeRangesResponseValidationError{
+					field:  fmt.Sprintf("TimeRanges[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTimeRangeSummary()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewTimeRangeResponseValidationError{
-				field:  "TimeRangeSummary",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewTimeRangeResponseMultiError(errors)
+		return MetricsViewTimeRangesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewTimeRangeResponseMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewTimeRangeResponse.ValidateAll() if
-// the designated constraints aren't met.
-type MetricsViewTimeRangeResponseMultiError []error
+// MetricsViewTimeRangesResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewTimeRangesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewTimeRangesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTimeRangeResponseMultiError) Error() string {
+func (m MetricsViewTimeRangesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8916,12 +11385,12 @@ func (m MetricsViewTimeRangeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTimeRangeResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewTimeRangesResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewTimeRangeResponseValidationError is the validation error returned
-// by MetricsViewTimeRangeResponse.Validate if the designated constraints
-// aren't met.
-type MetricsViewTimeRangeResponseValidationError struct {
+// MetricsViewTimeRangesResponseValidationError is the validation error
+// returned by MetricsViewTimeRangesResponse.Validate if the designated
+// constraints aren't met.
+type MetricsViewTimeRangesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8929,24 +11398,24 @@ type MetricsViewTimeRangeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTimeRangeResponseValidationError) Field() string { return e.field }
+func (e MetricsViewTimeRangesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTimeRangeResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewTimeRangesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTimeRangeResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewTimeRangesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTimeRangeResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewTimeRangesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTimeRangeResponseValidationError) ErrorName() string {
-	return "MetricsViewTimeRangeResponseValidationError"
+func (e MetricsViewTimeRangesResponseValidationError) ErrorName() string {
+	return "MetricsViewTimeRangesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTimeRangeResponseValidationError) Error() string {
+func (e MetricsViewTimeRangesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8958,14 +11427,14 @@ func (e MetricsViewTimeRangeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTimeRangeResponse.%s: %s%s",
+		"invalid %sMetricsViewTimeRangesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTimeRangeResponseValidationError{}
+var _ error = MetricsViewTimeRangesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8973,59 +11442,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTimeRangeResponseValidationError{}
+} = MetricsViewTimeRangesResponseValidationError{}
 
-// Validate checks the field values on MetricsViewSchemaRequest with the rules
+// Validate checks the field values on ResolveCanvasRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSchemaRequest) Validate() error {
+func (m *ResolveCanvasRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSchemaRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ResolveCanvasRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSchemaRequestMultiError, or nil if none found.
-func (m *MetricsViewSchemaRequest) ValidateAll() error {
+// ResolveCanvasRequestMultiError, or nil if none found.
+func (m *ResolveCanvasRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSchemaRequest) validate(all bool) error {
+func (m *ResolveCanvasRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for InstanceId
-
-	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewSchemaRequestValidationError{
-			field:  "MetricsViewName",
-			reason: "value length must be at least 1 runes",
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Canvas
+
+	if all {
+		switch v := interface{}(m.GetArgs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveCanvasRequestValidationError{
+					field:  "Args",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveCanvasRequestValidationError{
+					field:  "Args",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArgs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveCanvasRequestValidationError{
+				field:  "Args",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveCanvasRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveCanvasRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveCanvasRequestValidationError{
+				field:  "Filters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return MetricsViewSchemaRequestMultiError(errors)
+		return ResolveCanvasRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSchemaRequestMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSchemaRequest.ValidateAll() if the designated
+// ResolveCanvasRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveCanvasRequest.ValidateAll() if the designated
 // constraints aren't met.
-type MetricsViewSchemaRequestMultiError []error
+type ResolveCanvasRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSchemaRequestMultiError) Error() string {
+func (m ResolveCanvasRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9034,11 +11550,11 @@ func (m MetricsViewSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSchemaRequestMultiError) AllErrors() []error { return m }
+func (m ResolveCanvasRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewSchemaRequestValidationError is the validation error returned by
-// MetricsViewSchemaRequest.Validate if the designated constraints aren't met.
-type MetricsViewSchemaRequestValidationError struct {
+// ResolveCanvasRequestValidationError is the validation error returned by
+// ResolveCanvasRequest.Validate if the designated constraints aren't met.
+type ResolveCanvasRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9046,24 +11562,24 @@ type MetricsViewSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSchemaRequestValidationError) Field() string { return e.field }
+func (e ResolveCanvasRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSchemaRequestValidationError) Reason() string { return e.reason }
+func (e ResolveCanvasRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSchemaRequestValidationError) Cause() error { return e.cause }
+func (e ResolveCanvasRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSchemaRequestValidationError) Key() bool { return e.key }
+func (e ResolveCanvasRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSchemaRequestValidationError) ErrorName() string {
-	return "MetricsViewSchemaRequestValidationError"
+func (e ResolveCanvasRequestValidationError) ErrorName() string {
+	return "ResolveCanvasRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSchemaRequestValidationError) Error() string {
+func (e ResolveCanvasRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9075,14 +11591,14 @@ func (e MetricsViewSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSchemaRequest.%s: %s%s",
+		"invalid %sResolveCanvasRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSchemaRequestValidationError{}
+var _ error = ResolveCanvasRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9090,24 +11606,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSchemaRequestValidationError{}
+} = ResolveCanvasRequestValidationError{}
 
-// Validate checks the field values on MetricsViewSchemaResponse with the rules
+// Validate checks the field values on ResolveCanvasResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSchemaResponse) Validate() error {
+func (m *ResolveCanvasResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSchemaResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ResolveCanvasResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSchemaResponseMultiError, or nil if none found.
-func (m *MetricsViewSchemaResponse) ValidateAll() error {
+// ResolveCanvasResponseMultiError, or nil if none found.
+func (m *ResolveCanvasResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSchemaResponse) validate(all bool) error {
+func (m *ResolveCanvasResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9115,48 +11631,186 @@ func (m *MetricsViewSchemaResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSchema()).(type) {
+		switch v := interface{}(m.GetCanvas()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSchemaResponseValidationError{
-					field:  "Schema",
+				errors = append(errors, ResolveCanvasResponseValidationError{
+					field:  "Canvas",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSchemaResponseValidationError{
-					field:  "Schema",
+				errors = append(errors, ResolveCanvasResponseValidationError{
+					field:  "Canvas",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCanvas()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewSchemaResponseValidationError{
-				field:  "Schema",
+			return ResolveCanvasResponseValidationError{
+				field:  "Canvas",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetResolvedComponents()))
+		i := 0
+		for key := range m.GetResolvedComponents() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetResolvedComponents()[key]
+			_ = val
+
+			// no validation rules for ResolvedComponents[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ResolveCanvasResponseValidationError{
+							field:  fmt.Sprintf("ResolvedComponents[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ResolveCanvasResponseValidationError{
+							field:  fmt.Sprintf("ResolvedComponents[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ResolveCanvasResponseValidationError{
+						field:  fmt.Sprintf("ResolvedComponents[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetReferencedMetricsViews()))
+		i := 0
+		for key := range m.GetReferencedMetricsViews() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetReferencedMetricsViews()[key]
+			_ = val
+
+			// no validation rules for ReferencedMetricsViews[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ResolveCanvasResponseValidationError{
+							field:  fmt.Sprintf("ReferencedMetricsViews[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ResolveCanvasResponseValidationError{
+							field:  fmt.Sprintf("ReferencedMetricsViews[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ResolveCanvasResponseValidationError{
+						field:  fmt.Sprintf("ReferencedMetricsViews[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetComponentFilters()))
+		i := 0
+		for key := range m.GetComponentFilters() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetComponentFilters()[key]
+			_ = val
+
+			// no validation rules for ComponentFilters[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ResolveCanvasResponseValidationError{
+							field:  fmt.Sprintf("ComponentFilters[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ResolveCanvasResponseValidationError{
+							field:  fmt.Sprintf("ComponentFilters[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ResolveCanvasResponseValidationError{
+						field:  fmt.Sprintf("ComponentFilters[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
-		return MetricsViewSchemaResponseMultiError(errors)
+		return ResolveCanvasResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSchemaResponseMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSchemaResponse.ValidateAll() if the
-// designated constraints aren't met.
-type MetricsViewSchemaResponseMultiError []error
+// ResolveCanvasResponseMultiError is an error wrapping multiple validation
+// errors returned by ResolveCanvasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveCanvasResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSchemaResponseMultiError) Error() string {
+func (m ResolveCanvasResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9165,11 +11819,11 @@ func (m MetricsViewSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSchemaResponseMultiError) AllErrors() []error { return m }
+func (m ResolveCanvasResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewSchemaResponseValidationError is the validation error returned by
-// MetricsViewSchemaResponse.Validate if the designated constraints aren't met.
-type MetricsViewSchemaResponseValidationError struct {
+// ResolveCanvasResponseValidationError is the validation error returned by
+// ResolveCanvasResponse.Validate if the designated constraints aren't met.
+type ResolveCanvasResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9177,24 +11831,24 @@ type MetricsViewSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSchemaResponseValidationError) Field() string { return e.field }
+func (e ResolveCanvasResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSchemaResponseValidationError) Reason() string { return e.reason }
+func (e ResolveCanvasResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSchemaResponseValidationError) Cause() error { return e.cause }
+func (e ResolveCanvasResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSchemaResponseValidationError) Key() bool { return e.key }
+func (e ResolveCanvasResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSchemaResponseValidationError) ErrorName() string {
-	return "MetricsViewSchemaResponseValidationError"
+func (e ResolveCanvasResponseValidationError) ErrorName() string {
+	return "ResolveCanvasResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSchemaResponseValidationError) Error() string {
+func (e ResolveCanvasResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9206,14 +11860,14 @@ func (e MetricsViewSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSchemaResponse.%s: %s%s",
+		"invalid %sResolveCanvasResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSchemaResponseValidationError{}
+var _ error = ResolveCanvasResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9221,24 +11875,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSchemaResponseValidationError{}
+} = ResolveCanvasResponseValidationError{}
 
-// Validate checks the field values on MetricsViewSearchRequest with the rules
+// Validate checks the field values on ResolveComponentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSearchRequest) Validate() error {
+func (m *ResolveComponentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSearchRequest with the
+// ValidateAll checks the field values on ResolveComponentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSearchRequestMultiError, or nil if none found.
-func (m *MetricsViewSearchRequest) ValidateAll() error {
+// ResolveComponentRequestMultiError, or nil if none found.
+func (m *ResolveComponentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSearchRequest) validate(all bool) error {
+func (m *ResolveComponentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9247,133 +11901,82 @@ func (m *MetricsViewSearchRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
-	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewSearchRequestValidationError{
-			field:  "MetricsViewName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Search
+	// no validation rules for Component
 
 	if all {
-		switch v := interface{}(m.GetTimeRange()).(type) {
+		switch v := interface{}(m.GetArgs()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "TimeRange",
+				errors = append(errors, ResolveComponentRequestValidationError{
+					field:  "Args",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "TimeRange",
+				errors = append(errors, ResolveComponentRequestValidationError{
+					field:  "Args",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetArgs()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewSearchRequestValidationError{
-				field:  "TimeRange",
+			return ResolveComponentRequestValidationError{
+				field:  "Args",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetWhere()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Where",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Where",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewSearchRequestValidationError{
-				field:  "Where",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Canvas
 
 	if all {
-		switch v := interface{}(m.GetHaving()).(type) {
+		switch v := interface{}(m.GetFilters()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Having",
+				errors = append(errors, ResolveComponentRequestValidationError{
+					field:  "Filters",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Having",
+				errors = append(errors, ResolveComponentRequestValidationError{
+					field:  "Filters",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewSearchRequestValidationError{
-				field:  "Having",
+			return ResolveComponentRequestValidationError{
+				field:  "Filters",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetLimit() < 0 {
-		err := MetricsViewSearchRequestValidationError{
-			field:  "Limit",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return MetricsViewSearchRequestMultiError(errors)
+		return ResolveComponentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSearchRequestMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSearchRequest.ValidateAll() if the designated
+// ResolveComponentRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveComponentRequest.ValidateAll() if the designated
 // constraints aren't met.
-type MetricsViewSearchRequestMultiError []error
+type ResolveComponentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSearchRequestMultiError) Error() string {
+func (m ResolveComponentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9382,11 +11985,11 @@ func (m MetricsViewSearchRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSearchRequestMultiError) AllErrors() []error { return m }
+func (m ResolveComponentRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewSearchRequestValidationError is the validation error returned by
-// MetricsViewSearchRequest.Validate if the designated constraints aren't met.
-type MetricsViewSearchRequestValidationError struct {
+// ResolveComponentRequestValidationError is the validation error returned by
+// ResolveComponentRequest.Validate if the designated constraints aren't met.
+type ResolveComponentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9394,24 +11997,24 @@ type MetricsViewSearchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSearchRequestValidationError) Field() string { return e.field }
+func (e ResolveComponentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSearchRequestValidationError) Reason() string { return e.reason }
+func (e ResolveComponentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSearchRequestValidationError) Cause() error { return e.cause }
+func (e ResolveComponentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSearchRequestValidationError) Key() bool { return e.key }
+func (e ResolveComponentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSearchRequestValidationError) ErrorName() string {
-	return "MetricsViewSearchRequestValidationError"
+func (e ResolveComponentRequestValidationError) ErrorName() string {
+	return "ResolveComponentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSearchRequestValidationError) Error() string {
+func (e ResolveComponentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9423,14 +12026,14 @@ func (e MetricsViewSearchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSearchRequest.%s: %s%s",
+		"invalid %sResolveComponentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSearchRequestValidationError{}
+var _ error = ResolveComponentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9438,47 +12041,134 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSearchRequestValidationError{}
+} = ResolveComponentRequestValidationError{}
 
-// Validate checks the field values on MetricsViewSearchResponse with the rules
+// Validate checks the field values on ResolveComponentResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSearchResponse) Validate() error {
+func (m *ResolveComponentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSearchResponse with the
+// ValidateAll checks the field values on ResolveComponentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSearchResponseMultiError, or nil if none found.
-func (m *MetricsViewSearchResponse) ValidateAll() error {
+// ResolveComponentResponseMultiError, or nil if none found.
+func (m *ResolveComponentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSearchResponse) validate(all bool) error {
+func (m *ResolveComponentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	for idx, item := range m.GetResults() {
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRendererProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveComponentResponseValidationError{
+					field:  "RendererProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveComponentResponseValidationError{
+					field:  "RendererProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRendererProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveComponentResponseValidationError{
+				field:  "RendererProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveComponentResponseValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveComponentResponseValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveComponentResponseValidationError{
+				field:  "Filters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveComponentResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveComponentResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveComponentResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSearchResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
+					errors = append(errors, ResolveComponentResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSearchResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
+					errors = append(errors, ResolveComponentResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9486,8 +12176,8 @@ func (m *MetricsViewSearchResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MetricsViewSearchResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
+				return ResolveComponentResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9497,19 +12187,19 @@ func (m *MetricsViewSearchResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewSearchResponseMultiError(errors)
+		return ResolveComponentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSearchResponseMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSearchResponse.ValidateAll() if the
-// designated constraints aren't met.
-type MetricsViewSearchResponseMultiError []error
+// ResolveComponentResponseMultiError is an error wrapping multiple validation
+// errors returned by ResolveComponentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveComponentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSearchResponseMultiError) Error() string {
+func (m ResolveComponentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9518,11 +12208,11 @@ func (m MetricsViewSearchResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSearchResponseMultiError) AllErrors() []error { return m }
+func (m ResolveComponentResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewSearchResponseValidationError is the validation error returned by
-// MetricsViewSearchResponse.Validate if the designated constraints aren't met.
-type MetricsViewSearchResponseValidationError struct {
+// ResolveComponentResponseValidationError is the validation error returned by
+// ResolveComponentResponse.Validate if the designated constraints aren't met.
+type ResolveComponentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9530,24 +12220,24 @@ type MetricsViewSearchResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSearchResponseValidationError) Field() string { return e.field }
+func (e ResolveComponentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSearchResponseValidationError) Reason() string { return e.reason }
+func (e ResolveComponentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSearchResponseValidationError) Cause() error { return e.cause }
+func (e ResolveComponentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSearchResponseValidationError) Key() bool { return e.key }
+func (e ResolveComponentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSearchResponseValidationError) ErrorName() string {
-	return "MetricsViewSearchResponseValidationError"
+func (e ResolveComponentResponseValidationError) ErrorName() string {
+	return "ResolveComponentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSearchResponseValidationError) Error() string {
+func (e ResolveComponentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9559,14 +12249,14 @@ func (e MetricsViewSearchResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSearchResponse.%s: %s%s",
+		"invalid %sResolveComponentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSearchResponseValidationError{}
+var _ error = ResolveComponentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9574,59 +12264,104 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSearchResponseValidationError{}
+} = ResolveComponentResponseValidationError{}
 
-// Validate checks the field values on MetricsViewTimeRangesRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTimeRangesRequest) Validate() error {
+// Validate checks the field values on ComponentFilters with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ComponentFilters) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTimeRangesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ComponentFilters with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewTimeRangesRequestMultiError, or nil if none found.
-func (m *MetricsViewTimeRangesRequest) ValidateAll() error {
+// ComponentFiltersMultiError, or nil if none found.
+func (m *ComponentFilters) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTimeRangesRequest) validate(all bool) error {
+func (m *ComponentFilters) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComponentFiltersValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComponentFiltersValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComponentFiltersValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewTimeRangesRequestValidationError{
-			field:  "MetricsViewName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetHaving()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComponentFiltersValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComponentFiltersValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComponentFiltersValidationError{
+				field:  "Having",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
+	// no validation rules for TimeRange
 
 	if len(errors) > 0 {
-		return MetricsViewTimeRangesRequestMultiError(errors)
+		return ComponentFiltersMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewTimeRangesRequestMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewTimeRangesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type MetricsViewTimeRangesRequestMultiError []error
+// ComponentFiltersMultiError is an error wrapping multiple validation errors
+// returned by ComponentFilters.ValidateAll() if the designated constraints
+// aren't met.
+type ComponentFiltersMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTimeRangesRequestMultiError) Error() string {
+func (m ComponentFiltersMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9635,12 +12370,11 @@ func (m MetricsViewTimeRangesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTimeRangesRequestMultiError) AllErrors() []error { return m }
+func (m ComponentFiltersMultiError) AllErrors() []error { return m }
 
-// MetricsViewTimeRangesRequestValidationError is the validation error returned
-// by MetricsViewTimeRangesRequest.Validate if the designated constraints
-// aren't met.
-type MetricsViewTimeRangesRequestValidationError struct {
+// ComponentFiltersValidationError is the validation error returned by
+// ComponentFilters.Validate if the designated constraints aren't met.
+type ComponentFiltersValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9648,24 +12382,22 @@ type MetricsViewTimeRangesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTimeRangesRequestValidationError) Field() string { return e.field }
+func (e ComponentFiltersValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTimeRangesRequestValidationError) Reason() string { return e.reason }
+func (e ComponentFiltersValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTimeRangesRequestValidationError) Cause() error { return e.cause }
+func (e ComponentFiltersValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTimeRangesRequestValidationError) Key() bool { return e.key }
+func (e ComponentFiltersValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTimeRangesRequestValidationError) ErrorName() string {
-	return "MetricsViewTimeRangesRequestValidationError"
-}
+func (e ComponentFiltersValidationError) ErrorName() string { return "ComponentFiltersValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTimeRangesRequestValidationError) Error() string {
+func (e ComponentFiltersValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9677,14 +12409,14 @@ func (e MetricsViewTimeRangesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTimeRangesRequest.%s: %s%s",
+		"invalid %sComponentFilters.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTimeRangesRequestValidationError{}
+var _ error = ComponentFiltersValidationError{}
 
 var _ interface {
 	Field() string
@@ -9692,78 +12424,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTimeRangesRequestValidationError{}
+} = ComponentFiltersValidationError{}
 
-// Validate checks the field values on MetricsViewTimeRangesResponse with the
+// Validate checks the field values on ColumnRollupIntervalRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewTimeRangesResponse) Validate() error {
+func (m *ColumnRollupIntervalRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewTimeRangesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// MetricsViewTimeRangesResponseMultiError, or nil if none found.
-func (m *MetricsViewTimeRangesResponse) ValidateAll() error {
+// ValidateAll checks the field values on ColumnRollupIntervalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnRollupIntervalRequestMultiError, or nil if none found.
+func (m *ColumnRollupIntervalRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewTimeRangesResponse) validate(all bool) error {
+func (m *ColumnRollupIntervalRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTimeRanges() {
-		_, _ = idx, item
+	// no validation rules for InstanceId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewTimeRangesResponseValidationError{
-						field:  fmt.Sprintf("TimeRanges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewTimeRangesResponseValidationError{
-						field:  fmt.Sprintf("TimeRanges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewTimeRangesResponseValidationError{
-					field:  fmt.Sprintf("TimeRanges[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnRollupIntervalRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnRollupIntervalRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return MetricsViewTimeRangesResponseMultiError(errors)
+		return ColumnRollupIntervalRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewTimeRangesResponseMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewTimeRangesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type MetricsViewTimeRangesResponseMultiError []error
+// ColumnRollupIntervalRequestMultiError is an error wrapping multiple
+// validation errors returned by ColumnRollupIntervalRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ColumnRollupIntervalRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewTimeRangesResponseMultiError) Error() string {
+func (m ColumnRollupIntervalRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9772,12 +12502,12 @@ func (m MetricsViewTimeRangesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewTimeRangesResponseMultiError) AllErrors() []error { return m }
+func (m ColumnRollupIntervalRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewTimeRangesResponseValidationError is the validation error
-// returned by MetricsViewTimeRangesResponse.Validate if the designated
-// constraints aren't met.
-type MetricsViewTimeRangesResponseValidationError struct {
+// ColumnRollupIntervalRequestValidationError is the validation error returned
+// by ColumnRollupIntervalRequest.Validate if the designated constraints
+// aren't met.
+type ColumnRollupIntervalRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9785,24 +12515,24 @@ type MetricsViewTimeRangesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewTimeRangesResponseValidationError) Field() string { return e.field }
+func (e ColumnRollupIntervalRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewTimeRangesResponseValidationError) Reason() string { return e.reason }
+func (e ColumnRollupIntervalRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewTimeRangesResponseValidationError) Cause() error { return e.cause }
+func (e ColumnRollupIntervalRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewTimeRangesResponseValidationError) Key() bool { return e.key }
+func (e ColumnRollupIntervalRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewTimeRangesResponseValidationError) ErrorName() string {
-	return "MetricsViewTimeRangesResponseValidationError"
+func (e ColumnRollupIntervalRequestValidationError) ErrorName() string {
+	return "ColumnRollupIntervalRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewTimeRangesResponseValidationError) Error() string {
+func (e ColumnRollupIntervalRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9814,14 +12544,14 @@ func (e MetricsViewTimeRangesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewTimeRangesResponse.%s: %s%s",
+		"invalid %sColumnRollupIntervalRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewTimeRangesResponseValidationError{}
+var _ error = ColumnRollupIntervalRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9829,77 +12559,104 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewTimeRangesResponseValidationError{}
+} = ColumnRollupIntervalRequestValidationError{}
 
-// Validate checks the field values on ResolveCanvasRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ColumnRollupIntervalResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResolveCanvasRequest) Validate() error {
+func (m *ColumnRollupIntervalResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResolveCanvasRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnRollupIntervalResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResolveCanvasRequestMultiError, or nil if none found.
-func (m *ResolveCanvasRequest) ValidateAll() error {
+// ColumnRollupIntervalResponseMultiError, or nil if none found.
+func (m *ColumnRollupIntervalResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResolveCanvasRequest) validate(all bool) error {
+func (m *ColumnRollupIntervalResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Canvas
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnRollupIntervalResponseValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetArgs()).(type) {
+		switch v := interface{}(m.GetEnd()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResolveCanvasRequestValidationError{
-					field:  "Args",
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "End",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResolveCanvasRequestValidationError{
-					field:  "Args",
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "End",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetArgs()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResolveCanvasRequestValidationError{
-				field:  "Args",
+			return ColumnRollupIntervalResponseValidationError{
+				field:  "End",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Interval
+
 	if len(errors) > 0 {
-		return ResolveCanvasRequestMultiError(errors)
+		return ColumnRollupIntervalResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResolveCanvasRequestMultiError is an error wrapping multiple validation
-// errors returned by ResolveCanvasRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ResolveCanvasRequestMultiError []error
+// ColumnRollupIntervalResponseMultiError is an error wrapping multiple
+// validation errors returned by ColumnRollupIntervalResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ColumnRollupIntervalResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResolveCanvasRequestMultiError) Error() string {
+func (m ColumnRollupIntervalResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9908,11 +12665,12 @@ func (m ResolveCanvasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResolveCanvasRequestMultiError) AllErrors() []error { return m }
+func (m ColumnRollupIntervalResponseMultiError) AllErrors() []error { return m }
 
-// ResolveCanvasRequestValidationError is the validation error returned by
-// ResolveCanvasRequest.Validate if the designated constraints aren't met.
-type ResolveCanvasRequestValidationError struct {
+// ColumnRollupIntervalResponseValidationError is the validation error returned
+// by ColumnRollupIntervalResponse.Validate if the designated constraints
+// aren't met.
+type ColumnRollupIntervalResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9920,24 +12678,24 @@ type ResolveCanvasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResolveCanvasRequestValidationError) Field() string { return e.field }
+func (e ColumnRollupIntervalResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResolveCanvasRequestValidationError) Reason() string { return e.reason }
+func (e ColumnRollupIntervalResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResolveCanvasRequestValidationError) Cause() error { return e.cause }
+func (e ColumnRollupIntervalResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResolveCanvasRequestValidationError) Key() bool { return e.key }
+func (e ColumnRollupIntervalResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResolveCanvasRequestValidationError) ErrorName() string {
-	return "ResolveCanvasRequestValidationError"
+func (e ColumnRollupIntervalResponseValidationError) ErrorName() string {
+	return "ColumnRollupIntervalResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResolveCanvasRequestValidationError) Error() string {
+func (e ColumnRollupIntervalResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9949,14 +12707,14 @@ func (e ResolveCanvasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResolveCanvasRequest.%s: %s%s",
+		"invalid %sColumnRollupIntervalResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResolveCanvasRequestValidationError{}
+var _ error = ColumnRollupIntervalResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9964,165 +12722,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResolveCanvasRequestValidationError{}
+} = ColumnRollupIntervalResponseValidationError{}
 
-// Validate checks the field values on ResolveCanvasResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResolveCanvasResponse) Validate() error {
+// Validate checks the field values on ColumnTopKRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTopKRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResolveCanvasResponse with the rules
+// ValidateAll checks the field values on ColumnTopKRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResolveCanvasResponseMultiError, or nil if none found.
-func (m *ResolveCanvasResponse) ValidateAll() error {
+// ColumnTopKRequestMultiError, or nil if none found.
+func (m *ColumnTopKRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResolveCanvasResponse) validate(all bool) error {
+func (m *ColumnTopKRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCanvas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResolveCanvasResponseValidationError{
-					field:  "Canvas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResolveCanvasResponseValidationError{
-					field:  "Canvas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCanvas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResolveCanvasResponseValidationError{
-				field:  "Canvas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for InstanceId
 
-	{
-		sorted_keys := make([]string, len(m.GetResolvedComponents()))
-		i := 0
-		for key := range m.GetResolvedComponents() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetResolvedComponents()[key]
-			_ = val
+	// no validation rules for Connector
 
-			// no validation rules for ResolvedComponents[key]
+	// no validation rules for Database
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, ResolveCanvasResponseValidationError{
-							field:  fmt.Sprintf("ResolvedComponents[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, ResolveCanvasResponseValidationError{
-							field:  fmt.Sprintf("ResolvedComponents[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return ResolveCanvasResponseValidationError{
-						field:  fmt.Sprintf("ResolvedComponents[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for DatabaseSchema
 
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnTopKRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
-	}
-
-	{
-		sorted_keys := make([]string, len(m.GetReferencedMetricsViews()))
-		i := 0
-		for key := range m.GetReferencedMetricsViews() {
-			sorted_keys[i] = key
-			i++
+		if !all {
+			return err
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetReferencedMetricsViews()[key]
-			_ = val
-
-			// no validation rules for ReferencedMetricsViews[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, ResolveCanvasResponseValidationError{
-							field:  fmt.Sprintf("ReferencedMetricsViews[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, ResolveCanvasResponseValidationError{
-							field:  fmt.Sprintf("ReferencedMetricsViews[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return ResolveCanvasResponseValidationError{
-						field:  fmt.Sprintf("ReferencedMetricsViews[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnTopKRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Agg
+
+	// no validation rules for K
+
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return ResolveCanvasResponseMultiError(errors)
+		return ColumnTopKRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResolveCanvasResponseMultiError is an error wrapping multiple validation
-// errors returned by ResolveCanvasResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ResolveCanvasResponseMultiError []error
+// ColumnTopKRequestMultiError is an error wrapping multiple validation errors
+// returned by ColumnTopKRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnTopKRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResolveCanvasResponseMultiError) Error() string {
+func (m ColumnTopKRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10131,11 +12804,11 @@ func (m ResolveCanvasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResolveCanvasResponseMultiError) AllErrors() []error { return m }
+func (m ColumnTopKRequestMultiError) AllErrors() []error { return m }
 
-// ResolveCanvasResponseValidationError is the validation error returned by
-// ResolveCanvasResponse.Validate if the designated constraints aren't met.
-type ResolveCanvasResponseValidationError struct {
+// ColumnTopKRequestValidationError is the validation error returned by
+// ColumnTopKRequest.Validate if the designated constraints aren't met.
+type ColumnTopKRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10143,24 +12816,24 @@ type ResolveCanvasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResolveCanvasResponseValidationError) Field() string { return e.field }
+func (e ColumnTopKRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResolveCanvasResponseValidationError) Reason() string { return e.reason }
+func (e ColumnTopKRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResolveCanvasResponseValidationError) Cause() error { return e.cause }
+func (e ColumnTopKRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResolveCanvasResponseValidationError) Key() bool { return e.key }
+func (e ColumnTopKRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResolveCanvasResponseValidationError) ErrorName() string {
-	return "ResolveCanvasResponseValidationError"
+func (e ColumnTopKRequestValidationError) ErrorName() string {
+	return "ColumnTopKRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResolveCanvasResponseValidationError) Error() string {
+func (e ColumnTopKRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10172,14 +12845,14 @@ func (e ResolveCanvasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResolveCanvasResponse.%s: %s%s",
+		"invalid %sColumnTopKRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResolveCanvasResponseValidationError{}
+var _ error = ColumnTopKRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10187,57 +12860,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResolveCanvasResponseValidationError{}
+} = ColumnTopKRequestValidationError{}
 
-// Validate checks the field values on ResolveComponentRequest with the rules
+// Validate checks the field values on ColumnTopKResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResolveComponentRequest) Validate() error {
+func (m *ColumnTopKResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResolveComponentRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnTopKResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResolveComponentRequestMultiError, or nil if none found.
-func (m *ResolveComponentRequest) ValidateAll() error {
+// ColumnTopKResponseMultiError, or nil if none found.
+func (m *ColumnTopKResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResolveComponentRequest) validate(all bool) error {
+func (m *ColumnTopKResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Component
-
 	if all {
-		switch v := interface{}(m.GetArgs()).(type) {
+		switch v := interface{}(m.GetCategoricalSummary()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResolveComponentRequestValidationError{
-					field:  "Args",
+				errors = append(errors, ColumnTopKResponseValidationError{
+					field:  "CategoricalSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResolveComponentRequestValidationError{
-					field:  "Args",
+				errors = append(errors, ColumnTopKResponseValidationError{
+					field:  "CategoricalSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetArgs()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResolveComponentRequestValidationError{
-				field:  "Args",
+			return ColumnTopKResponseValidationError{
+				field:  "CategoricalSummary",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10245,19 +12914,19 @@ func (m *ResolveComponentRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ResolveComponentRequestMultiError(errors)
+		return ColumnTopKResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResolveComponentRequestMultiError is an error wrapping multiple validation
-// errors returned by ResolveComponentRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ResolveComponentRequestMultiError []error
+// ColumnTopKResponseMultiError is an error wrapping multiple validation errors
+// returned by ColumnTopKResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnTopKResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResolveComponentRequestMultiError) Error() string {
+func (m ColumnTopKResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10266,11 +12935,11 @@ func (m ResolveComponentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResolveComponentRequestMultiError) AllErrors() []error { return m }
+func (m ColumnTopKResponseMultiError) AllErrors() []error { return m }
 
-// ResolveComponentRequestValidationError is the validation error returned by
-// ResolveComponentRequest.Validate if the designated constraints aren't met.
-type ResolveComponentRequestValidationError struct {
+// ColumnTopKResponseValidationError is the validation error returned by
+// ColumnTopKResponse.Validate if the designated constraints aren't met.
+type ColumnTopKResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10278,24 +12947,24 @@ type ResolveComponentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResolveComponentRequestValidationError) Field() string { return e.field }
+func (e ColumnTopKResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResolveComponentRequestValidationError) Reason() string { return e.reason }
+func (e ColumnTopKResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResolveComponentRequestValidationError) Cause() error { return e.cause }
+func (e ColumnTopKResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResolveComponentRequestValidationError) Key() bool { return e.key }
+func (e ColumnTopKResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResolveComponentRequestValidationError) ErrorName() string {
-	return "ResolveComponentRequestValidationError"
+func (e ColumnTopKResponseValidationError) ErrorName() string {
+	return "ColumnTopKResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResolveComponentRequestValidationError) Error() string {
+func (e ColumnTopKResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10307,14 +12976,14 @@ func (e ResolveComponentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResolveComponentRequest.%s: %s%s",
+		"invalid %sColumnTopKResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResolveComponentRequestValidationError{}
+var _ error = ColumnTopKResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10322,73 +12991,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResolveComponentRequestValidationError{}
+} = ColumnTopKResponseValidationError{}
 
-// Validate checks the field values on ResolveComponentResponse with the rules
+// Validate checks the field values on CategoricalSummary with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResolveComponentResponse) Validate() error {
+func (m *CategoricalSummary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResolveComponentResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CategoricalSummary with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResolveComponentResponseMultiError, or nil if none found.
-func (m *ResolveComponentResponse) ValidateAll() error {
+// CategoricalSummaryMultiError, or nil if none found.
+func (m *CategoricalSummary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResolveComponentResponse) validate(all bool) error {
+func (m *CategoricalSummary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRendererProperties()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResolveComponentResponseValidationError{
-					field:  "RendererProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	switch v := m.Case.(type) {
+	case *CategoricalSummary_TopK:
+		if v == nil {
+			err := CategoricalSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTopK()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoricalSummaryValidationError{
+						field:  "TopK",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoricalSummaryValidationError{
+						field:  "TopK",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTopK()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResolveComponentResponseValidationError{
-					field:  "RendererProperties",
+				return CategoricalSummaryValidationError{
+					field:  "TopK",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetRendererProperties()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResolveComponentResponseValidationError{
-				field:  "RendererProperties",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	case *CategoricalSummary_Cardinality:
+		if v == nil {
+			err := CategoricalSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+		// no validation rules for Cardinality
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return ResolveComponentResponseMultiError(errors)
+		return CategoricalSummaryMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResolveComponentResponseMultiError is an error wrapping multiple validation
-// errors returned by ResolveComponentResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ResolveComponentResponseMultiError []error
+// CategoricalSummaryMultiError is an error wrapping multiple validation errors
+// returned by CategoricalSummary.ValidateAll() if the designated constraints
+// aren't met.
+type CategoricalSummaryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResolveComponentResponseMultiError) Error() string {
+func (m CategoricalSummaryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10397,11 +13095,11 @@ func (m ResolveComponentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResolveComponentResponseMultiError) AllErrors() []error { return m }
+func (m CategoricalSummaryMultiError) AllErrors() []error { return m }
 
-// ResolveComponentResponseValidationError is the validation error returned by
-// ResolveComponentResponse.Validate if the designated constraints aren't met.
-type ResolveComponentResponseValidationError struct {
+// CategoricalSummaryValidationError is the validation error returned by
+// CategoricalSummary.Validate if the designated constraints aren't met.
+type CategoricalSummaryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10409,24 +13107,24 @@ type ResolveComponentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResolveComponentResponseValidationError) Field() string { return e.field }
+func (e CategoricalSummaryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResolveComponentResponseValidationError) Reason() string { return e.reason }
+func (e CategoricalSummaryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResolveComponentResponseValidationError) Cause() error { return e.cause }
+func (e CategoricalSummaryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResolveComponentResponseValidationError) Key() bool { return e.key }
+func (e CategoricalSummaryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResolveComponentResponseValidationError) ErrorName() string {
-	return "ResolveComponentResponseValidationError"
+func (e CategoricalSummaryValidationError) ErrorName() string {
+	return "CategoricalSummaryValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResolveComponentResponseValidationError) Error() string {
+func (e CategoricalSummaryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10438,14 +13136,14 @@ func (e ResolveComponentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResolveComponentResponse.%s: %s%s",
+		"invalid %sCategoricalSummary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResolveComponentResponseValidationError{}
+var _ error = CategoricalSummaryValidationError{}
 
 var _ interface {
 	Field() string
@@ -10453,76 +13151,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResolveComponentResponseValidationError{}
+} = CategoricalSummaryValidationError{}
 
-// Validate checks the field values on ColumnRollupIntervalRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRollupIntervalRequest) Validate() error {
+// Validate checks the field values on TopK with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *TopK) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRollupIntervalRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ColumnRollupIntervalRequestMultiError, or nil if none found.
-func (m *ColumnRollupIntervalRequest) ValidateAll() error {
+// ValidateAll checks the field values on TopK with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TopKMultiError, or nil if none found.
+func (m *TopK) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRollupIntervalRequest) validate(all bool) error {
+func (m *TopK) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnRollupIntervalRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TopKValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TopKValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TopKValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnRollupIntervalRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnRollupIntervalRequestMultiError(errors)
+		return TopKMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRollupIntervalRequestMultiError is an error wrapping multiple
-// validation errors returned by ColumnRollupIntervalRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ColumnRollupIntervalRequestMultiError []error
+// TopKMultiError is an error wrapping multiple validation errors returned by
+// TopK.ValidateAll() if the designated constraints aren't met.
+type TopKMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRollupIntervalRequestMultiError) Error() string {
+func (m TopKMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10531,12 +13229,11 @@ func (m ColumnRollupIntervalRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRollupIntervalRequestMultiError) AllErrors() []error { return m }
+func (m TopKMultiError) AllErrors() []error { return m }
 
-// ColumnRollupIntervalRequestValidationError is the validation error returned
-// by ColumnRollupIntervalRequest.Validate if the designated constraints
-// aren't met.
-type ColumnRollupIntervalRequestValidationError struct {
+// TopKValidationError is the validation error returned by TopK.Validate if the
+// designated constraints aren't met.
+type TopKValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10544,24 +13241,22 @@ type ColumnRollupIntervalRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRollupIntervalRequestValidationError) Field() string { return e.field }
+func (e TopKValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRollupIntervalRequestValidationError) Reason() string { return e.reason }
+func (e TopKValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRollupIntervalRequestValidationError) Cause() error { return e.cause }
+func (e TopKValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRollupIntervalRequestValidationError) Key() bool { return e.key }
+func (e TopKValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRollupIntervalRequestValidationError) ErrorName() string {
-	return "ColumnRollupIntervalRequestValidationError"
-}
+func (e TopKValidationError) ErrorName() string { return "TopKValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ColumnRollupIntervalRequestValidationError) Error() string {
+func (e TopKValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10573,14 +13268,14 @@ func (e ColumnRollupIntervalRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRollupIntervalRequest.%s: %s%s",
+		"invalid %sTopK.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRollupIntervalRequestValidationError{}
+var _ error = TopKValidationError{}
 
 var _ interface {
 	Field() string
@@ -10588,104 +13283,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRollupIntervalRequestValidationError{}
+} = TopKValidationError{}
 
-// Validate checks the field values on ColumnRollupIntervalResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ColumnNullCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRollupIntervalResponse) Validate() error {
+func (m *ColumnNullCountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRollupIntervalResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnNullCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnRollupIntervalResponseMultiError, or nil if none found.
-func (m *ColumnRollupIntervalResponse) ValidateAll() error {
+// ColumnNullCountRequestMultiError, or nil if none found.
+func (m *ColumnNullCountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRollupIntervalResponse) validate(all bool) error {
+func (m *ColumnNullCountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStart()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnNullCountRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnRollupIntervalResponseValidationError{
-				field:  "Start",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetEnd()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnNullCountRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnRollupIntervalResponseValidationError{
-				field:  "End",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Interval
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return ColumnRollupIntervalResponseMultiError(errors)
+		return ColumnNullCountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRollupIntervalResponseMultiError is an error wrapping multiple
-// validation errors returned by ColumnRollupIntervalResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ColumnRollupIntervalResponseMultiError []error
+// ColumnNullCountRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnNullCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnNullCountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRollupIntervalResponseMultiError) Error() string {
+func (m ColumnNullCountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10694,12 +13361,11 @@ func (m ColumnRollupIntervalResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRollupIntervalResponseMultiError) AllErrors() []error { return m }
+func (m ColumnNullCountRequestMultiError) AllErrors() []error { return m }
 
-// ColumnRollupIntervalResponseValidationError is the validation error returned
-// by ColumnRollupIntervalResponse.Validate if the designated constraints
-// aren't met.
-type ColumnRollupIntervalResponseValidationError struct {
+// ColumnNullCountRequestValidationError is the validation error returned by
+// ColumnNullCountRequest.Validate if the designated constraints aren't met.
+type ColumnNullCountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10707,24 +13373,24 @@ type ColumnRollupIntervalResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRollupIntervalResponseValidationError) Field() string { return e.field }
+func (e ColumnNullCountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRollupIntervalResponseValidationError) Reason() string { return e.reason }
+func (e ColumnNullCountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRollupIntervalResponseValidationError) Cause() error { return e.cause }
+func (e ColumnNullCountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRollupIntervalResponseValidationError) Key() bool { return e.key }
+func (e ColumnNullCountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRollupIntervalResponseValidationError) ErrorName() string {
-	return "ColumnRollupIntervalResponseValidationError"
+func (e ColumnNullCountRequestValidationError) ErrorName() string {
+	return "ColumnNullCountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnRollupIntervalResponseValidationError) Error() string {
+func (e ColumnNullCountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10736,14 +13402,14 @@ func (e ColumnRollupIntervalResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRollupIntervalResponse.%s: %s%s",
+		"invalid %sColumnNullCountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRollupIntervalResponseValidationError{}
+var _ error = ColumnNullCountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10751,80 +13417,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRollupIntervalResponseValidationError{}
+} = ColumnNullCountRequestValidationError{}
 
-// Validate checks the field values on ColumnTopKRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTopKRequest) Validate() error {
+// Validate checks the field values on ColumnNullCountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnNullCountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTopKRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnNullCountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTopKRequestMultiError, or nil if none found.
-func (m *ColumnTopKRequest) ValidateAll() error {
+// ColumnNullCountResponseMultiError, or nil if none found.
+func (m *ColumnNullCountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTopKRequest) validate(all bool) error {
+func (m *ColumnNullCountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTopKRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnTopKRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Agg
-
-	// no validation rules for K
-
-	// no validation rules for Priority
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return ColumnTopKRequestMultiError(errors)
+		return ColumnNullCountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTopKRequestMultiError is an error wrapping multiple validation errors
-// returned by ColumnTopKRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ColumnTopKRequestMultiError []error
+// ColumnNullCountResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnNullCountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnNullCountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTopKRequestMultiError) Error() string {
+func (m ColumnNullCountResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10833,11 +13465,11 @@ func (m ColumnTopKRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTopKRequestMultiError) AllErrors() []error { return m }
+func (m ColumnNullCountResponseMultiError) AllErrors() []error { return m }
 
-// ColumnTopKRequestValidationError is the validation error returned by
-// ColumnTopKRequest.Validate if the designated constraints aren't met.
-type ColumnTopKRequestValidationError struct {
+// ColumnNullCountResponseValidationError is the validation error returned by
+// ColumnNullCountResponse.Validate if the designated constraints aren't met.
+type ColumnNullCountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10845,24 +13477,24 @@ type ColumnTopKRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTopKRequestValidationError) Field() string { return e.field }
+func (e ColumnNullCountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTopKRequestValidationError) Reason() string { return e.reason }
+func (e ColumnNullCountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTopKRequestValidationError) Cause() error { return e.cause }
+func (e ColumnNullCountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTopKRequestValidationError) Key() bool { return e.key }
+func (e ColumnNullCountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTopKRequestValidationError) ErrorName() string {
-	return "ColumnTopKRequestValidationError"
+func (e ColumnNullCountResponseValidationError) ErrorName() string {
+	return "ColumnNullCountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTopKRequestValidationError) Error() string {
+func (e ColumnNullCountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10874,14 +13506,14 @@ func (e ColumnTopKRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTopKRequest.%s: %s%s",
+		"invalid %sColumnNullCountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTopKRequestValidationError{}
+var _ error = ColumnNullCountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10889,73 +13521,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTopKRequestValidationError{}
+} = ColumnNullCountResponseValidationError{}
 
-// Validate checks the field values on ColumnTopKResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTopKResponse) Validate() error {
+// Validate checks the field values on ColumnDescriptiveStatisticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ColumnDescriptiveStatisticsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTopKResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ColumnTopKResponseMultiError, or nil if none found.
-func (m *ColumnTopKResponse) ValidateAll() error {
+// ValidateAll checks the field values on ColumnDescriptiveStatisticsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ColumnDescriptiveStatisticsRequestMultiError, or nil if none found.
+func (m *ColumnDescriptiveStatisticsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTopKResponse) validate(all bool) error {
+func (m *ColumnDescriptiveStatisticsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCategoricalSummary()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTopKResponseValidationError{
-					field:  "CategoricalSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTopKResponseValidationError{
-					field:  "CategoricalSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnDescriptiveStatisticsRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnTopKResponseValidationError{
-				field:  "CategoricalSummary",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnDescriptiveStatisticsRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return ColumnTopKResponseMultiError(errors)
+		return ColumnDescriptiveStatisticsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTopKResponseMultiError is an error wrapping multiple validation errors
-// returned by ColumnTopKResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ColumnTopKResponseMultiError []error
+// ColumnDescriptiveStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ColumnDescriptiveStatisticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTopKResponseMultiError) Error() string {
+func (m ColumnDescriptiveStatisticsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10964,11 +13601,12 @@ func (m ColumnTopKResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTopKResponseMultiError) AllErrors() []error { return m }
+func (m ColumnDescriptiveStatisticsRequestMultiError) AllErrors() []error { return m }
 
-// ColumnTopKResponseValidationError is the validation error returned by
-// ColumnTopKResponse.Validate if the designated constraints aren't met.
-type ColumnTopKResponseValidationError struct {
+// ColumnDescriptiveStatisticsRequestValidationError is the validation error
+// returned by ColumnDescriptiveStatisticsRequest.Validate if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10976,24 +13614,24 @@ type ColumnTopKResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTopKResponseValidationError) Field() string { return e.field }
+func (e ColumnDescriptiveStatisticsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTopKResponseValidationError) Reason() string { return e.reason }
+func (e ColumnDescriptiveStatisticsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTopKResponseValidationError) Cause() error { return e.cause }
+func (e ColumnDescriptiveStatisticsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTopKResponseValidationError) Key() bool { return e.key }
+func (e ColumnDescriptiveStatisticsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTopKResponseValidationError) ErrorName() string {
-	return "ColumnTopKResponseValidationError"
+func (e ColumnDescriptiveStatisticsRequestValidationError) ErrorName() string {
+	return "ColumnDescriptiveStatisticsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTopKResponseValidationError) Error() string {
+func (e ColumnDescriptiveStatisticsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11005,14 +13643,14 @@ func (e ColumnTopKResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTopKResponse.%s: %s%s",
+		"invalid %sColumnDescriptiveStatisticsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTopKResponseValidationError{}
+var _ error = ColumnDescriptiveStatisticsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11020,102 +13658,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTopKResponseValidationError{}
+} = ColumnDescriptiveStatisticsRequestValidationError{}
 
-// Validate checks the field values on CategoricalSummary with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CategoricalSummary) Validate() error {
+// Validate checks the field values on ColumnDescriptiveStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ColumnDescriptiveStatisticsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CategoricalSummary with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CategoricalSummaryMultiError, or nil if none found.
-func (m *CategoricalSummary) ValidateAll() error {
+// ValidateAll checks the field values on ColumnDescriptiveStatisticsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ColumnDescriptiveStatisticsResponseMultiError, or nil if none found.
+func (m *ColumnDescriptiveStatisticsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CategoricalSummary) validate(all bool) error {
+func (m *ColumnDescriptiveStatisticsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Case.(type) {
-	case *CategoricalSummary_TopK:
-		if v == nil {
-			err := CategoricalSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetTopK()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CategoricalSummaryValidationError{
-						field:  "TopK",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CategoricalSummaryValidationError{
-						field:  "TopK",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetNumericSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetTopK()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CategoricalSummaryValidationError{
-					field:  "TopK",
+				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
+					field:  "NumericSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *CategoricalSummary_Cardinality:
-		if v == nil {
-			err := CategoricalSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnDescriptiveStatisticsResponseValidationError{
+				field:  "NumericSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for Cardinality
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return CategoricalSummaryMultiError(errors)
+		return ColumnDescriptiveStatisticsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CategoricalSummaryMultiError is an error wrapping multiple validation errors
-// returned by CategoricalSummary.ValidateAll() if the designated constraints
-// aren't met.
-type CategoricalSummaryMultiError []error
+// ColumnDescriptiveStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ColumnDescriptiveStatisticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CategoricalSummaryMultiError) Error() string {
+func (m ColumnDescriptiveStatisticsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11124,11 +13735,12 @@ func (m CategoricalSummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CategoricalSummaryMultiError) AllErrors() []error { return m }
+func (m ColumnDescriptiveStatisticsResponseMultiError) AllErrors() []error { return m }
 
-// CategoricalSummaryValidationError is the validation error returned by
-// CategoricalSummary.Validate if the designated constraints aren't met.
-type CategoricalSummaryValidationError struct {
+// ColumnDescriptiveStatisticsResponseValidationError is the validation error
+// returned by ColumnDescriptiveStatisticsResponse.Validate if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11136,24 +13748,24 @@ type CategoricalSummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e CategoricalSummaryValidationError) Field() string { return e.field }
+func (e ColumnDescriptiveStatisticsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CategoricalSummaryValidationError) Reason() string { return e.reason }
+func (e ColumnDescriptiveStatisticsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CategoricalSummaryValidationError) Cause() error { return e.cause }
+func (e ColumnDescriptiveStatisticsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CategoricalSummaryValidationError) Key() bool { return e.key }
+func (e ColumnDescriptiveStatisticsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CategoricalSummaryValidationError) ErrorName() string {
-	return "CategoricalSummaryValidationError"
+func (e ColumnDescriptiveStatisticsResponseValidationError) ErrorName() string {
+	return "ColumnDescriptiveStatisticsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CategoricalSummaryValidationError) Error() string {
+func (e ColumnDescriptiveStatisticsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11165,14 +13777,14 @@ func (e CategoricalSummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCategoricalSummary.%s: %s%s",
+		"invalid %sColumnDescriptiveStatisticsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CategoricalSummaryValidationError{}
+var _ error = ColumnDescriptiveStatisticsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11180,76 +13792,172 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CategoricalSummaryValidationError{}
+} = ColumnDescriptiveStatisticsResponseValidationError{}
 
-// Validate checks the field values on TopK with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *TopK) Validate() error {
+// Validate checks the field values on NumericSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NumericSummary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TopK with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TopKMultiError, or nil if none found.
-func (m *TopK) ValidateAll() error {
+// ValidateAll checks the field values on NumericSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NumericSummaryMultiError,
+// or nil if none found.
+func (m *NumericSummary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TopK) validate(all bool) error {
+func (m *NumericSummary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEntries() {
-		_, _ = idx, item
+	switch v := m.Case.(type) {
+	case *NumericSummary_NumericHistogramBins:
+		if v == nil {
+			err := NumericSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetNumericHistogramBins()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TopKValidationError{
-						field:  fmt.Sprintf("Entries[%v]", idx),
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericHistogramBins",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TopKValidationError{
-						field:  fmt.Sprintf("Entries[%v]", idx),
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericHistogramBins",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetNumericHistogramBins()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TopKValidationError{
-					field:  fmt.Sprintf("Entries[%v]", idx),
+				return NumericSummaryValidationError{
+					field:  "NumericHistogramBins",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NumericSummary_NumericStatistics:
+		if v == nil {
+			err := NumericSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNumericStatistics()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericStatistics",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericStatistics",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNumericStatistics()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericSummaryValidationError{
+					field:  "NumericStatistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NumericSummary_NumericOutliers:
+		if v == nil {
+			err := NumericSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNumericOutliers()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericOutliers",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericOutliers",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNumericOutliers()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericSummaryValidationError{
+					field:  "NumericOutliers",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return TopKMultiError(errors)
+		return NumericSummaryMultiError(errors)
 	}
 
 	return nil
 }
 
-// TopKMultiError is an error wrapping multiple validation errors returned by
-// TopK.ValidateAll() if the designated constraints aren't met.
-type TopKMultiError []error
+// NumericSummaryMultiError is an error wrapping multiple validation errors
+// returned by NumericSummary.ValidateAll() if the designated constraints
+// aren't met.
+type NumericSummaryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TopKMultiError) Error() string {
+func (m NumericSummaryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11258,11 +13966,11 @@ func (m TopKMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TopKMultiError) AllErrors() []error { return m }
+func (m NumericSummaryMultiError) AllErrors() []error { return m }
 
-// TopKValidationError is the validation error returned by TopK.Validate if the
-// designated constraints aren't met.
-type TopKValidationError struct {
+// NumericSummaryValidationError is the validation error returned by
+// NumericSummary.Validate if the designated constraints aren't met.
+type NumericSummaryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11270,22 +13978,22 @@ type TopKValidationError struct {
 }
 
 // Field function returns field value.
-func (e TopKValidationError) Field() string { return e.field }
+func (e NumericSummaryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TopKValidationError) Reason() string { return e.reason }
+func (e NumericSummaryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TopKValidationError) Cause() error { return e.cause }
+func (e NumericSummaryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TopKValidationError) Key() bool { return e.key }
+func (e NumericSummaryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TopKValidationError) ErrorName() string { return "TopKValidationError" }
+func (e NumericSummaryValidationError) ErrorName() string { return "NumericSummaryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TopKValidationError) Error() string {
+func (e NumericSummaryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11297,14 +14005,14 @@ func (e TopKValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTopK.%s: %s%s",
+		"invalid %sNumericSummary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TopKValidationError{}
+var _ error = NumericSummaryValidationError{}
 
 var _ interface {
 	Field() string
@@ -11312,76 +14020,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TopKValidationError{}
+} = NumericSummaryValidationError{}
 
-// Validate checks the field values on ColumnNullCountRequest with the rules
+// Validate checks the field values on NumericHistogramBins with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNullCountRequest) Validate() error {
+func (m *NumericHistogramBins) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNullCountRequest with the rules
+// ValidateAll checks the field values on NumericHistogramBins with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnNullCountRequestMultiError, or nil if none found.
-func (m *ColumnNullCountRequest) ValidateAll() error {
+// NumericHistogramBinsMultiError, or nil if none found.
+func (m *NumericHistogramBins) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNullCountRequest) validate(all bool) error {
+func (m *NumericHistogramBins) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
+	for idx, item := range m.GetBins() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnNullCountRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericHistogramBinsValidationError{
+						field:  fmt.Sprintf("Bins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericHistogramBinsValidationError{
+						field:  fmt.Sprintf("Bins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericHistogramBinsValidationError{
+					field:  fmt.Sprintf("Bins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnNullCountRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnNullCountRequestMultiError(errors)
+		return NumericHistogramBinsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNullCountRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnNullCountRequest.ValidateAll() if the designated
+// NumericHistogramBinsMultiError is an error wrapping multiple validation
+// errors returned by NumericHistogramBins.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnNullCountRequestMultiError []error
+type NumericHistogramBinsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNullCountRequestMultiError) Error() string {
+func (m NumericHistogramBinsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11390,11 +14100,11 @@ func (m ColumnNullCountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNullCountRequestMultiError) AllErrors() []error { return m }
+func (m NumericHistogramBinsMultiError) AllErrors() []error { return m }
 
-// ColumnNullCountRequestValidationError is the validation error returned by
-// ColumnNullCountRequest.Validate if the designated constraints aren't met.
-type ColumnNullCountRequestValidationError struct {
+// NumericHistogramBinsValidationError is the validation error returned by
+// NumericHistogramBins.Validate if the designated constraints aren't met.
+type NumericHistogramBinsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11402,24 +14112,24 @@ type ColumnNullCountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNullCountRequestValidationError) Field() string { return e.field }
+func (e NumericHistogramBinsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNullCountRequestValidationError) Reason() string { return e.reason }
+func (e NumericHistogramBinsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNullCountRequestValidationError) Cause() error { return e.cause }
+func (e NumericHistogramBinsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNullCountRequestValidationError) Key() bool { return e.key }
+func (e NumericHistogramBinsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNullCountRequestValidationError) ErrorName() string {
-	return "ColumnNullCountRequestValidationError"
+func (e NumericHistogramBinsValidationError) ErrorName() string {
+	return "NumericHistogramBinsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnNullCountRequestValidationError) Error() string {
+func (e NumericHistogramBinsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11431,14 +14141,14 @@ func (e ColumnNullCountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNullCountRequest.%s: %s%s",
+		"invalid %sNumericHistogramBins.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNullCountRequestValidationError{}
+var _ error = NumericHistogramBinsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11446,46 +14156,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNullCountRequestValidationError{}
+} = NumericHistogramBinsValidationError{}
 
-// Validate checks the field values on ColumnNullCountResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNullCountResponse) Validate() error {
+// Validate checks the field values on NumericStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NumericStatistics) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNullCountResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on NumericStatistics with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnNullCountResponseMultiError, or nil if none found.
-func (m *ColumnNullCountResponse) ValidateAll() error {
+// NumericStatisticsMultiError, or nil if none found.
+func (m *NumericStatistics) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNullCountResponse) validate(all bool) error {
+func (m *NumericStatistics) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Count
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Mean
+
+	// no validation rules for Q25
+
+	// no validation rules for Q50
+
+	// no validation rules for Q75
+
+	// no validation rules for Sd
 
 	if len(errors) > 0 {
-		return ColumnNullCountResponseMultiError(errors)
+		return NumericStatisticsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNullCountResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnNullCountResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnNullCountResponseMultiError []error
+// NumericStatisticsMultiError is an error wrapping multiple validation errors
+// returned by NumericStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type NumericStatisticsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNullCountResponseMultiError) Error() string {
+func (m NumericStatisticsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11494,11 +14216,11 @@ func (m ColumnNullCountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNullCountResponseMultiError) AllErrors() []error { return m }
+func (m NumericStatisticsMultiError) AllErrors() []error { return m }
 
-// ColumnNullCountResponseValidationError is the validation error returned by
-// ColumnNullCountResponse.Validate if the designated constraints aren't met.
-type ColumnNullCountResponseValidationError struct {
+// NumericStatisticsValidationError is the validation error returned by
+// NumericStatistics.Validate if the designated constraints aren't met.
+type NumericStatisticsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11506,24 +14228,24 @@ type ColumnNullCountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNullCountResponseValidationError) Field() string { return e.field }
+func (e NumericStatisticsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNullCountResponseValidationError) Reason() string { return e.reason }
+func (e NumericStatisticsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNullCountResponseValidationError) Cause() error { return e.cause }
+func (e NumericStatisticsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNullCountResponseValidationError) Key() bool { return e.key }
+func (e NumericStatisticsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNullCountResponseValidationError) ErrorName() string {
-	return "ColumnNullCountResponseValidationError"
+func (e NumericStatisticsValidationError) ErrorName() string {
+	return "NumericStatisticsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnNullCountResponseValidationError) Error() string {
+func (e NumericStatisticsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11535,14 +14257,14 @@ func (e ColumnNullCountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNullCountResponse.%s: %s%s",
+		"invalid %sNumericStatistics.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNullCountResponseValidationError{}
+var _ error = NumericStatisticsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11550,78 +14272,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNullCountResponseValidationError{}
+} = NumericStatisticsValidationError{}
 
-// Validate checks the field values on ColumnDescriptiveStatisticsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ColumnDescriptiveStatisticsRequest) Validate() error {
+// Validate checks the field values on NumericOutliers with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NumericOutliers) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnDescriptiveStatisticsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ColumnDescriptiveStatisticsRequestMultiError, or nil if none found.
-func (m *ColumnDescriptiveStatisticsRequest) ValidateAll() error {
+// ValidateAll checks the field values on NumericOutliers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NumericOutliersMultiError, or nil if none found.
+func (m *NumericOutliers) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnDescriptiveStatisticsRequest) validate(all bool) error {
+func (m *NumericOutliers) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
+	for idx, item := range m.GetOutliers() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnDescriptiveStatisticsRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericOutliersValidationError{
+						field:  fmt.Sprintf("Outliers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericOutliersValidationError{
+						field:  fmt.Sprintf("Outliers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericOutliersValidationError{
+					field:  fmt.Sprintf("Outliers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnDescriptiveStatisticsRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnDescriptiveStatisticsRequestMultiError(errors)
+		return NumericOutliersMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnDescriptiveStatisticsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ColumnDescriptiveStatisticsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnDescriptiveStatisticsRequestMultiError []error
+// NumericOutliersMultiError is an error wrapping multiple validation errors
+// returned by NumericOutliers.ValidateAll() if the designated constraints
+// aren't met.
+type NumericOutliersMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnDescriptiveStatisticsRequestMultiError) Error() string {
+func (m NumericOutliersMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11630,12 +14352,11 @@ func (m ColumnDescriptiveStatisticsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnDescriptiveStatisticsRequestMultiError) AllErrors() []error { return m }
+func (m NumericOutliersMultiError) AllErrors() []error { return m }
 
-// ColumnDescriptiveStatisticsRequestValidationError is the validation error
-// returned by ColumnDescriptiveStatisticsRequest.Validate if the designated
-// constraints aren't met.
-type ColumnDescriptiveStatisticsRequestValidationError struct {
+// NumericOutliersValidationError is the validation error returned by
+// NumericOutliers.Validate if the designated constraints aren't met.
+type NumericOutliersValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11643,24 +14364,22 @@ type ColumnDescriptiveStatisticsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Field() string { return e.field }
+func (e NumericOutliersValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Reason() string { return e.reason }
+func (e NumericOutliersValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Cause() error { return e.cause }
+func (e NumericOutliersValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Key() bool { return e.key }
+func (e NumericOutliersValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnDescriptiveStatisticsRequestValidationError) ErrorName() string {
-	return "ColumnDescriptiveStatisticsRequestValidationError"
-}
+func (e NumericOutliersValidationError) ErrorName() string { return "NumericOutliersValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ColumnDescriptiveStatisticsRequestValidationError) Error() string {
+func (e NumericOutliersValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11672,14 +14391,14 @@ func (e ColumnDescriptiveStatisticsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnDescriptiveStatisticsRequest.%s: %s%s",
+		"invalid %sNumericOutliers.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnDescriptiveStatisticsRequestValidationError{}
+var _ error = NumericOutliersValidationError{}
 
 var _ interface {
 	Field() string
@@ -11687,75 +14406,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnDescriptiveStatisticsRequestValidationError{}
+} = NumericOutliersValidationError{}
 
-// Validate checks the field values on ColumnDescriptiveStatisticsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ColumnDescriptiveStatisticsResponse) Validate() error {
+// Validate checks the field values on ColumnTimeGrainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeGrainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnDescriptiveStatisticsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ColumnDescriptiveStatisticsResponseMultiError, or nil if none found.
-func (m *ColumnDescriptiveStatisticsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ColumnTimeGrainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeGrainRequestMultiError, or nil if none found.
+func (m *ColumnTimeGrainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnDescriptiveStatisticsResponse) validate(all bool) error {
+func (m *ColumnTimeGrainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNumericSummary()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
-					field:  "NumericSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
-					field:  "NumericSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnTimeGrainRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnDescriptiveStatisticsResponseValidationError{
-				field:  "NumericSummary",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnTimeGrainRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return ColumnDescriptiveStatisticsResponseMultiError(errors)
+		return ColumnTimeGrainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnDescriptiveStatisticsResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ColumnDescriptiveStatisticsResponse.ValidateAll() if the designated
+// ColumnTimeGrainRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeGrainRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnDescriptiveStatisticsResponseMultiError []error
+type ColumnTimeGrainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnDescriptiveStatisticsResponseMultiError) Error() string {
+func (m ColumnTimeGrainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11764,12 +14484,11 @@ func (m ColumnDescriptiveStatisticsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnDescriptiveStatisticsResponseMultiError) AllErrors() []error { return m }
+func (m ColumnTimeGrainRequestMultiError) AllErrors() []error { return m }
 
-// ColumnDescriptiveStatisticsResponseValidationError is the validation error
-// returned by ColumnDescriptiveStatisticsResponse.Validate if the designated
-// constraints aren't met.
-type ColumnDescriptiveStatisticsResponseValidationError struct {
+// ColumnTimeGrainRequestValidationError is the validation error returned by
+// ColumnTimeGrainRequest.Validate if the designated constraints aren't met.
+type ColumnTimeGrainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11777,24 +14496,24 @@ type ColumnDescriptiveStatisticsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Field() string { return e.field }
+func (e ColumnTimeGrainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Reason() string { return e.reason }
+func (e ColumnTimeGrainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Cause() error { return e.cause }
+func (e ColumnTimeGrainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Key() bool { return e.key }
+func (e ColumnTimeGrainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnDescriptiveStatisticsResponseValidationError) ErrorName() string {
-	return "ColumnDescriptiveStatisticsResponseValidationError"
+func (e ColumnTimeGrainRequestValidationError) ErrorName() string {
+	return "ColumnTimeGrainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnDescriptiveStatisticsResponseValidationError) Error() string {
+func (e ColumnTimeGrainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11806,14 +14525,14 @@ func (e ColumnDescriptiveStatisticsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnDescriptiveStatisticsResponse.%s: %s%s",
+		"invalid %sColumnTimeGrainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnDescriptiveStatisticsResponseValidationError{}
+var _ error = ColumnTimeGrainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11821,172 +14540,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnDescriptiveStatisticsResponseValidationError{}
+} = ColumnTimeGrainRequestValidationError{}
 
-// Validate checks the field values on NumericSummary with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *NumericSummary) Validate() error {
+// Validate checks the field values on ColumnTimeGrainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeGrainResponse) Validate() error {
 	return m.validate(false)
-}
-
-// ValidateAll checks the field values on NumericSummary with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NumericSummaryMultiError,
-// or nil if none found.
-func (m *NumericSummary) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *NumericSummary) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	switch v := m.Case.(type) {
-	case *NumericSummary_NumericHistogramBins:
-		if v == nil {
-			err := NumericSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetNumericHistogramBins()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericHistogramBins",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericHistogramBins",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNumericHistogramBins()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NumericSummaryValidationError{
-					field:  "NumericHistogramBins",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *NumericSummary_NumericStatistics:
-		if v == nil {
-			err := NumericSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetNumericStatistics()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericStatistics",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericStatistics",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNumericStatistics()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NumericSummaryValidationError{
-					field:  "NumericStatistics",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *NumericSummary_NumericOutliers:
-		if v == nil {
-			err := NumericSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+}
 
-		if all {
-			switch v := interface{}(m.GetNumericOutliers()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericOutliers",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericOutliers",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNumericOutliers()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NumericSummaryValidationError{
-					field:  "NumericOutliers",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ValidateAll checks the field values on ColumnTimeGrainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeGrainResponseMultiError, or nil if none found.
+func (m *ColumnTimeGrainResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
-	default:
-		_ = v // ensures v is used
+func (m *ColumnTimeGrainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for TimeGrain
+
 	if len(errors) > 0 {
-		return NumericSummaryMultiError(errors)
+		return ColumnTimeGrainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericSummaryMultiError is an error wrapping multiple validation errors
-// returned by NumericSummary.ValidateAll() if the designated constraints
-// aren't met.
-type NumericSummaryMultiError []error
+// ColumnTimeGrainResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeGrainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeGrainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericSummaryMultiError) Error() string {
+func (m ColumnTimeGrainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11995,11 +14588,11 @@ func (m NumericSummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericSummaryMultiError) AllErrors() []error { return m }
+func (m ColumnTimeGrainResponseMultiError) AllErrors() []error { return m }
 
-// NumericSummaryValidationError is the validation error returned by
-// NumericSummary.Validate if the designated constraints aren't met.
-type NumericSummaryValidationError struct {
+// ColumnTimeGrainResponseValidationError is the validation error returned by
+// ColumnTimeGrainResponse.Validate if the designated constraints aren't met.
+type ColumnTimeGrainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12007,22 +14600,24 @@ type NumericSummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericSummaryValidationError) Field() string { return e.field }
+func (e ColumnTimeGrainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericSummaryValidationError) Reason() string { return e.reason }
+func (e ColumnTimeGrainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericSummaryValidationError) Cause() error { return e.cause }
+func (e ColumnTimeGrainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericSummaryValidationError) Key() bool { return e.key }
+func (e ColumnTimeGrainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericSummaryValidationError) ErrorName() string { return "NumericSummaryValidationError" }
+func (e ColumnTimeGrainResponseValidationError) ErrorName() string {
+	return "ColumnTimeGrainResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NumericSummaryValidationError) Error() string {
+func (e ColumnTimeGrainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12034,14 +14629,14 @@ func (e NumericSummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericSummary.%s: %s%s",
+		"invalid %sColumnTimeGrainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericSummaryValidationError{}
+var _ error = ColumnTimeGrainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12049,78 +14644,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericSummaryValidationError{}
+} = ColumnTimeGrainResponseValidationError{}
 
-// Validate checks the field values on NumericHistogramBins with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ColumnNumericHistogramRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *NumericHistogramBins) Validate() error {
+func (m *ColumnNumericHistogramRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumericHistogramBins with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// NumericHistogramBinsMultiError, or nil if none found.
-func (m *NumericHistogramBins) ValidateAll() error {
+// ValidateAll checks the field values on ColumnNumericHistogramRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ColumnNumericHistogramRequestMultiError, or nil if none found.
+func (m *ColumnNumericHistogramRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumericHistogramBins) validate(all bool) error {
+func (m *ColumnNumericHistogramRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBins() {
-		_, _ = idx, item
+	// no validation rules for InstanceId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericHistogramBinsValidationError{
-						field:  fmt.Sprintf("Bins[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericHistogramBinsValidationError{
-						field:  fmt.Sprintf("Bins[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NumericHistogramBinsValidationError{
-					field:  fmt.Sprintf("Bins[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnNumericHistogramRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnNumericHistogramRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for HistogramMethod
+
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return NumericHistogramBinsMultiError(errors)
+		return ColumnNumericHistogramRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericHistogramBinsMultiError is an error wrapping multiple validation
-// errors returned by NumericHistogramBins.ValidateAll() if the designated
-// constraints aren't met.
-type NumericHistogramBinsMultiError []error
+// ColumnNumericHistogramRequestMultiError is an error wrapping multiple
+// validation errors returned by ColumnNumericHistogramRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ColumnNumericHistogramRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericHistogramBinsMultiError) Error() string {
+func (m ColumnNumericHistogramRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12129,11 +14724,12 @@ func (m NumericHistogramBinsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericHistogramBinsMultiError) AllErrors() []error { return m }
+func (m ColumnNumericHistogramRequestMultiError) AllErrors() []error { return m }
 
-// NumericHistogramBinsValidationError is the validation error returned by
-// NumericHistogramBins.Validate if the designated constraints aren't met.
-type NumericHistogramBinsValidationError struct {
+// ColumnNumericHistogramRequestValidationError is the validation error
+// returned by ColumnNumericHistogramRequest.Validate if the designated
+// constraints aren't met.
+type ColumnNumericHistogramRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12141,24 +14737,24 @@ type NumericHistogramBinsValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericHistogramBinsValidationError) Field() string { return e.field }
+func (e ColumnNumericHistogramRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericHistogramBinsValidationError) Reason() string { return e.reason }
+func (e ColumnNumericHistogramRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericHistogramBinsValidationError) Cause() error { return e.cause }
+func (e ColumnNumericHistogramRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericHistogramBinsValidationError) Key() bool { return e.key }
+func (e ColumnNumericHistogramRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericHistogramBinsValidationError) ErrorName() string {
-	return "NumericHistogramBinsValidationError"
+func (e ColumnNumericHistogramRequestValidationError) ErrorName() string {
+	return "ColumnNumericHistogramRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e NumericHistogramBinsValidationError) Error() string {
+func (e ColumnNumericHistogramRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12170,14 +14766,14 @@ func (e NumericHistogramBinsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericHistogramBins.%s: %s%s",
+		"invalid %sColumnNumericHistogramRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericHistogramBinsValidationError{}
+var _ error = ColumnNumericHistogramRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12185,58 +14781,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericHistogramBinsValidationError{}
+} = ColumnNumericHistogramRequestValidationError{}
 
-// Validate checks the field values on NumericStatistics with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *NumericStatistics) Validate() error {
+// Validate checks the field values on ColumnNumericHistogramResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnNumericHistogramResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumericStatistics with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// NumericStatisticsMultiError, or nil if none found.
-func (m *NumericStatistics) ValidateAll() error {
+// ValidateAll checks the field values on ColumnNumericHistogramResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ColumnNumericHistogramResponseMultiError, or nil if none found.
+func (m *ColumnNumericHistogramResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumericStatistics) validate(all bool) error {
+func (m *ColumnNumericHistogramResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
-
-	// no validation rules for Max
-
-	// no validation rules for Mean
-
-	// no validation rules for Q25
-
-	// no validation rules for Q50
-
-	// no validation rules for Q75
-
-	// no validation rules for Sd
+	if all {
+		switch v := interface{}(m.GetNumericSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnNumericHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnNumericHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnNumericHistogramResponseValidationError{
+				field:  "NumericSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return NumericStatisticsMultiError(errors)
+		return ColumnNumericHistogramResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericStatisticsMultiError is an error wrapping multiple validation errors
-// returned by NumericStatistics.ValidateAll() if the designated constraints
-// aren't met.
-type NumericStatisticsMultiError []error
+// ColumnNumericHistogramResponseMultiError is an error wrapping multiple
+// validation errors returned by ColumnNumericHistogramResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ColumnNumericHistogramResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericStatisticsMultiError) Error() string {
+func (m ColumnNumericHistogramResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12245,11 +14856,12 @@ func (m NumericStatisticsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericStatisticsMultiError) AllErrors() []error { return m }
+func (m ColumnNumericHistogramResponseMultiError) AllErrors() []error { return m }
 
-// NumericStatisticsValidationError is the validation error returned by
-// NumericStatistics.Validate if the designated constraints aren't met.
-type NumericStatisticsValidationError struct {
+// ColumnNumericHistogramResponseValidationError is the validation error
+// returned by ColumnNumericHistogramResponse.Validate if the designated
+// constraints aren't met.
+type ColumnNumericHistogramResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12257,24 +14869,24 @@ type NumericStatisticsValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericStatisticsValidationError) Field() string { return e.field }
+func (e ColumnNumericHistogramResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericStatisticsValidationError) Reason() string { return e.reason }
+func (e ColumnNumericHistogramResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericStatisticsValidationError) Cause() error { return e.cause }
+func (e ColumnNumericHistogramResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericStatisticsValidationError) Key() bool { return e.key }
+func (e ColumnNumericHistogramResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericStatisticsValidationError) ErrorName() string {
-	return "NumericStatisticsValidationError"
+func (e ColumnNumericHistogramResponseValidationError) ErrorName() string {
+	return "ColumnNumericHistogramResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e NumericStatisticsValidationError) Error() string {
+func (e ColumnNumericHistogramResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12286,14 +14898,14 @@ func (e NumericStatisticsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericStatistics.%s: %s%s",
+		"invalid %sColumnNumericHistogramResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericStatisticsValidationError{}
+var _ error = ColumnNumericHistogramResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12301,78 +14913,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericStatisticsValidationError{}
+} = ColumnNumericHistogramResponseValidationError{}
 
-// Validate checks the field values on NumericOutliers with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *NumericOutliers) Validate() error {
+// Validate checks the field values on ColumnRugHistogramRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnRugHistogramRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumericOutliers with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnRugHistogramRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// NumericOutliersMultiError, or nil if none found.
-func (m *NumericOutliers) ValidateAll() error {
+// ColumnRugHistogramRequestMultiError, or nil if none found.
+func (m *ColumnRugHistogramRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumericOutliers) validate(all bool) error {
+func (m *ColumnRugHistogramRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOutliers() {
-		_, _ = idx, item
+	// no validation rules for InstanceId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericOutliersValidationError{
-						field:  fmt.Sprintf("Outliers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericOutliersValidationError{
-						field:  fmt.Sprintf("Outliers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NumericOutliersValidationError{
-					field:  fmt.Sprintf("Outliers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnRugHistogramRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnRugHistogramRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return NumericOutliersMultiError(errors)
+		return ColumnRugHistogramRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericOutliersMultiError is an error wrapping multiple validation errors
-// returned by NumericOutliers.ValidateAll() if the designated constraints
-// aren't met.
-type NumericOutliersMultiError []error
+// ColumnRugHistogramRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnRugHistogramRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ColumnRugHistogramRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericOutliersMultiError) Error() string {
+func (m ColumnRugHistogramRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12381,11 +14991,11 @@ func (m NumericOutliersMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericOutliersMultiError) AllErrors() []error { return m }
+func (m ColumnRugHistogramRequestMultiError) AllErrors() []error { return m }
 
-// NumericOutliersValidationError is the validation error returned by
-// NumericOutliers.Validate if the designated constraints aren't met.
-type NumericOutliersValidationError struct {
+// ColumnRugHistogramRequestValidationError is the validation error returned by
+// ColumnRugHistogramRequest.Validate if the designated constraints aren't met.
+type ColumnRugHistogramRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12393,22 +15003,24 @@ type NumericOutliersValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericOutliersValidationError) Field() string { return e.field }
+func (e ColumnRugHistogramRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericOutliersValidationError) Reason() string { return e.reason }
+func (e ColumnRugHistogramRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericOutliersValidationError) Cause() error { return e.cause }
+func (e ColumnRugHistogramRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericOutliersValidationError) Key() bool { return e.key }
+func (e ColumnRugHistogramRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericOutliersValidationError) ErrorName() string { return "NumericOutliersValidationError" }
+func (e ColumnRugHistogramRequestValidationError) ErrorName() string {
+	return "ColumnRugHistogramRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NumericOutliersValidationError) Error() string {
+func (e ColumnRugHistogramRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12420,14 +15032,14 @@ func (e NumericOutliersValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericOutliers.%s: %s%s",
+		"invalid %sColumnRugHistogramRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericOutliersValidationError{}
+var _ error = ColumnRugHistogramRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12435,76 +15047,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericOutliersValidationError{}
+} = ColumnRugHistogramRequestValidationError{}
 
-// Validate checks the field values on ColumnTimeGrainRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ColumnRugHistogramResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeGrainRequest) Validate() error {
+func (m *ColumnRugHistogramResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeGrainRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnRugHistogramResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeGrainRequestMultiError, or nil if none found.
-func (m *ColumnTimeGrainRequest) ValidateAll() error {
+// ColumnRugHistogramResponseMultiError, or nil if none found.
+func (m *ColumnRugHistogramResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeGrainRequest) validate(all bool) error {
+func (m *ColumnRugHistogramResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTimeGrainRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnTimeGrainRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetNumericSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnRugHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnRugHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnRugHistogramResponseValidationError{
+				field:  "NumericSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnTimeGrainRequestMultiError(errors)
+		return ColumnRugHistogramResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeGrainRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeGrainRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnTimeGrainRequestMultiError []error
+// ColumnRugHistogramResponseMultiError is an error wrapping multiple
+// validation errors returned by ColumnRugHistogramResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ColumnRugHistogramResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeGrainRequestMultiError) Error() string {
+func (m ColumnRugHistogramResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12513,11 +15122,11 @@ func (m ColumnTimeGrainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeGrainRequestMultiError) AllErrors() []error { return m }
+func (m ColumnRugHistogramResponseMultiError) AllErrors() []error { return m }
 
-// ColumnTimeGrainRequestValidationError is the validation error returned by
-// ColumnTimeGrainRequest.Validate if the designated constraints aren't met.
-type ColumnTimeGrainRequestValidationError struct {
+// ColumnRugHistogramResponseValidationError is the validation error returned
+// by ColumnRugHistogramResponse.Validate if the designated constraints aren't met.
+type ColumnRugHistogramResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12525,24 +15134,24 @@ type ColumnTimeGrainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeGrainRequestValidationError) Field() string { return e.field }
+func (e ColumnRugHistogramResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeGrainRequestValidationError) Reason() string { return e.reason }
+func (e ColumnRugHistogramResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeGrainRequestValidationError) Cause() error { return e.cause }
+func (e ColumnRugHistogramResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeGrainRequestValidationError) Key() bool { return e.key }
+func (e ColumnRugHistogramResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeGrainRequestValidationError) ErrorName() string {
-	return "ColumnTimeGrainRequestValidationError"
+func (e ColumnRugHistogramResponseValidationError) ErrorName() string {
+	return "ColumnRugHistogramResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeGrainRequestValidationError) Error() string {
+func (e ColumnRugHistogramResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12554,14 +15163,14 @@ func (e ColumnTimeGrainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeGrainRequest.%s: %s%s",
+		"invalid %sColumnRugHistogramResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeGrainRequestValidationError{}
+var _ error = ColumnRugHistogramResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12569,46 +15178,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeGrainRequestValidationError{}
+} = ColumnRugHistogramResponseValidationError{}
 
-// Validate checks the field values on ColumnTimeGrainResponse with the rules
+// Validate checks the field values on ColumnTimeRangeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeGrainResponse) Validate() error {
+func (m *ColumnTimeRangeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeGrainResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnTimeRangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeGrainResponseMultiError, or nil if none found.
-func (m *ColumnTimeGrainResponse) ValidateAll() error {
+// ColumnTimeRangeRequestMultiError, or nil if none found.
+func (m *ColumnTimeRangeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeGrainResponse) validate(all bool) error {
+func (m *ColumnTimeRangeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TimeGrain
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnTimeRangeRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnTimeRangeRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return ColumnTimeGrainResponseMultiError(errors)
+		return ColumnTimeRangeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeGrainResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeGrainResponse.ValidateAll() if the designated
+// ColumnTimeRangeRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeRangeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnTimeGrainResponseMultiError []error
+type ColumnTimeRangeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeGrainResponseMultiError) Error() string {
+func (m ColumnTimeRangeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12617,11 +15256,11 @@ func (m ColumnTimeGrainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeGrainResponseMultiError) AllErrors() []error { return m }
+func (m ColumnTimeRangeRequestMultiError) AllErrors() []error { return m }
 
-// ColumnTimeGrainResponseValidationError is the validation error returned by
-// ColumnTimeGrainResponse.Validate if the designated constraints aren't met.
-type ColumnTimeGrainResponseValidationError struct {
+// ColumnTimeRangeRequestValidationError is the validation error returned by
+// ColumnTimeRangeRequest.Validate if the designated constraints aren't met.
+type ColumnTimeRangeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12629,24 +15268,24 @@ type ColumnTimeGrainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeGrainResponseValidationError) Field() string { return e.field }
+func (e ColumnTimeRangeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeGrainResponseValidationError) Reason() string { return e.reason }
+func (e ColumnTimeRangeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeGrainResponseValidationError) Cause() error { return e.cause }
+func (e ColumnTimeRangeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeGrainResponseValidationError) Key() bool { return e.key }
+func (e ColumnTimeRangeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeGrainResponseValidationError) ErrorName() string {
-	return "ColumnTimeGrainResponseValidationError"
+func (e ColumnTimeRangeRequestValidationError) ErrorName() string {
+	return "ColumnTimeRangeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeGrainResponseValidationError) Error() string {
+func (e ColumnTimeRangeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12658,14 +15297,14 @@ func (e ColumnTimeGrainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeGrainResponse.%s: %s%s",
+		"invalid %sColumnTimeRangeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeGrainResponseValidationError{}
+var _ error = ColumnTimeRangeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12673,78 +15312,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeGrainResponseValidationError{}
+} = ColumnTimeRangeRequestValidationError{}
 
-// Validate checks the field values on ColumnNumericHistogramRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ColumnTimeRangeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNumericHistogramRequest) Validate() error {
+func (m *ColumnTimeRangeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNumericHistogramRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ColumnNumericHistogramRequestMultiError, or nil if none found.
-func (m *ColumnNumericHistogramRequest) ValidateAll() error {
+// ValidateAll checks the field values on ColumnTimeRangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeRangeResponseMultiError, or nil if none found.
+func (m *ColumnTimeRangeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNumericHistogramRequest) validate(all bool) error {
+func (m *ColumnTimeRangeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnNumericHistogramRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnNumericHistogramRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetTimeRangeSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnTimeRangeResponseValidationError{
+					field:  "TimeRangeSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnTimeRangeResponseValidationError{
+					field:  "TimeRangeSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTimeRangeSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnTimeRangeResponseValidationError{
+				field:  "TimeRangeSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for HistogramMethod
-
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnNumericHistogramRequestMultiError(errors)
+		return ColumnTimeRangeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNumericHistogramRequestMultiError is an error wrapping multiple
-// validation errors returned by ColumnNumericHistogramRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ColumnNumericHistogramRequestMultiError []error
+// ColumnTimeRangeResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeRangeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeRangeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNumericHistogramRequestMultiError) Error() string {
+func (m ColumnTimeRangeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12753,12 +15387,11 @@ func (m ColumnNumericHistogramRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNumericHistogramRequestMultiError) AllErrors() []error { return m }
+func (m ColumnTimeRangeResponseMultiError) AllErrors() []error { return m }
 
-// ColumnNumericHistogramRequestValidationError is the validation error
-// returned by ColumnNumericHistogramRequest.Validate if the designated
-// constraints aren't met.
-type ColumnNumericHistogramRequestValidationError struct {
+// ColumnTimeRangeResponseValidationError is the validation error returned by
+// ColumnTimeRangeResponse.Validate if the designated constraints aren't met.
+type ColumnTimeRangeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12766,24 +15399,24 @@ type ColumnNumericHistogramRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNumericHistogramRequestValidationError) Field() string { return e.field }
+func (e ColumnTimeRangeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNumericHistogramRequestValidationError) Reason() string { return e.reason }
+func (e ColumnTimeRangeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNumericHistogramRequestValidationError) Cause() error { return e.cause }
+func (e ColumnTimeRangeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNumericHistogramRequestValidationError) Key() bool { return e.key }
+func (e ColumnTimeRangeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNumericHistogramRequestValidationError) ErrorName() string {
-	return "ColumnNumericHistogramRequestValidationError"
+func (e ColumnTimeRangeResponseValidationError) ErrorName() string {
+	return "ColumnTimeRangeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnNumericHistogramRequestValidationError) Error() string {
+func (e ColumnTimeRangeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12795,14 +15428,14 @@ func (e ColumnNumericHistogramRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNumericHistogramRequest.%s: %s%s",
+		"invalid %sColumnTimeRangeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNumericHistogramRequestValidationError{}
+var _ error = ColumnTimeRangeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12810,53 +15443,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNumericHistogramRequestValidationError{}
+} = ColumnTimeRangeResponseValidationError{}
 
-// Validate checks the field values on ColumnNumericHistogramResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNumericHistogramResponse) Validate() error {
+// Validate checks the field values on TimeRangeSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeRangeSummary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNumericHistogramResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ColumnNumericHistogramResponseMultiError, or nil if none found.
-func (m *ColumnNumericHistogramResponse) ValidateAll() error {
+// ValidateAll checks the field values on TimeRangeSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeRangeSummaryMultiError, or nil if none found.
+func (m *TimeRangeSummary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNumericHistogramResponse) validate(all bool) error {
+func (m *TimeRangeSummary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeRangeSummaryValidationError{
+				field:  "Min",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMax()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMax()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeRangeSummaryValidationError{
+				field:  "Max",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetNumericSummary()).(type) {
+		switch v := interface{}(m.GetWatermark()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnNumericHistogramResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Watermark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnNumericHistogramResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Watermark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetWatermark()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnNumericHistogramResponseValidationError{
-				field:  "NumericSummary",
+			return TimeRangeSummaryValidationError{
+				field:  "Watermark",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -12864,19 +15555,19 @@ func (m *ColumnNumericHistogramResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ColumnNumericHistogramResponseMultiError(errors)
+		return TimeRangeSummaryMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNumericHistogramResponseMultiError is an error wrapping multiple
-// validation errors returned by ColumnNumericHistogramResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ColumnNumericHistogramResponseMultiError []error
+// TimeRangeSummaryMultiError is an error wrapping multiple validation errors
+// returned by TimeRangeSummary.ValidateAll() if the designated constraints
+// aren't met.
+type TimeRangeSummaryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNumericHistogramResponseMultiError) Error() string {
+func (m TimeRangeSummaryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12885,12 +15576,11 @@ func (m ColumnNumericHistogramResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNumericHistogramResponseMultiError) AllErrors() []error { return m }
+func (m TimeRangeSummaryMultiError) AllErrors() []error { return m }
 
-// ColumnNumericHistogramResponseValidationError is the validation error
-// returned by ColumnNumericHistogramResponse.Validate if the designated
-// constraints aren't met.
-type ColumnNumericHistogramResponseValidationError struct {
+// TimeRangeSummaryValidationError is the validation error returned by
+// TimeRangeSummary.Validate if the designated constraints aren't met.
+type TimeRangeSummaryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12898,24 +15588,22 @@ type ColumnNumericHistogramResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNumericHistogramResponseValidationError) Field() string { return e.field }
+func (e TimeRangeSummaryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNumericHistogramResponseValidationError) Reason() string { return e.reason }
+func (e TimeRangeSummaryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNumericHistogramResponseValidationError) Cause() error { return e.cause }
+func (e TimeRangeSummaryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNumericHistogramResponseValidationError) Key() bool { return e.key }
+func (e TimeRangeSummaryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNumericHistogramResponseValidationError) ErrorName() string {
-	return "ColumnNumericHistogramResponseValidationError"
-}
+func (e TimeRangeSummaryValidationError) ErrorName() string { return "TimeRangeSummaryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ColumnNumericHistogramResponseValidationError) Error() string {
+func (e TimeRangeSummaryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12927,14 +15615,14 @@ func (e ColumnNumericHistogramResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNumericHistogramResponse.%s: %s%s",
+		"invalid %sTimeRangeSummary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNumericHistogramResponseValidationError{}
+var _ error = TimeRangeSummaryValidationError{}
 
 var _ interface {
 	Field() string
@@ -12942,24 +15630,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNumericHistogramResponseValidationError{}
+} = TimeRangeSummaryValidationError{}
 
-// Validate checks the field values on ColumnRugHistogramRequest with the rules
+// Validate checks the field values on ColumnCardinalityRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRugHistogramRequest) Validate() error {
+func (m *ColumnCardinalityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRugHistogramRequest with the
+// ValidateAll checks the field values on ColumnCardinalityRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnRugHistogramRequestMultiError, or nil if none found.
-func (m *ColumnRugHistogramRequest) ValidateAll() error {
+// ColumnCardinalityRequestMultiError, or nil if none found.
+func (m *ColumnCardinalityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRugHistogramRequest) validate(all bool) error {
+func (m *ColumnCardinalityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12975,7 +15663,7 @@ func (m *ColumnRugHistogramRequest) validate(all bool) error {
 	// no validation rules for DatabaseSchema
 
 	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnRugHistogramRequestValidationError{
+		err := ColumnCardinalityRequestValidationError{
 			field:  "TableName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12986,7 +15674,7 @@ func (m *ColumnRugHistogramRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnRugHistogramRequestValidationError{
+		err := ColumnCardinalityRequestValidationError{
 			field:  "ColumnName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12999,19 +15687,19 @@ func (m *ColumnRugHistogramRequest) validate(all bool) error {
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return ColumnRugHistogramRequestMultiError(errors)
+		return ColumnCardinalityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRugHistogramRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnRugHistogramRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ColumnRugHistogramRequestMultiError []error
+// ColumnCardinalityRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnCardinalityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnCardinalityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRugHistogramRequestMultiError) Error() string {
+func (m ColumnCardinalityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13020,11 +15708,11 @@ func (m ColumnRugHistogramRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRugHistogramRequestMultiError) AllErrors() []error { return m }
+func (m ColumnCardinalityRequestMultiError) AllErrors() []error { return m }
 
-// ColumnRugHistogramRequestValidationError is the validation error returned by
-// ColumnRugHistogramRequest.Validate if the designated constraints aren't met.
-type ColumnRugHistogramRequestValidationError struct {
+// ColumnCardinalityRequestValidationError is the validation error returned by
+// ColumnCardinalityRequest.Validate if the designated constraints aren't met.
+type ColumnCardinalityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13032,24 +15720,24 @@ type ColumnRugHistogramRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRugHistogramRequestValidationError) Field() string { return e.field }
+func (e ColumnCardinalityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRugHistogramRequestValidationError) Reason() string { return e.reason }
+func (e ColumnCardinalityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRugHistogramRequestValidationError) Cause() error { return e.cause }
+func (e ColumnCardinalityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRugHistogramRequestValidationError) Key() bool { return e.key }
+func (e ColumnCardinalityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRugHistogramRequestValidationError) ErrorName() string {
-	return "ColumnRugHistogramRequestValidationError"
+func (e ColumnCardinalityRequestValidationError) ErrorName() string {
+	return "ColumnCardinalityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnRugHistogramRequestValidationError) Error() string {
+func (e ColumnCardinalityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13061,14 +15749,14 @@ func (e ColumnRugHistogramRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRugHistogramRequest.%s: %s%s",
+		"invalid %sColumnCardinalityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRugHistogramRequestValidationError{}
+var _ error = ColumnCardinalityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13076,24 +15764,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRugHistogramRequestValidationError{}
+} = ColumnCardinalityRequestValidationError{}
 
-// Validate checks the field values on ColumnRugHistogramResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ColumnCardinalityResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRugHistogramResponse) Validate() error {
+func (m *ColumnCardinalityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRugHistogramResponse with the
+// ValidateAll checks the field values on ColumnCardinalityResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnRugHistogramResponseMultiError, or nil if none found.
-func (m *ColumnRugHistogramResponse) ValidateAll() error {
+// ColumnCardinalityResponseMultiError, or nil if none found.
+func (m *ColumnCardinalityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRugHistogramResponse) validate(all bool) error {
+func (m *ColumnCardinalityResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13101,28 +15789,28 @@ func (m *ColumnRugHistogramResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetNumericSummary()).(type) {
+		switch v := interface{}(m.GetCategoricalSummary()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnRugHistogramResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, ColumnCardinalityResponseValidationError{
+					field:  "CategoricalSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnRugHistogramResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, ColumnCardinalityResponseValidationError{
+					field:  "CategoricalSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnRugHistogramResponseValidationError{
-				field:  "NumericSummary",
+			return ColumnCardinalityResponseValidationError{
+				field:  "CategoricalSummary",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13130,19 +15818,19 @@ func (m *ColumnRugHistogramResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ColumnRugHistogramResponseMultiError(errors)
+		return ColumnCardinalityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRugHistogramResponseMultiError is an error wrapping multiple
-// validation errors returned by ColumnRugHistogramResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ColumnRugHistogramResponseMultiError []error
+// ColumnCardinalityResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnCardinalityResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ColumnCardinalityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRugHistogramResponseMultiError) Error() string {
+func (m ColumnCardinalityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13151,11 +15839,11 @@ func (m ColumnRugHistogramResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRugHistogramResponseMultiError) AllErrors() []error { return m }
+func (m ColumnCardinalityResponseMultiError) AllErrors() []error { return m }
 
-// ColumnRugHistogramResponseValidationError is the validation error returned
-// by ColumnRugHistogramResponse.Validate if the designated constraints aren't met.
-type ColumnRugHistogramResponseValidationError struct {
+// ColumnCardinalityResponseValidationError is the validation error returned by
+// ColumnCardinalityResponse.Validate if the designated constraints aren't met.
+type ColumnCardinalityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13163,24 +15851,24 @@ type ColumnRugHistogramResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRugHistogramResponseValidationError) Field() string { return e.field }
+func (e ColumnCardinalityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRugHistogramResponseValidationError) Reason() string { return e.reason }
+func (e ColumnCardinalityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRugHistogramResponseValidationError) Cause() error { return e.cause }
+func (e ColumnCardinalityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRugHistogramResponseValidationError) Key() bool { return e.key }
+func (e ColumnCardinalityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRugHistogramResponseValidationError) ErrorName() string {
-	return "ColumnRugHistogramResponseValidationError"
+func (e ColumnCardinalityResponseValidationError) ErrorName() string {
+	return "ColumnCardinalityResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnRugHistogramResponseValidationError) Error() string {
+func (e ColumnCardinalityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13192,14 +15880,14 @@ func (e ColumnRugHistogramResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRugHistogramResponse.%s: %s%s",
+		"invalid %sColumnCardinalityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRugHistogramResponseValidationError{}
+var _ error = ColumnCardinalityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13207,42 +15895,138 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRugHistogramResponseValidationError{}
+} = ColumnCardinalityResponseValidationError{}
 
-// Validate checks the field values on ColumnTimeRangeRequest with the rules
+// Validate checks the field values on ColumnTimeSeriesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeRangeRequest) Validate() error {
+func (m *ColumnTimeSeriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeRangeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeRangeRequestMultiError, or nil if none found.
-func (m *ColumnTimeRangeRequest) ValidateAll() error {
+// ColumnTimeSeriesRequestMultiError, or nil if none found.
+func (m *ColumnTimeSeriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeRangeRequest) validate(all bool) error {
+func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMeasures()) < 1 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "Measures",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMeasures() {
+		_, _ = idx, item
 
-	// no validation rules for InstanceId
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnTimeSeriesRequestValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnTimeSeriesRequestValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnTimeSeriesRequestValidationError{
+					field:  fmt.Sprintf("Measures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Connector
+	}
 
-	// no validation rules for Database
+	if utf8.RuneCountInString(m.GetTimestampColumnName()) < 1 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "TimestampColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for DatabaseSchema
+	if all {
+		switch v := interface{}(m.GetTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnTimeSeriesRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnTimeSeriesRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnTimeSeriesRequestValidationError{
+				field:  "TimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTimeRangeRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
+	if m.GetPixels() < 0 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "Pixels",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -13250,10 +16034,10 @@ func (m *ColumnTimeRangeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnTimeRangeRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
+	if m.GetSampleSize() < 0 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "SampleSize",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -13263,20 +16047,22 @@ func (m *ColumnTimeRangeRequest) validate(all bool) error {
 
 	// no validation rules for Priority
 
+	// no validation rules for TimeZone
+
 	if len(errors) > 0 {
-		return ColumnTimeRangeRequestMultiError(errors)
+		return ColumnTimeSeriesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeRangeRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeRangeRequest.ValidateAll() if the designated
+// ColumnTimeSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeSeriesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnTimeRangeRequestMultiError []error
+type ColumnTimeSeriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeRangeRequestMultiError) Error() string {
+func (m ColumnTimeSeriesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13285,11 +16071,11 @@ func (m ColumnTimeRangeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeRangeRequestMultiError) AllErrors() []error { return m }
+func (m ColumnTimeSeriesRequestMultiError) AllErrors() []error { return m }
 
-// ColumnTimeRangeRequestValidationError is the validation error returned by
-// ColumnTimeRangeRequest.Validate if the designated constraints aren't met.
-type ColumnTimeRangeRequestValidationError struct {
+// ColumnTimeSeriesRequestValidationError is the validation error returned by
+// ColumnTimeSeriesRequest.Validate if the designated constraints aren't met.
+type ColumnTimeSeriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13297,24 +16083,24 @@ type ColumnTimeRangeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeRangeRequestValidationError) Field() string { return e.field }
+func (e ColumnTimeSeriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeRangeRequestValidationError) Reason() string { return e.reason }
+func (e ColumnTimeSeriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeRangeRequestValidationError) Cause() error { return e.cause }
+func (e ColumnTimeSeriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeRangeRequestValidationError) Key() bool { return e.key }
+func (e ColumnTimeSeriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeRangeRequestValidationError) ErrorName() string {
-	return "ColumnTimeRangeRequestValidationError"
+func (e ColumnTimeSeriesRequestValidationError) ErrorName() string {
+	return "ColumnTimeSeriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeRangeRequestValidationError) Error() string {
+func (e ColumnTimeSeriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13326,14 +16112,14 @@ func (e ColumnTimeRangeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeRangeRequest.%s: %s%s",
+		"invalid %sColumnTimeSeriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeRangeRequestValidationError{}
+var _ error = ColumnTimeSeriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13341,24 +16127,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeRangeRequestValidationError{}
+} = ColumnTimeSeriesRequestValidationError{}
 
-// Validate checks the field values on ColumnTimeRangeResponse with the rules
+// Validate checks the field values on ColumnTimeSeriesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeRangeResponse) Validate() error {
+func (m *ColumnTimeSeriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeRangeResponse with the
+// ValidateAll checks the field values on ColumnTimeSeriesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeRangeResponseMultiError, or nil if none found.
-func (m *ColumnTimeRangeResponse) ValidateAll() error {
+// ColumnTimeSeriesResponseMultiError, or nil if none found.
+func (m *ColumnTimeSeriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeRangeResponse) validate(all bool) error {
+func (m *ColumnTimeSeriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13366,28 +16152,28 @@ func (m *ColumnTimeRangeResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTimeRangeSummary()).(type) {
+		switch v := interface{}(m.GetRollup()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTimeRangeResponseValidationError{
-					field:  "TimeRangeSummary",
+				errors = append(errors, ColumnTimeSeriesResponseValidationError{
+					field:  "Rollup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTimeRangeResponseValidationError{
-					field:  "TimeRangeSummary",
+				errors = append(errors, ColumnTimeSeriesResponseValidationError{
+					field:  "Rollup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeRangeSummary()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRollup()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnTimeRangeResponseValidationError{
-				field:  "TimeRangeSummary",
+			return ColumnTimeSeriesResponseValidationError{
+				field:  "Rollup",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13395,19 +16181,19 @@ func (m *ColumnTimeRangeResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ColumnTimeRangeResponseMultiError(errors)
+		return ColumnTimeSeriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeRangeResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeRangeResponse.ValidateAll() if the designated
+// ColumnTimeSeriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeSeriesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnTimeRangeResponseMultiError []error
+type ColumnTimeSeriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeRangeResponseMultiError) Error() string {
+func (m ColumnTimeSeriesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13416,11 +16202,11 @@ func (m ColumnTimeRangeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeRangeResponseMultiError) AllErrors() []error { return m }
+func (m ColumnTimeSeriesResponseMultiError) AllErrors() []error { return m }
 
-// ColumnTimeRangeResponseValidationError is the validation error returned by
-// ColumnTimeRangeResponse.Validate if the designated constraints aren't met.
-type ColumnTimeRangeResponseValidationError struct {
+// ColumnTimeSeriesResponseValidationError is the validation error returned by
+// ColumnTimeSeriesResponse.Validate if the designated constraints aren't met.
+type ColumnTimeSeriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13428,24 +16214,24 @@ type ColumnTimeRangeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeRangeResponseValidationError) Field() string { return e.field }
+func (e ColumnTimeSeriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeRangeResponseValidationError) Reason() string { return e.reason }
+func (e ColumnTimeSeriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeRangeResponseValidationError) Cause() error { return e.cause }
+func (e ColumnTimeSeriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeRangeResponseValidationError) Key() bool { return e.key }
+func (e ColumnTimeSeriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeRangeResponseValidationError) ErrorName() string {
-	return "ColumnTimeRangeResponseValidationError"
+func (e ColumnTimeSeriesResponseValidationError) ErrorName() string {
+	return "ColumnTimeSeriesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeRangeResponseValidationError) Error() string {
+func (e ColumnTimeSeriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13457,14 +16243,14 @@ func (e ColumnTimeRangeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeRangeResponse.%s: %s%s",
+		"invalid %sColumnTimeSeriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeRangeResponseValidationError{}
+var _ error = ColumnTimeSeriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13472,82 +16258,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeRangeResponseValidationError{}
-
-// Validate checks the field values on TimeRangeSummary with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TimeRangeSummary) Validate() error {
-	return m.validate(false)
-}
+} = ColumnTimeSeriesResponseValidationError{}
 
-// ValidateAll checks the field values on TimeRangeSummary with the rules
+// Validate checks the field values on TimeSeriesTimeRange with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TimeRangeSummaryMultiError, or nil if none found.
-func (m *TimeRangeSummary) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *TimeRangeSummary) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetMin()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Min",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Min",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMin()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeRangeSummaryValidationError{
-				field:  "Min",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesTimeRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesTimeRange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesTimeRangeMultiError, or nil if none found.
+func (m *TimeSeriesTimeRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesTimeRange) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetMax()).(type) {
+		switch v := interface{}(m.GetStart()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Max",
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "Start",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Max",
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "Start",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMax()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TimeRangeSummaryValidationError{
-				field:  "Max",
+			return TimeSeriesTimeRangeValidationError{
+				field:  "Start",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13555,48 +16312,59 @@ func (m *TimeRangeSummary) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetWatermark()).(type) {
+		switch v := interface{}(m.GetEnd()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Watermark",
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "End",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Watermark",
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "End",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetWatermark()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TimeRangeSummaryValidationError{
-				field:  "Watermark",
+			return TimeSeriesTimeRangeValidationError{
+				field:  "End",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if _, ok := TimeGrain_name[int32(m.GetInterval())]; !ok {
+		err := TimeSeriesTimeRangeValidationError{
+			field:  "Interval",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return TimeRangeSummaryMultiError(errors)
+		return TimeSeriesTimeRangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeRangeSummaryMultiError is an error wrapping multiple validation errors
-// returned by TimeRangeSummary.ValidateAll() if the designated constraints
-// aren't met.
-type TimeRangeSummaryMultiError []error
+// TimeSeriesTimeRangeMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesTimeRange.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesTimeRangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeRangeSummaryMultiError) Error() string {
+func (m TimeSeriesTimeRangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13605,11 +16373,11 @@ func (m TimeRangeSummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeRangeSummaryMultiError) AllErrors() []error { return m }
+func (m TimeSeriesTimeRangeMultiError) AllErrors() []error { return m }
 
-// TimeRangeSummaryValidationError is the validation error returned by
-// TimeRangeSummary.Validate if the designated constraints aren't met.
-type TimeRangeSummaryValidationError struct {
+// TimeSeriesTimeRangeValidationError is the validation error returned by
+// TimeSeriesTimeRange.Validate if the designated constraints aren't met.
+type TimeSeriesTimeRangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13617,22 +16385,24 @@ type TimeRangeSummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeRangeSummaryValidationError) Field() string { return e.field }
+func (e TimeSeriesTimeRangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeRangeSummaryValidationError) Reason() string { return e.reason }
+func (e TimeSeriesTimeRangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeRangeSummaryValidationError) Cause() error { return e.cause }
+func (e TimeSeriesTimeRangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeRangeSummaryValidationError) Key() bool { return e.key }
+func (e TimeSeriesTimeRangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeRangeSummaryValidationError) ErrorName() string { return "TimeRangeSummaryValidationError" }
+func (e TimeSeriesTimeRangeValidationError) ErrorName() string {
+	return "TimeSeriesTimeRangeValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TimeRangeSummaryValidationError) Error() string {
+func (e TimeSeriesTimeRangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13644,14 +16414,14 @@ func (e TimeRangeSummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeRangeSummary.%s: %s%s",
+		"invalid %sTimeSeriesTimeRange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeRangeSummaryValidationError{}
+var _ error = TimeSeriesTimeRangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -13659,76 +16429,114 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeRangeSummaryValidationError{}
+} = TimeSeriesTimeRangeValidationError{}
 
-// Validate checks the field values on ColumnCardinalityRequest with the rules
+// Validate checks the field values on TimeSeriesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnCardinalityRequest) Validate() error {
+func (m *TimeSeriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnCardinalityRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TimeSeriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnCardinalityRequestMultiError, or nil if none found.
-func (m *ColumnCardinalityRequest) ValidateAll() error {
+// TimeSeriesResponseMultiError, or nil if none found.
+func (m *TimeSeriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnCardinalityRequest) validate(all bool) error {
+func (m *TimeSeriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-	// no validation rules for Connector
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Database
+	}
 
-	// no validation rules for DatabaseSchema
+	for idx, item := range m.GetSpark() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnCardinalityRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Spark[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Spark[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Spark[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnCardinalityRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
+	// no validation rules for SampleSize
 
 	if len(errors) > 0 {
-		return ColumnCardinalityRequestMultiError(errors)
+		return TimeSeriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnCardinalityRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnCardinalityRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnCardinalityRequestMultiError []error
+// TimeSeriesResponseMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnCardinalityRequestMultiError) Error() string {
+func (m TimeSeriesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13737,11 +16545,11 @@ func (m ColumnCardinalityRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnCardinalityRequestMultiError) AllErrors() []error { return m }
+func (m TimeSeriesResponseMultiError) AllErrors() []error { return m }
 
-// ColumnCardinalityRequestValidationError is the validation error returned by
-// ColumnCardinalityRequest.Validate if the designated constraints aren't met.
-type ColumnCardinalityRequestValidationError struct {
+// TimeSeriesResponseValidationError is the validation error returned by
+// TimeSeriesResponse.Validate if the designated constraints aren't met.
+type TimeSeriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13749,24 +16557,24 @@ type ColumnCardinalityRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnCardinalityRequestValidationError) Field() string { return e.field }
+func (e TimeSeriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnCardinalityRequestValidationError) Reason() string { return e.reason }
+func (e TimeSeriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnCardinalityRequestValidationError) Cause() error { return e.cause }
+func (e TimeSeriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnCardinalityRequestValidationError) Key() bool { return e.key }
+func (e TimeSeriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnCardinalityRequestValidationError) ErrorName() string {
-	return "ColumnCardinalityRequestValidationError"
+func (e TimeSeriesResponseValidationError) ErrorName() string {
+	return "TimeSeriesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnCardinalityRequestValidationError) Error() string {
+func (e TimeSeriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13778,14 +16586,14 @@ func (e ColumnCardinalityRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnCardinalityRequest.%s: %s%s",
+		"invalid %sTimeSeriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnCardinalityRequestValidationError{}
+var _ error = TimeSeriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13793,24 +16601,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnCardinalityRequestValidationError{}
+} = TimeSeriesResponseValidationError{}
 
-// Validate checks the field values on ColumnCardinalityResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnCardinalityResponse) Validate() error {
+// Validate checks the field values on TimeSeriesValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesValue) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnCardinalityResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TimeSeriesValue with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnCardinalityResponseMultiError, or nil if none found.
-func (m *ColumnCardinalityResponse) ValidateAll() error {
+// TimeSeriesValueMultiError, or nil if none found.
+func (m *TimeSeriesValue) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnCardinalityResponse) validate(all bool) error {
+func (m *TimeSeriesValue) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13818,28 +16626,59 @@ func (m *ColumnCardinalityResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetCategoricalSummary()).(type) {
+		switch v := interface{}(m.GetTs()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnCardinalityResponseValidationError{
-					field:  "CategoricalSummary",
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Ts",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnCardinalityResponseValidationError{
-					field:  "CategoricalSummary",
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Ts",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTs()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnCardinalityResponseValidationError{
-				field:  "CategoricalSummary",
+			return TimeSeriesValueValidationError{
+				field:  "Ts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Bin
+
+	if all {
+		switch v := interface{}(m.GetRecords()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Records",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Records",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecords()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesValueValidationError{
+				field:  "Records",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13847,19 +16686,19 @@ func (m *ColumnCardinalityResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ColumnCardinalityResponseMultiError(errors)
+		return TimeSeriesValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnCardinalityResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnCardinalityResponse.ValidateAll() if the
-// designated constraints aren't met.
-type ColumnCardinalityResponseMultiError []error
+// TimeSeriesValueMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesValue.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnCardinalityResponseMultiError) Error() string {
+func (m TimeSeriesValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13868,11 +16707,11 @@ func (m ColumnCardinalityResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnCardinalityResponseMultiError) AllErrors() []error { return m }
+func (m TimeSeriesValueMultiError) AllErrors() []error { return m }
 
-// ColumnCardinalityResponseValidationError is the validation error returned by
-// ColumnCardinalityResponse.Validate if the designated constraints aren't met.
-type ColumnCardinalityResponseValidationError struct {
+// TimeSeriesValueValidationError is the validation error returned by
+// TimeSeriesValue.Validate if the designated constraints aren't met.
+type TimeSeriesValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13880,24 +16719,22 @@ type ColumnCardinalityResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnCardinalityResponseValidationError) Field() string { return e.field }
+func (e TimeSeriesValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnCardinalityResponseValidationError) Reason() string { return e.reason }
+func (e TimeSeriesValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnCardinalityResponseValidationError) Cause() error { return e.cause }
+func (e TimeSeriesValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnCardinalityResponseValidationError) Key() bool { return e.key }
+func (e TimeSeriesValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnCardinalityResponseValidationError) ErrorName() string {
-	return "ColumnCardinalityResponseValidationError"
-}
+func (e TimeSeriesValueValidationError) ErrorName() string { return "TimeSeriesValueValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ColumnCardinalityResponseValidationError) Error() string {
+func (e TimeSeriesValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13909,14 +16746,14 @@ func (e ColumnCardinalityResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnCardinalityResponse.%s: %s%s",
+		"invalid %sTimeSeriesValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnCardinalityResponseValidationError{}
+var _ error = TimeSeriesValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -13924,24 +16761,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnCardinalityResponseValidationError{}
+} = TimeSeriesValueValidationError{}
 
-// Validate checks the field values on ColumnTimeSeriesRequest with the rules
+// Validate checks the field values on TableCardinalityRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeSeriesRequest) Validate() error {
+func (m *TableCardinalityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeSeriesRequest with the
+// ValidateAll checks the field values on TableCardinalityRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeSeriesRequestMultiError, or nil if none found.
-func (m *ColumnTimeSeriesRequest) ValidateAll() error {
+// TableCardinalityRequestMultiError, or nil if none found.
+func (m *TableCardinalityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeSeriesRequest) validate(all bool) error {
+func (m *TableCardinalityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13957,7 +16794,7 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 	// no validation rules for DatabaseSchema
 
 	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTimeSeriesRequestValidationError{
+		err := TableCardinalityRequestValidationError{
 			field:  "TableName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -13967,131 +16804,22 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetMeasures()) < 1 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "Measures",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetMeasures() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ColumnTimeSeriesRequestValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ColumnTimeSeriesRequestValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ColumnTimeSeriesRequestValidationError{
-					field:  fmt.Sprintf("Measures[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if utf8.RuneCountInString(m.GetTimestampColumnName()) < 1 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "TimestampColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetTimeRange()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTimeSeriesRequestValidationError{
-					field:  "TimeRange",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTimeSeriesRequestValidationError{
-					field:  "TimeRange",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnTimeSeriesRequestValidationError{
-				field:  "TimeRange",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetPixels() < 0 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "Pixels",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetSampleSize() < 0 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "SampleSize",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	// no validation rules for Priority
 
-	// no validation rules for TimeZone
-
 	if len(errors) > 0 {
-		return ColumnTimeSeriesRequestMultiError(errors)
+		return TableCardinalityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeSeriesRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeSeriesRequest.ValidateAll() if the designated
+// TableCardinalityRequestMultiError is an error wrapping multiple validation
+// errors returned by TableCardinalityRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnTimeSeriesRequestMultiError []error
+type TableCardinalityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeSeriesRequestMultiError) Error() string {
+func (m TableCardinalityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14100,11 +16828,11 @@ func (m ColumnTimeSeriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeSeriesRequestMultiError) AllErrors() []error { return m }
+func (m TableCardinalityRequestMultiError) AllErrors() []error { return m }
 
-// ColumnTimeSeriesRequestValidationError is the validation error returned by
-// ColumnTimeSeriesRequest.Validate if the designated constraints aren't met.
-type ColumnTimeSeriesRequestValidationError struct {
+// TableCardinalityRequestValidationError is the validation error returned by
+// TableCardinalityRequest.Validate if the designated constraints aren't met.
+type TableCardinalityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14112,24 +16840,24 @@ type ColumnTimeSeriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeSeriesRequestValidationError) Field() string { return e.field }
+func (e TableCardinalityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeSeriesRequestValidationError) Reason() string { return e.reason }
+func (e TableCardinalityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeSeriesRequestValidationError) Cause() error { return e.cause }
+func (e TableCardinalityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeSeriesRequestValidationError) Key() bool { return e.key }
+func (e TableCardinalityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeSeriesRequestValidationError) ErrorName() string {
-	return "ColumnTimeSeriesRequestValidationError"
+func (e TableCardinalityRequestValidationError) ErrorName() string {
+	return "TableCardinalityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeSeriesRequestValidationError) Error() string {
+func (e TableCardinalityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14141,14 +16869,14 @@ func (e ColumnTimeSeriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeSeriesRequest.%s: %s%s",
+		"invalid %sTableCardinalityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeSeriesRequestValidationError{}
+var _ error = TableCardinalityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14156,73 +16884,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeSeriesRequestValidationError{}
+} = TableCardinalityRequestValidationError{}
 
-// Validate checks the field values on ColumnTimeSeriesResponse with the rules
+// Validate checks the field values on TableCardinalityResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeSeriesResponse) Validate() error {
+func (m *TableCardinalityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeSeriesResponse with the
+// ValidateAll checks the field values on TableCardinalityResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeSeriesResponseMultiError, or nil if none found.
-func (m *ColumnTimeSeriesResponse) ValidateAll() error {
+// TableCardinalityResponseMultiError, or nil if none found.
+func (m *TableCardinalityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeSeriesResponse) validate(all bool) error {
+func (m *TableCardinalityResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRollup()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTimeSeriesResponseValidationError{
-					field:  "Rollup",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTimeSeriesResponseValidationError{
-					field:  "Rollup",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRollup()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnTimeSeriesResponseValidationError{
-				field:  "Rollup",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Cardinality
 
 	if len(errors) > 0 {
-		return ColumnTimeSeriesResponseMultiError(errors)
+		return TableCardinalityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeSeriesResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeSeriesResponse.ValidateAll() if the designated
+// TableCardinalityResponseMultiError is an error wrapping multiple validation
+// errors returned by TableCardinalityResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnTimeSeriesResponseMultiError []error
+type TableCardinalityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeSeriesResponseMultiError) Error() string {
+func (m TableCardinalityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14231,11 +16932,11 @@ func (m ColumnTimeSeriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeSeriesResponseMultiError) AllErrors() []error { return m }
+func (m TableCardinalityResponseMultiError) AllErrors() []error { return m }
 
-// ColumnTimeSeriesResponseValidationError is the validation error returned by
-// ColumnTimeSeriesResponse.Validate if the designated constraints aren't met.
-type ColumnTimeSeriesResponseValidationError struct {
+// TableCardinalityResponseValidationError is the validation error returned by
+// TableCardinalityResponse.Validate if the designated constraints aren't met.
+type TableCardinalityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14243,24 +16944,24 @@ type ColumnTimeSeriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeSeriesResponseValidationError) Field() string { return e.field }
+func (e TableCardinalityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeSeriesResponseValidationError) Reason() string { return e.reason }
+func (e TableCardinalityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeSeriesResponseValidationError) Cause() error { return e.cause }
+func (e TableCardinalityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeSeriesResponseValidationError) Key() bool { return e.key }
+func (e TableCardinalityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeSeriesResponseValidationError) ErrorName() string {
-	return "ColumnTimeSeriesResponseValidationError"
+func (e TableCardinalityResponseValidationError) ErrorName() string {
+	return "TableCardinalityResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeSeriesResponseValidationError) Error() string {
+func (e TableCardinalityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14272,14 +16973,14 @@ func (e ColumnTimeSeriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeSeriesResponse.%s: %s%s",
+		"invalid %sTableCardinalityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeSeriesResponseValidationError{}
+var _ error = TableCardinalityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14287,92 +16988,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeSeriesResponseValidationError{}
+} = TableCardinalityResponseValidationError{}
 
-// Validate checks the field values on TimeSeriesTimeRange with the rules
+// Validate checks the field values on TableColumnsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TimeSeriesTimeRange) Validate() error {
+func (m *TableColumnsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TimeSeriesTimeRange with the rules
+// ValidateAll checks the field values on TableColumnsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TimeSeriesTimeRangeMultiError, or nil if none found.
-func (m *TimeSeriesTimeRange) ValidateAll() error {
+// TableColumnsRequestMultiError, or nil if none found.
+func (m *TableColumnsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TimeSeriesTimeRange) validate(all bool) error {
+func (m *TableColumnsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStart()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesTimeRangeValidationError{
-				field:  "Start",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for InstanceId
 
-	if all {
-		switch v := interface{}(m.GetEnd()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesTimeRangeValidationError{
-				field:  "End",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Connector
 
-	if _, ok := TimeGrain_name[int32(m.GetInterval())]; !ok {
-		err := TimeSeriesTimeRangeValidationError{
-			field:  "Interval",
-			reason: "value must be one of the defined enum values",
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := TableColumnsRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -14380,20 +17031,22 @@ func (m *TimeSeriesTimeRange) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return TimeSeriesTimeRangeMultiError(errors)
+		return TableColumnsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeSeriesTimeRangeMultiError is an error wrapping multiple validation
-// errors returned by TimeSeriesTimeRange.ValidateAll() if the designated
+// TableColumnsRequestMultiError is an error wrapping multiple validation
+// errors returned by TableColumnsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TimeSeriesTimeRangeMultiError []error
+type TableColumnsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeSeriesTimeRangeMultiError) Error() string {
+func (m TableColumnsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14402,11 +17055,11 @@ func (m TimeSeriesTimeRangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeSeriesTimeRangeMultiError) AllErrors() []error { return m }
+func (m TableColumnsRequestMultiError) AllErrors() []error { return m }
 
-// TimeSeriesTimeRangeValidationError is the validation error returned by
-// TimeSeriesTimeRange.Validate if the designated constraints aren't met.
-type TimeSeriesTimeRangeValidationError struct {
+// TableColumnsRequestValidationError is the validation error returned by
+// TableColumnsRequest.Validate if the designated constraints aren't met.
+type TableColumnsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14414,24 +17067,24 @@ type TimeSeriesTimeRangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeSeriesTimeRangeValidationError) Field() string { return e.field }
+func (e TableColumnsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeSeriesTimeRangeValidationError) Reason() string { return e.reason }
+func (e TableColumnsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeSeriesTimeRangeValidationError) Cause() error { return e.cause }
+func (e TableColumnsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeSeriesTimeRangeValidationError) Key() bool { return e.key }
+func (e TableColumnsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeSeriesTimeRangeValidationError) ErrorName() string {
-	return "TimeSeriesTimeRangeValidationError"
+func (e TableColumnsRequestValidationError) ErrorName() string {
+	return "TableColumnsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TimeSeriesTimeRangeValidationError) Error() string {
+func (e TableColumnsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14443,96 +17096,62 @@ func (e TimeSeriesTimeRangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeSeriesTimeRange.%s: %s%s",
+		"invalid %sTableColumnsRequest.%s: %s%s",
 		key,
 		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = TimeSeriesTimeRangeValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = TimeSeriesTimeRangeValidationError{}
-
-// Validate checks the field values on TimeSeriesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TimeSeriesResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on TimeSeriesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TimeSeriesResponseMultiError, or nil if none found.
-func (m *TimeSeriesResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *TimeSeriesResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TimeSeriesResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+		e.reason,
+		cause)
+}
+
+var _ error = TableColumnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableColumnsRequestValidationError{}
+
+// Validate checks the field values on TableColumnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableColumnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableColumnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableColumnsResponseMultiError, or nil if none found.
+func (m *TableColumnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *TableColumnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetSpark() {
+	var errors []error
+
+	for idx, item := range m.GetProfileColumns() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Spark[%v]", idx),
+					errors = append(errors, TableColumnsResponseValidationError{
+						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Spark[%v]", idx),
+					errors = append(errors, TableColumnsResponseValidationError{
+						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -14540,8 +17159,8 @@ func (m *TimeSeriesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TimeSeriesResponseValidationError{
-					field:  fmt.Sprintf("Spark[%v]", idx),
+				return TableColumnsResponseValidationError{
+					field:  fmt.Sprintf("ProfileColumns[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -14550,22 +17169,22 @@ func (m *TimeSeriesResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for SampleSize
+	// no validation rules for UnsupportedColumns
 
 	if len(errors) > 0 {
-		return TimeSeriesResponseMultiError(errors)
+		return TableColumnsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeSeriesResponseMultiError is an error wrapping multiple validation errors
-// returned by TimeSeriesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TimeSeriesResponseMultiError []error
+// TableColumnsResponseMultiError is an error wrapping multiple validation
+// errors returned by TableColumnsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TableColumnsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeSeriesResponseMultiError) Error() string {
+func (m TableColumnsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14574,11 +17193,11 @@ func (m TimeSeriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeSeriesResponseMultiError) AllErrors() []error { return m }
+func (m TableColumnsResponseMultiError) AllErrors() []error { return m }
 
-// TimeSeriesResponseValidationError is the validation error returned by
-// TimeSeriesResponse.Validate if the designated constraints aren't met.
-type TimeSeriesResponseValidationError struct {
+// TableColumnsResponseValidationError is the validation error returned by
+// TableColumnsResponse.Validate if the designated constraints aren't met.
+type TableColumnsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14586,24 +17205,24 @@ type TimeSeriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeSeriesResponseValidationError) Field() string { return e.field }
+func (e TableColumnsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeSeriesResponseValidationError) Reason() string { return e.reason }
+func (e TableColumnsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeSeriesResponseValidationError) Cause() error { return e.cause }
+func (e TableColumnsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeSeriesResponseValidationError) Key() bool { return e.key }
+func (e TableColumnsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeSeriesResponseValidationError) ErrorName() string {
-	return "TimeSeriesResponseValidationError"
+func (e TableColumnsResponseValidationError) ErrorName() string {
+	return "TableColumnsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TimeSeriesResponseValidationError) Error() string {
+func (e TableColumnsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14615,14 +17234,14 @@ func (e TimeSeriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeSeriesResponse.%s: %s%s",
+		"invalid %sTableColumnsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeSeriesResponseValidationError{}
+var _ error = TableColumnsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14630,104 +17249,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeSeriesResponseValidationError{}
+} = TableColumnsResponseValidationError{}
 
-// Validate checks the field values on TimeSeriesValue with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TimeSeriesValue) Validate() error {
+// Validate checks the field values on ProfileColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProfileColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TimeSeriesValue with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TimeSeriesValueMultiError, or nil if none found.
-func (m *TimeSeriesValue) ValidateAll() error {
+// ValidateAll checks the field values on ProfileColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProfileColumnMultiError, or
+// nil if none found.
+func (m *ProfileColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TimeSeriesValue) validate(all bool) error {
+func (m *ProfileColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTs()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Ts",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Ts",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTs()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesValueValidationError{
-				field:  "Ts",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
-	// no validation rules for Bin
+	// no validation rules for Type
 
-	if all {
-		switch v := interface{}(m.GetRecords()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Records",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Records",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRecords()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesValueValidationError{
-				field:  "Records",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for LargestStringLength
 
 	if len(errors) > 0 {
-		return TimeSeriesValueMultiError(errors)
+		return ProfileColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeSeriesValueMultiError is an error wrapping multiple validation errors
-// returned by TimeSeriesValue.ValidateAll() if the designated constraints
+// ProfileColumnMultiError is an error wrapping multiple validation errors
+// returned by ProfileColumn.ValidateAll() if the designated constraints
 // aren't met.
-type TimeSeriesValueMultiError []error
+type ProfileColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeSeriesValueMultiError) Error() string {
+func (m ProfileColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14736,11 +17301,11 @@ func (m TimeSeriesValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeSeriesValueMultiError) AllErrors() []error { return m }
+func (m ProfileColumnMultiError) AllErrors() []error { return m }
 
-// TimeSeriesValueValidationError is the validation error returned by
-// TimeSeriesValue.Validate if the designated constraints aren't met.
-type TimeSeriesValueValidationError struct {
+// ProfileColumnValidationError is the validation error returned by
+// ProfileColumn.Validate if the designated constraints aren't met.
+type ProfileColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14748,22 +17313,22 @@ type TimeSeriesValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeSeriesValueValidationError) Field() string { return e.field }
+func (e ProfileColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeSeriesValueValidationError) Reason() string { return e.reason }
+func (e ProfileColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeSeriesValueValidationError) Cause() error { return e.cause }
+func (e ProfileColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeSeriesValueValidationError) Key() bool { return e.key }
+func (e ProfileColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeSeriesValueValidationError) ErrorName() string { return "TimeSeriesValueValidationError" }
+func (e ProfileColumnValidationError) ErrorName() string { return "ProfileColumnValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TimeSeriesValueValidationError) Error() string {
+func (e ProfileColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14775,14 +17340,14 @@ func (e TimeSeriesValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeSeriesValue.%s: %s%s",
+		"invalid %sProfileColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeSeriesValueValidationError{}
+var _ error = ProfileColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -14790,24 +17355,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeSeriesValueValidationError{}
+} = ProfileColumnValidationError{}
 
-// Validate checks the field values on TableCardinalityRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableCardinalityRequest) Validate() error {
+// Validate checks the field values on TableDiffRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableDiffRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableCardinalityRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TableDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableCardinalityRequestMultiError, or nil if none found.
-func (m *TableCardinalityRequest) ValidateAll() error {
+// TableDiffRequestMultiError, or nil if none found.
+func (m *TableDiffRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableCardinalityRequest) validate(all bool) error {
+func (m *TableDiffRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14822,9 +17387,20 @@ func (m *TableCardinalityRequest) validate(all bool) error {
 
 	// no validation rules for DatabaseSchema
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := TableCardinalityRequestValidationError{
-			field:  "TableName",
+	if utf8.RuneCountInString(m.GetBaseTableName()) < 1 {
+		err := TableDiffRequestValidationError{
+			field:  "BaseTableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCompareTableName()) < 1 {
+		err := TableDiffRequestValidationError{
+			field:  "CompareTableName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -14836,19 +17412,19 @@ func (m *TableCardinalityRequest) validate(all bool) error {
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return TableCardinalityRequestMultiError(errors)
+		return TableDiffRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableCardinalityRequestMultiError is an error wrapping multiple validation
-// errors returned by TableCardinalityRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TableCardinalityRequestMultiError []error
+// TableDiffRequestMultiError is an error wrapping multiple validation errors
+// returned by TableDiffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TableDiffRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableCardinalityRequestMultiError) Error() string {
+func (m TableDiffRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14857,11 +17433,11 @@ func (m TableCardinalityRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableCardinalityRequestMultiError) AllErrors() []error { return m }
+func (m TableDiffRequestMultiError) AllErrors() []error { return m }
 
-// TableCardinalityRequestValidationError is the validation error returned by
-// TableCardinalityRequest.Validate if the designated constraints aren't met.
-type TableCardinalityRequestValidationError struct {
+// TableDiffRequestValidationError is the validation error returned by
+// TableDiffRequest.Validate if the designated constraints aren't met.
+type TableDiffRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14869,24 +17445,22 @@ type TableCardinalityRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableCardinalityRequestValidationError) Field() string { return e.field }
+func (e TableDiffRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableCardinalityRequestValidationError) Reason() string { return e.reason }
+func (e TableDiffRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableCardinalityRequestValidationError) Cause() error { return e.cause }
+func (e TableDiffRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableCardinalityRequestValidationError) Key() bool { return e.key }
+func (e TableDiffRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableCardinalityRequestValidationError) ErrorName() string {
-	return "TableCardinalityRequestValidationError"
-}
+func (e TableDiffRequestValidationError) ErrorName() string { return "TableDiffRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TableCardinalityRequestValidationError) Error() string {
+func (e TableDiffRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14898,14 +17472,14 @@ func (e TableCardinalityRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableCardinalityRequest.%s: %s%s",
+		"invalid %sTableDiffRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableCardinalityRequestValidationError{}
+var _ error = TableDiffRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14913,46 +17487,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableCardinalityRequestValidationError{}
+} = TableDiffRequestValidationError{}
 
-// Validate checks the field values on TableCardinalityResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableCardinalityResponse) Validate() error {
+// Validate checks the field values on TableDiffResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableDiffResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableCardinalityResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TableDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableCardinalityResponseMultiError, or nil if none found.
-func (m *TableCardinalityResponse) ValidateAll() error {
+// TableDiffResponseMultiError, or nil if none found.
+func (m *TableDiffResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableCardinalityResponse) validate(all bool) error {
+func (m *TableDiffResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cardinality
+	// no validation rules for BaseRowCount
+
+	// no validation rules for CompareRowCount
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableDiffResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableDiffResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableDiffResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRows()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableDiffResponseValidationError{
+					field:  "Rows",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableDiffResponseValidationError{
+					field:  "Rows",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRows()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableDiffResponseValidationError{
+				field:  "Rows",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return TableCardinalityResponseMultiError(errors)
+		return TableDiffResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableCardinalityResponseMultiError is an error wrapping multiple validation
-// errors returned by TableCardinalityResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TableCardinalityResponseMultiError []error
+// TableDiffResponseMultiError is an error wrapping multiple validation errors
+// returned by TableDiffResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TableDiffResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableCardinalityResponseMultiError) Error() string {
+func (m TableDiffResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14961,11 +17600,11 @@ func (m TableCardinalityResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableCardinalityResponseMultiError) AllErrors() []error { return m }
+func (m TableDiffResponseMultiError) AllErrors() []error { return m }
 
-// TableCardinalityResponseValidationError is the validation error returned by
-// TableCardinalityResponse.Validate if the designated constraints aren't met.
-type TableCardinalityResponseValidationError struct {
+// TableDiffResponseValidationError is the validation error returned by
+// TableDiffResponse.Validate if the designated constraints aren't met.
+type TableDiffResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14973,24 +17612,24 @@ type TableCardinalityResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableCardinalityResponseValidationError) Field() string { return e.field }
+func (e TableDiffResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableCardinalityResponseValidationError) Reason() string { return e.reason }
+func (e TableDiffResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableCardinalityResponseValidationError) Cause() error { return e.cause }
+func (e TableDiffResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableCardinalityResponseValidationError) Key() bool { return e.key }
+func (e TableDiffResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableCardinalityResponseValidationError) ErrorName() string {
-	return "TableCardinalityResponseValidationError"
+func (e TableDiffResponseValidationError) ErrorName() string {
+	return "TableDiffResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TableCardinalityResponseValidationError) Error() string {
+func (e TableDiffResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15002,14 +17641,14 @@ func (e TableCardinalityResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableCardinalityResponse.%s: %s%s",
+		"invalid %sTableDiffResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableCardinalityResponseValidationError{}
+var _ error = TableDiffResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15017,65 +17656,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableCardinalityResponseValidationError{}
+} = TableDiffResponseValidationError{}
 
-// Validate checks the field values on TableColumnsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableColumnsRequest) Validate() error {
+// Validate checks the field values on TableDiffColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableDiffColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableColumnsRequest with the rules
+// ValidateAll checks the field values on TableDiffColumn with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableColumnsRequestMultiError, or nil if none found.
-func (m *TableColumnsRequest) ValidateAll() error {
+// TableDiffColumnMultiError, or nil if none found.
+func (m *TableDiffColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableColumnsRequest) validate(all bool) error {
+func (m *TableDiffColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for Name
 
-	// no validation rules for Connector
+	// no validation rules for BaseType
 
-	// no validation rules for Database
+	// no validation rules for CompareType
 
-	// no validation rules for DatabaseSchema
+	if all {
+		switch v := interface{}(m.GetBase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableDiffColumnValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableDiffColumnValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableDiffColumnValidationError{
+				field:  "Base",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := TableColumnsRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetCompare()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableDiffColumnValidationError{
+					field:  "Compare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableDiffColumnValidationError{
+					field:  "Compare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetCompare()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableDiffColumnValidationError{
+				field:  "Compare",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
+	// no validation rules for ChangedRows
 
 	if len(errors) > 0 {
-		return TableColumnsRequestMultiError(errors)
+		return TableDiffColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableColumnsRequestMultiError is an error wrapping multiple validation
-// errors returned by TableColumnsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TableColumnsRequestMultiError []error
+// TableDiffColumnMultiError is an error wrapping multiple validation errors
+// returned by TableDiffColumn.ValidateAll() if the designated constraints
+// aren't met.
+type TableDiffColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableColumnsRequestMultiError) Error() string {
+func (m TableDiffColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15084,11 +17768,11 @@ func (m TableColumnsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableColumnsRequestMultiError) AllErrors() []error { return m }
+func (m TableDiffColumnMultiError) AllErrors() []error { return m }
 
-// TableColumnsRequestValidationError is the validation error returned by
-// TableColumnsRequest.Validate if the designated constraints aren't met.
-type TableColumnsRequestValidationError struct {
+// TableDiffColumnValidationError is the validation error returned by
+// TableDiffColumn.Validate if the designated constraints aren't met.
+type TableDiffColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15096,24 +17780,22 @@ type TableColumnsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableColumnsRequestValidationError) Field() string { return e.field }
+func (e TableDiffColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableColumnsRequestValidationError) Reason() string { return e.reason }
+func (e TableDiffColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableColumnsRequestValidationError) Cause() error { return e.cause }
+func (e TableDiffColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableColumnsRequestValidationError) Key() bool { return e.key }
+func (e TableDiffColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableColumnsRequestValidationError) ErrorName() string {
-	return "TableColumnsRequestValidationError"
-}
+func (e TableDiffColumnValidationError) ErrorName() string { return "TableDiffColumnValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TableColumnsRequestValidationError) Error() string {
+func (e TableDiffColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15125,14 +17807,14 @@ func (e TableColumnsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableColumnsRequest.%s: %s%s",
+		"invalid %sTableDiffColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableColumnsRequestValidationError{}
+var _ error = TableDiffColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -15140,80 +17822,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableColumnsRequestValidationError{}
+} = TableDiffColumnValidationError{}
 
-// Validate checks the field values on TableColumnsResponse with the rules
+// Validate checks the field values on TableDiffColumnProfile with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableColumnsResponse) Validate() error {
+func (m *TableDiffColumnProfile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableColumnsResponse with the rules
+// ValidateAll checks the field values on TableDiffColumnProfile with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableColumnsResponseMultiError, or nil if none found.
-func (m *TableColumnsResponse) ValidateAll() error {
+// TableDiffColumnProfileMultiError, or nil if none found.
+func (m *TableDiffColumnProfile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableColumnsResponse) validate(all bool) error {
+func (m *TableDiffColumnProfile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProfileColumns() {
-		_, _ = idx, item
+	// no validation rules for NullCount
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TableColumnsResponseValidationError{
-						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TableColumnsResponseValidationError{
-						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Cardinality
+
+	if all {
+		switch v := interface{}(m.GetNumericStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableDiffColumnProfileValidationError{
+					field:  "NumericStatistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return TableColumnsResponseValidationError{
-					field:  fmt.Sprintf("ProfileColumns[%v]", idx),
+				errors = append(errors, TableDiffColumnProfileValidationError{
+					field:  "NumericStatistics",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNumericStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableDiffColumnProfileValidationError{
+				field:  "NumericStatistics",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for UnsupportedColumns
-
 	if len(errors) > 0 {
-		return TableColumnsResponseMultiError(errors)
+		return TableDiffColumnProfileMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableColumnsResponseMultiError is an error wrapping multiple validation
-// errors returned by TableColumnsResponse.ValidateAll() if the designated
+// TableDiffColumnProfileMultiError is an error wrapping multiple validation
+// errors returned by TableDiffColumnProfile.ValidateAll() if the designated
 // constraints aren't met.
-type TableColumnsResponseMultiError []error
+type TableDiffColumnProfileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableColumnsResponseMultiError) Error() string {
+func (m TableDiffColumnProfileMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15222,11 +17901,11 @@ func (m TableColumnsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableColumnsResponseMultiError) AllErrors() []error { return m }
+func (m TableDiffColumnProfileMultiError) AllErrors() []error { return m }
 
-// TableColumnsResponseValidationError is the validation error returned by
-// TableColumnsResponse.Validate if the designated constraints aren't met.
-type TableColumnsResponseValidationError struct {
+// TableDiffColumnProfileValidationError is the validation error returned by
+// TableDiffColumnProfile.Validate if the designated constraints aren't met.
+type TableDiffColumnProfileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15234,24 +17913,24 @@ type TableColumnsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableColumnsResponseValidationError) Field() string { return e.field }
+func (e TableDiffColumnProfileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableColumnsResponseValidationError) Reason() string { return e.reason }
+func (e TableDiffColumnProfileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableColumnsResponseValidationError) Cause() error { return e.cause }
+func (e TableDiffColumnProfileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableColumnsResponseValidationError) Key() bool { return e.key }
+func (e TableDiffColumnProfileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableColumnsResponseValidationError) ErrorName() string {
-	return "TableColumnsResponseValidationError"
+func (e TableDiffColumnProfileValidationError) ErrorName() string {
+	return "TableDiffColumnProfileValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TableColumnsResponseValidationError) Error() string {
+func (e TableDiffColumnProfileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15263,14 +17942,14 @@ func (e TableColumnsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableColumnsResponse.%s: %s%s",
+		"invalid %sTableDiffColumnProfile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableColumnsResponseValidationError{}
+var _ error = TableDiffColumnProfileValidationError{}
 
 var _ interface {
 	Field() string
@@ -15278,50 +17957,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableColumnsResponseValidationError{}
+} = TableDiffColumnProfileValidationError{}
 
-// Validate checks the field values on ProfileColumn with the rules defined in
+// Validate checks the field values on TableDiffRows with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ProfileColumn) Validate() error {
+func (m *TableDiffRows) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProfileColumn with the rules defined
+// ValidateAll checks the field values on TableDiffRows with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ProfileColumnMultiError, or
+// result is a list of violation errors wrapped in TableDiffRowsMultiError, or
 // nil if none found.
-func (m *ProfileColumn) ValidateAll() error {
+func (m *TableDiffRows) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProfileColumn) validate(all bool) error {
+func (m *TableDiffRows) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Added
 
-	// no validation rules for Type
+	// no validation rules for Removed
 
-	// no validation rules for LargestStringLength
+	// no validation rules for Changed
+
+	// no validation rules for Unchanged
 
 	if len(errors) > 0 {
-		return ProfileColumnMultiError(errors)
+		return TableDiffRowsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProfileColumnMultiError is an error wrapping multiple validation errors
-// returned by ProfileColumn.ValidateAll() if the designated constraints
+// TableDiffRowsMultiError is an error wrapping multiple validation errors
+// returned by TableDiffRows.ValidateAll() if the designated constraints
 // aren't met.
-type ProfileColumnMultiError []error
+type TableDiffRowsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProfileColumnMultiError) Error() string {
+func (m TableDiffRowsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15330,11 +18011,11 @@ func (m ProfileColumnMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProfileColumnMultiError) AllErrors() []error { return m }
+func (m TableDiffRowsMultiError) AllErrors() []error { return m }
 
-// ProfileColumnValidationError is the validation error returned by
-// ProfileColumn.Validate if the designated constraints aren't met.
-type ProfileColumnValidationError struct {
+// TableDiffRowsValidationError is the validation error returned by
+// TableDiffRows.Validate if the designated constraints aren't met.
+type TableDiffRowsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15342,22 +18023,22 @@ type ProfileColumnValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProfileColumnValidationError) Field() string { return e.field }
+func (e TableDiffRowsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProfileColumnValidationError) Reason() string { return e.reason }
+func (e TableDiffRowsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProfileColumnValidationError) Cause() error { return e.cause }
+func (e TableDiffRowsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProfileColumnValidationError) Key() bool { return e.key }
+func (e TableDiffRowsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProfileColumnValidationError) ErrorName() string { return "ProfileColumnValidationError" }
+func (e TableDiffRowsValidationError) ErrorName() string { return "TableDiffRowsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProfileColumnValidationError) Error() string {
+func (e TableDiffRowsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15369,14 +18050,14 @@ func (e ProfileColumnValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProfileColumn.%s: %s%s",
+		"invalid %sTableDiffRows.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProfileColumnValidationError{}
+var _ error = TableDiffRowsValidationError{}
 
 var _ interface {
 	Field() string
@@ -15384,7 +18065,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProfileColumnValidationError{}
+} = TableDiffRowsValidationError{}
 
 // Validate checks the field values on TableRowsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
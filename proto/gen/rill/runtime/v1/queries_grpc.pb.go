@@ -20,12 +20,14 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	QueryService_Query_FullMethodName                       = "/rill.runtime.v1.QueryService/Query"
+	QueryService_QueryResolver_FullMethodName               = "/rill.runtime.v1.QueryService/QueryResolver"
 	QueryService_QueryBatch_FullMethodName                  = "/rill.runtime.v1.QueryService/QueryBatch"
 	QueryService_Export_FullMethodName                      = "/rill.runtime.v1.QueryService/Export"
 	QueryService_ExportReport_FullMethodName                = "/rill.runtime.v1.QueryService/ExportReport"
 	QueryService_MetricsViewAggregation_FullMethodName      = "/rill.runtime.v1.QueryService/MetricsViewAggregation"
 	QueryService_MetricsViewToplist_FullMethodName          = "/rill.runtime.v1.QueryService/MetricsViewToplist"
 	QueryService_MetricsViewComparison_FullMethodName       = "/rill.runtime.v1.QueryService/MetricsViewComparison"
+	QueryService_MetricsViewContribution_FullMethodName     = "/rill.runtime.v1.QueryService/MetricsViewContribution"
 	QueryService_MetricsViewTimeSeries_FullMethodName       = "/rill.runtime.v1.QueryService/MetricsViewTimeSeries"
 	QueryService_MetricsViewTotals_FullMethodName           = "/rill.runtime.v1.QueryService/MetricsViewTotals"
 	QueryService_MetricsViewRows_FullMethodName             = "/rill.runtime.v1.QueryService/MetricsViewRows"
@@ -47,6 +49,7 @@ const (
 	QueryService_ColumnTimeSeries_FullMethodName            = "/rill.runtime.v1.QueryService/ColumnTimeSeries"
 	QueryService_TableCardinality_FullMethodName            = "/rill.runtime.v1.QueryService/TableCardinality"
 	QueryService_TableColumns_FullMethodName                = "/rill.runtime.v1.QueryService/TableColumns"
+	QueryService_TableDiff_FullMethodName                   = "/rill.runtime.v1.QueryService/TableDiff"
 	QueryService_TableRows_FullMethodName                   = "/rill.runtime.v1.QueryService/TableRows"
 )
 
@@ -56,6 +59,9 @@ const (
 type QueryServiceClient interface {
 	// Query runs a SQL query against the instance's OLAP datastore.
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// QueryResolver runs an ad-hoc query using a resolver, such as "sql" or "metrics_sql".
+	// Only admins can run ad-hoc resolvers. They can optionally impersonate another user by passing their attributes.
+	QueryResolver(ctx context.Context, in *QueryResolverRequest, opts ...grpc.CallOption) (*QueryResolverResponse, error)
 	// Batch request with different queries
 	QueryBatch(ctx context.Context, in *QueryBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryBatchResponse], error)
 	// Export builds a URL to download the results of a query as a file.
@@ -85,6 +91,10 @@ type QueryServiceClient interface {
 	// | 1        | 350      | Chrome    |
 	// | 0        | 25       | Firefox   |
 	MetricsViewComparison(ctx context.Context, in *MetricsViewComparisonRequest, opts ...grpc.CallOption) (*MetricsViewComparisonResponse, error)
+	// MetricsViewContribution explains the change of a measure between two time ranges.
+	// It ranks the values of the metrics view's dimensions by their contribution to the change,
+	// and optionally decomposes each contribution into volume, mix and rate effects.
+	MetricsViewContribution(ctx context.Context, in *MetricsViewContributionRequest, opts ...grpc.CallOption) (*MetricsViewContributionResponse, error)
 	// MetricsViewTimeSeries returns time series for the measures in the metrics view.
 	// It's a convenience API for querying a metrics view.
 	MetricsViewTimeSeries(ctx context.Context, in *MetricsViewTimeSeriesRequest, opts ...grpc.CallOption) (*MetricsViewTimeSeriesResponse, error)
@@ -143,6 +153,8 @@ type QueryServiceClient interface {
 	TableCardinality(ctx context.Context, in *TableCardinalityRequest, opts ...grpc.CallOption) (*TableCardinalityResponse, error)
 	// TableColumns returns column profiles
 	TableColumns(ctx context.Context, in *TableColumnsRequest, opts ...grpc.CallOption) (*TableColumnsResponse, error)
+	// TableDiff compares the schema, row counts, column profiles and (optionally) key-matched rows of two tables
+	TableDiff(ctx context.Context, in *TableDiffRequest, opts ...grpc.CallOption) (*TableDiffResponse, error)
 	// TableRows returns table rows
 	TableRows(ctx context.Context, in *TableRowsRequest, opts ...grpc.CallOption) (*TableRowsResponse, error)
 }
@@ -165,6 +177,16 @@ func (c *queryServiceClient) Query(ctx context.Context, in *QueryRequest, opts .
 	return out, nil
 }
 
+func (c *queryServiceClient) QueryResolver(ctx context.Context, in *QueryResolverRequest, opts ...grpc.CallOption) (*QueryResolverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResolverResponse)
+	err := c.cc.Invoke(ctx, QueryService_QueryResolver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) QueryBatch(ctx context.Context, in *QueryBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryBatchResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &QueryService_ServiceDesc.Streams[0], QueryService_QueryBatch_FullMethodName, cOpts...)
@@ -234,6 +256,16 @@ func (c *queryServiceClient) MetricsViewComparison(ctx context.Context, in *Metr
 	return out, nil
 }
 
+func (c *queryServiceClient) MetricsViewContribution(ctx context.Context, in *MetricsViewContributionRequest, opts ...grpc.CallOption) (*MetricsViewContributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetricsViewContributionResponse)
+	err := c.cc.Invoke(ctx, QueryService_MetricsViewContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) MetricsViewTimeSeries(ctx context.Context, in *MetricsViewTimeSeriesRequest, opts ...grpc.CallOption) (*MetricsViewTimeSeriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MetricsViewTimeSeriesResponse)
@@ -444,6 +476,16 @@ func (c *queryServiceClient) TableColumns(ctx context.Context, in *TableColumnsR
 	return out, nil
 }
 
+func (c *queryServiceClient) TableDiff(ctx context.Context, in *TableDiffRequest, opts ...grpc.CallOption) (*TableDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableDiffResponse)
+	err := c.cc.Invoke(ctx, QueryService_TableDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) TableRows(ctx context.Context, in *TableRowsRequest, opts ...grpc.CallOption) (*TableRowsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TableRowsResponse)
@@ -460,6 +502,9 @@ func (c *queryServiceClient) TableRows(ctx context.Context, in *TableRowsRequest
 type QueryServiceServer interface {
 	// Query runs a SQL query against the instance's OLAP datastore.
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// QueryResolver runs an ad-hoc query using a resolver, such as "sql" or "metrics_sql".
+	// Only admins can run ad-hoc resolvers. They can optionally impersonate another user by passing their attributes.
+	QueryResolver(context.Context, *QueryResolverRequest) (*QueryResolverResponse, error)
 	// Batch request with different queries
 	QueryBatch(*QueryBatchRequest, grpc.ServerStreamingServer[QueryBatchResponse]) error
 	// Export builds a URL to download the results of a query as a file.
@@ -489,6 +534,10 @@ type QueryServiceServer interface {
 	// | 1        | 350      | Chrome    |
 	// | 0        | 25       | Firefox   |
 	MetricsViewComparison(context.Context, *MetricsViewComparisonRequest) (*MetricsViewComparisonResponse, error)
+	// MetricsViewContribution explains the change of a measure between two time ranges.
+	// It ranks the values of the metrics view's dimensions by their contribution to the change,
+	// and optionally decomposes each contribution into volume, mix and rate effects.
+	MetricsViewContribution(context.Context, *MetricsViewContributionRequest) (*MetricsViewContributionResponse, error)
 	// MetricsViewTimeSeries returns time series for the measures in the metrics view.
 	// It's a convenience API for querying a metrics view.
 	MetricsViewTimeSeries(context.Context, *MetricsViewTimeSeriesRequest) (*MetricsViewTimeSeriesResponse, error)
@@ -547,6 +596,8 @@ type QueryServiceServer interface {
 	TableCardinality(context.Context, *TableCardinalityRequest) (*TableCardinalityResponse, error)
 	// TableColumns returns column profiles
 	TableColumns(context.Context, *TableColumnsRequest) (*TableColumnsResponse, error)
+	// TableDiff compares the schema, row counts, column profiles and (optionally) key-matched rows of two tables
+	TableDiff(context.Context, *TableDiffRequest) (*TableDiffResponse, error)
 	// TableRows returns table rows
 	TableRows(context.Context, *TableRowsRequest) (*TableRowsResponse, error)
 	mustEmbedUnimplementedQueryServiceServer()
@@ -562,6 +613,9 @@ type UnimplementedQueryServiceServer struct{}
 func (UnimplementedQueryServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedQueryServiceServer) QueryResolver(context.Context, *QueryResolverRequest) (*QueryResolverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryResolver not implemented")
+}
 func (UnimplementedQueryServiceServer) QueryBatch(*QueryBatchRequest, grpc.ServerStreamingServer[QueryBatchResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method QueryBatch not implemented")
 }
@@ -580,6 +634,9 @@ func (UnimplementedQueryServiceServer) MetricsViewToplist(context.Context, *Metr
 func (UnimplementedQueryServiceServer) MetricsViewComparison(context.Context, *MetricsViewComparisonRequest) (*MetricsViewComparisonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewComparison not implemented")
 }
+func (UnimplementedQueryServiceServer) MetricsViewContribution(context.Context, *MetricsViewContributionRequest) (*MetricsViewContributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewContribution not implemented")
+}
 func (UnimplementedQueryServiceServer) MetricsViewTimeSeries(context.Context, *MetricsViewTimeSeriesRequest) (*MetricsViewTimeSeriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewTimeSeries not implemented")
 }
@@ -643,6 +700,9 @@ func (UnimplementedQueryServiceServer) TableCardinality(context.Context, *TableC
 func (UnimplementedQueryServiceServer) TableColumns(context.Context, *TableColumnsRequest) (*TableColumnsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TableColumns not implemented")
 }
+func (UnimplementedQueryServiceServer) TableDiff(context.Context, *TableDiffRequest) (*TableDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TableDiff not implemented")
+}
 func (UnimplementedQueryServiceServer) TableRows(context.Context, *TableRowsRequest) (*TableRowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TableRows not implemented")
 }
@@ -685,6 +745,24 @@ func _QueryService_Query_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_QueryResolver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).QueryResolver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_QueryResolver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).QueryResolver(ctx, req.(*QueryResolverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_QueryBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(QueryBatchRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -786,6 +864,24 @@ func _QueryService_MetricsViewComparison_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_MetricsViewContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsViewContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MetricsViewContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_MetricsViewContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MetricsViewContribution(ctx, req.(*MetricsViewContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_MetricsViewTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MetricsViewTimeSeriesRequest)
 	if err := dec(in); err != nil {
@@ -1164,6 +1260,24 @@ func _QueryService_TableColumns_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_TableDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TableDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TableDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_TableDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TableDiff(ctx, req.(*TableDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_TableRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TableRowsRequest)
 	if err := dec(in); err != nil {
@@ -1193,6 +1307,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _QueryService_Query_Handler,
 		},
+		{
+			MethodName: "QueryResolver",
+			Handler:    _QueryService_QueryResolver_Handler,
+		},
 		{
 			MethodName: "Export",
 			Handler:    _QueryService_Export_Handler,
@@ -1213,6 +1331,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MetricsViewComparison",
 			Handler:    _QueryService_MetricsViewComparison_Handler,
 		},
+		{
+			MethodName: "MetricsViewContribution",
+			Handler:    _QueryService_MetricsViewContribution_Handler,
+		},
 		{
 			MethodName: "MetricsViewTimeSeries",
 			Handler:    _QueryService_MetricsViewTimeSeries_Handler,
@@ -1297,6 +1419,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TableColumns",
 			Handler:    _QueryService_TableColumns_Handler,
 		},
+		{
+			MethodName: "TableDiff",
+			Handler:    _QueryService_TableDiff_Handler,
+		},
 		{
 			MethodName: "TableRows",
 			Handler:    _QueryService_TableRows_Handler,
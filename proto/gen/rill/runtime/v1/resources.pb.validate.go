@@ -2579,6 +2579,35 @@ func (m *ModelSpec) validate(all bool) error {
 
 	// no validation rules for TriggerFull
 
+	if all {
+		switch v := interface{}(m.GetContract()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Contract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Contract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContract()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelSpecValidationError{
+				field:  "Contract",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ModelSpecMultiError(errors)
 	}
@@ -2656,6 +2685,250 @@ var _ interface {
 	ErrorName() string
 } = ModelSpecValidationError{}
 
+// Validate checks the field values on ModelContract with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelContract) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelContract with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelContractMultiError, or
+// nil if none found.
+func (m *ModelContract) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelContract) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enforced
+
+	// no validation rules for AllowExtraColumns
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelContractValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelContractValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelContractValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ModelContractMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelContractMultiError is an error wrapping multiple validation errors
+// returned by ModelContract.ValidateAll() if the designated constraints
+// aren't met.
+type ModelContractMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelContractMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelContractMultiError) AllErrors() []error { return m }
+
+// ModelContractValidationError is the validation error returned by
+// ModelContract.Validate if the designated constraints aren't met.
+type ModelContractValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelContractValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelContractValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelContractValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelContractValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelContractValidationError) ErrorName() string { return "ModelContractValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelContractValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelContract.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelContractValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelContractValidationError{}
+
+// Validate checks the field values on ModelContractColumn with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModelContractColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelContractColumn with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModelContractColumnMultiError, or nil if none found.
+func (m *ModelContractColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelContractColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return ModelContractColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelContractColumnMultiError is an error wrapping multiple validation
+// errors returned by ModelContractColumn.ValidateAll() if the designated
+// constraints aren't met.
+type ModelContractColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelContractColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelContractColumnMultiError) AllErrors() []error { return m }
+
+// ModelContractColumnValidationError is the validation error returned by
+// ModelContractColumn.Validate if the designated constraints aren't met.
+type ModelContractColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelContractColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelContractColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelContractColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelContractColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelContractColumnValidationError) ErrorName() string {
+	return "ModelContractColumnValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModelContractColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelContractColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelContractColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelContractColumnValidationError{}
+
 // Validate checks the field values on ModelState with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2808,32 +3081,95 @@ func (m *ModelState) validate(all bool) error {
 
 	// no validation rules for PartitionsHaveErrors
 
-	if len(errors) > 0 {
-		return ModelStateMultiError(errors)
-	}
-
-	return nil
-}
-
-// ModelStateMultiError is an error wrapping multiple validation errors
-// returned by ModelState.ValidateAll() if the designated constraints aren't met.
-type ModelStateMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ModelStateMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetResultSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "ResultSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "ResultSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResultSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "ResultSchema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m ModelStateMultiError) AllErrors() []error { return m }
+	for idx, item := range m.GetSchemaChanges() {
+		_, _ = idx, item
 
-// ModelStateValidationError is the validation error returned by
-// ModelState.Validate if the designated constraints aren't met.
-type ModelStateValidationError struct {
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelStateValidationError{
+					field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ModelStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelStateMultiError is an error wrapping multiple validation errors
+// returned by ModelState.ValidateAll() if the designated constraints aren't met.
+type ModelStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelStateMultiError) AllErrors() []error { return m }
+
+// ModelStateValidationError is the validation error returned by
+// ModelState.Validate if the designated constraints aren't met.
+type ModelStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2885,22 +3221,22 @@ var _ interface {
 	ErrorName() string
 } = ModelStateValidationError{}
 
-// Validate checks the field values on MetricsViewV2 with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewV2) Validate() error {
+// Validate checks the field values on ModelSchemaChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ModelSchemaChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewV2 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MetricsViewV2MultiError, or
-// nil if none found.
-func (m *MetricsViewV2) ValidateAll() error {
+// ValidateAll checks the field values on ModelSchemaChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModelSchemaChangeMultiError, or nil if none found.
+func (m *ModelSchemaChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewV2) validate(all bool) error {
+func (m *ModelSchemaChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2908,77 +3244,82 @@ func (m *MetricsViewV2) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
+		switch v := interface{}(m.GetDetectedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "Spec",
+				errors = append(errors, ModelSchemaChangeValidationError{
+					field:  "DetectedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "Spec",
+				errors = append(errors, ModelSchemaChangeValidationError{
+					field:  "DetectedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDetectedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewV2ValidationError{
-				field:  "Spec",
+			return ModelSchemaChangeValidationError{
+				field:  "DetectedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetState()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelSchemaChangeValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelSchemaChangeValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "State",
+				return ModelSchemaChangeValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewV2ValidationError{
-				field:  "State",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewV2MultiError(errors)
+		return ModelSchemaChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewV2MultiError is an error wrapping multiple validation errors
-// returned by MetricsViewV2.ValidateAll() if the designated constraints
+// ModelSchemaChangeMultiError is an error wrapping multiple validation errors
+// returned by ModelSchemaChange.ValidateAll() if the designated constraints
 // aren't met.
-type MetricsViewV2MultiError []error
+type ModelSchemaChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewV2MultiError) Error() string {
+func (m ModelSchemaChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2987,11 +3328,11 @@ func (m MetricsViewV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewV2MultiError) AllErrors() []error { return m }
+func (m ModelSchemaChangeMultiError) AllErrors() []error { return m }
 
-// MetricsViewV2ValidationError is the validation error returned by
-// MetricsViewV2.Validate if the designated constraints aren't met.
-type MetricsViewV2ValidationError struct {
+// ModelSchemaChangeValidationError is the validation error returned by
+// ModelSchemaChange.Validate if the designated constraints aren't met.
+type ModelSchemaChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2999,22 +3340,24 @@ type MetricsViewV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewV2ValidationError) Field() string { return e.field }
+func (e ModelSchemaChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewV2ValidationError) Reason() string { return e.reason }
+func (e ModelSchemaChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewV2ValidationError) Cause() error { return e.cause }
+func (e ModelSchemaChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewV2ValidationError) Key() bool { return e.key }
+func (e ModelSchemaChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewV2ValidationError) ErrorName() string { return "MetricsViewV2ValidationError" }
+func (e ModelSchemaChangeValidationError) ErrorName() string {
+	return "ModelSchemaChangeValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MetricsViewV2ValidationError) Error() string {
+func (e ModelSchemaChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3026,14 +3369,14 @@ func (e MetricsViewV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewV2.%s: %s%s",
+		"invalid %sModelSchemaChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewV2ValidationError{}
+var _ error = ModelSchemaChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3041,220 +3384,214 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewV2ValidationError{}
+} = ModelSchemaChangeValidationError{}
 
-// Validate checks the field values on MetricsViewSpec with the rules defined
+// Validate checks the field values on ModelColumnChange with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSpec) Validate() error {
+func (m *ModelColumnChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSpec with the rules
+// ValidateAll checks the field values on ModelColumnChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSpecMultiError, or nil if none found.
-func (m *MetricsViewSpec) ValidateAll() error {
+// ModelColumnChangeMultiError, or nil if none found.
+func (m *ModelColumnChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSpec) validate(all bool) error {
+func (m *ModelColumnChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
+	// no validation rules for Name
 
-	// no validation rules for Database
+	// no validation rules for Added
 
-	// no validation rules for DatabaseSchema
+	// no validation rules for Removed
 
-	// no validation rules for Table
+	// no validation rules for PreviousType
 
-	// no validation rules for Model
+	// no validation rules for NewType
 
-	// no validation rules for DisplayName
+	if len(errors) > 0 {
+		return ModelColumnChangeMultiError(errors)
+	}
 
-	// no validation rules for Description
+	return nil
+}
 
-	// no validation rules for TimeDimension
+// ModelColumnChangeMultiError is an error wrapping multiple validation errors
+// returned by ModelColumnChange.ValidateAll() if the designated constraints
+// aren't met.
+type ModelColumnChangeMultiError []error
 
-	// no validation rules for SmallestTimeGrain
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelColumnChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for WatermarkExpression
+// AllErrors returns a list of validation violation errors.
+func (m ModelColumnChangeMultiError) AllErrors() []error { return m }
 
-	for idx, item := range m.GetDimensions() {
-		_, _ = idx, item
+// ModelColumnChangeValidationError is the validation error returned by
+// ModelColumnChange.Validate if the designated constraints aren't met.
+type ModelColumnChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Dimensions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Dimensions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("Dimensions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Field function returns field value.
+func (e ModelColumnChangeValidationError) Field() string { return e.field }
 
-	}
+// Reason function returns reason value.
+func (e ModelColumnChangeValidationError) Reason() string { return e.reason }
 
-	for idx, item := range m.GetMeasures() {
-		_, _ = idx, item
+// Cause function returns cause value.
+func (e ModelColumnChangeValidationError) Cause() error { return e.cause }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("Measures[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Key function returns key value.
+func (e ModelColumnChangeValidationError) Key() bool { return e.key }
 
+// ErrorName returns error name.
+func (e ModelColumnChangeValidationError) ErrorName() string {
+	return "ModelColumnChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModelColumnChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	for idx, item := range m.GetSecurityRules() {
-		_, _ = idx, item
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("SecurityRules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("SecurityRules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("SecurityRules[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for FirstDayOfWeek
+	return fmt.Sprintf(
+		"invalid %sModelColumnChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for FirstMonthOfYear
+var _ error = ModelColumnChangeValidationError{}
 
-	// no validation rules for DefaultTimeRange
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelColumnChangeValidationError{}
 
-	// no validation rules for DefaultComparisonMode
+// Validate checks the field values on MetricsViewV2 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewV2) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for DefaultComparisonDimension
+// ValidateAll checks the field values on MetricsViewV2 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricsViewV2MultiError, or
+// nil if none found.
+func (m *MetricsViewV2) ValidateAll() error {
+	return m.validate(true)
+}
 
-	// no validation rules for DefaultTheme
+func (m *MetricsViewV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	for idx, item := range m.GetAvailableTimeRanges() {
-		_, _ = idx, item
+	var errors []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewV2ValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for CacheKeySql
-
-	// no validation rules for CacheKeyTtlSeconds
-
-	if m.CacheEnabled != nil {
-		// no validation rules for CacheEnabled
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewV2ValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewSpecMultiError(errors)
+		return MetricsViewV2MultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSpecMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewSpec.ValidateAll() if the designated constraints
+// MetricsViewV2MultiError is an error wrapping multiple validation errors
+// returned by MetricsViewV2.ValidateAll() if the designated constraints
 // aren't met.
-type MetricsViewSpecMultiError []error
+type MetricsViewV2MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpecMultiError) Error() string {
+func (m MetricsViewV2MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3263,11 +3600,11 @@ func (m MetricsViewSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
+func (m MetricsViewV2MultiError) AllErrors() []error { return m }
 
-// MetricsViewSpecValidationError is the validation error returned by
-// MetricsViewSpec.Validate if the designated constraints aren't met.
-type MetricsViewSpecValidationError struct {
+// MetricsViewV2ValidationError is the validation error returned by
+// MetricsViewV2.Validate if the designated constraints aren't met.
+type MetricsViewV2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3275,22 +3612,22 @@ type MetricsViewSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSpecValidationError) Field() string { return e.field }
+func (e MetricsViewV2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
+func (e MetricsViewV2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
+func (e MetricsViewV2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSpecValidationError) Key() bool { return e.key }
+func (e MetricsViewV2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
+func (e MetricsViewV2ValidationError) ErrorName() string { return "MetricsViewV2ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSpecValidationError) Error() string {
+func (e MetricsViewV2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3302,14 +3639,14 @@ func (e MetricsViewSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSpec.%s: %s%s",
+		"invalid %sMetricsViewV2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSpecValidationError{}
+var _ error = MetricsViewV2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -3317,275 +3654,220 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSpecValidationError{}
+} = MetricsViewV2ValidationError{}
 
-// Validate checks the field values on SecurityRule with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SecurityRule) Validate() error {
+// Validate checks the field values on MetricsViewSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecurityRule with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecurityRuleMultiError, or
-// nil if none found.
-func (m *SecurityRule) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpecMultiError, or nil if none found.
+func (m *MetricsViewSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecurityRule) validate(all bool) error {
+func (m *MetricsViewSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Rule.(type) {
-	case *SecurityRule_Access:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	// no validation rules for Table
+
+	// no validation rules for Model
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for TimeDimension
+
+	// no validation rules for SmallestTimeGrain
+
+	// no validation rules for WatermarkExpression
+
+	for idx, item := range m.GetDimensions() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetAccess()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "Access",
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "Access",
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetAccess()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SecurityRuleValidationError{
-					field:  "Access",
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Dimensions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *SecurityRule_FieldAccess:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetMeasures() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetFieldAccess()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "FieldAccess",
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "FieldAccess",
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetFieldAccess()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SecurityRuleValidationError{
-					field:  "FieldAccess",
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Measures[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *SecurityRule_RowFilter:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetSecurityRules() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetRowFilter()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "RowFilter",
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("SecurityRules[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "RowFilter",
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("SecurityRules[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRowFilter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SecurityRuleValidationError{
-					field:  "RowFilter",
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("SecurityRules[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return SecurityRuleMultiError(errors)
-	}
-
-	return nil
-}
-
-// SecurityRuleMultiError is an error wrapping multiple validation errors
-// returned by SecurityRule.ValidateAll() if the designated constraints aren't met.
-type SecurityRuleMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m SecurityRuleMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m SecurityRuleMultiError) AllErrors() []error { return m }
+	// no validation rules for FirstDayOfWeek
 
-// SecurityRuleValidationError is the validation error returned by
-// SecurityRule.Validate if the designated constraints aren't met.
-type SecurityRuleValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	// no validation rules for FirstMonthOfYear
 
-// Field function returns field value.
-func (e SecurityRuleValidationError) Field() string { return e.field }
+	// no validation rules for DefaultTimeRange
 
-// Reason function returns reason value.
-func (e SecurityRuleValidationError) Reason() string { return e.reason }
+	// no validation rules for DefaultComparisonMode
 
-// Cause function returns cause value.
-func (e SecurityRuleValidationError) Cause() error { return e.cause }
+	// no validation rules for DefaultComparisonDimension
 
-// Key function returns key value.
-func (e SecurityRuleValidationError) Key() bool { return e.key }
+	// no validation rules for DefaultTheme
 
-// ErrorName returns error name.
-func (e SecurityRuleValidationError) ErrorName() string { return "SecurityRuleValidationError" }
+	for idx, item := range m.GetAvailableTimeRanges() {
+		_, _ = idx, item
 
-// Error satisfies the builtin error interface
-func (e SecurityRuleValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	key := ""
-	if e.key {
-		key = "key for "
 	}
 
-	return fmt.Sprintf(
-		"invalid %sSecurityRule.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = SecurityRuleValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = SecurityRuleValidationError{}
-
-// Validate checks the field values on SecurityRuleAccess with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SecurityRuleAccess) Validate() error {
-	return m.validate(false)
-}
+	// no validation rules for CacheKeySql
 
-// ValidateAll checks the field values on SecurityRuleAccess with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SecurityRuleAccessMultiError, or nil if none found.
-func (m *SecurityRuleAccess) ValidateAll() error {
-	return m.validate(true)
-}
+	// no validation rules for CacheKeyTtlSeconds
 
-func (m *SecurityRuleAccess) validate(all bool) error {
-	if m == nil {
-		return nil
+	if m.CacheEnabled != nil {
+		// no validation rules for CacheEnabled
 	}
 
-	var errors []error
-
-	// no validation rules for Condition
-
-	// no validation rules for Allow
-
 	if len(errors) > 0 {
-		return SecurityRuleAccessMultiError(errors)
+		return MetricsViewSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecurityRuleAccessMultiError is an error wrapping multiple validation errors
-// returned by SecurityRuleAccess.ValidateAll() if the designated constraints
+// MetricsViewSpecMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewSpec.ValidateAll() if the designated constraints
 // aren't met.
-type SecurityRuleAccessMultiError []error
+type MetricsViewSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecurityRuleAccessMultiError) Error() string {
+func (m MetricsViewSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3594,11 +3876,11 @@ func (m SecurityRuleAccessMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecurityRuleAccessMultiError) AllErrors() []error { return m }
+func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
 
-// SecurityRuleAccessValidationError is the validation error returned by
-// SecurityRuleAccess.Validate if the designated constraints aren't met.
-type SecurityRuleAccessValidationError struct {
+// MetricsViewSpecValidationError is the validation error returned by
+// MetricsViewSpec.Validate if the designated constraints aren't met.
+type MetricsViewSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3606,24 +3888,22 @@ type SecurityRuleAccessValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecurityRuleAccessValidationError) Field() string { return e.field }
+func (e MetricsViewSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecurityRuleAccessValidationError) Reason() string { return e.reason }
+func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecurityRuleAccessValidationError) Cause() error { return e.cause }
+func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecurityRuleAccessValidationError) Key() bool { return e.key }
+func (e MetricsViewSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecurityRuleAccessValidationError) ErrorName() string {
-	return "SecurityRuleAccessValidationError"
-}
+func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SecurityRuleAccessValidationError) Error() string {
+func (e MetricsViewSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3635,14 +3915,14 @@ func (e SecurityRuleAccessValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecurityRuleAccess.%s: %s%s",
+		"invalid %sMetricsViewSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecurityRuleAccessValidationError{}
+var _ error = MetricsViewSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -3650,50 +3930,212 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecurityRuleAccessValidationError{}
+} = MetricsViewSpecValidationError{}
 
-// Validate checks the field values on SecurityRuleFieldAccess with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SecurityRuleFieldAccess) Validate() error {
+// Validate checks the field values on SecurityRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecurityRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecurityRuleFieldAccess with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SecurityRuleFieldAccessMultiError, or nil if none found.
-func (m *SecurityRuleFieldAccess) ValidateAll() error {
+// ValidateAll checks the field values on SecurityRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecurityRuleMultiError, or
+// nil if none found.
+func (m *SecurityRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecurityRuleFieldAccess) validate(all bool) error {
+func (m *SecurityRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Condition
-
-	// no validation rules for Allow
-
-	// no validation rules for AllFields
+	switch v := m.Rule.(type) {
+	case *SecurityRule_Access:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if len(errors) > 0 {
-		return SecurityRuleFieldAccessMultiError(errors)
-	}
+		if all {
+			switch v := interface{}(m.GetAccess()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccess()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "Access",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecurityRule_FieldAccess:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFieldAccess()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "FieldAccess",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "FieldAccess",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFieldAccess()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "FieldAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecurityRule_RowFilter:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRowFilter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "RowFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "RowFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRowFilter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "RowFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecurityRule_MinGroupSize:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMinGroupSize()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "MinGroupSize",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "MinGroupSize",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMinGroupSize()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "MinGroupSize",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SecurityRuleMultiError(errors)
+	}
 
 	return nil
 }
 
-// SecurityRuleFieldAccessMultiError is an error wrapping multiple validation
-// errors returned by SecurityRuleFieldAccess.ValidateAll() if the designated
-// constraints aren't met.
-type SecurityRuleFieldAccessMultiError []error
+// SecurityRuleMultiError is an error wrapping multiple validation errors
+// returned by SecurityRule.ValidateAll() if the designated constraints aren't met.
+type SecurityRuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecurityRuleFieldAccessMultiError) Error() string {
+func (m SecurityRuleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3702,11 +4144,11 @@ func (m SecurityRuleFieldAccessMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecurityRuleFieldAccessMultiError) AllErrors() []error { return m }
+func (m SecurityRuleMultiError) AllErrors() []error { return m }
 
-// SecurityRuleFieldAccessValidationError is the validation error returned by
-// SecurityRuleFieldAccess.Validate if the designated constraints aren't met.
-type SecurityRuleFieldAccessValidationError struct {
+// SecurityRuleValidationError is the validation error returned by
+// SecurityRule.Validate if the designated constraints aren't met.
+type SecurityRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3714,24 +4156,22 @@ type SecurityRuleFieldAccessValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecurityRuleFieldAccessValidationError) Field() string { return e.field }
+func (e SecurityRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecurityRuleFieldAccessValidationError) Reason() string { return e.reason }
+func (e SecurityRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecurityRuleFieldAccessValidationError) Cause() error { return e.cause }
+func (e SecurityRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecurityRuleFieldAccessValidationError) Key() bool { return e.key }
+func (e SecurityRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecurityRuleFieldAccessValidationError) ErrorName() string {
-	return "SecurityRuleFieldAccessValidationError"
-}
+func (e SecurityRuleValidationError) ErrorName() string { return "SecurityRuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SecurityRuleFieldAccessValidationError) Error() string {
+func (e SecurityRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3743,14 +4183,14 @@ func (e SecurityRuleFieldAccessValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecurityRuleFieldAccess.%s: %s%s",
+		"invalid %sSecurityRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecurityRuleFieldAccessValidationError{}
+var _ error = SecurityRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -3758,24 +4198,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecurityRuleFieldAccessValidationError{}
+} = SecurityRuleValidationError{}
 
-// Validate checks the field values on SecurityRuleRowFilter with the rules
+// Validate checks the field values on SecurityRuleAccess with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SecurityRuleRowFilter) Validate() error {
+func (m *SecurityRuleAccess) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecurityRuleRowFilter with the rules
+// ValidateAll checks the field values on SecurityRuleAccess with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SecurityRuleRowFilterMultiError, or nil if none found.
-func (m *SecurityRuleRowFilter) ValidateAll() error {
+// SecurityRuleAccessMultiError, or nil if none found.
+func (m *SecurityRuleAccess) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecurityRuleRowFilter) validate(all bool) error {
+func (m *SecurityRuleAccess) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3784,31 +4224,643 @@ func (m *SecurityRuleRowFilter) validate(all bool) error {
 
 	// no validation rules for Condition
 
-	// no validation rules for Sql
+	// no validation rules for Allow
+
+	if len(errors) > 0 {
+		return SecurityRuleAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityRuleAccessMultiError is an error wrapping multiple validation errors
+// returned by SecurityRuleAccess.ValidateAll() if the designated constraints
+// aren't met.
+type SecurityRuleAccessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityRuleAccessMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityRuleAccessMultiError) AllErrors() []error { return m }
+
+// SecurityRuleAccessValidationError is the validation error returned by
+// SecurityRuleAccess.Validate if the designated constraints aren't met.
+type SecurityRuleAccessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityRuleAccessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityRuleAccessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityRuleAccessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityRuleAccessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityRuleAccessValidationError) ErrorName() string {
+	return "SecurityRuleAccessValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecurityRuleAccessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityRuleAccess.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityRuleAccessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityRuleAccessValidationError{}
+
+// Validate checks the field values on SecurityRuleFieldAccess with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecurityRuleFieldAccess) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityRuleFieldAccess with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecurityRuleFieldAccessMultiError, or nil if none found.
+func (m *SecurityRuleFieldAccess) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityRuleFieldAccess) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	// no validation rules for Allow
+
+	// no validation rules for AllFields
+
+	if len(errors) > 0 {
+		return SecurityRuleFieldAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityRuleFieldAccessMultiError is an error wrapping multiple validation
+// errors returned by SecurityRuleFieldAccess.ValidateAll() if the designated
+// constraints aren't met.
+type SecurityRuleFieldAccessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityRuleFieldAccessMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityRuleFieldAccessMultiError) AllErrors() []error { return m }
+
+// SecurityRuleFieldAccessValidationError is the validation error returned by
+// SecurityRuleFieldAccess.Validate if the designated constraints aren't met.
+type SecurityRuleFieldAccessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityRuleFieldAccessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityRuleFieldAccessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityRuleFieldAccessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityRuleFieldAccessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityRuleFieldAccessValidationError) ErrorName() string {
+	return "SecurityRuleFieldAccessValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecurityRuleFieldAccessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityRuleFieldAccess.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityRuleFieldAccessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityRuleFieldAccessValidationError{}
+
+// Validate checks the field values on SecurityRuleRowFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecurityRuleRowFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityRuleRowFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecurityRuleRowFilterMultiError, or nil if none found.
+func (m *SecurityRuleRowFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityRuleRowFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	// no validation rules for Sql
+
+	if all {
+		switch v := interface{}(m.GetExpression()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecurityRuleRowFilterValidationError{
+					field:  "Expression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecurityRuleRowFilterValidationError{
+					field:  "Expression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpression()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecurityRuleRowFilterValidationError{
+				field:  "Expression",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SecurityRuleRowFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityRuleRowFilterMultiError is an error wrapping multiple validation
+// errors returned by SecurityRuleRowFilter.ValidateAll() if the designated
+// constraints aren't met.
+type SecurityRuleRowFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityRuleRowFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityRuleRowFilterMultiError) AllErrors() []error { return m }
+
+// SecurityRuleRowFilterValidationError is the validation error returned by
+// SecurityRuleRowFilter.Validate if the designated constraints aren't met.
+type SecurityRuleRowFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityRuleRowFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityRuleRowFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityRuleRowFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityRuleRowFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityRuleRowFilterValidationError) ErrorName() string {
+	return "SecurityRuleRowFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecurityRuleRowFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityRuleRowFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityRuleRowFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityRuleRowFilterValidationError{}
+
+// Validate checks the field values on SecurityRuleMinGroupSize with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecurityRuleMinGroupSize) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityRuleMinGroupSize with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecurityRuleMinGroupSizeMultiError, or nil if none found.
+func (m *SecurityRuleMinGroupSize) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityRuleMinGroupSize) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	// no validation rules for MinGroupSize
+
+	// no validation rules for Noise
+
+	if len(errors) > 0 {
+		return SecurityRuleMinGroupSizeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityRuleMinGroupSizeMultiError is an error wrapping multiple validation
+// errors returned by SecurityRuleMinGroupSize.ValidateAll() if the designated
+// constraints aren't met.
+type SecurityRuleMinGroupSizeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityRuleMinGroupSizeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityRuleMinGroupSizeMultiError) AllErrors() []error { return m }
+
+// SecurityRuleMinGroupSizeValidationError is the validation error returned by
+// SecurityRuleMinGroupSize.Validate if the designated constraints aren't met.
+type SecurityRuleMinGroupSizeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityRuleMinGroupSizeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityRuleMinGroupSizeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityRuleMinGroupSizeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityRuleMinGroupSizeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityRuleMinGroupSizeValidationError) ErrorName() string {
+	return "SecurityRuleMinGroupSizeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecurityRuleMinGroupSizeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityRuleMinGroupSize.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityRuleMinGroupSizeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityRuleMinGroupSizeValidationError{}
+
+// Validate checks the field values on MetricsViewState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewStateMultiError, or nil if none found.
+func (m *MetricsViewState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValidSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewStateValidationError{
+				field:  "ValidSpec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Streaming
+
+	if len(errors) > 0 {
+		return MetricsViewStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewStateMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewState.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewStateMultiError) AllErrors() []error { return m }
+
+// MetricsViewStateValidationError is the validation error returned by
+// MetricsViewState.Validate if the designated constraints aren't met.
+type MetricsViewStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewStateValidationError) ErrorName() string { return "MetricsViewStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewStateValidationError{}
+
+// Validate checks the field values on Explore with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Explore) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Explore with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ExploreMultiError, or nil if none found.
+func (m *Explore) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Explore) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExploreValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExploreValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExploreValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetExpression()).(type) {
+		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecurityRuleRowFilterValidationError{
-					field:  "Expression",
+				errors = append(errors, ExploreValidationError{
+					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SecurityRuleRowFilterValidationError{
-					field:  "Expression",
+				errors = append(errors, ExploreValidationError{
+					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExpression()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SecurityRuleRowFilterValidationError{
-				field:  "Expression",
+			return ExploreValidationError{
+				field:  "State",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3816,19 +4868,18 @@ func (m *SecurityRuleRowFilter) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SecurityRuleRowFilterMultiError(errors)
+		return ExploreMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecurityRuleRowFilterMultiError is an error wrapping multiple validation
-// errors returned by SecurityRuleRowFilter.ValidateAll() if the designated
-// constraints aren't met.
-type SecurityRuleRowFilterMultiError []error
+// ExploreMultiError is an error wrapping multiple validation errors returned
+// by Explore.ValidateAll() if the designated constraints aren't met.
+type ExploreMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecurityRuleRowFilterMultiError) Error() string {
+func (m ExploreMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3837,11 +4888,11 @@ func (m SecurityRuleRowFilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecurityRuleRowFilterMultiError) AllErrors() []error { return m }
+func (m ExploreMultiError) AllErrors() []error { return m }
 
-// SecurityRuleRowFilterValidationError is the validation error returned by
-// SecurityRuleRowFilter.Validate if the designated constraints aren't met.
-type SecurityRuleRowFilterValidationError struct {
+// ExploreValidationError is the validation error returned by Explore.Validate
+// if the designated constraints aren't met.
+type ExploreValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3849,24 +4900,22 @@ type SecurityRuleRowFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecurityRuleRowFilterValidationError) Field() string { return e.field }
+func (e ExploreValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecurityRuleRowFilterValidationError) Reason() string { return e.reason }
+func (e ExploreValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecurityRuleRowFilterValidationError) Cause() error { return e.cause }
+func (e ExploreValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecurityRuleRowFilterValidationError) Key() bool { return e.key }
+func (e ExploreValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecurityRuleRowFilterValidationError) ErrorName() string {
-	return "SecurityRuleRowFilterValidationError"
-}
+func (e ExploreValidationError) ErrorName() string { return "ExploreValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SecurityRuleRowFilterValidationError) Error() string {
+func (e ExploreValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3878,14 +4927,14 @@ func (e SecurityRuleRowFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecurityRuleRowFilter.%s: %s%s",
+		"invalid %sExplore.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecurityRuleRowFilterValidationError{}
+var _ error = ExploreValidationError{}
 
 var _ interface {
 	Field() string
@@ -3893,75 +4942,239 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecurityRuleRowFilterValidationError{}
+} = ExploreValidationError{}
 
-// Validate checks the field values on MetricsViewState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewState) Validate() error {
+// Validate checks the field values on ExploreSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExploreSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewState with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewStateMultiError, or nil if none found.
-func (m *MetricsViewState) ValidateAll() error {
+// ValidateAll checks the field values on ExploreSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExploreSpecMultiError, or
+// nil if none found.
+func (m *ExploreSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewState) validate(all bool) error {
+func (m *ExploreSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for MetricsView
+
 	if all {
-		switch v := interface{}(m.GetValidSpec()).(type) {
+		switch v := interface{}(m.GetDimensionsSelector()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "DimensionsSelector",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "DimensionsSelector",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDimensionsSelector()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewStateValidationError{
-				field:  "ValidSpec",
+			return ExploreSpecValidationError{
+				field:  "DimensionsSelector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeasuresSelector()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "MeasuresSelector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "MeasuresSelector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeasuresSelector()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExploreSpecValidationError{
+				field:  "MeasuresSelector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Theme
+
+	if all {
+		switch v := interface{}(m.GetEmbeddedTheme()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "EmbeddedTheme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "EmbeddedTheme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmbeddedTheme()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExploreSpecValidationError{
+				field:  "EmbeddedTheme",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTimeRanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExploreSpecValidationError{
+						field:  fmt.Sprintf("TimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExploreSpecValidationError{
+						field:  fmt.Sprintf("TimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExploreSpecValidationError{
+					field:  fmt.Sprintf("TimeRanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDefaultPreset()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "DefaultPreset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExploreSpecValidationError{
+					field:  "DefaultPreset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaultPreset()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExploreSpecValidationError{
+				field:  "DefaultPreset",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Streaming
+	// no validation rules for EmbedsHidePivot
+
+	for idx, item := range m.GetSecurityRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExploreSpecValidationError{
+						field:  fmt.Sprintf("SecurityRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExploreSpecValidationError{
+						field:  fmt.Sprintf("SecurityRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExploreSpecValidationError{
+					field:  fmt.Sprintf("SecurityRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Banner
 
 	if len(errors) > 0 {
-		return MetricsViewStateMultiError(errors)
+		return ExploreSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewStateMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewState.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewStateMultiError []error
+// ExploreSpecMultiError is an error wrapping multiple validation errors
+// returned by ExploreSpec.ValidateAll() if the designated constraints aren't met.
+type ExploreSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewStateMultiError) Error() string {
+func (m ExploreSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3970,11 +5183,11 @@ func (m MetricsViewStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewStateMultiError) AllErrors() []error { return m }
+func (m ExploreSpecMultiError) AllErrors() []error { return m }
 
-// MetricsViewStateValidationError is the validation error returned by
-// MetricsViewState.Validate if the designated constraints aren't met.
-type MetricsViewStateValidationError struct {
+// ExploreSpecValidationError is the validation error returned by
+// ExploreSpec.Validate if the designated constraints aren't met.
+type ExploreSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3982,22 +5195,22 @@ type MetricsViewStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewStateValidationError) Field() string { return e.field }
+func (e ExploreSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewStateValidationError) Reason() string { return e.reason }
+func (e ExploreSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewStateValidationError) Cause() error { return e.cause }
+func (e ExploreSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewStateValidationError) Key() bool { return e.key }
+func (e ExploreSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewStateValidationError) ErrorName() string { return "MetricsViewStateValidationError" }
+func (e ExploreSpecValidationError) ErrorName() string { return "ExploreSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewStateValidationError) Error() string {
+func (e ExploreSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4009,14 +5222,14 @@ func (e MetricsViewStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewState.%s: %s%s",
+		"invalid %sExploreSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewStateValidationError{}
+var _ error = ExploreSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -4024,23 +5237,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewStateValidationError{}
+} = ExploreSpecValidationError{}
 
-// Validate checks the field values on Explore with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ExploreState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Explore) Validate() error {
+func (m *ExploreState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Explore with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ExploreMultiError, or nil if none found.
-func (m *Explore) ValidateAll() error {
+// ValidateAll checks the field values on ExploreState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExploreStateMultiError, or
+// nil if none found.
+func (m *ExploreState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Explore) validate(all bool) error {
+func (m *ExploreState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4048,57 +5262,28 @@ func (m *Explore) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExploreValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExploreValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExploreValidationError{
-				field:  "Spec",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetValidSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExploreValidationError{
-					field:  "State",
+				errors = append(errors, ExploreStateValidationError{
+					field:  "ValidSpec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExploreValidationError{
-					field:  "State",
+				errors = append(errors, ExploreStateValidationError{
+					field:  "ValidSpec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExploreValidationError{
-				field:  "State",
+			return ExploreStateValidationError{
+				field:  "ValidSpec",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4106,18 +5291,18 @@ func (m *Explore) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ExploreMultiError(errors)
+		return ExploreStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExploreMultiError is an error wrapping multiple validation errors returned
-// by Explore.ValidateAll() if the designated constraints aren't met.
-type ExploreMultiError []error
+// ExploreStateMultiError is an error wrapping multiple validation errors
+// returned by ExploreState.ValidateAll() if the designated constraints aren't met.
+type ExploreStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExploreMultiError) Error() string {
+func (m ExploreStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4126,11 +5311,11 @@ func (m ExploreMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExploreMultiError) AllErrors() []error { return m }
+func (m ExploreStateMultiError) AllErrors() []error { return m }
 
-// ExploreValidationError is the validation error returned by Explore.Validate
-// if the designated constraints aren't met.
-type ExploreValidationError struct {
+// ExploreStateValidationError is the validation error returned by
+// ExploreState.Validate if the designated constraints aren't met.
+type ExploreStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4138,22 +5323,22 @@ type ExploreValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExploreValidationError) Field() string { return e.field }
+func (e ExploreStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExploreValidationError) Reason() string { return e.reason }
+func (e ExploreStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExploreValidationError) Cause() error { return e.cause }
+func (e ExploreStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExploreValidationError) Key() bool { return e.key }
+func (e ExploreStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExploreValidationError) ErrorName() string { return "ExploreValidationError" }
+func (e ExploreStateValidationError) ErrorName() string { return "ExploreStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExploreValidationError) Error() string {
+func (e ExploreStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4165,14 +5350,14 @@ func (e ExploreValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExplore.%s: %s%s",
+		"invalid %sExploreState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExploreValidationError{}
+var _ error = ExploreStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -4180,142 +5365,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExploreValidationError{}
-
-// Validate checks the field values on ExploreSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ExploreSpec) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ExploreSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExploreSpecMultiError, or
-// nil if none found.
-func (m *ExploreSpec) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ExploreSpec) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for MetricsView
-
-	if all {
-		switch v := interface{}(m.GetDimensionsSelector()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "DimensionsSelector",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "DimensionsSelector",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDimensionsSelector()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExploreSpecValidationError{
-				field:  "DimensionsSelector",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetMeasuresSelector()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "MeasuresSelector",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "MeasuresSelector",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMeasuresSelector()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExploreSpecValidationError{
-				field:  "MeasuresSelector",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Theme
+} = ExploreStateValidationError{}
 
-	if all {
-		switch v := interface{}(m.GetEmbeddedTheme()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "EmbeddedTheme",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "EmbeddedTheme",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEmbeddedTheme()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExploreSpecValidationError{
-				field:  "EmbeddedTheme",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Validate checks the field values on ExploreTimeRange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExploreTimeRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExploreTimeRange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExploreTimeRangeMultiError, or nil if none found.
+func (m *ExploreTimeRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExploreTimeRange) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetTimeRanges() {
+	var errors []error
+
+	// no validation rules for Range
+
+	for idx, item := range m.GetComparisonTimeRanges() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExploreSpecValidationError{
-						field:  fmt.Sprintf("TimeRanges[%v]", idx),
+					errors = append(errors, ExploreTimeRangeValidationError{
+						field:  fmt.Sprintf("ComparisonTimeRanges[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ExploreSpecValidationError{
-						field:  fmt.Sprintf("TimeRanges[%v]", idx),
+					errors = append(errors, ExploreTimeRangeValidationError{
+						field:  fmt.Sprintf("ComparisonTimeRanges[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4323,8 +5415,8 @@ func (m *ExploreSpec) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ExploreSpecValidationError{
-					field:  fmt.Sprintf("TimeRanges[%v]", idx),
+				return ExploreTimeRangeValidationError{
+					field:  fmt.Sprintf("ComparisonTimeRanges[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4333,86 +5425,124 @@ func (m *ExploreSpec) validate(all bool) error {
 
 	}
 
-	if all {
-		switch v := interface{}(m.GetDefaultPreset()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "DefaultPreset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExploreSpecValidationError{
-					field:  "DefaultPreset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDefaultPreset()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExploreSpecValidationError{
-				field:  "DefaultPreset",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return ExploreTimeRangeMultiError(errors)
 	}
 
-	// no validation rules for EmbedsHidePivot
+	return nil
+}
 
-	for idx, item := range m.GetSecurityRules() {
-		_, _ = idx, item
+// ExploreTimeRangeMultiError is an error wrapping multiple validation errors
+// returned by ExploreTimeRange.ValidateAll() if the designated constraints
+// aren't met.
+type ExploreTimeRangeMultiError []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExploreSpecValidationError{
-						field:  fmt.Sprintf("SecurityRules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ExploreSpecValidationError{
-						field:  fmt.Sprintf("SecurityRules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ExploreSpecValidationError{
-					field:  fmt.Sprintf("SecurityRules[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExploreTimeRangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExploreTimeRangeMultiError) AllErrors() []error { return m }
+
+// ExploreTimeRangeValidationError is the validation error returned by
+// ExploreTimeRange.Validate if the designated constraints aren't met.
+type ExploreTimeRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExploreTimeRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExploreTimeRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExploreTimeRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExploreTimeRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExploreTimeRangeValidationError) ErrorName() string { return "ExploreTimeRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExploreTimeRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	// no validation rules for Banner
+	return fmt.Sprintf(
+		"invalid %sExploreTimeRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExploreTimeRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExploreTimeRangeValidationError{}
+
+// Validate checks the field values on ExploreComparisonTimeRange with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExploreComparisonTimeRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExploreComparisonTimeRange with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExploreComparisonTimeRangeMultiError, or nil if none found.
+func (m *ExploreComparisonTimeRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExploreComparisonTimeRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Range
 
 	if len(errors) > 0 {
-		return ExploreSpecMultiError(errors)
+		return ExploreComparisonTimeRangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExploreSpecMultiError is an error wrapping multiple validation errors
-// returned by ExploreSpec.ValidateAll() if the designated constraints aren't met.
-type ExploreSpecMultiError []error
+// ExploreComparisonTimeRangeMultiError is an error wrapping multiple
+// validation errors returned by ExploreComparisonTimeRange.ValidateAll() if
+// the designated constraints aren't met.
+type ExploreComparisonTimeRangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExploreSpecMultiError) Error() string {
+func (m ExploreComparisonTimeRangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4421,11 +5551,11 @@ func (m ExploreSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExploreSpecMultiError) AllErrors() []error { return m }
+func (m ExploreComparisonTimeRangeMultiError) AllErrors() []error { return m }
 
-// ExploreSpecValidationError is the validation error returned by
-// ExploreSpec.Validate if the designated constraints aren't met.
-type ExploreSpecValidationError struct {
+// ExploreComparisonTimeRangeValidationError is the validation error returned
+// by ExploreComparisonTimeRange.Validate if the designated constraints aren't met.
+type ExploreComparisonTimeRangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4433,22 +5563,24 @@ type ExploreSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExploreSpecValidationError) Field() string { return e.field }
+func (e ExploreComparisonTimeRangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExploreSpecValidationError) Reason() string { return e.reason }
+func (e ExploreComparisonTimeRangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExploreSpecValidationError) Cause() error { return e.cause }
+func (e ExploreComparisonTimeRangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExploreSpecValidationError) Key() bool { return e.key }
+func (e ExploreComparisonTimeRangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExploreSpecValidationError) ErrorName() string { return "ExploreSpecValidationError" }
+func (e ExploreComparisonTimeRangeValidationError) ErrorName() string {
+	return "ExploreComparisonTimeRangeValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExploreSpecValidationError) Error() string {
+func (e ExploreComparisonTimeRangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4460,14 +5592,14 @@ func (e ExploreSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExploreSpec.%s: %s%s",
+		"invalid %sExploreComparisonTimeRange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExploreSpecValidationError{}
+var _ error = ExploreComparisonTimeRangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -4475,72 +5607,201 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExploreSpecValidationError{}
+} = ExploreComparisonTimeRangeValidationError{}
 
-// Validate checks the field values on ExploreState with the rules defined in
+// Validate checks the field values on ExplorePreset with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ExploreState) Validate() error {
+func (m *ExplorePreset) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExploreState with the rules defined
+// ValidateAll checks the field values on ExplorePreset with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExploreStateMultiError, or
+// result is a list of violation errors wrapped in ExplorePresetMultiError, or
 // nil if none found.
-func (m *ExploreState) ValidateAll() error {
+func (m *ExplorePreset) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExploreState) validate(all bool) error {
+func (m *ExplorePreset) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDimensionsSelector()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplorePresetValidationError{
+					field:  "DimensionsSelector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplorePresetValidationError{
+					field:  "DimensionsSelector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensionsSelector()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplorePresetValidationError{
+				field:  "DimensionsSelector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if all {
-		switch v := interface{}(m.GetValidSpec()).(type) {
+		switch v := interface{}(m.GetMeasuresSelector()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExploreStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, ExplorePresetValidationError{
+					field:  "MeasuresSelector",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExploreStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, ExplorePresetValidationError{
+					field:  "MeasuresSelector",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMeasuresSelector()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExploreStateValidationError{
-				field:  "ValidSpec",
+			return ExplorePresetValidationError{
+				field:  "MeasuresSelector",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for ComparisonMode
+
+	if m.Where != nil {
+
+		if all {
+			switch v := interface{}(m.GetWhere()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplorePresetValidationError{
+						field:  "Where",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplorePresetValidationError{
+						field:  "Where",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplorePresetValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TimeRange != nil {
+		// no validation rules for TimeRange
+	}
+
+	if m.Timezone != nil {
+		// no validation rules for Timezone
+	}
+
+	if m.TimeGrain != nil {
+		// no validation rules for TimeGrain
+	}
+
+	if m.SelectTimeRange != nil {
+		// no validation rules for SelectTimeRange
+	}
+
+	if m.CompareTimeRange != nil {
+		// no validation rules for CompareTimeRange
+	}
+
+	if m.ComparisonDimension != nil {
+		// no validation rules for ComparisonDimension
+	}
+
+	if m.View != nil {
+		// no validation rules for View
+	}
+
+	if m.ExploreSortBy != nil {
+		// no validation rules for ExploreSortBy
+	}
+
+	if m.ExploreSortAsc != nil {
+		// no validation rules for ExploreSortAsc
+	}
+
+	if m.ExploreSortType != nil {
+		// no validation rules for ExploreSortType
+	}
+
+	if m.ExploreExpandedDimension != nil {
+		// no validation rules for ExploreExpandedDimension
+	}
+
+	if m.TimeDimensionMeasure != nil {
+		// no validation rules for TimeDimensionMeasure
+	}
+
+	if m.TimeDimensionChartType != nil {
+		// no validation rules for TimeDimensionChartType
+	}
+
+	if m.TimeDimensionPin != nil {
+		// no validation rules for TimeDimensionPin
+	}
+
+	if m.PivotSortBy != nil {
+		// no validation rules for PivotSortBy
+	}
+
+	if m.PivotSortAsc != nil {
+		// no validation rules for PivotSortAsc
+	}
+
 	if len(errors) > 0 {
-		return ExploreStateMultiError(errors)
+		return ExplorePresetMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExploreStateMultiError is an error wrapping multiple validation errors
-// returned by ExploreState.ValidateAll() if the designated constraints aren't met.
-type ExploreStateMultiError []error
+// ExplorePresetMultiError is an error wrapping multiple validation errors
+// returned by ExplorePreset.ValidateAll() if the designated constraints
+// aren't met.
+type ExplorePresetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExploreStateMultiError) Error() string {
+func (m ExplorePresetMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4549,11 +5810,11 @@ func (m ExploreStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExploreStateMultiError) AllErrors() []error { return m }
+func (m ExplorePresetMultiError) AllErrors() []error { return m }
 
-// ExploreStateValidationError is the validation error returned by
-// ExploreState.Validate if the designated constraints aren't met.
-type ExploreStateValidationError struct {
+// ExplorePresetValidationError is the validation error returned by
+// ExplorePreset.Validate if the designated constraints aren't met.
+type ExplorePresetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4561,22 +5822,22 @@ type ExploreStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExploreStateValidationError) Field() string { return e.field }
+func (e ExplorePresetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExploreStateValidationError) Reason() string { return e.reason }
+func (e ExplorePresetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExploreStateValidationError) Cause() error { return e.cause }
+func (e ExplorePresetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExploreStateValidationError) Key() bool { return e.key }
+func (e ExplorePresetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExploreStateValidationError) ErrorName() string { return "ExploreStateValidationError" }
+func (e ExplorePresetValidationError) ErrorName() string { return "ExplorePresetValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExploreStateValidationError) Error() string {
+func (e ExplorePresetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4588,14 +5849,14 @@ func (e ExploreStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExploreState.%s: %s%s",
+		"invalid %sExplorePreset.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExploreStateValidationError{}
+var _ error = ExplorePresetValidationError{}
 
 var _ interface {
 	Field() string
@@ -4603,80 +5864,128 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExploreStateValidationError{}
+} = ExplorePresetValidationError{}
 
-// Validate checks the field values on ExploreTimeRange with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ExploreTimeRange) Validate() error {
+// Validate checks the field values on FieldSelector with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldSelector) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExploreTimeRange with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ExploreTimeRangeMultiError, or nil if none found.
-func (m *ExploreTimeRange) ValidateAll() error {
+// ValidateAll checks the field values on FieldSelector with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldSelectorMultiError, or
+// nil if none found.
+func (m *FieldSelector) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExploreTimeRange) validate(all bool) error {
+func (m *FieldSelector) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Range
+	// no validation rules for Invert
 
-	for idx, item := range m.GetComparisonTimeRanges() {
-		_, _ = idx, item
+	switch v := m.Selector.(type) {
+	case *FieldSelector_All:
+		if v == nil {
+			err := FieldSelectorValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for All
+	case *FieldSelector_Fields:
+		if v == nil {
+			err := FieldSelectorValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetFields()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExploreTimeRangeValidationError{
-						field:  fmt.Sprintf("ComparisonTimeRanges[%v]", idx),
+					errors = append(errors, FieldSelectorValidationError{
+						field:  "Fields",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ExploreTimeRangeValidationError{
-						field:  fmt.Sprintf("ComparisonTimeRanges[%v]", idx),
+					errors = append(errors, FieldSelectorValidationError{
+						field:  "Fields",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ExploreTimeRangeValidationError{
-					field:  fmt.Sprintf("ComparisonTimeRanges[%v]", idx),
+				return FieldSelectorValidationError{
+					field:  "Fields",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
+	case *FieldSelector_Regex:
+		if v == nil {
+			err := FieldSelectorValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Regex
+	case *FieldSelector_DuckdbExpression:
+		if v == nil {
+			err := FieldSelectorValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for DuckdbExpression
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return ExploreTimeRangeMultiError(errors)
+		return FieldSelectorMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExploreTimeRangeMultiError is an error wrapping multiple validation errors
-// returned by ExploreTimeRange.ValidateAll() if the designated constraints
+// FieldSelectorMultiError is an error wrapping multiple validation errors
+// returned by FieldSelector.ValidateAll() if the designated constraints
 // aren't met.
-type ExploreTimeRangeMultiError []error
+type FieldSelectorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExploreTimeRangeMultiError) Error() string {
+func (m FieldSelectorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4685,11 +5994,11 @@ func (m ExploreTimeRangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExploreTimeRangeMultiError) AllErrors() []error { return m }
+func (m FieldSelectorMultiError) AllErrors() []error { return m }
 
-// ExploreTimeRangeValidationError is the validation error returned by
-// ExploreTimeRange.Validate if the designated constraints aren't met.
-type ExploreTimeRangeValidationError struct {
+// FieldSelectorValidationError is the validation error returned by
+// FieldSelector.Validate if the designated constraints aren't met.
+type FieldSelectorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4697,22 +6006,22 @@ type ExploreTimeRangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExploreTimeRangeValidationError) Field() string { return e.field }
+func (e FieldSelectorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExploreTimeRangeValidationError) Reason() string { return e.reason }
+func (e FieldSelectorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExploreTimeRangeValidationError) Cause() error { return e.cause }
+func (e FieldSelectorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExploreTimeRangeValidationError) Key() bool { return e.key }
+func (e FieldSelectorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExploreTimeRangeValidationError) ErrorName() string { return "ExploreTimeRangeValidationError" }
+func (e FieldSelectorValidationError) ErrorName() string { return "FieldSelectorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExploreTimeRangeValidationError) Error() string {
+func (e FieldSelectorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4724,14 +6033,14 @@ func (e ExploreTimeRangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExploreTimeRange.%s: %s%s",
+		"invalid %sFieldSelector.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExploreTimeRangeValidationError{}
+var _ error = FieldSelectorValidationError{}
 
 var _ interface {
 	Field() string
@@ -4739,48 +6048,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExploreTimeRangeValidationError{}
+} = FieldSelectorValidationError{}
 
-// Validate checks the field values on ExploreComparisonTimeRange with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExploreComparisonTimeRange) Validate() error {
+// Validate checks the field values on StringListValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StringListValue) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExploreComparisonTimeRange with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StringListValue with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExploreComparisonTimeRangeMultiError, or nil if none found.
-func (m *ExploreComparisonTimeRange) ValidateAll() error {
+// StringListValueMultiError, or nil if none found.
+func (m *StringListValue) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExploreComparisonTimeRange) validate(all bool) error {
+func (m *StringListValue) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
-
-	// no validation rules for Range
-
 	if len(errors) > 0 {
-		return ExploreComparisonTimeRangeMultiError(errors)
+		return StringListValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExploreComparisonTimeRangeMultiError is an error wrapping multiple
-// validation errors returned by ExploreComparisonTimeRange.ValidateAll() if
-// the designated constraints aren't met.
-type ExploreComparisonTimeRangeMultiError []error
+// StringListValueMultiError is an error wrapping multiple validation errors
+// returned by StringListValue.ValidateAll() if the designated constraints
+// aren't met.
+type StringListValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExploreComparisonTimeRangeMultiError) Error() string {
+func (m StringListValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4789,11 +6094,11 @@ func (m ExploreComparisonTimeRangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExploreComparisonTimeRangeMultiError) AllErrors() []error { return m }
+func (m StringListValueMultiError) AllErrors() []error { return m }
 
-// ExploreComparisonTimeRangeValidationError is the validation error returned
-// by ExploreComparisonTimeRange.Validate if the designated constraints aren't met.
-type ExploreComparisonTimeRangeValidationError struct {
+// StringListValueValidationError is the validation error returned by
+// StringListValue.Validate if the designated constraints aren't met.
+type StringListValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4801,24 +6106,22 @@ type ExploreComparisonTimeRangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExploreComparisonTimeRangeValidationError) Field() string { return e.field }
+func (e StringListValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExploreComparisonTimeRangeValidationError) Reason() string { return e.reason }
+func (e StringListValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExploreComparisonTimeRangeValidationError) Cause() error { return e.cause }
+func (e StringListValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExploreComparisonTimeRangeValidationError) Key() bool { return e.key }
+func (e StringListValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExploreComparisonTimeRangeValidationError) ErrorName() string {
-	return "ExploreComparisonTimeRangeValidationError"
-}
+func (e StringListValueValidationError) ErrorName() string { return "StringListValueValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExploreComparisonTimeRangeValidationError) Error() string {
+func (e StringListValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4830,14 +6133,14 @@ func (e ExploreComparisonTimeRangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExploreComparisonTimeRange.%s: %s%s",
+		"invalid %sStringListValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExploreComparisonTimeRangeValidationError{}
+var _ error = StringListValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -4845,24 +6148,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExploreComparisonTimeRangeValidationError{}
+} = StringListValueValidationError{}
 
-// Validate checks the field values on ExplorePreset with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Migration with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ExplorePreset) Validate() error {
+func (m *Migration) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExplorePreset with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExplorePresetMultiError, or
-// nil if none found.
-func (m *ExplorePreset) ValidateAll() error {
+// ValidateAll checks the field values on Migration with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationMultiError, or nil
+// if none found.
+func (m *Migration) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExplorePreset) validate(all bool) error {
+func (m *Migration) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4870,28 +6173,28 @@ func (m *ExplorePreset) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDimensionsSelector()).(type) {
+		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExplorePresetValidationError{
-					field:  "DimensionsSelector",
+				errors = append(errors, MigrationValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExplorePresetValidationError{
-					field:  "DimensionsSelector",
+				errors = append(errors, MigrationValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDimensionsSelector()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExplorePresetValidationError{
-				field:  "DimensionsSelector",
+			return MigrationValidationError{
+				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4899,147 +6202,47 @@ func (m *ExplorePreset) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetMeasuresSelector()).(type) {
+		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExplorePresetValidationError{
-					field:  "MeasuresSelector",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExplorePresetValidationError{
-					field:  "MeasuresSelector",
+				errors = append(errors, MigrationValidationError{
+					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMeasuresSelector()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExplorePresetValidationError{
-				field:  "MeasuresSelector",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for ComparisonMode
-
-	if m.Where != nil {
-
-		if all {
-			switch v := interface{}(m.GetWhere()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExplorePresetValidationError{
-						field:  "Where",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ExplorePresetValidationError{
-						field:  "Where",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ExplorePresetValidationError{
-					field:  "Where",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.TimeRange != nil {
-		// no validation rules for TimeRange
-	}
-
-	if m.Timezone != nil {
-		// no validation rules for Timezone
-	}
-
-	if m.TimeGrain != nil {
-		// no validation rules for TimeGrain
-	}
-
-	if m.SelectTimeRange != nil {
-		// no validation rules for SelectTimeRange
-	}
-
-	if m.CompareTimeRange != nil {
-		// no validation rules for CompareTimeRange
-	}
-
-	if m.ComparisonDimension != nil {
-		// no validation rules for ComparisonDimension
-	}
-
-	if m.View != nil {
-		// no validation rules for View
-	}
-
-	if m.ExploreSortBy != nil {
-		// no validation rules for ExploreSortBy
-	}
-
-	if m.ExploreSortAsc != nil {
-		// no validation rules for ExploreSortAsc
-	}
-
-	if m.ExploreSortType != nil {
-		// no validation rules for ExploreSortType
-	}
-
-	if m.ExploreExpandedDimension != nil {
-		// no validation rules for ExploreExpandedDimension
-	}
-
-	if m.TimeDimensionMeasure != nil {
-		// no validation rules for TimeDimensionMeasure
-	}
-
-	if m.TimeDimensionChartType != nil {
-		// no validation rules for TimeDimensionChartType
-	}
-
-	if m.TimeDimensionPin != nil {
-		// no validation rules for TimeDimensionPin
-	}
-
-	if m.PivotSortBy != nil {
-		// no validation rules for PivotSortBy
-	}
-
-	if m.PivotSortAsc != nil {
-		// no validation rules for PivotSortAsc
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrationValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return ExplorePresetMultiError(errors)
+		return MigrationMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExplorePresetMultiError is an error wrapping multiple validation errors
-// returned by ExplorePreset.ValidateAll() if the designated constraints
-// aren't met.
-type ExplorePresetMultiError []error
+// MigrationMultiError is an error wrapping multiple validation errors returned
+// by Migration.ValidateAll() if the designated constraints aren't met.
+type MigrationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExplorePresetMultiError) Error() string {
+func (m MigrationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5048,11 +6251,11 @@ func (m ExplorePresetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExplorePresetMultiError) AllErrors() []error { return m }
+func (m MigrationMultiError) AllErrors() []error { return m }
 
-// ExplorePresetValidationError is the validation error returned by
-// ExplorePreset.Validate if the designated constraints aren't met.
-type ExplorePresetValidationError struct {
+// MigrationValidationError is the validation error returned by
+// Migration.Validate if the designated constraints aren't met.
+type MigrationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5060,22 +6263,22 @@ type ExplorePresetValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExplorePresetValidationError) Field() string { return e.field }
+func (e MigrationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExplorePresetValidationError) Reason() string { return e.reason }
+func (e MigrationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExplorePresetValidationError) Cause() error { return e.cause }
+func (e MigrationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExplorePresetValidationError) Key() bool { return e.key }
+func (e MigrationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExplorePresetValidationError) ErrorName() string { return "ExplorePresetValidationError" }
+func (e MigrationValidationError) ErrorName() string { return "MigrationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExplorePresetValidationError) Error() string {
+func (e MigrationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5087,14 +6290,14 @@ func (e ExplorePresetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExplorePreset.%s: %s%s",
+		"invalid %sMigration.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExplorePresetValidationError{}
+var _ error = MigrationValidationError{}
 
 var _ interface {
 	Field() string
@@ -5102,128 +6305,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExplorePresetValidationError{}
+} = MigrationValidationError{}
 
-// Validate checks the field values on FieldSelector with the rules defined in
+// Validate checks the field values on MigrationSpec with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *FieldSelector) Validate() error {
+func (m *MigrationSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FieldSelector with the rules defined
+// ValidateAll checks the field values on MigrationSpec with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FieldSelectorMultiError, or
+// result is a list of violation errors wrapped in MigrationSpecMultiError, or
 // nil if none found.
-func (m *FieldSelector) ValidateAll() error {
+func (m *MigrationSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FieldSelector) validate(all bool) error {
+func (m *MigrationSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Invert
-
-	switch v := m.Selector.(type) {
-	case *FieldSelector_All:
-		if v == nil {
-			err := FieldSelectorValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for All
-	case *FieldSelector_Fields:
-		if v == nil {
-			err := FieldSelectorValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Connector
 
-		if all {
-			switch v := interface{}(m.GetFields()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FieldSelectorValidationError{
-						field:  "Fields",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, FieldSelectorValidationError{
-						field:  "Fields",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return FieldSelectorValidationError{
-					field:  "Fields",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Sql
 
-	case *FieldSelector_Regex:
-		if v == nil {
-			err := FieldSelectorValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Regex
-	case *FieldSelector_DuckdbExpression:
-		if v == nil {
-			err := FieldSelectorValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for DuckdbExpression
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return FieldSelectorMultiError(errors)
+		return MigrationSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// FieldSelectorMultiError is an error wrapping multiple validation errors
-// returned by FieldSelector.ValidateAll() if the designated constraints
+// MigrationSpecMultiError is an error wrapping multiple validation errors
+// returned by MigrationSpec.ValidateAll() if the designated constraints
 // aren't met.
-type FieldSelectorMultiError []error
+type MigrationSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FieldSelectorMultiError) Error() string {
+func (m MigrationSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5232,11 +6357,11 @@ func (m FieldSelectorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FieldSelectorMultiError) AllErrors() []error { return m }
+func (m MigrationSpecMultiError) AllErrors() []error { return m }
 
-// FieldSelectorValidationError is the validation error returned by
-// FieldSelector.Validate if the designated constraints aren't met.
-type FieldSelectorValidationError struct {
+// MigrationSpecValidationError is the validation error returned by
+// MigrationSpec.Validate if the designated constraints aren't met.
+type MigrationSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5244,22 +6369,22 @@ type FieldSelectorValidationError struct {
 }
 
 // Field function returns field value.
-func (e FieldSelectorValidationError) Field() string { return e.field }
+func (e MigrationSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FieldSelectorValidationError) Reason() string { return e.reason }
+func (e MigrationSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FieldSelectorValidationError) Cause() error { return e.cause }
+func (e MigrationSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FieldSelectorValidationError) Key() bool { return e.key }
+func (e MigrationSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FieldSelectorValidationError) ErrorName() string { return "FieldSelectorValidationError" }
+func (e MigrationSpecValidationError) ErrorName() string { return "MigrationSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FieldSelectorValidationError) Error() string {
+func (e MigrationSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5271,14 +6396,14 @@ func (e FieldSelectorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFieldSelector.%s: %s%s",
+		"invalid %sMigrationSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FieldSelectorValidationError{}
+var _ error = MigrationSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -5286,44 +6411,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FieldSelectorValidationError{}
+} = MigrationSpecValidationError{}
 
-// Validate checks the field values on StringListValue with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *StringListValue) Validate() error {
+// Validate checks the field values on MigrationState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MigrationState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StringListValue with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// StringListValueMultiError, or nil if none found.
-func (m *StringListValue) ValidateAll() error {
+// ValidateAll checks the field values on MigrationState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationStateMultiError,
+// or nil if none found.
+func (m *MigrationState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StringListValue) validate(all bool) error {
+func (m *MigrationState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Version
+
 	if len(errors) > 0 {
-		return StringListValueMultiError(errors)
+		return MigrationStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// StringListValueMultiError is an error wrapping multiple validation errors
-// returned by StringListValue.ValidateAll() if the designated constraints
+// MigrationStateMultiError is an error wrapping multiple validation errors
+// returned by MigrationState.ValidateAll() if the designated constraints
 // aren't met.
-type StringListValueMultiError []error
+type MigrationStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StringListValueMultiError) Error() string {
+func (m MigrationStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5332,11 +6459,11 @@ func (m StringListValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StringListValueMultiError) AllErrors() []error { return m }
+func (m MigrationStateMultiError) AllErrors() []error { return m }
 
-// StringListValueValidationError is the validation error returned by
-// StringListValue.Validate if the designated constraints aren't met.
-type StringListValueValidationError struct {
+// MigrationStateValidationError is the validation error returned by
+// MigrationState.Validate if the designated constraints aren't met.
+type MigrationStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5344,22 +6471,22 @@ type StringListValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e StringListValueValidationError) Field() string { return e.field }
+func (e MigrationStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StringListValueValidationError) Reason() string { return e.reason }
+func (e MigrationStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StringListValueValidationError) Cause() error { return e.cause }
+func (e MigrationStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StringListValueValidationError) Key() bool { return e.key }
+func (e MigrationStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StringListValueValidationError) ErrorName() string { return "StringListValueValidationError" }
+func (e MigrationStateValidationError) ErrorName() string { return "MigrationStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StringListValueValidationError) Error() string {
+func (e MigrationStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5371,14 +6498,14 @@ func (e StringListValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStringListValue.%s: %s%s",
+		"invalid %sMigrationState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StringListValueValidationError{}
+var _ error = MigrationStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -5386,24 +6513,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StringListValueValidationError{}
+} = MigrationStateValidationError{}
 
-// Validate checks the field values on Migration with the rules defined in the
+// Validate checks the field values on Report with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Migration) Validate() error {
+func (m *Report) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Migration with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationMultiError, or nil
-// if none found.
-func (m *Migration) ValidateAll() error {
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Migration) validate(all bool) error {
+func (m *Report) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5414,7 +6540,7 @@ func (m *Migration) validate(all bool) error {
 		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5422,7 +6548,7 @@ func (m *Migration) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5431,7 +6557,7 @@ func (m *Migration) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MigrationValidationError{
+			return ReportValidationError{
 				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -5443,7 +6569,7 @@ func (m *Migration) validate(all bool) error {
 		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5451,7 +6577,7 @@ func (m *Migration) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5460,7 +6586,7 @@ func (m *Migration) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MigrationValidationError{
+			return ReportValidationError{
 				field:  "State",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -5469,18 +6595,18 @@ func (m *Migration) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MigrationMultiError(errors)
+		return ReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationMultiError is an error wrapping multiple validation errors returned
-// by Migration.ValidateAll() if the designated constraints aren't met.
-type MigrationMultiError []error
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationMultiError) Error() string {
+func (m ReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5489,11 +6615,11 @@ func (m MigrationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationMultiError) AllErrors() []error { return m }
+func (m ReportMultiError) AllErrors() []error { return m }
 
-// MigrationValidationError is the validation error returned by
-// Migration.Validate if the designated constraints aren't met.
-type MigrationValidationError struct {
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5501,22 +6627,22 @@ type MigrationValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationValidationError) Field() string { return e.field }
+func (e ReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationValidationError) Reason() string { return e.reason }
+func (e ReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationValidationError) Cause() error { return e.cause }
+func (e ReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationValidationError) Key() bool { return e.key }
+func (e ReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationValidationError) ErrorName() string { return "MigrationValidationError" }
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationValidationError) Error() string {
+func (e ReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5528,14 +6654,14 @@ func (e MigrationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigration.%s: %s%s",
+		"invalid %sReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationValidationError{}
+var _ error = ReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -5543,50 +6669,217 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationValidationError{}
+} = ReportValidationError{}
 
-// Validate checks the field values on MigrationSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ReportSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MigrationSpec) Validate() error {
+func (m *ReportSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MigrationSpec with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationSpecMultiError, or
+// ValidateAll checks the field values on ReportSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportSpecMultiError, or
 // nil if none found.
-func (m *MigrationSpec) ValidateAll() error {
+func (m *ReportSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MigrationSpec) validate(all bool) error {
+func (m *ReportSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
+	// no validation rules for DisplayName
 
-	// no validation rules for Sql
+	// no validation rules for Trigger
 
-	// no validation rules for Version
+	if all {
+		switch v := interface{}(m.GetRefreshSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "RefreshSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for QueryName
+
+	// no validation rules for QueryArgsJson
+
+	// no validation rules for ExportLimit
+
+	// no validation rules for ExportFormat
+
+	if all {
+		switch v := interface{}(m.GetExportGoogleSheets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "ExportGoogleSheets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "ExportGoogleSheets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportGoogleSheets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "ExportGoogleSheets",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExportSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "ExportSnapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "ExportSnapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "ExportSnapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetNotifiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportSpecValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Annotations
+
+	// no validation rules for WatermarkInherit
+
+	// no validation rules for IntervalsIsoDuration
+
+	// no validation rules for IntervalsLimit
+
+	// no validation rules for IntervalsCheckUnclosed
+
+	if all {
+		switch v := interface{}(m.GetBurst()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "Burst",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "Burst",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBurst()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "Burst",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return MigrationSpecMultiError(errors)
+		return ReportSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationSpecMultiError is an error wrapping multiple validation errors
-// returned by MigrationSpec.ValidateAll() if the designated constraints
-// aren't met.
-type MigrationSpecMultiError []error
+// ReportSpecMultiError is an error wrapping multiple validation errors
+// returned by ReportSpec.ValidateAll() if the designated constraints aren't met.
+type ReportSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationSpecMultiError) Error() string {
+func (m ReportSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5595,11 +6888,11 @@ func (m MigrationSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationSpecMultiError) AllErrors() []error { return m }
+func (m ReportSpecMultiError) AllErrors() []error { return m }
 
-// MigrationSpecValidationError is the validation error returned by
-// MigrationSpec.Validate if the designated constraints aren't met.
-type MigrationSpecValidationError struct {
+// ReportSpecValidationError is the validation error returned by
+// ReportSpec.Validate if the designated constraints aren't met.
+type ReportSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5607,22 +6900,22 @@ type MigrationSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationSpecValidationError) Field() string { return e.field }
+func (e ReportSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationSpecValidationError) Reason() string { return e.reason }
+func (e ReportSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationSpecValidationError) Cause() error { return e.cause }
+func (e ReportSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationSpecValidationError) Key() bool { return e.key }
+func (e ReportSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationSpecValidationError) ErrorName() string { return "MigrationSpecValidationError" }
+func (e ReportSpecValidationError) ErrorName() string { return "ReportSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationSpecValidationError) Error() string {
+func (e ReportSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5634,14 +6927,14 @@ func (e MigrationSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigrationSpec.%s: %s%s",
+		"invalid %sReportSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationSpecValidationError{}
+var _ error = ReportSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -5649,46 +6942,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationSpecValidationError{}
+} = ReportSpecValidationError{}
 
-// Validate checks the field values on MigrationState with the rules defined in
+// Validate checks the field values on ReportBurst with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MigrationState) Validate() error {
+func (m *ReportBurst) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MigrationState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationStateMultiError,
-// or nil if none found.
-func (m *MigrationState) ValidateAll() error {
+// ValidateAll checks the field values on ReportBurst with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportBurstMultiError, or
+// nil if none found.
+func (m *ReportBurst) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MigrationState) validate(all bool) error {
+func (m *ReportBurst) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Version
+	// no validation rules for PerRecipient
+
+	// no validation rules for Dimension
+
+	for idx, item := range m.GetSlices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportBurstValidationError{
+						field:  fmt.Sprintf("Slices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportBurstValidationError{
+						field:  fmt.Sprintf("Slices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportBurstValidationError{
+					field:  fmt.Sprintf("Slices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Concurrency
 
 	if len(errors) > 0 {
-		return MigrationStateMultiError(errors)
+		return ReportBurstMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationStateMultiError is an error wrapping multiple validation errors
-// returned by MigrationState.ValidateAll() if the designated constraints
-// aren't met.
-type MigrationStateMultiError []error
+// ReportBurstMultiError is an error wrapping multiple validation errors
+// returned by ReportBurst.ValidateAll() if the designated constraints aren't met.
+type ReportBurstMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationStateMultiError) Error() string {
+func (m ReportBurstMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5697,11 +7027,11 @@ func (m MigrationStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationStateMultiError) AllErrors() []error { return m }
+func (m ReportBurstMultiError) AllErrors() []error { return m }
 
-// MigrationStateValidationError is the validation error returned by
-// MigrationState.Validate if the designated constraints aren't met.
-type MigrationStateValidationError struct {
+// ReportBurstValidationError is the validation error returned by
+// ReportBurst.Validate if the designated constraints aren't met.
+type ReportBurstValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5709,22 +7039,22 @@ type MigrationStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationStateValidationError) Field() string { return e.field }
+func (e ReportBurstValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationStateValidationError) Reason() string { return e.reason }
+func (e ReportBurstValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationStateValidationError) Cause() error { return e.cause }
+func (e ReportBurstValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationStateValidationError) Key() bool { return e.key }
+func (e ReportBurstValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationStateValidationError) ErrorName() string { return "MigrationStateValidationError" }
+func (e ReportBurstValidationError) ErrorName() string { return "ReportBurstValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationStateValidationError) Error() string {
+func (e ReportBurstValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5736,14 +7066,14 @@ func (e MigrationStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigrationState.%s: %s%s",
+		"invalid %sReportBurst.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationStateValidationError{}
+var _ error = ReportBurstValidationError{}
 
 var _ interface {
 	Field() string
@@ -5751,23 +7081,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationStateValidationError{}
+} = ReportBurstValidationError{}
 
-// Validate checks the field values on Report with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Report) Validate() error {
+// Validate checks the field values on ReportBurstSlice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportBurstSlice) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Report with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ReportMultiError, or nil if none found.
-func (m *Report) ValidateAll() error {
+// ValidateAll checks the field values on ReportBurstSlice with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportBurstSliceMultiError, or nil if none found.
+func (m *ReportBurstSlice) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Report) validate(all bool) error {
+func (m *ReportBurstSlice) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5775,28 +7106,28 @@ func (m *Report) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
+		switch v := interface{}(m.GetValue()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "Spec",
+				errors = append(errors, ReportBurstSliceValidationError{
+					field:  "Value",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "Spec",
+				errors = append(errors, ReportBurstSliceValidationError{
+					field:  "Value",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportValidationError{
-				field:  "Spec",
+			return ReportBurstSliceValidationError{
+				field:  "Value",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5804,28 +7135,28 @@ func (m *Report) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetAttributes()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "State",
+				errors = append(errors, ReportBurstSliceValidationError{
+					field:  "Attributes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "State",
+				errors = append(errors, ReportBurstSliceValidationError{
+					field:  "Attributes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportValidationError{
-				field:  "State",
+			return ReportBurstSliceValidationError{
+				field:  "Attributes",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5833,18 +7164,19 @@ func (m *Report) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ReportMultiError(errors)
+		return ReportBurstSliceMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportMultiError is an error wrapping multiple validation errors returned by
-// Report.ValidateAll() if the designated constraints aren't met.
-type ReportMultiError []error
+// ReportBurstSliceMultiError is an error wrapping multiple validation errors
+// returned by ReportBurstSlice.ValidateAll() if the designated constraints
+// aren't met.
+type ReportBurstSliceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportMultiError) Error() string {
+func (m ReportBurstSliceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5853,11 +7185,11 @@ func (m ReportMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportMultiError) AllErrors() []error { return m }
+func (m ReportBurstSliceMultiError) AllErrors() []error { return m }
 
-// ReportValidationError is the validation error returned by Report.Validate if
-// the designated constraints aren't met.
-type ReportValidationError struct {
+// ReportBurstSliceValidationError is the validation error returned by
+// ReportBurstSlice.Validate if the designated constraints aren't met.
+type ReportBurstSliceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5865,22 +7197,22 @@ type ReportValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportValidationError) Field() string { return e.field }
+func (e ReportBurstSliceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportValidationError) Reason() string { return e.reason }
+func (e ReportBurstSliceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportValidationError) Cause() error { return e.cause }
+func (e ReportBurstSliceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportValidationError) Key() bool { return e.key }
+func (e ReportBurstSliceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+func (e ReportBurstSliceValidationError) ErrorName() string { return "ReportBurstSliceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReportValidationError) Error() string {
+func (e ReportBurstSliceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5892,14 +7224,14 @@ func (e ReportValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReport.%s: %s%s",
+		"invalid %sReportBurstSlice.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportValidationError{}
+var _ error = ReportBurstSliceValidationError{}
 
 var _ interface {
 	Field() string
@@ -5907,130 +7239,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportValidationError{}
+} = ReportBurstSliceValidationError{}
 
-// Validate checks the field values on ReportSpec with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ReportSpec) Validate() error {
+// Validate checks the field values on ReportSnapshotOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportSnapshotOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReportSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ReportSpecMultiError, or
-// nil if none found.
-func (m *ReportSpec) ValidateAll() error {
+// ValidateAll checks the field values on ReportSnapshotOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportSnapshotOptionsMultiError, or nil if none found.
+func (m *ReportSnapshotOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReportSpec) validate(all bool) error {
+func (m *ReportSnapshotOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
-
-	// no validation rules for Trigger
-
-	if all {
-		switch v := interface{}(m.GetRefreshSchedule()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportSpecValidationError{
-					field:  "RefreshSchedule",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportSpecValidationError{
-					field:  "RefreshSchedule",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ReportSpecValidationError{
-				field:  "RefreshSchedule",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for TimeoutSeconds
-
-	// no validation rules for QueryName
-
-	// no validation rules for QueryArgsJson
-
-	// no validation rules for ExportLimit
-
-	// no validation rules for ExportFormat
-
-	for idx, item := range m.GetNotifiers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ReportSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ReportSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ReportSpecValidationError{
-					field:  fmt.Sprintf("Notifiers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Annotations
-
-	// no validation rules for WatermarkInherit
+	// no validation rules for Canvas
 
-	// no validation rules for IntervalsIsoDuration
+	// no validation rules for Explore
 
-	// no validation rules for IntervalsLimit
+	// no validation rules for Width
 
-	// no validation rules for IntervalsCheckUnclosed
+	// no validation rules for Height
 
 	if len(errors) > 0 {
-		return ReportSpecMultiError(errors)
+		return ReportSnapshotOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportSpecMultiError is an error wrapping multiple validation errors
-// returned by ReportSpec.ValidateAll() if the designated constraints aren't met.
-type ReportSpecMultiError []error
+// ReportSnapshotOptionsMultiError is an error wrapping multiple validation
+// errors returned by ReportSnapshotOptions.ValidateAll() if the designated
+// constraints aren't met.
+type ReportSnapshotOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportSpecMultiError) Error() string {
+func (m ReportSnapshotOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6039,11 +7293,11 @@ func (m ReportSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportSpecMultiError) AllErrors() []error { return m }
+func (m ReportSnapshotOptionsMultiError) AllErrors() []error { return m }
 
-// ReportSpecValidationError is the validation error returned by
-// ReportSpec.Validate if the designated constraints aren't met.
-type ReportSpecValidationError struct {
+// ReportSnapshotOptionsValidationError is the validation error returned by
+// ReportSnapshotOptions.Validate if the designated constraints aren't met.
+type ReportSnapshotOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6051,22 +7305,24 @@ type ReportSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportSpecValidationError) Field() string { return e.field }
+func (e ReportSnapshotOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportSpecValidationError) Reason() string { return e.reason }
+func (e ReportSnapshotOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportSpecValidationError) Cause() error { return e.cause }
+func (e ReportSnapshotOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportSpecValidationError) Key() bool { return e.key }
+func (e ReportSnapshotOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportSpecValidationError) ErrorName() string { return "ReportSpecValidationError" }
+func (e ReportSnapshotOptionsValidationError) ErrorName() string {
+	return "ReportSnapshotOptionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ReportSpecValidationError) Error() string {
+func (e ReportSnapshotOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6078,14 +7334,14 @@ func (e ReportSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReportSpec.%s: %s%s",
+		"invalid %sReportSnapshotOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportSpecValidationError{}
+var _ error = ReportSnapshotOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6093,7 +7349,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportSpecValidationError{}
+} = ReportSnapshotOptionsValidationError{}
 
 // Validate checks the field values on ReportState with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -6401,6 +7657,40 @@ func (m *ReportExecution) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetBurstResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportExecutionValidationError{
+						field:  fmt.Sprintf("BurstResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportExecutionValidationError{
+						field:  fmt.Sprintf("BurstResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportExecutionValidationError{
+					field:  fmt.Sprintf("BurstResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ReportExecutionMultiError(errors)
 	}
@@ -6479,6 +7769,114 @@ var _ interface {
 	ErrorName() string
 } = ReportExecutionValidationError{}
 
+// Validate checks the field values on ReportBurstResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportBurstResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportBurstResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportBurstResultMultiError, or nil if none found.
+func (m *ReportBurstResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportBurstResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Recipient
+
+	// no validation rules for Slice
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return ReportBurstResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportBurstResultMultiError is an error wrapping multiple validation errors
+// returned by ReportBurstResult.ValidateAll() if the designated constraints
+// aren't met.
+type ReportBurstResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportBurstResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportBurstResultMultiError) AllErrors() []error { return m }
+
+// ReportBurstResultValidationError is the validation error returned by
+// ReportBurstResult.Validate if the designated constraints aren't met.
+type ReportBurstResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportBurstResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportBurstResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportBurstResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportBurstResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportBurstResultValidationError) ErrorName() string {
+	return "ReportBurstResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportBurstResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportBurstResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportBurstResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportBurstResultValidationError{}
+
 // Validate checks the field values on Alert with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -7178,6 +8576,8 @@ func (m *AlertState) validate(all bool) error {
 
 	// no validation rules for ExecutionCount
 
+	// no validation rules for ConsecutiveFailures
+
 	if len(errors) > 0 {
 		return AlertStateMultiError(errors)
 	}
@@ -9738,6 +11138,37 @@ func (m *ComponentSpec) validate(all bool) error {
 
 	// no validation rules for DefinedInCanvas
 
+	// no validation rules for Resolver
+
+	if all {
+		switch v := interface{}(m.GetResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComponentSpecValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComponentSpecValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComponentSpecValidationError{
+				field:  "ResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ComponentSpecMultiError(errors)
 	}
@@ -10495,6 +11926,40 @@ func (m *CanvasSpec) validate(all bool) error {
 
 	// no validation rules for Banner
 
+	for idx, item := range m.GetFilters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CanvasSpecValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CanvasSpecValidationError{
+						field:  fmt.Sprintf("Filters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CanvasSpecValidationError{
+					field:  fmt.Sprintf("Filters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CanvasSpecMultiError(errors)
 	}
@@ -10572,6 +12037,146 @@ var _ interface {
 	ErrorName() string
 } = CanvasSpecValidationError{}
 
+// Validate checks the field values on CanvasFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CanvasFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CanvasFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CanvasFilterMultiError, or
+// nil if none found.
+func (m *CanvasFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CanvasFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for DisplayName
+
+	// no validation rules for MetricsView
+
+	// no validation rules for Dimension
+
+	// no validation rules for Measure
+
+	// no validation rules for Operator
+
+	if all {
+		switch v := interface{}(m.GetDefaultValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CanvasFilterValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CanvasFilterValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaultValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CanvasFilterValidationError{
+				field:  "DefaultValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CanvasFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// CanvasFilterMultiError is an error wrapping multiple validation errors
+// returned by CanvasFilter.ValidateAll() if the designated constraints aren't met.
+type CanvasFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CanvasFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CanvasFilterMultiError) AllErrors() []error { return m }
+
+// CanvasFilterValidationError is the validation error returned by
+// CanvasFilter.Validate if the designated constraints aren't met.
+type CanvasFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CanvasFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CanvasFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CanvasFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CanvasFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CanvasFilterValidationError) ErrorName() string { return "CanvasFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CanvasFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCanvasFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CanvasFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CanvasFilterValidationError{}
+
 // Validate checks the field values on CanvasState with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -29,6 +29,7 @@ const retryPolicy = `{"methodConfig": [{
 // It's a thin wrapper around the generated gRPC client for proto/rill/runtime/v1.
 type Client struct {
 	runtimev1.RuntimeServiceClient
+	runtimev1.QueryServiceClient
 	conn *grpc.ClientConn
 }
 
@@ -66,6 +67,7 @@ func New(runtimeHost, bearerToken string) (*Client, error) {
 
 	return &Client{
 		RuntimeServiceClient: runtimev1.NewRuntimeServiceClient(conn),
+		QueryServiceClient:   runtimev1.NewQueryServiceClient(conn),
 		conn:                 conn,
 	}, nil
 }
@@ -61,6 +61,11 @@ func (a *connectorAnalyzer) analyze(ctx context.Context) {
 		if a.parser.RillYAML.OLAPConnector != "" {
 			a.trackConnector(a.parser.RillYAML.OLAPConnector, nil, false)
 		}
+
+		// Track the AI connector specified in rill.yaml
+		if a.parser.RillYAML.AIConnector != "" {
+			a.trackConnector(a.parser.RillYAML.AIConnector, nil, false)
+		}
 	}
 
 	for _, r := range a.parser.Resources {
@@ -116,6 +116,7 @@ type DataYAML struct {
 	Args           map[string]any `yaml:"args"`
 	Glob           yaml.Node      `yaml:"glob"` // Path (string) or properties (map[string]any)
 	ResourceStatus map[string]any `yaml:"resource_status"`
+	MetricsJoin    map[string]any `yaml:"metrics_join"`
 }
 
 // parseDataYAML parses a data resolver and its properties from a DataYAML.
@@ -184,6 +185,22 @@ func (p *Parser) parseDataYAML(raw *DataYAML, contextualConnector string) (strin
 		resolverProps = raw.ResourceStatus
 	}
 
+	// Handle metrics_join resolver
+	if raw.MetricsJoin != nil {
+		count++
+		resolver = "metrics_join"
+		resolverProps = raw.MetricsJoin
+		if qs, ok := raw.MetricsJoin["queries"].([]any); ok {
+			for _, q := range qs {
+				if q, ok := q.(map[string]any); ok {
+					if mv, ok := q["metrics_view"].(string); ok && mv != "" {
+						refs = append(refs, ResourceName{Kind: ResourceKindMetricsView, Name: mv})
+					}
+				}
+			}
+		}
+	}
+
 	// Validate there was exactly one resolver
 	if count == 0 {
 		return "", nil, nil, fmt.Errorf(`the API definition does not specify a resolver (for example, "sql:", "metrics_sql:", ...)`)
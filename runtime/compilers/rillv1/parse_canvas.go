@@ -3,6 +3,7 @@ package rillv1
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,7 +26,9 @@ type CanvasYAML struct {
 	TimeRanges  []ExploreTimeRangeYAML `yaml:"time_ranges"`
 	TimeZones   []string               `yaml:"time_zones"`
 	Filters     struct {
-		Enable *bool `yaml:"enable"`
+		Enable     *bool                        `yaml:"enable"`
+		Dimensions []*CanvasDimensionFilterYAML `yaml:"dimensions"`
+		Measures   []*CanvasMeasureFilterYAML   `yaml:"measures"`
 	}
 	Defaults *struct {
 		TimeRange           string `yaml:"time_range"`
@@ -115,6 +118,17 @@ func (p *Parser) parseCanvas(node *Node) error {
 		}
 	}
 
+	// Parse filter definitions
+	filters, err := tmp.parseFilters()
+	if err != nil {
+		return err
+	}
+	for _, f := range filters {
+		if f.MetricsView != "" {
+			node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: f.MetricsView})
+		}
+	}
+
 	// Parse items.
 	// Each item can either reference an externally defined component by name or define a component inline.
 	var items []*runtimev1.CanvasItem
@@ -131,6 +145,10 @@ func (p *Parser) parseCanvas(node *Node) error {
 
 		// Track inline component definitions so we can insert them after we have validated all components
 		if inlineComponentDef != nil {
+			err = validateComponentOutput(inlineComponentDef.spec, filters)
+			if err != nil {
+				return fmt.Errorf("invalid component at index %d: %w", i, err)
+			}
 			inlineComponentDefs = append(inlineComponentDefs, inlineComponentDef)
 		}
 
@@ -221,6 +239,7 @@ func (p *Parser) parseCanvas(node *Node) error {
 	r.CanvasSpec.Items = items
 	r.CanvasSpec.Layout = layout
 	r.CanvasSpec.SecurityRules = rules
+	r.CanvasSpec.Filters = filters
 
 	// Track inline components
 	for _, def := range inlineComponentDefs {
@@ -286,6 +305,148 @@ func (p *Parser) parseCanvasItemComponent(canvasName string, idx int, n yaml.Nod
 	return name, def, nil
 }
 
+// CanvasTimeRangeFilterName is the name of the built-in time range filter of a canvas.
+const CanvasTimeRangeFilterName = "time_range"
+
+type CanvasDimensionFilterYAML struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"display_name"`
+	MetricsView string `yaml:"metrics_view"`
+	Dimension   string `yaml:"dimension"`
+	Default     any    `yaml:"default"`
+}
+
+type CanvasMeasureFilterYAML struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"display_name"`
+	MetricsView string `yaml:"metrics_view"`
+	Measure     string `yaml:"measure"`
+	Operator    string `yaml:"operator"`
+	Default     any    `yaml:"default"`
+}
+
+var canvasFilterOperators = map[string]runtimev1.Operation{
+	"eq":  runtimev1.Operation_OPERATION_EQ,
+	"neq": runtimev1.Operation_OPERATION_NEQ,
+	"lt":  runtimev1.Operation_OPERATION_LT,
+	"lte": runtimev1.Operation_OPERATION_LTE,
+	"gt":  runtimev1.Operation_OPERATION_GT,
+	"gte": runtimev1.Operation_OPERATION_GTE,
+}
+
+// parseFilters parses and validates the canvas's dimension and measure filter definitions.
+func (y *CanvasYAML) parseFilters() ([]*runtimev1.CanvasFilter, error) {
+	var res []*runtimev1.CanvasFilter
+	names := make(map[string]bool)
+	for _, v := range y.Variables {
+		if v != nil {
+			names[v.Name] = true
+		}
+	}
+	checkName := func(name string) error {
+		if name == CanvasTimeRangeFilterName {
+			return fmt.Errorf("filter name %q is reserved for the time range filter", name)
+		}
+		if names[name] {
+			return fmt.Errorf("filter name %q is used by another filter or variable", name)
+		}
+		names[name] = true
+		return nil
+	}
+
+	for i, f := range y.Filters.Dimensions {
+		if f == nil || f.Dimension == "" {
+			return nil, fmt.Errorf("dimension filter at index %d must have a dimension", i)
+		}
+		if f.Name == "" {
+			f.Name = f.Dimension
+		}
+		if err := checkName(f.Name); err != nil {
+			return nil, err
+		}
+
+		var def *structpb.Value
+		if f.Default != nil {
+			vals, ok := f.Default.([]any)
+			if !ok {
+				vals = []any{f.Default}
+			}
+			var err error
+			def, err = structpb.NewValue(vals)
+			if err != nil {
+				return nil, fmt.Errorf("invalid default for filter %q: %w", f.Name, err)
+			}
+		}
+
+		res = append(res, &runtimev1.CanvasFilter{
+			Name:         f.Name,
+			DisplayName:  f.DisplayName,
+			MetricsView:  f.MetricsView,
+			Dimension:    f.Dimension,
+			DefaultValue: def,
+		})
+	}
+
+	for i, f := range y.Filters.Measures {
+		if f == nil || f.Measure == "" {
+			return nil, fmt.Errorf("measure filter at index %d must have a measure", i)
+		}
+		if f.Name == "" {
+			f.Name = f.Measure
+		}
+		if err := checkName(f.Name); err != nil {
+			return nil, err
+		}
+
+		op, ok := canvasFilterOperators[f.Operator]
+		if !ok {
+			ops := maps.Keys(canvasFilterOperators)
+			slices.Sort(ops)
+			return nil, fmt.Errorf("invalid operator %q for filter %q (options: %s)", f.Operator, f.Name, strings.Join(ops, ", "))
+		}
+
+		var def *structpb.Value
+		if f.Default != nil {
+			switch v := f.Default.(type) {
+			case int:
+				def = structpb.NewNumberValue(float64(v))
+			case float64:
+				def = structpb.NewNumberValue(v)
+			default:
+				return nil, fmt.Errorf("default for filter %q must be a number", f.Name)
+			}
+		}
+
+		res = append(res, &runtimev1.CanvasFilter{
+			Name:         f.Name,
+			DisplayName:  f.DisplayName,
+			MetricsView:  f.MetricsView,
+			Measure:      f.Measure,
+			Operator:     op,
+			DefaultValue: def,
+		})
+	}
+
+	return res, nil
+}
+
+// validateComponentOutput validates that a component's output variable can set the canvas filter it's bound to.
+// Outputs are bound to filters by name. Since only dimension values can be selected in a component, outputs can't be bound to measure filters.
+func validateComponentOutput(spec *runtimev1.ComponentSpec, filters []*runtimev1.CanvasFilter) error {
+	if spec.Output == nil {
+		return nil
+	}
+	if spec.Output.Name == "" {
+		return errors.New("output variable must have a name")
+	}
+	for _, f := range filters {
+		if f.Name == spec.Output.Name && f.Measure != "" {
+			return fmt.Errorf("output variable %q can't set measure filter %q", spec.Output.Name, f.Name)
+		}
+	}
+	return nil
+}
+
 type componentDef struct {
 	name string
 	refs []ResourceName
@@ -16,6 +16,17 @@ var componentTemplateSpec string
 
 var componentTemplateSchema = jsonschema.MustCompileString("https://github.com/rilldata/rill/tree/main/runtime/compilers/rillv1/data/component-template-v1.json", componentTemplateSpec)
 
+// IsComponentDataResolver returns true if the resolver can be used for a component's data.
+// Components are visible to everyone who can access a canvas, so only resolvers that query metrics views (and apply their security policies) are allowed.
+func IsComponentDataResolver(resolver string) bool {
+	switch resolver {
+	case "metrics_sql", "metrics_join":
+		return true
+	default:
+		return false
+	}
+}
+
 type ComponentYAML struct {
 	commonYAML  `yaml:",inline"`          // Not accessed here, only setting it so we can use KnownFields for YAML parsing
 	DisplayName string                    `yaml:"display_name"`
@@ -24,6 +35,7 @@ type ComponentYAML struct {
 	Subtitle    string                    `yaml:"subtitle"` // Deprecated: use description
 	Input       []*ComponentVariableYAML  `yaml:"input"`
 	Output      *ComponentVariableYAML    `yaml:"output"`
+	Data        *DataYAML                 `yaml:"data"`
 	Other       map[string]map[string]any `yaml:",inline" mapstructure:",remain"` // Generic renderer: can only have one key
 }
 
@@ -150,6 +162,22 @@ func (p *Parser) parseComponentYAML(tmp *ComponentYAML) (*runtimev1.ComponentSpe
 		}
 	}
 
+	// Parse the data resolver, if any
+	var resolver string
+	var resolverProps *structpb.Struct
+	if tmp.Data != nil {
+		var resolverRefs []ResourceName
+		var err error
+		resolver, resolverProps, resolverRefs, err = p.parseDataYAML(tmp.Data, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf(`failed to parse "data": %w`, err)
+		}
+		if !IsComponentDataResolver(resolver) {
+			return nil, nil, fmt.Errorf(`invalid "data": components can only use "metrics_sql" or "metrics_join"`)
+		}
+		refs = append(refs, resolverRefs...)
+	}
+
 	// Create the component spec
 	spec := &runtimev1.ComponentSpec{
 		DisplayName:        tmp.DisplayName,
@@ -158,6 +186,8 @@ func (p *Parser) parseComponentYAML(tmp *ComponentYAML) (*runtimev1.ComponentSpe
 		RendererProperties: rendererProps,
 		Input:              input,
 		Output:             output,
+		Resolver:           resolver,
+		ResolverProperties: resolverProps,
 	}
 
 	return spec, refs, nil
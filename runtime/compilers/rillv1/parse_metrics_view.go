@@ -211,7 +211,9 @@ type SecurityPolicyYAML struct {
 		Condition string    `yaml:"if"`
 		Names     yaml.Node // []string or "*" (will be parsed with parseNamesYAML)
 	}
-	Rules []*SecurityRuleYAML `yaml:"rules"`
+	Rules        []*SecurityRuleYAML `yaml:"rules"`
+	MinGroupSize int                 `yaml:"min_group_size"`
+	Noise        float64             `yaml:"noise"`
 }
 
 func (p *SecurityPolicyYAML) Proto() ([]*runtimev1.SecurityRule, error) {
@@ -349,6 +351,14 @@ func (p *SecurityPolicyYAML) Proto() ([]*runtimev1.SecurityRule, error) {
 		})
 	}
 
+	if p.MinGroupSize != 0 || p.Noise != 0 {
+		rule, err := minGroupSizeSecurityRule("", p.MinGroupSize, p.Noise)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': %w`, err)
+		}
+		rules = append(rules, rule)
+	}
+
 	for _, r := range p.Rules {
 		if r == nil {
 			continue
@@ -365,12 +375,14 @@ func (p *SecurityPolicyYAML) Proto() ([]*runtimev1.SecurityRule, error) {
 }
 
 type SecurityRuleYAML struct {
-	Type   string
-	Action string
-	If     string
-	Names  []string
-	All    bool
-	SQL    string
+	Type         string
+	Action       string
+	If           string
+	Names        []string
+	All          bool
+	SQL          string
+	MinGroupSize int `yaml:"min_group_size"`
+	Noise        float64
 }
 
 func (r *SecurityRuleYAML) Proto() (*runtimev1.SecurityRule, error) {
@@ -449,11 +461,42 @@ func (r *SecurityRuleYAML) Proto() (*runtimev1.SecurityRule, error) {
 				},
 			},
 		}, nil
+	case "min_group_size":
+		if allow != nil {
+			return nil, fmt.Errorf("invalid security rule of type %q: cannot specify an action", r.Type)
+		}
+		rule, err := minGroupSizeSecurityRule(condition, r.MinGroupSize, r.Noise)
+		if err != nil {
+			return nil, fmt.Errorf("invalid security rule of type %q: %w", r.Type, err)
+		}
+		return rule, nil
 	default:
 		return nil, fmt.Errorf("invalid security rule type %q", r.Type)
 	}
 }
 
+// minGroupSizeSecurityRule validates and builds a security rule that suppresses aggregates over small groups.
+func minGroupSizeSecurityRule(condition string, size int, noise float64) (*runtimev1.SecurityRule, error) {
+	if size < 0 {
+		return nil, fmt.Errorf(`'min_group_size' must be a positive number`)
+	}
+	if noise < 0 || noise >= 1 {
+		return nil, fmt.Errorf(`'noise' must be a number between 0 and 1`)
+	}
+	if size == 0 && noise == 0 {
+		return nil, fmt.Errorf(`must provide 'min_group_size' or 'noise'`)
+	}
+	return &runtimev1.SecurityRule{
+		Rule: &runtimev1.SecurityRule_MinGroupSize{
+			MinGroupSize: &runtimev1.SecurityRuleMinGroupSize{
+				Condition:    condition,
+				MinGroupSize: uint32(size),
+				Noise:        noise,
+			},
+		},
+	}, nil
+}
+
 var comparisonModesMap = map[string]runtimev1.MetricsViewSpec_ComparisonMode{
 	"":          runtimev1.MetricsViewSpec_COMPARISON_MODE_UNSPECIFIED,
 	"none":      runtimev1.MetricsViewSpec_COMPARISON_MODE_NONE,
@@ -34,7 +34,18 @@ type ModelYAML struct {
 		Connector  string         `yaml:"connector"`
 		Properties map[string]any `yaml:",inline" mapstructure:",remain"`
 	} `yaml:"output"`
-	Materialize *bool `yaml:"materialize"`
+	Materialize *bool              `yaml:"materialize"`
+	Contract    *ModelContractYAML `yaml:"contract"`
+}
+
+// ModelContractYAML is the raw structure of a model's contract, which declares the expected columns of its output.
+type ModelContractYAML struct {
+	Mode              string `yaml:"mode"` // Either "enforced" (default) or "warn"
+	AllowExtraColumns bool   `yaml:"allow_extra_columns"`
+	Columns           []*struct {
+		Name string `yaml:"name"`
+		Type string `yaml:"type"`
+	} `yaml:"columns"`
 }
 
 // parseModel parses a model definition and adds the resulting resource to p.Resources.
@@ -162,6 +173,19 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		}
 	}
 
+	// Parse contract
+	var contract *runtimev1.ModelContract
+	if tmp.Contract != nil {
+		contract, err = parseModelContract(tmp.Contract)
+		if err != nil {
+			return fmt.Errorf(`invalid "contract": %w`, err)
+		}
+		// Incremental runs insert into the existing output in-place, so a violation can't be rolled back.
+		if contract.Enforced && tmp.Incremental {
+			return errors.New(`invalid "contract": enforced contracts are not supported for incremental models (set "mode: warn" instead)`)
+		}
+	}
+
 	// Insert the model
 	r, err := p.insertResource(ResourceKindModel, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -196,9 +220,95 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 	r.ModelSpec.OutputConnector = outputConnector
 	r.ModelSpec.OutputProperties = outputPropsPB
 
+	r.ModelSpec.Contract = contract
+
 	return nil
 }
 
+// parseModelContract validates a model contract and converts it to its protobuf representation.
+func parseModelContract(c *ModelContractYAML) (*runtimev1.ModelContract, error) {
+	res := &runtimev1.ModelContract{AllowExtraColumns: c.AllowExtraColumns}
+
+	switch c.Mode {
+	case "", "enforced":
+		res.Enforced = true
+	case "warn":
+		res.Enforced = false
+	default:
+		return nil, fmt.Errorf(`invalid mode %q (must be "enforced" or "warn")`, c.Mode)
+	}
+
+	if len(c.Columns) == 0 {
+		return nil, errors.New(`must specify at least one column in "columns"`)
+	}
+
+	seen := make(map[string]bool, len(c.Columns))
+	for _, col := range c.Columns {
+		if col == nil || col.Name == "" {
+			return nil, errors.New(`all columns must have a "name"`)
+		}
+		name := strings.ToLower(col.Name)
+		if seen[name] {
+			return nil, fmt.Errorf("column %q is listed more than once", col.Name)
+		}
+		seen[name] = true
+
+		typ := runtimev1.Type_CODE_UNSPECIFIED
+		if col.Type != "" {
+			var ok bool
+			typ, ok = parseContractType(col.Type)
+			if !ok {
+				return nil, fmt.Errorf("unsupported type %q for column %q", col.Type, col.Name)
+			}
+		}
+
+		res.Columns = append(res.Columns, &runtimev1.ModelContractColumn{
+			Name: col.Name,
+			Type: typ,
+		})
+	}
+
+	return res, nil
+}
+
+// contractTypeAliases maps common SQL type names to the type codes used by the runtime.
+var contractTypeAliases = map[string]runtimev1.Type_Code{
+	"BOOLEAN":   runtimev1.Type_CODE_BOOL,
+	"TINYINT":   runtimev1.Type_CODE_INT8,
+	"SMALLINT":  runtimev1.Type_CODE_INT16,
+	"INT":       runtimev1.Type_CODE_INT32,
+	"INTEGER":   runtimev1.Type_CODE_INT32,
+	"BIGINT":    runtimev1.Type_CODE_INT64,
+	"HUGEINT":   runtimev1.Type_CODE_INT128,
+	"UTINYINT":  runtimev1.Type_CODE_UINT8,
+	"USMALLINT": runtimev1.Type_CODE_UINT16,
+	"UINTEGER":  runtimev1.Type_CODE_UINT32,
+	"UBIGINT":   runtimev1.Type_CODE_UINT64,
+	"FLOAT":     runtimev1.Type_CODE_FLOAT32,
+	"REAL":      runtimev1.Type_CODE_FLOAT32,
+	"DOUBLE":    runtimev1.Type_CODE_FLOAT64,
+	"VARCHAR":   runtimev1.Type_CODE_STRING,
+	"TEXT":      runtimev1.Type_CODE_STRING,
+	"BLOB":      runtimev1.Type_CODE_BYTES,
+}
+
+// parseContractType parses a column type in a model contract.
+// It accepts the runtime's type names (e.g. "int64" or "CODE_INT64") and common SQL type names (e.g. "BIGINT").
+func parseContractType(s string) (runtimev1.Type_Code, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if t, ok := contractTypeAliases[s]; ok {
+		return t, true
+	}
+	if !strings.HasPrefix(s, "CODE_") {
+		s = "CODE_" + s
+	}
+	t, ok := runtimev1.Type_Code_value[s]
+	if !ok || t == int32(runtimev1.Type_CODE_UNSPECIFIED) {
+		return runtimev1.Type_CODE_UNSPECIFIED, false
+	}
+	return runtimev1.Type_Code(t), true
+}
+
 // inferSQLRefs attempts to infer table references from the node's SQL.
 // The provided node must have a non-empty SQL field.
 func (p *Parser) inferSQLRefs(node *Node) ([]ResourceName, error) {
@@ -98,6 +98,7 @@ type commonYAML struct {
 
 // parseStem parses a pair of YAML and SQL files with the same path stem (e.g. "/path/to/file.yaml" for "/path/to/file.sql").
 // Note that either of the YAML or SQL files may be empty (the paths arg will only contain non-nil paths).
+// It returns a nil node if the YAML file doesn't declare a resource (currently only for project test files, see TestFileType).
 func (p *Parser) parseStem(paths []string, ymlPath, yml, sqlPath, sql string) (*Node, error) {
 	// The rest of the function builds a Node from YAML and SQL info
 	res := &Node{Paths: paths}
@@ -184,6 +185,9 @@ func (p *Parser) parseStem(paths []string, ymlPath, yml, sqlPath, sql string) (*
 				return nil, pathError{path: ymlPath, err: err}
 			}
 		}
+		if cfg.Type != nil && IsTestFileType(*cfg.Type) {
+			return nil, nil
+		}
 		if cfg.Type != nil {
 			kind, err := ParseResourceKind(*cfg.Type)
 			if err == nil {
@@ -34,8 +34,18 @@ type ReportYAML struct {
 		ArgsJSON string         `yaml:"args_json"`
 	} `yaml:"query"`
 	Export struct {
-		Format string `yaml:"format"`
-		Limit  uint   `yaml:"limit"`
+		Format       string `yaml:"format"`
+		Limit        uint   `yaml:"limit"`
+		GoogleSheets *struct {
+			SpreadsheetID string `yaml:"spreadsheet_id"`
+			Sheet         string `yaml:"sheet"`
+		} `yaml:"google_sheets"`
+		Snapshot *struct {
+			Canvas  string `yaml:"canvas"`
+			Explore string `yaml:"explore"`
+			Width   uint32 `yaml:"width"`
+			Height  uint32 `yaml:"height"`
+		} `yaml:"snapshot"`
 	} `yaml:"export"`
 	Email struct {
 		Recipients []string `yaml:"recipients"`
@@ -50,6 +60,16 @@ type ReportYAML struct {
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
 	} `yaml:"notify"`
+	Burst *struct {
+		PerRecipient bool   `yaml:"per_recipient"`
+		Dimension    string `yaml:"dimension"`
+		Slices       []struct {
+			Value      any            `yaml:"value"`
+			Attributes map[string]any `yaml:"attributes"`
+			Recipients []string       `yaml:"recipients"`
+		} `yaml:"slices"`
+		Concurrency uint32 `yaml:"concurrency"`
+	} `yaml:"burst"`
 	Annotations map[string]string `yaml:"annotations"`
 }
 
@@ -111,37 +131,85 @@ func (p *Parser) parseReport(node *Node) error {
 		}
 	}
 
-	// Query name
-	if tmp.Query.Name == "" {
-		return fmt.Errorf(`invalid value %q for property "query.name"`, tmp.Query.Name)
+	// Parse export format
+	exportFormat, err := parseExportFormat(tmp.Export.Format)
+	if err != nil {
+		return err
 	}
+	if exportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return fmt.Errorf(`missing required property "export.format"`)
+	}
+
+	isSnapshot := exportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_PDF || exportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_PNG
 
-	// Query args
-	if tmp.Query.ArgsJSON != "" {
-		// Validate JSON
-		if !json.Valid([]byte(tmp.Query.ArgsJSON)) {
-			return errors.New(`failed to parse "query.args_json" as JSON`)
+	// Query name and args (snapshot reports render a dashboard, so they don't need a query)
+	if !isSnapshot || tmp.Query.Name != "" {
+		// Query name
+		if tmp.Query.Name == "" {
+			return fmt.Errorf(`invalid value %q for property "query.name"`, tmp.Query.Name)
 		}
-	} else {
-		// Fall back to query.args if query.args_json is not set
-		data, err := json.Marshal(tmp.Query.Args)
-		if err != nil {
-			return fmt.Errorf(`failed to serialize "query.args" to JSON: %w`, err)
+
+		// Query args
+		if tmp.Query.ArgsJSON != "" {
+			// Validate JSON
+			if !json.Valid([]byte(tmp.Query.ArgsJSON)) {
+				return errors.New(`failed to parse "query.args_json" as JSON`)
+			}
+		} else {
+			// Fall back to query.args if query.args_json is not set
+			data, err := json.Marshal(tmp.Query.Args)
+			if err != nil {
+				return fmt.Errorf(`failed to serialize "query.args" to JSON: %w`, err)
+			}
+			tmp.Query.ArgsJSON = string(data)
+		}
+		if tmp.Query.ArgsJSON == "" {
+			return errors.New(`missing query args (must set either "query.args" or "query.args_json")`)
 		}
-		tmp.Query.ArgsJSON = string(data)
 	}
-	if tmp.Query.ArgsJSON == "" {
-		return errors.New(`missing query args (must set either "query.args" or "query.args_json")`)
+
+	// Parse Google Sheets destination
+	var exportGoogleSheets *runtimev1.GoogleSheetsExportOptions
+	if exportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS {
+		if tmp.Export.GoogleSheets == nil || tmp.Export.GoogleSheets.SpreadsheetID == "" {
+			return fmt.Errorf(`missing required property "export.google_sheets.spreadsheet_id" for export format "google_sheets"`)
+		}
+		exportGoogleSheets = &runtimev1.GoogleSheetsExportOptions{
+			SpreadsheetId: tmp.Export.GoogleSheets.SpreadsheetID,
+			Sheet:         tmp.Export.GoogleSheets.Sheet,
+		}
+	} else if tmp.Export.GoogleSheets != nil {
+		return fmt.Errorf(`property "export.google_sheets" can only be set for export format "google_sheets"`)
 	}
 
-	// Parse export format
-	exportFormat, err := parseExportFormat(tmp.Export.Format)
+	// Parse snapshot options
+	var exportSnapshot *runtimev1.ReportSnapshotOptions
+	var snapshotRef *ResourceName
+	if isSnapshot {
+		s := tmp.Export.Snapshot
+		if s == nil || (s.Canvas == "") == (s.Explore == "") {
+			return fmt.Errorf(`must set exactly one of "export.snapshot.canvas" or "export.snapshot.explore" for export format %q`, tmp.Export.Format)
+		}
+		if s.Canvas != "" {
+			snapshotRef = &ResourceName{Kind: ResourceKindCanvas, Name: s.Canvas}
+		} else {
+			snapshotRef = &ResourceName{Kind: ResourceKindExplore, Name: s.Explore}
+		}
+		exportSnapshot = &runtimev1.ReportSnapshotOptions{
+			Canvas:  s.Canvas,
+			Explore: s.Explore,
+			Width:   s.Width,
+			Height:  s.Height,
+		}
+	} else if tmp.Export.Snapshot != nil {
+		return fmt.Errorf(`property "export.snapshot" can only be set for export formats "pdf" and "png"`)
+	}
+
+	// Parse burst options
+	burst, err := parseReportBurst(tmp, exportFormat)
 	if err != nil {
 		return err
 	}
-	if exportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
-		return fmt.Errorf(`missing required property "export.format"`)
-	}
 
 	if len(tmp.Email.Recipients) > 0 && len(tmp.Notify.Email.Recipients) > 0 {
 		return errors.New(`cannot set both "email.recipients" and "notify.email.recipients"`)
@@ -159,8 +227,11 @@ func (p *Parser) parseReport(node *Node) error {
 			}
 		}
 	} else {
+		// Notifications are optional for Google Sheets exports since the sheet itself is the destination
+		// Burst slices have their own recipients
 		if len(tmp.Notify.Email.Recipients) == 0 && len(tmp.Notify.Slack.Channels) == 0 &&
-			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 {
+			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 &&
+			exportFormat != runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS && len(burst.GetSlices()) == 0 {
 			return fmt.Errorf(`missing notification recipients`)
 		}
 		for _, email := range tmp.Notify.Email.Recipients {
@@ -178,7 +249,11 @@ func (p *Parser) parseReport(node *Node) error {
 	}
 
 	// Track report
-	r, err := p.insertResource(ResourceKindReport, node.Name, node.Paths, node.Refs...)
+	refs := node.Refs
+	if snapshotRef != nil {
+		refs = append(refs, *snapshotRef)
+	}
+	r, err := p.insertResource(ResourceKindReport, node.Name, node.Paths, refs...)
 	if err != nil {
 		return err
 	}
@@ -202,6 +277,9 @@ func (p *Parser) parseReport(node *Node) error {
 	r.ReportSpec.QueryArgsJson = tmp.Query.ArgsJSON
 	r.ReportSpec.ExportLimit = uint64(tmp.Export.Limit)
 	r.ReportSpec.ExportFormat = exportFormat
+	r.ReportSpec.ExportGoogleSheets = exportGoogleSheets
+	r.ReportSpec.ExportSnapshot = exportSnapshot
+	r.ReportSpec.Burst = burst
 
 	if isLegacySyntax {
 		// Backwards compatibility
@@ -250,6 +328,86 @@ func (p *Parser) parseReport(node *Node) error {
 	return nil
 }
 
+// parseReportBurst parses and validates the burst options of a report. It returns nil if the report doesn't burst.
+func parseReportBurst(tmp *ReportYAML, exportFormat runtimev1.ExportFormat) (*runtimev1.ReportBurst, error) {
+	b := tmp.Burst
+	if b == nil {
+		return nil, nil
+	}
+
+	// Each slice's output is attached to emails, so it must be a file that's generated with the slice's security claims
+	switch exportFormat {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS, runtimev1.ExportFormat_EXPORT_FORMAT_PDF, runtimev1.ExportFormat_EXPORT_FORMAT_PNG:
+		return nil, fmt.Errorf(`property "burst" is not supported for export format %q`, tmp.Export.Format)
+	}
+	if len(tmp.Notify.Slack.Channels) > 0 || len(tmp.Notify.Slack.Users) > 0 || len(tmp.Notify.Slack.Webhooks) > 0 {
+		return nil, errors.New(`property "burst" can only be used with email notifications`)
+	}
+
+	res := &runtimev1.ReportBurst{
+		PerRecipient: b.PerRecipient,
+		Dimension:    b.Dimension,
+		Concurrency:  b.Concurrency,
+	}
+
+	if b.PerRecipient {
+		if b.Dimension != "" || len(b.Slices) > 0 {
+			return nil, errors.New(`cannot set "burst.dimension" or "burst.slices" when "burst.per_recipient" is true`)
+		}
+		if len(tmp.Email.Recipients) == 0 && len(tmp.Notify.Email.Recipients) == 0 {
+			return nil, errors.New(`"burst.per_recipient" requires email recipients`)
+		}
+		return res, nil
+	}
+
+	if len(b.Slices) == 0 {
+		return nil, errors.New(`must set either "burst.per_recipient" or "burst.slices"`)
+	}
+	for i, s := range b.Slices {
+		if len(s.Recipients) == 0 {
+			return nil, fmt.Errorf(`missing recipients for burst slice at index %d`, i)
+		}
+		for _, email := range s.Recipients {
+			_, err := mail.ParseAddress(email)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recipient email address %q", email)
+			}
+		}
+
+		slice := &runtimev1.ReportBurstSlice{Recipients: s.Recipients}
+		if b.Dimension != "" {
+			if s.Value == nil {
+				return nil, fmt.Errorf(`missing value for burst slice at index %d`, i)
+			}
+			var err error
+			slice.Value, err = structpb.NewValue(s.Value)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid value for burst slice at index %d: %w`, i, err)
+			}
+			if _, ok := slice.Value.Kind.(*structpb.Value_StructValue); ok {
+				return nil, fmt.Errorf(`invalid value for burst slice at index %d: must be a scalar or list`, i)
+			}
+		} else {
+			if s.Value != nil {
+				return nil, fmt.Errorf(`burst slice at index %d has a value, but "burst.dimension" is not set`, i)
+			}
+			if len(s.Attributes) == 0 {
+				return nil, fmt.Errorf(`burst slice at index %d must set "attributes" when "burst.dimension" is not set`, i)
+			}
+		}
+		if len(s.Attributes) > 0 {
+			var err error
+			slice.Attributes, err = structpb.NewStruct(s.Attributes)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid attributes for burst slice at index %d: %w`, i, err)
+			}
+		}
+		res.Slices = append(res.Slices, slice)
+	}
+
+	return res, nil
+}
+
 func parseExportFormat(s string) (runtimev1.ExportFormat, error) {
 	switch strings.ToLower(s) {
 	case "":
@@ -260,6 +418,16 @@ func parseExportFormat(s string) (runtimev1.ExportFormat, error) {
 		return runtimev1.ExportFormat_EXPORT_FORMAT_XLSX, nil
 	case "parquet":
 		return runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET, nil
+	case "jsonl", "ndjson":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_JSONL, nil
+	case "arrow":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_ARROW, nil
+	case "google_sheets":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS, nil
+	case "pdf":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_PDF, nil
+	case "png":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_PNG, nil
 	default:
 		if val, ok := runtimev1.ExportFormat_value[s]; ok {
 			return runtimev1.ExportFormat(val), nil
@@ -5,9 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/mail"
 	"strings"
+	"time"
 
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/slack"
 	"github.com/rilldata/rill/runtime/pkg/env"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"gopkg.in/yaml.v3"
 )
 
@@ -20,11 +25,14 @@ type RillYAML struct {
 	DisplayName   string
 	Description   string
 	OLAPConnector string
+	AIConnector   string
 	Connectors    []*ConnectorDef
 	Variables     []*VariableDef
 	Defaults      map[ResourceKind]yaml.Node
 	FeatureFlags  map[string]bool
 	PublicPaths   []string
+	// NotificationPolicy configures project-level handling of alert notifications. It is nil if not configured.
+	NotificationPolicy *drivers.NotificationPolicy
 }
 
 // ConnectorDef is a subtype of RillYAML, defining connectors required by the project
@@ -56,6 +64,8 @@ type rillYAML struct {
 	Description string `yaml:"description"`
 	// The project's default OLAP connector to use (can be overridden in the individual resources)
 	OLAPConnector string `yaml:"olap_connector"`
+	// The connector to use for AI features (overrides the default AI service of the deployment)
+	AIConnector string `yaml:"ai_connector"`
 	// Connectors required by the project
 	Connectors []struct {
 		Type     string            `yaml:"type"`
@@ -90,6 +100,34 @@ type rillYAML struct {
 	Features yaml.Node `yaml:"features"`
 	// Paths to expose over HTTP (defaults to ./public)
 	PublicPaths []string `yaml:"public_paths"`
+	// Project-level policies for alert notifications
+	Notifications *struct {
+		Digest struct {
+			Window string `yaml:"window"`
+		} `yaml:"digest"`
+		QuietHours *struct {
+			Start    string `yaml:"start"`
+			End      string `yaml:"end"`
+			TimeZone string `yaml:"time_zone"`
+		} `yaml:"quiet_hours"`
+		RateLimits map[string]struct {
+			Limit  int    `yaml:"limit"`
+			Window string `yaml:"window"`
+		} `yaml:"rate_limits"`
+		Escalation *struct {
+			AfterExecutions uint32 `yaml:"after_executions"`
+			Notify          struct {
+				Email struct {
+					Recipients []string `yaml:"recipients"`
+				} `yaml:"email"`
+				Slack struct {
+					Users    []string `yaml:"users"`
+					Channels []string `yaml:"channels"`
+					Webhooks []string `yaml:"webhooks"`
+				} `yaml:"slack"`
+			} `yaml:"notify"`
+		} `yaml:"escalation"`
+	} `yaml:"notifications"`
 	// Paths to ignore when watching for changes.
 	// This is ignored in this parser because it's consumed directly by the repo driver.
 	IgnorePaths []string `yaml:"ignore_paths"`
@@ -249,6 +287,11 @@ func (p *Parser) parseRillYAML(ctx context.Context, path string) error {
 		tmp.PublicPaths = []string{"public"}
 	}
 
+	notificationPolicy, err := parseNotificationPolicy(tmp)
+	if err != nil {
+		return err
+	}
+
 	defaults := map[ResourceKind]yaml.Node{
 		ResourceKindSource:      tmp.Sources,
 		ResourceKindModel:       tmp.Models,
@@ -264,11 +307,14 @@ func (p *Parser) parseRillYAML(ctx context.Context, path string) error {
 		DisplayName:   tmp.DisplayName,
 		Description:   tmp.Description,
 		OLAPConnector: tmp.OLAPConnector,
+		AIConnector:   tmp.AIConnector,
 		Connectors:    make([]*ConnectorDef, len(tmp.Connectors)),
 		Variables:     make([]*VariableDef, len(vars)),
 		Defaults:      defaults,
 		FeatureFlags:  featureFlags,
 		PublicPaths:   tmp.PublicPaths,
+
+		NotificationPolicy: notificationPolicy,
 	}
 
 	for i, c := range tmp.Connectors {
@@ -294,3 +340,102 @@ func (p *Parser) parseRillYAML(ctx context.Context, path string) error {
 	p.RillYAML = res
 	return nil
 }
+
+// parseNotificationPolicy parses the "notifications" property of rill.yaml.
+func parseNotificationPolicy(tmp *rillYAML) (*drivers.NotificationPolicy, error) {
+	n := tmp.Notifications
+	if n == nil {
+		return nil, nil
+	}
+
+	res := &drivers.NotificationPolicy{}
+
+	if n.Digest.Window != "" {
+		d, err := parseDuration(n.Digest.Window)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid property "notifications.digest.window": %w`, err)
+		}
+		if d <= 0 {
+			return nil, errors.New(`invalid property "notifications.digest.window": must be positive`)
+		}
+		res.DigestWindow = d
+	}
+
+	if n.QuietHours != nil {
+		start, err := parseTimeOfDay(n.QuietHours.Start)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid property "notifications.quiet_hours.start": %w`, err)
+		}
+		end, err := parseTimeOfDay(n.QuietHours.End)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid property "notifications.quiet_hours.end": %w`, err)
+		}
+		if start == end {
+			return nil, errors.New(`"notifications.quiet_hours.start" and "notifications.quiet_hours.end" must be different`)
+		}
+		if n.QuietHours.TimeZone != "" {
+			_, err := time.LoadLocation(n.QuietHours.TimeZone)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid property "notifications.quiet_hours.time_zone": %w`, err)
+			}
+		}
+		res.QuietHours = &drivers.QuietHours{Start: start, End: end, TimeZone: n.QuietHours.TimeZone}
+	}
+
+	for connector, rl := range n.RateLimits {
+		if rl.Limit <= 0 {
+			return nil, fmt.Errorf(`invalid property "notifications.rate_limits.%s.limit": must be positive`, connector)
+		}
+		window, err := parseDuration(rl.Window)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid property "notifications.rate_limits.%s.window": %w`, connector, err)
+		}
+		if window <= 0 {
+			return nil, fmt.Errorf(`invalid property "notifications.rate_limits.%s.window": must be positive`, connector)
+		}
+		if res.RateLimits == nil {
+			res.RateLimits = make(map[string]drivers.NotificationRateLimit)
+		}
+		res.RateLimits[connector] = drivers.NotificationRateLimit{Limit: rl.Limit, Window: window}
+	}
+
+	if e := n.Escalation; e != nil {
+		if e.AfterExecutions == 0 {
+			return nil, errors.New(`missing required property "notifications.escalation.after_executions"`)
+		}
+		esc := &drivers.NotificationEscalation{AfterExecutions: e.AfterExecutions}
+		if len(e.Notify.Email.Recipients) > 0 {
+			for _, email := range e.Notify.Email.Recipients {
+				_, err := mail.ParseAddress(email)
+				if err != nil {
+					return nil, fmt.Errorf("invalid escalation email address %q", email)
+				}
+			}
+			esc.Notifiers = append(esc.Notifiers, &drivers.NotificationNotifier{
+				Connector:  "email",
+				Properties: map[string]any{"recipients": pbutil.ToSliceAny(e.Notify.Email.Recipients)},
+			})
+		}
+		if len(e.Notify.Slack.Channels) > 0 || len(e.Notify.Slack.Users) > 0 || len(e.Notify.Slack.Webhooks) > 0 {
+			esc.Notifiers = append(esc.Notifiers, &drivers.NotificationNotifier{
+				Connector:  "slack",
+				Properties: slack.EncodeProps(e.Notify.Slack.Users, e.Notify.Slack.Channels, e.Notify.Slack.Webhooks),
+			})
+		}
+		if len(esc.Notifiers) == 0 {
+			return nil, errors.New(`missing required property "notifications.escalation.notify"`)
+		}
+		res.Escalation = esc
+	}
+
+	return res, nil
+}
+
+// parseTimeOfDay parses a time of day in the format "HH:MM" and returns the number of minutes since midnight.
+func parseTimeOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("expected a time of day in the format HH:MM, got %q", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
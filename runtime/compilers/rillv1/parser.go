@@ -90,6 +90,15 @@ const (
 	ResourceKindConnector
 )
 
+// TestFileType is the value of "type:" in project test files run by "rill test".
+// Test files are not resources, so the parser skips them.
+const TestFileType = "test"
+
+// IsTestFileType returns true if the "type:" of a YAML file marks it as a project test file.
+func IsTestFileType(typ string) bool {
+	return strings.EqualFold(strings.TrimSpace(typ), TestFileType)
+}
+
 // ParseResourceKind maps a string to a ResourceKind.
 // Note: The empty string is considered a valid kind (unspecified).
 func ParseResourceKind(kind string) (ResourceKind, error) {
@@ -692,6 +701,10 @@ func (p *Parser) parseStemPaths(ctx context.Context, paths []string) error {
 
 	// Parse the SQL/YAML file pair to a Node, then parse the Node to p.Resources.
 	node, err := p.parseStem(paths, yamlPath, yaml, sqlPath, sql)
+	if err == nil && node == nil {
+		// The file doesn't declare a resource
+		return nil
+	}
 	if err == nil {
 		err = p.parseNode(ctx, node)
 	}
@@ -9,6 +9,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -27,6 +28,7 @@ func TestRillYAML(t *testing.T) {
 		`rill.yaml`: `
 display_name: Hello world
 description: This project says hello to the world
+ai_connector: ollama
 
 connectors:
 - name: my-s3
@@ -48,6 +50,7 @@ mock_users:
 
 	require.Equal(t, res.DisplayName, "Hello world")
 	require.Equal(t, res.Description, "This project says hello to the world")
+	require.Equal(t, "ollama", res.AIConnector)
 
 	require.Len(t, res.Connectors, 1)
 	require.Equal(t, "my-s3", res.Connectors[0].Name)
@@ -119,6 +122,102 @@ features:
 	}
 }
 
+func TestRillYAMLNotifications(t *testing.T) {
+	tt := []struct {
+		yaml    string
+		want    *drivers.NotificationPolicy
+		wantErr string
+	}{
+		{
+			yaml: ` `,
+			want: nil,
+		},
+		{
+			yaml: `
+notifications:
+  digest:
+    window: 10m
+  quiet_hours:
+    start: "22:00"
+    end: "07:30"
+    time_zone: Europe/Copenhagen
+  rate_limits:
+    slack:
+      limit: 10
+      window: 1h
+  escalation:
+    after_executions: 3
+    notify:
+      email:
+        recipients: [oncall@example.com]
+      slack:
+        channels: [oncall]
+`,
+			want: &drivers.NotificationPolicy{
+				DigestWindow: 10 * time.Minute,
+				QuietHours:   &drivers.QuietHours{Start: 22 * 60, End: 7*60 + 30, TimeZone: "Europe/Copenhagen"},
+				RateLimits:   map[string]drivers.NotificationRateLimit{"slack": {Limit: 10, Window: time.Hour}},
+				Escalation: &drivers.NotificationEscalation{
+					AfterExecutions: 3,
+					Notifiers: []*drivers.NotificationNotifier{
+						{Connector: "email", Properties: map[string]any{"recipients": []any{"oncall@example.com"}}},
+						{Connector: "slack", Properties: map[string]any{"users": []any{}, "channels": []any{"oncall"}, "webhooks": []any{}}},
+					},
+				},
+			},
+		},
+		{
+			yaml: `
+notifications:
+  quiet_hours:
+    start: "10pm"
+    end: "07:00"
+`,
+			wantErr: `invalid property "notifications.quiet_hours.start"`,
+		},
+		{
+			yaml: `
+notifications:
+  rate_limits:
+    slack:
+      window: 1h
+`,
+			wantErr: `invalid property "notifications.rate_limits.slack.limit"`,
+		},
+		{
+			yaml: `
+notifications:
+  escalation:
+    after_executions: 3
+`,
+			wantErr: `missing required property "notifications.escalation.notify"`,
+		},
+	}
+
+	for i, tc := range tt {
+		t.Run(fmt.Sprintf("case=%d", i), func(t *testing.T) {
+			ctx := context.Background()
+			repo := makeRepo(t, map[string]string{
+				`rill.yaml`: tc.yaml,
+			})
+
+			if tc.wantErr != "" {
+				p, err := Parse(ctx, repo, "", "", "duckdb")
+				require.NoError(t, err)
+				require.Len(t, p.Errors, 1)
+				require.Equal(t, "/rill.yaml", p.Errors[0].FilePath)
+				require.Contains(t, p.Errors[0].Message, tc.wantErr)
+				return
+			}
+
+			res, err := ParseRillYAML(ctx, repo, "")
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, res.NotificationPolicy)
+		})
+	}
+}
+
 func TestComplete(t *testing.T) {
 	files := map[string]string{
 		// rill.yaml
@@ -1264,6 +1363,89 @@ security:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestMetricsViewMinGroupSize(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`metrics/d1.yaml`: `
+version: 1
+type: metrics_view
+table: t1
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+security:
+  access: true
+  min_group_size: 10
+  noise: 0.05
+  rules:
+    - type: min_group_size
+      if: "'{{ .user.domain }}' != 'example.com'"
+      min_group_size: 50
+`,
+		`metrics/d2.yaml`: `
+version: 1
+type: metrics_view
+table: t1
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+security:
+  access: true
+  noise: 2
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/metrics/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector:   "duckdb",
+				Table:       "t1",
+				DisplayName: "D1",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "a", DisplayName: "A", Column: "a"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "b", DisplayName: "B", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+				},
+				SecurityRules: []*runtimev1.SecurityRule{
+					{Rule: &runtimev1.SecurityRule_Access{Access: &runtimev1.SecurityRuleAccess{
+						Condition: "true",
+						Allow:     true,
+					}}},
+					{Rule: &runtimev1.SecurityRule_MinGroupSize{MinGroupSize: &runtimev1.SecurityRuleMinGroupSize{
+						MinGroupSize: 10,
+						Noise:        0.05,
+					}}},
+					{Rule: &runtimev1.SecurityRule_MinGroupSize{MinGroupSize: &runtimev1.SecurityRuleMinGroupSize{
+						Condition:    "'{{ .user.domain }}' != 'example.com'",
+						MinGroupSize: 50,
+					}}},
+				},
+			},
+		},
+	}
+
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  "'noise' must be a number between 0 and 1",
+			FilePath: "/metrics/d2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errs)
+}
+
 func TestReport(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1334,6 +1516,107 @@ notify:
 
 annotations:
   foo: bar
+`,
+		`reports/r3.yaml`: `
+type: report
+refresh:
+  cron: 0 * * * *
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: google_sheets
+  google_sheets:
+    spreadsheet_id: abc123
+    sheet: Weekly
+`,
+		`reports/r4.yaml`: `
+type: report
+refresh:
+  cron: 0 * * * *
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: google_sheets
+`,
+		`reports/r5.yaml`: `
+type: report
+refresh:
+  cron: 0 9 * * 1
+export:
+  format: pdf
+  snapshot:
+    canvas: c1
+    width: 1200
+notify:
+  email:
+    recipients: [exec@example.com]
+`,
+		`reports/r6.yaml`: `
+type: report
+refresh:
+  cron: 0 9 * * 1
+export:
+  format: png
+notify:
+  email:
+    recipients: [exec@example.com]
+`,
+		`reports/r7.yaml`: `
+type: report
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: xlsx
+burst:
+  dimension: region
+  concurrency: 2
+  slices:
+    - value: EMEA
+      recipients: [emea@example.com]
+    - value: [NA, LATAM]
+      attributes:
+        admin: false
+      recipients: [americas@example.com]
+`,
+		`reports/r8.yaml`: `
+type: report
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+burst:
+  per_recipient: true
+notify:
+  email:
+    recipients: [user_1@example.com, user_2@example.com]
+`,
+		`reports/r9.yaml`: `
+type: report
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+burst:
+  per_recipient: true
+notify:
+  slack:
+    channels: [reports]
 `,
 	})
 
@@ -1386,11 +1669,110 @@ annotations:
 				IntervalsLimit:       10,
 			},
 		},
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r3"},
+			Paths: []string{"/reports/r3.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				DisplayName: "R3",
+				RefreshSchedule: &runtimev1.Schedule{
+					RefUpdate: true,
+					Cron:      "0 * * * *",
+				},
+				QueryName:     "MetricsViewAggregation",
+				QueryArgsJson: `{"metrics_view":"mv1"}`,
+				ExportFormat:  runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS,
+				ExportGoogleSheets: &runtimev1.GoogleSheetsExportOptions{
+					SpreadsheetId: "abc123",
+					Sheet:         "Weekly",
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r5"},
+			Paths: []string{"/reports/r5.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindCanvas, Name: "c1"}},
+			ReportSpec: &runtimev1.ReportSpec{
+				DisplayName: "R5",
+				RefreshSchedule: &runtimev1.Schedule{
+					RefUpdate: true,
+					Cron:      "0 9 * * 1",
+				},
+				ExportFormat: runtimev1.ExportFormat_EXPORT_FORMAT_PDF,
+				ExportSnapshot: &runtimev1.ReportSnapshotOptions{
+					Canvas: "c1",
+					Width:  1200,
+				},
+				Notifiers: []*runtimev1.Notifier{{
+					Connector:  "email",
+					Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"exec@example.com"}})),
+				}},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r7"},
+			Paths: []string{"/reports/r7.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				DisplayName: "R7",
+				RefreshSchedule: &runtimev1.Schedule{
+					RefUpdate: true,
+					Cron:      "0 9 * * 1",
+				},
+				QueryName:     "MetricsViewAggregation",
+				QueryArgsJson: `{"metrics_view":"mv1"}`,
+				ExportFormat:  runtimev1.ExportFormat_EXPORT_FORMAT_XLSX,
+				Burst: &runtimev1.ReportBurst{
+					Dimension:   "region",
+					Concurrency: 2,
+					Slices: []*runtimev1.ReportBurstSlice{
+						{Value: structpb.NewStringValue("EMEA"), Recipients: []string{"emea@example.com"}},
+						{
+							Value:      must(structpb.NewValue([]any{"NA", "LATAM"})),
+							Attributes: must(structpb.NewStruct(map[string]any{"admin": false})),
+							Recipients: []string{"americas@example.com"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r8"},
+			Paths: []string{"/reports/r8.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				DisplayName: "R8",
+				RefreshSchedule: &runtimev1.Schedule{
+					RefUpdate: true,
+					Cron:      "0 9 * * 1",
+				},
+				QueryName:     "MetricsViewAggregation",
+				QueryArgsJson: `{"metrics_view":"mv1"}`,
+				ExportFormat:  runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+				Burst:         &runtimev1.ReportBurst{PerRecipient: true},
+				Notifiers: []*runtimev1.Notifier{{
+					Connector:  "email",
+					Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"user_1@example.com", "user_2@example.com"}})),
+				}},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `missing required property "export.google_sheets.spreadsheet_id"`,
+			FilePath: "/reports/r4.yaml",
+		},
+		{
+			Message:  `must set exactly one of "export.snapshot.canvas" or "export.snapshot.explore"`,
+			FilePath: "/reports/r6.yaml",
+		},
+		{
+			Message:  `property "burst" can only be used with email notifications`,
+			FilePath: "/reports/r9.yaml",
+		},
 	}
 
 	p, err := Parse(ctx, repo, "", "", "duckdb")
 	require.NoError(t, err)
-	requireResourcesAndErrors(t, p, resources, nil)
+	requireResourcesAndErrors(t, p, resources, errors)
 }
 
 func TestAlert(t *testing.T) {
@@ -1804,6 +2186,211 @@ layout:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestCanvasFilters(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`canvases/d1.yaml`: `
+type: canvas
+filters:
+  dimensions:
+  - dimension: country
+    default: US
+  - name: devices
+    metrics_view: mv1
+    dimension: device
+    default: [mobile, desktop]
+  measures:
+  - measure: revenue
+    operator: gte
+    default: 1000
+items:
+- component:
+    output:
+      name: country
+    bar_chart:
+      metrics_view: mv1
+`,
+		`canvases/d2.yaml`: `
+type: canvas
+filters:
+  dimensions:
+  - name: time_range
+    dimension: country
+`,
+		`canvases/d3.yaml`: `
+type: canvas
+filters:
+  measures:
+  - measure: revenue
+    operator: between
+`,
+		`canvases/d4.yaml`: `
+type: canvas
+filters:
+  measures:
+  - measure: revenue
+    operator: gt
+items:
+- component:
+    output:
+      name: revenue
+    bar_chart:
+      metrics_view: mv1
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindComponent, Name: "d1--component-0"},
+			Paths: []string{"/canvases/d1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			ComponentSpec: &runtimev1.ComponentSpec{
+				Renderer:           "bar_chart",
+				RendererProperties: must(structpb.NewStruct(map[string]any{"metrics_view": "mv1"})),
+				Output:             &runtimev1.ComponentVariable{Name: "country", DefaultValue: structpb.NewNullValue()},
+				DefinedInCanvas:    true,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindCanvas, Name: "d1"},
+			Paths: []string{"/canvases/d1.yaml"},
+			Refs: []ResourceName{
+				{Kind: ResourceKindMetricsView, Name: "mv1"},
+				{Kind: ResourceKindComponent, Name: "d1--component-0"},
+			},
+			CanvasSpec: &runtimev1.CanvasSpec{
+				DisplayName:    "D1",
+				FiltersEnabled: true,
+				Filters: []*runtimev1.CanvasFilter{
+					{
+						Name:         "country",
+						Dimension:    "country",
+						DefaultValue: must(structpb.NewValue([]any{"US"})),
+					},
+					{
+						Name:         "devices",
+						MetricsView:  "mv1",
+						Dimension:    "device",
+						DefaultValue: must(structpb.NewValue([]any{"mobile", "desktop"})),
+					},
+					{
+						Name:         "revenue",
+						Measure:      "revenue",
+						Operator:     runtimev1.Operation_OPERATION_GTE,
+						DefaultValue: structpb.NewNumberValue(1000),
+					},
+				},
+				Items: []*runtimev1.CanvasItem{
+					{Component: "d1--component-0", DefinedInCanvas: true},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `filter name "time_range" is reserved`,
+			FilePath: "/canvases/d2.yaml",
+		},
+		{
+			Message:  `invalid operator "between" for filter "revenue" (options: eq, gt, gte, lt, lte, neq)`,
+			FilePath: "/canvases/d3.yaml",
+		},
+		{
+			Message:  `can't set measure filter "revenue"`,
+			FilePath: "/canvases/d4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestComponentData(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`components/c1.yaml`: `
+type: component
+data:
+  metrics_join:
+    queries:
+    - metrics_view: ad_spend
+      measures: [cost]
+    - metrics_view: conversions
+      measures: [conversions]
+    dimensions: [campaign]
+    time_grain: day
+    measures:
+    - name: cost_per_conversion
+      expression: cost / conversions
+kpi:
+  measure: cost_per_conversion
+`,
+		`components/c2.yaml`: `
+type: component
+data:
+  metrics_join:
+    queries: []
+  sql: SELECT 1
+kpi:
+  measure: foo
+`,
+		`components/c3.yaml`: `
+type: component
+data:
+  sql: SELECT * FROM raw_events
+kpi:
+  measure: foo
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindComponent, Name: "c1"},
+			Paths: []string{"/components/c1.yaml"},
+			Refs: []ResourceName{
+				{Kind: ResourceKindMetricsView, Name: "ad_spend"},
+				{Kind: ResourceKindMetricsView, Name: "conversions"},
+			},
+			ComponentSpec: &runtimev1.ComponentSpec{
+				DisplayName:        "C1",
+				Renderer:           "kpi",
+				RendererProperties: must(structpb.NewStruct(map[string]any{"measure": "cost_per_conversion"})),
+				Resolver:           "metrics_join",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{
+					"queries": []any{
+						map[string]any{"metrics_view": "ad_spend", "measures": []any{"cost"}},
+						map[string]any{"metrics_view": "conversions", "measures": []any{"conversions"}},
+					},
+					"dimensions": []any{"campaign"},
+					"time_grain": "day",
+					"measures": []any{
+						map[string]any{"name": "cost_per_conversion", "expression": "cost / conversions"},
+					},
+				})),
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `failed to parse "data"`,
+			FilePath: "/components/c2.yaml",
+		},
+		{
+			Message:  `components can only use "metrics_sql" or "metrics_join"`,
+			FilePath: "/components/c3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestAPI(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -2153,6 +2740,148 @@ refresh:
 	requireResourcesAndErrors(t, p, []*Resource{m1, m2}, nil)
 }
 
+func TestModelContract(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`m1.yaml`: `
+type: model
+sql: SELECT 1 AS id, 'a' AS name
+contract:
+  columns:
+  - name: id
+    type: BIGINT
+  - name: name
+`,
+		`m2.yaml`: `
+type: model
+sql: SELECT 1 AS id
+contract:
+  mode: warn
+  allow_extra_columns: true
+  columns:
+  - name: id
+    type: int32
+`,
+		`m3.yaml`: `
+type: model
+sql: SELECT 1 AS id
+contract:
+  mode: strict
+  columns:
+  - name: id
+`,
+		`m4.yaml`: `
+type: model
+sql: SELECT 1 AS id
+contract:
+  columns:
+  - name: id
+    type: NUMBERISH
+`,
+		`m5.yaml`: `
+type: model
+sql: SELECT 1 AS id
+incremental: true
+contract:
+  columns:
+  - name: id
+`,
+	})
+
+	m1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+		Paths: []string{"/m1.yaml"},
+		ModelSpec: &runtimev1.ModelSpec{
+			RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+			InputConnector:  "duckdb",
+			InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT 1 AS id, 'a' AS name`})),
+			OutputConnector: "duckdb",
+			Contract: &runtimev1.ModelContract{
+				Enforced: true,
+				Columns: []*runtimev1.ModelContractColumn{
+					{Name: "id", Type: runtimev1.Type_CODE_INT64},
+					{Name: "name"},
+				},
+			},
+		},
+	}
+
+	m2 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindModel, Name: "m2"},
+		Paths: []string{"/m2.yaml"},
+		ModelSpec: &runtimev1.ModelSpec{
+			RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+			InputConnector:  "duckdb",
+			InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT 1 AS id`})),
+			OutputConnector: "duckdb",
+			Contract: &runtimev1.ModelContract{
+				AllowExtraColumns: true,
+				Columns: []*runtimev1.ModelContractColumn{
+					{Name: "id", Type: runtimev1.Type_CODE_INT32},
+				},
+			},
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{m1, m2}, []*runtimev1.ParseError{
+		{
+			Message:  `invalid mode "strict"`,
+			FilePath: "/m3.yaml",
+		},
+		{
+			Message:  `unsupported type "NUMBERISH"`,
+			FilePath: "/m4.yaml",
+		},
+		{
+			Message:  `enforced contracts are not supported for incremental models`,
+			FilePath: "/m5.yaml",
+		},
+	})
+}
+
+func TestTestFiles(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// model m1 in a directory named "tests", which should still be parsed
+		`tests/m1.sql`: `SELECT 1`,
+		// test file for "rill test", which is marked with "type: test" and should not be parsed
+		`tests/m1_test.yaml`: `
+type: test
+mocks:
+  m1:
+    rows:
+      - id: 1
+tests:
+  - name: foo
+`,
+		// test files can be placed anywhere
+		`checks/m1_test.yml`: `
+type: Test
+tests:
+  - name: bar
+`,
+	})
+
+	m1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+		Paths: []string{"/tests/m1.sql"},
+		ModelSpec: &runtimev1.ModelSpec{
+			RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+			InputConnector:  "duckdb",
+			InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT 1`})),
+			OutputConnector: "duckdb",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{m1}, nil)
+}
+
 func TestConnector(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{`rill.yaml`: ``})
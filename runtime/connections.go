@@ -108,11 +108,12 @@ func (r *Runtime) AI(ctx context.Context, instanceID string) (drivers.AIService,
 	}
 
 	// The AI connector is optional
-	if inst.AIConnector == "" {
+	aiConnector := inst.ResolveAIConnector()
+	if aiConnector == "" {
 		return nil, nil, ErrAINotConfigured
 	}
 
-	conn, release, err := r.AcquireHandle(ctx, instanceID, inst.AIConnector)
+	conn, release, err := r.AcquireHandle(ctx, instanceID, aiConnector)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -120,7 +121,7 @@ func (r *Runtime) AI(ctx context.Context, instanceID string) (drivers.AIService,
 	ai, ok := conn.AsAI(instanceID)
 	if !ok {
 		release()
-		return nil, nil, fmt.Errorf("connector %q is not a valid AI service", inst.AIConnector)
+		return nil, nil, fmt.Errorf("connector %q is not a valid AI service", aiConnector)
 	}
 
 	return ai, release, nil
@@ -6,20 +6,30 @@ import (
 )
 
 type AdminService interface {
-	GetReportMetadata(ctx context.Context, reportName, ownerID string, emailRecipients []string, executionTime time.Time) (*ReportMetadata, error)
+	GetReportMetadata(ctx context.Context, reportName, ownerID string, emailRecipients []string, recipientAttributes bool, snapshotType, snapshotResource string, executionTime time.Time) (*ReportMetadata, error)
 	GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*AlertMetadata, error)
 	ProvisionConnector(ctx context.Context, name, driver string, args map[string]any) (map[string]any, error)
 }
 
 type ReportMetadata struct {
-	BaseURLs      ReportURLs
-	RecipientURLs map[string]ReportURLs
+	BaseURLs           ReportURLs
+	RecipientURLs      map[string]ReportURLs
+	QueryForAttributes map[string]any
+	// RecipientAttributes contains the security attributes of each email recipient, if requested.
+	RecipientAttributes map[string]map[string]any
+	// RecipientAttributeErrors contains an error message for each email recipient whose attributes could not be resolved, if attributes were requested.
+	RecipientAttributeErrors map[string]string
+	// RecipientSnapshotURLs contains a snapshot URL for each email recipient who is a member of the project, if a snapshot was requested.
+	RecipientSnapshotURLs map[string]string
 }
 
 type ReportURLs struct {
 	OpenURL   string
 	ExportURL string
 	EditURL   string
+	// SnapshotURL is the URL to render a snapshot of the report's canvas or explore from, if a snapshot was requested.
+	// It embeds a short-lived access token for the report's owner.
+	SnapshotURL string
 }
 
 type AlertMetadata struct {
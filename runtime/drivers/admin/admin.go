@@ -14,6 +14,7 @@ import (
 	"github.com/eapache/go-resiliency/retrier"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/admin/client"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -21,6 +22,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/archive"
 	"github.com/rilldata/rill/runtime/pkg/ctxsync"
+	"github.com/rilldata/rill/runtime/pkg/gitutil"
 	"github.com/rilldata/rill/runtime/storage"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -122,6 +124,8 @@ type Handle struct {
 	// These will not be set if archiveDownloadURL is set
 	gitURL          string
 	gitURLExpiresOn time.Time
+	// gitAuth is set for SSH remotes, which authenticate with a deploy key instead of credentials in the URL
+	gitAuth transport.AuthMethod
 
 	// archiveDownloadURL is set when using one-time uploads
 	archiveDownloadURL string
@@ -415,6 +419,13 @@ func (h *Handle) checkHandshake(ctx context.Context) error {
 	}
 
 	h.gitURL = meta.GitUrl
+	h.gitAuth = nil
+	if meta.GitSshPrivateKey != "" {
+		h.gitAuth, err = gitutil.SSHAuthMethod(meta.GitUrl, meta.GitSshPrivateKey, meta.GitSshHostKey)
+		if err != nil {
+			return err
+		}
+	}
 	if meta.GitUrlExpiresOn != nil {
 		h.gitURLExpiresOn = meta.GitUrlExpiresOn.AsTime()
 	} else {
@@ -435,6 +446,7 @@ func (h *Handle) cloneGit() error {
 
 	_, err = git.PlainClone(h.repoPath, false, &git.CloneOptions{
 		URL:           h.gitURL,
+		Auth:          h.gitAuth,
 		ReferenceName: plumbing.NewBranchReferenceName(h.config.Branch),
 		SingleBranch:  true,
 	})
@@ -456,6 +468,7 @@ func (h *Handle) pullGit() error {
 
 	err = wt.Pull(&git.PullOptions{
 		RemoteURL:     h.gitURL,
+		Auth:          h.gitAuth,
 		ReferenceName: plumbing.NewBranchReferenceName(h.config.Branch),
 		SingleBranch:  true,
 		Force:         true,
@@ -10,14 +10,17 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (h *Handle) GetReportMetadata(ctx context.Context, reportName, ownerID string, emailRecipients []string, executionTime time.Time) (*drivers.ReportMetadata, error) {
+func (h *Handle) GetReportMetadata(ctx context.Context, reportName, ownerID string, emailRecipients []string, recipientAttributes bool, snapshotType, snapshotResource string, executionTime time.Time) (*drivers.ReportMetadata, error) {
 	res, err := h.admin.GetReportMeta(ctx, &adminv1.GetReportMetaRequest{
-		ProjectId:       h.config.ProjectID,
-		Branch:          h.config.Branch,
-		Report:          reportName,
-		OwnerId:         ownerID,
-		EmailRecipients: emailRecipients,
-		ExecutionTime:   timestamppb.New(executionTime),
+		ProjectId:           h.config.ProjectID,
+		Branch:              h.config.Branch,
+		Report:              reportName,
+		OwnerId:             ownerID,
+		EmailRecipients:     emailRecipients,
+		RecipientAttributes: recipientAttributes,
+		SnapshotType:        snapshotType,
+		SnapshotResource:    snapshotResource,
+		ExecutionTime:       timestamppb.New(executionTime),
 	})
 	if err != nil {
 		return nil, err
@@ -32,14 +35,30 @@ func (h *Handle) GetReportMetadata(ctx context.Context, reportName, ownerID stri
 		}
 	}
 
-	return &drivers.ReportMetadata{
+	meta := &drivers.ReportMetadata{
 		BaseURLs: drivers.ReportURLs{
-			OpenURL:   res.BaseUrls.OpenUrl,
-			ExportURL: res.BaseUrls.ExportUrl,
-			EditURL:   res.BaseUrls.EditUrl,
+			OpenURL:     res.BaseUrls.OpenUrl,
+			ExportURL:   res.BaseUrls.ExportUrl,
+			EditURL:     res.BaseUrls.EditUrl,
+			SnapshotURL: res.BaseUrls.SnapshotUrl,
 		},
-		RecipientURLs: recipientURLs,
-	}, nil
+		RecipientURLs:            recipientURLs,
+		RecipientSnapshotURLs:    res.RecipientSnapshotUrls,
+		RecipientAttributeErrors: res.RecipientAttributeErrors,
+	}
+
+	if res.QueryForAttributes != nil {
+		meta.QueryForAttributes = res.QueryForAttributes.AsMap()
+	}
+
+	if len(res.RecipientAttributes) > 0 {
+		meta.RecipientAttributes = make(map[string]map[string]any, len(res.RecipientAttributes))
+		for k, v := range res.RecipientAttributes {
+			meta.RecipientAttributes[k] = v.AsMap()
+		}
+	}
+
+	return meta, nil
 }
 
 func (h *Handle) GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*drivers.AlertMetadata, error) {
@@ -2,21 +2,38 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 )
 
-func (h *Handle) Complete(ctx context.Context, msgs []*drivers.CompletionMessage) (*drivers.CompletionMessage, error) {
+func (h *Handle) Complete(ctx context.Context, msgs []*drivers.CompletionMessage, tools []*drivers.CompletionTool) (*drivers.CompletionMessage, error) {
 	reqMsgs := make([]*adminv1.CompletionMessage, len(msgs))
 	for i, msg := range msgs {
-		reqMsgs[i] = &adminv1.CompletionMessage{Role: msg.Role, Data: msg.Data}
+		reqMsgs[i] = &adminv1.CompletionMessage{Role: msg.Role, Data: msg.Data, ToolCallId: msg.ToolCallID}
+		for _, call := range msg.ToolCalls {
+			reqMsgs[i].ToolCalls = append(reqMsgs[i].ToolCalls, &adminv1.ToolCall{Id: call.ID, Name: call.Name, Input: call.Input})
+		}
 	}
 
-	res, err := h.admin.Complete(ctx, &adminv1.CompleteRequest{Messages: reqMsgs})
+	reqTools := make([]*adminv1.Tool, len(tools))
+	for i, tool := range tools {
+		schema, err := json.Marshal(tool.InputSchema)
+		if err != nil {
+			return nil, err
+		}
+		reqTools[i] = &adminv1.Tool{Name: tool.Name, Description: tool.Description, InputSchema: string(schema)}
+	}
+
+	res, err := h.admin.Complete(ctx, &adminv1.CompleteRequest{Messages: reqMsgs, Tools: reqTools})
 	if err != nil {
 		return nil, err
 	}
 
-	return &drivers.CompletionMessage{Role: res.Message.Role, Data: res.Message.Data}, nil
+	resMsg := &drivers.CompletionMessage{Role: res.Message.Role, Data: res.Message.Data}
+	for _, call := range res.Message.ToolCalls {
+		resMsg.ToolCalls = append(resMsg.ToolCalls, &drivers.CompletionToolCall{ID: call.Id, Name: call.Name, Input: call.Input})
+	}
+	return resMsg, nil
 }
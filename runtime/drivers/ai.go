@@ -5,10 +5,31 @@ import (
 )
 
 type AIService interface {
-	Complete(ctx context.Context, msgs []*CompletionMessage) (*CompletionMessage, error)
+	// Complete sends the messages of a chat to the AI and asks it to generate a new message.
+	// If tools are provided, the AI may respond with calls to the tools instead of text.
+	// The results of tool calls should be sent back as messages with role "tool" and a ToolCallID.
+	Complete(ctx context.Context, msgs []*CompletionMessage, tools []*CompletionTool) (*CompletionMessage, error)
 }
 
 type CompletionMessage struct {
-	Role string
-	Data string
+	Role       string
+	Data       string
+	ToolCalls  []*CompletionToolCall
+	ToolCallID string
+}
+
+// CompletionTool is a tool that the AI may call.
+type CompletionTool struct {
+	Name        string
+	Description string
+	// InputSchema is a JSON schema for the tool's input.
+	InputSchema map[string]any
+}
+
+// CompletionToolCall is a call to a tool requested by the AI.
+type CompletionToolCall struct {
+	ID   string
+	Name string
+	// Input is the JSON-encoded input for the tool.
+	Input string
 }
@@ -50,6 +50,21 @@ type CatalogStore interface {
 
 	FindInstanceHealth(ctx context.Context, instanceID string) (*InstanceHealth, error)
 	UpsertInstanceHealth(ctx context.Context, h *InstanceHealth) error
+
+	FindAIConversations(ctx context.Context, opts *FindAIConversationsOptions) ([]*AIConversation, error)
+	FindAIConversation(ctx context.Context, conversationID string) (*AIConversation, error)
+	InsertAIConversation(ctx context.Context, c *AIConversation) error
+	DeleteAIConversation(ctx context.Context, conversationID string) error
+	FindAIMessages(ctx context.Context, conversationID string) ([]*AIMessage, error)
+	InsertAIMessage(ctx context.Context, m *AIMessage) error
+
+	FindPendingNotifications(ctx context.Context) ([]*Notification, error)
+	CountSentNotifications(ctx context.Context, connector string, sentAfter time.Time) (int, error)
+	InsertNotification(ctx context.Context, n *Notification) error
+	ClaimDueNotifications(ctx context.Context, deliverBefore, leaseUntil time.Time) ([]*Notification, error)
+	UpdateNotificationDeliverOn(ctx context.Context, id string, deliverOn time.Time) error
+	DeleteNotifications(ctx context.Context, ids []string) error
+	DeleteSentNotifications(ctx context.Context, sentBefore time.Time) error
 }
 
 // Resource is an entry in a catalog store
@@ -97,3 +112,59 @@ type InstanceHealth struct {
 	HealthJSON []byte    `db:"health_json"`
 	UpdatedOn  time.Time `db:"updated_on"`
 }
+
+// FindAIConversationsOptions is used to filter and paginate AI conversations.
+// Conversations are ordered by most recently updated first, so the After fields select older conversations.
+type FindAIConversationsOptions struct {
+	OwnerID        string
+	Limit          int
+	AfterUpdatedOn time.Time
+	AfterID        string
+}
+
+// AIConversation represents a conversation between a user and an AI agent.
+type AIConversation struct {
+	ID        string
+	OwnerID   string
+	Title     string
+	CreatedOn time.Time
+	UpdatedOn time.Time
+}
+
+// AIMessage represents a message in an AI conversation.
+// Messages are ordered by Index within a conversation.
+type AIMessage struct {
+	ID             string
+	ConversationID string
+	Index          int
+	Role           string
+	Data           string
+	ToolCalls      []*CompletionToolCall
+	ToolCallID     string
+	CreatedOn      time.Time
+}
+
+// Notification is an entry in the log of notifications sent by alerts.
+// Notifications that are held back by a notification policy are stored as pending until they are due for delivery.
+// Pending notifications are claimed for delivery with a lease by moving their delivery time to when the lease expires, so they are delivered again if the claimer fails before completing the delivery.
+// Once delivered, they are removed and the delivered message (which may be a digest of several notifications) is logged as a single sent notification.
+// Since all alerts in an instance share the log, it is used to enforce rate limits and group notifications into digests.
+type Notification struct {
+	ID string
+	// Source is the name of the alert that created the notification.
+	Source string
+	// Connector is the notifier connector to deliver the notification with, such as "email" or "slack".
+	Connector string
+	// PropertiesJSON is the serialized properties of the notifier. Due notifications with the same connector and properties are delivered together.
+	PropertiesJSON []byte
+	// DataJSON is the serialized notification message.
+	DataJSON []byte
+	// CreatedOn is when the notification was created.
+	CreatedOn time.Time
+	// DeliverOn is when a pending notification is due for delivery.
+	DeliverOn time.Time
+	// SentOn is when the notification was sent. If it is nil, the notification is pending.
+	SentOn *time.Time
+	// Attempts is the number of times a pending notification has been claimed for delivery.
+	Attempts int
+}
@@ -13,6 +13,8 @@ import (
 
 func testCatalog(t *testing.T, catalog drivers.CatalogStore) {
 	t.Run("Partitions", func(t *testing.T) { testCatalogPartitions(t, catalog) })
+	t.Run("AIConversations", func(t *testing.T) { testCatalogAIConversations(t, catalog) })
+	t.Run("Notifications", func(t *testing.T) { testCatalogNotifications(t, catalog) })
 }
 
 func testCatalogPartitions(t *testing.T, catalog drivers.CatalogStore) {
@@ -60,6 +62,208 @@ func testCatalogPartitions(t *testing.T, catalog drivers.CatalogStore) {
 	require.NoError(t, err)
 }
 
+func testCatalogAIConversations(t *testing.T, catalog drivers.CatalogStore) {
+	ctx := context.Background()
+	ownerID := uuid.NewString()
+
+	now, err := time.Parse(time.RFC3339, "2021-01-01T00:00:00Z")
+	require.NoError(t, err)
+
+	convs, err := catalog.FindAIConversations(ctx, &drivers.FindAIConversationsOptions{OwnerID: ownerID})
+	if errors.Is(err, drivers.ErrNotImplemented) {
+		t.Skip("AI conversations not implemented")
+	}
+	require.NoError(t, err)
+	require.Len(t, convs, 0)
+
+	conv := &drivers.AIConversation{
+		ID:        uuid.NewString(),
+		OwnerID:   ownerID,
+		Title:     "Hello",
+		CreatedOn: now,
+		UpdatedOn: now,
+	}
+	err = catalog.InsertAIConversation(ctx, conv)
+	require.NoError(t, err)
+
+	err = catalog.InsertAIMessage(ctx, &drivers.AIMessage{
+		ID:             uuid.NewString(),
+		ConversationID: conv.ID,
+		Index:          0,
+		Role:           "user",
+		Data:           "Hello",
+		CreatedOn:      now,
+	})
+	require.NoError(t, err)
+
+	err = catalog.InsertAIMessage(ctx, &drivers.AIMessage{
+		ID:             uuid.NewString(),
+		ConversationID: conv.ID,
+		Index:          1,
+		Role:           "assistant",
+		ToolCalls:      []*drivers.CompletionToolCall{{ID: "call_1", Name: "list_metrics_views", Input: "{}"}},
+		CreatedOn:      now.Add(time.Second),
+	})
+	require.NoError(t, err)
+
+	convs, err = catalog.FindAIConversations(ctx, &drivers.FindAIConversationsOptions{OwnerID: ownerID})
+	require.NoError(t, err)
+	require.Len(t, convs, 1)
+	require.Equal(t, "Hello", convs[0].Title)
+	require.True(t, now.Add(time.Second).Equal(convs[0].UpdatedOn))
+
+	// Paginate through conversations, most recently updated first
+	conv2 := &drivers.AIConversation{
+		ID:        uuid.NewString(),
+		OwnerID:   ownerID,
+		Title:     "World",
+		CreatedOn: now.Add(time.Minute),
+		UpdatedOn: now.Add(time.Minute),
+	}
+	err = catalog.InsertAIConversation(ctx, conv2)
+	require.NoError(t, err)
+
+	convs, err = catalog.FindAIConversations(ctx, &drivers.FindAIConversationsOptions{OwnerID: ownerID, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, convs, 1)
+	require.Equal(t, conv2.ID, convs[0].ID)
+
+	convs, err = catalog.FindAIConversations(ctx, &drivers.FindAIConversationsOptions{OwnerID: ownerID, Limit: 1, AfterUpdatedOn: convs[0].UpdatedOn, AfterID: convs[0].ID})
+	require.NoError(t, err)
+	require.Len(t, convs, 1)
+	require.Equal(t, conv.ID, convs[0].ID)
+
+	err = catalog.DeleteAIConversation(ctx, conv2.ID)
+	require.NoError(t, err)
+
+	msgs, err := catalog.FindAIMessages(ctx, conv.ID)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, "Hello", msgs[0].Data)
+	require.Len(t, msgs[0].ToolCalls, 0)
+	require.Len(t, msgs[1].ToolCalls, 1)
+	require.Equal(t, "list_metrics_views", msgs[1].ToolCalls[0].Name)
+
+	err = catalog.DeleteAIConversation(ctx, conv.ID)
+	require.NoError(t, err)
+
+	_, err = catalog.FindAIConversation(ctx, conv.ID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	msgs, err = catalog.FindAIMessages(ctx, conv.ID)
+	require.NoError(t, err)
+	require.Len(t, msgs, 0)
+}
+
+func testCatalogNotifications(t *testing.T, catalog drivers.CatalogStore) {
+	ctx := context.Background()
+	source := uuid.NewString()
+
+	now := time.Now().Truncate(time.Second)
+
+	pending, err := catalog.FindPendingNotifications(ctx)
+	if errors.Is(err, drivers.ErrNotImplemented) {
+		t.Skip("Notifications not implemented")
+	}
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+
+	// A sent notification counts towards rate limits
+	sentOn := now.Add(-time.Minute)
+	err = catalog.InsertNotification(ctx, &drivers.Notification{
+		ID:             uuid.NewString(),
+		Source:         source,
+		Connector:      "slack",
+		PropertiesJSON: []byte(`{"channels":["alerts"]}`),
+		DataJSON:       []byte(`{}`),
+		CreatedOn:      sentOn,
+		DeliverOn:      sentOn,
+		SentOn:         &sentOn,
+	})
+	require.NoError(t, err)
+
+	n, err := catalog.CountSentNotifications(ctx, "slack", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	n, err = catalog.CountSentNotifications(ctx, "email", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// Queue one notification that is due and one that isn't
+	for i, deliverOn := range []time.Time{now.Add(time.Hour), now.Add(-time.Second)} {
+		err = catalog.InsertNotification(ctx, &drivers.Notification{
+			ID:             uuid.NewString(),
+			Source:         source,
+			Connector:      "slack",
+			PropertiesJSON: []byte(`{"channels":["alerts"]}`),
+			DataJSON:       []byte(`{}`),
+			CreatedOn:      now.Add(time.Duration(i) * time.Second),
+			DeliverOn:      deliverOn,
+		})
+		require.NoError(t, err)
+	}
+
+	pending, err = catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.True(t, now.Add(-time.Second).Equal(pending[0].DeliverOn))
+	require.Nil(t, pending[0].SentOn)
+
+	// Only the due notification is claimed, and only once until the lease expires
+	leaseUntil := now.Add(10 * time.Minute)
+	claimed, err := catalog.ClaimDueNotifications(ctx, now, leaseUntil)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, source, claimed[0].Source)
+	require.Equal(t, 1, claimed[0].Attempts)
+
+	claimed, err = catalog.ClaimDueNotifications(ctx, now, leaseUntil)
+	require.NoError(t, err)
+	require.Len(t, claimed, 0)
+
+	// Claimed notifications are still pending until the lease expires, and can then be claimed again
+	pending, err = catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.True(t, leaseUntil.Equal(pending[0].DeliverOn))
+
+	claimed, err = catalog.ClaimDueNotifications(ctx, leaseUntil, leaseUntil.Add(10*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, 2, claimed[0].Attempts)
+
+	// Requeue the claimed notification after a failed delivery
+	err = catalog.UpdateNotificationDeliverOn(ctx, claimed[0].ID, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	pending, err = catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.True(t, now.Add(2*time.Hour).Equal(pending[1].DeliverOn))
+	require.Equal(t, 2, pending[1].Attempts)
+
+	// Remove it after a successful delivery
+	err = catalog.DeleteNotifications(ctx, []string{claimed[0].ID})
+	require.NoError(t, err)
+	pending, err = catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	// Claimed notifications don't count towards rate limits until the delivered message is logged
+	n, err = catalog.CountSentNotifications(ctx, "slack", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// Cleaning up sent notifications doesn't delete pending ones
+	err = catalog.DeleteSentNotifications(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	n, err = catalog.CountSentNotifications(ctx, "slack", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	pending, err = catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+}
+
 func requirePartitionEqual(t *testing.T, expected, actual drivers.ModelPartition) {
 	t.Helper()
 	require.Equal(t, expected.Key, actual.Key)
@@ -126,6 +126,15 @@ func (e *localFileToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		}
 	}
 
+	// Validate the staged output before it replaces the previous output
+	if opts.ValidateOutput != nil {
+		err = opts.ValidateOutput(ctx, stagingTableName)
+		if err != nil {
+			_ = e.c.DropTable(ctx, stagingTableName)
+			return nil, err
+		}
+	}
+
 	if outputProps.Typ == "DICTIONARY" {
 		err = e.c.createDictionary(ctx, tableName, fmt.Sprintf("SELECT * FROM %s", safeSQLName(stagingTableName)), outputProps)
 		// drop the temp table
@@ -68,16 +68,25 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 		_ = e.c.DropTable(ctx, stagingTableName)
 
 		// Create the table
-		opts := &drivers.CreateTableOptions{
+		createTableOpts := &drivers.CreateTableOptions{
 			View:      asView,
 			TableOpts: mustToMap(outputProps),
 		}
-		err := e.c.CreateTableAsSelect(ctx, stagingTableName, inputProps.SQL, opts)
+		err := e.c.CreateTableAsSelect(ctx, stagingTableName, inputProps.SQL, createTableOpts)
 		if err != nil {
 			_ = e.c.DropTable(ctx, stagingTableName)
 			return nil, fmt.Errorf("failed to create model: %w", err)
 		}
 
+		// Validate the staged output before it replaces the previous output
+		if opts.ValidateOutput != nil {
+			err = opts.ValidateOutput(ctx, stagingTableName)
+			if err != nil {
+				_ = e.c.DropTable(ctx, stagingTableName)
+				return nil, err
+			}
+		}
+
 		// Rename the staging table to the final table name
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, e.c, stagingTableName, asView, tableName)
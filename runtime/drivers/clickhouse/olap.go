@@ -3,6 +3,7 @@ package clickhouse
 import (
 	"context"
 	"crypto/md5"
+	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
@@ -110,6 +111,12 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		return nil, err
 	}
 
+	// Charge the budget before adding settings to the query
+	err := drivers.ChargeQueryBudget(ctx, c, stmt)
+	if err != nil {
+		return nil, err
+	}
+
 	if c.config.SettingsOverride != "" {
 		stmt.Query += "\n SETTINGS " + c.config.SettingsOverride
 	} else {
@@ -195,6 +202,60 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 	return res, nil
 }
 
+// EstimateCost implements drivers.OLAPStore.
+// It uses EXPLAIN ESTIMATE to get the number of rows that will be read from each table,
+// and estimates the scanned bytes from the average row size of each table.
+func (c *connection) EstimateCost(ctx context.Context, stmt *drivers.Statement) (*drivers.QueryCost, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	rows, err := conn.QueryxContext(ctx, fmt.Sprintf("EXPLAIN ESTIMATE %s", stmt.Query), stmt.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type tableEstimate struct {
+		database string
+		table    string
+		rows     int64
+	}
+	var estimates []tableEstimate
+	for rows.Next() {
+		var database, table string
+		var parts, nrows, marks uint64
+		if err := rows.Scan(&database, &table, &parts, &nrows, &marks); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, tableEstimate{database: database, table: table, rows: int64(nrows)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	res := &drivers.QueryCost{}
+	for _, e := range estimates {
+		res.ScannedRows += e.rows
+
+		var totalRows, totalBytes *uint64
+		err := conn.QueryRowxContext(ctx, "SELECT total_rows, total_bytes FROM system.tables WHERE database = ? AND name = ?", e.database, e.table).Scan(&totalRows, &totalBytes)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return nil, err
+		}
+		if totalRows != nil && totalBytes != nil && *totalRows > 0 {
+			res.ScannedBytes += int64(float64(e.rows) * float64(*totalBytes) / float64(*totalRows))
+		}
+	}
+
+	return res, nil
+}
+
 // AddTableColumn implements drivers.OLAPStore.
 func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
 	return fmt.Errorf("clickhouse: data transformation not yet supported")
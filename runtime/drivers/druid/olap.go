@@ -2,6 +2,7 @@ package druid
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -85,6 +86,11 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 		return nil, rows.Close()
 	}
 
+	err := drivers.ChargeQueryBudget(ctx, c, stmt)
+	if err != nil {
+		return nil, err
+	}
+
 	var cancelFunc context.CancelFunc
 	if stmt.ExecutionTimeout != 0 {
 		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
@@ -108,7 +114,6 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 	}
 
 	var rows *sqlx.Rows
-	var err error
 
 	re := retrier.New(retrier.ExponentialBackoff(numRetries, retryWait), retryErrClassifier{})
 	err = re.RunCtx(ctx, func(ctx2 context.Context) error {
@@ -144,6 +149,139 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 	return r, nil
 }
 
+// EstimateCost implements drivers.OLAPStore.
+// Druid's query planner doesn't estimate costs, so it uses EXPLAIN PLAN FOR to find the tables and intervals scanned by the query,
+// and then sums the rows and sizes of the segments that overlap with them.
+func (c *connection) EstimateCost(ctx context.Context, stmt *drivers.Statement) (*drivers.QueryCost, error) {
+	rows, err := c.db.QueryxContext(ctx, "EXPLAIN PLAN FOR "+stmt.Query, stmt.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if rows.Err() != nil {
+			return nil, rows.Err()
+		}
+		return nil, fmt.Errorf("druid: empty query plan")
+	}
+	var planRaw, resRaw, attrRaw string
+	if err := rows.Scan(&planRaw, &resRaw, &attrRaw); err != nil {
+		return nil, err
+	}
+	_ = rows.Close()
+
+	var plan []struct {
+		Query map[string]any `json:"query"`
+	}
+	if err := json.Unmarshal([]byte(planRaw), &plan); err != nil {
+		return nil, fmt.Errorf("druid: failed to parse query plan: %w", err)
+	}
+
+	var scans []druidScan
+	for _, p := range plan {
+		scans = collectDruidScans(p.Query, scans)
+	}
+
+	res := &drivers.QueryCost{}
+	for _, s := range scans {
+		qry := `SELECT COALESCE(SUM(num_rows), 0), COALESCE(SUM("size"), 0) FROM sys.segments WHERE datasource = ? AND is_active = 1`
+		args := []any{s.table}
+		if !s.start.IsZero() {
+			qry += ` AND "end" > ?`
+			args = append(args, s.start.UTC().Format(druidTimeFormat))
+		}
+		if !s.end.IsZero() {
+			qry += ` AND "start" < ?`
+			args = append(args, s.end.UTC().Format(druidTimeFormat))
+		}
+
+		var numRows, size int64
+		err := c.db.QueryRowxContext(ctx, qry, args...).Scan(&numRows, &size)
+		if err != nil {
+			return nil, err
+		}
+		res.ScannedRows += numRows
+		res.ScannedBytes += size
+	}
+
+	return res, nil
+}
+
+// druidTimeFormat is the format of the start and end times of segments in sys.segments.
+const druidTimeFormat = "2006-01-02T15:04:05.000Z"
+
+// druidScan represents a scan of a time interval of a table in a native Druid query.
+// Zero start or end times mean the interval is unbounded.
+type druidScan struct {
+	table string
+	start time.Time
+	end   time.Time
+}
+
+// collectDruidScans appends the table scans of a native Druid query to res.
+func collectDruidScans(qry map[string]any, res []druidScan) []druidScan {
+	// Find the query's intervals. Intervals that can't be parsed are treated as unbounded (e.g. Druid's "eternity" interval).
+	var start, end time.Time
+	var bounded bool
+	if intervals, ok := qry["intervals"].(map[string]any); ok {
+		list, _ := intervals["intervals"].([]any)
+		for _, v := range list {
+			s, _ := v.(string)
+			startStr, endStr, ok := strings.Cut(s, "/")
+			if !ok {
+				continue
+			}
+			t1, err1 := time.Parse(time.RFC3339Nano, startStr)
+			t2, err2 := time.Parse(time.RFC3339Nano, endStr)
+			if err1 != nil {
+				t1 = time.Time{}
+			}
+			if err2 != nil {
+				t2 = time.Time{}
+			}
+			if !bounded {
+				start, end, bounded = t1, t2, true
+				continue
+			}
+			// Use the union of the intervals
+			if t1.IsZero() || t1.Before(start) {
+				start = t1
+			}
+			if t2.IsZero() || (!end.IsZero() && t2.After(end)) {
+				end = t2
+			}
+		}
+	}
+
+	var walk func(ds any)
+	walk = func(ds any) {
+		m, ok := ds.(map[string]any)
+		if !ok {
+			return
+		}
+		switch m["type"] {
+		case "table":
+			name, _ := m["name"].(string)
+			res = append(res, druidScan{table: name, start: start, end: end})
+		case "query":
+			q, _ := m["query"].(map[string]any)
+			res = collectDruidScans(q, res)
+		case "join":
+			walk(m["left"])
+			walk(m["right"])
+		case "union":
+			list, _ := m["dataSources"].([]any)
+			for _, v := range list {
+				walk(v)
+			}
+		}
+	}
+	walk(qry["dataSource"])
+
+	return res
+}
+
 func (c *connection) MayBeScaledToZero(ctx context.Context) bool {
 	return false
 }
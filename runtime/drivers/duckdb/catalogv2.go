@@ -228,3 +228,55 @@ func (c *connection) FindInstanceHealth(ctx context.Context, instanceID string)
 func (c *connection) UpsertInstanceHealth(ctx context.Context, h *drivers.InstanceHealth) error {
 	return drivers.ErrNotImplemented
 }
+
+func (c *connection) FindAIConversations(ctx context.Context, opts *drivers.FindAIConversationsOptions) ([]*drivers.AIConversation, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+func (c *connection) FindAIConversation(ctx context.Context, conversationID string) (*drivers.AIConversation, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+func (c *connection) InsertAIConversation(ctx context.Context, conv *drivers.AIConversation) error {
+	return drivers.ErrNotImplemented
+}
+
+func (c *connection) DeleteAIConversation(ctx context.Context, conversationID string) error {
+	return drivers.ErrNotImplemented
+}
+
+func (c *connection) FindAIMessages(ctx context.Context, conversationID string) ([]*drivers.AIMessage, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+func (c *connection) InsertAIMessage(ctx context.Context, m *drivers.AIMessage) error {
+	return drivers.ErrNotImplemented
+}
+
+func (c *connection) FindPendingNotifications(ctx context.Context) ([]*drivers.Notification, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+func (c *connection) CountSentNotifications(ctx context.Context, connector string, sentAfter time.Time) (int, error) {
+	return 0, drivers.ErrNotImplemented
+}
+
+func (c *connection) InsertNotification(ctx context.Context, n *drivers.Notification) error {
+	return drivers.ErrNotImplemented
+}
+
+func (c *connection) ClaimDueNotifications(ctx context.Context, deliverBefore, leaseUntil time.Time) ([]*drivers.Notification, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
+func (c *connection) UpdateNotificationDeliverOn(ctx context.Context, id string, deliverOn time.Time) error {
+	return drivers.ErrNotImplemented
+}
+
+func (c *connection) DeleteNotifications(ctx context.Context, ids []string) error {
+	return drivers.ErrNotImplemented
+}
+
+func (c *connection) DeleteSentNotifications(ctx context.Context, sentBefore time.Time) error {
+	return drivers.ErrNotImplemented
+}
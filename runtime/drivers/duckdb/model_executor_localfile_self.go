@@ -97,6 +97,15 @@ func (e *localFileToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		return nil, fmt.Errorf("failed to create model: %w", err)
 	}
 
+	// Validate the staged output before it replaces the previous output
+	if opts.ValidateOutput != nil {
+		err = opts.ValidateOutput(ctx, stagingTableName)
+		if err != nil {
+			_ = e.c.DropTable(ctx, stagingTableName)
+			return nil, err
+		}
+	}
+
 	// Rename the staging table to the final table name
 	if stagingTableName != tableName {
 		err = olapForceRenameTable(ctx, e.c, stagingTableName, asView, tableName)
@@ -78,6 +78,15 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 			return nil, fmt.Errorf("failed to create model: %w", err)
 		}
 
+		// Validate the staged output before it replaces the previous output
+		if opts.ValidateOutput != nil {
+			err = opts.ValidateOutput(ctx, stagingTableName)
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName)
+				return nil, err
+			}
+		}
+
 		// Rename the staging table to the final table name
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, olap, stagingTableName, asView, tableName)
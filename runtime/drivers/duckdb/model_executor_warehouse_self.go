@@ -68,6 +68,15 @@ func (e *warehouseToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 	}
 
 	if !opts.IncrementalRun {
+		// Validate the staged output before it replaces the previous output
+		if opts.ValidateOutput != nil {
+			err = opts.ValidateOutput(ctx, stagingTableName)
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName)
+				return nil, err
+			}
+		}
+
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, olap, stagingTableName, false, tableName)
 			if err != nil {
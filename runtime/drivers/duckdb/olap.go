@@ -2,8 +2,10 @@ package duckdb
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -89,6 +91,11 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		return nil, c.checkErr(err)
 	}
 
+	err := drivers.ChargeQueryBudget(ctx, c, stmt)
+	if err != nil {
+		return nil, err
+	}
+
 	// Gather metrics only for actual queries
 	var acquiredTime time.Time
 	acquired := false
@@ -175,6 +182,63 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 	return res, nil
 }
 
+// EstimateCost implements drivers.OLAPStore.
+// It sums the estimated cardinalities of the leaf operators (i.e. the scans) in the query plan.
+// DuckDB doesn't estimate the size of scans, so ScannedBytes is always 0.
+func (c *connection) EstimateCost(ctx context.Context, stmt *drivers.Statement) (*drivers.QueryCost, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	var key, plan string
+	err = conn.QueryRowxContext(ctx, "EXPLAIN (FORMAT JSON) "+stmt.Query, stmt.Args...).Scan(&key, &plan)
+	if err != nil {
+		return nil, c.checkErr(err)
+	}
+
+	var nodes []*planNode
+	err = json.Unmarshal([]byte(plan), &nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query plan: %w", err)
+	}
+
+	res := &drivers.QueryCost{}
+	for _, n := range nodes {
+		res.ScannedRows += n.scannedRows()
+	}
+	return res, nil
+}
+
+// planNode represents an operator in a query plan output by EXPLAIN (FORMAT JSON).
+type planNode struct {
+	Name      string         `json:"name"`
+	Children  []*planNode    `json:"children"`
+	ExtraInfo map[string]any `json:"extra_info"`
+}
+
+// scannedRows returns the sum of the estimated cardinalities of the leaf operators in the plan.
+func (n *planNode) scannedRows() int64 {
+	if len(n.Children) > 0 {
+		var res int64
+		for _, c := range n.Children {
+			res += c.scannedRows()
+		}
+		return res
+	}
+
+	v, ok := n.ExtraInfo["Estimated Cardinality"]
+	if !ok {
+		return 0
+	}
+	res, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return res
+}
+
 func (c *connection) estimateSize() int64 {
 	db, release, err := c.acquireDB()
 	if err != nil {
@@ -2,6 +2,7 @@ package duckdb
 
 import (
 	"context"
+	"errors"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -35,6 +36,60 @@ func TestQuery(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEstimateCost(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.AsOLAP("")
+
+	cost, err := olap.EstimateCost(context.Background(), &drivers.Statement{Query: "SELECT bar, COUNT(*) FROM foo GROUP BY bar"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), cost.ScannedRows)
+
+	cost, err = olap.EstimateCost(context.Background(), &drivers.Statement{Query: "SELECT * FROM foo JOIN bar ON foo.bar = bar.bar"})
+	require.NoError(t, err)
+	require.Equal(t, int64(8), cost.ScannedRows)
+
+	err = conn.Close()
+	require.NoError(t, err)
+}
+
+type testQueryBudget struct {
+	charged []*drivers.QueryCost
+	err     error
+}
+
+func (b *testQueryBudget) Applies(dialect drivers.Dialect) bool {
+	return true
+}
+
+func (b *testQueryBudget) Charge(ctx context.Context, dialect drivers.Dialect, cost *drivers.QueryCost) error {
+	if b.err != nil {
+		return b.err
+	}
+	b.charged = append(b.charged, cost)
+	return nil
+}
+
+func TestQueryBudget(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.AsOLAP("")
+
+	b := &testQueryBudget{}
+	ctx := drivers.WithQueryBudget(context.Background(), b)
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT COUNT(*) FROM foo"})
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+	require.Len(t, b.charged, 1)
+	require.Equal(t, int64(4), b.charged[0].ScannedRows)
+
+	b.err = errors.New("budget exceeded")
+	_, err = olap.Execute(ctx, &drivers.Statement{Query: "SELECT COUNT(*) FROM foo"})
+	require.ErrorIs(t, err, b.err)
+
+	err = conn.Close()
+	require.NoError(t, err)
+}
+
 func TestPriorityQueue(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
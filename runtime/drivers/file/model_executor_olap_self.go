@@ -1,6 +1,7 @@
 package file
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
 	"encoding/json"
@@ -12,12 +13,14 @@ import (
 
 	"github.com/apache/arrow/go/v15/arrow"
 	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
 	"github.com/apache/arrow/go/v15/arrow/memory"
 	"github.com/apache/arrow/go/v15/parquet/pqarrow"
 	"github.com/c2h5oh/datasize"
 	"github.com/mitchellh/mapstructure"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/arrowutil"
 	"github.com/rilldata/rill/runtime/pkg/jsonval"
 	"github.com/xuri/excelize/v2"
 )
@@ -87,9 +90,11 @@ func (e *olapToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 	case drivers.FileFormatCSV:
 		err = writeCSV(res, fw)
 	case drivers.FileFormatJSON:
-		return nil, errors.New("json file output not currently supported")
+		err = writeJSON(res, fw)
 	case drivers.FileFormatXLSX:
 		err = writeXLSX(res, fw)
+	case drivers.FileFormatArrow:
+		err = writeArrow(res, fw)
 	default:
 		return nil, fmt.Errorf("unsupported output format %q", outputProps.Format)
 	}
@@ -172,6 +177,62 @@ func writeCSV(res *drivers.Result, fw io.Writer) error {
 	return nil
 }
 
+// writeJSON writes the result as newline-delimited JSON with one object per row.
+func writeJSON(res *drivers.Result, fw io.Writer) error {
+	keys := make([][]byte, len(res.Schema.Fields))
+	for i, f := range res.Schema.Fields {
+		key, err := json.Marshal(f.Name)
+		if err != nil {
+			return err
+		}
+		keys[i] = key
+	}
+
+	vals := make([]any, len(res.Schema.Fields))
+	for i := range vals {
+		vals[i] = new(any)
+	}
+
+	// Write the fields of each row in the order of the schema, which json.Marshal on a map would not preserve
+	var buf bytes.Buffer
+	for res.Next() {
+		err := res.Scan(vals...)
+		if err != nil {
+			return err
+		}
+
+		buf.Reset()
+		buf.WriteByte('{')
+		for i, v := range vals {
+			v := *(v.(*any))
+
+			v, err := jsonval.ToValue(v, res.Schema.Fields[i].Type)
+			if err != nil {
+				return fmt.Errorf("failed to convert to JSON value: %w", err)
+			}
+
+			tmp, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("failed to marshal JSON value: %w", err)
+			}
+
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(keys[i])
+			buf.WriteByte(':')
+			buf.Write(tmp)
+		}
+		buf.WriteString("}\n")
+
+		_, err = fw.Write(buf.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return res.Err()
+}
+
 func writeXLSX(res *drivers.Result, fw io.Writer) error {
 	xf := excelize.NewFile()
 	defer func() { _ = xf.Close() }()
@@ -247,35 +308,7 @@ func writeXLSX(res *drivers.Result, fw io.Writer) error {
 }
 
 func writeParquet(res *drivers.Result, fw io.Writer) error {
-	fields := make([]arrow.Field, 0, len(res.Schema.Fields))
-	for _, f := range res.Schema.Fields {
-		arrowField := arrow.Field{}
-		arrowField.Name = f.Name
-		switch f.Type.Code {
-		case runtimev1.Type_CODE_BOOL:
-			arrowField.Type = arrow.FixedWidthTypes.Boolean
-		case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
-			arrowField.Type = arrow.PrimitiveTypes.Int64
-		case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
-			arrowField.Type = arrow.PrimitiveTypes.Uint64
-		case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_DECIMAL:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
-			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
-		case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_INTERVAL, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
-			arrowField.Type = arrow.BinaryTypes.String
-		case runtimev1.Type_CODE_BYTES:
-			arrowField.Type = arrow.BinaryTypes.Binary
-		}
-		fields = append(fields, arrowField)
-	}
-	schema := arrow.NewSchema(fields, nil)
+	schema := arrowutil.Schema(res.Schema)
 	mem := memory.DefaultAllocator
 	recordBuilder := array.NewRecordBuilder(mem, schema)
 	defer recordBuilder.Release()
@@ -297,53 +330,9 @@ func writeParquet(res *drivers.Result, fw io.Writer) error {
 			return err
 		}
 
-		for i, v := range vals {
-			t := res.Schema.Fields[i].Type
-			v := *(v.(*any))
-			v, err := jsonval.ToValue(v, res.Schema.Fields[i].Type)
-			if err != nil {
-				return fmt.Errorf("failed to convert to JSON value: %w", err)
-			}
-
-			switch t.Code {
-			case runtimev1.Type_CODE_BOOL:
-				v, _ := v.(bool)
-				recordBuilder.Field(i).(*array.BooleanBuilder).Append(v)
-			case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
-				v, _ := v.(int64)
-				recordBuilder.Field(i).(*array.Int64Builder).Append(v)
-			case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
-				v, _ := v.(uint64)
-				recordBuilder.Field(i).(*array.Uint64Builder).Append(v)
-			case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_DECIMAL:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
-				v, _ := v.(time.Time)
-				tmp, err := arrow.TimestampFromTime(v, arrow.Microsecond)
-				if err != nil {
-					return err
-				}
-				recordBuilder.Field(i).(*array.TimestampBuilder).Append(tmp)
-			case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_INTERVAL, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
-				res, err := json.Marshal(v)
-				if err != nil {
-					return fmt.Errorf("failed to convert to JSON value: %w", err)
-				}
-				recordBuilder.Field(i).(*array.StringBuilder).Append(string(res))
-			case runtimev1.Type_CODE_BYTES:
-				v, _ := v.([]byte)
-				recordBuilder.Field(i).(*array.BinaryBuilder).Append(v)
-			}
+		err = appendArrowRow(recordBuilder, res.Schema, vals)
+		if err != nil {
+			return err
 		}
 		rows++
 		if rows == 1000 {
@@ -373,6 +362,111 @@ func writeParquet(res *drivers.Result, fw io.Writer) error {
 	return err
 }
 
+// writeArrow writes the result as an Arrow IPC stream with a record batch for every 1000 rows.
+func writeArrow(res *drivers.Result, fw io.Writer) error {
+	schema := arrowutil.Schema(res.Schema)
+	mem := memory.DefaultAllocator
+	recordBuilder := array.NewRecordBuilder(mem, schema)
+	defer recordBuilder.Release()
+
+	vals := make([]any, len(res.Schema.Fields))
+	for i := range vals {
+		vals[i] = new(any)
+	}
+
+	w := ipc.NewWriter(fw, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	defer w.Close()
+
+	writeRecord := func() error {
+		rec := recordBuilder.NewRecord()
+		defer rec.Release()
+		return w.Write(rec)
+	}
+
+	var rows int64
+	for res.Next() {
+		err := res.Scan(vals...)
+		if err != nil {
+			return err
+		}
+
+		err = appendArrowRow(recordBuilder, res.Schema, vals)
+		if err != nil {
+			return err
+		}
+		rows++
+		if rows == 1000 {
+			if err := writeRecord(); err != nil {
+				return err
+			}
+			rows = 0
+		}
+	}
+	if res.Err() != nil {
+		return res.Err()
+	}
+	if rows > 0 {
+		if err := writeRecord(); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// appendArrowRow appends a row of scanned values to the record builder.
+func appendArrowRow(recordBuilder *array.RecordBuilder, s *runtimev1.StructType, vals []any) error {
+	for i, v := range vals {
+		t := s.Fields[i].Type
+		v := *(v.(*any))
+		v, err := jsonval.ToValue(v, s.Fields[i].Type)
+		if err != nil {
+			return fmt.Errorf("failed to convert to JSON value: %w", err)
+		}
+
+		switch t.Code {
+		case runtimev1.Type_CODE_BOOL:
+			v, _ := v.(bool)
+			recordBuilder.Field(i).(*array.BooleanBuilder).Append(v)
+		case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
+			v, _ := v.(int64)
+			recordBuilder.Field(i).(*array.Int64Builder).Append(v)
+		case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
+			v, _ := v.(float64)
+			recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+		case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
+			v, _ := v.(uint64)
+			recordBuilder.Field(i).(*array.Uint64Builder).Append(v)
+		case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
+			v, _ := v.(float64)
+			recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
+			v, _ := v.(float64)
+			recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+		case runtimev1.Type_CODE_DECIMAL:
+			v, _ := v.(float64)
+			recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
+			v, _ := v.(time.Time)
+			tmp, err := arrow.TimestampFromTime(v, arrow.Microsecond)
+			if err != nil {
+				return err
+			}
+			recordBuilder.Field(i).(*array.TimestampBuilder).Append(tmp)
+		case runtimev1.Type_CODE_BYTES:
+			v, _ := v.([]byte)
+			recordBuilder.Field(i).(*array.BinaryBuilder).Append(v)
+		default:
+			// Other types are mapped to strings by arrowutil.Schema
+			res, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("failed to convert to JSON value: %w", err)
+			}
+			recordBuilder.Field(i).(*array.StringBuilder).Append(string(res))
+		}
+	}
+	return nil
+}
+
 // A limitedWriter writes to W but limits the amount of
 // data written to just N bytes.
 //
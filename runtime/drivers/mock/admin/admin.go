@@ -0,0 +1,265 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("mock_admin", driver{})
+	drivers.RegisterAsConnector("mock_admin", driver{})
+}
+
+// BaseURL is the base of the URLs returned by the mock admin service.
+const BaseURL = "https://ui.example.com"
+
+type configProperties struct {
+	// Users is a JSON-encoded map of the emails of the project's members to their security attributes.
+	// Report recipients who are not in the map are treated as external recipients.
+	Users string `mapstructure:"users"`
+	// OwnerAttributes is a JSON-encoded map of the security attributes of report owners.
+	OwnerAttributes string `mapstructure:"owner_attributes"`
+	// FailAttributes is a comma-separated list of emails whose attributes can't be resolved.
+	FailAttributes string `mapstructure:"fail_attributes"`
+}
+
+type driver struct{}
+
+var _ drivers.Driver = driver{}
+
+// Spec implements drivers.Driver.
+func (driver) Spec() drivers.Spec {
+	return drivers.Spec{}
+}
+
+// Open implements drivers.Driver.
+func (driver) Open(instanceID string, config map[string]any, st *storage.Client, ac *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	cfg := &configProperties{}
+	err := mapstructure.WeakDecode(config, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &handle{config: config, failAttributes: make(map[string]bool)}
+	for _, email := range strings.Split(cfg.FailAttributes, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			h.failAttributes[email] = true
+		}
+	}
+	if cfg.Users != "" {
+		err = json.Unmarshal([]byte(cfg.Users), &h.users)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse users: %w", err)
+		}
+	}
+	if cfg.OwnerAttributes != "" {
+		err = json.Unmarshal([]byte(cfg.OwnerAttributes), &h.ownerAttributes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse owner attributes: %w", err)
+		}
+	}
+
+	return h, nil
+}
+
+// HasAnonymousSourceAccess implements drivers.Driver.
+func (driver) HasAnonymousSourceAccess(ctx context.Context, srcProps map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+// TertiarySourceConnectors implements drivers.Driver.
+func (driver) TertiarySourceConnectors(ctx context.Context, srcProps map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+// handle is an admin service that returns predictable URLs and configured user attributes.
+type handle struct {
+	config          map[string]any
+	users           map[string]map[string]any
+	ownerAttributes map[string]any
+	failAttributes  map[string]bool
+}
+
+var (
+	_ drivers.Handle       = &handle{}
+	_ drivers.AdminService = &handle{}
+)
+
+// GetReportMetadata implements drivers.AdminService.
+// Snapshot URLs identify the user they were issued for in the "user" query parameter.
+func (h *handle) GetReportMetadata(ctx context.Context, reportName, ownerID string, emailRecipients []string, recipientAttributes bool, snapshotType, snapshotResource string, executionTime time.Time) (*drivers.ReportMetadata, error) {
+	meta := &drivers.ReportMetadata{
+		BaseURLs: drivers.ReportURLs{
+			OpenURL:   fmt.Sprintf("%s/-/reports/%s/open?execution_time=%s", BaseURL, reportName, executionTime.UTC().Format(time.RFC3339)),
+			ExportURL: fmt.Sprintf("%s/-/reports/%s/export", BaseURL, reportName),
+			EditURL:   fmt.Sprintf("%s/-/reports/%s", BaseURL, reportName),
+		},
+		RecipientURLs:      make(map[string]drivers.ReportURLs),
+		QueryForAttributes: h.ownerAttributes,
+	}
+	if snapshotResource != "" {
+		meta.BaseURLs.SnapshotURL = snapshotURL(snapshotType, snapshotResource, "owner:"+ownerID)
+		meta.RecipientSnapshotURLs = make(map[string]string)
+	}
+	if recipientAttributes {
+		meta.RecipientAttributes = make(map[string]map[string]any)
+	}
+
+	for _, email := range emailRecipients {
+		attrs, member := h.users[email]
+		if !member {
+			token := url.QueryEscape(email)
+			meta.RecipientURLs[email] = drivers.ReportURLs{
+				OpenURL:   meta.BaseURLs.OpenURL,
+				ExportURL: fmt.Sprintf("%s/-/reports/%s/export?token=%s", BaseURL, reportName, token),
+				EditURL:   fmt.Sprintf("%s/-/reports/%s/unsubscribe?token=%s", BaseURL, reportName, token),
+			}
+			attrs = map[string]any{"email": email, "domain": email[strings.LastIndex(email, "@")+1:], "admin": false}
+		} else if snapshotResource != "" {
+			meta.RecipientSnapshotURLs[email] = snapshotURL(snapshotType, snapshotResource, email)
+		}
+
+		if recipientAttributes {
+			if h.failAttributes[email] {
+				if meta.RecipientAttributeErrors == nil {
+					meta.RecipientAttributeErrors = make(map[string]string)
+				}
+				meta.RecipientAttributeErrors[email] = "mock_admin: attributes are not available"
+				continue
+			}
+			meta.RecipientAttributes[email] = attrs
+		}
+	}
+
+	return meta, nil
+}
+
+// GetAlertMetadata implements drivers.AdminService.
+func (h *handle) GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*drivers.AlertMetadata, error) {
+	meta := &drivers.AlertMetadata{
+		OpenURL: fmt.Sprintf("%s/-/alerts/%s/open", BaseURL, alertName),
+		EditURL: fmt.Sprintf("%s/-/alerts/%s", BaseURL, alertName),
+	}
+	if queryForUserEmail != "" {
+		meta.QueryForAttributes = h.users[queryForUserEmail]
+	} else if queryForUserID != "" {
+		meta.QueryForAttributes = h.ownerAttributes
+	}
+	return meta, nil
+}
+
+// ProvisionConnector implements drivers.AdminService.
+func (h *handle) ProvisionConnector(ctx context.Context, name, driver string, args map[string]any) (map[string]any, error) {
+	return nil, errors.New("mock_admin: provisioning is not supported")
+}
+
+// snapshotURL returns a snapshot URL for a user.
+func snapshotURL(typ, resource, user string) string {
+	return fmt.Sprintf("%s/-/embed?type=%s&resource=%s&user=%s", BaseURL, url.QueryEscape(typ), url.QueryEscape(resource), url.QueryEscape(user))
+}
+
+// Ping implements drivers.Handle.
+func (h *handle) Ping(ctx context.Context) error {
+	return nil
+}
+
+// Driver implements drivers.Handle.
+func (h *handle) Driver() string {
+	return "mock_admin"
+}
+
+// Config implements drivers.Handle.
+func (h *handle) Config() map[string]any {
+	return h.config
+}
+
+// Migrate implements drivers.Handle.
+func (h *handle) Migrate(ctx context.Context) error {
+	return nil
+}
+
+// MigrationStatus implements drivers.Handle.
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// Close implements drivers.Handle.
+func (h *handle) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Handle.
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Handle.
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Handle.
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return h, true
+}
+
+// AsAI implements drivers.Handle.
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Handle.
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// AsObjectStore implements drivers.Handle.
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Handle.
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (h *handle) AsWarehouse() (drivers.Warehouse, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Handle.
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Handle.
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
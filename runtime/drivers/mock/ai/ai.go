@@ -0,0 +1,220 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("mock_ai", driver{})
+	drivers.RegisterAsConnector("mock_ai", driver{})
+}
+
+type configProperties struct {
+	// Responses is a JSON-encoded list of responses to return from successive calls to Complete.
+	// Each response is either a string or an object of the form {"data": "...", "tool_calls": [{"id": "...", "name": "...", "input": {...}}]}.
+	// Complete returns an error when all responses have been consumed.
+	Responses string `mapstructure:"responses"`
+}
+
+// response is a scripted response.
+type response struct {
+	Data      string `json:"data"`
+	ToolCalls []struct {
+		ID    string          `json:"id"`
+		Name  string          `json:"name"`
+		Input json.RawMessage `json:"input"`
+	} `json:"tool_calls"`
+}
+
+type driver struct{}
+
+var _ drivers.Driver = driver{}
+
+// Spec implements drivers.Driver.
+func (driver) Spec() drivers.Spec {
+	return drivers.Spec{}
+}
+
+// Open implements drivers.Driver.
+func (driver) Open(instanceID string, config map[string]any, st *storage.Client, ac *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	cfg := &configProperties{}
+	err := mapstructure.WeakDecode(config, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []json.RawMessage
+	if cfg.Responses != "" {
+		err = json.Unmarshal([]byte(cfg.Responses), &raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse responses: %w", err)
+		}
+	}
+
+	responses := make([]*drivers.CompletionMessage, len(raw))
+	for i, r := range raw {
+		var res response
+		if err := json.Unmarshal(r, &res.Data); err != nil {
+			if err := json.Unmarshal(r, &res); err != nil {
+				return nil, fmt.Errorf("failed to parse response %d: %w", i, err)
+			}
+		}
+
+		msg := &drivers.CompletionMessage{Role: "assistant", Data: res.Data}
+		for _, call := range res.ToolCalls {
+			input := string(call.Input)
+			if input == "" {
+				input = "{}"
+			}
+			msg.ToolCalls = append(msg.ToolCalls, &drivers.CompletionToolCall{ID: call.ID, Name: call.Name, Input: input})
+		}
+		responses[i] = msg
+	}
+
+	return &handle{
+		config:    config,
+		responses: responses,
+	}, nil
+}
+
+// HasAnonymousSourceAccess implements drivers.Driver.
+func (driver) HasAnonymousSourceAccess(ctx context.Context, srcProps map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+// TertiarySourceConnectors implements drivers.Driver.
+func (driver) TertiarySourceConnectors(ctx context.Context, srcProps map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+// handle is an AI service that returns scripted responses.
+type handle struct {
+	config map[string]any
+
+	mu        sync.Mutex
+	responses []*drivers.CompletionMessage
+}
+
+var (
+	_ drivers.Handle    = &handle{}
+	_ drivers.AIService = &handle{}
+)
+
+// Complete implements drivers.AIService.
+func (h *handle) Complete(ctx context.Context, msgs []*drivers.CompletionMessage, tools []*drivers.CompletionTool) (*drivers.CompletionMessage, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.responses) == 0 {
+		return nil, errors.New("mock_ai: no more scripted responses")
+	}
+
+	res := h.responses[0]
+	h.responses = h.responses[1:]
+	return res, nil
+}
+
+// Ping implements drivers.Handle.
+func (h *handle) Ping(ctx context.Context) error {
+	return nil
+}
+
+// Driver implements drivers.Handle.
+func (h *handle) Driver() string {
+	return "mock_ai"
+}
+
+// Config implements drivers.Handle.
+func (h *handle) Config() map[string]any {
+	return h.config
+}
+
+// Migrate implements drivers.Handle.
+func (h *handle) Migrate(ctx context.Context) error {
+	return nil
+}
+
+// MigrationStatus implements drivers.Handle.
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// Close implements drivers.Handle.
+func (h *handle) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Handle.
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Handle.
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Handle.
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return h, true
+}
+
+// AsOLAP implements drivers.Handle.
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// AsObjectStore implements drivers.Handle.
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Handle.
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (h *handle) AsWarehouse() (drivers.Warehouse, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Handle.
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Handle.
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
@@ -2,5 +2,7 @@ package mock
 
 import (
 	// Import mock drivers
+	_ "github.com/rilldata/rill/runtime/drivers/mock/admin"
+	_ "github.com/rilldata/rill/runtime/drivers/mock/ai"
 	_ "github.com/rilldata/rill/runtime/drivers/mock/object_store"
 )
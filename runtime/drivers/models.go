@@ -74,6 +74,9 @@ type ModelExecuteOptions struct {
 	PreviousResult *ModelResult
 	// TempDir is a temporary directory for storing intermediate data.
 	TempDir string
+	// ValidateOutput is an optional callback that validates the new output table (which may be a staging table) before it replaces the previous output.
+	// It is not called for incremental runs. If it returns an error, the executor must discard the new output and return the error.
+	ValidateOutput func(ctx context.Context, table string) error
 }
 
 // ModelEnv contains contextual info about the model's instance.
@@ -100,6 +103,7 @@ const (
 	FileFormatCSV         FileFormat = "csv"
 	FileFormatJSON        FileFormat = "json"
 	FileFormatXLSX        FileFormat = "xlsx"
+	FileFormatArrow       FileFormat = "arrow"
 )
 
 func (f FileFormat) Filename(stem string) string {
@@ -108,7 +112,7 @@ func (f FileFormat) Filename(stem string) string {
 
 func (f FileFormat) Valid() bool {
 	switch f {
-	case FileFormatParquet, FileFormatCSV, FileFormatJSON, FileFormatXLSX:
+	case FileFormatParquet, FileFormatCSV, FileFormatJSON, FileFormatXLSX, FileFormatArrow:
 		return true
 	}
 	return false
@@ -9,6 +9,7 @@ import (
 // Notifier sends notifications.
 type Notifier interface {
 	SendAlertStatus(s *AlertStatus) error
+	SendAlertDigest(d *AlertDigest) error
 	SendScheduledReport(s *ScheduledReport) error
 }
 
@@ -26,6 +27,32 @@ type AlertStatus struct {
 	EditLink       string
 }
 
+// Summary returns a short description of the status, such as "triggered" or "recovered", for use in notifications that list multiple alerts.
+func (s *AlertStatus) Summary() string {
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		if s.IsRecover {
+			return "recovered"
+		}
+		return "passed"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		return "triggered"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		return "failed to evaluate"
+	default:
+		return "unknown"
+	}
+}
+
+// AlertDigest groups the status of multiple alerts into a single notification.
+// It is sent instead of individual alert statuses when notifications were held back by a project's notification policy.
+type AlertDigest struct {
+	// TODO: Remove ToEmail, ToName once email notifier is created
+	ToEmail string
+	ToName  string
+	Alerts  []*AlertStatus
+}
+
 type ScheduledReport struct {
 	DisplayName    string
 	ReportTime     time.Time
@@ -33,4 +60,88 @@ type ScheduledReport struct {
 	OpenLink       string
 	DownloadLink   string
 	EditLink       string
+	// Attachments are files to send with the notification, such as a rendered snapshot of a dashboard.
+	// Notifiers that can't send files should link to the OpenLink instead.
+	Attachments []*Attachment
+}
+
+// Attachment is a file sent with a notification.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// NotificationPolicy configures project-level handling of alert notifications.
+// It is configured in rill.yaml and applies to all alerts in the project.
+type NotificationPolicy struct {
+	// DigestWindow groups alert notifications sent to the same destination within a window into a single digest. It is disabled if zero.
+	DigestWindow time.Duration `json:"digest_window,omitempty"`
+	// QuietHours holds back alert notifications during a daily period. They are delivered as a digest when the period ends.
+	QuietHours *QuietHours `json:"quiet_hours,omitempty"`
+	// RateLimits limits the number of alert notifications sent through a notifier connector (e.g. "slack" or "email").
+	// Notifications beyond the limit are held back and delivered as a digest when the window has passed.
+	RateLimits map[string]NotificationRateLimit `json:"rate_limits,omitempty"`
+	// Escalation notifies additional destinations when an alert has not recovered after a number of executions.
+	Escalation *NotificationEscalation `json:"escalation,omitempty"`
+}
+
+// QuietHours is a daily period during which notifications are held back.
+// Start and End are minutes since midnight in the time zone. If End is before Start, the period spans midnight.
+type QuietHours struct {
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	TimeZone string `json:"time_zone,omitempty"`
+}
+
+// Until returns the end of the quiet hours if t falls within them.
+func (q *QuietHours) Until(t time.Time) (time.Time, bool) {
+	loc := time.UTC
+	if q.TimeZone != "" {
+		l, err := time.LoadLocation(q.TimeZone)
+		if err == nil {
+			loc = l
+		}
+	}
+	t = t.In(loc)
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	minute := t.Hour()*60 + t.Minute()
+	end := func(daysAhead int) time.Time {
+		return midnight.AddDate(0, 0, daysAhead).Add(time.Duration(q.End) * time.Minute)
+	}
+
+	if q.Start <= q.End {
+		if minute >= q.Start && minute < q.End {
+			return end(0), true
+		}
+		return time.Time{}, false
+	}
+
+	// The period spans midnight
+	if minute >= q.Start {
+		return end(1), true
+	}
+	if minute < q.End {
+		return end(0), true
+	}
+	return time.Time{}, false
+}
+
+// NotificationRateLimit is the max number of notifications sent within a window.
+type NotificationRateLimit struct {
+	Limit  int           `json:"limit"`
+	Window time.Duration `json:"window"`
+}
+
+// NotificationEscalation configures notifiers to notify when an alert has failed for a number of consecutive executions.
+type NotificationEscalation struct {
+	AfterExecutions uint32                  `json:"after_executions"`
+	Notifiers       []*NotificationNotifier `json:"notifiers"`
+}
+
+// NotificationNotifier is a serializable definition of a notifier, equivalent to runtimev1.Notifier.
+type NotificationNotifier struct {
+	Connector  string         `json:"connector"`
+	Properties map[string]any `json:"properties"`
 }
@@ -53,6 +53,9 @@ type OLAPStore interface {
 	WithConnection(ctx context.Context, priority int, longRunning bool, fn WithConnectionFunc) error
 	Exec(ctx context.Context, stmt *Statement) error
 	Execute(ctx context.Context, stmt *Statement) (*Result, error)
+	// EstimateCost estimates the cost of executing a statement using the OLAP store's query planner (usually with EXPLAIN).
+	// It returns ErrNotImplemented if the OLAP store doesn't support cost estimation.
+	EstimateCost(ctx context.Context, stmt *Statement) (*QueryCost, error)
 	InformationSchema() InformationSchema
 
 	CreateTableAsSelect(ctx context.Context, name, sql string, opts *CreateTableOptions) error
@@ -363,6 +366,24 @@ func (d Dialect) SafeDivideExpression(numExpr, denExpr string) string {
 	}
 }
 
+// EstimatesScannedBytes returns true if OLAPStore.EstimateCost returns an estimate of the scanned bytes for the dialect.
+// For other dialects, only the scanned rows are estimated.
+func (d Dialect) EstimatesScannedBytes() bool {
+	return d == DialectClickHouse || d == DialectDruid
+}
+
+// HashExpression returns a SQL expression that deterministically hashes the given expressions to a non-negative integer.
+func (d Dialect) HashExpression(exprs []string) (string, error) {
+	switch d {
+	case DialectDuckDB:
+		return fmt.Sprintf("hash(%s)", strings.Join(exprs, ", ")), nil
+	case DialectClickHouse:
+		return fmt.Sprintf("cityHash64(%s)", strings.Join(exprs, ", ")), nil
+	default:
+		return "", fmt.Errorf("hashing is not supported for dialect %q", d)
+	}
+}
+
 func (d Dialect) OrderByExpression(name string, desc bool) string {
 	res := d.EscapeIdentifier(name)
 	if desc {
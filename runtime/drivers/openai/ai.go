@@ -0,0 +1,86 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/sashabaranov/go-openai"
+)
+
+var _ drivers.AIService = &handle{}
+
+// Complete implements drivers.AIService.
+func (h *handle) Complete(ctx context.Context, msgs []*drivers.CompletionMessage, tools []*drivers.CompletionTool) (*drivers.CompletionMessage, error) {
+	reqMsgs := make([]openai.ChatCompletionMessage, len(msgs))
+	for i, msg := range msgs {
+		reqMsgs[i] = openai.ChatCompletionMessage{
+			Role:       msg.Role,
+			Content:    msg.Data,
+			ToolCallID: msg.ToolCallID,
+		}
+		for _, call := range msg.ToolCalls {
+			reqMsgs[i].ToolCalls = append(reqMsgs[i].ToolCalls, openai.ToolCall{
+				ID:   call.ID,
+				Type: openai.ToolTypeFunction,
+				Function: openai.FunctionCall{
+					Name:      call.Name,
+					Arguments: call.Input,
+				},
+			})
+		}
+	}
+
+	var reqTools []openai.Tool
+	for _, tool := range tools {
+		schema, err := json.Marshal(tool.InputSchema)
+		if err != nil {
+			return nil, err
+		}
+		reqTools = append(reqTools, openai.Tool{
+			Type: openai.ToolTypeFunction,
+			Function: &openai.FunctionDefinition{
+				Name:        tool.Name,
+				Description: tool.Description,
+				Parameters:  json.RawMessage(schema),
+			},
+		})
+	}
+
+	// The client omits a temperature of 0 from the request, which makes the server use its default.
+	// Sending the smallest non-zero value has the same effect as 0.
+	temperature := *h.config.Temperature
+	if temperature == 0 {
+		temperature = math.SmallestNonzeroFloat32
+	}
+
+	res, err := h.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model:       h.config.Model,
+		Messages:    reqMsgs,
+		Tools:       reqTools,
+		Temperature: temperature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Choices) == 0 {
+		return nil, errors.New("no choices returned")
+	}
+
+	resMsg := &drivers.CompletionMessage{
+		Role: openai.ChatMessageRoleAssistant,
+		Data: res.Choices[0].Message.Content,
+	}
+	for _, call := range res.Choices[0].Message.ToolCalls {
+		resMsg.ToolCalls = append(resMsg.ToolCalls, &drivers.CompletionToolCall{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Input: call.Function.Arguments,
+		})
+	}
+
+	return resMsg, nil
+}
@@ -0,0 +1,69 @@
+package openai_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	_ "github.com/rilldata/rill/runtime/drivers/openai"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestComplete(t *testing.T) {
+	// Serve a stand-in for an OpenAI-compatible server (like Ollama) that calls a tool
+	var req map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/chat/completions", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{
+			"choices": [{
+				"message": {
+					"role": "assistant",
+					"tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "get_time", "arguments": "{\"tz\":\"UTC\"}"}}]
+				}
+			}]
+		}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	conn, err := drivers.Open("openai", "default", map[string]any{
+		"base_url":    srv.URL + "/v1",
+		"model":       "llama3.1",
+		"temperature": "0.5",
+	}, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ai, ok := conn.AsAI("default")
+	require.True(t, ok)
+
+	res, err := ai.Complete(context.Background(), []*drivers.CompletionMessage{
+		{Role: "system", Data: "You are a helpful assistant."},
+		{Role: "user", Data: "What time is it?"},
+	}, []*drivers.CompletionTool{
+		{Name: "get_time", Description: "Gets the current time.", InputSchema: map[string]any{"type": "object"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "assistant", res.Role)
+	require.Len(t, res.ToolCalls, 1)
+	require.Equal(t, "get_time", res.ToolCalls[0].Name)
+	require.JSONEq(t, `{"tz":"UTC"}`, res.ToolCalls[0].Input)
+
+	// Check the request
+	require.Equal(t, "llama3.1", req["model"])
+	require.InDelta(t, 0.5, req["temperature"], 0.001)
+	require.Len(t, req["messages"], 2)
+	require.Len(t, req["tools"], 1)
+
+	// Check that invalid temperatures are rejected
+	_, err = drivers.Open("openai", "default", map[string]any{"temperature": "3"}, storage.MustNew(t.TempDir(), nil), activity.NewNoopClient(), zap.NewNop())
+	require.ErrorContains(t, err, "invalid temperature")
+}
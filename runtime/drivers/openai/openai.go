@@ -0,0 +1,235 @@
+package openai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/storage"
+	"github.com/sashabaranov/go-openai"
+	"go.uber.org/zap"
+)
+
+// defaultModel is the model used if no model is configured.
+const defaultModel = openai.GPT4o
+
+// defaultTemperature is the sampling temperature used if no temperature is configured.
+const defaultTemperature = 0.2
+
+var spec = drivers.Spec{
+	DisplayName: "OpenAI",
+	Description: "Connect to OpenAI or any server with an OpenAI-compatible API, such as vLLM, Ollama or llama.cpp.",
+	DocsURL:     "https://docs.rilldata.com/reference/connectors/openai",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "api_key",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "API key",
+			Description: "API key for the server. Not required for servers that don't use authentication.",
+			Secret:      true,
+		},
+		{
+			Key:         "base_url",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Base URL",
+			Description: "Base URL of the OpenAI-compatible API.",
+			Placeholder: "http://localhost:11434/v1",
+			Default:     "https://api.openai.com/v1",
+		},
+		{
+			Key:         "model",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Model",
+			Description: "Name of the model to use.",
+			Default:     defaultModel,
+		},
+		{
+			Key:         "temperature",
+			Type:        drivers.NumberPropertyType,
+			DisplayName: "Temperature",
+			Description: "Sampling temperature between 0 and 2.",
+			Default:     "0.2",
+		},
+	},
+	ImplementsAI: true,
+}
+
+func init() {
+	drivers.Register("openai", driver{})
+	drivers.RegisterAsConnector("openai", driver{})
+}
+
+type driver struct{}
+
+var _ drivers.Driver = driver{}
+
+type configProperties struct {
+	// APIKey is the API key to authenticate with. It is optional since local servers usually don't require authentication.
+	APIKey string `mapstructure:"api_key"`
+	// BaseURL is the base URL of the OpenAI-compatible API, e.g. "http://localhost:11434/v1" for Ollama.
+	BaseURL string `mapstructure:"base_url"`
+	// Model is the name of the model to use.
+	Model string `mapstructure:"model"`
+	// Temperature is the sampling temperature. It is a pointer to distinguish an explicit 0 from an unset value.
+	Temperature *float32 `mapstructure:"temperature"`
+}
+
+// Spec implements drivers.Driver.
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+// Open implements drivers.Driver.
+func (d driver) Open(instanceID string, config map[string]any, st *storage.Client, ac *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, errors.New("openai driver can't be shared")
+	}
+
+	cfg := &configProperties{}
+	err := mapstructure.WeakDecode(config, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Model == "" {
+		cfg.Model = defaultModel
+	}
+	if cfg.Temperature == nil {
+		t := float32(defaultTemperature)
+		cfg.Temperature = &t
+	}
+	if *cfg.Temperature < 0 || *cfg.Temperature > 2 {
+		return nil, fmt.Errorf("invalid temperature %v: must be between 0 and 2", *cfg.Temperature)
+	}
+
+	clientCfg := openai.DefaultConfig(cfg.APIKey)
+	if cfg.BaseURL != "" {
+		clientCfg.BaseURL = cfg.BaseURL
+	}
+
+	return &handle{
+		config: cfg,
+		client: openai.NewClientWithConfig(clientCfg),
+		logger: logger,
+	}, nil
+}
+
+// HasAnonymousSourceAccess implements drivers.Driver.
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, props map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+// TertiarySourceConnectors implements drivers.Driver.
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type handle struct {
+	config *configProperties
+	client *openai.Client
+	logger *zap.Logger
+}
+
+var _ drivers.Handle = &handle{}
+
+// Ping implements drivers.Handle.
+func (h *handle) Ping(ctx context.Context) error {
+	_, err := h.client.ListModels(ctx)
+	return err
+}
+
+// Driver implements drivers.Handle.
+func (h *handle) Driver() string {
+	return "openai"
+}
+
+// Config implements drivers.Handle.
+func (h *handle) Config() map[string]any {
+	return map[string]any{
+		"base_url":    h.config.BaseURL,
+		"model":       h.config.Model,
+		"temperature": *h.config.Temperature,
+	}
+}
+
+// Migrate implements drivers.Handle.
+func (h *handle) Migrate(ctx context.Context) error {
+	return nil
+}
+
+// MigrationStatus implements drivers.Handle.
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// Close implements drivers.Handle.
+func (h *handle) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Handle.
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Handle.
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Handle.
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return h, true
+}
+
+// AsOLAP implements drivers.Handle.
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// AsObjectStore implements drivers.Handle.
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Handle.
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (h *handle) AsWarehouse() (drivers.Warehouse, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Handle.
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Handle.
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
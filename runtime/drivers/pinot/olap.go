@@ -115,6 +115,11 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 	return r, nil
 }
 
+// EstimateCost implements drivers.OLAPStore.
+func (c *connection) EstimateCost(ctx context.Context, stmt *drivers.Statement) (*drivers.QueryCost, error) {
+	return nil, drivers.ErrNotImplemented
+}
+
 func (c *connection) MayBeScaledToZero(ctx context.Context) bool {
 	return false
 }
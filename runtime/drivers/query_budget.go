@@ -0,0 +1,62 @@
+package drivers
+
+import (
+	"context"
+	"errors"
+)
+
+// QueryCost is an estimate of the resources needed to execute a query.
+// The estimates are derived from the OLAP store's query planner and may be inaccurate.
+type QueryCost struct {
+	// ScannedRows is the estimated number of rows scanned by the query.
+	ScannedRows int64
+	// ScannedBytes is the estimated number of bytes scanned by the query. It is 0 if the OLAP store can't estimate it.
+	ScannedBytes int64
+}
+
+// QueryBudget limits the resources that can be used by queries.
+// It can be added to a context with WithQueryBudget, in which case OLAP stores will charge the estimated cost of each query against it before executing the query.
+type QueryBudget interface {
+	// Applies returns true if the budget limits queries of the dialect.
+	// If it returns false, the cost of queries is not estimated, which avoids running an EXPLAIN for every query.
+	Applies(dialect Dialect) bool
+	// Charge adds the cost of a query to the budget.
+	// It returns an error without charging the cost if the query would exceed the budget.
+	Charge(ctx context.Context, dialect Dialect, cost *QueryCost) error
+}
+
+type queryBudgetCtxKey struct{}
+
+// WithQueryBudget returns a context that carries a query budget.
+// Passing a nil budget removes any budget from the context.
+func WithQueryBudget(ctx context.Context, b QueryBudget) context.Context {
+	return context.WithValue(ctx, queryBudgetCtxKey{}, b)
+}
+
+// QueryBudgetFromContext returns the query budget carried by the context, or nil if there is none.
+func QueryBudgetFromContext(ctx context.Context) QueryBudget {
+	b, _ := ctx.Value(queryBudgetCtxKey{}).(QueryBudget)
+	return b
+}
+
+// ChargeQueryBudget estimates the cost of a statement and charges it against the query budget in the context (if any).
+// It should be called by OLAP stores before executing a statement.
+// Statements that can't be estimated are not charged, since failing them would make the OLAP store unusable.
+// If the context has no budget or the budget doesn't apply to the OLAP store, it returns immediately without estimating the cost.
+func ChargeQueryBudget(ctx context.Context, olap OLAPStore, stmt *Statement) error {
+	b := QueryBudgetFromContext(ctx)
+	if b == nil || stmt.DryRun || !b.Applies(olap.Dialect()) {
+		return nil
+	}
+
+	// Remove the budget from the context to prevent estimation queries from being charged
+	cost, err := olap.EstimateCost(WithQueryBudget(ctx, nil), stmt)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		return nil
+	}
+
+	return b.Charge(ctx, olap.Dialect(), cost)
+}
@@ -38,6 +38,8 @@ type Instance struct {
 	AdminConnector string
 	// Driver name for the AI service (optional)
 	AIConnector string
+	// ProjectAIConnector is an override of AIConnector that may be set in rill.yaml.
+	ProjectAIConnector string
 	// Driver name for catalog
 	CatalogConnector string
 	// CreatedOn is when the instance was created
@@ -64,6 +66,8 @@ type Instance struct {
 	WatchRepo bool `db:"watch_repo"`
 	// Paths to expose over HTTP (defaults to ./public)
 	PublicPaths []string `db:"public_paths"`
+	// NotificationPolicy configures project-level handling of alert notifications, as set in rill.yaml
+	NotificationPolicy *NotificationPolicy `db:"notification_policy"`
 	// IgnoreInitialInvalidProjectError indicates whether to ignore an invalid project error when the instance is initially created.
 	IgnoreInitialInvalidProjectError bool `db:"-"`
 }
@@ -99,6 +103,15 @@ type InstanceConfig struct {
 	// AlertStreamingRefDefaultRefreshCron sets a default cron expression for refreshing alerts with streaming refs.
 	// Namely, this is used to check alerts against external tables (e.g. in Druid) where new data may be added at any time (i.e. is considered "streaming").
 	AlertsDefaultStreamingRefreshCron string `mapstructure:"rill.alerts.default_streaming_refresh_cron"`
+	// QueryBudgetScannedRowsPerHour is the max number of rows that queries of a single user or token may scan per hour, as estimated by the OLAP store. If set to 0, there is no limit.
+	QueryBudgetScannedRowsPerHour int64 `mapstructure:"rill.query_budget.scanned_rows_per_hour"`
+	// QueryBudgetScannedBytesPerHour is the max number of bytes that queries of a single user or token may scan per hour, as estimated by the OLAP store. If set to 0, there is no limit.
+	// It's only enforced for OLAP stores that can estimate scanned bytes (ClickHouse and Druid); for DuckDB, use QueryBudgetScannedRowsPerHour instead.
+	QueryBudgetScannedBytesPerHour int64 `mapstructure:"rill.query_budget.scanned_bytes_per_hour"`
+	// GoogleSheetsCredentialsJSON is a Google service account key used for exports to Google Sheets.
+	GoogleSheetsCredentialsJSON string `mapstructure:"rill.google_sheets.credentials_json"`
+	// GoogleSheetsEndpoint overrides the Google Sheets API endpoint, e.g. to target a local stand-in for the API.
+	GoogleSheetsEndpoint string `mapstructure:"rill.google_sheets.endpoint"`
 }
 
 // ResolveOLAPConnector resolves the OLAP connector to default to for the instance.
@@ -109,6 +122,14 @@ func (i *Instance) ResolveOLAPConnector() string {
 	return i.OLAPConnector
 }
 
+// ResolveAIConnector resolves the AI connector to use for the instance.
+func (i *Instance) ResolveAIConnector() string {
+	if i.ProjectAIConnector != "" {
+		return i.ProjectAIConnector
+	}
+	return i.AIConnector
+}
+
 // ResolveVariables returns the final resolved variables
 func (i *Instance) ResolveVariables(withLowerKeys bool) map[string]string {
 	r := make(map[string]string, len(i.ProjectVariables)+len(i.Variables))
@@ -147,6 +168,8 @@ func (i *Instance) Config() (InstanceConfig, error) {
 		MetricsApproxComparisonTwoPhaseLimit: 250,
 		MetricsExactifyDruidTopN:             false,
 		AlertsDefaultStreamingRefreshCron:    "*/10 * * * *", // Every 10 minutes
+		QueryBudgetScannedRowsPerHour:        0,
+		QueryBudgetScannedBytesPerHour:       0,
 	}
 
 	// Resolve variables
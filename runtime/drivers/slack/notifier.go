@@ -57,12 +57,29 @@ func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
 		EditLink:         s.EditLink,
 	}
 
-	buf := new(bytes.Buffer)
-	err := n.templates.Lookup("scheduled_report.slack").Execute(buf, d)
+	txt, err := n.scheduledReportText(d)
 	if err != nil {
-		return fmt.Errorf("slack template error: %w", err)
+		return err
+	}
+
+	if len(s.Attachments) > 0 {
+		// Files can't be sent through webhooks, so they only get the text without the files (which links to the report)
+		if err := n.sendTextViaWebhooks(txt); err != nil {
+			return err
+		}
+
+		for _, a := range s.Attachments {
+			d.Attachments = append(d.Attachments, a.Filename)
+		}
+		txt, err := n.scheduledReportText(d)
+		if err != nil {
+			return err
+		}
+		if err := n.sendFilesToChannels(txt, s.Attachments); err != nil {
+			return err
+		}
+		return n.sendFilesToUsers(txt, s.Attachments)
 	}
-	txt := buf.String()
 
 	if err := n.sendTextToChannels(txt); err != nil {
 		return err
@@ -73,6 +90,15 @@ func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
 	return n.sendTextViaWebhooks(txt)
 }
 
+func (n *notifier) scheduledReportText(d ReportStatusData) (string, error) {
+	buf := new(bytes.Buffer)
+	err := n.templates.Lookup("scheduled_report.slack").Execute(buf, d)
+	if err != nil {
+		return "", fmt.Errorf("slack template error: %w", err)
+	}
+	return buf.String(), nil
+}
+
 func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
 	switch s.Status {
 	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
@@ -106,6 +132,34 @@ func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
 	}
 }
 
+func (n *notifier) SendAlertDigest(d *drivers.AlertDigest) error {
+	data := &AlertDigestData{}
+	for _, s := range d.Alerts {
+		data.Alerts = append(data.Alerts, AlertDigestItem{
+			DisplayName:         s.DisplayName,
+			ExecutionTimeString: s.ExecutionTime.Format(time.RFC1123),
+			Summary:             s.Summary(),
+			ErrorMessage:        s.ExecutionError,
+			OpenLink:            htemplate.URL(s.OpenLink),
+		})
+	}
+
+	buf := new(bytes.Buffer)
+	err := n.templates.Lookup("alert_digest.slack").Execute(buf, data)
+	if err != nil {
+		return fmt.Errorf("slack template error: %w", err)
+	}
+	txt := buf.String()
+
+	if err := n.sendTextToChannels(txt); err != nil {
+		return err
+	}
+	if err := n.sendTextToUsers(txt); err != nil {
+		return err
+	}
+	return n.sendTextViaWebhooks(txt)
+}
+
 func (n *notifier) sendAlertStatus(data *AlertStatusData) error {
 	subject := fmt.Sprintf("%s (%s)", data.DisplayName, data.ExecutionTimeString)
 	if data.IsRecover {
@@ -188,6 +242,70 @@ func (n *notifier) sendTextToUsers(txt string) error {
 	return nil
 }
 
+func (n *notifier) sendFilesToChannels(txt string, files []*drivers.Attachment) error {
+	if len(n.props.Channels) == 0 {
+		return nil
+	}
+
+	if n.api == nil {
+		return fmt.Errorf("slack api is not configured, consider setting a bot token")
+	}
+
+	for _, channel := range n.props.Channels {
+		if err := n.uploadFiles(channel, txt, files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *notifier) sendFilesToUsers(txt string, files []*drivers.Attachment) error {
+	if len(n.props.Users) == 0 {
+		return nil
+	}
+
+	if n.api == nil {
+		return fmt.Errorf("slack api is not configured, consider setting a bot token")
+	}
+
+	for _, email := range n.props.Users {
+		user, err := n.api.GetUserByEmail(email)
+		if err != nil {
+			return fmt.Errorf("slack api error: %w", err)
+		}
+		// Files can only be uploaded to a conversation, so we open the direct message conversation with the user
+		ch, _, _, err := n.api.OpenConversation(&slack.OpenConversationParameters{Users: []string{user.ID}})
+		if err != nil {
+			return fmt.Errorf("slack api error: %w", err)
+		}
+		if err := n.uploadFiles(ch.ID, txt, files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uploadFiles uploads files to a channel. The text is posted as a comment on the first file.
+func (n *notifier) uploadFiles(channel, txt string, files []*drivers.Attachment) error {
+	for i, f := range files {
+		params := slack.UploadFileV2Parameters{
+			Reader:   bytes.NewReader(f.Data),
+			FileSize: len(f.Data),
+			Filename: f.Filename,
+			Title:    f.Filename,
+			Channel:  channel,
+		}
+		if i == 0 {
+			params.InitialComment = txt
+		}
+		_, err := n.api.UploadFileV2(params)
+		if err != nil {
+			return fmt.Errorf("slack api error: %w", err)
+		}
+	}
+	return nil
+}
+
 func (n *notifier) sendTextViaWebhooks(txt string) error {
 	for _, webhook := range n.props.Webhooks {
 		payload := slack.WebhookMessage{
@@ -225,6 +343,7 @@ type ReportStatusData struct {
 	OpenLink         string
 	DownloadLink     string
 	EditLink         string
+	Attachments      []string
 }
 
 type AlertStatusData struct {
@@ -247,3 +366,15 @@ type AlertFailData struct {
 	OpenLink            htemplate.URL
 	EditLink            htemplate.URL
 }
+
+type AlertDigestData struct {
+	Alerts []AlertDigestItem
+}
+
+type AlertDigestItem struct {
+	DisplayName         string
+	ExecutionTimeString string
+	Summary             string
+	ErrorMessage        string
+	OpenLink            htemplate.URL
+}
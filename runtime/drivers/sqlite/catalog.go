@@ -2,6 +2,9 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"strings"
 	"time"
 
@@ -357,3 +360,239 @@ func (c *catalogStore) UpsertInstanceHealth(ctx context.Context, h *drivers.Inst
 	`, h.InstanceID, h.HealthJSON)
 	return err
 }
+
+func (c *catalogStore) FindAIConversations(ctx context.Context, opts *drivers.FindAIConversationsOptions) ([]*drivers.AIConversation, error) {
+	var qry strings.Builder
+	var args []any
+
+	qry.WriteString("SELECT id, owner_id, title, created_on, updated_on FROM ai_conversations WHERE instance_id=? AND owner_id=?")
+	args = append(args, c.instanceID, opts.OwnerID)
+
+	if !opts.AfterUpdatedOn.IsZero() || opts.AfterID != "" {
+		qry.WriteString(" AND (updated_on < ? OR (updated_on = ? AND id < ?))")
+		args = append(args, opts.AfterUpdatedOn, opts.AfterUpdatedOn, opts.AfterID)
+	}
+
+	qry.WriteString(" ORDER BY updated_on DESC, id DESC")
+
+	if opts.Limit != 0 {
+		qry.WriteString(" LIMIT ?")
+		args = append(args, opts.Limit)
+	}
+
+	rows, err := c.db.QueryContext(ctx, qry.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.AIConversation
+	for rows.Next() {
+		r := &drivers.AIConversation{}
+		err := rows.Scan(&r.ID, &r.OwnerID, &r.Title, &r.CreatedOn, &r.UpdatedOn)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return res, nil
+}
+
+func (c *catalogStore) FindAIConversation(ctx context.Context, conversationID string) (*drivers.AIConversation, error) {
+	r := &drivers.AIConversation{}
+	err := c.db.QueryRowContext(ctx, "SELECT id, owner_id, title, created_on, updated_on FROM ai_conversations WHERE instance_id=? AND id=?", c.instanceID, conversationID).Scan(&r.ID, &r.OwnerID, &r.Title, &r.CreatedOn, &r.UpdatedOn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (c *catalogStore) InsertAIConversation(ctx context.Context, conv *drivers.AIConversation) error {
+	_, err := c.db.ExecContext(ctx, "INSERT INTO ai_conversations(id, instance_id, owner_id, title, created_on, updated_on) VALUES (?, ?, ?, ?, ?, ?)", conv.ID, c.instanceID, conv.OwnerID, conv.Title, conv.CreatedOn, conv.UpdatedOn)
+	return err
+}
+
+func (c *catalogStore) DeleteAIConversation(ctx context.Context, conversationID string) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM ai_messages WHERE instance_id=? AND conversation_id=?", c.instanceID, conversationID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, "DELETE FROM ai_conversations WHERE instance_id=? AND id=?", c.instanceID, conversationID)
+	return err
+}
+
+func (c *catalogStore) FindAIMessages(ctx context.Context, conversationID string) ([]*drivers.AIMessage, error) {
+	rows, err := c.db.QueryContext(ctx, "SELECT id, conversation_id, idx, role, data, tool_calls_json, tool_call_id, created_on FROM ai_messages WHERE instance_id=? AND conversation_id=? ORDER BY idx", c.instanceID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.AIMessage
+	for rows.Next() {
+		var toolCallsJSON []byte
+		r := &drivers.AIMessage{}
+		err := rows.Scan(&r.ID, &r.ConversationID, &r.Index, &r.Role, &r.Data, &toolCallsJSON, &r.ToolCallID, &r.CreatedOn)
+		if err != nil {
+			return nil, err
+		}
+		if len(toolCallsJSON) > 0 {
+			err = json.Unmarshal(toolCallsJSON, &r.ToolCalls)
+			if err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, r)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return res, nil
+}
+
+func (c *catalogStore) InsertAIMessage(ctx context.Context, m *drivers.AIMessage) error {
+	var toolCallsJSON []byte
+	if len(m.ToolCalls) > 0 {
+		var err error
+		toolCallsJSON, err = json.Marshal(m.ToolCalls)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := c.db.ExecContext(ctx, "INSERT INTO ai_messages(id, instance_id, conversation_id, idx, role, data, tool_calls_json, tool_call_id, created_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		m.ID, c.instanceID, m.ConversationID, m.Index, m.Role, m.Data, toolCallsJSON, m.ToolCallID, m.CreatedOn)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, "UPDATE ai_conversations SET updated_on=? WHERE instance_id=? AND id=?", m.CreatedOn, c.instanceID, m.ConversationID)
+	return err
+}
+
+func (c *catalogStore) FindPendingNotifications(ctx context.Context) ([]*drivers.Notification, error) {
+	rows, err := c.db.QueryContext(ctx, "SELECT id, source, connector, properties_json, data_json, created_on, deliver_on, sent_on, attempts FROM notifications WHERE instance_id=? AND sent_on IS NULL ORDER BY deliver_on", c.instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return scanNotifications(rows)
+}
+
+func (c *catalogStore) CountSentNotifications(ctx context.Context, connector string, sentAfter time.Time) (int, error) {
+	var n int
+	err := c.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM notifications WHERE instance_id=? AND connector=? AND sent_on IS NOT NULL AND sent_on>?", c.instanceID, connector, sentAfter).Scan(&n)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (c *catalogStore) InsertNotification(ctx context.Context, n *drivers.Notification) error {
+	_, err := c.db.ExecContext(ctx, "INSERT INTO notifications(id, instance_id, source, connector, properties_json, data_json, created_on, deliver_on, sent_on, attempts) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		n.ID, c.instanceID, n.Source, n.Connector, n.PropertiesJSON, n.DataJSON, n.CreatedOn, n.DeliverOn, n.SentOn, n.Attempts)
+	return err
+}
+
+func (c *catalogStore) ClaimDueNotifications(ctx context.Context, deliverBefore, leaseUntil time.Time) ([]*drivers.Notification, error) {
+	rows, err := c.db.QueryContext(ctx, "SELECT id, source, connector, properties_json, data_json, created_on, deliver_on, sent_on, attempts FROM notifications WHERE instance_id=? AND sent_on IS NULL AND deliver_on<=? ORDER BY created_on", c.instanceID, deliverBefore)
+	if err != nil {
+		return nil, err
+	}
+	due, err := scanNotifications(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claim each notification by moving its delivery time to the end of the lease and counting the attempt.
+	// The update only succeeds if the attempts haven't changed since it was read, so a notification is only claimed by one of multiple concurrent callers.
+	// If the caller doesn't delete or requeue the notification before the lease ends, it becomes due again.
+	var res []*drivers.Notification
+	for _, n := range due {
+		r, err := c.db.ExecContext(ctx, "UPDATE notifications SET deliver_on=?, attempts=attempts+1 WHERE instance_id=? AND id=? AND sent_on IS NULL AND attempts=?", leaseUntil, c.instanceID, n.ID, n.Attempts)
+		if err != nil {
+			return nil, err
+		}
+		affected, err := r.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if affected == 0 {
+			continue
+		}
+		n.DeliverOn = leaseUntil
+		n.Attempts++
+		res = append(res, n)
+	}
+
+	return res, nil
+}
+
+func (c *catalogStore) UpdateNotificationDeliverOn(ctx context.Context, id string, deliverOn time.Time) error {
+	_, err := c.db.ExecContext(ctx, "UPDATE notifications SET deliver_on=? WHERE instance_id=? AND id=? AND sent_on IS NULL", deliverOn, c.instanceID, id)
+	return err
+}
+
+func (c *catalogStore) DeleteNotifications(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// We can't pass a []string as a bound parameter, so we have to build a query with a corresponding number of placeholders.
+	var qry strings.Builder
+	var args []any
+	qry.WriteString("DELETE FROM notifications WHERE instance_id=? AND id IN (")
+	args = append(args, c.instanceID)
+	for i, id := range ids {
+		if i == 0 {
+			qry.WriteString("?")
+		} else {
+			qry.WriteString(",?")
+		}
+		args = append(args, id)
+	}
+	qry.WriteString(")")
+
+	_, err := c.db.ExecContext(ctx, qry.String(), args...)
+	return err
+}
+
+func (c *catalogStore) DeleteSentNotifications(ctx context.Context, sentBefore time.Time) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM notifications WHERE instance_id=? AND sent_on IS NOT NULL AND sent_on<?", c.instanceID, sentBefore)
+	return err
+}
+
+func scanNotifications(rows *sql.Rows) ([]*drivers.Notification, error) {
+	defer rows.Close()
+
+	var res []*drivers.Notification
+	for rows.Next() {
+		n := &drivers.Notification{}
+		var sentOn sql.NullTime
+		err := rows.Scan(&n.ID, &n.Source, &n.Connector, &n.PropertiesJSON, &n.DataJSON, &n.CreatedOn, &n.DeliverOn, &sentOn, &n.Attempts)
+		if err != nil {
+			return nil, err
+		}
+		if sentOn.Valid {
+			n.SentOn = &sentOn.Time
+		}
+		res = append(res, n)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return res, nil
+}
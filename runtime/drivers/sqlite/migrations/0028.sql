@@ -0,0 +1,24 @@
+CREATE TABLE ai_conversations (
+    id TEXT PRIMARY KEY,
+    instance_id TEXT NOT NULL,
+    owner_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    created_on TIMESTAMP NOT NULL,
+    updated_on TIMESTAMP NOT NULL
+);
+
+CREATE INDEX ai_conversations_instance_id_owner_id_idx ON ai_conversations (instance_id, owner_id);
+
+CREATE TABLE ai_messages (
+    id TEXT PRIMARY KEY,
+    instance_id TEXT NOT NULL,
+    conversation_id TEXT NOT NULL,
+    idx INTEGER NOT NULL,
+    role TEXT NOT NULL,
+    data TEXT NOT NULL,
+    tool_calls_json BLOB,
+    tool_call_id TEXT NOT NULL,
+    created_on TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX ai_messages_conversation_id_idx_idx ON ai_messages (conversation_id, idx);
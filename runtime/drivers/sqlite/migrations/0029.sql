@@ -0,0 +1 @@
+ALTER TABLE instances ADD COLUMN project_ai_connector TEXT NOT NULL DEFAULT '';
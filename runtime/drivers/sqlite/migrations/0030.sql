@@ -0,0 +1,16 @@
+ALTER TABLE instances ADD COLUMN notification_policy BLOB;
+
+CREATE TABLE notifications (
+    id TEXT PRIMARY KEY,
+    instance_id TEXT NOT NULL,
+    source TEXT NOT NULL,
+    connector TEXT NOT NULL,
+    properties_json BLOB NOT NULL,
+    data_json BLOB NOT NULL,
+    created_on TIMESTAMP NOT NULL,
+    deliver_on TIMESTAMP NOT NULL,
+    sent_on TIMESTAMP,
+    attempts INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX notifications_instance_id_sent_on_deliver_on_idx ON notifications (instance_id, sent_on, deliver_on);
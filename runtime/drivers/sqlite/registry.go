@@ -41,6 +41,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			repo_connector,
 			admin_connector,
 			ai_connector,
+			project_ai_connector,
 			catalog_connector,
 			created_on,
 			updated_on,
@@ -52,7 +53,8 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			annotations,
 			embed_catalog,
 			watch_repo,
-			public_paths
+			public_paths,
+			notification_policy
 		FROM instances %s ORDER BY id
 	`, whereClause)
 
@@ -65,7 +67,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 	var res []*drivers.Instance
 	for rows.Next() {
 		// sqlite doesn't support maps need to read as bytes and convert to map
-		var variables, projectVariables, featureFlags, annotations, connectors, projectConnectors, publicPaths []byte
+		var variables, projectVariables, featureFlags, annotations, connectors, projectConnectors, publicPaths, notificationPolicy []byte
 		i := &drivers.Instance{}
 		err := rows.Scan(
 			&i.ID,
@@ -75,6 +77,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			&i.RepoConnector,
 			&i.AdminConnector,
 			&i.AIConnector,
+			&i.ProjectAIConnector,
 			&i.CatalogConnector,
 			&i.CreatedOn,
 			&i.UpdatedOn,
@@ -87,6 +90,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			&i.EmbedCatalog,
 			&i.WatchRepo,
 			&publicPaths,
+			&notificationPolicy,
 		)
 		if err != nil {
 			return nil, err
@@ -127,6 +131,14 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			return nil, err
 		}
 
+		if len(notificationPolicy) > 0 {
+			i.NotificationPolicy = &drivers.NotificationPolicy{}
+			err = json.Unmarshal(notificationPolicy, i.NotificationPolicy)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		res = append(res, i)
 	}
 
@@ -178,6 +190,11 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		return err
 	}
 
+	notificationPolicy, err := notificationPolicyToJSON(inst.NotificationPolicy)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	_, err = c.db.ExecContext(
 		ctx,
@@ -190,6 +207,7 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 			repo_connector,
 			admin_connector,
 			ai_connector,
+			project_ai_connector,
 			catalog_connector,
 			created_on,
 			updated_on,
@@ -201,9 +219,10 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 			annotations,
 			embed_catalog,
 			watch_repo,
-			public_paths
+			public_paths,
+			notification_policy
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 		`,
 		inst.ID,
 		inst.Environment,
@@ -212,6 +231,7 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		inst.RepoConnector,
 		inst.AdminConnector,
 		inst.AIConnector,
+		inst.ProjectAIConnector,
 		inst.CatalogConnector,
 		now,
 		now,
@@ -224,6 +244,7 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		inst.EmbedCatalog,
 		inst.WatchRepo,
 		publicPaths,
+		notificationPolicy,
 	)
 	if err != nil {
 		return err
@@ -276,6 +297,11 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 		return err
 	}
 
+	notificationPolicy, err := notificationPolicyToJSON(inst.NotificationPolicy)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	_, err = c.db.ExecContext(
 		ctx,
@@ -287,17 +313,19 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 			repo_connector = $5,
 			admin_connector = $6,
 			ai_connector = $7,
-			catalog_connector = $8,
-			updated_on = $9,
-			connectors = $10,
-			project_connectors = $11,
-			variables = $12,
-			project_variables = $13,
-			feature_flags = $14,
-			annotations = $15,
-			embed_catalog = $16,
-			watch_repo = $17,
-			public_paths = $18
+			project_ai_connector = $8,
+			catalog_connector = $9,
+			updated_on = $10,
+			connectors = $11,
+			project_connectors = $12,
+			variables = $13,
+			project_variables = $14,
+			feature_flags = $15,
+			annotations = $16,
+			embed_catalog = $17,
+			watch_repo = $18,
+			public_paths = $19,
+			notification_policy = $20
 		WHERE id = $1
 		`,
 		inst.ID,
@@ -307,6 +335,7 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 		inst.RepoConnector,
 		inst.AdminConnector,
 		inst.AIConnector,
+		inst.ProjectAIConnector,
 		inst.CatalogConnector,
 		now,
 		connectors,
@@ -318,6 +347,7 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 		inst.EmbedCatalog,
 		inst.WatchRepo,
 		publicPaths,
+		notificationPolicy,
 	)
 	if err != nil {
 		return err
@@ -371,3 +401,11 @@ func unmarshalConnectors(s []byte) ([]*runtimev1.Connector, error) {
 	err := json.Unmarshal(s, &defs)
 	return defs, err
 }
+
+// notificationPolicyToJSON serializes a notification policy. A nil policy is stored as NULL.
+func notificationPolicyToJSON(p *drivers.NotificationPolicy) ([]byte, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return json.Marshal(p)
+}
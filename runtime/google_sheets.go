@@ -0,0 +1,21 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/runtime/pkg/gsheets"
+)
+
+// GoogleSheets returns a client for exporting data to Google Sheets using the instance's Google Sheets config.
+func (r *Runtime) GoogleSheets(ctx context.Context, instanceID string) (*gsheets.Client, error) {
+	cfg, err := r.InstanceConfig(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gsheets.New(ctx, &gsheets.Options{
+		CredentialsJSON: cfg.GoogleSheetsCredentialsJSON,
+		AllowHostAccess: r.AllowHostAccess(),
+		Endpoint:        cfg.GoogleSheetsEndpoint,
+	})
+}
@@ -0,0 +1,495 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+)
+
+// ResourceLineage is the lineage of a resource in the resource DAG.
+type ResourceLineage struct {
+	// Upstream are the resources that the resource depends on, directly or indirectly.
+	Upstream []*runtimev1.ResourceName
+	// Downstream are the resources that depend on the resource, directly or indirectly.
+	Downstream []*runtimev1.ResourceName
+	// Edges are the direct dependencies between the resources in the graph.
+	Edges []*runtimev1.ResourceLineageEdge
+	// Columns is the column-level lineage of the models and metrics views in the graph.
+	Columns []*runtimev1.ColumnLineage
+}
+
+// Lineage returns the upstream and downstream resources of the named resource.
+// If columns is true, it also computes column-level lineage for the models and metrics views in the graph.
+//
+// Column-level lineage is best-effort: it is only computed for DuckDB models and metrics views,
+// and only traces columns in the outermost SELECT of a model's SQL (CTEs and subqueries are treated as opaque).
+func (r *Runtime) Lineage(ctx context.Context, instanceID string, name *runtimev1.ResourceName, columns bool) (*ResourceLineage, error) {
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	self, err := ctrl.Get(ctx, name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := ctrl.List(ctx, "", "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Index the resources and their dependents
+	byKey := make(map[string]*runtimev1.Resource, len(rs))
+	dependents := make(map[string][]*runtimev1.Resource)
+	for _, r := range rs {
+		byKey[lineageKey(r.Meta.Name)] = r
+		for _, ref := range r.Meta.Refs {
+			k := lineageKey(ref)
+			dependents[k] = append(dependents[k], r)
+		}
+	}
+
+	// Walk the graph in both directions
+	selfKey := lineageKey(self.Meta.Name)
+	graph := map[string]*runtimev1.Resource{selfKey: self}
+	upstream := walkLineage(self, func(r *runtimev1.Resource) []*runtimev1.Resource {
+		var res []*runtimev1.Resource
+		for _, ref := range r.Meta.Refs {
+			if r, ok := byKey[lineageKey(ref)]; ok {
+				res = append(res, r)
+			}
+		}
+		return res
+	})
+	downstream := walkLineage(self, func(r *runtimev1.Resource) []*runtimev1.Resource {
+		return dependents[lineageKey(r.Meta.Name)]
+	})
+
+	res := &ResourceLineage{}
+	for _, r := range upstream {
+		graph[lineageKey(r.Meta.Name)] = r
+		res.Upstream = append(res.Upstream, r.Meta.Name)
+	}
+	for _, r := range downstream {
+		graph[lineageKey(r.Meta.Name)] = r
+		res.Downstream = append(res.Downstream, r.Meta.Name)
+	}
+	slices.SortFunc(res.Upstream, compareResourceNames)
+	slices.SortFunc(res.Downstream, compareResourceNames)
+
+	// Collect the edges between resources in the graph
+	nodes := make([]*runtimev1.Resource, 0, len(graph))
+	for _, r := range graph {
+		nodes = append(nodes, r)
+	}
+	slices.SortFunc(nodes, func(a, b *runtimev1.Resource) int {
+		return compareResourceNames(a.Meta.Name, b.Meta.Name)
+	})
+	for _, n := range nodes {
+		for _, ref := range n.Meta.Refs {
+			from, ok := graph[lineageKey(ref)]
+			if !ok {
+				continue
+			}
+			res.Edges = append(res.Edges, &runtimev1.ResourceLineageEdge{From: from.Meta.Name, To: n.Meta.Name})
+		}
+	}
+
+	if !columns {
+		return res, nil
+	}
+
+	b := &columnLineageBuilder{
+		rt:         r,
+		instanceID: instanceID,
+		byKey:      byKey,
+		schemas:    make(map[string]map[string]string),
+	}
+	for _, n := range nodes {
+		cols, err := b.columns(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		res.Columns = append(res.Columns, cols...)
+	}
+
+	return res, nil
+}
+
+// columnLineageBuilder computes column-level lineage for resources.
+type columnLineageBuilder struct {
+	rt         *Runtime
+	instanceID string
+	byKey      map[string]*runtimev1.Resource
+	// schemas caches the column names of the tables produced by models and sources.
+	// It maps a resource key to a map of lowercase column names to the columns' original names.
+	schemas map[string]map[string]string
+}
+
+// columns returns the column lineage for a resource. It returns nil for resources that don't support column lineage.
+func (b *columnLineageBuilder) columns(ctx context.Context, r *runtimev1.Resource) ([]*runtimev1.ColumnLineage, error) {
+	switch r.Meta.Name.Kind {
+	case ResourceKindModel:
+		return b.modelColumns(ctx, r)
+	case ResourceKindMetricsView:
+		return b.metricsViewColumns(ctx, r)
+	default:
+		return nil, nil
+	}
+}
+
+// modelColumns maps the output columns of a DuckDB SQL model to the columns of the models and sources it selects from.
+func (b *columnLineageBuilder) modelColumns(ctx context.Context, r *runtimev1.Resource) ([]*runtimev1.ColumnLineage, error) {
+	spec := r.GetModel().Spec
+	if spec.InputProperties == nil || !b.isDuckDB(ctx, spec.InputConnector) {
+		return nil, nil
+	}
+	sql, ok := spec.InputProperties.AsMap()["sql"].(string)
+	if !ok || sql == "" {
+		return nil, nil
+	}
+	ast, err := duckdbsql.Parse(sql)
+	if err != nil {
+		// The SQL may contain templating or syntax that can't be parsed statically
+		return nil, nil
+	}
+
+	// The tables the model can select from
+	var upstream []*runtimev1.Resource
+	for _, ref := range r.Meta.Refs {
+		if ref.Kind != ResourceKindModel && ref.Kind != ResourceKindSource {
+			continue
+		}
+		if u, ok := b.byKey[lineageKey(ref)]; ok {
+			upstream = append(upstream, u)
+		}
+	}
+	if len(upstream) == 0 {
+		return nil, nil
+	}
+
+	lb := newColumnLineageList(r.Meta.Name)
+	colRefs := ast.ExtractColumnRefs()
+
+	excluded := make(map[string]bool)
+	for _, c := range colRefs {
+		if c.IsExclude {
+			excluded[strings.ToLower(unqualifiedColumn(c.Name))] = true
+		}
+	}
+
+	for _, c := range colRefs {
+		switch {
+		case c.IsExclude:
+			continue
+		case c.IsStar:
+			// If the star is qualified with the name of an upstream table, only expand that table.
+			// If it's qualified with an alias we can't resolve, expand all upstream tables.
+			stars := upstream
+			if c.RelationName != "" {
+				for _, u := range upstream {
+					if strings.EqualFold(c.RelationName, u.Meta.Name.Name) {
+						stars = []*runtimev1.Resource{u}
+						break
+					}
+				}
+			}
+			for _, u := range stars {
+				schema, err := b.schema(ctx, u)
+				if err != nil {
+					return nil, err
+				}
+				for _, col := range sortedColumns(schema) {
+					if excluded[strings.ToLower(col)] {
+						continue
+					}
+					lb.add(col, u.Meta.Name, col)
+				}
+			}
+		default:
+			name := unqualifiedColumn(c.Name)
+			if name == "" {
+				name = c.Expr
+			}
+			if name == "" {
+				continue
+			}
+			lb.add(name, nil, "")
+			for _, src := range c.SourceColumns {
+				err := b.resolveSource(ctx, lb, name, unqualifiedColumn(src), upstream)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return lb.list, nil
+}
+
+// metricsViewColumns maps the dimensions and measures of a DuckDB metrics view to the columns of its underlying model or source.
+func (b *columnLineageBuilder) metricsViewColumns(ctx context.Context, r *runtimev1.Resource) ([]*runtimev1.ColumnLineage, error) {
+	spec := r.GetMetricsView().State.ValidSpec
+	if spec == nil || !b.isDuckDB(ctx, spec.Connector) {
+		return nil, nil
+	}
+
+	// Find the model or source that the metrics view queries
+	table := spec.Model
+	if table == "" {
+		table = spec.Table
+	}
+	var upstream []*runtimev1.Resource
+	for _, ref := range r.Meta.Refs {
+		if (ref.Kind == ResourceKindModel || ref.Kind == ResourceKindSource) && strings.EqualFold(ref.Name, table) {
+			if u, ok := b.byKey[lineageKey(ref)]; ok {
+				upstream = append(upstream, u)
+			}
+		}
+	}
+	if len(upstream) == 0 {
+		return nil, nil
+	}
+
+	lb := newColumnLineageList(r.Meta.Name)
+	for _, d := range spec.Dimensions {
+		lb.add(d.Name, nil, "")
+		srcs := []string{d.Column}
+		if d.Expression != "" {
+			srcs = expressionColumns(d.Expression)
+		}
+		for _, src := range srcs {
+			err := b.resolveSource(ctx, lb, d.Name, src, upstream)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	measures := make(map[string]*runtimev1.MetricsViewSpec_MeasureV2, len(spec.Measures))
+	for _, m := range spec.Measures {
+		measures[m.Name] = m
+	}
+	for _, m := range spec.Measures {
+		lb.add(m.Name, nil, "")
+		for _, src := range measureColumns(m, measures, map[string]bool{}) {
+			err := b.resolveSource(ctx, lb, m.Name, src, upstream)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return lb.list, nil
+}
+
+// resolveSource adds the upstream tables that contain the source column as sources of the column.
+// If the schema of the upstream tables is not known and there is exactly one upstream table, it is assumed to contain the column.
+func (b *columnLineageBuilder) resolveSource(ctx context.Context, lb *columnLineageList, column, src string, upstream []*runtimev1.Resource) error {
+	if src == "" {
+		return nil
+	}
+	found := false
+	for _, u := range upstream {
+		schema, err := b.schema(ctx, u)
+		if err != nil {
+			return err
+		}
+		if col, ok := schema[strings.ToLower(src)]; ok {
+			lb.add(column, u.Meta.Name, col)
+			found = true
+		}
+	}
+	if !found && len(upstream) == 1 {
+		schema, err := b.schema(ctx, upstream[0])
+		if err != nil {
+			return err
+		}
+		if schema == nil {
+			lb.add(column, upstream[0].Meta.Name, src)
+		}
+	}
+	return nil
+}
+
+// schema returns the columns of the table produced by a model or source.
+// It returns nil if the table doesn't exist or can't be inspected.
+func (b *columnLineageBuilder) schema(ctx context.Context, r *runtimev1.Resource) (map[string]string, error) {
+	key := lineageKey(r.Meta.Name)
+	if s, ok := b.schemas[key]; ok {
+		return s, nil
+	}
+
+	var connector, table string
+	switch r.Meta.Name.Kind {
+	case ResourceKindModel:
+		connector = r.GetModel().State.ResultConnector
+		table = r.GetModel().State.ResultTable
+	case ResourceKindSource:
+		connector = r.GetSource().State.Connector
+		table = r.GetSource().State.Table
+	}
+	if connector == "" || table == "" {
+		b.schemas[key] = nil
+		return nil, nil
+	}
+
+	olap, release, err := b.rt.OLAP(ctx, b.instanceID, connector)
+	if err != nil {
+		b.schemas[key] = nil
+		return nil, nil
+	}
+	defer release()
+
+	t, err := olap.InformationSchema().Lookup(ctx, "", "", table)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		b.schemas[key] = nil
+		return nil, nil
+	}
+
+	s := make(map[string]string, len(t.Schema.Fields))
+	for _, f := range t.Schema.Fields {
+		s[strings.ToLower(f.Name)] = f.Name
+	}
+	b.schemas[key] = s
+	return s, nil
+}
+
+// isDuckDB returns true if the connector uses the DuckDB driver.
+func (b *columnLineageBuilder) isDuckDB(ctx context.Context, connector string) bool {
+	cfg, err := b.rt.ConnectorConfig(ctx, b.instanceID, connector)
+	if err != nil {
+		return false
+	}
+	return cfg.Driver == "duckdb"
+}
+
+// columnLineageList accumulates the column lineage of a resource in insertion order.
+type columnLineageList struct {
+	resource *runtimev1.ResourceName
+	list     []*runtimev1.ColumnLineage
+	byName   map[string]*runtimev1.ColumnLineage
+}
+
+func newColumnLineageList(resource *runtimev1.ResourceName) *columnLineageList {
+	return &columnLineageList{
+		resource: resource,
+		byName:   make(map[string]*runtimev1.ColumnLineage),
+	}
+}
+
+// add adds a column and optionally one of its sources. It skips duplicates.
+func (l *columnLineageList) add(column string, srcResource *runtimev1.ResourceName, srcColumn string) {
+	c, ok := l.byName[column]
+	if !ok {
+		c = &runtimev1.ColumnLineage{Resource: l.resource, Column: column}
+		l.byName[column] = c
+		l.list = append(l.list, c)
+	}
+	if srcResource == nil {
+		return
+	}
+	for _, s := range c.Sources {
+		if s.Column == srcColumn && compareResourceNames(s.Resource, srcResource) == 0 {
+			return
+		}
+	}
+	c.Sources = append(c.Sources, &runtimev1.ColumnLineageSource{Resource: srcResource, Column: srcColumn})
+}
+
+// measureColumns returns the names of the columns referenced by a measure.
+// Derived measures inherit the columns of the measures they reference.
+func measureColumns(m *runtimev1.MetricsViewSpec_MeasureV2, measures map[string]*runtimev1.MetricsViewSpec_MeasureV2, visited map[string]bool) []string {
+	if visited[m.Name] {
+		return nil
+	}
+	visited[m.Name] = true
+
+	var res []string
+	for _, col := range expressionColumns(m.Expression) {
+		// References to other measures are handled through ReferencedMeasures
+		if _, ok := measures[col]; ok {
+			continue
+		}
+		res = append(res, col)
+	}
+	for _, ref := range m.ReferencedMeasures {
+		if rm, ok := measures[ref]; ok {
+			res = append(res, measureColumns(rm, measures, visited)...)
+		}
+	}
+	return res
+}
+
+// expressionColumns returns the names of the columns referenced in a DuckDB SQL expression.
+// It returns nil if the expression can't be parsed.
+func expressionColumns(expr string) []string {
+	ast, err := duckdbsql.Parse(fmt.Sprintf("SELECT %s FROM tbl", expr))
+	if err != nil {
+		return nil
+	}
+	var res []string
+	for _, c := range ast.ExtractColumnRefs() {
+		for _, src := range c.SourceColumns {
+			res = append(res, unqualifiedColumn(src))
+		}
+	}
+	return res
+}
+
+// walkLineage returns the resources reachable from r through the next function, excluding r itself.
+func walkLineage(r *runtimev1.Resource, next func(r *runtimev1.Resource) []*runtimev1.Resource) []*runtimev1.Resource {
+	seen := map[string]bool{lineageKey(r.Meta.Name): true}
+	var res []*runtimev1.Resource
+	queue := []*runtimev1.Resource{r}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range next(n) {
+			k := lineageKey(m.Meta.Name)
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			res = append(res, m)
+			queue = append(queue, m)
+		}
+	}
+	return res
+}
+
+func lineageKey(n *runtimev1.ResourceName) string {
+	return fmt.Sprintf("%s/%s", n.Kind, strings.ToLower(n.Name))
+}
+
+func compareResourceNames(a, b *runtimev1.ResourceName) int {
+	if c := strings.Compare(a.Kind, b.Kind); c != 0 {
+		return c
+	}
+	return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+}
+
+// unqualifiedColumn returns the column name without a table qualifier (e.g. "tbl.col" -> "col").
+func unqualifiedColumn(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func sortedColumns(schema map[string]string) []string {
+	res := make([]string, 0, len(schema))
+	for _, col := range schema {
+		res = append(res, col)
+	}
+	slices.Sort(res)
+	return res
+}
@@ -0,0 +1,87 @@
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineage(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql":    `SELECT 'US' AS country, 1 AS val, 'x' AS other`,
+			"m2.sql":    `SELECT country, val * 2 AS double_val, upper(other) AS other_upper FROM m1`,
+			"mv.yaml": `
+type: metrics_view
+version: 1
+model: m2
+dimensions:
+- column: country
+- name: other
+  expression: lower(other_upper)
+measures:
+- name: total
+  expression: SUM(double_val)
+- name: total_doubled
+  type: derived
+  expression: total * 2
+  requires: [total]
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	m1 := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "m1"}
+	m2 := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "m2"}
+	mv := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "mv"}
+
+	// Resource lineage of the root model
+	l, err := rt.Lineage(context.Background(), id, m1, false)
+	require.NoError(t, err)
+	require.Empty(t, l.Upstream)
+	requireNames(t, []*runtimev1.ResourceName{mv, m2}, l.Downstream)
+	require.Len(t, l.Edges, 2)
+	require.Empty(t, l.Columns)
+
+	// Resource and column lineage of the metrics view
+	l, err = rt.Lineage(context.Background(), id, mv, true)
+	require.NoError(t, err)
+	requireNames(t, []*runtimev1.ResourceName{m1, m2}, l.Upstream)
+	require.Empty(t, l.Downstream)
+	require.Len(t, l.Edges, 2)
+
+	cols := make(map[string][]string)
+	for _, c := range l.Columns {
+		var srcs []string
+		for _, s := range c.Sources {
+			srcs = append(srcs, s.Resource.Name+"."+s.Column)
+		}
+		cols[c.Resource.Name+"."+c.Column] = srcs
+	}
+	require.Equal(t, map[string][]string{
+		"m2.country":       {"m1.country"},
+		"m2.double_val":    {"m1.val"},
+		"m2.other_upper":   {"m1.other"},
+		"mv.country":       {"m2.country"},
+		"mv.other":         {"m2.other_upper"},
+		"mv.total":         {"m2.double_val"},
+		"mv.total_doubled": {"m2.double_val"},
+	}, cols)
+
+	// Not found
+	_, err = rt.Lineage(context.Background(), id, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "nope"}, false)
+	require.Error(t, err)
+}
+
+func requireNames(t *testing.T, expected, actual []*runtimev1.ResourceName) {
+	require.Len(t, actual, len(expected))
+	for i, n := range expected {
+		require.Equal(t, n.Kind, actual[i].Kind)
+		require.Equal(t, n.Name, actual[i].Name)
+	}
+}
@@ -128,12 +128,6 @@ func (e *Executor) CacheKey(ctx context.Context) ([]byte, bool, error) {
 	return keyBytes, true, nil
 }
 
-// ValidateQuery validates the provided query against the executor's metrics view.
-func (e *Executor) ValidateQuery(qry *Query) error {
-	// TODO: Implement it
-	panic("not implemented")
-}
-
 // Timestamps queries min, max and watermark for the metrics view
 func (e *Executor) Timestamps(ctx context.Context) (TimestampsResult, error) {
 	if !e.timestamps.Min.IsZero() {
@@ -289,6 +283,10 @@ func (e *Executor) Query(ctx context.Context, qry *Query, executionTime *time.Ti
 		return nil, err
 	}
 
+	if err := e.rewriteMinGroupSize(ast); err != nil {
+		return nil, err
+	}
+
 	var res *drivers.Result
 	if !pivoting {
 		sql, args, err := ast.SQL()
@@ -400,6 +398,10 @@ func (e *Executor) Export(ctx context.Context, qry *Query, executionTime *time.T
 		return "", err
 	}
 
+	if err := e.rewriteMinGroupSize(ast); err != nil {
+		return "", err
+	}
+
 	if pivoting {
 		return e.executePivotExport(ctx, ast, pivotAST, format)
 	}
@@ -440,7 +442,8 @@ func (e *Executor) Search(ctx context.Context, qry *SearchQuery, executionTime *
 	// This is a hacky implementation since both metricsview.Query and AST are designed for aggregate queries.
 	// TODO :: Refactor the code and extract common functionality from metricsview.Query and AST and write SearchQuery to underlying SQL/Native druid query directly.
 
-	if e.olap.Dialect() == drivers.DialectDruid {
+	// The native search doesn't support enforcing a minimum group size or noise, so we only use it when neither is configured.
+	if e.olap.Dialect() == drivers.DialectDruid && e.security.MinGroupSize() == 0 && e.security.Noise() == 0 {
 		// native search
 		res, err := e.executeSearchInDruid(ctx, qry, executionTime)
 		if err == nil || !errors.Is(err, errDruidNativeSearchUnimplemented) {
@@ -494,6 +497,10 @@ func (e *Executor) Search(ctx context.Context, qry *SearchQuery, executionTime *
 			return nil, err
 		}
 
+		if err := e.rewriteMinGroupSize(ast); err != nil {
+			return nil, err
+		}
+
 		sql, args, err := ast.SQL()
 		if err != nil {
 			return nil, err
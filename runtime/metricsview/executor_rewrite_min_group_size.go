@@ -0,0 +1,101 @@
+package metricsview
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// noiseBuckets is the number of distinct noise factors that a hash is mapped to.
+// It must be odd so the factors are symmetric around 1.
+const noiseBuckets = 20001
+
+// rewriteMinGroupSize rewrites the AST to enforce the minimum group size and noise of the security policy.
+// It adds a HAVING clause to every SELECT that aggregates over the underlying table, which suppresses groups backed by fewer rows than the minimum.
+// Since comparison, spine and per-dimension sub-selects are also built directly against the underlying table, this covers comparison, pivot and export queries too.
+//
+// If noise is configured, measures computed against the underlying table are multiplied by a deterministic factor derived from the group's dimension values.
+// Using a deterministic factor ensures that repeating the same query doesn't allow averaging out the noise.
+func (e *Executor) rewriteMinGroupSize(ast *AST) error {
+	k := e.security.MinGroupSize()
+	noise := e.security.Noise()
+	if k <= 0 && noise <= 0 {
+		return nil
+	}
+
+	seen := make(map[*SelectNode]bool)
+	err := e.rewriteMinGroupSizeWalk(ast, ast.Root, k, noise, seen)
+	if err != nil {
+		return err
+	}
+	for _, n := range ast.CTEs {
+		err := e.rewriteMinGroupSizeWalk(ast, n, k, noise, seen)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Executor) rewriteMinGroupSizeWalk(ast *AST, n *SelectNode, k int, noise float64, seen map[*SelectNode]bool) error {
+	if n == nil || seen[n] {
+		return nil
+	}
+	seen[n] = true
+
+	// Recurse
+	if n.FromSelect != nil {
+		err := e.rewriteMinGroupSizeWalk(ast, n.FromSelect, k, noise, seen)
+		if err != nil {
+			return err
+		}
+	}
+	if n.SpineSelect != nil {
+		err := e.rewriteMinGroupSizeWalk(ast, n.SpineSelect, k, noise, seen)
+		if err != nil {
+			return err
+		}
+	}
+	for _, ljs := range n.LeftJoinSelects {
+		err := e.rewriteMinGroupSizeWalk(ast, ljs, k, noise, seen)
+		if err != nil {
+			return err
+		}
+	}
+	if n.JoinComparisonSelect != nil {
+		err := e.rewriteMinGroupSizeWalk(ast, n.JoinComparisonSelect, k, noise, seen)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Only rewrite nodes that aggregate the underlying table. Outer nodes only pass through values computed by these nodes.
+	if n.FromTable == nil || !n.Group {
+		return nil
+	}
+
+	if k > 0 {
+		n.Having = n.Having.and(fmt.Sprintf("COUNT(*) >= %d", k), nil)
+	}
+
+	if noise > 0 && len(n.MeasureFields) > 0 {
+		for i, f := range n.MeasureFields {
+			// Seed the hash with the measure name so different measures in the same group get different factors
+			exprs := make([]string, 0, len(n.DimFields)+1)
+			exprs = append(exprs, ast.dialect.EscapeStringValue(f.Name))
+			for _, d := range n.DimFields {
+				exprs = append(exprs, ast.sqlForAnyInGroup(d.Expr))
+			}
+			hash, err := ast.dialect.HashExpression(exprs)
+			if err != nil {
+				return fmt.Errorf("security policy: noise is not supported: %w", err)
+			}
+
+			// Maps the hash to a factor in the range [1-noise, 1+noise]
+			f.Expr = fmt.Sprintf("(%s) * (1 + %s * (CAST((%s) %% %d AS DOUBLE) / %d - 1))", f.Expr, strconv.FormatFloat(noise, 'f', -1, 64), hash, noiseBuckets, noiseBuckets/2)
+			n.MeasureFields[i] = f
+		}
+	}
+
+	return nil
+}
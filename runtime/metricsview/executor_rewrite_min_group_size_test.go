@@ -0,0 +1,167 @@
+package metricsview_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinGroupSize(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"data.sql": `
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP AS time, 'US' AS country, 1::DOUBLE AS val UNION ALL
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP AS time, 'US' AS country, 2::DOUBLE AS val UNION ALL
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP AS time, 'DK' AS country, 3::DOUBLE AS val UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP AS time, 'US' AS country, 4::DOUBLE AS val UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP AS time, 'US' AS country, 5::DOUBLE AS val UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP AS time, 'DK' AS country, 6::DOUBLE AS val UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP AS time, 'DK' AS country, 7::DOUBLE AS val
+`,
+			"mv.yaml": `
+type: metrics_view
+version: 1
+model: data
+timeseries: time
+dimensions:
+- column: country
+measures:
+- name: records
+  expression: COUNT(*)
+- name: total
+  expression: SUM(val)
+security:
+  access: true
+  min_group_size: 2
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 2, 0, 0)
+
+	t.Run("aggregation", func(t *testing.T) {
+		rows := queryMinGroupSize(t, rt, instanceID, &metricsview.Query{
+			MetricsView: "mv",
+			Dimensions:  []metricsview.Dimension{{Name: "country"}},
+			Measures:    []metricsview.Measure{{Name: "total"}},
+			TimeRange:   &metricsview.TimeRange{Start: day(1), End: day(2)},
+			Sort:        []metricsview.Sort{{Name: "country"}},
+		})
+		// DK only has one row on the first day, so it's suppressed
+		require.Equal(t, []map[string]any{
+			{"country": "US", "total": 3.0},
+		}, rows)
+	})
+
+	t.Run("comparison", func(t *testing.T) {
+		rows := queryMinGroupSize(t, rt, instanceID, &metricsview.Query{
+			MetricsView: "mv",
+			Dimensions:  []metricsview.Dimension{{Name: "country"}},
+			Measures: []metricsview.Measure{
+				{Name: "total"},
+				{Name: "total_prev", Compute: &metricsview.MeasureCompute{ComparisonValue: &metricsview.MeasureComputeComparisonValue{Measure: "total"}}},
+			},
+			TimeRange:           &metricsview.TimeRange{Start: day(2), End: day(3)},
+			ComparisonTimeRange: &metricsview.TimeRange{Start: day(1), End: day(2)},
+			Sort:                []metricsview.Sort{{Name: "country"}},
+		})
+		// DK has enough rows on the second day, but its comparison value is computed over a single row
+		require.Equal(t, []map[string]any{
+			{"country": "DK", "total": 13.0, "total_prev": nil},
+			{"country": "US", "total": 9.0, "total_prev": 3.0},
+		}, rows)
+	})
+
+	t.Run("totals", func(t *testing.T) {
+		rows := queryMinGroupSize(t, rt, instanceID, &metricsview.Query{
+			MetricsView: "mv",
+			Measures:    []metricsview.Measure{{Name: "records"}},
+		})
+		require.Equal(t, []map[string]any{{"records": int64(7)}}, rows)
+
+		rows = queryMinGroupSize(t, rt, instanceID, &metricsview.Query{
+			MetricsView: "mv",
+			Measures:    []metricsview.Measure{{Name: "records"}},
+			TimeRange:   &metricsview.TimeRange{Start: day(1), End: day(2)},
+			Where:       &metricsview.Expression{Condition: &metricsview.Condition{Operator: metricsview.OperatorEq, Expressions: []*metricsview.Expression{{Name: "country"}, {Value: "DK"}}}},
+		})
+		require.Empty(t, rows)
+	})
+}
+
+func TestNoise(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"data.sql": `
+SELECT 'US' AS country, range::DOUBLE AS val FROM range(100) UNION ALL
+SELECT 'DK' AS country, range::DOUBLE AS val FROM range(50)
+`,
+			"mv.yaml": `
+type: metrics_view
+version: 1
+model: data
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(val)
+security:
+  access: true
+  noise: 0.1
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 2, 0, 0)
+
+	qry := &metricsview.Query{
+		MetricsView: "mv",
+		Dimensions:  []metricsview.Dimension{{Name: "country"}},
+		Measures:    []metricsview.Measure{{Name: "total"}},
+		Sort:        []metricsview.Sort{{Name: "country"}},
+	}
+	rows := queryMinGroupSize(t, rt, instanceID, qry)
+	require.Len(t, rows, 2)
+
+	exact := map[string]float64{"DK": 1225, "US": 4950}
+	for _, row := range rows {
+		v := exact[row["country"].(string)]
+		require.InDelta(t, v, row["total"], v*0.1)
+	}
+
+	// The noise is deterministic, so repeating the query doesn't allow averaging it out
+	require.Equal(t, rows, queryMinGroupSize(t, rt, instanceID, qry))
+}
+
+func queryMinGroupSize(t *testing.T, rt *runtime.Runtime, instanceID string, qry *metricsview.Query) []map[string]any {
+	ctx := context.Background()
+	r := testruntime.GetResource(t, rt, instanceID, runtime.ResourceKindMetricsView, qry.MetricsView)
+	sec, err := rt.ResolveSecurity(instanceID, &runtime.SecurityClaims{UserAttributes: map[string]any{}}, r)
+	require.NoError(t, err)
+
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, r.GetMetricsView().State.ValidSpec, false, sec, 0)
+	require.NoError(t, err)
+	defer e.Close()
+
+	res, err := e.Query(ctx, qry, nil)
+	require.NoError(t, err)
+	defer res.Close()
+
+	var rows []map[string]any
+	for res.Next() {
+		row := make(map[string]any)
+		require.NoError(t, res.MapScan(row))
+		rows = append(rows, row)
+	}
+	require.NoError(t, res.Err())
+	return rows
+}
+
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+}
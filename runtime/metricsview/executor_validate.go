@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -103,6 +104,17 @@ func (e *Executor) ValidateMetricsView(ctx context.Context) (*ValidateMetricsVie
 		}
 	}
 
+	// Check noise is supported by the OLAP connector since it relies on a dialect-specific hash function
+	for _, rule := range mv.SecurityRules {
+		if rule.GetMinGroupSize().GetNoise() == 0 {
+			continue
+		}
+		if _, err := e.olap.Dialect().HashExpression(nil); err != nil {
+			res.OtherErrs = append(res.OtherErrs, fmt.Errorf("invalid 'security': 'noise' is not supported for metrics views backed by %s", e.olap.Dialect().String()))
+		}
+		break
+	}
+
 	// ClickHouse specifically does not support using a column name as a dimension or measure name if the dimension or measure has an expression.
 	// This is due to ClickHouse's aggressive substitution of aliases: https://github.com/ClickHouse/ClickHouse/issues/9715.
 	if e.olap.Dialect() == drivers.DialectClickHouse {
@@ -354,3 +366,219 @@ func (e *Executor) validateSchema(ctx context.Context, res *ValidateMetricsViewR
 
 	return nil
 }
+
+// ValidateQuery validates the provided query against the executor's metrics view.
+// It checks that the query only references fields that exist and that the security policy grants access to, and that its options are well-formed.
+// It does not access the underlying OLAP database.
+func (e *Executor) ValidateQuery(qry *Query) error {
+	if !e.security.CanAccess() {
+		return runtime.ErrForbidden
+	}
+
+	a := &AST{metricsView: e.metricsView, security: e.security, query: qry}
+	if len(qry.Dimensions) == 0 && len(qry.Measures) == 0 {
+		return errors.New("must specify at least one dimension or measure")
+	}
+
+	// Track the names of the fields output by the query
+	fields := make(map[string]bool)
+
+	for _, d := range qry.Dimensions {
+		if err := e.validateQueryDimension(a, d); err != nil {
+			return err
+		}
+		if fields[d.Name] {
+			return fmt.Errorf("dimension %q is selected more than once", d.Name)
+		}
+		fields[d.Name] = true
+	}
+
+	for _, m := range qry.Measures {
+		if m.Compute == nil {
+			if _, err := a.lookupMeasure(m.Name, true); err != nil {
+				return err
+			}
+		} else {
+			if err := m.Compute.Validate(); err != nil {
+				return fmt.Errorf("invalid compute for measure %q: %w", m.Name, err)
+			}
+			if err := a.checkNameForComputedField(m.Name); err != nil {
+				return fmt.Errorf("invalid name for measure %q: %w", m.Name, err)
+			}
+			if err := e.validateQueryMeasureCompute(a, m.Compute); err != nil {
+				return fmt.Errorf("invalid compute for measure %q: %w", m.Name, err)
+			}
+		}
+		if fields[m.Name] {
+			return fmt.Errorf("measure %q is selected more than once", m.Name)
+		}
+		fields[m.Name] = true
+	}
+
+	for _, n := range qry.PivotOn {
+		if !fields[n] {
+			return fmt.Errorf("pivot field %q is not selected in the query", n)
+		}
+	}
+
+	for _, s := range qry.Sort {
+		if !fields[s.Name] {
+			return fmt.Errorf("sort field %q is not selected in the query", s.Name)
+		}
+	}
+
+	if err := e.validateQueryTimeRange(qry.TimeRange); err != nil {
+		return fmt.Errorf("invalid time range: %w", err)
+	}
+	if err := e.validateQueryTimeRange(qry.ComparisonTimeRange); err != nil {
+		return fmt.Errorf("invalid comparison time range: %w", err)
+	}
+
+	if err := e.validateQueryExpression(a, qry.Where, nil); err != nil {
+		return fmt.Errorf("invalid where expression: %w", err)
+	}
+	if err := e.validateQueryExpression(a, qry.Having, fields); err != nil {
+		return fmt.Errorf("invalid having expression: %w", err)
+	}
+
+	if qry.Limit != nil && *qry.Limit < 0 {
+		return errors.New("limit must be non-negative")
+	}
+	if qry.Offset != nil && *qry.Offset < 0 {
+		return errors.New("offset must be non-negative")
+	}
+
+	if qry.TimeZone != "" {
+		if _, err := time.LoadLocation(qry.TimeZone); err != nil {
+			return fmt.Errorf("invalid time zone %q: %w", qry.TimeZone, err)
+		}
+	}
+
+	return nil
+}
+
+// validateQueryDimension validates a dimension selected in a query.
+func (e *Executor) validateQueryDimension(a *AST, d Dimension) error {
+	if d.Compute == nil {
+		_, err := a.lookupDimension(d.Name, true)
+		return err
+	}
+
+	if d.Compute.TimeFloor == nil {
+		return fmt.Errorf("must specify a compute operation for dimension %q", d.Name)
+	}
+	if _, err := a.lookupDimension(d.Compute.TimeFloor.Dimension, true); err != nil {
+		return err
+	}
+	if d.Compute.TimeFloor.Grain == TimeGrainUnspecified || !d.Compute.TimeFloor.Grain.Valid() {
+		return fmt.Errorf("invalid time grain %q for dimension %q", d.Compute.TimeFloor.Grain, d.Name)
+	}
+	if d.Name != d.Compute.TimeFloor.Dimension {
+		if err := a.checkNameForComputedField(d.Name); err != nil {
+			return fmt.Errorf("invalid name for dimension %q: %w", d.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateQueryMeasureCompute validates that the fields referenced by a measure's compute operation exist.
+func (e *Executor) validateQueryMeasureCompute(a *AST, c *MeasureCompute) error {
+	var dim, measure string
+	switch {
+	case c.CountDistinct != nil:
+		dim = c.CountDistinct.Dimension
+	case c.URI != nil:
+		dim = c.URI.Dimension
+	case c.ComparisonValue != nil:
+		measure = c.ComparisonValue.Measure
+	case c.ComparisonDelta != nil:
+		measure = c.ComparisonDelta.Measure
+	case c.ComparisonRatio != nil:
+		measure = c.ComparisonRatio.Measure
+	case c.PercentOfTotal != nil:
+		measure = c.PercentOfTotal.Measure
+	}
+
+	if dim != "" {
+		if _, err := a.lookupDimension(dim, true); err != nil {
+			return err
+		}
+	}
+	if measure != "" {
+		if _, err := a.lookupMeasure(measure, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateQueryTimeRange validates a time range in a query.
+func (e *Executor) validateQueryTimeRange(tr *TimeRange) error {
+	if tr == nil || tr.IsZero() {
+		return nil
+	}
+	if e.metricsView.TimeDimension == "" {
+		return errors.New("metrics view does not have a time dimension")
+	}
+	if !tr.Start.IsZero() && !tr.End.IsZero() && tr.End.Before(tr.Start) {
+		return errors.New("end is before start")
+	}
+	if !tr.RoundToGrain.Valid() {
+		return fmt.Errorf("invalid time grain %q", tr.RoundToGrain)
+	}
+	return nil
+}
+
+// validateQueryExpression validates the operators and names in an expression.
+// If fields is nil, names must reference a dimension or measure in the metrics view. Otherwise, they must reference a field in fields.
+func (e *Executor) validateQueryExpression(a *AST, expr *Expression, fields map[string]bool) error {
+	if expr == nil {
+		return nil
+	}
+
+	if expr.Name != "" {
+		if fields != nil {
+			if !fields[expr.Name] {
+				return fmt.Errorf("field %q is not selected in the query", expr.Name)
+			}
+			return nil
+		}
+		if _, err := a.lookupDimension(expr.Name, true); err == nil {
+			return nil
+		}
+		if _, err := a.lookupMeasure(expr.Name, true); err != nil {
+			return fmt.Errorf("field %q is not a dimension or measure in the metrics view", expr.Name)
+		}
+		return nil
+	}
+
+	if expr.Condition != nil {
+		if !expr.Condition.Operator.Valid() {
+			return fmt.Errorf("invalid operator %q", expr.Condition.Operator)
+		}
+		for _, sub := range expr.Condition.Expressions {
+			if err := e.validateQueryExpression(a, sub, fields); err != nil {
+				return err
+			}
+		}
+	}
+
+	if expr.Subquery != nil {
+		if err := e.validateQueryDimension(a, expr.Subquery.Dimension); err != nil {
+			return err
+		}
+		for _, m := range expr.Subquery.Measures {
+			if _, err := a.lookupMeasure(m.Name, true); err != nil {
+				return err
+			}
+		}
+		if err := e.validateQueryExpression(a, expr.Subquery.Where, nil); err != nil {
+			return err
+		}
+		if err := e.validateQueryExpression(a, expr.Subquery.Having, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
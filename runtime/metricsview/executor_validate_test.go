@@ -162,3 +162,115 @@ timeseries: time
 		})
 	}
 }
+
+func TestValidateQuery(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+	mv := &runtimev1.MetricsViewSpec{
+		Connector:     "duckdb",
+		Table:         "ad_bids",
+		TimeDimension: "timestamp",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "publisher", Column: "publisher"},
+			{Name: "domain", Column: "domain"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "records", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+		},
+	}
+
+	e, err := metricsview.NewExecutor(context.Background(), rt, instanceID, mv, false, runtime.ResolvedSecurityOpen, 0)
+	require.NoError(t, err)
+	defer e.Close()
+
+	limit := int64(-1)
+	tt := []struct {
+		name  string
+		query *metricsview.Query
+		err   string
+	}{
+		{
+			name: "valid",
+			query: &metricsview.Query{
+				Dimensions: []metricsview.Dimension{
+					{Name: "publisher"},
+					{Name: "timestamp", Compute: &metricsview.DimensionCompute{TimeFloor: &metricsview.DimensionComputeTimeFloor{Dimension: "timestamp", Grain: metricsview.TimeGrainDay}}},
+				},
+				Measures: []metricsview.Measure{
+					{Name: "records"},
+					{Name: "records__prev", Compute: &metricsview.MeasureCompute{ComparisonValue: &metricsview.MeasureComputeComparisonValue{Measure: "records"}}},
+				},
+				Sort:                []metricsview.Sort{{Name: "records", Desc: true}},
+				TimeRange:           &metricsview.TimeRange{Expression: "P7D"},
+				ComparisonTimeRange: &metricsview.TimeRange{Expression: "P7D", IsoOffset: "P7D"},
+				Where:               &metricsview.Expression{Condition: &metricsview.Condition{Operator: metricsview.OperatorEq, Expressions: []*metricsview.Expression{{Name: "domain"}, {Value: "msn.com"}}}},
+				Having:              &metricsview.Expression{Condition: &metricsview.Condition{Operator: metricsview.OperatorGt, Expressions: []*metricsview.Expression{{Name: "records"}, {Value: 10}}}},
+			},
+		},
+		{
+			name:  "empty",
+			query: &metricsview.Query{},
+			err:   "at least one dimension or measure",
+		},
+		{
+			name:  "unknown dimension",
+			query: &metricsview.Query{Dimensions: []metricsview.Dimension{{Name: "country"}}},
+			err:   `dimension "country" not found`,
+		},
+		{
+			name:  "unknown measure",
+			query: &metricsview.Query{Measures: []metricsview.Measure{{Name: "revenue"}}},
+			err:   `measure "revenue" not found`,
+		},
+		{
+			name: "sort on unselected field",
+			query: &metricsview.Query{
+				Dimensions: []metricsview.Dimension{{Name: "publisher"}},
+				Sort:       []metricsview.Sort{{Name: "records"}},
+			},
+			err: `sort field "records" is not selected`,
+		},
+		{
+			name: "invalid operator",
+			query: &metricsview.Query{
+				Measures: []metricsview.Measure{{Name: "records"}},
+				Where:    &metricsview.Expression{Condition: &metricsview.Condition{Operator: "like", Expressions: []*metricsview.Expression{{Name: "domain"}, {Value: "msn.com"}}}},
+			},
+			err: `invalid operator "like"`,
+		},
+		{
+			name: "having on unselected field",
+			query: &metricsview.Query{
+				Dimensions: []metricsview.Dimension{{Name: "publisher"}},
+				Having:     &metricsview.Expression{Condition: &metricsview.Condition{Operator: metricsview.OperatorGt, Expressions: []*metricsview.Expression{{Name: "records"}, {Value: 10}}}},
+			},
+			err: `field "records" is not selected`,
+		},
+		{
+			name: "negative limit",
+			query: &metricsview.Query{
+				Measures: []metricsview.Measure{{Name: "records"}},
+				Limit:    &limit,
+			},
+			err: "limit must be non-negative",
+		},
+		{
+			name: "invalid time zone",
+			query: &metricsview.Query{
+				Measures: []metricsview.Measure{{Name: "records"}},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			err: "invalid time zone",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := e.ValidateQuery(tc.query)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
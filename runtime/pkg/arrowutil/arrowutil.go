@@ -0,0 +1,48 @@
+// Package arrowutil contains helpers for converting query results to Apache Arrow.
+package arrowutil
+
+import (
+	"github.com/apache/arrow/go/v15/arrow"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// Schema converts a result schema to an Arrow schema.
+// All fields are nullable. Types without a direct Arrow equivalent are mapped to strings (and are expected to be serialized as JSON).
+func Schema(s *runtimev1.StructType) *arrow.Schema {
+	fields := make([]arrow.Field, 0, len(s.Fields))
+	for _, f := range s.Fields {
+		fields = append(fields, arrow.Field{
+			Name:     f.Name,
+			Type:     Type(f.Type),
+			Nullable: true,
+		})
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// Type returns the Arrow type used for values of the given type.
+func Type(t *runtimev1.Type) arrow.DataType {
+	switch t.GetCode() {
+	case runtimev1.Type_CODE_BOOL:
+		return arrow.FixedWidthTypes.Boolean
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
+		return arrow.PrimitiveTypes.Int64
+	case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
+		return arrow.PrimitiveTypes.Float64
+	case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
+		return arrow.PrimitiveTypes.Uint64
+	case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
+		return arrow.PrimitiveTypes.Float64
+	case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
+		return arrow.PrimitiveTypes.Float64
+	case runtimev1.Type_CODE_DECIMAL:
+		return arrow.PrimitiveTypes.Float64
+	case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
+		return arrow.FixedWidthTypes.Timestamp_us
+	case runtimev1.Type_CODE_BYTES:
+		return arrow.BinaryTypes.Binary
+	default:
+		// Includes STRING, INTERVAL, DATE, ARRAY, STRUCT, MAP, JSON and UUID
+		return arrow.BinaryTypes.String
+	}
+}
@@ -199,4 +199,6 @@ type ColumnRef struct {
 	IsAggr       bool
 	IsStar       bool
 	IsExclude    bool
+	// SourceColumns are the names of the columns referenced by the column's expression
+	SourceColumns []string
 }
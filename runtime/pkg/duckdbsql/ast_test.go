@@ -239,7 +239,7 @@ func TestParse_ColumnRefs(t *testing.T) {
 				{IsStar: true},
 				{Name: "id", IsExclude: true},
 				{Expr: "count_star()"},
-				{Name: "bid_price", Expr: "avg(bid_price)"},
+				{Name: "bid_price", Expr: "avg(bid_price)", SourceColumns: []string{"bid_price"}},
 			},
 		},
 		{
@@ -250,11 +250,11 @@ select b.*, i.city, i.country as i_cnt, exclude(i.id), count(*), avg(b.bid_price
 `,
 			[]*ColumnRef{
 				{IsStar: true, RelationName: "b"},
-				{Name: "i.city"},
-				{Name: "i_cnt"},
+				{Name: "i.city", SourceColumns: []string{"i.city"}},
+				{Name: "i_cnt", SourceColumns: []string{"i.country"}},
 				{Name: "i.id", IsExclude: true},
 				{Expr: "count_star()"},
-				{Name: "bid_price", Expr: "avg(b.bid_price)"},
+				{Name: "bid_price", Expr: "avg(b.bid_price)", SourceColumns: []string{"b.bid_price"}},
 			},
 		},
 		{
@@ -267,10 +267,10 @@ select b.*, i.city, exclude(i.id), count(*), avg(b.bid_price) as bid_price from
 `,
 			[]*ColumnRef{
 				{IsStar: true, RelationName: "b"},
-				{Name: "i.city"},
+				{Name: "i.city", SourceColumns: []string{"i.city"}},
 				{Name: "i.id", IsExclude: true},
 				{Expr: "count_star()"},
-				{Name: "bid_price", Expr: "avg(b.bid_price)"},
+				{Name: "bid_price", Expr: "avg(b.bid_price)", SourceColumns: []string{"b.bid_price"}},
 			},
 		},
 		{
@@ -286,9 +286,9 @@ with
 			[]*ColumnRef{
 				{IsStar: true, RelationName: "b"},
 				{Name: "i.id", IsExclude: true},
-				{Name: "i.city"},
+				{Name: "i.city", SourceColumns: []string{"i.city"}},
 				{Expr: "count_star()"},
-				{Name: "bid_price", Expr: "avg(b.bid_price)"},
+				{Name: "bid_price", Expr: "avg(b.bid_price)", SourceColumns: []string{"b.bid_price"}},
 			},
 		},
 	}
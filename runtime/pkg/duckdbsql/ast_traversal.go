@@ -3,9 +3,12 @@ package duckdbsql
 import (
 	"encoding/json"
 	"errors"
+	"maps"
 	"math"
 	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 // TODO: handle parameters in values
@@ -79,7 +82,8 @@ func (a *AST) traverseColumnNode(node astNode) {
 	switch toString(node, astKeyType) {
 	case "COLUMN_REF":
 		a.newColumnNode(node, &ColumnRef{
-			Name: getColumnName(node),
+			Name:          getColumnName(node),
+			SourceColumns: collectColumnNames(node, nil),
 		})
 
 	case "STAR":
@@ -95,6 +99,10 @@ func (a *AST) traverseColumnNode(node astNode) {
 		} else {
 			a.traverseExpressionColumnNode(node)
 		}
+
+	default:
+		// Other expressions, like CASE, casts and operators
+		a.traverseExpressionColumnNode(node)
 	}
 }
 
@@ -130,12 +138,39 @@ func (a *AST) traverseExpressionColumnNode(node astNode) {
 	}
 
 	a.newColumnNode(node, &ColumnRef{
-		Name: toString(node, astKeyAlias),
-		Expr: subMatches[1],
+		Name:          toString(node, astKeyAlias),
+		Expr:          subMatches[1],
+		SourceColumns: collectColumnNames(node, nil),
 	})
 	// TODO: fill in isAggr
 }
 
+// collectColumnNames appends the names of the columns referenced anywhere in an expression node to res.
+// Qualified names are joined with a dot (e.g. "tbl.col"). Duplicates are skipped.
+func collectColumnNames(node any, res []string) []string {
+	switch n := node.(type) {
+	case map[string]any:
+		if n[astKeyClass] == "COLUMN_REF" {
+			name := strings.Join(toTypedArray[string](n, astKeyColumnNames), ".")
+			if name != "" && !slices.Contains(res, name) {
+				res = append(res, name)
+			}
+			return res
+		}
+		// Iterate in key order to get a deterministic result
+		for _, k := range slices.Sorted(maps.Keys(n)) {
+			res = collectColumnNames(n[k], res)
+		}
+	case astNode:
+		return collectColumnNames(map[string]any(n), res)
+	case []any:
+		for _, v := range n {
+			res = collectColumnNames(v, res)
+		}
+	}
+	return res
+}
+
 func (a *AST) traverseFromTable(parent astNode, childKey string) {
 	node := toNode(parent, childKey)
 	switch toString(node, astKeyType) {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"html/template"
 	"math"
+	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -39,6 +40,8 @@ type ScheduledReport struct {
 	DownloadLink   string
 	EditLink       string
 	External       bool
+	// Attachments are sent as files with the email, such as a rendered snapshot of a dashboard.
+	Attachments []*Attachment
 }
 
 type scheduledReportData struct {
@@ -49,6 +52,7 @@ type scheduledReportData struct {
 	DownloadLink     template.URL
 	EditLink         template.URL
 	External         bool
+	Attached         bool
 }
 
 func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
@@ -61,6 +65,7 @@ func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
 		DownloadLink:     template.URL(opts.DownloadLink),
 		EditLink:         template.URL(opts.EditLink),
 		External:         opts.External,
+		Attached:         len(opts.Attachments) > 0,
 	}
 
 	// Build subject
@@ -75,6 +80,14 @@ func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
 	}
 	html := buf.String()
 
+	if len(opts.Attachments) > 0 {
+		s, ok := c.Sender.(AttachmentSender)
+		if !ok {
+			return fmt.Errorf("email sender does not support attachments")
+		}
+		return s.SendWithAttachments(opts.ToEmail, opts.ToName, subject, html, opts.Attachments)
+	}
+
 	return c.Sender.Send(opts.ToEmail, opts.ToName, subject, html)
 }
 
@@ -159,6 +172,30 @@ func (c *Client) sendAlertStatus(opts *drivers.AlertStatus, data *alertStatusDat
 	return c.Sender.Send(opts.ToEmail, opts.ToName, subject, html)
 }
 
+// SendAlertDigest sends a single email listing the status of multiple alerts.
+func (c *Client) SendAlertDigest(opts *drivers.AlertDigest) error {
+	body := new(strings.Builder)
+	fmt.Fprintf(body, "<b>%d alert notifications</b> were grouped into this email:<br /><ul>", len(opts.Alerts))
+	for _, s := range opts.Alerts {
+		fmt.Fprintf(body, "<li><b>%s</b> %s for %s.", template.HTMLEscapeString(s.DisplayName), s.Summary(), s.ExecutionTime.Format(time.RFC1123))
+		if s.ExecutionError != "" {
+			fmt.Fprintf(body, " <i>%s</i>", template.HTMLEscapeString(s.ExecutionError))
+		}
+		if s.OpenLink != "" {
+			fmt.Fprintf(body, ` <a href="%s" style="color:#4736F5">Open in browser</a>`, template.HTMLEscapeString(s.OpenLink))
+		}
+		body.WriteString("</li>")
+	}
+	body.WriteString("</ul>")
+
+	return c.SendInformational(&Informational{
+		ToEmail: opts.ToEmail,
+		ToName:  opts.ToName,
+		Subject: fmt.Sprintf("%d alert notifications", len(opts.Alerts)),
+		Body:    template.HTML(body.String()),
+	})
+}
+
 type CallToAction struct {
 	ToEmail    string
 	ToName     string
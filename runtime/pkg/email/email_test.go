@@ -1,7 +1,14 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
 	"testing"
 	"time"
 
@@ -128,3 +135,98 @@ func TestAlertError(t *testing.T) {
 	require.Contains(t, mock.body, opts.ExecutionTime.Format(time.RFC1123))
 	require.Contains(t, mock.body, "hello error")
 }
+
+func TestScheduledReportAttachments(t *testing.T) {
+	opts := &ScheduledReport{
+		ToEmail:        "exec@example.com",
+		DisplayName:    "Weekly KPIs",
+		ReportTime:     time.Date(2024, 01, 29, 9, 0, 0, 0, time.UTC),
+		DownloadFormat: "PDF",
+		OpenLink:       "https://example.com/open",
+		EditLink:       "https://example.com/edit",
+		Attachments: []*Attachment{
+			{Filename: "weekly_kpis.pdf", ContentType: "application/pdf", Data: []byte("%PDF-1.4")},
+		},
+	}
+
+	// Senders that don't support attachments return an error
+	err := New(&mockSender{}).SendScheduledReport(opts)
+	require.Error(t, err)
+
+	sender := NewTestSender().(*TestSender)
+	err = New(sender).SendScheduledReport(opts)
+	require.NoError(t, err)
+	require.Len(t, sender.Emails, 1)
+	require.Equal(t, opts.Attachments, sender.Emails[0].Attachments)
+	require.Contains(t, sender.Emails[0].Body, "file is attached")
+	require.Contains(t, sender.Emails[0].Body, `class="hidden"`)
+}
+
+func TestMultipartMessage(t *testing.T) {
+	data := bytes.Repeat([]byte{0x00, 0xFF, 0x10}, 100)
+	msg, err := multipartMessage("<p>Hello</p>", []*Attachment{
+		{Filename: "report.png", ContentType: "image/png", Data: data},
+	})
+	require.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	require.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(m.Body, params["boundary"])
+	part, err := r.NextPart()
+	require.NoError(t, err)
+	body, err := io.ReadAll(part)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "<p>Hello</p>")
+
+	part, err = r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "report.png", part.FileName())
+	require.Equal(t, "image/png", part.Header.Get("Content-Type"))
+	encoded, err := io.ReadAll(part)
+	require.NoError(t, err)
+	for _, line := range strings.Split(strings.TrimSpace(string(encoded)), "\r\n") {
+		require.LessOrEqual(t, len(line), 76)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	_, err = r.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestAlertDigest(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock)
+
+	opts := &drivers.AlertDigest{
+		ToEmail: uuid.New().String(),
+		Alerts: []*drivers.AlertStatus{
+			{
+				DisplayName:   "Revenue <drop>",
+				ExecutionTime: time.Date(2024, 01, 27, 0, 0, 0, 0, time.UTC),
+				Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+				OpenLink:      "https://example.com/a1",
+			},
+			{
+				DisplayName:    "Signups",
+				ExecutionTime:  time.Date(2024, 01, 27, 0, 5, 0, 0, time.UTC),
+				Status:         runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR,
+				ExecutionError: "query timed out",
+			},
+		},
+	}
+	err := client.SendAlertDigest(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, opts.ToEmail, mock.toEmail)
+	require.Equal(t, "2 alert notifications", mock.subject)
+	require.Contains(t, mock.body, "Revenue &lt;drop&gt;</b> triggered")
+	require.Contains(t, mock.body, "https://example.com/a1")
+	require.Contains(t, mock.body, "Signups</b> failed to evaluate")
+	require.Contains(t, mock.body, "query timed out")
+}
@@ -1,10 +1,16 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"net/smtp"
+	"net/textproto"
 	"strconv"
+	"sync"
 
 	"go.uber.org/zap"
 )
@@ -13,6 +19,19 @@ type Sender interface {
 	Send(toEmail, toName, subject, body string) error
 }
 
+// AttachmentSender is a Sender that can send emails with file attachments.
+type AttachmentSender interface {
+	Sender
+	SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error
+}
+
+// Attachment is a file attached to an email.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
 type SMTPOptions struct {
 	SMTPHost     string
 	SMTPPort     int
@@ -48,16 +67,31 @@ func NewSMTPSender(opts *SMTPOptions) (Sender, error) {
 }
 
 func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
+	return s.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (s *smtpSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
 	// Compose the email message
 	from := mail.Address{Name: s.opts.FromName, Address: s.opts.FromEmail}
 	to := mail.Address{Name: toName, Address: toEmail}
-	message := []byte("From: " + from.String() + "\r\n" +
+	header := "From: " + from.String() + "\r\n" +
 		"To: " + to.String() + "\r\n" +
-		"Subject: " + subject + "\r\n" +
-		"Content-Type: text/html; charset=utf-8\r\n" +
-		"\r\n" +
-		body + "\r\n",
-	)
+		"Subject: " + subject + "\r\n"
+
+	var message []byte
+	if len(attachments) == 0 {
+		message = []byte(header +
+			"Content-Type: text/html; charset=utf-8\r\n" +
+			"\r\n" +
+			body + "\r\n",
+		)
+	} else {
+		msg, err := multipartMessage(body, attachments)
+		if err != nil {
+			return err
+		}
+		message = append([]byte(header), msg...)
+	}
 
 	// Build recipients list
 	recipients := []string{toEmail}
@@ -75,6 +109,52 @@ func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+// multipartMessage builds the MIME headers and body of an HTML email with attachments.
+func multipartMessage(body string, attachments []*Attachment) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+
+	part, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=utf-8"}})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write([]byte(body + "\r\n")); err != nil {
+		return nil, err
+	}
+
+	for _, a := range attachments {
+		part, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Base64 encoded lines must be at most 76 characters
+		enc := base64.StdEncoding.EncodeToString(a.Data)
+		for len(enc) > 76 {
+			if _, err := part.Write([]byte(enc[:76] + "\r\n")); err != nil {
+				return nil, err
+			}
+			enc = enc[76:]
+		}
+		if _, err := part.Write([]byte(enc + "\r\n")); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	header := "MIME-Version: 1.0\r\n" +
+		"Content-Type: " + mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": w.Boundary()}) + "\r\n" +
+		"\r\n"
+	return append([]byte(header), buf.Bytes()...), nil
+}
+
 type consoleSender struct {
 	logger    *zap.Logger
 	fromEmail string
@@ -97,6 +177,23 @@ func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+func (s *consoleSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
+	names := make([]string, len(attachments))
+	for i, a := range attachments {
+		names[i] = a.Filename
+	}
+	s.logger.Info("email sent",
+		zap.String("from_email", s.fromEmail),
+		zap.String("from_name", s.fromName),
+		zap.String("to_email", toEmail),
+		zap.String("to_name", toName),
+		zap.String("subject", subject),
+		zap.String("body", body),
+		zap.Strings("attachments", names),
+	)
+	return nil
+}
+
 type noopSender struct{}
 
 func NewNoopSender() Sender {
@@ -107,12 +204,18 @@ func (s *noopSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+func (s *noopSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
+	return nil
+}
+
 type TestSender struct {
+	mu     sync.Mutex
 	Emails []struct {
-		ToEmail string
-		ToName  string
-		Subject string
-		Body    string
+		ToEmail     string
+		ToName      string
+		Subject     string
+		Body        string
+		Attachments []*Attachment
 	}
 }
 
@@ -121,16 +224,24 @@ func NewTestSender() Sender {
 }
 
 func (s *TestSender) Send(toEmail, toName, subject, body string) error {
+	return s.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (s *TestSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.Emails = append(s.Emails, struct {
-		ToEmail string
-		ToName  string
-		Subject string
-		Body    string
+		ToEmail     string
+		ToName      string
+		Subject     string
+		Body        string
+		Attachments []*Attachment
 	}{
-		ToEmail: toEmail,
-		ToName:  toName,
-		Subject: subject,
-		Body:    body,
+		ToEmail:     toEmail,
+		ToName:      toName,
+		Subject:     subject,
+		Body:        body,
+		Attachments: attachments,
 	})
 	return nil
 }
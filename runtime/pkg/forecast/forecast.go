@@ -0,0 +1,349 @@
+// Package forecast implements deterministic forecasting of evenly spaced time series.
+// All methods are computed in-process and produce the same output for the same input.
+package forecast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+)
+
+// Method is a forecasting method.
+type Method int
+
+const (
+	// MethodAuto uses Holt-Winters if the series covers at least two seasons, and a linear trend otherwise.
+	MethodAuto Method = iota
+	// MethodSeasonalNaive repeats the values of the last season.
+	MethodSeasonalNaive
+	// MethodHoltWinters uses additive triple exponential smoothing (or double exponential smoothing if there is no seasonality).
+	MethodHoltWinters
+	// MethodLinearTrend extrapolates a least squares linear fit.
+	MethodLinearTrend
+)
+
+// DefaultConfidence is the confidence level used for prediction intervals if none is configured.
+const DefaultConfidence = 0.95
+
+// ErrInsufficientData is returned when the series is too short for the forecasting method.
+var ErrInsufficientData = errors.New("forecast: insufficient data")
+
+// Options configures a forecast.
+type Options struct {
+	// Method is the forecasting method to use.
+	Method Method
+	// Periods is the number of periods to forecast.
+	Periods int
+	// SeasonLength is the number of periods in a season. Values less than 2 mean no seasonality.
+	SeasonLength int
+	// Confidence is the confidence level of the prediction intervals, between 0 and 1. Defaults to DefaultConfidence.
+	Confidence float64
+}
+
+// Result contains the forecasted values and their prediction intervals.
+type Result struct {
+	Values []float64
+	Lower  []float64
+	Upper  []float64
+}
+
+// Forecast forecasts the next opts.Periods values of an evenly spaced series.
+func Forecast(values []float64, opts Options) (*Result, error) {
+	if opts.Periods <= 0 {
+		return nil, fmt.Errorf("forecast: periods must be positive")
+	}
+	if opts.Confidence == 0 {
+		opts.Confidence = DefaultConfidence
+	}
+	if opts.Confidence <= 0 || opts.Confidence >= 1 {
+		return nil, fmt.Errorf("forecast: confidence must be between 0 and 1")
+	}
+	if opts.SeasonLength < 2 {
+		opts.SeasonLength = 1
+	}
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("forecast: values must be finite")
+		}
+	}
+
+	method := opts.Method
+	if method == MethodAuto {
+		if opts.SeasonLength > 1 && len(values) >= 2*opts.SeasonLength {
+			method = MethodHoltWinters
+		} else {
+			method = MethodLinearTrend
+		}
+	}
+
+	var points, stderrs []float64
+	var err error
+	switch method {
+	case MethodSeasonalNaive:
+		points, stderrs, err = seasonalNaive(values, opts.SeasonLength, opts.Periods)
+	case MethodHoltWinters:
+		points, stderrs, err = holtWinters(values, opts.SeasonLength, opts.Periods)
+	case MethodLinearTrend:
+		points, stderrs, err = linearTrend(values, opts.Periods)
+	default:
+		return nil, fmt.Errorf("forecast: unknown method %d", method)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Two-sided quantile of the standard normal distribution
+	z := math.Sqrt2 * math.Erfinv(opts.Confidence)
+
+	res := &Result{
+		Values: points,
+		Lower:  make([]float64, len(points)),
+		Upper:  make([]float64, len(points)),
+	}
+	for i, p := range points {
+		res.Lower[i] = p - z*stderrs[i]
+		res.Upper[i] = p + z*stderrs[i]
+	}
+	return res, nil
+}
+
+// ParseMethod parses a method name as used in YAML and resolver properties. An empty name means MethodAuto.
+func ParseMethod(s string) (Method, error) {
+	switch s {
+	case "", "auto":
+		return MethodAuto, nil
+	case "seasonal_naive":
+		return MethodSeasonalNaive, nil
+	case "holt_winters":
+		return MethodHoltWinters, nil
+	case "linear_trend":
+		return MethodLinearTrend, nil
+	default:
+		return 0, fmt.Errorf("forecast: unknown method %q", s)
+	}
+}
+
+// DefaultSeasonLength returns the number of periods in the most common seasonal cycle for a time grain.
+func DefaultSeasonLength(tg timeutil.TimeGrain) int {
+	switch tg {
+	case timeutil.TimeGrainHour:
+		return 24
+	case timeutil.TimeGrainDay:
+		return 7
+	case timeutil.TimeGrainWeek:
+		return 52
+	case timeutil.TimeGrainMonth:
+		return 12
+	case timeutil.TimeGrainQuarter:
+		return 4
+	default:
+		return 1
+	}
+}
+
+// Interpolate returns the values of a series from its first to its last non-nil value.
+// Missing values in between are linearly interpolated from their neighbours.
+func Interpolate(values []*float64) []float64 {
+	var res []float64
+	gap := 0
+	for _, v := range values {
+		if v == nil {
+			if len(res) > 0 {
+				gap++
+			}
+			continue
+		}
+		if gap > 0 {
+			prev := res[len(res)-1]
+			for i := 1; i <= gap; i++ {
+				res = append(res, prev+(*v-prev)*float64(i)/float64(gap+1))
+			}
+			gap = 0
+		}
+		res = append(res, *v)
+	}
+	return res
+}
+
+// seasonalNaive forecasts each period as the value of the same period in the last season.
+// The standard error grows with the square root of the number of seasons forecasted.
+func seasonalNaive(values []float64, m, periods int) ([]float64, []float64, error) {
+	n := len(values)
+	if n <= m {
+		return nil, nil, ErrInsufficientData
+	}
+
+	var sse float64
+	for t := m; t < n; t++ {
+		e := values[t] - values[t-m]
+		sse += e * e
+	}
+	sigma := math.Sqrt(sse / float64(n-m))
+
+	points := make([]float64, periods)
+	stderrs := make([]float64, periods)
+	for h := 1; h <= periods; h++ {
+		k := (h - 1) / m
+		points[h-1] = values[n-m+(h-1)%m]
+		stderrs[h-1] = sigma * math.Sqrt(float64(k+1))
+	}
+	return points, stderrs, nil
+}
+
+// linearTrend forecasts by extrapolating an ordinary least squares fit of the values against their index.
+func linearTrend(values []float64, periods int) ([]float64, []float64, error) {
+	n := len(values)
+	if n < 3 {
+		return nil, nil, ErrInsufficientData
+	}
+
+	var xbar, ybar float64
+	for i, y := range values {
+		xbar += float64(i)
+		ybar += y
+	}
+	xbar /= float64(n)
+	ybar /= float64(n)
+
+	var sxx, sxy float64
+	for i, y := range values {
+		dx := float64(i) - xbar
+		sxx += dx * dx
+		sxy += dx * (y - ybar)
+	}
+	slope := sxy / sxx
+	intercept := ybar - slope*xbar
+
+	var sse float64
+	for i, y := range values {
+		e := y - (intercept + slope*float64(i))
+		sse += e * e
+	}
+	s := math.Sqrt(sse / float64(n-2))
+
+	points := make([]float64, periods)
+	stderrs := make([]float64, periods)
+	for h := 1; h <= periods; h++ {
+		x := float64(n - 1 + h)
+		points[h-1] = intercept + slope*x
+		stderrs[h-1] = s * math.Sqrt(1+1/float64(n)+(x-xbar)*(x-xbar)/sxx)
+	}
+	return points, stderrs, nil
+}
+
+// holtWintersGrid are the candidate values for the smoothing parameters.
+// The parameters that minimize the one-step-ahead squared error are picked.
+var holtWintersGrid = []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+
+// holtWinters forecasts using additive Holt-Winters exponential smoothing.
+// If m is 1, it uses Holt's linear method (no seasonal component).
+func holtWinters(values []float64, m, periods int) ([]float64, []float64, error) {
+	n := len(values)
+	if m > 1 && n < 2*m {
+		return nil, nil, ErrInsufficientData
+	}
+	if n < 3 {
+		return nil, nil, ErrInsufficientData
+	}
+
+	gammas := holtWintersGrid
+	if m == 1 {
+		gammas = []float64{0}
+	}
+
+	var best *holtWintersFit
+	for _, alpha := range holtWintersGrid {
+		for _, beta := range holtWintersGrid {
+			for _, gamma := range gammas {
+				fit := fitHoltWinters(values, m, alpha, beta, gamma)
+				if best == nil || fit.sse < best.sse {
+					best = fit
+				}
+			}
+		}
+	}
+
+	sigma := math.Sqrt(best.sse / float64(best.count))
+
+	// Prediction intervals use the variance of the equivalent state space model,
+	// which has the parameters alpha, alpha*beta and (1-alpha)*gamma.
+	var cumulative float64
+	points := make([]float64, periods)
+	stderrs := make([]float64, periods)
+	for h := 1; h <= periods; h++ {
+		points[h-1] = best.level + float64(h)*best.trend + best.season[(n-1+h)%m]
+		stderrs[h-1] = sigma * math.Sqrt(1+cumulative)
+
+		c := best.alpha + best.alpha*best.beta*float64(h)
+		if m > 1 && h%m == 0 {
+			c += (1 - best.alpha) * best.gamma
+		}
+		cumulative += c * c
+	}
+	return points, stderrs, nil
+}
+
+type holtWintersFit struct {
+	alpha, beta, gamma float64
+	level, trend       float64
+	season             []float64
+	sse                float64
+	count              int
+}
+
+// fitHoltWinters runs the smoothing equations over the values and returns the final state.
+func fitHoltWinters(values []float64, m int, alpha, beta, gamma float64) *holtWintersFit {
+	// Initialize the level and trend from the first two seasons (or values if there's no seasonality),
+	// and the seasonal component from the first season's deviations from its mean.
+	var level, trend float64
+	season := make([]float64, m)
+	if m == 1 {
+		level = values[0]
+		trend = values[1] - values[0]
+	} else {
+		var first, second float64
+		for i := 0; i < m; i++ {
+			first += values[i]
+			second += values[m+i]
+		}
+		first /= float64(m)
+		second /= float64(m)
+		level = first
+		trend = (second - first) / float64(m)
+		for i := 0; i < m; i++ {
+			season[i] = values[i] - first
+		}
+	}
+
+	start := m
+	if m == 1 {
+		start = 1
+	}
+
+	var sse float64
+	for t := start; t < len(values); t++ {
+		s := season[t%m]
+		e := values[t] - (level + trend + s)
+		sse += e * e
+
+		newLevel := alpha*(values[t]-s) + (1-alpha)*(level+trend)
+		trend = beta*(newLevel-level) + (1-beta)*trend
+		level = newLevel
+		if m > 1 {
+			season[t%m] = gamma*(values[t]-level) + (1-gamma)*s
+		}
+	}
+
+	return &holtWintersFit{
+		alpha:  alpha,
+		beta:   beta,
+		gamma:  gamma,
+		level:  level,
+		trend:  trend,
+		season: season,
+		sse:    sse,
+		count:  len(values) - start,
+	}
+}
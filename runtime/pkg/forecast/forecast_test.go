@@ -0,0 +1,84 @@
+package forecast
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeasonalNaive(t *testing.T) {
+	values := []float64{1, 2, 3, 1, 2, 3, 1, 2, 4}
+	res, err := Forecast(values, Options{Method: MethodSeasonalNaive, Periods: 4, SeasonLength: 3})
+	require.NoError(t, err)
+	require.Equal(t, []float64{1, 2, 4, 1}, res.Values)
+
+	// The intervals widen after the first season
+	require.InDelta(t, res.Values[0]-res.Lower[0], res.Upper[0]-res.Values[0], 1e-9)
+	require.Greater(t, res.Upper[3]-res.Lower[3], res.Upper[0]-res.Lower[0])
+}
+
+func TestLinearTrend(t *testing.T) {
+	// A perfect line has no prediction error
+	values := []float64{10, 12, 14, 16, 18}
+	res, err := Forecast(values, Options{Method: MethodLinearTrend, Periods: 2})
+	require.NoError(t, err)
+	require.InDeltaSlice(t, []float64{20, 22}, res.Values, 1e-9)
+	require.InDeltaSlice(t, res.Values, res.Lower, 1e-9)
+	require.InDeltaSlice(t, res.Values, res.Upper, 1e-9)
+
+	// Noisy values have intervals that widen with the horizon
+	values = []float64{10, 13, 13, 17, 18, 19}
+	res, err = Forecast(values, Options{Method: MethodLinearTrend, Periods: 3, Confidence: 0.8})
+	require.NoError(t, err)
+	for i := range res.Values {
+		require.Less(t, res.Lower[i], res.Values[i])
+		require.Greater(t, res.Upper[i], res.Values[i])
+	}
+	require.Greater(t, res.Upper[2]-res.Lower[2], res.Upper[0]-res.Lower[0])
+}
+
+func TestHoltWinters(t *testing.T) {
+	// A trending series with a weekly pattern
+	var values []float64
+	pattern := []float64{0, 5, 10, 5, 0, -5, -10}
+	for i := 0; i < 28; i++ {
+		values = append(values, 100+float64(i)+pattern[i%7])
+	}
+
+	res, err := Forecast(values, Options{Method: MethodHoltWinters, Periods: 7, SeasonLength: 7})
+	require.NoError(t, err)
+	for h := 0; h < 7; h++ {
+		expected := 100 + float64(28+h) + pattern[(28+h)%7]
+		require.InDelta(t, expected, res.Values[h], 2)
+		require.LessOrEqual(t, res.Lower[h], res.Values[h])
+		require.GreaterOrEqual(t, res.Upper[h], res.Values[h])
+	}
+
+	// Auto picks Holt-Winters when there are at least two seasons
+	auto, err := Forecast(values, Options{Periods: 7, SeasonLength: 7})
+	require.NoError(t, err)
+	require.Equal(t, res, auto)
+
+	// Without seasonality, it follows the trend
+	res, err = Forecast([]float64{1, 2, 3, 4, 5, 6}, Options{Method: MethodHoltWinters, Periods: 2})
+	require.NoError(t, err)
+	require.InDeltaSlice(t, []float64{7, 8}, res.Values, 1e-9)
+}
+
+func TestForecastErrors(t *testing.T) {
+	_, err := Forecast([]float64{1, 2}, Options{Method: MethodLinearTrend, Periods: 1})
+	require.ErrorIs(t, err, ErrInsufficientData)
+
+	_, err = Forecast([]float64{1, 2, 3, 4, 5}, Options{Method: MethodHoltWinters, Periods: 1, SeasonLength: 3})
+	require.ErrorIs(t, err, ErrInsufficientData)
+
+	_, err = Forecast([]float64{1, 2, 3}, Options{Periods: 0})
+	require.Error(t, err)
+
+	_, err = Forecast([]float64{1, 2, 3}, Options{Periods: 1, Confidence: 1.5})
+	require.Error(t, err)
+
+	_, err = Forecast([]float64{1, math.NaN(), 3}, Options{Periods: 1})
+	require.Error(t, err)
+}
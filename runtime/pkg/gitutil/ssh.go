@@ -0,0 +1,35 @@
+package gitutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHAuthMethod returns a go-git auth method for an SSH remote that authenticates with a private key and only accepts the given host key.
+// The host key must be in authorized_keys format.
+func SSHAuthMethod(remote, privateKey, hostKey string) (transport.AuthMethod, error) {
+	if hostKey == "" {
+		return nil, errors.New("gitutil: a host key is required for SSH remotes")
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+	if err != nil {
+		return nil, fmt.Errorf("gitutil: invalid host key: %w", err)
+	}
+
+	user := "git"
+	ep, err := transport.NewEndpoint(remote)
+	if err == nil && ep.User != "" {
+		user = ep.User
+	}
+
+	auth, err := gitssh.NewPublicKeys(user, []byte(privateKey), "")
+	if err != nil {
+		return nil, fmt.Errorf("gitutil: invalid private key: %w", err)
+	}
+	auth.HostKeyCallback = ssh.FixedHostKey(pub)
+	return auth, nil
+}
@@ -0,0 +1,159 @@
+// Package gsheets writes tabular data to tabs in Google Sheets.
+package gsheets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+	"google.golang.org/api/sheets/v4"
+)
+
+// Options configures a Client.
+type Options struct {
+	// CredentialsJSON is a Google service account key. The spreadsheets written to must be shared with the service account.
+	CredentialsJSON string
+	// AllowHostAccess allows falling back to the host's default credentials if CredentialsJSON is empty.
+	AllowHostAccess bool
+	// Endpoint overrides the Sheets API endpoint. It is used to target a local stand-in for the API.
+	// If Endpoint is set and CredentialsJSON is empty, requests are not authenticated.
+	Endpoint string
+}
+
+// Client writes data to Google Sheets.
+type Client struct {
+	svc *sheets.Service
+}
+
+// New creates a new Client.
+func New(ctx context.Context, opts *Options) (*Client, error) {
+	var clientOpts []option.ClientOption
+	switch {
+	case opts.CredentialsJSON != "":
+		creds, err := google.CredentialsFromJSON(ctx, []byte(opts.CredentialsJSON), sheets.SpreadsheetsScope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Google Sheets credentials: %w", err)
+		}
+		clientOpts = append(clientOpts, option.WithCredentials(creds))
+	case opts.Endpoint != "":
+		clientOpts = append(clientOpts, option.WithoutAuthentication())
+	case opts.AllowHostAccess:
+		creds, err := google.FindDefaultCredentials(ctx, sheets.SpreadsheetsScope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find default Google credentials: %w", err)
+		}
+		clientOpts = append(clientOpts, option.WithCredentials(creds))
+	default:
+		return nil, errors.New("no credentials configured for Google Sheets: set the `rill.google_sheets.credentials_json` variable")
+	}
+
+	if opts.Endpoint != "" {
+		clientOpts = append(clientOpts, option.WithEndpoint(opts.Endpoint))
+	}
+
+	svc, err := sheets.NewService(ctx, clientOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{svc: svc}, nil
+}
+
+// WriteSheet replaces the contents of a tab in a spreadsheet with the given rows, creating the tab if it doesn't exist.
+// Values are interpreted as if typed into the sheet by a user, so numbers and dates are parsed from strings.
+// String values that would be interpreted as formulas are escaped, so query results can't inject formulas into the sheet.
+// It returns the URL of the tab.
+func (c *Client) WriteSheet(ctx context.Context, spreadsheetID, sheet string, rows [][]any) (string, error) {
+	if spreadsheetID == "" {
+		return "", errors.New("missing spreadsheet ID")
+	}
+	if sheet == "" {
+		return "", errors.New("missing sheet name")
+	}
+
+	sheetID, err := c.ensureSheet(ctx, spreadsheetID, sheet)
+	if err != nil {
+		return "", err
+	}
+
+	rng := quoteSheetName(sheet)
+	_, err = c.svc.Spreadsheets.Values.Clear(spreadsheetID, rng, &sheets.ClearValuesRequest{}).Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to clear sheet %q: %w", sheet, err)
+	}
+
+	if len(rows) > 0 {
+		values := make([][]any, len(rows))
+		for i, row := range rows {
+			values[i] = make([]any, len(row))
+			for j, v := range row {
+				values[i][j] = escapeFormula(v)
+			}
+		}
+		_, err = c.svc.Spreadsheets.Values.Update(spreadsheetID, rng+"!A1", &sheets.ValueRange{Values: values}).ValueInputOption("USER_ENTERED").Context(ctx).Do()
+		if err != nil {
+			return "", fmt.Errorf("failed to write to sheet %q: %w", sheet, err)
+		}
+	}
+
+	return SheetURL(spreadsheetID, sheetID), nil
+}
+
+// ensureSheet returns the ID of the tab with the given name, creating it if it doesn't exist.
+func (c *Client) ensureSheet(ctx context.Context, spreadsheetID, sheet string) (int64, error) {
+	ss, err := c.svc.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties").Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get spreadsheet %q: %w", spreadsheetID, err)
+	}
+	for _, s := range ss.Sheets {
+		if s.Properties != nil && s.Properties.Title == sheet {
+			return s.Properties.SheetId, nil
+		}
+	}
+
+	res, err := c.svc.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{{
+			AddSheet: &sheets.AddSheetRequest{Properties: &sheets.SheetProperties{Title: sheet}},
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to add sheet %q: %w", sheet, err)
+	}
+	if len(res.Replies) == 0 || res.Replies[0].AddSheet == nil || res.Replies[0].AddSheet.Properties == nil {
+		return 0, fmt.Errorf("failed to add sheet %q: unexpected response", sheet)
+	}
+	return res.Replies[0].AddSheet.Properties.SheetId, nil
+}
+
+// SheetURL returns the URL for opening a tab in a spreadsheet.
+func SheetURL(spreadsheetID string, sheetID int64) string {
+	return fmt.Sprintf("https://docs.google.com/spreadsheets/d/%s/edit#gid=%d", url.PathEscape(spreadsheetID), sheetID)
+}
+
+// escapeFormula prefixes a string with an apostrophe if Google Sheets would otherwise interpret it as a formula.
+// The apostrophe makes Sheets store the string as text without displaying the apostrophe.
+// Numbers with a sign are not escaped, so they're still parsed as numbers.
+func escapeFormula(v any) any {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return v
+	}
+	switch s[0] {
+	case '=', '+', '-', '@', '\t', '\r', '\'':
+		if _, err := strconv.ParseFloat(s, 64); err == nil && s[0] != '\'' {
+			return v
+		}
+		return "'" + s
+	default:
+		return v
+	}
+}
+
+// quoteSheetName quotes a tab name for use in A1 notation.
+func quoteSheetName(name string) string {
+	return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+}
@@ -0,0 +1,109 @@
+package gsheets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stubServer is a minimal stand-in for the Sheets API that tracks the tabs of a single spreadsheet.
+type stubServer struct {
+	mu     sync.Mutex
+	sheets map[string]int64
+	values map[string][][]any
+}
+
+func (s *stubServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v4/spreadsheets/ss1")
+	switch {
+	case r.Method == http.MethodGet && path == "":
+		var tabs []map[string]any
+		for title, id := range s.sheets {
+			tabs = append(tabs, map[string]any{"properties": map[string]any{"title": title, "sheetId": id}})
+		}
+		writeJSON(w, map[string]any{"sheets": tabs})
+	case r.Method == http.MethodPost && path == ":batchUpdate":
+		var req struct {
+			Requests []struct {
+				AddSheet struct {
+					Properties struct {
+						Title string `json:"title"`
+					} `json:"properties"`
+				} `json:"addSheet"`
+			} `json:"requests"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		title := req.Requests[0].AddSheet.Properties.Title
+		id := int64(len(s.sheets) + 100)
+		s.sheets[title] = id
+		writeJSON(w, map[string]any{"replies": []any{map[string]any{"addSheet": map[string]any{"properties": map[string]any{"title": title, "sheetId": id}}}}})
+	case r.Method == http.MethodPost && strings.HasSuffix(path, ":clear"):
+		rng := strings.TrimSuffix(strings.TrimPrefix(path, "/values/"), ":clear")
+		delete(s.values, rng)
+		writeJSON(w, map[string]any{})
+	case r.Method == http.MethodPut && strings.HasPrefix(path, "/values/"):
+		if r.URL.Query().Get("valueInputOption") != "USER_ENTERED" {
+			http.Error(w, "unexpected value input option", http.StatusBadRequest)
+			return
+		}
+		var req struct {
+			Values [][]any `json:"values"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		rng := strings.TrimSuffix(strings.TrimPrefix(path, "/values/"), "!A1")
+		s.values[rng] = req.Values
+		writeJSON(w, map[string]any{})
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestWriteSheet(t *testing.T) {
+	stub := &stubServer{
+		sheets: map[string]int64{"Sheet1": 0},
+		values: map[string][][]any{},
+	}
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	ctx := context.Background()
+	c, err := New(ctx, &Options{Endpoint: srv.URL + "/"})
+	require.NoError(t, err)
+
+	// Writing to a new tab creates it
+	u, err := c.WriteSheet(ctx, "ss1", "Bob's report", [][]any{{"country", "revenue"}, {"US", "10"}})
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.google.com/spreadsheets/d/ss1/edit#gid=101", u)
+	require.Contains(t, stub.sheets, "Bob's report")
+	require.Equal(t, [][]any{{"country", "revenue"}, {"US", "10"}}, stub.values["'Bob''s report'"])
+
+	// Writing to an existing tab replaces its contents
+	u, err = c.WriteSheet(ctx, "ss1", "Sheet1", [][]any{{"a"}})
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.google.com/spreadsheets/d/ss1/edit#gid=0", u)
+	require.Len(t, stub.sheets, 2)
+	require.Equal(t, [][]any{{"a"}}, stub.values["'Sheet1'"])
+
+	// Values that would be interpreted as formulas are written as text, but signed numbers are kept as numbers
+	_, err = c.WriteSheet(ctx, "ss1", "Sheet1", [][]any{{"=IMAGE(\"https://example.com\")", "+1", "-2.5", "@SUM(A1)", "-", "'quoted", 3}})
+	require.NoError(t, err)
+	require.Equal(t, [][]any{{"'=IMAGE(\"https://example.com\")", "+1", "-2.5", "'@SUM(A1)", "'-", "''quoted", float64(3)}}, stub.values["'Sheet1'"])
+
+	// Missing spreadsheet
+	_, err = c.WriteSheet(ctx, "ss2", "Sheet1", nil)
+	require.ErrorContains(t, err, "failed to get spreadsheet")
+}
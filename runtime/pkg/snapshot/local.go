@@ -0,0 +1,114 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+	"time"
+)
+
+// NewLocal creates a Renderer that generates placeholder snapshots without a browser.
+// The PDF contains the request's title, URL and execution time as text, and the PNG is a blank frame of the requested size.
+// It is a stand-in for the renderer service in tests and local development.
+func NewLocal() Renderer {
+	return localRenderer{}
+}
+
+type localRenderer struct{}
+
+func (localRenderer) Render(ctx context.Context, req *Request) (*Snapshot, error) {
+	if err := req.normalize(); err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	var err error
+	switch req.Format {
+	case FormatPDF:
+		data = placeholderPDF(req)
+	case FormatPNG:
+		data, err = placeholderPNG(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Snapshot{Data: data, ContentType: req.Format.ContentType()}, nil
+}
+
+// placeholderPNG returns a white image of the requested size with a header band.
+func placeholderPNG(req *Request) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, req.Width, req.Height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	header := image.Rect(0, 0, req.Width, min(req.Height, 48))
+	draw.Draw(img, header, &image.Uniform{C: color.RGBA{R: 0xEC, G: 0xF0, B: 0xFF, A: 0xFF}}, image.Point{}, draw.Src)
+
+	buf := new(bytes.Buffer)
+	if err := png.Encode(buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// placeholderPDF returns a single page PDF document with the size of the viewport and a few lines of text describing the request.
+func placeholderPDF(req *Request) []byte {
+	lines := []string{req.Title, req.URL}
+	if !req.ExecutionTime.IsZero() {
+		lines = append(lines, req.ExecutionTime.Format(time.RFC1123))
+	}
+
+	content := new(strings.Builder)
+	fmt.Fprintf(content, "BT /F1 16 Tf 40 %d Td 20 TL", req.Height-60)
+	for _, l := range lines {
+		if l == "" {
+			continue
+		}
+		fmt.Fprintf(content, " (%s) Tj T*", pdfEscape(l))
+	}
+	content.WriteString(" ET")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>", req.Width, req.Height),
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// pdfEscape escapes a string for use in a PDF string literal. Non-ASCII characters are replaced since the standard fonts only support Latin characters.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7E:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
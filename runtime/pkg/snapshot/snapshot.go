@@ -0,0 +1,176 @@
+// Package snapshot renders dashboards to PDF documents and PNG images.
+// The rendering itself happens in a headless browser behind a Renderer, which is usually an external renderer service.
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Format is the output format of a snapshot.
+type Format string
+
+const (
+	FormatPDF Format = "pdf"
+	FormatPNG Format = "png"
+)
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPDF:
+		return "application/pdf"
+	case FormatPNG:
+		return "image/png"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Valid returns true if the format is supported.
+func (f Format) Valid() bool {
+	return f == FormatPDF || f == FormatPNG
+}
+
+// Default viewport size used when a request doesn't specify one.
+const (
+	DefaultWidth  = 1600
+	DefaultHeight = 1000
+)
+
+// Request describes a dashboard to render.
+type Request struct {
+	// URL of the dashboard to render.
+	URL string `json:"url"`
+	// ResourceKind and ResourceName identify the canvas or explore being rendered.
+	ResourceKind string `json:"resource_kind"`
+	ResourceName string `json:"resource_name"`
+	// Title is a human readable title for the snapshot.
+	Title string `json:"title"`
+	// Format is the output format.
+	Format Format `json:"format"`
+	// Width and Height are the size of the browser viewport in pixels.
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	// ExecutionTime is the time the dashboard should be rendered for.
+	ExecutionTime time.Time `json:"execution_time"`
+}
+
+// Snapshot is a rendered dashboard.
+type Snapshot struct {
+	Data        []byte
+	ContentType string
+}
+
+// Filename returns a filename for the snapshot with an extension that matches its format.
+func (s *Snapshot) Filename(name string) string {
+	ext := ".bin"
+	switch s.ContentType {
+	case FormatPDF.ContentType():
+		ext = ".pdf"
+	case FormatPNG.ContentType():
+		ext = ".png"
+	}
+	return name + ext
+}
+
+// Renderer renders dashboards.
+type Renderer interface {
+	Render(ctx context.Context, req *Request) (*Snapshot, error)
+}
+
+// normalize validates a request and applies defaults.
+func (r *Request) normalize() error {
+	if r.URL == "" {
+		return errors.New("snapshot: missing URL")
+	}
+	if !r.Format.Valid() {
+		return fmt.Errorf("snapshot: unsupported format %q", r.Format)
+	}
+	if r.Width < 0 || r.Height < 0 {
+		return errors.New("snapshot: width and height must be positive")
+	}
+	if r.Width == 0 {
+		r.Width = DefaultWidth
+	}
+	if r.Height == 0 {
+		r.Height = DefaultHeight
+	}
+	return nil
+}
+
+// HTTPOptions configures a renderer service client.
+type HTTPOptions struct {
+	// Endpoint of the renderer service. Requests are sent as a JSON encoded Request in a POST to the endpoint.
+	Endpoint string
+	// Token is sent as a bearer token to the renderer service, if set.
+	Token string
+	// Client is the HTTP client to use. It defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+type httpRenderer struct {
+	opts *HTTPOptions
+}
+
+// NewHTTP creates a Renderer that calls a renderer service over HTTP.
+// The renderer service loads the request's URL in a headless browser and responds with the rendered PDF or PNG.
+func NewHTTP(opts *HTTPOptions) (Renderer, error) {
+	if opts.Endpoint == "" {
+		return nil, errors.New("snapshot: missing renderer endpoint")
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	return &httpRenderer{opts: opts}, nil
+}
+
+func (r *httpRenderer) Render(ctx context.Context, req *Request) (*Snapshot, error) {
+	if err := req.normalize(); err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, r.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", req.Format.ContentType())
+	if r.opts.Token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+r.opts.Token)
+	}
+
+	res, err := r.opts.Client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: renderer request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: failed to read renderer response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("snapshot: renderer responded with status %d: %s", res.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	contentType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil || contentType != req.Format.ContentType() {
+		return nil, fmt.Errorf("snapshot: renderer responded with content type %q, expected %q", res.Header.Get("Content-Type"), req.Format.ContentType())
+	}
+
+	return &Snapshot{Data: data, ContentType: contentType}, nil
+}
@@ -0,0 +1,92 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPRenderer(t *testing.T) {
+	var got Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if got.Format == FormatPNG {
+			w.Header().Set("Content-Type", "text/plain")
+		} else {
+			w.Header().Set("Content-Type", "application/pdf")
+		}
+		_, _ = w.Write([]byte("%PDF-1.4"))
+	}))
+	defer srv.Close()
+
+	r, err := NewHTTP(&HTTPOptions{Endpoint: srv.URL, Token: "secret"})
+	require.NoError(t, err)
+
+	ts := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	s, err := r.Render(context.Background(), &Request{
+		URL:           "https://example.com/dashboard",
+		ResourceKind:  "rill.runtime.v1.Canvas",
+		ResourceName:  "sales",
+		Format:        FormatPDF,
+		ExecutionTime: ts,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", s.ContentType)
+	require.Equal(t, []byte("%PDF-1.4"), s.Data)
+	require.Equal(t, "sales.pdf", s.Filename("sales"))
+
+	// The request is forwarded with defaults applied
+	require.Equal(t, "https://example.com/dashboard", got.URL)
+	require.Equal(t, "sales", got.ResourceName)
+	require.Equal(t, DefaultWidth, got.Width)
+	require.Equal(t, DefaultHeight, got.Height)
+	require.True(t, ts.Equal(got.ExecutionTime))
+
+	// A response in the wrong format is an error
+	_, err = r.Render(context.Background(), &Request{URL: "https://example.com/dashboard", Format: FormatPNG})
+	require.ErrorContains(t, err, "content type")
+
+	// Errors from the renderer service are surfaced
+	r, err = NewHTTP(&HTTPOptions{Endpoint: srv.URL})
+	require.NoError(t, err)
+	_, err = r.Render(context.Background(), &Request{URL: "https://example.com/dashboard", Format: FormatPDF})
+	require.ErrorContains(t, err, "status 401")
+}
+
+func TestLocalRenderer(t *testing.T) {
+	r := NewLocal()
+
+	s, err := r.Render(context.Background(), &Request{URL: "https://example.com/dashboard", Title: "Sales (EMEA)", Format: FormatPDF})
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", s.ContentType)
+	require.True(t, bytes.HasPrefix(s.Data, []byte("%PDF-")))
+	require.True(t, bytes.HasSuffix(s.Data, []byte("%%EOF\n")))
+	require.Contains(t, string(s.Data), `(Sales \(EMEA\)) Tj`)
+
+	s, err = r.Render(context.Background(), &Request{URL: "https://example.com/dashboard", Format: FormatPNG, Width: 320, Height: 200})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", s.ContentType)
+	img, err := png.Decode(bytes.NewReader(s.Data))
+	require.NoError(t, err)
+	require.Equal(t, 320, img.Bounds().Dx())
+	require.Equal(t, 200, img.Bounds().Dy())
+
+	_, err = r.Render(context.Background(), &Request{URL: "https://example.com/dashboard", Format: "csv"})
+	require.Error(t, err)
+	_, err = r.Render(context.Background(), &Request{Format: FormatPNG})
+	require.Error(t, err)
+}
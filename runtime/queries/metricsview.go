@@ -1,8 +1,10 @@
 package queries
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/apache/arrow/go/v15/arrow"
 	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
 	"github.com/apache/arrow/go/v15/arrow/memory"
 	"github.com/apache/arrow/go/v15/parquet/pqarrow"
 	"github.com/google/uuid"
@@ -614,7 +617,87 @@ func WriteXLSX(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, wri
 	return err
 }
 
+// WriteJSONL writes the data as newline-delimited JSON with one object per row.
+func WriteJSONL(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
+	keys := make([][]byte, len(meta))
+	for i, field := range meta {
+		key, err := json.Marshal(field.Name)
+		if err != nil {
+			return err
+		}
+		keys[i] = key
+	}
+
+	// Write the fields of each row in the order of meta, which json.Marshal on a map would not preserve
+	var buf bytes.Buffer
+	for _, structs := range data {
+		buf.Reset()
+		buf.WriteByte('{')
+		for i, field := range meta {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(keys[i])
+			buf.WriteByte(':')
+
+			var val any
+			if pbvalue, ok := structs.Fields[field.Name]; ok {
+				val = pbvalue.AsInterface()
+			}
+			bts, err := json.Marshal(val)
+			if err != nil {
+				return err
+			}
+			buf.Write(bts)
+		}
+		buf.WriteString("}\n")
+
+		_, err := writer.Write(buf.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := buildArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	parquetwriter, err := pqarrow.NewFileWriter(rec.Schema(), ioWriter, nil, pqarrow.ArrowWriterProperties{})
+	if err != nil {
+		return err
+	}
+
+	defer parquetwriter.Close()
+
+	err = parquetwriter.Write(rec)
+	return err
+}
+
+// WriteArrow writes the data as an Arrow IPC stream.
+func WriteArrow(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := buildArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	w := ipc.NewWriter(ioWriter, ipc.WithSchema(rec.Schema()))
+	err = w.Write(rec)
+	if err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// buildArrowRecord converts the data to an Arrow record. The caller must release the record.
+func buildArrowRecord(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct) (arrow.Record, error) {
 	fields := make([]arrow.Field, 0, len(meta))
 	for _, f := range meta {
 		arrowField := arrow.Field{}
@@ -695,14 +778,14 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
 				tmp, err := arrow.TimestampFromString(v.GetStringValue(), arrow.Microsecond)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.TimestampBuilder).Append(tmp)
 			case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_STRUCT:
 				bts, err := protojson.Marshal(v)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.StringBuilder).Append(string(bts))
@@ -719,16 +802,7 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 		}
 	}
 
-	parquetwriter, err := pqarrow.NewFileWriter(schema, ioWriter, nil, pqarrow.ArrowWriterProperties{})
-	if err != nil {
-		return err
-	}
-
-	defer parquetwriter.Close()
-
-	rec := recordBuilder.NewRecord()
-	err = parquetwriter.Write(rec)
-	return err
+	return recordBuilder.NewRecord(), nil
 }
 
 func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, exportFormat runtimev1.ExportFormat) error {
@@ -738,6 +812,8 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 		extension = "parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
 		extension = "csv"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		extension = "jsonl"
 	}
 
 	tmpPath := fmt.Sprintf("export_%s.%s", uuid.New().String(), extension)
@@ -745,8 +821,12 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 	defer os.Remove(tmpPath)
 
 	sql = fmt.Sprintf("COPY (%s) TO '%s'", sql, tmpPath)
-	if extension == "csv" {
+	switch extension {
+	case "csv":
 		sql += " (FORMAT CSV, HEADER)"
+	case "jsonl":
+		// DuckDB writes newline-delimited JSON by default
+		sql += " (FORMAT JSON)"
 	}
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
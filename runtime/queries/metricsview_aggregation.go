@@ -152,6 +152,10 @@ func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime
 		format = drivers.FileFormatXLSX
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		format = drivers.FileFormatParquet
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		format = drivers.FileFormatJSON
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		format = drivers.FileFormatArrow
 	default:
 		return fmt.Errorf("unsupported format: %s", opts.Format.String())
 	}
@@ -0,0 +1,467 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// metricsViewContributionDefaultLimit is the default number of values returned for each dimension.
+const metricsViewContributionDefaultLimit = 10
+
+// metricsViewContributionConcurrency is the max number of dimensions analyzed in parallel.
+const metricsViewContributionConcurrency = 4
+
+// metricsViewContributionNarrativeTimeout is the timeout for generating a narrative with AI.
+const metricsViewContributionNarrativeTimeout = 30 * time.Second
+
+// MetricsViewContribution explains the change of a measure between two time ranges by ranking dimension values by their contribution to the change.
+// It runs a MetricsViewComparison query for each dimension in parallel.
+//
+// If a volume measure is provided, the change of each dimension value is decomposed into:
+//   - the volume effect, which is the change explained by the change of the total volume,
+//   - the mix effect, which is the change explained by the change of the dimension value's share of the total volume,
+//   - the rate effect, which is the change explained by the change of the measure's value per unit of volume.
+//
+// The contributions only add up to the total change for additive measures, such as sums and counts.
+type MetricsViewContribution struct {
+	MetricsViewName     string                  `json:"metrics_view_name,omitempty"`
+	Measure             string                  `json:"measure,omitempty"`
+	VolumeMeasure       string                  `json:"volume_measure,omitempty"`
+	Dimensions          []string                `json:"dimensions,omitempty"`
+	TimeRange           *runtimev1.TimeRange    `json:"time_range,omitempty"`
+	ComparisonTimeRange *runtimev1.TimeRange    `json:"comparison_time_range,omitempty"`
+	Where               *runtimev1.Expression   `json:"where,omitempty"`
+	Limit               int64                   `json:"limit,omitempty"`
+	Narrative           bool                    `json:"narrative,omitempty"`
+	SecurityClaims      *runtime.SecurityClaims `json:"security_claims,omitempty"`
+
+	Result *runtimev1.MetricsViewContributionResponse `json:"-"`
+}
+
+var _ runtime.Query = &MetricsViewContribution{}
+
+func (q *MetricsViewContribution) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewContribution:%s", r)
+}
+
+func (q *MetricsViewContribution) Deps() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{
+		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
+	}
+}
+
+func (q *MetricsViewContribution) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *MetricsViewContribution) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.MetricsViewContributionResponse)
+	if !ok {
+		return fmt.Errorf("MetricsViewContribution: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewContribution) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	if q.Measure == "" {
+		return errors.New("a measure is required")
+	}
+	if q.TimeRange == nil || q.ComparisonTimeRange == nil {
+		return errors.New("both a time range and a comparison time range are required")
+	}
+
+	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityClaims)
+	if err != nil {
+		return err
+	}
+
+	// Default to all dimensions the user has access to
+	dims := q.Dimensions
+	if len(dims) == 0 {
+		for _, d := range mv.ValidSpec.Dimensions {
+			if security.CanAccessField(d.Name) {
+				dims = append(dims, d.Name)
+			}
+		}
+	}
+
+	limit := q.Limit
+	if limit == 0 {
+		limit = metricsViewContributionDefaultLimit
+	}
+
+	totals, err := q.resolveTotals(ctx, rt, instanceID, priority)
+	if err != nil {
+		return err
+	}
+
+	// Analyze each dimension in parallel
+	rowsByDim := make([][]*runtimev1.MetricsViewContributionRow, len(dims))
+	grp, grpCtx := errgroup.WithContext(ctx)
+	grp.SetLimit(metricsViewContributionConcurrency)
+	for i, dim := range dims {
+		grp.Go(func() error {
+			rows, err := q.resolveDimension(grpCtx, rt, instanceID, priority, dim, limit, totals)
+			if err != nil {
+				return fmt.Errorf("failed to analyze dimension %q: %w", dim, err)
+			}
+			rowsByDim[i] = rows
+			return nil
+		})
+	}
+	err = grp.Wait()
+	if err != nil {
+		return err
+	}
+
+	var rows []*runtimev1.MetricsViewContributionRow
+	for _, rs := range rowsByDim {
+		rows = append(rows, rs...)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return math.Abs(rows[i].DeltaAbs) > math.Abs(rows[j].DeltaAbs)
+	})
+
+	q.Result = &runtimev1.MetricsViewContributionResponse{
+		Totals: totals,
+		Rows:   rows,
+	}
+
+	if q.Narrative {
+		q.Result.Narrative, err = q.generateNarrative(ctx, rt, instanceID, mv.ValidSpec)
+		if err != nil {
+			return fmt.Errorf("failed to generate narrative: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (q *MetricsViewContribution) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	err := q.Resolve(ctx, rt, instanceID, opts.Priority)
+	if err != nil {
+		return err
+	}
+
+	if opts.PreWriteHook != nil {
+		err = opts.PreWriteHook(q.generateFilename())
+		if err != nil {
+			return err
+		}
+	}
+
+	meta, data, err := q.exportData()
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
+		return fmt.Errorf("unspecified format")
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
+		return WriteCSV(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		return WriteXLSX(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return WriteParquet(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, data, w)
+	}
+
+	return nil
+}
+
+// resolveTotals resolves the totals of the measure and volume measure across all dimension values.
+func (q *MetricsViewContribution) resolveTotals(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) (*runtimev1.MetricsViewContributionTotals, error) {
+	measures := []*runtimev1.MetricsViewAggregationMeasure{
+		{Name: q.Measure},
+		comparisonValueMeasure(q.Measure),
+	}
+	if q.VolumeMeasure != "" {
+		measures = append(measures, &runtimev1.MetricsViewAggregationMeasure{Name: q.VolumeMeasure}, comparisonValueMeasure(q.VolumeMeasure))
+	}
+
+	agg := &MetricsViewAggregation{
+		MetricsViewName:     q.MetricsViewName,
+		Measures:            measures,
+		TimeRange:           q.TimeRange,
+		ComparisonTimeRange: q.ComparisonTimeRange,
+		Where:               q.Where,
+		SecurityClaims:      q.SecurityClaims,
+	}
+	err := rt.Query(ctx, instanceID, agg, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &runtimev1.MetricsViewContributionTotals{}
+	if len(agg.Result.Data) == 0 {
+		return res, nil
+	}
+	row := agg.Result.Data[0].Fields
+
+	res.BaseValue = row[q.Measure].GetNumberValue()
+	res.ComparisonValue = row[q.Measure+"__previous"].GetNumberValue()
+	res.DeltaAbs = res.BaseValue - res.ComparisonValue
+	if res.ComparisonValue != 0 {
+		res.DeltaRel = structpb.NewNumberValue(res.DeltaAbs / res.ComparisonValue)
+	} else {
+		res.DeltaRel = structpb.NewNullValue()
+	}
+	if q.VolumeMeasure != "" {
+		res.BaseVolume = row[q.VolumeMeasure].GetNumberValue()
+		res.ComparisonVolume = row[q.VolumeMeasure+"__previous"].GetNumberValue()
+	}
+
+	return res, nil
+}
+
+// resolveDimension finds the values of a dimension with the largest contributions to the change of the measure.
+// Since both increases and decreases can contribute, it queries the top values sorted in both directions and merges them.
+func (q *MetricsViewContribution) resolveDimension(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int, dim string, limit int64, totals *runtimev1.MetricsViewContributionTotals) ([]*runtimev1.MetricsViewContributionRow, error) {
+	measures := []*runtimev1.MetricsViewAggregationMeasure{{Name: q.Measure}}
+	comparisonMeasures := []string{q.Measure}
+	if q.VolumeMeasure != "" {
+		measures = append(measures, &runtimev1.MetricsViewAggregationMeasure{Name: q.VolumeMeasure})
+		comparisonMeasures = append(comparisonMeasures, q.VolumeMeasure)
+	}
+
+	seen := make(map[string]bool)
+	var rows []*runtimev1.MetricsViewContributionRow
+	for _, desc := range []bool{true, false} {
+		cmp := &MetricsViewComparison{
+			MetricsViewName:     q.MetricsViewName,
+			DimensionName:       dim,
+			Measures:            measures,
+			ComparisonMeasures:  comparisonMeasures,
+			TimeRange:           q.TimeRange,
+			ComparisonTimeRange: q.ComparisonTimeRange,
+			Limit:               limit,
+			Sort: []*runtimev1.MetricsViewComparisonSort{{
+				Name:     q.Measure,
+				SortType: runtimev1.MetricsViewComparisonMeasureType_METRICS_VIEW_COMPARISON_MEASURE_TYPE_ABS_DELTA,
+				Desc:     desc,
+			}},
+			Where:          q.Where,
+			SecurityClaims: q.SecurityClaims,
+		}
+		err := rt.Query(ctx, instanceID, cmp, priority)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range cmp.Result.Rows {
+			key := fmt.Sprint(r.DimensionValue.AsInterface())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			row := &runtimev1.MetricsViewContributionRow{
+				Dimension:      dim,
+				DimensionValue: r.DimensionValue,
+			}
+			for _, v := range r.MeasureValues {
+				switch v.MeasureName {
+				case q.Measure:
+					row.BaseValue = v.BaseValue.GetNumberValue()
+					row.ComparisonValue = v.ComparisonValue.GetNumberValue()
+				case q.VolumeMeasure:
+					row.BaseVolume = v.BaseValue.GetNumberValue()
+					row.ComparisonVolume = v.ComparisonValue.GetNumberValue()
+				}
+			}
+			calculateContribution(row, totals, q.VolumeMeasure != "")
+			rows = append(rows, row)
+		}
+	}
+
+	// Keep the values with the largest contributions
+	sort.SliceStable(rows, func(i, j int) bool {
+		return math.Abs(rows[i].DeltaAbs) > math.Abs(rows[j].DeltaAbs)
+	})
+	if int64(len(rows)) > limit {
+		rows = rows[:limit]
+	}
+
+	return rows, nil
+}
+
+// calculateContribution calculates the delta, contribution and (optionally) the volume, mix and rate effects of a row.
+// The rate effect is calculated as the remainder of the delta, which ensures that the effects always add up to the delta.
+func calculateContribution(row *runtimev1.MetricsViewContributionRow, totals *runtimev1.MetricsViewContributionTotals, withVolume bool) {
+	row.DeltaAbs = row.BaseValue - row.ComparisonValue
+	if totals.DeltaAbs != 0 {
+		row.Contribution = row.DeltaAbs / totals.DeltaAbs
+	}
+
+	if !withVolume {
+		return
+	}
+
+	// Shares of the total volume
+	var baseShare, comparisonShare float64
+	if totals.BaseVolume != 0 {
+		baseShare = row.BaseVolume / totals.BaseVolume
+	}
+	if totals.ComparisonVolume != 0 {
+		comparisonShare = row.ComparisonVolume / totals.ComparisonVolume
+	}
+
+	// The measure's value per unit of volume in the comparison period.
+	// For new dimension values, the base period's rate is used so the change is attributed to the mix.
+	var comparisonRate float64
+	if row.ComparisonVolume != 0 {
+		comparisonRate = row.ComparisonValue / row.ComparisonVolume
+	} else if row.BaseVolume != 0 {
+		comparisonRate = row.BaseValue / row.BaseVolume
+	}
+
+	row.VolumeEffect = comparisonShare * (totals.BaseVolume - totals.ComparisonVolume) * comparisonRate
+	row.MixEffect = (baseShare - comparisonShare) * totals.BaseVolume * comparisonRate
+	row.RateEffect = row.DeltaAbs - row.VolumeEffect - row.MixEffect
+}
+
+// generateNarrative uses AI to write a summary of the result.
+func (q *MetricsViewContribution) generateNarrative(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsViewSpec) (string, error) {
+	ai, release, err := rt.AI(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, metricsViewContributionNarrativeTimeout)
+	defer cancel()
+
+	res, err := ai.Complete(ctx, []*drivers.CompletionMessage{
+		{Role: "system", Data: metricsViewContributionNarrativeSystemPrompt()},
+		{Role: "user", Data: q.narrativeUserPrompt(mv)},
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(res.Data), nil
+}
+
+func metricsViewContributionNarrativeSystemPrompt() string {
+	return `
+You are a data analyst who explains why a metric changed between two periods.
+You are given the metric's totals and the dimension values that contributed most to the change.
+Write a summary of two to four sentences in plain text that states how much the metric changed and names the main drivers.
+If a mix and rate decomposition is provided, explain whether the drivers changed because of their share of the volume (mix) or their performance (rate).
+Only use the numbers provided. Do not speculate about causes that are not in the data.
+`
+}
+
+func (q *MetricsViewContribution) narrativeUserPrompt(mv *runtimev1.MetricsViewSpec) string {
+	measureName := q.Measure
+	for _, m := range mv.Measures {
+		if m.Name == q.Measure && m.DisplayName != "" {
+			measureName = m.DisplayName
+		}
+	}
+
+	t := q.Result.Totals
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Metric: %s\n", measureName)
+	fmt.Fprintf(&sb, "Current period total: %g\n", t.BaseValue)
+	fmt.Fprintf(&sb, "Previous period total: %g\n", t.ComparisonValue)
+	fmt.Fprintf(&sb, "Change: %g\n", t.DeltaAbs)
+	if q.VolumeMeasure != "" {
+		fmt.Fprintf(&sb, "Volume measure: %s (current period: %g, previous period: %g)\n", q.VolumeMeasure, t.BaseVolume, t.ComparisonVolume)
+	}
+
+	sb.WriteString("\nLargest contributions to the change:\n")
+	for i, r := range q.Result.Rows {
+		if i >= metricsViewContributionDefaultLimit {
+			break
+		}
+		fmt.Fprintf(&sb, "- %s = %v: current %g, previous %g, change %g (%.1f%% of the total change)", r.Dimension, r.DimensionValue.AsInterface(), r.BaseValue, r.ComparisonValue, r.DeltaAbs, r.Contribution*100)
+		if q.VolumeMeasure != "" {
+			fmt.Fprintf(&sb, ", volume effect %g, mix effect %g, rate effect %g", r.VolumeEffect, r.MixEffect, r.RateEffect)
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// exportData converts the result to the tabular format used for exports.
+func (q *MetricsViewContribution) exportData() ([]*runtimev1.MetricsViewColumn, []*structpb.Struct, error) {
+	names := []string{"dimension", "value", q.Measure, q.Measure + "__previous", q.Measure + "__delta_abs", "contribution"}
+	if q.VolumeMeasure != "" {
+		names = append(names, q.VolumeMeasure, q.VolumeMeasure+"__previous", "volume_effect", "mix_effect", "rate_effect")
+	}
+
+	meta := make([]*runtimev1.MetricsViewColumn, len(names))
+	for i, n := range names {
+		typ := runtimev1.Type_CODE_FLOAT64.String()
+		if i < 2 {
+			typ = runtimev1.Type_CODE_STRING.String()
+		}
+		meta[i] = &runtimev1.MetricsViewColumn{Name: n, Type: typ, Nullable: true}
+	}
+
+	data := make([]*structpb.Struct, len(q.Result.Rows))
+	for i, r := range q.Result.Rows {
+		vals := []any{r.Dimension, fmt.Sprint(r.DimensionValue.AsInterface()), r.BaseValue, r.ComparisonValue, r.DeltaAbs, r.Contribution}
+		if q.VolumeMeasure != "" {
+			vals = append(vals, r.BaseVolume, r.ComparisonVolume, r.VolumeEffect, r.MixEffect, r.RateEffect)
+		}
+
+		fields := make(map[string]any, len(names))
+		for j, n := range names {
+			fields[n] = vals[j]
+		}
+		s, err := structpb.NewStruct(fields)
+		if err != nil {
+			return nil, nil, err
+		}
+		data[i] = s
+	}
+
+	return meta, data, nil
+}
+
+func (q *MetricsViewContribution) generateFilename() string {
+	filename := strings.ReplaceAll(q.MetricsViewName, `"`, `_`)
+	filename += "_" + q.Measure + "_contribution"
+	if q.Where != nil {
+		filename += "_filtered"
+	}
+	return filename
+}
+
+// comparisonValueMeasure returns an aggregation measure for the comparison value of a measure.
+func comparisonValueMeasure(name string) *runtimev1.MetricsViewAggregationMeasure {
+	return &runtimev1.MetricsViewAggregationMeasure{
+		Name: name + "__previous",
+		Compute: &runtimev1.MetricsViewAggregationMeasure_ComparisonValue{
+			ComparisonValue: &runtimev1.MetricsViewAggregationMeasureComputeComparisonValue{Measure: name},
+		},
+	}
+}
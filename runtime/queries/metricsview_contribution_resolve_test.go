@@ -0,0 +1,138 @@
+package queries_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMetricsViewContribution(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			// Revenue goes from 180 to 220: US +60, DK -30, NO +10 (new), SE unchanged
+			"m1.sql": `
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP AS time, 'US' AS country, 'mobile' AS device, 100 AS revenue, 10 AS orders
+UNION ALL SELECT '2024-01-01T00:00:00Z'::TIMESTAMP, 'DK', 'desktop', 50, 5
+UNION ALL SELECT '2024-01-01T00:00:00Z'::TIMESTAMP, 'SE', 'mobile', 30, 3
+UNION ALL SELECT '2024-01-02T00:00:00Z'::TIMESTAMP, 'US', 'mobile', 160, 12
+UNION ALL SELECT '2024-01-02T00:00:00Z'::TIMESTAMP, 'DK', 'desktop', 20, 2
+UNION ALL SELECT '2024-01-02T00:00:00Z'::TIMESTAMP, 'SE', 'mobile', 30, 3
+UNION ALL SELECT '2024-01-02T00:00:00Z'::TIMESTAMP, 'NO', 'desktop', 10, 1
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+timeseries: time
+dimensions:
+- column: country
+- column: device
+measures:
+- name: revenue
+  expression: SUM(revenue)
+- name: orders
+  expression: SUM(orders)
+security:
+  access: true
+  row_filter: "{{ if .user.admin }}TRUE{{ else }}country != 'US'{{ end }}"
+  exclude:
+  - if: "NOT {{ .user.admin }}"
+    names: [device]
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	newQuery := func(claims *runtime.SecurityClaims) *queries.MetricsViewContribution {
+		return &queries.MetricsViewContribution{
+			MetricsViewName: "mv1",
+			Measure:         "revenue",
+			VolumeMeasure:   "orders",
+			TimeRange: &runtimev1.TimeRange{
+				Start: timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				End:   timestamppb.New(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+			ComparisonTimeRange: &runtimev1.TimeRange{
+				Start: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				End:   timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			Limit:          2,
+			SecurityClaims: claims,
+		}
+	}
+
+	// All dimensions are analyzed. The largest decrease (DK) is only found when sorting ascending, so it tests that both directions are merged.
+	q := newQuery(&runtime.SecurityClaims{UserAttributes: map[string]any{"admin": true}})
+	err := q.Resolve(context.Background(), rt, instanceID, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 220, q.Result.Totals.BaseValue, 1e-9)
+	require.InDelta(t, 180, q.Result.Totals.ComparisonValue, 1e-9)
+	require.InDelta(t, 40, q.Result.Totals.DeltaAbs, 1e-9)
+
+	type contribution struct {
+		Dimension string
+		Value     string
+		Delta     float64
+	}
+	contributions := func(rows []*runtimev1.MetricsViewContributionRow) []contribution {
+		var res []contribution
+		for _, r := range rows {
+			res = append(res, contribution{r.Dimension, r.DimensionValue.GetStringValue(), r.DeltaAbs})
+		}
+		return res
+	}
+	require.Equal(t, []contribution{
+		{"country", "US", 60},
+		{"device", "mobile", 60},
+		{"country", "DK", -30},
+		{"device", "desktop", -20},
+	}, contributions(q.Result.Rows))
+	for _, r := range q.Result.Rows {
+		require.InDelta(t, r.DeltaAbs, r.VolumeEffect+r.MixEffect+r.RateEffect, 1e-9)
+	}
+
+	// The security policy filters rows and restricts the default dimensions
+	q = newQuery(&runtime.SecurityClaims{UserAttributes: map[string]any{"admin": false}})
+	err = q.Resolve(context.Background(), rt, instanceID, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 60, q.Result.Totals.BaseValue, 1e-9)
+	require.InDelta(t, 80, q.Result.Totals.ComparisonValue, 1e-9)
+	require.Equal(t, []contribution{
+		{"country", "DK", -30},
+		{"country", "NO", 10},
+	}, contributions(q.Result.Rows))
+
+	// Excluded dimensions can't be requested explicitly
+	q = newQuery(&runtime.SecurityClaims{UserAttributes: map[string]any{"admin": false}})
+	q.Dimensions = []string{"device"}
+	err = q.Resolve(context.Background(), rt, instanceID, 1)
+	require.ErrorIs(t, err, runtime.ErrForbidden)
+
+	// Export writes one row per contribution
+	q = newQuery(&runtime.SecurityClaims{UserAttributes: map[string]any{"admin": false}})
+	var buf bytes.Buffer
+	var filename string
+	err = q.Export(context.Background(), rt, instanceID, &buf, &runtime.ExportOptions{
+		Format:       runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+		Priority:     1,
+		PreWriteHook: func(name string) error { filename = name; return nil },
+	})
+	require.NoError(t, err)
+	require.Equal(t, "mv1_revenue_contribution", filename)
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, []string{"dimension", "value", "revenue", "revenue__previous", "revenue__delta_abs", "contribution"}, records[0][:6])
+	require.Equal(t, []string{"country", "DK", "20", "50", "-30"}, records[1][:5])
+	require.Equal(t, []string{"country", "NO", "10", "0", "10"}, records[2][:5])
+}
@@ -0,0 +1,61 @@
+package queries
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateContribution(t *testing.T) {
+	// Revenue with orders as the volume measure: 100 -> 150 in total, orders 10 -> 12
+	totals := &runtimev1.MetricsViewContributionTotals{
+		BaseValue:        150,
+		ComparisonValue:  100,
+		DeltaAbs:         50,
+		BaseVolume:       12,
+		ComparisonVolume: 10,
+	}
+
+	tests := []struct {
+		name string
+		row  *runtimev1.MetricsViewContributionRow
+		want *runtimev1.MetricsViewContributionRow
+	}{
+		{
+			name: "volume growth only",
+			row:  &runtimev1.MetricsViewContributionRow{BaseValue: 60, ComparisonValue: 50, BaseVolume: 6, ComparisonVolume: 5},
+			want: &runtimev1.MetricsViewContributionRow{DeltaAbs: 10, Contribution: 0.2, VolumeEffect: 10, MixEffect: 0, RateEffect: 0},
+		},
+		{
+			name: "rate change only",
+			row:  &runtimev1.MetricsViewContributionRow{BaseValue: 90, ComparisonValue: 50, BaseVolume: 6, ComparisonVolume: 5},
+			want: &runtimev1.MetricsViewContributionRow{DeltaAbs: 40, Contribution: 0.8, VolumeEffect: 10, MixEffect: 0, RateEffect: 30},
+		},
+		{
+			name: "new value",
+			row:  &runtimev1.MetricsViewContributionRow{BaseValue: 20, ComparisonValue: 0, BaseVolume: 2, ComparisonVolume: 0},
+			want: &runtimev1.MetricsViewContributionRow{DeltaAbs: 20, Contribution: 0.4, VolumeEffect: 0, MixEffect: 20, RateEffect: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculateContribution(tt.row, totals, true)
+			require.InDelta(t, tt.want.DeltaAbs, tt.row.DeltaAbs, 1e-9)
+			require.InDelta(t, tt.want.Contribution, tt.row.Contribution, 1e-9)
+			require.InDelta(t, tt.want.VolumeEffect, tt.row.VolumeEffect, 1e-9)
+			require.InDelta(t, tt.want.MixEffect, tt.row.MixEffect, 1e-9)
+			require.InDelta(t, tt.want.RateEffect, tt.row.RateEffect, 1e-9)
+			require.InDelta(t, tt.row.DeltaAbs, tt.row.VolumeEffect+tt.row.MixEffect+tt.row.RateEffect, 1e-9)
+		})
+	}
+
+	// Without a volume measure, only the delta and contribution are set
+	row := &runtimev1.MetricsViewContributionRow{BaseValue: 60, ComparisonValue: 50, BaseVolume: 6, ComparisonVolume: 5}
+	calculateContribution(row, totals, false)
+	require.InDelta(t, 10, row.DeltaAbs, 1e-9)
+	require.Zero(t, row.VolumeEffect)
+	require.Zero(t, row.MixEffect)
+	require.Zero(t, row.RateEffect)
+}
@@ -76,6 +76,10 @@ func (q *MetricsViewRows) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	if err := q.checkRowAccess(); err != nil {
+		return err
+	}
+
 	if q.MetricsView.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
@@ -118,9 +122,13 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 	}
 	defer release()
 
+	if err := q.checkRowAccess(); err != nil {
+		return err
+	}
+
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_JSONL {
 			if q.MetricsView.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
 				return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 			}
@@ -163,6 +171,18 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 	return nil
 }
 
+// checkRowAccess returns an error if the security policy doesn't allow access to individual rows.
+// Rows are not aggregated, so they can't be returned when the policy enforces a minimum group size or noise.
+func (q *MetricsViewRows) checkRowAccess() error {
+	if q.ResolvedMVSecurity == nil {
+		return nil
+	}
+	if q.ResolvedMVSecurity.MinGroupSize() > 0 || q.ResolvedMVSecurity.Noise() > 0 {
+		return fmt.Errorf("metrics view %q enforces a minimum group size, so its underlying rows can't be queried: %w", q.MetricsViewName, runtime.ErrForbidden)
+	}
+	return nil
+}
+
 func (q *MetricsViewRows) generalExport(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions, mv *runtimev1.MetricsViewSpec) error {
 	err := q.Resolve(ctx, rt, instanceID, opts.Priority)
 	if err != nil {
@@ -185,6 +205,10 @@ func (q *MetricsViewRows) generalExport(ctx context.Context, rt *runtime.Runtime
 		return WriteXLSX(q.Result.Meta, q.Result.Data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(q.Result.Meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(q.Result.Meta, q.Result.Data, w)
 	}
 
 	return nil
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/stretchr/testify/require"
 	"github.com/xuri/excelize/v2"
@@ -454,3 +456,73 @@ func Test_writeXLSX_quotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "a\"", v)
 }
+
+func Test_writeJSONL(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{
+		{Name: "b", Type: "CODE_STRING"},
+		{Name: "a", Type: "CODE_FLOAT64"},
+		{Name: "c", Type: "CODE_STRING"},
+	}
+	data := []*structpb.Struct{
+		{
+			Fields: map[string]*structpb.Value{
+				"a": structpb.NewNumberValue(1.5),
+				"b": structpb.NewStringValue("x"),
+				"c": structpb.NewNullValue(),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{
+				"a": structpb.NewNumberValue(2),
+				"b": structpb.NewStringValue(`"y"`),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteJSONL(meta, data, &buf)
+	require.NoError(t, err)
+	require.Equal(t, "{\"b\":\"x\",\"a\":1.5,\"c\":null}\n{\"b\":\"\\\"y\\\"\",\"a\":2,\"c\":null}\n", buf.String())
+}
+
+func Test_writeArrow(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{
+		{Name: "name", Type: "CODE_STRING"},
+		{Name: "count", Type: "CODE_INT64"},
+	}
+	data := []*structpb.Struct{
+		{
+			Fields: map[string]*structpb.Value{
+				"name":  structpb.NewStringValue("x"),
+				"count": structpb.NewNumberValue(10),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{
+				"name":  structpb.NewStringValue("y"),
+				"count": structpb.NewNumberValue(20),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteArrow(meta, data, &buf)
+	require.NoError(t, err)
+
+	r, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer r.Release()
+
+	require.Equal(t, "name", r.Schema().Field(0).Name)
+	require.Equal(t, "count", r.Schema().Field(1).Name)
+
+	require.True(t, r.Next())
+	rec := r.Record()
+	require.Equal(t, int64(2), rec.NumRows())
+	require.Equal(t, "y", rec.Column(0).(*array.String).Value(1))
+	require.Equal(t, int64(20), rec.Column(1).(*array.Int64).Value(1))
+	require.False(t, r.Next())
+	require.NoError(t, r.Err())
+}
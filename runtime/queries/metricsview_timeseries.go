@@ -21,21 +21,22 @@ import (
 )
 
 type MetricsViewTimeSeries struct {
-	MetricsViewName string                       `json:"metrics_view_name,omitempty"`
-	MeasureNames    []string                     `json:"measure_names,omitempty"`
-	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
-	Limit           int64                        `json:"limit,omitempty"`
-	Offset          int64                        `json:"offset,omitempty"`
-	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
-	Where           *runtimev1.Expression        `json:"where,omitempty"`
-	WhereSQL        string                       `json:"where_sql,omitempty"`
-	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"` // backwards compatibility
-	Having          *runtimev1.Expression        `json:"having,omitempty"`
-	HavingSQL       string                       `json:"having_sql,omitempty"`
-	TimeGranularity runtimev1.TimeGrain          `json:"time_granularity,omitempty"`
-	TimeZone        string                       `json:"time_zone,omitempty"`
-	SecurityClaims  *runtime.SecurityClaims      `json:"security_claims,omitempty"`
+	MetricsViewName string                                   `json:"metrics_view_name,omitempty"`
+	MeasureNames    []string                                 `json:"measure_names,omitempty"`
+	TimeStart       *timestamppb.Timestamp                   `json:"time_start,omitempty"`
+	TimeEnd         *timestamppb.Timestamp                   `json:"time_end,omitempty"`
+	Limit           int64                                    `json:"limit,omitempty"`
+	Offset          int64                                    `json:"offset,omitempty"`
+	Sort            []*runtimev1.MetricsViewSort             `json:"sort,omitempty"`
+	Where           *runtimev1.Expression                    `json:"where,omitempty"`
+	WhereSQL        string                                   `json:"where_sql,omitempty"`
+	Filter          *runtimev1.MetricsViewFilter             `json:"filter,omitempty"` // backwards compatibility
+	Having          *runtimev1.Expression                    `json:"having,omitempty"`
+	HavingSQL       string                                   `json:"having_sql,omitempty"`
+	TimeGranularity runtimev1.TimeGrain                      `json:"time_granularity,omitempty"`
+	TimeZone        string                                   `json:"time_zone,omitempty"`
+	Forecast        *runtimev1.MetricsViewTimeSeriesForecast `json:"forecast,omitempty"`
+	SecurityClaims  *runtime.SecurityClaims                  `json:"security_claims,omitempty"`
 
 	Result *runtimev1.MetricsViewTimeSeriesResponse `json:"-"`
 }
@@ -148,6 +149,10 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 		return WriteXLSX(meta, tmp, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, tmp, w)
 	}
 
 	return nil
@@ -246,9 +251,19 @@ func (q *MetricsViewTimeSeries) populateResult(rows *drivers.Result, tsAlias str
 
 	meta := structTypeToMetricsViewColumn(rows.Schema)
 
+	var forecast []*runtimev1.TimeSeriesForecastValue
+	if q.Forecast != nil {
+		var err error
+		forecast, err = q.forecast(data, dur, tz)
+		if err != nil {
+			return err
+		}
+	}
+
 	q.Result = &runtimev1.MetricsViewTimeSeriesResponse{
-		Meta: meta,
-		Data: data,
+		Meta:     meta,
+		Data:     data,
+		Forecast: forecast,
 	}
 
 	return nil
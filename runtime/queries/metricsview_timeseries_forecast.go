@@ -0,0 +1,130 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/pkg/forecast"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// forecast forecasts the configured measures for a number of periods after the last period with data.
+// Measures that don't have enough data for the forecasting method get null values.
+// It returns nil if there is no data to forecast from.
+func (q *MetricsViewTimeSeries) forecast(data []*runtimev1.TimeSeriesValue, dur duration.Duration, tz *time.Location) ([]*runtimev1.TimeSeriesForecastValue, error) {
+	measures := q.Forecast.MeasureNames
+	if len(measures) == 0 {
+		measures = q.MeasureNames
+	}
+	for _, m := range measures {
+		if !slices.Contains(q.MeasureNames, m) {
+			return nil, fmt.Errorf("forecast measure %q is not in the query", m)
+		}
+	}
+
+	periods := int(q.Forecast.Periods)
+	if periods <= 0 {
+		return nil, fmt.Errorf("forecast periods must be positive")
+	}
+
+	// Find the last period with data. Trailing periods without data (e.g. up to the end of the time range) are not used for the forecast.
+	last := -1
+	for i, v := range data {
+		for _, m := range measures {
+			if _, ok := timeSeriesNumber(v, m); ok {
+				last = i
+				break
+			}
+		}
+	}
+
+	if last < 0 {
+		return nil, nil
+	}
+
+	res := make([]*runtimev1.TimeSeriesForecastValue, periods)
+	t := data[last].Ts.AsTime()
+	for i := range res {
+		t = addTo(t, dur, tz)
+		res[i] = &runtimev1.TimeSeriesForecastValue{
+			Ts:      timestamppb.New(t),
+			Records: &structpb.Struct{Fields: make(map[string]*structpb.Value, len(measures))},
+			Lower:   &structpb.Struct{Fields: make(map[string]*structpb.Value, len(measures))},
+			Upper:   &structpb.Struct{Fields: make(map[string]*structpb.Value, len(measures))},
+		}
+	}
+
+	opts := forecast.Options{
+		Method:       forecastMethodFromProto(q.Forecast.Method),
+		Periods:      periods,
+		SeasonLength: int(q.Forecast.SeasonLength),
+		Confidence:   q.Forecast.Confidence,
+	}
+	if opts.SeasonLength == 0 {
+		opts.SeasonLength = forecast.DefaultSeasonLength(metricsview.TimeGrainFromProto(q.TimeGranularity).ToTimeutil())
+	}
+
+	for _, m := range measures {
+		f, err := forecast.Forecast(timeSeriesValues(data[:last+1], m), opts)
+		if err != nil && !errors.Is(err, forecast.ErrInsufficientData) {
+			return nil, fmt.Errorf("failed to forecast measure %q: %w", m, err)
+		}
+
+		for i, v := range res {
+			if f == nil {
+				v.Records.Fields[m] = structpb.NewNullValue()
+				v.Lower.Fields[m] = structpb.NewNullValue()
+				v.Upper.Fields[m] = structpb.NewNullValue()
+				continue
+			}
+			v.Records.Fields[m] = structpb.NewNumberValue(f.Values[i])
+			v.Lower.Fields[m] = structpb.NewNumberValue(f.Lower[i])
+			v.Upper.Fields[m] = structpb.NewNumberValue(f.Upper[i])
+		}
+	}
+
+	return res, nil
+}
+
+// timeSeriesValues returns the values of a measure from its first non-null value.
+// Missing values in between are linearly interpolated from their neighbours.
+func timeSeriesValues(data []*runtimev1.TimeSeriesValue, measure string) []float64 {
+	values := make([]*float64, len(data))
+	for i, v := range data {
+		if n, ok := timeSeriesNumber(v, measure); ok {
+			values[i] = &n
+		}
+	}
+	return forecast.Interpolate(values)
+}
+
+// timeSeriesNumber returns the numeric value of a measure in a time series value.
+func timeSeriesNumber(v *runtimev1.TimeSeriesValue, measure string) (float64, bool) {
+	if v.Records == nil {
+		return 0, false
+	}
+	n, ok := v.Records.Fields[measure].GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, false
+	}
+	return n.NumberValue, true
+}
+
+func forecastMethodFromProto(m runtimev1.ForecastMethod) forecast.Method {
+	switch m {
+	case runtimev1.ForecastMethod_FORECAST_METHOD_SEASONAL_NAIVE:
+		return forecast.MethodSeasonalNaive
+	case runtimev1.ForecastMethod_FORECAST_METHOD_HOLT_WINTERS:
+		return forecast.MethodHoltWinters
+	case runtimev1.ForecastMethod_FORECAST_METHOD_LINEAR_TREND:
+		return forecast.MethodLinearTrend
+	default:
+		return forecast.MethodAuto
+	}
+}
@@ -0,0 +1,50 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMetricsViewTimeSeriesForecast(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var data []*runtimev1.TimeSeriesValue
+	for i, v := range []any{10.0, 12.0, nil, 16.0, 18.0, nil} {
+		records, err := structpb.NewStruct(map[string]any{"total": v, "label": "x"})
+		require.NoError(t, err)
+		data = append(data, &runtimev1.TimeSeriesValue{
+			Ts:      timestamppb.New(start.AddDate(0, 0, i)),
+			Records: records,
+		})
+	}
+
+	q := &MetricsViewTimeSeries{
+		MeasureNames:    []string{"total", "label"},
+		TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		Forecast: &runtimev1.MetricsViewTimeSeriesForecast{
+			Periods: 2,
+			Method:  runtimev1.ForecastMethod_FORECAST_METHOD_LINEAR_TREND,
+		},
+	}
+	res, err := q.forecast(data, timeGrainToDuration(q.TimeGranularity), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	// The forecast starts after the last period with data, and the gap is interpolated
+	require.Equal(t, start.AddDate(0, 0, 5), res[0].Ts.AsTime())
+	require.Equal(t, start.AddDate(0, 0, 6), res[1].Ts.AsTime())
+	require.InDelta(t, 20, res[0].Records.Fields["total"].GetNumberValue(), 1e-9)
+	require.InDelta(t, 22, res[1].Records.Fields["total"].GetNumberValue(), 1e-9)
+
+	// Non-numeric measures are not forecasted
+	require.Equal(t, structpb.NewNullValue(), res[0].Records.Fields["label"])
+
+	// Only measures in the query can be forecasted
+	q.Forecast.MeasureNames = []string{"missing"}
+	_, err = q.forecast(data, timeGrainToDuration(q.TimeGranularity), time.UTC)
+	require.Error(t, err)
+}
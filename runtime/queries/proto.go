@@ -57,6 +57,20 @@ func ProtoToQuery(q *runtimev1.Query, claims *runtime.SecurityClaims) (runtime.Q
 			Exact:               req.Exact,
 			SecurityClaims:      claims,
 		}, nil
+	case *runtimev1.Query_MetricsViewContributionRequest:
+		req := r.MetricsViewContributionRequest
+		return &MetricsViewContribution{
+			MetricsViewName:     req.MetricsViewName,
+			Measure:             req.Measure,
+			VolumeMeasure:       req.VolumeMeasure,
+			Dimensions:          req.Dimensions,
+			TimeRange:           req.TimeRange,
+			ComparisonTimeRange: req.ComparisonTimeRange,
+			Where:               req.Where,
+			Limit:               req.Limit,
+			Narrative:           req.Narrative,
+			SecurityClaims:      claims,
+		}, nil
 	default:
 		return nil, fmt.Errorf("query type %T not supported for alerts", r)
 	}
@@ -113,6 +127,19 @@ func ProtoFromJSON(qryName, qryArgsJSON string, executionTime *time.Time) (*runt
 				req.ComparisonTimeRange = overrideTimeRange(req.ComparisonTimeRange, *executionTime)
 			}
 		}
+	case "MetricsViewContribution":
+		req := &runtimev1.MetricsViewContributionRequest{}
+		qry.Query = &runtimev1.Query_MetricsViewContributionRequest{MetricsViewContributionRequest: req}
+		err := protojson.Unmarshal([]byte(qryArgsJSON), req)
+		if err != nil {
+			return nil, fmt.Errorf("invalid properties for query %q: %w", qryName, err)
+		}
+		if executionTime != nil {
+			req.TimeRange = overrideTimeRange(req.TimeRange, *executionTime)
+			if req.ComparisonTimeRange != nil {
+				req.ComparisonTimeRange = overrideTimeRange(req.ComparisonTimeRange, *executionTime)
+			}
+		}
 	default:
 		return nil, fmt.Errorf("query %q not supported for reports", qryName)
 	}
@@ -165,6 +192,14 @@ func MetricsViewFromQuery(qryName, qryArgsJSON string) (string, error) {
 			return "", fmt.Errorf("invalid properties for query %q: %w", qryName, err)
 		}
 		metricsView = req.MetricsViewName
+	case "MetricsViewContribution":
+		req := &runtimev1.MetricsViewContributionRequest{}
+		qry.Query = &runtimev1.Query_MetricsViewContributionRequest{MetricsViewContributionRequest: req}
+		err := protojson.Unmarshal([]byte(qryArgsJSON), req)
+		if err != nil {
+			return "", fmt.Errorf("invalid properties for query %q: %w", qryName, err)
+		}
+		metricsView = req.MetricsViewName
 	default:
 		return "", fmt.Errorf("query %q not supported for reports", qryName)
 	}
@@ -0,0 +1,344 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// TableDiff compares two tables in the same OLAP connector.
+// It reports schema changes, row counts and per-column profiles of both tables.
+// If Keys are provided, it also matches rows on the keys to count added, removed and changed rows (DuckDB only).
+// The keys should uniquely identify a row in both tables; duplicate keys inflate the row counts.
+type TableDiff struct {
+	Connector        string
+	Database         string
+	DatabaseSchema   string
+	BaseTableName    string
+	CompareTableName string
+	Keys             []string
+	Result           *runtimev1.TableDiffResponse
+}
+
+var _ runtime.Query = &TableDiff{}
+
+// ErrKeyDiffNotSupported is returned when keys are passed to TableDiff for a connector that doesn't support key-based diffs.
+var ErrKeyDiffNotSupported = errors.New("key-based table diff is only supported for DuckDB")
+
+func (q *TableDiff) Key() string {
+	return fmt.Sprintf("TableDiff:%s:%s:%s:%s:%s:%s", q.Connector, q.Database, q.DatabaseSchema, q.BaseTableName, q.CompareTableName, strings.Join(q.Keys, ","))
+}
+
+func (q *TableDiff) Deps() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{
+		{Kind: runtime.ResourceKindSource, Name: q.BaseTableName},
+		{Kind: runtime.ResourceKindModel, Name: q.BaseTableName},
+		{Kind: runtime.ResourceKindSource, Name: q.CompareTableName},
+		{Kind: runtime.ResourceKindModel, Name: q.CompareTableName},
+	}
+}
+
+func (q *TableDiff) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *TableDiff) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.TableDiffResponse)
+	if !ok {
+		return fmt.Errorf("TableDiff: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *TableDiff) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	// Fail fast before profiling if a key-based diff was requested for an unsupported dialect
+	if len(q.Keys) > 0 {
+		olap, release, err := rt.OLAP(ctx, instanceID, q.Connector)
+		if err != nil {
+			return err
+		}
+		dialect := olap.Dialect()
+		release()
+		if dialect != drivers.DialectDuckDB {
+			return fmt.Errorf("%w (got %q); omit the keys to only compare schemas and column profiles", ErrKeyDiffNotSupported, dialect.String())
+		}
+	}
+
+	base, err := q.profile(ctx, rt, instanceID, priority, q.BaseTableName)
+	if err != nil {
+		return fmt.Errorf("failed to profile %q: %w", q.BaseTableName, err)
+	}
+	compare, err := q.profile(ctx, rt, instanceID, priority, q.CompareTableName)
+	if err != nil {
+		return fmt.Errorf("failed to profile %q: %w", q.CompareTableName, err)
+	}
+
+	res := DiffTableProfiles(base, compare)
+	if len(q.Keys) > 0 {
+		err = q.diffRows(ctx, rt, instanceID, priority, res)
+		if err != nil {
+			return err
+		}
+	}
+
+	q.Result = res
+	return nil
+}
+
+func (q *TableDiff) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
+
+// profile builds a TableProfile for a table using the column profiling queries.
+func (q *TableDiff) profile(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int, table string) (*TableProfile, error) {
+	cq := &TableColumns{
+		Connector:      q.Connector,
+		Database:       q.Database,
+		DatabaseSchema: q.DatabaseSchema,
+		TableName:      table,
+	}
+	err := rt.Query(ctx, instanceID, cq, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	tq := &TableCardinality{
+		Connector:      q.Connector,
+		Database:       q.Database,
+		DatabaseSchema: q.DatabaseSchema,
+		TableName:      table,
+	}
+	err = rt.Query(ctx, instanceID, tq, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &TableProfile{
+		RowCount: tq.Result,
+		Columns:  cq.Result.ProfileColumns,
+		Profiles: make(map[string]*runtimev1.TableDiffColumnProfile, len(cq.Result.ProfileColumns)),
+	}
+	for _, c := range cq.Result.ProfileColumns {
+		nq := &ColumnNullCount{
+			Connector:      q.Connector,
+			Database:       q.Database,
+			DatabaseSchema: q.DatabaseSchema,
+			TableName:      table,
+			ColumnName:     c.Name,
+		}
+		err = rt.Query(ctx, instanceID, nq, priority)
+		if err != nil {
+			return nil, err
+		}
+
+		cardq := &ColumnCardinality{
+			Connector:      q.Connector,
+			Database:       q.Database,
+			DatabaseSchema: q.DatabaseSchema,
+			TableName:      table,
+			ColumnName:     c.Name,
+		}
+		err = rt.Query(ctx, instanceID, cardq, priority)
+		if err != nil {
+			return nil, err
+		}
+
+		cp := &runtimev1.TableDiffColumnProfile{
+			NullCount:   nq.Result,
+			Cardinality: cardq.Result,
+		}
+
+		if IsNumericType(c.Type) {
+			sq := &ColumnDescriptiveStatistics{
+				Connector:      q.Connector,
+				Database:       q.Database,
+				DatabaseSchema: q.DatabaseSchema,
+				TableName:      table,
+				ColumnName:     c.Name,
+			}
+			err = rt.Query(ctx, instanceID, sq, priority)
+			if err != nil {
+				return nil, err
+			}
+			cp.NumericStatistics = sq.Result
+		}
+
+		p.Profiles[c.Name] = cp
+	}
+
+	return p, nil
+}
+
+// diffRows matches the rows of the two tables on the keys and populates the row and per-column change counts of res.
+func (q *TableDiff) diffRows(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int, res *runtimev1.TableDiffResponse) error {
+	olap, release, err := rt.OLAP(ctx, instanceID, q.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	isKey := make(map[string]bool, len(q.Keys))
+	for _, k := range q.Keys {
+		isKey[k] = true
+	}
+
+	// Find the columns present in both tables
+	var shared []*runtimev1.TableDiffColumn
+	found := make(map[string]bool, len(q.Keys))
+	for _, c := range res.Columns {
+		if c.BaseType == "" || c.CompareType == "" {
+			continue
+		}
+		if isKey[c.Name] {
+			found[c.Name] = true
+			continue
+		}
+		shared = append(shared, c)
+	}
+	for _, k := range q.Keys {
+		if !found[k] {
+			return fmt.Errorf("key column %q is not present in both tables", k)
+		}
+	}
+
+	const present = "__rill_diff_present"
+	var on []string
+	for _, k := range q.Keys {
+		on = append(on, fmt.Sprintf("b.%[1]s IS NOT DISTINCT FROM c.%[1]s", safeName(k)))
+	}
+
+	matched := fmt.Sprintf("b.%[1]s AND c.%[1]s", present)
+	var distinct []string
+	for _, c := range shared {
+		distinct = append(distinct, fmt.Sprintf("b.%[1]s IS DISTINCT FROM c.%[1]s", safeName(c.Name)))
+	}
+	changed := "false"
+	if len(distinct) > 0 {
+		changed = strings.Join(distinct, " OR ")
+	}
+
+	selects := []string{
+		fmt.Sprintf("count(*) FILTER (WHERE b.%s IS NULL)", present),
+		fmt.Sprintf("count(*) FILTER (WHERE c.%s IS NULL)", present),
+		fmt.Sprintf("count(*) FILTER (WHERE %s AND (%s))", matched, changed),
+		fmt.Sprintf("count(*) FILTER (WHERE %s AND NOT (%s))", matched, changed),
+	}
+	for _, d := range distinct {
+		selects = append(selects, fmt.Sprintf("count(*) FILTER (WHERE %s AND %s)", matched, d))
+	}
+
+	diffSQL := fmt.Sprintf(
+		"SELECT %s FROM (SELECT *, true AS %s FROM %s) b FULL OUTER JOIN (SELECT *, true AS %s FROM %s) c ON %s",
+		strings.Join(selects, ", "),
+		present,
+		olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.BaseTableName),
+		present,
+		olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.CompareTableName),
+		strings.Join(on, " AND "),
+	)
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            diffSQL,
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	counts := make([]int64, len(selects))
+	dest := make([]any, len(selects))
+	for i := range counts {
+		dest[i] = &counts[i]
+	}
+	if rows.Next() {
+		err = rows.Scan(dest...)
+		if err != nil {
+			return err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	res.Rows = &runtimev1.TableDiffRows{
+		Added:     counts[0],
+		Removed:   counts[1],
+		Changed:   counts[2],
+		Unchanged: counts[3],
+	}
+	for i, c := range shared {
+		c.ChangedRows = counts[4+i]
+	}
+	return nil
+}
+
+// TableProfile summarizes a table's schema, row count and column profiles.
+// It is used to diff tables that are not in the same OLAP connector (e.g. in different deployments).
+type TableProfile struct {
+	RowCount int64
+	Columns  []*runtimev1.ProfileColumn
+	Profiles map[string]*runtimev1.TableDiffColumnProfile
+}
+
+// DiffTableProfiles compares the schema, row counts and column profiles of two tables.
+// It does not populate the key-based row diff.
+func DiffTableProfiles(base, compare *TableProfile) *runtimev1.TableDiffResponse {
+	res := &runtimev1.TableDiffResponse{
+		BaseRowCount:    base.RowCount,
+		CompareRowCount: compare.RowCount,
+	}
+
+	cols := make(map[string]*runtimev1.TableDiffColumn)
+	for _, c := range base.Columns {
+		col := &runtimev1.TableDiffColumn{
+			Name:     c.Name,
+			BaseType: c.Type,
+			Base:     base.Profiles[c.Name],
+		}
+		cols[c.Name] = col
+		res.Columns = append(res.Columns, col)
+	}
+	for _, c := range compare.Columns {
+		col, ok := cols[c.Name]
+		if !ok {
+			col = &runtimev1.TableDiffColumn{Name: c.Name}
+			res.Columns = append(res.Columns, col)
+		}
+		col.CompareType = c.Type
+		col.Compare = compare.Profiles[c.Name]
+	}
+
+	return res
+}
+
+// IsNumericType returns true if a column type returned by TableColumns is numeric.
+// It handles both DuckDB type names (e.g. "BIGINT", "DECIMAL(10,2)") and runtimev1.Type_Code names (e.g. "CODE_INT64").
+func IsNumericType(typ string) bool {
+	typ = strings.TrimPrefix(strings.ToUpper(typ), "CODE_")
+	if strings.HasPrefix(typ, "DECIMAL") {
+		return true
+	}
+	switch typ {
+	case "TINYINT", "SMALLINT", "INTEGER", "BIGINT", "HUGEINT",
+		"UTINYINT", "USMALLINT", "UINTEGER", "UBIGINT", "UHUGEINT",
+		"FLOAT", "REAL", "DOUBLE",
+		"INT8", "INT16", "INT32", "INT64", "INT128", "INT256",
+		"UINT8", "UINT16", "UINT32", "UINT64", "UINT128", "UINT256",
+		"FLOAT32", "FLOAT64":
+		return true
+	}
+	return false
+}
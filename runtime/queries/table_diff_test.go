@@ -0,0 +1,129 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableDiff(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"base.sql": `
+SELECT 1 AS id, 'a' AS name, 10 AS val, 'x' AS old_col
+UNION ALL SELECT 2, 'b', 20, 'x'
+UNION ALL SELECT 3, 'c', 30, 'x'
+`,
+			"compare.sql": `
+SELECT 1 AS id, 'a' AS name, 10 AS val, true AS new_col
+UNION ALL SELECT 2, 'b', 25, true
+UNION ALL SELECT 4, 'd', 40, false
+UNION ALL SELECT 5, 'e', 50, false
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 2, 0, 0)
+
+	q := &queries.TableDiff{
+		BaseTableName:    "base",
+		CompareTableName: "compare",
+		Keys:             []string{"id"},
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(3), q.Result.BaseRowCount)
+	require.Equal(t, int64(4), q.Result.CompareRowCount)
+	require.Equal(t, int64(2), q.Result.Rows.Added)
+	require.Equal(t, int64(1), q.Result.Rows.Removed)
+	require.Equal(t, int64(1), q.Result.Rows.Changed)
+	require.Equal(t, int64(1), q.Result.Rows.Unchanged)
+
+	cols := make(map[string]*runtimev1.TableDiffColumn)
+	for _, c := range q.Result.Columns {
+		cols[c.Name] = c
+	}
+	require.Len(t, cols, 5)
+	require.Empty(t, cols["old_col"].CompareType)
+	require.Empty(t, cols["new_col"].BaseType)
+	require.Equal(t, int64(1), cols["val"].ChangedRows)
+	require.Equal(t, int64(0), cols["name"].ChangedRows)
+	require.Equal(t, 30.0, cols["val"].Base.NumericStatistics.Max)
+	require.Equal(t, 50.0, cols["val"].Compare.NumericStatistics.Max)
+	require.Nil(t, cols["name"].Base.NumericStatistics)
+
+	// Keys must be present in both tables
+	q = &queries.TableDiff{
+		BaseTableName:    "base",
+		CompareTableName: "compare",
+		Keys:             []string{"old_col"},
+	}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.ErrorContains(t, err, "not present in both tables")
+}
+
+func TestDiffTableProfiles(t *testing.T) {
+	base := &queries.TableProfile{
+		RowCount: 10,
+		Columns: []*runtimev1.ProfileColumn{
+			{Name: "a", Type: "BIGINT"},
+			{Name: "b", Type: "VARCHAR"},
+		},
+		Profiles: map[string]*runtimev1.TableDiffColumnProfile{
+			"a": {NullCount: 1},
+			"b": {NullCount: 2},
+		},
+	}
+	compare := &queries.TableProfile{
+		RowCount: 12,
+		Columns: []*runtimev1.ProfileColumn{
+			{Name: "c", Type: "DOUBLE"},
+			{Name: "a", Type: "INTEGER"},
+		},
+		Profiles: map[string]*runtimev1.TableDiffColumnProfile{
+			"a": {NullCount: 3},
+			"c": {NullCount: 4},
+		},
+	}
+
+	res := queries.DiffTableProfiles(base, compare)
+	require.Equal(t, int64(10), res.BaseRowCount)
+	require.Equal(t, int64(12), res.CompareRowCount)
+	require.Nil(t, res.Rows)
+	require.Len(t, res.Columns, 3)
+
+	require.Equal(t, "a", res.Columns[0].Name)
+	require.Equal(t, "BIGINT", res.Columns[0].BaseType)
+	require.Equal(t, "INTEGER", res.Columns[0].CompareType)
+	require.Equal(t, 1.0, res.Columns[0].Base.NullCount)
+	require.Equal(t, 3.0, res.Columns[0].Compare.NullCount)
+
+	require.Equal(t, "b", res.Columns[1].Name)
+	require.Empty(t, res.Columns[1].CompareType)
+	require.Nil(t, res.Columns[1].Compare)
+
+	require.Equal(t, "c", res.Columns[2].Name)
+	require.Empty(t, res.Columns[2].BaseType)
+	require.Nil(t, res.Columns[2].Base)
+}
+
+func TestIsNumericType(t *testing.T) {
+	require.True(t, queries.IsNumericType("BIGINT"))
+	require.True(t, queries.IsNumericType("DECIMAL(18,3)"))
+	require.True(t, queries.IsNumericType("CODE_FLOAT64"))
+	require.False(t, queries.IsNumericType("VARCHAR"))
+	require.False(t, queries.IsNumericType("CODE_TIMESTAMP"))
+}
+
+func TestTableDiffKey(t *testing.T) {
+	q1 := &queries.TableDiff{Connector: "duckdb", BaseTableName: "a", CompareTableName: "b"}
+	q2 := &queries.TableDiff{Connector: "clickhouse", BaseTableName: "a", CompareTableName: "b"}
+	q3 := &queries.TableDiff{Connector: "duckdb", DatabaseSchema: "other", BaseTableName: "a", CompareTableName: "b"}
+	require.NotEqual(t, q1.Key(), q2.Key())
+	require.NotEqual(t, q1.Key(), q3.Key())
+}
@@ -102,7 +102,7 @@ func (q *TableHead) Export(ctx context.Context, rt *runtime.Runtime, instanceID
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_JSONL {
 			filename := q.TableName
 			sql, err := q.buildTableHeadSQL(ctx, olap)
 			if err != nil {
@@ -156,6 +156,10 @@ func (q *TableHead) generalExport(ctx context.Context, rt *runtime.Runtime, inst
 		return WriteXLSX(meta, q.Result, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, q.Result, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, q.Result, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, q.Result, w)
 	}
 
 	return nil
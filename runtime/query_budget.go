@@ -0,0 +1,192 @@
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// queryBudgetWindow is the duration of the window that query budgets apply to.
+const queryBudgetWindow = time.Hour
+
+// QueryBudgetExceededError is returned when executing a query would exceed the query budget of the user or token that issued it.
+type QueryBudgetExceededError struct {
+	// Unit is the unit of the budget that would be exceeded ("rows" or "bytes").
+	Unit string
+	// Cost is the estimated cost of the query.
+	Cost int64
+	// Used is the amount of the budget already used in the current window.
+	Used int64
+	// Limit is the budget per window.
+	Limit int64
+	// ResetIn is the time until the current window ends.
+	ResetIn time.Duration
+}
+
+func (e QueryBudgetExceededError) Error() string {
+	return fmt.Sprintf("query budget exceeded: the query is estimated to scan %d %s, but %d of the hourly budget of %d %s have already been used (resets in %s)", e.Cost, e.Unit, e.Used, e.Limit, e.Unit, e.ResetIn.Round(time.Second))
+}
+
+// WithQueryBudget returns a context that enforces the instance's query budget for the user or token identified by the claims.
+// OLAP stores that support cost estimation will charge the estimated cost of each query executed with the context against the budget,
+// and reject queries that would exceed it with a QueryBudgetExceededError.
+// The context is returned unchanged if the instance doesn't configure a budget, if the claims skip security checks,
+// or if the claims are anonymous (i.e. have neither user attributes nor additional rules to identify the caller by).
+func (r *Runtime) WithQueryBudget(ctx context.Context, instanceID string, claims *SecurityClaims) (context.Context, error) {
+	if claims == nil || claims.SkipChecks {
+		return ctx, nil
+	}
+
+	cfg, err := r.InstanceConfig(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.QueryBudgetScannedRowsPerHour <= 0 && cfg.QueryBudgetScannedBytesPerHour <= 0 {
+		return ctx, nil
+	}
+
+	subject, err := queryBudgetSubject(claims)
+	if err != nil {
+		return nil, err
+	}
+	if subject == "" {
+		return ctx, nil
+	}
+
+	return drivers.WithQueryBudget(ctx, &queryBudget{
+		tracker:    r.queryBudgets,
+		activity:   r.activity,
+		instanceID: instanceID,
+		subject:    subject,
+		userID:     claims.UserID(),
+		maxRows:    cfg.QueryBudgetScannedRowsPerHour,
+		maxBytes:   cfg.QueryBudgetScannedBytesPerHour,
+	}), nil
+}
+
+// queryBudgetSubject returns the key that identifies the caller's budget.
+// It is the user ID if the claims have one. Embed, magic and service tokens usually don't, so for them it falls back to a hash of
+// the user attributes and additional rules, which means callers with identical claims share a budget.
+// It returns an empty string for anonymous claims, which are not subject to a budget.
+func queryBudgetSubject(claims *SecurityClaims) (string, error) {
+	if id := claims.UserID(); id != "" {
+		return "user:" + id, nil
+	}
+	if len(claims.UserAttributes) == 0 && len(claims.AdditionalRules) == 0 {
+		return "", nil
+	}
+
+	data, err := claims.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(data)
+	return "claims:" + hex.EncodeToString(h[:]), nil
+}
+
+// queryBudget implements drivers.QueryBudget for a user or token in an instance.
+type queryBudget struct {
+	tracker    *queryBudgetTracker
+	activity   *activity.Client
+	instanceID string
+	subject    string
+	userID     string
+	maxRows    int64
+	maxBytes   int64
+}
+
+var _ drivers.QueryBudget = (*queryBudget)(nil)
+
+// Applies implements drivers.QueryBudget.
+// A bytes budget doesn't apply to dialects that can't estimate the scanned bytes (such as DuckDB), since their estimates would always be 0.
+func (b *queryBudget) Applies(dialect drivers.Dialect) bool {
+	return b.maxRows > 0 || (b.maxBytes > 0 && dialect.EstimatesScannedBytes())
+}
+
+// Charge implements drivers.QueryBudget.
+func (b *queryBudget) Charge(ctx context.Context, dialect drivers.Dialect, cost *drivers.QueryCost) error {
+	err := b.tracker.charge(b.instanceID, b.subject, b.maxRows, b.maxBytes, cost)
+
+	if b.activity != nil {
+		attrs := []attribute.KeyValue{
+			attribute.String("instance_id", b.instanceID),
+			attribute.String("user_id", b.userID),
+			attribute.String("dialect", dialect.String()),
+			attribute.Bool("rejected", err != nil),
+		}
+		b.activity.RecordMetric(ctx, "query_budget_scanned_rows", float64(cost.ScannedRows), attrs...)
+		b.activity.RecordMetric(ctx, "query_budget_scanned_bytes", float64(cost.ScannedBytes), attrs...)
+	}
+
+	return err
+}
+
+// queryBudgetTracker tracks the usage of query budgets in fixed windows.
+type queryBudgetTracker struct {
+	mu        sync.Mutex
+	usage     map[queryBudgetKey]*queryBudgetUsage
+	lastPrune time.Time
+}
+
+type queryBudgetKey struct {
+	instanceID string
+	subject    string
+}
+
+type queryBudgetUsage struct {
+	windowStart time.Time
+	rows        int64
+	bytes       int64
+}
+
+func newQueryBudgetTracker() *queryBudgetTracker {
+	return &queryBudgetTracker{
+		usage:     make(map[queryBudgetKey]*queryBudgetUsage),
+		lastPrune: time.Now(),
+	}
+}
+
+// charge adds the cost to the usage of the subject in the current window.
+// It returns a QueryBudgetExceededError without recording the cost if it would exceed one of the limits (where a limit <= 0 means no limit).
+func (t *queryBudgetTracker) charge(instanceID, subject string, maxRows, maxBytes int64, cost *drivers.QueryCost) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+
+	// Remove expired usage periodically to prevent the map from growing indefinitely
+	if now.Sub(t.lastPrune) > queryBudgetWindow {
+		for k, u := range t.usage {
+			if now.Sub(u.windowStart) > queryBudgetWindow {
+				delete(t.usage, k)
+			}
+		}
+		t.lastPrune = now
+	}
+
+	key := queryBudgetKey{instanceID: instanceID, subject: subject}
+	u, ok := t.usage[key]
+	if !ok || now.Sub(u.windowStart) > queryBudgetWindow {
+		u = &queryBudgetUsage{windowStart: now}
+		t.usage[key] = u
+	}
+
+	resetIn := queryBudgetWindow - now.Sub(u.windowStart)
+	if maxRows > 0 && u.rows+cost.ScannedRows > maxRows {
+		return QueryBudgetExceededError{Unit: "rows", Cost: cost.ScannedRows, Used: u.rows, Limit: maxRows, ResetIn: resetIn}
+	}
+	if maxBytes > 0 && u.bytes+cost.ScannedBytes > maxBytes {
+		return QueryBudgetExceededError{Unit: "bytes", Cost: cost.ScannedBytes, Used: u.bytes, Limit: maxBytes, ResetIn: resetIn}
+	}
+
+	u.rows += cost.ScannedRows
+	u.bytes += cost.ScannedBytes
+	return nil
+}
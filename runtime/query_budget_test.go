@@ -0,0 +1,81 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBudgetTracker(t *testing.T) {
+	tr := newQueryBudgetTracker()
+
+	// Within budget
+	err := tr.charge("i1", "u1", 100, 0, &drivers.QueryCost{ScannedRows: 60, ScannedBytes: 1000})
+	require.NoError(t, err)
+
+	// Exceeds the rows budget
+	err = tr.charge("i1", "u1", 100, 0, &drivers.QueryCost{ScannedRows: 60})
+	var budgetErr QueryBudgetExceededError
+	require.True(t, errors.As(err, &budgetErr))
+	require.Equal(t, "rows", budgetErr.Unit)
+	require.Equal(t, int64(60), budgetErr.Used)
+	require.Equal(t, int64(100), budgetErr.Limit)
+
+	// Rejected queries are not charged
+	err = tr.charge("i1", "u1", 100, 0, &drivers.QueryCost{ScannedRows: 40})
+	require.NoError(t, err)
+
+	// Budgets are tracked separately per user and instance
+	err = tr.charge("i1", "u2", 100, 0, &drivers.QueryCost{ScannedRows: 100})
+	require.NoError(t, err)
+	err = tr.charge("i2", "u1", 100, 0, &drivers.QueryCost{ScannedRows: 100})
+	require.NoError(t, err)
+
+	// Exceeds the bytes budget
+	err = tr.charge("i1", "u3", 0, 500, &drivers.QueryCost{ScannedRows: 1000000, ScannedBytes: 501})
+	require.True(t, errors.As(err, &budgetErr))
+	require.Equal(t, "bytes", budgetErr.Unit)
+
+	// The budget resets when the window expires
+	tr.usage[queryBudgetKey{instanceID: "i1", subject: "u1"}].windowStart = time.Now().Add(-2 * queryBudgetWindow)
+	err = tr.charge("i1", "u1", 100, 0, &drivers.QueryCost{ScannedRows: 100})
+	require.NoError(t, err)
+}
+
+func TestQueryBudgetApplies(t *testing.T) {
+	rows := &queryBudget{maxRows: 100}
+	require.True(t, rows.Applies(drivers.DialectDuckDB))
+	require.True(t, rows.Applies(drivers.DialectClickHouse))
+
+	// DuckDB doesn't estimate scanned bytes, so a bytes budget doesn't apply to it
+	bytes := &queryBudget{maxBytes: 100}
+	require.False(t, bytes.Applies(drivers.DialectDuckDB))
+	require.True(t, bytes.Applies(drivers.DialectClickHouse))
+	require.True(t, bytes.Applies(drivers.DialectDruid))
+}
+
+func TestQueryBudgetSubject(t *testing.T) {
+	// Users are identified by their ID
+	s, err := queryBudgetSubject(&SecurityClaims{UserAttributes: map[string]any{"id": "u1", "email": "a@example.com"}})
+	require.NoError(t, err)
+	require.Equal(t, "user:u1", s)
+
+	// Tokens without a user ID are identified by their claims
+	embed1, err := queryBudgetSubject(&SecurityClaims{UserAttributes: map[string]any{"domain": "example.com"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, embed1)
+	embed2, err := queryBudgetSubject(&SecurityClaims{UserAttributes: map[string]any{"domain": "example.org"}})
+	require.NoError(t, err)
+	require.NotEqual(t, embed1, embed2)
+	same, err := queryBudgetSubject(&SecurityClaims{UserAttributes: map[string]any{"domain": "example.com"}})
+	require.NoError(t, err)
+	require.Equal(t, embed1, same)
+
+	// Anonymous claims are not subject to a budget
+	s, err = queryBudgetSubject(&SecurityClaims{})
+	require.NoError(t, err)
+	require.Empty(t, s)
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/queries"
-	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -85,9 +84,19 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Exit early for deletion
 	if self.Meta.DeletedOn != nil {
+		err = r.flushOrphanedNotifications(ctx, self)
+		if err != nil {
+			r.C.Logger.Warn("Failed to deliver queued alert notifications", zap.String("name", n.Name), zap.Error(err))
+		}
 		return runtime.ReconcileResult{}
 	}
 
+	// Deliver notifications queued by the project's notification policy that are now due (they may have been queued by any alert)
+	err = r.flushNotifications(ctx, time.Now())
+	if err != nil {
+		r.C.Logger.Warn("Failed to deliver queued alert notifications", zap.String("name", n.Name), zap.Error(err))
+	}
+
 	// If CurrentExecution is not nil, a catastrophic failure occurred during the last execution.
 	// Clean up to ensure CurrentExecution is nil.
 	if a.State.CurrentExecution != nil {
@@ -144,10 +153,7 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
 		}
-		if a.State.NextRunOn != nil {
-			return runtime.ReconcileResult{Retrigger: a.State.NextRunOn.AsTime()}
-		}
-		return runtime.ReconcileResult{}
+		return runtime.ReconcileResult{Retrigger: r.retriggerOn(ctx, self, a)}
 	}
 
 	// If the spec hash changed, clear all alert state
@@ -158,6 +164,7 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		a.State.CurrentExecution = nil
 		a.State.ExecutionHistory = nil
 		a.State.ExecutionCount = 0
+		a.State.ConsecutiveFailures = 0
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -206,10 +213,28 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	}
 
 	// Done
+	return runtime.ReconcileResult{Err: executeErr, Retrigger: r.retriggerOn(ctx, self, a)}
+}
+
+// retriggerOn returns when the alert should be reconciled next.
+// It is the earliest of the next scheduled run and the delivery time of the notifications queued in the instance.
+// Since every alert retriggers for the earliest queued notification, notifications queued by deleted or renamed alerts are delivered as long as the project has an alert.
+// It returns the zero time if there's nothing to retrigger for.
+func (r *AlertReconciler) retriggerOn(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert) time.Time {
+	var t time.Time
 	if a.State.NextRunOn != nil {
-		return runtime.ReconcileResult{Err: executeErr, Retrigger: a.State.NextRunOn.AsTime()}
+		t = a.State.NextRunOn.AsTime()
+	}
+
+	deliverOn, ok, err := r.nextNotificationDeliverOn(ctx)
+	if err != nil {
+		r.C.Logger.Warn("Failed to check queued alert notifications", zap.String("name", self.Meta.Name.Name), zap.Error(err))
+		return t
+	}
+	if ok && (t.IsZero() || deliverOn.Before(t)) {
+		t = deliverOn
 	}
-	return runtime.ReconcileResult{Err: executeErr}
+	return t
 }
 
 // executionSpecHash computes a hash of the alert properties that impact execution.
@@ -719,70 +744,47 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		}
 	}
 
+	// Build links for notifications.
+	// Note: adminMeta may not always be available (if outside of cloud). In those cases, we leave the links blank (no clickthrough available).
+	var openLink, editLink string
+	if adminMeta != nil {
+		var err error
+		openLink, err = addExecutionTime(adminMeta.OpenURL, executionTime)
+		if err != nil {
+			return fmt.Errorf("failed to build open url: %w", err)
+		}
+		editLink = adminMeta.EditURL
+	}
+
+	// Get the project's notification policy (if any)
+	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+	policy := inst.NotificationPolicy
+
 	// Send a notification (if applicable)
 	var notificationErr error
 	var sentNotifications bool
 	if msg != nil {
-		if adminMeta != nil {
-			// Note: adminMeta may not always be available (if outside of cloud). In those cases, we leave the links blank (no clickthrough available).
-			openLink, err := addExecutionTime(adminMeta.OpenURL, executionTime)
-			if err != nil {
-				return fmt.Errorf("failed to build open url: %w", err)
-			}
-			msg.OpenLink = openLink
-			msg.EditLink = adminMeta.EditURL
-		}
-
-		for _, notifier := range a.Spec.Notifiers {
-			switch notifier.Connector {
-			// TODO: transform email client to notifier
-			case "email":
-				recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-				for _, recipient := range recipients {
-					msg.ToEmail = recipient
-					err := r.C.Runtime.Email.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send email to %q: %w", recipient, err)
-						break
-					}
-				}
-			default:
-				err := func() (outErr error) {
-					conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-					if err != nil {
-						return err
-					}
-					defer release()
-					n, err := conn.AsNotifier(notifier.Properties.AsMap())
-					if err != nil {
-						return err
-					}
-					start := time.Now()
-					defer func() {
-						totalLatency := time.Since(start).Milliseconds()
-
-						if r.C.Activity != nil {
-							r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-								attribute.Bool("failed", outErr != nil),
-								attribute.String("connector", notifier.Connector),
-								attribute.String("notification_type", "alert_status"),
-							)
-						}
-					}()
-					err = n.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
-					}
-					return nil
-				}()
-				if err != nil {
-					return err
-				}
-			}
-		}
+		msg.OpenLink = openLink
+		msg.EditLink = editLink
+
+		notificationErr = r.notify(ctx, self, a.Spec.Notifiers, policy, msg)
 		sentNotifications = true
 	}
 
+	// Track executions that did not pass and escalate if the alert has not recovered
+	if current.Result.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
+		a.State.ConsecutiveFailures = 0
+	} else {
+		a.State.ConsecutiveFailures++
+	}
+	err = r.escalate(ctx, self, a, policy, current, openLink, editLink)
+	if err != nil && notificationErr == nil {
+		notificationErr = fmt.Errorf("failed to send escalation: %w", err)
+	}
+
 	// If sending notifications failed, add the error as an execution error.
 	if notificationErr != nil {
 		a.State.CurrentExecution.Result = &runtimev1.AssertionResult{
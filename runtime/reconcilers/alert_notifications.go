@@ -0,0 +1,444 @@
+package reconcilers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// notificationLogRetention is how long sent notifications are kept in the catalog's notification log.
+// It must be longer than any rate limit window for rate limits to be enforced correctly.
+const notificationLogRetention = 7 * 24 * time.Hour
+
+// notificationClaimLease is how long queued notifications claimed for delivery are reserved for the claimer.
+// If they haven't been delivered or requeued when it expires, for example because the runtime restarted, they become due again.
+const notificationClaimLease = 10 * time.Minute
+
+// Queued notifications that fail to be delivered are retried with an exponential backoff, and dropped after notificationMaxAttempts attempts.
+const (
+	notificationRetryBackoff    = time.Minute
+	notificationMaxRetryBackoff = time.Hour
+	notificationMaxAttempts     = 10
+)
+
+// notify sends an alert notification to the given notifiers.
+// If the project has a notification policy, notifications may be held back and queued in the catalog for later delivery in a digest.
+func (r *AlertReconciler) notify(ctx context.Context, self *runtimev1.Resource, notifiers []*runtimev1.Notifier, policy *drivers.NotificationPolicy, msg *drivers.AlertStatus) error {
+	if policy == nil {
+		return r.sendAlertStatus(ctx, notifiers, msg)
+	}
+
+	catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	var notificationErr error
+	now := time.Now()
+	for _, notifier := range notifiers {
+		deliverOn, err := notificationDeliverOn(ctx, catalog, policy, notifier.Connector, now)
+		if err != nil {
+			if errors.Is(err, drivers.ErrNotImplemented) {
+				// The catalog can't store notifications, so we can't apply the policy
+				return r.sendAlertStatus(ctx, notifiers, msg)
+			}
+			return err
+		}
+
+		n, err := newNotification(self.Meta.Name.Name, notifier, msg, now, deliverOn)
+		if err != nil {
+			return err
+		}
+
+		// Hold back the notification if it's not due yet
+		if deliverOn.After(now) {
+			err = catalog.InsertNotification(ctx, n)
+			if err != nil {
+				return fmt.Errorf("failed to queue notification: %w", err)
+			}
+			continue
+		}
+
+		err = r.sendAlertStatus(ctx, []*runtimev1.Notifier{notifier}, msg)
+		if err != nil {
+			notificationErr = err
+			continue
+		}
+
+		// Log the notification so it counts towards rate limits
+		if _, ok := policy.RateLimits[notifier.Connector]; ok {
+			n.SentOn = &now
+			err = catalog.InsertNotification(ctx, n)
+			if err != nil {
+				return fmt.Errorf("failed to log notification: %w", err)
+			}
+		}
+	}
+
+	return notificationErr
+}
+
+// notificationDeliverOn returns when a notification created at now should be delivered according to the notification policy.
+// Digests and rate limits use fixed windows, so notifications that are held back within the same window are delivered together.
+func notificationDeliverOn(ctx context.Context, catalog drivers.CatalogStore, policy *drivers.NotificationPolicy, connector string, now time.Time) (time.Time, error) {
+	t := now
+	if policy.DigestWindow > 0 {
+		t = now.Truncate(policy.DigestWindow).Add(policy.DigestWindow)
+	}
+
+	if policy.QuietHours != nil {
+		if end, ok := policy.QuietHours.Until(now); ok && end.After(t) {
+			t = end
+		}
+	}
+
+	if rl, ok := policy.RateLimits[connector]; ok && !t.After(now) {
+		n, err := catalog.CountSentNotifications(ctx, connector, now.Add(-rl.Window))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if n >= rl.Limit {
+			t = now.Truncate(rl.Window).Add(rl.Window)
+		}
+	}
+
+	return t, nil
+}
+
+// flushNotifications delivers queued notifications that are due before deliverBefore.
+// Due notifications for the same destination are combined into a digest, even if they were queued by different alerts.
+// Since all alert reconcilers in the instance call it, each notification is claimed atomically with a lease to ensure it's only sent once.
+// Notifications are removed once delivered, and requeued with a backoff if the delivery fails.
+// Each delivered message is logged as one sent notification, so a digest only counts once towards rate limits.
+func (r *AlertReconciler) flushNotifications(ctx context.Context, deliverBefore time.Time) error {
+	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	now := time.Now()
+	due, err := catalog.ClaimDueNotifications(ctx, deliverBefore, now.Add(notificationClaimLease))
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotImplemented) {
+			return nil
+		}
+		return err
+	}
+
+	// Group by destination, preserving the order the notifications were created in
+	type group struct {
+		notifier      *runtimev1.Notifier
+		notifications []*drivers.Notification
+		msgs          []*drivers.AlertStatus
+	}
+	var groups []*group
+	var invalid []string
+	byKey := make(map[string]*group)
+	for _, n := range due {
+		msg := &drivers.AlertStatus{}
+		err := json.Unmarshal(n.DataJSON, msg)
+		if err != nil {
+			r.C.Logger.Warn("Dropping queued alert notification that can't be decoded", zap.String("source", n.Source), zap.Error(err))
+			invalid = append(invalid, n.ID)
+			continue
+		}
+
+		key := n.Connector + "/" + string(n.PropertiesJSON)
+		g, ok := byKey[key]
+		if !ok {
+			notifier, err := notificationNotifier(n)
+			if err != nil {
+				r.C.Logger.Warn("Dropping queued alert notification that can't be decoded", zap.String("source", n.Source), zap.Error(err))
+				invalid = append(invalid, n.ID)
+				continue
+			}
+			g = &group{notifier: notifier}
+			byKey[key] = g
+			groups = append(groups, g)
+		}
+		g.notifications = append(g.notifications, n)
+		g.msgs = append(g.msgs, msg)
+	}
+	err = catalog.DeleteNotifications(ctx, invalid)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range groups {
+		var err error
+		if len(g.msgs) == 1 {
+			err = r.sendAlertStatus(ctx, []*runtimev1.Notifier{g.notifier}, g.msgs[0])
+		} else {
+			err = r.sendAlertDigest(ctx, g.notifier, g.msgs)
+		}
+		if err != nil {
+			r.C.Logger.Warn("Failed to deliver queued alert notifications", zap.String("connector", g.notifier.Connector), zap.Int("notifications", len(g.msgs)), zap.Error(err))
+			err = r.requeueNotifications(ctx, catalog, g.notifications, now)
+			if err != nil {
+				return fmt.Errorf("failed to requeue notifications: %w", err)
+			}
+			continue
+		}
+
+		ids := make([]string, len(g.notifications))
+		for i, n := range g.notifications {
+			ids[i] = n.ID
+		}
+		err = catalog.DeleteNotifications(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to remove delivered notifications: %w", err)
+		}
+
+		// Log the delivered message so it counts towards rate limits
+		if inst.NotificationPolicy == nil {
+			continue
+		}
+		if _, ok := inst.NotificationPolicy.RateLimits[g.notifier.Connector]; ok {
+			sent := *g.notifications[0]
+			sent.ID = uuid.NewString()
+			sent.SentOn = &now
+			sent.Attempts = 0
+			err = catalog.InsertNotification(ctx, &sent)
+			if err != nil {
+				return fmt.Errorf("failed to log notification: %w", err)
+			}
+		}
+	}
+
+	return catalog.DeleteSentNotifications(ctx, now.Add(-notificationLogRetention))
+}
+
+// requeueNotifications reschedules claimed notifications that failed to be delivered.
+// The delay doubles with each attempt, and notifications that have been attempted notificationMaxAttempts times are dropped.
+func (r *AlertReconciler) requeueNotifications(ctx context.Context, catalog drivers.CatalogStore, ns []*drivers.Notification, now time.Time) error {
+	var drop []string
+	for _, n := range ns {
+		if n.Attempts >= notificationMaxAttempts {
+			r.C.Logger.Warn("Dropping queued alert notification after too many failed attempts", zap.String("source", n.Source), zap.String("connector", n.Connector), zap.Int("attempts", n.Attempts))
+			drop = append(drop, n.ID)
+			continue
+		}
+
+		backoff := notificationMaxRetryBackoff
+		if n.Attempts > 0 && n.Attempts < 32 {
+			backoff = min(notificationRetryBackoff<<(n.Attempts-1), notificationMaxRetryBackoff)
+		}
+		err := catalog.UpdateNotificationDeliverOn(ctx, n.ID, now.Add(backoff))
+		if err != nil {
+			return err
+		}
+	}
+	return catalog.DeleteNotifications(ctx, drop)
+}
+
+// flushOrphanedNotifications delivers all queued notifications if the given alert is being deleted and no other alerts remain in the instance.
+// Queued notifications are only delivered by alert reconcilers, so they would otherwise not be delivered until a new alert is created.
+// Since nothing is left to deliver them later, they are delivered immediately, even if they were held back by quiet hours or rate limits.
+func (r *AlertReconciler) flushOrphanedNotifications(ctx context.Context, self *runtimev1.Resource) error {
+	alerts, err := r.C.List(ctx, runtime.ResourceKindAlert, "", false)
+	if err != nil {
+		return err
+	}
+	for _, a := range alerts {
+		if a.Meta.DeletedOn == nil && a.Meta.Name.Name != self.Meta.Name.Name {
+			return nil
+		}
+	}
+
+	// Notifications are never queued for longer than the log retention, so this delivers all of them
+	return r.flushNotifications(ctx, time.Now().Add(notificationLogRetention))
+}
+
+// nextNotificationDeliverOn returns the earliest delivery time of the notifications queued in the instance.
+// It considers notifications queued by any alert, so notifications queued by an alert that has since been deleted or renamed are still delivered.
+func (r *AlertReconciler) nextNotificationDeliverOn(ctx context.Context) (time.Time, bool, error) {
+	catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	defer release()
+
+	pending, err := catalog.FindPendingNotifications(ctx)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotImplemented) {
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, err
+	}
+	if len(pending) == 0 {
+		return time.Time{}, false, nil
+	}
+	return pending[0].DeliverOn, true, nil
+}
+
+// escalate notifies the policy's escalation notifiers if the alert has not recovered after the configured number of executions.
+// It is called once per execution after a.State.ConsecutiveFailures has been updated, and only notifies when the count reaches the threshold.
+// Like other notifications, escalations are subject to the policy's digest window, quiet hours and rate limits.
+func (r *AlertReconciler) escalate(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, policy *drivers.NotificationPolicy, current *runtimev1.AlertExecution, openLink, editLink string) error {
+	if policy == nil || policy.Escalation == nil || a.State.ConsecutiveFailures != policy.Escalation.AfterExecutions {
+		return nil
+	}
+
+	var executionTime time.Time
+	if current.ExecutionTime != nil {
+		executionTime = current.ExecutionTime.AsTime()
+	}
+
+	msg := &drivers.AlertStatus{
+		DisplayName:    fmt.Sprintf("%s (not recovered after %d executions)", a.Spec.DisplayName, a.State.ConsecutiveFailures),
+		ExecutionTime:  executionTime,
+		Status:         current.Result.Status,
+		FailRow:        current.Result.FailRow.AsMap(),
+		ExecutionError: current.Result.ErrorMessage,
+		OpenLink:       openLink,
+		EditLink:       editLink,
+	}
+
+	notifiers := make([]*runtimev1.Notifier, len(policy.Escalation.Notifiers))
+	for i, n := range policy.Escalation.Notifiers {
+		props, err := structpb.NewStruct(n.Properties)
+		if err != nil {
+			return err
+		}
+		notifiers[i] = &runtimev1.Notifier{Connector: n.Connector, Properties: props}
+	}
+
+	return r.notify(ctx, self, notifiers, policy, msg)
+}
+
+// sendAlertStatus immediately sends an alert notification to the given notifiers.
+// If sending to a notifier fails, it still tries the remaining notifiers and returns the last error.
+func (r *AlertReconciler) sendAlertStatus(ctx context.Context, notifiers []*runtimev1.Notifier, msg *drivers.AlertStatus) error {
+	var notificationErr error
+	for _, notifier := range notifiers {
+		switch notifier.Connector {
+		// TODO: transform email client to notifier
+		case "email":
+			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
+			for _, recipient := range recipients {
+				msg.ToEmail = recipient
+				err := r.C.Runtime.Email.SendAlertStatus(msg)
+				if err != nil {
+					notificationErr = fmt.Errorf("failed to send email to %q: %w", recipient, err)
+					break
+				}
+			}
+		default:
+			err := r.withNotifier(ctx, notifier, "alert_status", func(n drivers.Notifier) error {
+				return n.SendAlertStatus(msg)
+			})
+			if err != nil {
+				notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
+			}
+		}
+	}
+	return notificationErr
+}
+
+// sendAlertDigest immediately sends a digest of multiple alert notifications to a notifier.
+func (r *AlertReconciler) sendAlertDigest(ctx context.Context, notifier *runtimev1.Notifier, msgs []*drivers.AlertStatus) error {
+	switch notifier.Connector {
+	case "email":
+		recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
+		for _, recipient := range recipients {
+			err := r.C.Runtime.Email.SendAlertDigest(&drivers.AlertDigest{ToEmail: recipient, Alerts: msgs})
+			if err != nil {
+				return fmt.Errorf("failed to send email to %q: %w", recipient, err)
+			}
+		}
+		return nil
+	default:
+		err := r.withNotifier(ctx, notifier, "alert_digest", func(n drivers.Notifier) error {
+			return n.SendAlertDigest(&drivers.AlertDigest{Alerts: msgs})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
+		}
+		return nil
+	}
+}
+
+// withNotifier acquires a notifier for the notifier's connector and calls fn with it.
+// It records the latency of fn as an activity metric.
+func (r *AlertReconciler) withNotifier(ctx context.Context, notifier *runtimev1.Notifier, notificationType string, fn func(n drivers.Notifier) error) (outErr error) {
+	conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+	n, err := conn.AsNotifier(notifier.Properties.AsMap())
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	defer func() {
+		totalLatency := time.Since(start).Milliseconds()
+
+		if r.C.Activity != nil {
+			r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+				attribute.Bool("failed", outErr != nil),
+				attribute.String("connector", notifier.Connector),
+				attribute.String("notification_type", notificationType),
+			)
+		}
+	}()
+	return fn(n)
+}
+
+// notificationNotifier returns the notifier to deliver a queued notification with.
+func notificationNotifier(n *drivers.Notification) (*runtimev1.Notifier, error) {
+	var props map[string]any
+	err := json.Unmarshal(n.PropertiesJSON, &props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode notifier properties: %w", err)
+	}
+	propsPB, err := structpb.NewStruct(props)
+	if err != nil {
+		return nil, err
+	}
+	return &runtimev1.Notifier{Connector: n.Connector, Properties: propsPB}, nil
+}
+
+// newNotification creates a catalog entry for an alert notification sent to a notifier.
+func newNotification(source string, notifier *runtimev1.Notifier, msg *drivers.AlertStatus, now, deliverOn time.Time) (*drivers.Notification, error) {
+	props, err := json.Marshal(notifier.Properties.AsMap())
+	if err != nil {
+		return nil, err
+	}
+
+	// The recipient is set per email when the notification is sent
+	tmp := *msg
+	tmp.ToEmail = ""
+	data, err := json.Marshal(&tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drivers.Notification{
+		ID:             uuid.NewString(),
+		Source:         source,
+		Connector:      notifier.Connector,
+		PropertiesJSON: props,
+		DataJSON:       data,
+		CreatedOn:      now,
+		DeliverOn:      deliverOn,
+	}, nil
+}
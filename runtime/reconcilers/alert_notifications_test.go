@@ -0,0 +1,50 @@
+package reconcilers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationDeliverOn(t *testing.T) {
+	ctx := context.Background()
+	loc, err := time.LoadLocation("Europe/Copenhagen")
+	require.NoError(t, err)
+
+	policy := &drivers.NotificationPolicy{
+		DigestWindow: 15 * time.Minute,
+		QuietHours:   &drivers.QuietHours{Start: 22 * 60, End: 7 * 60, TimeZone: "Europe/Copenhagen"},
+	}
+
+	// Outside quiet hours, notifications are delivered at the end of the digest window
+	got, err := notificationDeliverOn(ctx, nil, policy, "slack", time.Date(2024, 3, 1, 12, 5, 0, 0, loc))
+	require.NoError(t, err)
+	require.True(t, time.Date(2024, 3, 1, 12, 15, 0, 0, loc).Equal(got), got)
+
+	// During quiet hours spanning midnight, they are delivered when the quiet hours end
+	got, err = notificationDeliverOn(ctx, nil, policy, "slack", time.Date(2024, 3, 1, 23, 15, 0, 0, loc))
+	require.NoError(t, err)
+	require.True(t, time.Date(2024, 3, 2, 7, 0, 0, 0, loc).Equal(got), got)
+
+	got, err = notificationDeliverOn(ctx, nil, policy, "slack", time.Date(2024, 3, 2, 6, 59, 0, 0, loc))
+	require.NoError(t, err)
+	require.True(t, time.Date(2024, 3, 2, 7, 0, 0, 0, loc).Equal(got), got)
+
+	// Without a digest window, notifications outside quiet hours are delivered immediately
+	policy.DigestWindow = 0
+	now := time.Date(2024, 3, 2, 7, 0, 0, 0, loc)
+	got, err = notificationDeliverOn(ctx, nil, policy, "slack", now)
+	require.NoError(t, err)
+	require.True(t, now.Equal(got), got)
+
+	// Quiet hours within a day
+	q := &drivers.QuietHours{Start: 12 * 60, End: 13 * 60}
+	end, ok := q.Until(time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC))
+	require.True(t, ok)
+	require.True(t, time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC).Equal(end))
+	_, ok = q.Until(time.Date(2024, 3, 1, 11, 59, 0, 0, time.UTC))
+	require.False(t, ok)
+}
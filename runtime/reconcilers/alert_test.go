@@ -1,14 +1,19 @@
 package reconcilers_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
@@ -573,6 +578,112 @@ SELECT '2024-01-02T00:00:00Z'::TIMESTAMP as __time, 'Sweden' as country
 	require.Contains(t, emails[0].Body, "measure_0")
 }
 
+func TestAlertNotificationPolicy(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/rill.yaml": `
+notifications:
+  rate_limits:
+    email:
+      limit: 1
+      window: 1h
+  escalation:
+    after_executions: 2
+    notify:
+      email:
+        recipients: [oncall@example.com]
+`,
+		"/alerts/a1.yaml": `
+type: alert
+display_name: Test Alert
+refresh:
+  cron: 0 0 1 1 *
+data:
+  resource_status:
+    where_error: false
+renotify: true
+notify:
+  email:
+    recipients: [owner@example.com]
+`,
+	})
+	ctx := context.Background()
+	sender := rt.Email.Sender.(*email.TestSender)
+	catalog, release, err := rt.Catalog(ctx, id)
+	require.NoError(t, err)
+	defer release()
+
+	// The alert fails when it's created, which sends a notification and counts towards the rate limit
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	require.Len(t, sender.Emails, 1)
+	require.Equal(t, "owner@example.com", sender.Emails[0].ToEmail)
+	sent, err := catalog.CountSentNotifications(ctx, "email", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+
+	// The next failure is rate limited, and so is the escalation it triggers, so both are queued until the end of the rate limit window
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: "a1"})
+	require.Len(t, sender.Emails, 1)
+	pending, err := catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	var owner, escalation *drivers.Notification
+	for _, n := range pending {
+		require.Equal(t, "a1", n.Source)
+		require.True(t, n.DeliverOn.After(time.Now()))
+		require.False(t, n.DeliverOn.After(time.Now().Add(time.Hour)))
+		if strings.Contains(string(n.PropertiesJSON), "oncall@example.com") {
+			escalation = n
+		} else {
+			owner = n
+		}
+	}
+	require.NotNil(t, owner)
+	require.NotNil(t, escalation)
+	require.Contains(t, string(escalation.DataJSON), "not recovered after 2 executions")
+
+	// Due notifications for the same destination are delivered in one digest, also if they were queued by an alert that no longer exists
+	for _, src := range []string{"deleted", "a1"} {
+		data, err := json.Marshal(&drivers.AlertStatus{DisplayName: fmt.Sprintf("Queued by %s", src), Status: runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL})
+		require.NoError(t, err)
+		err = catalog.InsertNotification(ctx, &drivers.Notification{
+			ID:             uuid.NewString(),
+			Source:         src,
+			Connector:      "email",
+			PropertiesJSON: owner.PropertiesJSON,
+			DataJSON:       data,
+			CreatedOn:      time.Now().Add(-time.Hour),
+			DeliverOn:      time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+	}
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: "a1"})
+	require.Len(t, sender.Emails, 2)
+	digest := sender.Emails[1]
+	require.Equal(t, "owner@example.com", digest.ToEmail)
+	require.Equal(t, "2 alert notifications", digest.Subject)
+	require.Contains(t, digest.Body, "Queued by deleted")
+	require.Contains(t, digest.Body, "Queued by a1")
+
+	// The digest counts as one sent notification, and the alert's new notification is rate limited
+	sent, err = catalog.CountSentNotifications(ctx, "email", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, sent)
+	pending, err = catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+
+	// When the last alert is deleted, the queued notifications are delivered immediately since nothing would deliver them later
+	testruntime.DeleteFiles(t, rt, id, "/alerts/a1.yaml")
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 1, 0, 0)
+	require.Len(t, sender.Emails, 4)
+	pending, err = catalog.FindPendingNotifications(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+}
+
 func newMetricsView(name, model, timeDim string, measures, dimensions []string) (*runtimev1.MetricsViewV2, *runtimev1.Resource) {
 	metrics := &runtimev1.MetricsViewV2{
 		Spec: &runtimev1.MetricsViewSpec{
@@ -128,6 +128,13 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: err}
 	}
 
+	// Enforced contracts require staging changes, so the previous output can be kept if the new output violates the contract
+	if model.Spec.Contract != nil && model.Spec.Contract.Enforced && !modelEnv.StageChanges {
+		env := *modelEnv
+		env.StageChanges = true
+		modelEnv = &env
+	}
+
 	// Handle deletion
 	if self.Meta.DeletedOn != nil {
 		if prevManager != nil {
@@ -289,6 +296,25 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		newIncrementalState, newIncrementalStateSchema, execErr = r.resolveIncrementalState(ctx, model)
 	}
 
+	// If the model has a contract, check the output against it.
+	// Enforced contracts are also checked before the output replaces the previous output (the parser rejects enforced contracts for incremental models, which insert in-place).
+	var resultSchema *runtimev1.StructType
+	var contractViolations []string
+	if execErr == nil && model.Spec.Contract != nil {
+		resultSchema, execErr = r.lookupSchema(ctx, execRes.Connector, execRes.Table)
+		if execErr != nil {
+			execErr = fmt.Errorf("failed to check contract: %w", execErr)
+		} else {
+			contractViolations = checkContract(model.Spec.Contract, resultSchema)
+			if model.Spec.Contract.Enforced {
+				execErr = contractError(contractViolations)
+				contractViolations = nil
+			} else if len(contractViolations) > 0 {
+				r.C.Logger.Warn("model output violates its contract", zap.String("model", n.Name), zap.Strings("violations", contractViolations))
+			}
+		}
+	}
+
 	// If the model is partitioned, track if any of the partitions have errors
 	var partitionsHaveErrors bool
 	if model.State.PartitionsModelId != "" {
@@ -313,6 +339,13 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.IncrementalState = newIncrementalState
 		model.State.IncrementalStateSchema = newIncrementalStateSchema
 		model.State.PartitionsHaveErrors = partitionsHaveErrors
+		if model.Spec.Contract != nil {
+			model.State.SchemaChanges = appendSchemaChange(model.State.SchemaChanges, model.State.ResultSchema, resultSchema)
+		} else {
+			model.State.SchemaChanges = nil
+		}
+		model.State.ResultSchema = resultSchema
+		model.State.ContractViolations = contractViolations
 		err := r.updateStateWithResult(ctx, self, execRes)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -506,6 +539,31 @@ func (r *ModelReconciler) executionSpecHash(ctx context.Context, refs []*runtime
 		}
 	}
 
+	// Only hashed when set to avoid changing the spec hash of existing models
+	if spec.Contract != nil {
+		err = binary.Write(hash, binary.BigEndian, spec.Contract.Enforced)
+		if err != nil {
+			return "", err
+		}
+
+		err = binary.Write(hash, binary.BigEndian, spec.Contract.AllowExtraColumns)
+		if err != nil {
+			return "", err
+		}
+
+		for _, c := range spec.Contract.Columns {
+			_, err = hash.Write([]byte(c.Name))
+			if err != nil {
+				return "", err
+			}
+
+			err = binary.Write(hash, binary.BigEndian, int32(c.Type))
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
@@ -584,6 +642,8 @@ func (r *ModelReconciler) updateStateClear(ctx context.Context, self *runtimev1.
 	mdl.State.IncrementalStateSchema = nil
 	mdl.State.PartitionsModelId = ""
 	mdl.State.PartitionsHaveErrors = false
+	mdl.State.ResultSchema = nil
+	mdl.State.ContractViolations = nil
 
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
@@ -1187,6 +1247,7 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 		PartitionRun:         partition != nil,
 		PreviousResult:       prevResult,
 		TempDir:              tempDir,
+		ValidateOutput:       r.contractValidator(mdl, executor.finalConnector),
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,145 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxSchemaChanges is the number of schema changes retained in a model's state.
+const maxSchemaChanges = 10
+
+// lookupSchema returns the schema of a model's output table.
+func (r *ModelReconciler) lookupSchema(ctx context.Context, connector, table string) (*runtimev1.StructType, error) {
+	if table == "" {
+		return nil, fmt.Errorf("contracts are only supported for models that output to a table")
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	t, err := olap.InformationSchema().Lookup(ctx, "", "", table)
+	if err != nil {
+		return nil, err
+	}
+	return t.Schema, nil
+}
+
+// contractValidator returns a drivers.ModelExecuteOptions.ValidateOutput callback that fails if the output violates an enforced contract.
+// It returns nil if the model doesn't have an enforced contract.
+func (r *ModelReconciler) contractValidator(mdl *runtimev1.ModelV2, connector string) func(ctx context.Context, table string) error {
+	contract := mdl.Spec.Contract
+	if contract == nil || !contract.Enforced {
+		return nil
+	}
+
+	return func(ctx context.Context, table string) error {
+		schema, err := r.lookupSchema(ctx, connector, table)
+		if err != nil {
+			return fmt.Errorf("failed to check contract: %w", err)
+		}
+		return contractError(checkContract(contract, schema))
+	}
+}
+
+// contractError returns an error describing the contract violations, or nil if there are none.
+func contractError(violations []string) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return fmt.Errorf("model output violates its contract: %s", strings.Join(violations, "; "))
+}
+
+// checkContract returns a description of each way the schema violates the contract.
+func checkContract(contract *runtimev1.ModelContract, schema *runtimev1.StructType) []string {
+	fields := make(map[string]*runtimev1.StructType_Field, len(schema.Fields))
+	for _, f := range schema.Fields {
+		fields[strings.ToLower(f.Name)] = f
+	}
+
+	var violations []string
+	expected := make(map[string]bool, len(contract.Columns))
+	for _, c := range contract.Columns {
+		expected[strings.ToLower(c.Name)] = true
+
+		f, ok := fields[strings.ToLower(c.Name)]
+		if !ok {
+			violations = append(violations, fmt.Sprintf("missing column %q", c.Name))
+			continue
+		}
+		if c.Type != runtimev1.Type_CODE_UNSPECIFIED && f.Type.Code != c.Type {
+			violations = append(violations, fmt.Sprintf("column %q has type %s (expected %s)", c.Name, typeName(f.Type.Code), typeName(c.Type)))
+		}
+	}
+
+	if !contract.AllowExtraColumns {
+		for _, f := range schema.Fields {
+			if !expected[strings.ToLower(f.Name)] {
+				violations = append(violations, fmt.Sprintf("unexpected column %q", f.Name))
+			}
+		}
+	}
+
+	return violations
+}
+
+// appendSchemaChange appends the changes from prev to next (if any) to the changes, retaining at most maxSchemaChanges changes.
+// If prev is nil, there is no baseline to compare against, so no change is recorded.
+func appendSchemaChange(changes []*runtimev1.ModelSchemaChange, prev, next *runtimev1.StructType) []*runtimev1.ModelSchemaChange {
+	if prev == nil {
+		return changes
+	}
+
+	cols := diffSchemas(prev, next)
+	if len(cols) == 0 {
+		return changes
+	}
+
+	changes = append(changes, &runtimev1.ModelSchemaChange{
+		DetectedOn: timestamppb.Now(),
+		Columns:    cols,
+	})
+	if len(changes) > maxSchemaChanges {
+		changes = changes[len(changes)-maxSchemaChanges:]
+	}
+	return changes
+}
+
+// diffSchemas returns the columns that were added, removed or changed type between prev and next.
+func diffSchemas(prev, next *runtimev1.StructType) []*runtimev1.ModelColumnChange {
+	prevFields := make(map[string]*runtimev1.StructType_Field, len(prev.Fields))
+	for _, f := range prev.Fields {
+		prevFields[f.Name] = f
+	}
+	nextFields := make(map[string]bool, len(next.Fields))
+
+	var res []*runtimev1.ModelColumnChange
+	for _, f := range next.Fields {
+		nextFields[f.Name] = true
+		pf, ok := prevFields[f.Name]
+		if !ok {
+			res = append(res, &runtimev1.ModelColumnChange{Name: f.Name, Added: true, NewType: f.Type.Code})
+			continue
+		}
+		if pf.Type.Code != f.Type.Code {
+			res = append(res, &runtimev1.ModelColumnChange{Name: f.Name, PreviousType: pf.Type.Code, NewType: f.Type.Code})
+		}
+	}
+	for _, f := range prev.Fields {
+		if !nextFields[f.Name] {
+			res = append(res, &runtimev1.ModelColumnChange{Name: f.Name, Removed: true, PreviousType: f.Type.Code})
+		}
+	}
+	return res
+}
+
+// typeName returns a human-readable name for a type code, e.g. "INT64" for CODE_INT64.
+func typeName(c runtimev1.Type_Code) string {
+	return strings.TrimPrefix(c.String(), "CODE_")
+}
@@ -0,0 +1,91 @@
+package reconcilers_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelContract(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+
+	// Create a model that satisfies its contract
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"models/m1.yaml": `
+type: model
+sql: SELECT 1::BIGINT AS id, 'a' AS name
+contract:
+  columns:
+  - name: id
+    type: BIGINT
+  - name: name
+    type: VARCHAR
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+
+	m1 := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "m1").GetModel()
+	require.Equal(t, "m1", m1.State.ResultTable)
+	require.Len(t, m1.State.ResultSchema.Fields, 2)
+	require.Empty(t, m1.State.ContractViolations)
+	require.Empty(t, m1.State.SchemaChanges)
+
+	// Change the type of a column, which violates the enforced contract
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"models/m1.yaml": `
+type: model
+sql: SELECT 'x' AS id, 'a' AS name
+contract:
+  columns:
+  - name: id
+    type: BIGINT
+  - name: name
+    type: VARCHAR
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+
+	// Check the error and that the previous output was kept
+	r := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "m1")
+	require.Contains(t, r.Meta.ReconcileError, `column "id" has type STRING (expected INT64)`)
+	require.Equal(t, "m1", r.GetModel().State.ResultTable)
+	olap, release, err := rt.OLAP(context.Background(), id, "")
+	require.NoError(t, err)
+	defer release()
+	tbl, err := olap.InformationSchema().Lookup(context.Background(), "", "", "m1")
+	require.NoError(t, err)
+	require.Equal(t, runtimev1.Type_CODE_INT64, tbl.Schema.Fields[0].Type.Code)
+
+	// Add a column with a contract in warn mode
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"models/m1.yaml": `
+type: model
+sql: SELECT 1::BIGINT AS id, 'a' AS name, true AS extra
+contract:
+  mode: warn
+  columns:
+  - name: id
+    type: BIGINT
+  - name: name
+    type: VARCHAR
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+
+	// Check the violation and the schema change were recorded
+	m1 = testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "m1").GetModel()
+	require.Equal(t, []string{`unexpected column "extra"`}, m1.State.ContractViolations)
+	require.Len(t, m1.State.ResultSchema.Fields, 3)
+	require.Len(t, m1.State.SchemaChanges, 1)
+	require.Len(t, m1.State.SchemaChanges[0].Columns, 1)
+	require.Equal(t, "extra", m1.State.SchemaChanges[0].Columns[0].Name)
+	require.True(t, m1.State.SchemaChanges[0].Columns[0].Added)
+	require.Equal(t, runtimev1.Type_CODE_BOOL, m1.State.SchemaChanges[0].Columns[0].NewType)
+}
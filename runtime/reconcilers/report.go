@@ -2,8 +2,10 @@ package reconcilers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
 	"time"
@@ -14,9 +16,11 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/rilldata/rill/runtime/pkg/snapshot"
 	"github.com/rilldata/rill/runtime/queries"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -386,6 +390,11 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	admin, release, err := r.C.Runtime.Admin(ctx, r.C.InstanceID)
 	if err != nil {
 		if errors.Is(err, runtime.ErrAdminNotConfigured) {
+			// Google Sheets exports don't depend on the admin service, so we can still write the sheet
+			if rep.Spec.ExportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS {
+				_, err := r.exportToGoogleSheets(ctx, self, rep, t, nil)
+				return false, err
+			}
 			r.C.Logger.Info("Skipped sending report because an admin service is not configured", zap.String("report", self.Meta.Name.Name))
 			return false, nil
 		}
@@ -404,18 +413,60 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 			emailRecipients = pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
 		}
 	}
+	for _, s := range rep.Spec.Burst.GetSlices() {
+		emailRecipients = append(emailRecipients, s.Recipients...)
+	}
+
+	// Snapshot reports need URLs with access tokens to render the dashboard from
+	isSnapshot := isSnapshotExportFormat(rep.Spec.ExportFormat)
+	var snapshotType, snapshotResource string
+	if isSnapshot {
+		snapshotType, snapshotResource = snapshotTarget(rep)
+	}
 
-	meta, err := admin.GetReportMetadata(ctx, self.Meta.Name.Name, ownerID, emailRecipients, t)
+	meta, err := admin.GetReportMetadata(ctx, self.Meta.Name.Name, ownerID, emailRecipients, rep.Spec.Burst != nil, snapshotType, snapshotResource, t)
 	if err != nil {
 		return false, fmt.Errorf("failed to get report metadata: %w", err)
 	}
 
-	internalUsersExportURL, err := createExportURL(meta.BaseURLs.ExportURL, rep.Spec.ExportFormat.String(), t, int(rep.Spec.ExportLimit))
-	if err != nil {
-		return false, err
+	// Burst reports are executed separately for each recipient or slice
+	if rep.Spec.Burst != nil {
+		return r.sendBurstReport(ctx, self, rep, meta, t)
+	}
+
+	// Snapshot reports send a rendered dashboard instead of linking to a download of the query result.
+	// The snapshot is rendered separately for each email recipient with their own permissions.
+	// Recipients who are not members of the project can't receive snapshots, so we check that before sending anything.
+	var internalUsersExportURL *url.URL
+	if isSnapshot {
+		for _, recipient := range emailRecipients {
+			if meta.RecipientSnapshotURLs[recipient] == "" {
+				return false, fmt.Errorf("cannot send a snapshot to %q: snapshot reports can only be emailed to members of the project", recipient)
+			}
+		}
+	} else {
+		internalUsersExportURL, err = createExportURL(meta.BaseURLs.ExportURL, rep.Spec.ExportFormat.String(), t, int(rep.Spec.ExportLimit))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// For Google Sheets, write the data to the sheet on behalf of the owner and link to the sheet instead of a download.
+	var sheetURL string
+	if rep.Spec.ExportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS {
+		sheetURL, err = r.exportToGoogleSheets(ctx, self, rep, t, meta.QueryForAttributes)
+		if err != nil {
+			return false, err
+		}
+		internalUsersExportURL, err = url.Parse(sheetURL)
+		if err != nil {
+			return false, err
+		}
 	}
 
 	sent := false
+	var ownerSnap *snapshot.Snapshot
+	var ownerSnapFilename string
 	for _, notifier := range rep.Spec.Notifiers {
 		switch notifier.Connector {
 		case "email":
@@ -429,21 +480,33 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
 				}
 				openURL := meta.BaseURLs.OpenURL
-				exportURL := internalUsersExportURL.String()
+				var exportURL string
+				if internalUsersExportURL != nil {
+					exportURL = internalUsersExportURL.String()
+				}
 				editURL := meta.BaseURLs.EditURL
 				if urls, ok := meta.RecipientURLs[recipient]; ok {
 					openURL = urls.OpenURL
 					editURL = urls.EditURL
-					u, err := createExportURL(urls.ExportURL, rep.Spec.ExportFormat.String(), t, int(rep.Spec.ExportLimit))
-					if err != nil {
-						return false, err
+					if sheetURL == "" && !isSnapshot {
+						u, err := createExportURL(urls.ExportURL, rep.Spec.ExportFormat.String(), t, int(rep.Spec.ExportLimit))
+						if err != nil {
+							return false, err
+						}
+						exportURL = u.String()
 					}
-					exportURL = u.String()
 					opts.External = true
 				}
 				opts.OpenLink = openURL
 				opts.DownloadLink = exportURL
 				opts.EditLink = editURL
+				if isSnapshot {
+					snap, filename, err := r.renderSnapshot(ctx, self, rep, meta.RecipientSnapshotURLs[recipient], t)
+					if err != nil {
+						return sent, err
+					}
+					opts.Attachments = []*email.Attachment{{Filename: filename, ContentType: snap.ContentType, Data: snap.Data}}
+				}
 				err := r.C.Runtime.Email.SendScheduledReport(opts)
 				sent = true
 				if err != nil {
@@ -466,9 +529,21 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 					ReportTime:     t,
 					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
 					OpenLink:       meta.BaseURLs.OpenURL,
-					DownloadLink:   internalUsersExportURL.String(),
 					EditLink:       meta.BaseURLs.EditURL,
 				}
+				if internalUsersExportURL != nil {
+					msg.DownloadLink = internalUsersExportURL.String()
+				}
+				if isSnapshot {
+					// Other notifiers don't have individual recipients, so they get a snapshot rendered with the owner's permissions
+					if ownerSnap == nil {
+						ownerSnap, ownerSnapFilename, err = r.renderSnapshot(ctx, self, rep, meta.BaseURLs.SnapshotURL, t)
+						if err != nil {
+							return err
+						}
+					}
+					msg.Attachments = []*drivers.Attachment{{Filename: ownerSnapFilename, ContentType: ownerSnap.ContentType, Data: ownerSnap.Data}}
+				}
 				start := time.Now()
 				defer func() {
 					totalLatency := time.Since(start).Milliseconds()
@@ -497,6 +572,167 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	return false, nil
 }
 
+// exportToGoogleSheets writes the result of the report query to the report's Google Sheet and returns the URL of the sheet.
+// The query runs with the given user attributes, which should be the attributes of the report's owner.
+func (r *ReportReconciler) exportToGoogleSheets(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, t time.Time, attrs map[string]any) (string, error) {
+	opts := rep.Spec.ExportGoogleSheets
+	if opts == nil || opts.SpreadsheetId == "" {
+		return "", errors.New("missing Google Sheets spreadsheet ID")
+	}
+
+	res, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID: r.C.InstanceID,
+		Resolver:   "legacy_metrics",
+		ResolverProperties: map[string]any{
+			"query_name":      rep.Spec.QueryName,
+			"query_args_json": rep.Spec.QueryArgsJson,
+		},
+		Args: map[string]any{
+			"priority":       reportQueryPriority,
+			"execution_time": t,
+			"limit":          int(rep.Spec.ExportLimit),
+		},
+		Claims: &runtime.SecurityClaims{UserAttributes: attrs},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve report query: %w", err)
+	}
+	defer res.Close()
+
+	var data []map[string]any
+	for {
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return "", err
+		}
+		data = append(data, row)
+	}
+
+	// Use the schema for the column order if available, otherwise sort the keys of the first row
+	var header []string
+	if res.Schema() != nil {
+		for _, f := range res.Schema().Fields {
+			header = append(header, f.Name)
+		}
+	} else if len(data) > 0 {
+		header = maps.Keys(data[0])
+		slices.Sort(header)
+	}
+
+	rows := make([][]any, 0, len(data)+1)
+	headerRow := make([]any, len(header))
+	for i, h := range header {
+		headerRow[i] = h
+	}
+	rows = append(rows, headerRow)
+	for _, d := range data {
+		row := make([]any, len(header))
+		for i, h := range header {
+			row[i], err = sheetValue(d[h])
+			if err != nil {
+				return "", err
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	sheet := opts.Sheet
+	if sheet == "" {
+		sheet = self.Meta.Name.Name
+	}
+
+	client, err := r.C.Runtime.GoogleSheets(ctx, r.C.InstanceID)
+	if err != nil {
+		return "", err
+	}
+	u, err := client.WriteSheet(ctx, opts.SpreadsheetId, sheet, rows)
+	if err != nil {
+		return "", err
+	}
+
+	r.C.Logger.Info("Exported report to Google Sheets", zap.String("report", self.Meta.Name.Name), zap.String("spreadsheet_id", opts.SpreadsheetId), zap.String("sheet", sheet))
+	return u, nil
+}
+
+// snapshotTarget returns the type and name of the canvas or explore rendered by a snapshot report.
+func snapshotTarget(rep *runtimev1.Report) (string, string) {
+	opts := rep.Spec.ExportSnapshot
+	switch {
+	case opts.GetCanvas() != "":
+		return runtime.ResourceKindCanvas, opts.Canvas
+	case opts.GetExplore() != "":
+		return runtime.ResourceKindExplore, opts.Explore
+	default:
+		return "", ""
+	}
+}
+
+// renderSnapshot renders the report's canvas or explore and returns the snapshot with a filename for it.
+// The renderer service loads the dashboard from snapshotURL, which embeds an access token for the user the snapshot is rendered for.
+func (r *ReportReconciler) renderSnapshot(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, snapshotURL string, t time.Time) (*snapshot.Snapshot, string, error) {
+	opts := rep.Spec.ExportSnapshot
+	kind, name := snapshotTarget(rep)
+	if name == "" {
+		return nil, "", errors.New("missing canvas or explore to render")
+	}
+	if snapshotURL == "" {
+		return nil, "", fmt.Errorf("missing URL to render a snapshot of %q from", name)
+	}
+
+	req := &snapshot.Request{
+		URL:           snapshotURL,
+		ResourceKind:  kind,
+		ResourceName:  name,
+		Title:         rep.Spec.DisplayName,
+		Width:         int(opts.Width),
+		Height:        int(opts.Height),
+		ExecutionTime: t,
+	}
+	if rep.Spec.ExportFormat == runtimev1.ExportFormat_EXPORT_FORMAT_PNG {
+		req.Format = snapshot.FormatPNG
+	} else {
+		req.Format = snapshot.FormatPDF
+	}
+
+	renderer, err := r.C.Runtime.SnapshotRenderer()
+	if err != nil {
+		return nil, "", err
+	}
+	snap, err := renderer.Render(ctx, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to render snapshot of %q: %w", req.ResourceName, err)
+	}
+
+	r.C.Logger.Info("Rendered report snapshot", zap.String("report", self.Meta.Name.Name), zap.String("resource", req.ResourceName), zap.Int("bytes", len(snap.Data)))
+	return snap, snap.Filename(fmt.Sprintf("%s_%s", self.Meta.Name.Name, t.UTC().Format("2006-01-02"))), nil
+}
+
+// isSnapshotExportFormat returns true if the format is a rendered snapshot of a dashboard.
+func isSnapshotExportFormat(f runtimev1.ExportFormat) bool {
+	return f == runtimev1.ExportFormat_EXPORT_FORMAT_PDF || f == runtimev1.ExportFormat_EXPORT_FORMAT_PNG
+}
+
+// sheetValue converts a value to a type that can be written to a Google Sheets cell.
+func sheetValue(v any) (any, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case map[string]any, []any:
+		res, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(res), nil
+	case time.Time:
+		return v.Format(time.RFC3339), nil
+	default:
+		return v, nil
+	}
+}
+
 func createExportURL(inURL, format string, executionTime time.Time, exportLimit int) (*url.URL, error) {
 	exportURL, err := url.Parse(inURL)
 	if err != nil {
@@ -522,6 +758,16 @@ func formatExportFormat(f runtimev1.ExportFormat) string {
 		return "Excel"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return "Parquet"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return "JSON Lines"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return "Arrow"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS:
+		return "Google Sheets"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PDF:
+		return "PDF"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PNG:
+		return "PNG"
 	default:
 		return f.String()
 	}
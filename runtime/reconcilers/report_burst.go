@@ -0,0 +1,296 @@
+package reconcilers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/rilldata/rill/runtime/queries"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// reportBurstDefaultConcurrency is the default number of burst slices that are executed concurrently.
+const reportBurstDefaultConcurrency = 4
+
+// burstSlice is a single execution of a burst report.
+type burstSlice struct {
+	// name identifies the slice in the report's execution state.
+	name string
+	// recipients are the email addresses that receive the slice's output.
+	recipients []string
+	// claims are the security claims the slice's query runs with.
+	claims *runtime.SecurityClaims
+	// err is set if the slice can't be executed, for example if the recipient's attributes couldn't be resolved.
+	err error
+}
+
+// sendBurstReport executes the report's query once for each burst slice and emails each slice's output to its recipients as an attachment.
+// Slices run concurrently, and the outcome for each recipient is recorded in the current execution's burst results.
+// Like sendReport, it returns true if an error occurred after some notifications were sent.
+func (r *ReportReconciler) sendBurstReport(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, meta *drivers.ReportMetadata, t time.Time) (bool, error) {
+	var emailRecipients []string
+	for _, notifier := range rep.Spec.Notifiers {
+		if notifier.Connector == "email" {
+			emailRecipients = pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
+		}
+	}
+
+	bursts := burstSlices(rep.Spec.Burst, emailRecipients, meta)
+
+	concurrency := int(rep.Spec.Burst.Concurrency)
+	if concurrency <= 0 {
+		concurrency = reportBurstDefaultConcurrency
+	}
+
+	var mu sync.Mutex
+	var results []*runtimev1.ReportBurstResult
+	record := func(s *burstSlice, recipient string, err error) {
+		res := &runtimev1.ReportBurstResult{Recipient: recipient, Slice: s.name}
+		if err != nil {
+			res.ErrorMessage = err.Error()
+		}
+		mu.Lock()
+		results = append(results, res)
+		mu.Unlock()
+	}
+
+	// The group's context is not used to cancel other slices: a failure for one slice should not affect the others.
+	grp := &errgroup.Group{}
+	grp.SetLimit(concurrency)
+	for _, s := range bursts {
+		grp.Go(func() error {
+			if s.err != nil {
+				for _, recipient := range s.recipients {
+					record(s, recipient, s.err)
+				}
+				return nil
+			}
+
+			att, err := r.exportBurstSlice(ctx, rep, s.claims, t)
+			if err != nil {
+				r.C.Logger.Warn("Failed to execute report burst slice", zap.String("report", self.Meta.Name.Name), zap.String("slice", s.name), zap.Error(err))
+				for _, recipient := range s.recipients {
+					record(s, recipient, fmt.Errorf("failed to execute report query: %w", err))
+				}
+				return nil
+			}
+
+			for _, recipient := range s.recipients {
+				opts := &email.ScheduledReport{
+					ToEmail:        recipient,
+					DisplayName:    rep.Spec.DisplayName,
+					ReportTime:     t,
+					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
+					OpenLink:       meta.BaseURLs.OpenURL,
+					EditLink:       meta.BaseURLs.EditURL,
+					Attachments:    []*email.Attachment{att},
+				}
+				if urls, ok := meta.RecipientURLs[recipient]; ok {
+					opts.OpenLink = urls.OpenURL
+					opts.EditLink = urls.EditURL
+					opts.External = true
+				}
+				err := r.C.Runtime.Email.SendScheduledReport(opts)
+				if err != nil {
+					err = fmt.Errorf("failed to send email: %w", err)
+				}
+				record(s, recipient, err)
+			}
+			return nil
+		})
+	}
+	_ = grp.Wait()
+
+	// Sort the results for a stable order in the report's state
+	slices.SortFunc(results, func(a, b *runtimev1.ReportBurstResult) int {
+		if a.Slice != b.Slice {
+			return strings.Compare(a.Slice, b.Slice)
+		}
+		return strings.Compare(a.Recipient, b.Recipient)
+	})
+	rep.State.CurrentExecution.BurstResults = results
+
+	var failed int
+	for _, res := range results {
+		if res.ErrorMessage != "" {
+			failed++
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return failed < len(results), err
+	}
+	if failed > 0 {
+		return failed < len(results), fmt.Errorf("failed to send report to %d of %d recipients", failed, len(results))
+	}
+	return false, nil
+}
+
+// burstSlices resolves the slices of a burst report and the security claims each slice's query should run with.
+// For per-recipient bursts, every email recipient gets their own slice that runs with their own attributes.
+// For explicit slices that set attributes, the slice's query runs once with those attributes for all its recipients.
+// Otherwise, the slice's query runs separately for each recipient with the recipient's own attributes.
+// If the burst has a dimension, each slice is further restricted to rows matching the slice's value.
+func burstSlices(burst *runtimev1.ReportBurst, emailRecipients []string, meta *drivers.ReportMetadata) []*burstSlice {
+	if burst.PerRecipient {
+		res := make([]*burstSlice, 0, len(emailRecipients))
+		for _, recipient := range emailRecipients {
+			res = append(res, recipientBurstSlice(recipient, recipient, meta))
+		}
+		return res
+	}
+
+	var res []*burstSlice
+	for i, bs := range burst.Slices {
+		var name string
+		var rules []*runtimev1.SecurityRule
+		if burst.Dimension != "" {
+			name = fmt.Sprintf("%s=%s", burst.Dimension, burstValueString(bs.Value))
+			rules = []*runtimev1.SecurityRule{burstRowFilter(burst.Dimension, bs.Value)}
+		} else {
+			name = fmt.Sprintf("slice %d", i+1)
+		}
+
+		if bs.Attributes != nil {
+			res = append(res, &burstSlice{
+				name:       name,
+				recipients: bs.Recipients,
+				claims:     &runtime.SecurityClaims{UserAttributes: bs.Attributes.AsMap(), AdditionalRules: rules},
+			})
+			continue
+		}
+
+		for _, recipient := range bs.Recipients {
+			s := recipientBurstSlice(name, recipient, meta)
+			if s.claims != nil {
+				s.claims.AdditionalRules = rules
+			}
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// recipientBurstSlice returns a slice for a single recipient that runs with the recipient's own attributes.
+// If the recipient's attributes couldn't be resolved, the slice has an error instead of claims.
+func recipientBurstSlice(name, recipient string, meta *drivers.ReportMetadata) *burstSlice {
+	s := &burstSlice{name: name, recipients: []string{recipient}}
+	if attrs, ok := meta.RecipientAttributes[recipient]; ok {
+		s.claims = &runtime.SecurityClaims{UserAttributes: attrs}
+	} else if msg, ok := meta.RecipientAttributeErrors[recipient]; ok {
+		s.err = fmt.Errorf("could not resolve the recipient's attributes: %s", msg)
+	} else {
+		s.err = errors.New("could not resolve the recipient's attributes")
+	}
+	return s
+}
+
+// burstRowFilter returns a security rule that limits a query to rows where the dimension matches the value.
+// If the value is a list, rows matching any of the list's values are included.
+func burstRowFilter(dimension string, value *structpb.Value) *runtimev1.SecurityRule {
+	var vals []*runtimev1.Expression
+	if l := value.GetListValue(); l != nil {
+		for _, v := range l.Values {
+			vals = append(vals, expressionpb.Value(v))
+		}
+	} else {
+		vals = append(vals, expressionpb.Value(value))
+	}
+
+	return &runtimev1.SecurityRule{
+		Rule: &runtimev1.SecurityRule_RowFilter{
+			RowFilter: &runtimev1.SecurityRuleRowFilter{
+				Expression: expressionpb.In(expressionpb.Identifier(dimension), vals),
+			},
+		},
+	}
+}
+
+// burstValueString formats a burst slice's dimension value for display.
+func burstValueString(v *structpb.Value) string {
+	if l := v.GetListValue(); l != nil {
+		strs := make([]string, len(l.Values))
+		for i, v := range l.Values {
+			strs[i] = burstValueString(v)
+		}
+		return strings.Join(strs, ",")
+	}
+	return fmt.Sprint(v.AsInterface())
+}
+
+// exportBurstSlice runs the report's query with the given claims and returns the output as an email attachment.
+func (r *ReportReconciler) exportBurstSlice(ctx context.Context, rep *runtimev1.Report, claims *runtime.SecurityClaims, t time.Time) (*email.Attachment, error) {
+	qry, err := queries.ProtoFromJSON(rep.Spec.QueryName, rep.Spec.QueryArgsJson, &t)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := queries.ProtoToQuery(qry, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the report's export limit
+	if limit := rep.Spec.ExportLimit; limit > 0 {
+		switch q := q.(type) {
+		case *queries.MetricsViewAggregation:
+			if q.Limit == nil || *q.Limit == 0 || *q.Limit > int64(limit) {
+				l := int64(limit)
+				q.Limit = &l
+			}
+		case *queries.MetricsViewComparison:
+			if q.Limit == 0 || q.Limit > int64(limit) {
+				q.Limit = int64(limit)
+			}
+		case *queries.MetricsViewContribution:
+			if q.Limit == 0 || q.Limit > int64(limit) {
+				q.Limit = int64(limit)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	var filename string
+	err = q.Export(ctx, r.C.Runtime, r.C.InstanceID, &buf, &runtime.ExportOptions{
+		Format:   rep.Spec.ExportFormat,
+		Priority: reportQueryPriority,
+		PreWriteHook: func(name string) error {
+			filename = name
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	att := &email.Attachment{
+		Filename: fmt.Sprintf("%s_%s", filename, t.Format("20060102150405")),
+		Data:     buf.Bytes(),
+	}
+	switch rep.Spec.ExportFormat {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
+		att.Filename += ".csv"
+		att.ContentType = "text/csv"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		att.Filename += ".xlsx"
+		att.ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		att.Filename += ".parquet"
+		att.ContentType = "application/octet-stream"
+	default:
+		return nil, fmt.Errorf("unsupported export format %q for burst reports", rep.Spec.ExportFormat.String())
+	}
+	return att, nil
+}
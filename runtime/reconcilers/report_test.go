@@ -0,0 +1,222 @@
+package reconcilers_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+
+	mockadmin "github.com/rilldata/rill/runtime/drivers/mock/admin"
+)
+
+func TestSnapshotReport(t *testing.T) {
+	rt, id := newReportInstance(t, map[string]string{
+		"users": `{"alice@example.com": {"email": "alice@example.com", "admin": true}, "bob@example.com": {"email": "bob@example.com", "admin": false}}`,
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/rill.yaml": ``,
+		"/canvases/c1.yaml": `
+type: canvas
+items:
+- component:
+    markdown:
+      content: "Hello world!"
+`,
+		"/reports/r1.yaml": `
+type: report
+display_name: Weekly snapshot
+refresh:
+  cron: 0 9 * * 1
+export:
+  format: pdf
+  snapshot:
+    canvas: c1
+notify:
+  email:
+    recipients: [alice@example.com, bob@example.com]
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: "r1"})
+	rep := testruntime.GetResource(t, rt, id, runtime.ResourceKindReport, "r1").GetReport()
+	require.Len(t, rep.State.ExecutionHistory, 1)
+	require.Empty(t, rep.State.ExecutionHistory[0].ErrorMessage)
+
+	// Each recipient gets a snapshot rendered from a URL with their own access token
+	emails := rt.Email.Sender.(*email.TestSender).Emails
+	require.Len(t, emails, 2)
+	for i, recipient := range []string{"alice@example.com", "bob@example.com"} {
+		require.Equal(t, recipient, emails[i].ToEmail)
+		require.Len(t, emails[i].Attachments, 1)
+		a := emails[i].Attachments[0]
+		require.Equal(t, "application/pdf", a.ContentType)
+		require.Regexp(t, `^r1_\d{4}-\d{2}-\d{2}\.pdf$`, a.Filename)
+		// The local renderer writes the URL it renders into the PDF
+		u := fmt.Sprintf("%s/-/embed?type=%s&resource=c1&user=%s", mockadmin.BaseURL, url.QueryEscape(runtime.ResourceKindCanvas), url.QueryEscape(recipient))
+		require.Contains(t, string(a.Data), u)
+	}
+
+	// Snapshots can't be emailed to recipients who are not members of the project
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/reports/r1.yaml": `
+type: report
+display_name: Weekly snapshot
+refresh:
+  cron: 0 9 * * 1
+export:
+  format: pdf
+  snapshot:
+    canvas: c1
+notify:
+  email:
+    recipients: [alice@example.com, external@other.com]
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: "r1"})
+	rep = testruntime.GetResource(t, rt, id, runtime.ResourceKindReport, "r1").GetReport()
+	require.Contains(t, rep.State.ExecutionHistory[0].ErrorMessage, `cannot send a snapshot to "external@other.com"`)
+	require.Len(t, rt.Email.Sender.(*email.TestSender).Emails, 2)
+}
+
+func TestBurstReport(t *testing.T) {
+	rt, id := newReportInstance(t, map[string]string{
+		"users":           `{"alice@example.com": {"admin": true}, "bob@example.com": {"country": "SE"}, "dave@example.com": {"country": "US"}}`,
+		"fail_attributes": "carol@example.com",
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/rill.yaml": ``,
+		"/models/sales.sql": `
+SELECT 'EMEA' AS region, 'DK' AS country, 10 AS revenue
+UNION ALL SELECT 'EMEA' AS region, 'SE' AS country, 20 AS revenue
+UNION ALL SELECT 'NA' AS region, 'US' AS country, 30 AS revenue
+UNION ALL SELECT 'NA' AS region, 'CA' AS country, 40 AS revenue
+`,
+		"/metrics/mv1.yaml": `
+version: 1
+type: metrics_view
+model: sales
+dimensions:
+- column: region
+- column: country
+measures:
+- name: revenue
+  expression: sum(revenue)
+security:
+  access: true
+  row_filter: "{{ .user.admin }} OR country = '{{ .user.country }}'"
+`,
+		"/reports/r1.yaml": `
+type: report
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+    dimensions: [{name: region}, {name: country}]
+    measures: [{name: revenue}]
+    sort: [{name: country}]
+export:
+  format: csv
+burst:
+  per_recipient: true
+notify:
+  email:
+    recipients: [alice@example.com, bob@example.com, carol@example.com]
+`,
+		"/reports/r2.yaml": `
+type: report
+refresh:
+  cron: 0 9 * * 1
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+    dimensions: [{name: region}, {name: country}]
+    measures: [{name: revenue}]
+    sort: [{name: country}]
+export:
+  format: csv
+burst:
+  dimension: region
+  slices:
+  - value: EMEA
+    recipients: [alice@example.com, bob@example.com]
+  - value: NA
+    recipients: [dave@example.com, alice@example.com]
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 5, 0, 0)
+
+	// Each recipient gets the rows they're allowed to see, and a failed attribute lookup only fails that recipient
+	sender := rt.Email.Sender.(*email.TestSender)
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: "r1"})
+	rep := testruntime.GetResource(t, rt, id, runtime.ResourceKindReport, "r1").GetReport()
+	require.Len(t, rep.State.ExecutionHistory, 1)
+	require.Equal(t, "failed to send report to 1 of 3 recipients", rep.State.ExecutionHistory[0].ErrorMessage)
+	results := rep.State.ExecutionHistory[0].BurstResults
+	require.Len(t, results, 3)
+	require.Empty(t, results[0].ErrorMessage)
+	require.Empty(t, results[1].ErrorMessage)
+	require.Equal(t, "carol@example.com", results[2].Recipient)
+	require.Contains(t, results[2].ErrorMessage, "could not resolve the recipient's attributes")
+	require.ElementsMatch(t, []string{
+		"alice@example.com:region,country,revenue\nNA,CA,40\nEMEA,DK,10\nEMEA,SE,20\nNA,US,30\n",
+		"bob@example.com:region,country,revenue\nEMEA,SE,20\n",
+	}, burstAttachments(t, sender, 0))
+
+	// Dimension slices run with each recipient's own attributes in addition to the slice's filter
+	n := len(sender.Emails)
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: "r2"})
+	rep = testruntime.GetResource(t, rt, id, runtime.ResourceKindReport, "r2").GetReport()
+	require.Len(t, rep.State.ExecutionHistory, 1)
+	require.Empty(t, rep.State.ExecutionHistory[0].ErrorMessage)
+	require.Len(t, rep.State.ExecutionHistory[0].BurstResults, 4)
+	require.ElementsMatch(t, []string{
+		"alice@example.com:region,country,revenue\nEMEA,DK,10\nEMEA,SE,20\n",
+		"bob@example.com:region,country,revenue\nEMEA,SE,20\n",
+		"alice@example.com:region,country,revenue\nNA,CA,40\nNA,US,30\n",
+		"dave@example.com:region,country,revenue\nNA,US,30\n",
+	}, burstAttachments(t, sender, n))
+}
+
+// burstAttachments returns the recipient and CSV attachment of each email sent after the first n emails formatted as "<recipient>:<csv>".
+func burstAttachments(t *testing.T, sender *email.TestSender, n int) []string {
+	var res []string
+	for _, e := range sender.Emails[n:] {
+		require.Len(t, e.Attachments, 1)
+		require.Equal(t, "text/csv", e.Attachments[0].ContentType)
+		res = append(res, fmt.Sprintf("%s:%s", e.ToEmail, e.Attachments[0].Data))
+	}
+	slices.Sort(res)
+	return res
+}
+
+// newReportInstance creates an instance with a mock admin service with the given config.
+func newReportInstance(t *testing.T, adminConfig map[string]string) (*runtime.Runtime, string) {
+	rt, id := testruntime.NewInstance(t)
+
+	ctx := context.Background()
+	inst, err := rt.Instance(ctx, id)
+	require.NoError(t, err)
+	inst.Connectors = append(inst.Connectors, &runtimev1.Connector{
+		Type:   "mock_admin",
+		Name:   "admin",
+		Config: adminConfig,
+	})
+	inst.AdminConnector = "admin"
+	require.NoError(t, rt.EditInstance(ctx, inst, false))
+
+	return rt, id
+}
@@ -74,6 +74,9 @@ type ResolverOptions struct {
 	Args       map[string]any
 	Claims     *SecurityClaims
 	ForExport  bool
+	// Limit is an optional limit on the number of rows returned by ResolveInteractive.
+	// Resolvers that can push it down into the underlying query should do so; others may ignore it.
+	Limit int64
 }
 
 // ResolverInitializer is a function that initializes a resolver.
@@ -97,6 +100,8 @@ type ResolveOptions struct {
 	ResolverProperties map[string]any
 	Args               map[string]any
 	Claims             *SecurityClaims
+	// Limit is an optional limit on the number of rows to return. It is not guaranteed to be enforced by all resolvers.
+	Limit int64
 }
 
 // Resolve resolves a query using the given options.
@@ -108,6 +113,12 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolverRe
 		panic("received nil claims")
 	}
 
+	// Enforce the query budget of the user or token
+	ctx, err := r.WithQueryBudget(ctx, opts.InstanceID, opts.Claims)
+	if err != nil {
+		return nil, err
+	}
+
 	// Initialize the resolver
 	initializer, ok := ResolverInitializers[opts.Resolver]
 	if !ok {
@@ -120,6 +131,7 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolverRe
 		Args:       opts.Args,
 		Claims:     opts.Claims,
 		ForExport:  false,
+		Limit:      opts.Limit,
 	})
 	if err != nil {
 		return nil, err
@@ -145,6 +157,11 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolverRe
 	if _, err := hash.Write(cacheKey); err != nil {
 		return nil, err
 	}
+	if opts.Limit != 0 {
+		if err := binary.Write(hash, binary.BigEndian, opts.Limit); err != nil {
+			return nil, err
+		}
+	}
 	if opts.Claims.UserAttributes != nil {
 		h, err := hashstructure.Hash(opts.Claims.UserAttributes, hashstructure.FormatV2, nil)
 		if err != nil {
@@ -67,5 +67,6 @@ func newAPI(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolve
 		Args:       opts.Args,
 		Claims:     opts.Claims,
 		ForExport:  opts.ForExport,
+		Limit:      opts.Limit,
 	})
 }
@@ -0,0 +1,238 @@
+package resolvers
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// arithmeticExpr is a parsed arithmetic expression over named numeric values, such as "cost / conversions".
+// It supports numbers, identifiers, parentheses, unary minus and the operators +, -, * and /.
+type arithmeticExpr interface {
+	// eval evaluates the expression for the values in row.
+	// It returns false if a referenced value is null or not numeric, or if the result is not a finite number (e.g. division by zero).
+	eval(row map[string]any) (float64, bool)
+	// identifiers returns the identifiers referenced in the expression.
+	identifiers() []string
+}
+
+// parseArithmeticExpr parses an arithmetic expression.
+func parseArithmeticExpr(s string) (arithmeticExpr, error) {
+	p := &arithmeticParser{src: s}
+	expr, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.src[p.pos], p.pos)
+	}
+	return expr, nil
+}
+
+type arithmeticParser struct {
+	src string
+	pos int
+}
+
+func (p *arithmeticParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *arithmeticParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// parseSum parses terms separated by + or -.
+func (p *arithmeticParser) parseSum() (arithmeticExpr, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithmeticBinary{op: op, left: left, right: right}
+	}
+}
+
+// parseProduct parses factors separated by * or /.
+func (p *arithmeticParser) parseProduct() (arithmeticExpr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if op != '*' && op != '/' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithmeticBinary{op: op, left: left, right: right}
+	}
+}
+
+// parseFactor parses a number, identifier, parenthesized expression or negation.
+func (p *arithmeticParser) parseFactor() (arithmeticExpr, error) {
+	c := p.peek()
+	switch {
+	case c == 0:
+		return nil, fmt.Errorf("unexpected end of expression")
+	case c == '-':
+		p.pos++
+		x, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return &arithmeticBinary{op: '-', left: arithmeticNumber(0), right: x}, nil
+	case c == '(':
+		p.pos++
+		x, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis at position %d", p.pos)
+		}
+		p.pos++
+		return x, nil
+	case c == '.' || (c >= '0' && c <= '9'):
+		start := p.pos
+		for p.pos < len(p.src) && (p.src[p.pos] == '.' || (p.src[p.pos] >= '0' && p.src[p.pos] <= '9')) {
+			p.pos++
+		}
+		n, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", p.src[start:p.pos])
+		}
+		return arithmeticNumber(n), nil
+	case c == '_' || unicode.IsLetter(rune(c)):
+		start := p.pos
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || unicode.IsLetter(rune(p.src[p.pos])) || unicode.IsDigit(rune(p.src[p.pos]))) {
+			p.pos++
+		}
+		return arithmeticIdent(p.src[start:p.pos]), nil
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", c, p.pos)
+	}
+}
+
+type arithmeticNumber float64
+
+func (n arithmeticNumber) eval(row map[string]any) (float64, bool) {
+	return float64(n), true
+}
+
+func (n arithmeticNumber) identifiers() []string {
+	return nil
+}
+
+type arithmeticIdent string
+
+func (i arithmeticIdent) eval(row map[string]any) (float64, bool) {
+	return toFloat64(row[string(i)])
+}
+
+func (i arithmeticIdent) identifiers() []string {
+	return []string{string(i)}
+}
+
+type arithmeticBinary struct {
+	op          byte
+	left, right arithmeticExpr
+}
+
+func (b *arithmeticBinary) eval(row map[string]any) (float64, bool) {
+	l, ok := b.left.eval(row)
+	if !ok {
+		return 0, false
+	}
+	r, ok := b.right.eval(row)
+	if !ok {
+		return 0, false
+	}
+
+	var res float64
+	switch b.op {
+	case '+':
+		res = l + r
+	case '-':
+		res = l - r
+	case '*':
+		res = l * r
+	case '/':
+		res = l / r
+	}
+	if math.IsNaN(res) || math.IsInf(res, 0) {
+		return 0, false
+	}
+	return res, true
+}
+
+func (b *arithmeticBinary) identifiers() []string {
+	return append(b.left.identifiers(), b.right.identifiers()...)
+}
+
+// toFloat64 converts a numeric value returned by a driver to a float64.
+func toFloat64(v any) (float64, bool) {
+	switch v := v.(type) {
+	case nil:
+		return 0, false
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	case fmt.Stringer:
+		// Handles decimal types
+		f, err := strconv.ParseFloat(v.String(), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
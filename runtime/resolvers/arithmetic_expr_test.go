@@ -0,0 +1,49 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArithmeticExpr(t *testing.T) {
+	row := map[string]any{
+		"cost":        int64(150),
+		"conversions": 3.0,
+		"clicks":      "30",
+		"zero":        0,
+		"missing":     nil,
+	}
+
+	tt := []struct {
+		expr  string
+		want  float64
+		valid bool
+		ids   []string
+	}{
+		{expr: "cost / conversions", want: 50, valid: true, ids: []string{"cost", "conversions"}},
+		{expr: "cost - clicks * 2", want: 90, valid: true, ids: []string{"cost", "clicks"}},
+		{expr: "(cost - clicks) * 2", want: 240, valid: true, ids: []string{"cost", "clicks"}},
+		{expr: "-cost + 1.5", want: -148.5, valid: true, ids: []string{"cost"}},
+		{expr: "100 * conversions / clicks", want: 10, valid: true, ids: []string{"conversions", "clicks"}},
+		{expr: "cost / zero", valid: false, ids: []string{"cost", "zero"}},
+		{expr: "cost / missing", valid: false, ids: []string{"cost", "missing"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.expr, func(t *testing.T) {
+			expr, err := parseArithmeticExpr(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.ids, expr.identifiers())
+			got, ok := expr.eval(row)
+			require.Equal(t, tc.valid, ok)
+			if tc.valid {
+				require.InDelta(t, tc.want, got, 1e-9)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", "cost /", "(cost", "cost)", "cost $ 2", "1..2"} {
+		_, err := parseArithmeticExpr(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -35,5 +35,6 @@ func newBuiltinMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runt
 		Args:       args,
 		Claims:     opts.Claims,
 		ForExport:  opts.ForExport,
+		Limit:      opts.Limit,
 	})
 }
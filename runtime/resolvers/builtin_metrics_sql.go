@@ -45,5 +45,6 @@ func newBuiltinMetricsSQL(ctx context.Context, opts *runtime.ResolverOptions) (r
 		},
 		Claims:    opts.Claims,
 		ForExport: opts.ForExport,
+		Limit:     opts.Limit,
 	})
 }
@@ -47,5 +47,6 @@ func newBuiltinSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.
 		},
 		Claims:    opts.Claims,
 		ForExport: opts.ForExport,
+		Limit:     opts.Limit,
 	})
 }
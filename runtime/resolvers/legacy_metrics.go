@@ -160,6 +160,15 @@ func (r *legacyMetricsResolver) ResolveInteractive(ctx context.Context) (runtime
 				out = append(out, r)
 			}
 		}
+	case *queries.MetricsViewContribution:
+		if q.Result != nil {
+			for i, row := range q.Result.Rows {
+				if r.args.Limit > 0 && i >= r.args.Limit {
+					break
+				}
+				out = append(out, r.formatMetricsViewContributionResult(row, q, spec.Measures))
+			}
+		}
 	default:
 		return nil, fmt.Errorf("query type %T not supported", q)
 	}
@@ -212,6 +221,39 @@ func (r *legacyMetricsResolver) formatMetricsViewComparisonResult(row *runtimev1
 	return res
 }
 
+func (r *legacyMetricsResolver) formatMetricsViewContributionResult(row *runtimev1.MetricsViewContributionRow, q *queries.MetricsViewContribution, measures []*runtimev1.MetricsViewSpec_MeasureV2) map[string]any {
+	measureLabel := q.Measure
+	volumeLabel := q.VolumeMeasure
+	var f, fv, fp formatter.Formatter
+	if r.args.Format {
+		measureLabel, f = r.getMeasureLabelAndFormatter(q.Measure, measures)
+		if q.VolumeMeasure != "" {
+			volumeLabel, fv = r.getMeasureLabelAndFormatter(q.VolumeMeasure, measures)
+		}
+		var err error
+		fp, err = formatter.NewPresetFormatter("percentage", false)
+		if err != nil {
+			r.logger.Warn("Failed to get formatter, using no formatter", zap.Error(err))
+			fp = nil
+		}
+	}
+
+	res := make(map[string]any)
+	res[row.Dimension] = row.DimensionValue.AsInterface()
+	res[measureLabel] = r.formatValue(f, row.BaseValue)
+	res[measureLabel+" (prev)"] = r.formatValue(f, row.ComparisonValue)
+	res[measureLabel+" (Δ)"] = r.formatValue(f, row.DeltaAbs)
+	res["Contribution"] = r.formatValue(fp, row.Contribution)
+	if q.VolumeMeasure != "" {
+		res[volumeLabel] = r.formatValue(fv, row.BaseVolume)
+		res[volumeLabel+" (prev)"] = r.formatValue(fv, row.ComparisonVolume)
+		res["Volume effect"] = r.formatValue(f, row.VolumeEffect)
+		res["Mix effect"] = r.formatValue(f, row.MixEffect)
+		res["Rate effect"] = r.formatValue(f, row.RateEffect)
+	}
+	return res
+}
+
 // getComparisonMeasureLabelAndFormatter gets the measure label and formatter by a measure name and adds a suffix if it was compared measure.
 // for relative change comparison it uses percent formatter, uses defined preset for everything else
 // if a measure is not found in the request list, it returns the measure name as the label and no formatter.
@@ -27,6 +27,13 @@ type metricsResolver struct {
 	args           *metricsResolverArgs
 	claims         *runtime.SecurityClaims
 	metricsHasTime bool
+	forecast       *metricsForecast
+}
+
+// metricsResolverProps are properties of the metrics resolver that are not part of the metrics view query.
+type metricsResolverProps struct {
+	// Forecast optionally appends forecasted rows to a query that is grouped by time.
+	Forecast *metricsForecast `mapstructure:"forecast"`
 }
 
 type metricsResolverArgs struct {
@@ -40,11 +47,20 @@ func newMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Res
 		return nil, err
 	}
 
+	props := &metricsResolverProps{}
+	if err := mapstructureutil.WeakDecode(opts.Properties, props); err != nil {
+		return nil, err
+	}
+
 	args := &metricsResolverArgs{}
 	if err := mapstructureutil.WeakDecode(opts.Args, args); err != nil {
 		return nil, err
 	}
 
+	if opts.Limit > 0 && (qry.Limit == nil || *qry.Limit > opts.Limit) {
+		qry.Limit = &opts.Limit
+	}
+
 	ctrl, err := opts.Runtime.Controller(ctx, opts.InstanceID)
 	if err != nil {
 		return nil, err
@@ -60,6 +76,13 @@ func newMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Res
 		return nil, fmt.Errorf("metrics view %q is invalid", res.Meta.Name.Name)
 	}
 
+	if props.Forecast != nil {
+		err := props.Forecast.validate(qry, mv)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	security, err := opts.Runtime.ResolveSecurity(opts.InstanceID, opts.Claims, res)
 	if err != nil {
 		return nil, err
@@ -82,6 +105,7 @@ func newMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Res
 		args:           args,
 		claims:         opts.Claims,
 		metricsHasTime: mv.TimeDimension != "",
+		forecast:       props.Forecast,
 	}, nil
 }
 
@@ -107,6 +131,9 @@ func (r *metricsResolver) CacheKey(ctx context.Context) ([]byte, bool, error) {
 	}
 
 	queryMap["mv_cache_key"] = key
+	if r.forecast != nil {
+		queryMap["forecast"] = r.forecast
+	}
 	bytes, err := json.Marshal(queryMap)
 	return bytes, true, err
 }
@@ -136,6 +163,19 @@ func (r *metricsResolver) ResolveInteractive(ctx context.Context) (runtime.Resol
 	if err != nil {
 		return nil, err
 	}
+
+	if r.forecast != nil {
+		tz := time.UTC
+		if r.query.TimeZone != "" {
+			tz, err = time.LoadLocation(r.query.TimeZone)
+			if err != nil {
+				_ = res.Close()
+				return nil, err
+			}
+		}
+		return r.forecast.apply(res, tz)
+	}
+
 	return runtime.NewDriverResolverResult(res), nil
 }
 
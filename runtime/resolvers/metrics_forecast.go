@@ -0,0 +1,266 @@
+package resolvers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"sort"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/forecast"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// metricsForecastColumn is the name of the output column that is true for forecasted rows.
+const metricsForecastColumn = "is_forecast"
+
+// metricsForecastMaxPeriods is the maximum number of periods between the first and last row of a forecasted query.
+const metricsForecastMaxPeriods = 100000
+
+// metricsForecast configures a forecast in the metrics resolver.
+// The query must have a single dimension that is the time dimension floored to a grain.
+// Forecasted rows are appended after the last period with data. They have is_forecast set to true and a lower and upper bound for each forecasted measure.
+type metricsForecast struct {
+	Periods      int      `mapstructure:"periods"`
+	Method       string   `mapstructure:"method"`
+	SeasonLength int      `mapstructure:"season_length"`
+	Confidence   float64  `mapstructure:"confidence"`
+	Measures     []string `mapstructure:"measures"`
+
+	// Set by validate
+	timeAlias  string
+	grain      metricsview.TimeGrain
+	method     forecast.Method
+	firstDay   int
+	firstMonth int
+}
+
+// validate checks the forecast against the query and metrics view and initializes its unexported fields.
+func (f *metricsForecast) validate(qry *metricsview.Query, mv *runtimev1.MetricsViewSpec) error {
+	if f.Periods <= 0 {
+		return errors.New("forecast: periods must be positive")
+	}
+
+	var err error
+	f.method, err = forecast.ParseMethod(f.Method)
+	if err != nil {
+		return err
+	}
+
+	if len(qry.Dimensions) != 1 || qry.Dimensions[0].Compute == nil || qry.Dimensions[0].Compute.TimeFloor == nil || len(qry.PivotOn) > 0 {
+		return errors.New("forecast: the query must have a single dimension that floors the time dimension to a grain")
+	}
+	f.timeAlias = qry.Dimensions[0].Name
+	f.grain = qry.Dimensions[0].Compute.TimeFloor.Grain
+	if !f.grain.Valid() || f.grain == metricsview.TimeGrainUnspecified || f.grain == metricsview.TimeGrainMillisecond {
+		return fmt.Errorf("forecast: unsupported time grain %q", f.grain)
+	}
+
+	var names []string
+	for _, m := range qry.Measures {
+		names = append(names, m.Name)
+	}
+	if len(f.Measures) == 0 {
+		f.Measures = names
+	}
+	for _, m := range f.Measures {
+		if !slices.Contains(names, m) {
+			return fmt.Errorf("forecast: measure %q is not in the query", m)
+		}
+	}
+
+	f.firstDay = int(mv.FirstDayOfWeek)
+	if f.firstDay > 7 || f.firstDay <= 0 {
+		f.firstDay = 1
+	}
+	f.firstMonth = int(mv.FirstMonthOfYear)
+	if f.firstMonth > 12 || f.firstMonth <= 0 {
+		f.firstMonth = 1
+	}
+
+	return nil
+}
+
+// apply buffers the query result and appends the forecasted rows.
+func (f *metricsForecast) apply(res *drivers.Result, tz *time.Location) (runtime.ResolverResult, error) {
+	defer res.Close()
+
+	schema := proto.Clone(res.Schema).(*runtimev1.StructType)
+	for _, m := range f.Measures {
+		schema.Fields = append(schema.Fields,
+			&runtimev1.StructType_Field{Name: m + "__lower", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64, Nullable: true}},
+			&runtimev1.StructType_Field{Name: m + "__upper", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64, Nullable: true}},
+		)
+	}
+	schema.Fields = append(schema.Fields, &runtimev1.StructType_Field{Name: metricsForecastColumn, Type: &runtimev1.Type{Code: runtimev1.Type_CODE_BOOL}})
+	seen := make(map[string]bool, len(schema.Fields))
+	for _, field := range schema.Fields {
+		if seen[field.Name] {
+			return nil, fmt.Errorf("forecast: output column %q conflicts with a column in the query", field.Name)
+		}
+		seen[field.Name] = true
+	}
+
+	var rows []map[string]any
+	for res.Next() {
+		row := make(map[string]any)
+		err := res.MapScan(row)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range f.Measures {
+			row[m+"__lower"] = nil
+			row[m+"__upper"] = nil
+		}
+		row[metricsForecastColumn] = false
+		rows = append(rows, row)
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	// Index the rows with data for the forecasted measures by period
+	byPeriod := make(map[int64]map[string]any, len(rows))
+	var first, last time.Time
+	for _, row := range rows {
+		t, ok := metricsForecastTime(row[f.timeAlias])
+		if !ok {
+			continue
+		}
+		hasData := false
+		for _, m := range f.Measures {
+			if _, ok := metricsForecastNumber(row[m]); ok {
+				hasData = true
+			}
+		}
+		if !hasData {
+			continue
+		}
+		byPeriod[t.UnixNano()] = row
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+		if last.IsZero() || t.After(last) {
+			last = t
+		}
+	}
+	if len(byPeriod) == 0 {
+		return runtime.NewMapsResolverResult(rows, schema), nil
+	}
+
+	// Build an evenly spaced series for each measure from the first to the last period with data
+	var periods []map[string]any
+	for t := first; !t.After(last); t = f.next(t, tz) {
+		if len(periods) == metricsForecastMaxPeriods {
+			return nil, fmt.Errorf("forecast: the query spans more than %d periods", metricsForecastMaxPeriods)
+		}
+		periods = append(periods, byPeriod[t.UnixNano()])
+	}
+
+	opts := forecast.Options{
+		Method:       f.method,
+		Periods:      f.Periods,
+		SeasonLength: f.SeasonLength,
+		Confidence:   f.Confidence,
+	}
+	if opts.SeasonLength == 0 {
+		opts.SeasonLength = forecast.DefaultSeasonLength(f.grain.ToTimeutil())
+	}
+
+	forecasted := make([]map[string]any, f.Periods)
+	t := last
+	for i := range forecasted {
+		t = f.next(t, tz)
+		row := make(map[string]any, len(schema.Fields))
+		for _, field := range schema.Fields {
+			row[field.Name] = nil
+		}
+		row[f.timeAlias] = t
+		row[metricsForecastColumn] = true
+		forecasted[i] = row
+	}
+
+	for _, m := range f.Measures {
+		values := make([]*float64, len(periods))
+		for i, row := range periods {
+			if n, ok := metricsForecastNumber(row[m]); ok {
+				values[i] = &n
+			}
+		}
+
+		fc, err := forecast.Forecast(forecast.Interpolate(values), opts)
+		if err != nil && !errors.Is(err, forecast.ErrInsufficientData) {
+			return nil, fmt.Errorf("failed to forecast measure %q: %w", m, err)
+		}
+		if fc == nil {
+			continue
+		}
+		for i, row := range forecasted {
+			row[m] = fc.Values[i]
+			row[m+"__lower"] = fc.Lower[i]
+			row[m+"__upper"] = fc.Upper[i]
+		}
+	}
+
+	// Forecasted rows are appended after the actual rows, which are ordered by time for consistency with the forecast
+	sort.SliceStable(rows, func(i, j int) bool {
+		ti, _ := metricsForecastTime(rows[i][f.timeAlias])
+		tj, _ := metricsForecastTime(rows[j][f.timeAlias])
+		return ti.Before(tj)
+	})
+	rows = append(rows, forecasted...)
+
+	return runtime.NewMapsResolverResult(rows, schema), nil
+}
+
+// next returns the start of the period after t.
+func (f *metricsForecast) next(t time.Time, tz *time.Location) time.Time {
+	return timeutil.CeilTime(t.Add(time.Nanosecond), f.grain.ToTimeutil(), tz, f.firstDay, f.firstMonth)
+}
+
+// metricsForecastTime converts a time dimension value to a time.Time.
+func metricsForecastTime(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return *v, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+// metricsForecastNumber converts a measure value to a float64.
+func metricsForecastNumber(v any) (float64, bool) {
+	if n, ok := v.(*big.Int); ok {
+		if n == nil {
+			return 0, false
+		}
+		f, _ := new(big.Float).SetInt(n).Float64()
+		return f, true
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return float64(rv.Int()), true
+	case rv.CanUint():
+		return float64(rv.Uint()), true
+	case rv.CanFloat():
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,431 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
+)
+
+func init() {
+	runtime.RegisterResolverInitializer("metrics_join", newMetricsJoin)
+}
+
+// metricsJoinTimeAlias is the name of the output column for the time dimension when joining on a time grain.
+const metricsJoinTimeAlias = "time"
+
+// metricsJoinResolver queries multiple metrics views and joins the results on their shared dimensions (and optionally time grain).
+// Without dimensions or a time grain, each query returns a single row of totals, which are combined into one row.
+// Each metrics view is queried with the "metrics" resolver, so each metrics view's security policy is applied separately.
+type metricsJoinResolver struct {
+	runtime    *runtime.Runtime
+	instanceID string
+	props      *metricsJoinProps
+	args       map[string]any
+	claims     *runtime.SecurityClaims
+	keys       []string
+	queries    []*metricsview.Query
+	computed   []metricsJoinComputedMeasure
+	rowCap     int64
+}
+
+type metricsJoinProps struct {
+	// Queries to run against each metrics view.
+	Queries []*metricsJoinQuery `mapstructure:"queries"`
+	// Dimensions to join the queries on. They must exist in all the metrics views.
+	Dimensions []string `mapstructure:"dimensions"`
+	// TimeGrain optionally joins the queries on their time dimension truncated to the grain.
+	TimeGrain metricsview.TimeGrain `mapstructure:"time_grain"`
+	// TimeRange, TimeZone and Where are applied to all the queries.
+	TimeRange *metricsview.TimeRange  `mapstructure:"time_range"`
+	TimeZone  string                  `mapstructure:"time_zone"`
+	Where     *metricsview.Expression `mapstructure:"where"`
+	// Measures computed from the joined measures, such as "cost / conversions".
+	Measures []*metricsJoinMeasure `mapstructure:"measures"`
+	Sort     []metricsview.Sort    `mapstructure:"sort"`
+	Limit    *int64                `mapstructure:"limit"`
+}
+
+type metricsJoinQuery struct {
+	MetricsView string                  `mapstructure:"metrics_view"`
+	Measures    []string                `mapstructure:"measures"`
+	Where       *metricsview.Expression `mapstructure:"where"`
+	// Having filters the query's rows on its measures before they are joined.
+	Having *metricsview.Expression `mapstructure:"having"`
+}
+
+type metricsJoinMeasure struct {
+	Name       string `mapstructure:"name"`
+	Expression string `mapstructure:"expression"`
+}
+
+type metricsJoinComputedMeasure struct {
+	name string
+	expr arithmeticExpr
+}
+
+func newMetricsJoin(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
+	props := &metricsJoinProps{}
+	if err := mapstructureutil.WeakDecode(opts.Properties, props); err != nil {
+		return nil, err
+	}
+
+	if len(props.Queries) == 0 {
+		return nil, errors.New("metrics_join: must specify at least one query")
+	}
+	if props.TimeGrain != metricsview.TimeGrainUnspecified && !props.TimeGrain.Valid() {
+		return nil, fmt.Errorf("metrics_join: invalid time grain %q", props.TimeGrain)
+	}
+	if props.TimeGrain != metricsview.TimeGrainUnspecified && slices.Contains(props.Dimensions, metricsJoinTimeAlias) {
+		return nil, fmt.Errorf("metrics_join: dimension name %q is reserved for the time dimension", metricsJoinTimeAlias)
+	}
+
+	inst, err := opts.Runtime.Instance(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := inst.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl, err := opts.Runtime.Controller(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The join keys are the dimensions and the time dimension. Their names can't be used for measures.
+	keys := slices.Clone(props.Dimensions)
+	if props.TimeGrain != metricsview.TimeGrainUnspecified {
+		keys = append(keys, metricsJoinTimeAlias)
+	}
+	names := make(map[string]bool)
+	for _, k := range keys {
+		names[k] = true
+	}
+
+	// If the result is sorted by all the join keys and nothing else, the sort and limit can be pushed down to each query without changing the joined result.
+	// This works because the sort is then a total order on the keys, so the first N keys of the joined result are each among the first N keys of the queries they appear in.
+	// If the sort only covers some of the keys, each query may break ties differently and return rows for different keys.
+	pushDown := props.Limit != nil && len(props.Sort) > 0
+	sorted := make(map[string]bool)
+	for _, s := range props.Sort {
+		if !slices.Contains(keys, s.Name) {
+			pushDown = false
+		}
+		sorted[s.Name] = true
+	}
+	for _, k := range keys {
+		if !sorted[k] {
+			pushDown = false
+		}
+	}
+
+	// Build a metrics query for each metrics view
+	var queries []*metricsview.Query
+	for i, q := range props.Queries {
+		if q == nil || q.MetricsView == "" {
+			return nil, fmt.Errorf("metrics_join: query at index %d must specify a metrics view", i)
+		}
+		if len(q.Measures) == 0 {
+			return nil, fmt.Errorf("metrics_join: query for metrics view %q must specify measures", q.MetricsView)
+		}
+
+		res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsView}, false)
+		if err != nil {
+			return nil, err
+		}
+		mv := res.GetMetricsView().State.ValidSpec
+		if mv == nil {
+			return nil, fmt.Errorf("metrics view %q is invalid", res.Meta.Name.Name)
+		}
+
+		qry := &metricsview.Query{
+			MetricsView: q.MetricsView,
+			TimeRange:   props.TimeRange,
+			TimeZone:    props.TimeZone,
+			Where:       andExpressions(props.Where, q.Where),
+			Having:      q.Having,
+		}
+		for _, d := range props.Dimensions {
+			if !slices.ContainsFunc(mv.Dimensions, func(dim *runtimev1.MetricsViewSpec_DimensionV2) bool { return dim.Name == d }) {
+				return nil, fmt.Errorf("metrics_join: dimension %q not found in metrics view %q", d, q.MetricsView)
+			}
+			qry.Dimensions = append(qry.Dimensions, metricsview.Dimension{Name: d})
+		}
+		if props.TimeGrain != metricsview.TimeGrainUnspecified {
+			if mv.TimeDimension == "" {
+				return nil, fmt.Errorf("metrics_join: metrics view %q does not have a time dimension", q.MetricsView)
+			}
+			qry.Dimensions = append(qry.Dimensions, metricsview.Dimension{
+				Name: metricsJoinTimeAlias,
+				Compute: &metricsview.DimensionCompute{
+					TimeFloor: &metricsview.DimensionComputeTimeFloor{
+						Dimension: mv.TimeDimension,
+						Grain:     props.TimeGrain,
+					},
+				},
+			})
+		}
+		for _, m := range q.Measures {
+			if names[m] {
+				return nil, fmt.Errorf("metrics_join: name %q is used more than once", m)
+			}
+			names[m] = true
+			qry.Measures = append(qry.Measures, metricsview.Measure{Name: m})
+		}
+		if pushDown {
+			qry.Sort = slices.Clone(props.Sort)
+			qry.Limit = props.Limit
+		}
+
+		queries = append(queries, qry)
+	}
+
+	// Parse the computed measures. They can reference the queries' measures and previously computed measures.
+	var computed []metricsJoinComputedMeasure
+	for _, m := range props.Measures {
+		if m == nil || m.Name == "" || m.Expression == "" {
+			return nil, errors.New("metrics_join: computed measures must have a name and an expression")
+		}
+		if names[m.Name] {
+			return nil, fmt.Errorf("metrics_join: name %q is used more than once", m.Name)
+		}
+
+		expr, err := parseArithmeticExpr(m.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("metrics_join: invalid expression for measure %q: %w", m.Name, err)
+		}
+		for _, ident := range expr.identifiers() {
+			if !names[ident] || slices.Contains(keys, ident) {
+				return nil, fmt.Errorf("metrics_join: expression for measure %q references unknown measure %q", m.Name, ident)
+			}
+		}
+
+		names[m.Name] = true
+		computed = append(computed, metricsJoinComputedMeasure{name: m.Name, expr: expr})
+	}
+
+	for _, s := range props.Sort {
+		if !names[s.Name] {
+			return nil, fmt.Errorf("metrics_join: cannot sort by unknown field %q", s.Name)
+		}
+	}
+
+	return &metricsJoinResolver{
+		runtime:    opts.Runtime,
+		instanceID: opts.InstanceID,
+		props:      props,
+		args:       opts.Args,
+		claims:     opts.Claims,
+		keys:       keys,
+		queries:    queries,
+		computed:   computed,
+		rowCap:     cfg.InteractiveSQLRowLimit,
+	}, nil
+}
+
+func (r *metricsJoinResolver) Close() error {
+	return nil
+}
+
+func (r *metricsJoinResolver) CacheKey(ctx context.Context) ([]byte, bool, error) {
+	// The results of the underlying metrics queries are cached individually.
+	return nil, false, nil
+}
+
+func (r *metricsJoinResolver) Refs() []*runtimev1.ResourceName {
+	var refs []*runtimev1.ResourceName
+	for _, q := range r.queries {
+		if slices.ContainsFunc(refs, func(ref *runtimev1.ResourceName) bool { return ref.Name == q.MetricsView }) {
+			continue
+		}
+		refs = append(refs, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsView})
+	}
+	return refs
+}
+
+func (r *metricsJoinResolver) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (r *metricsJoinResolver) ResolveInteractive(ctx context.Context) (runtime.ResolverResult, error) {
+	// Run each query and do a full outer join of the rows on the keys.
+	// Rows are output in the order their keys are first seen.
+	// The joined rows are held in memory, so their number is capped like for other interactive queries.
+	var rows []map[string]any
+	rowsByKey := make(map[string]map[string]any)
+	schema := &runtimev1.StructType{}
+	for _, qry := range r.queries {
+		props := make(map[string]any)
+		err := mapstructure.Decode(qry, &props)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := r.runtime.Resolve(ctx, &runtime.ResolveOptions{
+			InstanceID:         r.instanceID,
+			Resolver:           "metrics",
+			ResolverProperties: props,
+			Args:               r.args,
+			Claims:             r.claims,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query metrics view %q: %w", qry.MetricsView, err)
+		}
+
+		for _, f := range res.Schema().Fields {
+			if !slices.ContainsFunc(schema.Fields, func(sf *runtimev1.StructType_Field) bool { return sf.Name == f.Name }) {
+				schema.Fields = append(schema.Fields, f)
+			}
+		}
+
+		for {
+			row, err := res.Next()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				res.Close()
+				return nil, err
+			}
+
+			key, err := metricsJoinKey(row, r.keys)
+			if err != nil {
+				res.Close()
+				return nil, err
+			}
+
+			joined, ok := rowsByKey[key]
+			if !ok {
+				if r.rowCap > 0 && int64(len(rows)) >= r.rowCap {
+					res.Close()
+					return nil, fmt.Errorf("metrics_join: the joined result exceeds the system cap of %d rows", r.rowCap)
+				}
+				joined = make(map[string]any)
+				for _, k := range r.keys {
+					joined[k] = row[k]
+				}
+				rowsByKey[key] = joined
+				rows = append(rows, joined)
+			}
+			for _, m := range qry.Measures {
+				joined[m.Name] = row[m.Name]
+			}
+		}
+		res.Close()
+	}
+
+	// Fill in nulls for measures without a matching row, and calculate the computed measures
+	for _, row := range rows {
+		for _, qry := range r.queries {
+			for _, m := range qry.Measures {
+				if _, ok := row[m.Name]; !ok {
+					row[m.Name] = nil
+				}
+			}
+		}
+		for _, m := range r.computed {
+			if v, ok := m.expr.eval(row); ok {
+				row[m.name] = v
+			} else {
+				row[m.name] = nil
+			}
+		}
+	}
+	for _, m := range r.computed {
+		schema.Fields = append(schema.Fields, &runtimev1.StructType_Field{
+			Name: m.name,
+			Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64, Nullable: true},
+		})
+	}
+
+	// Apply sort and limit
+	if len(r.props.Sort) > 0 {
+		sort.SliceStable(rows, func(i, j int) bool {
+			for _, s := range r.props.Sort {
+				a, b := rows[i][s.Name], rows[j][s.Name]
+				c := compareMetricsJoinValues(a, b)
+				if c == 0 {
+					continue
+				}
+				// Nulls are sorted last in both directions, consistent with the sort order of the metrics queries
+				if s.Desc && a != nil && b != nil {
+					return c > 0
+				}
+				return c < 0
+			}
+			return false
+		})
+	}
+	if r.props.Limit != nil && int64(len(rows)) > *r.props.Limit {
+		rows = rows[:*r.props.Limit]
+	}
+
+	return runtime.NewMapsResolverResult(rows, schema), nil
+}
+
+func (r *metricsJoinResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
+	return errors.New("not implemented")
+}
+
+// metricsJoinKey returns a string that uniquely identifies the values of the join keys in a row.
+func metricsJoinKey(row map[string]any, keys []string) (string, error) {
+	vals := make([]any, len(keys))
+	for i, k := range keys {
+		v := row[k]
+		if t, ok := v.(time.Time); ok {
+			// Normalize the time zone so the same instant from different metrics views gives the same key
+			v = t.UTC()
+		}
+		vals[i] = v
+	}
+	key, err := json.Marshal(vals)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+// compareMetricsJoinValues compares two values of the same field. Nulls are sorted last.
+func compareMetricsJoinValues(a, b any) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+	if an, ok := toFloat64(a); ok {
+		if bn, ok := toFloat64(b); ok {
+			switch {
+			case an < bn:
+				return -1
+			case an > bn:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Compare(bt)
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
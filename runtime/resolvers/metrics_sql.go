@@ -6,6 +6,7 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
 	metricssqlparser "github.com/rilldata/rill/runtime/pkg/metricssql"
 )
 
@@ -15,6 +16,12 @@ func init() {
 
 type metricsSQLProps struct {
 	SQL string `mapstructure:"sql"`
+	// AdditionalWhere and AdditionalHaving are combined with the filters in the SQL using AND.
+	// They are used to apply external filters (such as canvas filters) to a metrics SQL query.
+	AdditionalWhere  *metricsview.Expression `mapstructure:"additional_where"`
+	AdditionalHaving *metricsview.Expression `mapstructure:"additional_having"`
+	// AdditionalTimeRange is applied if the SQL doesn't specify a time range.
+	AdditionalTimeRange *metricsview.TimeRange `mapstructure:"additional_time_range"`
 }
 
 type metricsSQLArgs struct {
@@ -60,6 +67,11 @@ func newMetricsSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.
 	if err != nil {
 		return nil, err
 	}
+	query.Where = andExpressions(query.Where, props.AdditionalWhere)
+	query.Having = andExpressions(query.Having, props.AdditionalHaving)
+	if props.AdditionalTimeRange != nil && (query.TimeRange == nil || query.TimeRange.IsZero()) {
+		query.TimeRange = props.AdditionalTimeRange
+	}
 
 	// Build the options for the metrics resolver
 	metricProps := map[string]any{}
@@ -73,6 +85,7 @@ func newMetricsSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.
 		Args:       opts.Args,
 		Claims:     opts.Claims,
 		ForExport:  opts.ForExport,
+		Limit:      opts.Limit,
 	}
 	return newMetrics(ctx, resolverOpts)
 }
@@ -26,6 +26,7 @@ type sqlResolver struct {
 	olap                drivers.OLAPStore
 	olapRelease         func()
 	interactiveRowLimit int64
+	limit               int64
 	priority            int
 }
 
@@ -78,6 +79,7 @@ func newSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolve
 		olap:                olap,
 		olapRelease:         release,
 		interactiveRowLimit: cfg.InteractiveSQLRowLimit,
+		limit:               opts.Limit,
 		priority:            args.Priority,
 	}, nil
 }
@@ -107,6 +109,18 @@ func (r *sqlResolver) Validate(ctx context.Context) error {
 }
 
 func (r *sqlResolver) ResolveInteractive(ctx context.Context) (runtime.ResolverResult, error) {
+	// If the caller requested fewer rows than the interactive limit, push their limit into the query instead.
+	if r.limit > 0 && (r.interactiveRowLimit == 0 || r.limit <= r.interactiveRowLimit) {
+		res, err := r.olap.Execute(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("SELECT * FROM (%s) LIMIT %d", r.sql, r.limit),
+			Priority: r.priority,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewDriverResolverResult(res), nil
+	}
+
 	// Wrap the SQL with an outer SELECT to limit the number of rows returned in interactive mode.
 	// Adding +1 to the limit so we can return a nice error message if the limit is exceeded.
 	sql := fmt.Sprintf("SELECT * FROM (%s) LIMIT %d", r.sql, r.interactiveRowLimit+1)
@@ -137,7 +151,7 @@ func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runt
 
 	switch r.olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_JSONL {
 			return queries.DuckDBCopyExport(ctx, w, exportOpts, r.sql, nil, filename, r.olap, opts.Format)
 		}
 		return r.generalExport(ctx, w, filename, exportOpts)
@@ -196,6 +210,10 @@ func (r *sqlResolver) generalExport(ctx context.Context, w io.Writer, filename s
 		return queries.WriteXLSX(meta, data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return queries.WriteParquet(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return queries.WriteJSONL(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return queries.WriteArrow(meta, data, w)
 	}
 
 	return nil
@@ -10,6 +10,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
 )
 
 // normalizeRefs sorts and deduplicates the given refs.
@@ -59,3 +60,19 @@ func cacheKeyForMetricsView(ctx context.Context, r *runtime.Runtime, instanceID,
 	}
 	return []byte(res), true, nil
 }
+
+// andExpressions combines two optional metrics expressions with AND.
+func andExpressions(a, b *metricsview.Expression) *metricsview.Expression {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return &metricsview.Expression{
+		Condition: &metricsview.Condition{
+			Operator:    metricsview.OperatorAnd,
+			Expressions: []*metricsview.Expression{a, b},
+		},
+	}
+}
@@ -29,6 +29,12 @@ type Options struct {
 	ControllerLogBufferCapacity  int
 	ControllerLogBufferSizeBytes int64
 	AllowHostAccess              bool
+	// SnapshotsRendererURL is the endpoint of the renderer service used for PDF and PNG reports.
+	// If set to "local", placeholder snapshots are generated without a browser, which is useful for testing.
+	// It is configured for the runtime and not per instance since report access tokens are sent to it.
+	SnapshotsRendererURL string
+	// SnapshotsRendererToken is sent as a bearer token to the renderer service.
+	SnapshotsRendererToken string
 }
 
 type Runtime struct {
@@ -42,6 +48,7 @@ type Runtime struct {
 	connCache      conncache.Cache
 	queryCache     *queryCache
 	securityEngine *securityEngine
+	queryBudgets   *queryBudgetTracker
 }
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, st *storage.Client, ac *activity.Client, emailClient *email.Client) (*Runtime, error) {
@@ -57,6 +64,7 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, st *storage.Cli
 		activity:       ac,
 		queryCache:     newQueryCache(opts.QueryCacheSizeBytes),
 		securityEngine: newSecurityEngine(opts.SecurityEngineCacheSize, logger),
+		queryBudgets:   newQueryBudgetTracker(),
 	}
 
 	rt.connCache = rt.newConnectionCache()
@@ -102,6 +110,17 @@ func (r *Runtime) ResolveSecurity(instanceID string, claims *SecurityClaims, res
 	return r.securityEngine.resolveSecurity(instanceID, inst.Environment, vars, claims, res)
 }
 
+// ExplainSecurity resolves the security rules for a resource like ResolveSecurity,
+// but bypasses the cache and returns details about how each rule was evaluated.
+func (r *Runtime) ExplainSecurity(instanceID string, claims *SecurityClaims, res *runtimev1.Resource) (*SecurityExplanation, error) {
+	inst, err := r.Instance(context.Background(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+	vars := inst.ResolveVariables(false)
+	return r.securityEngine.explainSecurity(inst.Environment, vars, claims, res)
+}
+
 // GetInstanceAttributes fetches an instance and converts its annotations to attributes
 // nil is returned if an error occurred or instance was not found
 func (r *Runtime) GetInstanceAttributes(ctx context.Context, instanceID string) []attribute.KeyValue {
@@ -131,6 +150,7 @@ func (r *Runtime) UpdateInstanceWithRillYAML(ctx context.Context, instanceID str
 	inst = &tmp
 
 	inst.ProjectOLAPConnector = rillYAML.OLAPConnector
+	inst.ProjectAIConnector = rillYAML.AIConnector
 
 	// Dedupe connectors
 	connMap := make(map[string]*runtimev1.Connector)
@@ -171,6 +191,7 @@ func (r *Runtime) UpdateInstanceWithRillYAML(ctx context.Context, instanceID str
 	inst.ProjectVariables = vars
 	inst.FeatureFlags = rillYAML.FeatureFlags
 	inst.PublicPaths = rillYAML.PublicPaths
+	inst.NotificationPolicy = rillYAML.NotificationPolicy
 	return r.EditInstance(ctx, inst, restartController)
 }
 
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"sync"
 
 	"github.com/hashicorp/golang-lru/simplelru"
@@ -106,6 +107,9 @@ type ResolvedSecurity struct {
 	fieldAccess map[string]bool
 	rowFilter   string
 	queryFilter *runtimev1.Expression
+	// minGroupSize and noise are used to protect against revealing aggregates over small cohorts.
+	minGroupSize int
+	noise        float64
 }
 
 // CanAccess returns whether the resource can be accessed.
@@ -146,6 +150,39 @@ func (r *ResolvedSecurity) QueryFilter() *runtimev1.Expression {
 	return r.queryFilter
 }
 
+// MinGroupSize returns the minimum number of underlying rows a group must aggregate over to be returned when querying the resource.
+// It returns 0 if there is no minimum.
+func (r *ResolvedSecurity) MinGroupSize() int {
+	return r.minGroupSize
+}
+
+// Noise returns the relative amount of deterministic noise to apply to aggregated measure values.
+// For example, 0.05 means that values may deviate by up to +/- 5%. It returns 0 if no noise should be applied.
+func (r *ResolvedSecurity) Noise() float64 {
+	return r.noise
+}
+
+// SecurityExplanation describes how the security rules for a resource were resolved for a set of claims.
+// It is used to debug security policies.
+type SecurityExplanation struct {
+	// Resolved is the resulting security, which matches what ResolveSecurity returns for the same claims.
+	Resolved *ResolvedSecurity
+	// Rules are the evaluated rules in evaluation order.
+	Rules []*SecurityRuleEvaluation
+	// AccessRule is the index in Rules of the rule that decided access, or -1 if access was implicitly denied.
+	AccessRule int
+	// FieldRules maps fields with an explicit access decision to the index in Rules of the rule that decided it.
+	FieldRules map[string]int
+}
+
+// SecurityRuleEvaluation describes the evaluation of a single security rule.
+type SecurityRuleEvaluation struct {
+	Rule             *runtimev1.SecurityRule
+	Source           runtimev1.SecurityRuleSource
+	ConditionMatched bool
+	Skipped          bool
+}
+
 // truth is the compass that guides us through the labyrinth of existence.
 var truth = true
 
@@ -225,6 +262,43 @@ func (p *securityEngine) resolveSecurity(instanceID, environment string, vars ma
 		return cached.(*ResolvedSecurity), nil
 	}
 
+	res, err := p.applyRules(environment, vars, claims, r, rules, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p.cache.Add(cacheKey, res)
+
+	return res, nil
+}
+
+// explainSecurity resolves the security rules for a given resource and user context like resolveSecurity,
+// but bypasses the cache and records how each rule was evaluated.
+func (p *securityEngine) explainSecurity(environment string, vars map[string]string, claims *SecurityClaims, r *runtimev1.Resource) (*SecurityExplanation, error) {
+	exp := &SecurityExplanation{
+		AccessRule: -1,
+		FieldRules: make(map[string]int),
+	}
+
+	// If security checks are skipped, return open access
+	if claims.SkipChecks {
+		exp.Resolved = ResolvedSecurityOpen
+		return exp, nil
+	}
+
+	rules := p.resolveRules(claims, r)
+	res, err := p.applyRules(environment, vars, claims, r, rules, exp)
+	if err != nil {
+		return nil, err
+	}
+	exp.Resolved = res
+
+	return exp, nil
+}
+
+// applyRules applies the rules in order and returns the resulting ResolvedSecurity.
+// If exp is not nil, the evaluation of each rule is recorded in it.
+func (p *securityEngine) applyRules(environment string, vars map[string]string, claims *SecurityClaims, r *runtimev1.Resource, rules []*runtimev1.SecurityRule, exp *SecurityExplanation) (*ResolvedSecurity, error) {
 	attrs := claims.UserAttributes
 	if attrs == nil {
 		attrs = make(map[string]any)
@@ -236,34 +310,74 @@ func (p *securityEngine) resolveSecurity(instanceID, environment string, vars ma
 		Self:        rillv1.TemplateResource{Meta: r.Meta},
 	}
 
-	// Apply rules
 	res := &ResolvedSecurity{}
 	for _, rule := range rules {
 		if rule == nil {
 			continue
 		}
 
-		// As an optimization, if we've already determined that access is denied, we skip the rest of the rules
+		var eval *SecurityRuleEvaluation
+		if exp != nil {
+			eval = &SecurityRuleEvaluation{
+				Rule:   rule,
+				Source: securityRuleSource(claims, rule),
+			}
+			exp.Rules = append(exp.Rules, eval)
+		}
+
+		// As an optimization, if we've already determined that access is denied, we skip the rest of the rules.
+		// When explaining, we still record the skipped rules.
 		if res.access != nil && !*res.access {
-			break
+			if exp == nil {
+				break
+			}
+			eval.Skipped = true
+			continue
+		}
+
+		// Capture the state before applying the rule, so changes can be attributed to it
+		prevAccess := res.access
+		var prevFieldAccess map[string]bool
+		if exp != nil {
+			prevFieldAccess = maps.Clone(res.fieldAccess)
 		}
 
+		var matched bool
+		var err error
 		switch rule := rule.Rule.(type) {
 		case *runtimev1.SecurityRule_Access:
-			err := p.applySecurityRuleAccess(res, r, rule.Access, templateData)
+			matched, err = p.applySecurityRuleAccess(res, r, rule.Access, templateData)
 			if err != nil {
 				return nil, fmt.Errorf("security policy: failed to resolve access: %w", err)
 			}
 		case *runtimev1.SecurityRule_FieldAccess:
-			err := p.applySecurityRuleFieldAccess(res, r, rule.FieldAccess, templateData)
+			matched, err = p.applySecurityRuleFieldAccess(res, r, rule.FieldAccess, templateData)
 			if err != nil {
 				return nil, fmt.Errorf("security policy: failed to resolve field access: %w", err)
 			}
 		case *runtimev1.SecurityRule_RowFilter:
-			err := p.applySecurityRuleRowFilter(res, r, rule.RowFilter, templateData)
+			matched, err = p.applySecurityRuleRowFilter(res, r, rule.RowFilter, templateData)
 			if err != nil {
 				return nil, fmt.Errorf("security policy: failed to resolve row filter: %w", err)
 			}
+		case *runtimev1.SecurityRule_MinGroupSize:
+			matched, err = p.applySecurityRuleMinGroupSize(res, r, rule.MinGroupSize, templateData)
+			if err != nil {
+				return nil, fmt.Errorf("security policy: failed to resolve min group size: %w", err)
+			}
+		}
+
+		if exp != nil {
+			idx := len(exp.Rules) - 1
+			eval.ConditionMatched = matched
+			if res.access != prevAccess {
+				exp.AccessRule = idx
+			}
+			for f, v := range res.fieldAccess {
+				if pv, ok := prevFieldAccess[f]; !ok || pv != v {
+					exp.FieldRules[f] = idx
+				}
+			}
 		}
 	}
 
@@ -272,10 +386,13 @@ func (p *securityEngine) resolveSecurity(instanceID, environment string, vars ma
 		res.fieldAccess = nil
 		res.rowFilter = ""
 		res.queryFilter = nil
+		res.minGroupSize = 0
+		res.noise = 0
+		if exp != nil {
+			exp.FieldRules = make(map[string]int)
+		}
 	}
 
-	p.cache.Add(cacheKey, res)
-
 	return res, nil
 }
 
@@ -465,34 +582,29 @@ func (p *securityEngine) builtInReportSecurityRule(spec *runtimev1.ReportSpec, c
 }
 
 // applySecurityRuleAccess applies an access rule to the resolved security.
-func (p *securityEngine) applySecurityRuleAccess(res *ResolvedSecurity, _ *runtimev1.Resource, rule *runtimev1.SecurityRuleAccess, td rillv1.TemplateData) error {
+// It returns true if the rule's condition matched.
+func (p *securityEngine) applySecurityRuleAccess(res *ResolvedSecurity, _ *runtimev1.Resource, rule *runtimev1.SecurityRuleAccess, td rillv1.TemplateData) (bool, error) {
 	// If already explicitly denied, do nothing (explicit denies take precedence over explicit allows)
 	if res.access != nil && !*res.access {
-		return nil
+		return false, nil
 	}
 
-	if rule.Condition != "" {
-		expr, err := rillv1.ResolveTemplate(rule.Condition, td)
-		if err != nil {
-			return err
-		}
-		apply, err := rillv1.EvaluateBoolExpression(expr)
-		if err != nil {
-			return err
-		}
-
-		if !apply {
-			return nil
-		}
+	apply, err := evaluateSecurityCondition(rule.Condition, td)
+	if err != nil {
+		return false, err
+	}
+	if !apply {
+		return false, nil
 	}
 
 	res.access = &rule.Allow
 
-	return nil
+	return true, nil
 }
 
 // applySecurityRuleFieldAccess applies a field access rule to the resolved security.
-func (p *securityEngine) applySecurityRuleFieldAccess(res *ResolvedSecurity, r *runtimev1.Resource, rule *runtimev1.SecurityRuleFieldAccess, td rillv1.TemplateData) error {
+// It returns true if the rule's condition matched.
+func (p *securityEngine) applySecurityRuleFieldAccess(res *ResolvedSecurity, r *runtimev1.Resource, rule *runtimev1.SecurityRuleFieldAccess, td rillv1.TemplateData) (bool, error) {
 	// This rule currently only applies to metrics views and explores.
 	// Skip it for other resource types.
 	var availableFields []string
@@ -500,7 +612,7 @@ func (p *securityEngine) applySecurityRuleFieldAccess(res *ResolvedSecurity, r *
 	case ResourceKindMetricsView:
 		mv := r.GetMetricsView().State.ValidSpec
 		if mv == nil {
-			return nil
+			return false, nil
 		}
 		availableFields = make([]string, 0, len(mv.Dimensions)+len(mv.Measures))
 		for _, f := range mv.Dimensions {
@@ -512,13 +624,13 @@ func (p *securityEngine) applySecurityRuleFieldAccess(res *ResolvedSecurity, r *
 	case ResourceKindExplore:
 		exp := r.GetExplore().State.ValidSpec
 		if exp == nil {
-			return nil
+			return false, nil
 		}
 		availableFields = make([]string, 0, len(exp.Dimensions)+len(exp.Measures))
 		availableFields = append(availableFields, exp.Dimensions...)
 		availableFields = append(availableFields, exp.Measures...)
 	default:
-		return nil
+		return false, nil
 	}
 
 	// As soon as we see a field access rule, we set fieldAccess, which entails an implicit deny for all fields not mentioned
@@ -527,19 +639,12 @@ func (p *securityEngine) applySecurityRuleFieldAccess(res *ResolvedSecurity, r *
 	}
 
 	// Determine if the rule should be applied
-	if rule.Condition != "" {
-		expr, err := rillv1.ResolveTemplate(rule.Condition, td)
-		if err != nil {
-			return err
-		}
-		apply, err := rillv1.EvaluateBoolExpression(expr)
-		if err != nil {
-			return err
-		}
-
-		if !apply {
-			return nil
-		}
+	apply, err := evaluateSecurityCondition(rule.Condition, td)
+	if err != nil {
+		return false, err
+	}
+	if !apply {
+		return false, nil
 	}
 
 	// Set if the field should be allowed or denied
@@ -559,32 +664,26 @@ func (p *securityEngine) applySecurityRuleFieldAccess(res *ResolvedSecurity, r *
 		}
 	}
 
-	return nil
+	return true, nil
 }
 
 // applySecurityRuleRowFilter applies a row filter rule to the resolved security.
-func (p *securityEngine) applySecurityRuleRowFilter(res *ResolvedSecurity, _ *runtimev1.Resource, rule *runtimev1.SecurityRuleRowFilter, td rillv1.TemplateData) error {
+// It returns true if the rule's condition matched.
+func (p *securityEngine) applySecurityRuleRowFilter(res *ResolvedSecurity, _ *runtimev1.Resource, rule *runtimev1.SecurityRuleRowFilter, td rillv1.TemplateData) (bool, error) {
 	// Determine if the rule should be applied
-	if rule.Condition != "" {
-		expr, err := rillv1.ResolveTemplate(rule.Condition, td)
-		if err != nil {
-			return err
-		}
-		apply, err := rillv1.EvaluateBoolExpression(expr)
-		if err != nil {
-			return err
-		}
-
-		if !apply {
-			return nil
-		}
+	apply, err := evaluateSecurityCondition(rule.Condition, td)
+	if err != nil {
+		return false, err
+	}
+	if !apply {
+		return false, nil
 	}
 
 	// Handle raw SQL row filters
 	if rule.Sql != "" {
 		sql, err := rillv1.ResolveTemplate(rule.Sql, td)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		if res.rowFilter == "" {
@@ -603,7 +702,58 @@ func (p *securityEngine) applySecurityRuleRowFilter(res *ResolvedSecurity, _ *ru
 		}
 	}
 
-	return nil
+	return true, nil
+}
+
+// applySecurityRuleMinGroupSize applies a min group size rule to the resolved security.
+// It returns true if the rule's condition matched.
+func (p *securityEngine) applySecurityRuleMinGroupSize(res *ResolvedSecurity, _ *runtimev1.Resource, rule *runtimev1.SecurityRuleMinGroupSize, td rillv1.TemplateData) (bool, error) {
+	// Determine if the rule should be applied
+	apply, err := evaluateSecurityCondition(rule.Condition, td)
+	if err != nil {
+		return false, err
+	}
+	if !apply {
+		return false, nil
+	}
+
+	// When multiple rules apply, the most restrictive values take precedence
+	if int(rule.MinGroupSize) > res.minGroupSize {
+		res.minGroupSize = int(rule.MinGroupSize)
+	}
+	if rule.Noise > res.noise {
+		res.noise = rule.Noise
+	}
+
+	return true, nil
+}
+
+// evaluateSecurityCondition resolves and evaluates a security rule condition.
+// An empty condition always evaluates to true.
+func evaluateSecurityCondition(condition string, td rillv1.TemplateData) (bool, error) {
+	if condition == "" {
+		return true, nil
+	}
+
+	expr, err := rillv1.ResolveTemplate(condition, td)
+	if err != nil {
+		return false, err
+	}
+	return rillv1.EvaluateBoolExpression(expr)
+}
+
+// securityRuleSource returns where a rule applied to the given claims was declared.
+func securityRuleSource(claims *SecurityClaims, rule *runtimev1.SecurityRule) runtimev1.SecurityRuleSource {
+	for _, r := range claims.AdditionalRules {
+		if r == rule {
+			return runtimev1.SecurityRuleSource_SECURITY_RULE_SOURCE_CLAIMS
+		}
+	}
+	// The built-in rules all use allowAccessRule
+	if rule == allowAccessRule {
+		return runtimev1.SecurityRuleSource_SECURITY_RULE_SOURCE_BUILT_IN
+	}
+	return runtimev1.SecurityRuleSource_SECURITY_RULE_SOURCE_RESOURCE
 }
 
 // computeCacheKey computes a cache key for a resolved security policy.
@@ -515,3 +515,94 @@ func TestResolveMetricsView(t *testing.T) {
 		})
 	}
 }
+
+func TestExplainSecurity(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{{Name: "country"}, {Name: "domain"}},
+		Measures:   []*runtimev1.MetricsViewSpec_MeasureV2{{Name: "revenue"}, {Name: "cost"}},
+		SecurityRules: []*runtimev1.SecurityRule{
+			{Rule: &runtimev1.SecurityRule_Access{Access: &runtimev1.SecurityRuleAccess{Condition: "'{{.user.domain}}' = 'rilldata.com'", Allow: true}}},
+			{Rule: &runtimev1.SecurityRule_FieldAccess{FieldAccess: &runtimev1.SecurityRuleFieldAccess{Condition: "{{.user.admin}}", AllFields: true, Allow: true}}},
+			{Rule: &runtimev1.SecurityRule_FieldAccess{FieldAccess: &runtimev1.SecurityRuleFieldAccess{Fields: []string{"country", "revenue"}, Allow: true}}},
+			{Rule: &runtimev1.SecurityRule_RowFilter{RowFilter: &runtimev1.SecurityRuleRowFilter{Condition: "NOT {{.user.admin}}", Sql: "domain = '{{.user.domain}}'"}}},
+		},
+	}
+	r := &runtimev1.Resource{
+		Meta: &runtimev1.ResourceMeta{
+			Name:           &runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: "test"},
+			StateUpdatedOn: timestamppb.Now(),
+		},
+		Resource: &runtimev1.Resource_MetricsView{
+			MetricsView: &runtimev1.MetricsViewV2{
+				Spec:  mv,
+				State: &runtimev1.MetricsViewState{ValidSpec: mv},
+			},
+		},
+	}
+	p := newSecurityEngine(1, zap.NewNop())
+
+	t.Run("non_admin", func(t *testing.T) {
+		claims := &SecurityClaims{UserAttributes: map[string]any{"domain": "rilldata.com", "admin": false}}
+		exp, err := p.explainSecurity("test", map[string]string{}, claims, r)
+		require.NoError(t, err)
+
+		require.True(t, exp.Resolved.CanAccess())
+		require.Equal(t, 0, exp.AccessRule)
+		require.Equal(t, "domain = 'rilldata.com'", exp.Resolved.RowFilter())
+		require.Equal(t, map[string]int{"country": 2, "revenue": 2}, exp.FieldRules)
+		require.True(t, exp.Resolved.CanAccessField("country"))
+		require.False(t, exp.Resolved.CanAccessField("cost"))
+
+		require.Len(t, exp.Rules, 4)
+		require.Equal(t, []bool{true, false, true, true}, []bool{exp.Rules[0].ConditionMatched, exp.Rules[1].ConditionMatched, exp.Rules[2].ConditionMatched, exp.Rules[3].ConditionMatched})
+		for _, rule := range exp.Rules {
+			require.Equal(t, runtimev1.SecurityRuleSource_SECURITY_RULE_SOURCE_RESOURCE, rule.Source)
+			require.False(t, rule.Skipped)
+		}
+
+		// The explanation must match the regular resolution path
+		res, err := p.resolveSecurity("", "test", map[string]string{}, claims, r)
+		require.NoError(t, err)
+		require.Equal(t, res, exp.Resolved)
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		claims := &SecurityClaims{UserAttributes: map[string]any{"domain": "rilldata.com", "admin": true}}
+		exp, err := p.explainSecurity("test", map[string]string{}, claims, r)
+		require.NoError(t, err)
+
+		require.True(t, exp.Resolved.CanAccess())
+		require.Equal(t, "", exp.Resolved.RowFilter())
+		require.Equal(t, map[string]int{"country": 1, "domain": 1, "revenue": 1, "cost": 1}, exp.FieldRules)
+		require.False(t, exp.Rules[3].ConditionMatched)
+	})
+
+	t.Run("denied_by_claims", func(t *testing.T) {
+		claims := &SecurityClaims{
+			UserAttributes: map[string]any{"domain": "rilldata.com", "admin": false},
+			AdditionalRules: []*runtimev1.SecurityRule{
+				{Rule: &runtimev1.SecurityRule_Access{Access: &runtimev1.SecurityRuleAccess{Allow: false}}},
+			},
+		}
+		exp, err := p.explainSecurity("test", map[string]string{}, claims, r)
+		require.NoError(t, err)
+
+		require.False(t, exp.Resolved.CanAccess())
+		require.Equal(t, 0, exp.AccessRule)
+		require.Empty(t, exp.FieldRules)
+		require.Len(t, exp.Rules, 5)
+		require.Equal(t, runtimev1.SecurityRuleSource_SECURITY_RULE_SOURCE_CLAIMS, exp.Rules[0].Source)
+		for _, rule := range exp.Rules[1:] {
+			require.True(t, rule.Skipped)
+		}
+	})
+
+	t.Run("no_match", func(t *testing.T) {
+		claims := &SecurityClaims{UserAttributes: map[string]any{"domain": "example.com", "admin": false}}
+		exp, err := p.explainSecurity("test", map[string]string{}, claims, r)
+		require.NoError(t, err)
+
+		require.False(t, exp.Resolved.CanAccess())
+		require.Equal(t, -1, exp.AccessRule)
+	})
+}
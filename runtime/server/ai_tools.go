@@ -0,0 +1,322 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+)
+
+// aiToolMaxQueryLimit is the maximum number of rows returned to the AI from a metrics query.
+const aiToolMaxQueryLimit = 100
+
+// aiToolDefaultSearchLimit is the default number of values returned when searching dimension values.
+const aiToolDefaultSearchLimit = 10
+
+// aiTool is a tool that the AI agent can call.
+type aiTool struct {
+	spec *drivers.CompletionTool
+	// fn runs the tool with the input provided by the AI. The result is serialized to JSON and sent back to the AI.
+	fn func(ctx context.Context, instanceID string, input map[string]any, priority int) (any, error)
+}
+
+// aiTools returns the tools available to the AI agent.
+// All tools run with the security claims of the user in ctx.
+func (s *Server) aiTools() []*aiTool {
+	metricsViewProp := map[string]any{"type": "string", "description": "Name of the metrics view"}
+	return []*aiTool{
+		{
+			spec: &drivers.CompletionTool{
+				Name:        "list_metrics_views",
+				Description: "Lists the metrics views that can be queried.",
+				InputSchema: map[string]any{"type": "object", "properties": map[string]any{}},
+			},
+			fn: s.aiToolListMetricsViews,
+		},
+		{
+			spec: &drivers.CompletionTool{
+				Name:        "get_metrics_view",
+				Description: "Gets the time dimension, dimensions and measures of a metrics view.",
+				InputSchema: map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"metrics_view": metricsViewProp},
+					"required":   []any{"metrics_view"},
+				},
+			},
+			fn: s.aiToolGetMetricsView,
+		},
+		{
+			spec: &drivers.CompletionTool{
+				Name:        "get_time_range",
+				Description: "Gets the time range covered by the data in a metrics view. Use it to interpret relative time ranges like \"last week\".",
+				InputSchema: map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"metrics_view": metricsViewProp},
+					"required":   []any{"metrics_view"},
+				},
+			},
+			fn: s.aiToolGetTimeRange,
+		},
+		{
+			spec: &drivers.CompletionTool{
+				Name: "query_metrics_view",
+				Description: `Queries a metrics view. Returns at most 100 rows.
+Dimensions and measures are lists of objects like {"name": "<field>"}. To group by time, use {"name": "<time dimension>", "compute": {"time_floor": {"dimension": "<time dimension>", "grain": "day"}}}.
+Expressions for "where" (on dimensions) and "having" (on measures) have the format {"cond": {"op": "<operator>", "exprs": [{"name": "<field>"}, {"val": <value>}]}}. Operators are eq, neq, lt, lte, gt, gte, in, nin, ilike, nilike, and, or.
+To compare against a previous period, set "comparison_time_range" and add measures like {"name": "<measure>__delta_rel", "compute": {"comparison_ratio": {"measure": "<measure>"}}}.`,
+				InputSchema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"metrics_view":          metricsViewProp,
+						"dimensions":            map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+						"measures":              map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+						"where":                 map[string]any{"type": "object"},
+						"having":                map[string]any{"type": "object"},
+						"sort":                  map[string]any{"type": "array", "items": map[string]any{"type": "object", "properties": map[string]any{"name": map[string]any{"type": "string"}, "desc": map[string]any{"type": "boolean"}}}},
+						"time_range":            map[string]any{"type": "object", "properties": map[string]any{"start": map[string]any{"type": "string"}, "end": map[string]any{"type": "string"}}},
+						"comparison_time_range": map[string]any{"type": "object", "properties": map[string]any{"start": map[string]any{"type": "string"}, "end": map[string]any{"type": "string"}}},
+						"limit":                 map[string]any{"type": "integer"},
+					},
+					"required": []any{"metrics_view"},
+				},
+			},
+			fn: s.aiToolQueryMetricsView,
+		},
+		{
+			spec: &drivers.CompletionTool{
+				Name:        "search_dimension_values",
+				Description: "Searches the values of a dimension in a metrics view. Use it to find the exact values to filter by.",
+				InputSchema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"metrics_view": metricsViewProp,
+						"dimension":    map[string]any{"type": "string", "description": "Name of the dimension"},
+						"search":       map[string]any{"type": "string", "description": "Case-insensitive substring to search for"},
+						"limit":        map[string]any{"type": "integer"},
+					},
+					"required": []any{"metrics_view", "dimension"},
+				},
+			},
+			fn: s.aiToolSearchDimensionValues,
+		},
+	}
+}
+
+// aiToolListMetricsViews implements the "list_metrics_views" tool.
+func (s *Server) aiToolListMetricsViews(ctx context.Context, instanceID string, input map[string]any, priority int) (any, error) {
+	ctrl, err := s.runtime.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := ctrl.List(ctx, runtime.ResourceKindMetricsView, "", false)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Meta.Name.Name < rs[j].Meta.Name.Name })
+
+	res := []map[string]any{}
+	for _, r := range rs {
+		r, access, err := s.applySecurityPolicy(ctx, instanceID, r)
+		if err != nil {
+			return nil, err
+		}
+		if !access {
+			continue
+		}
+		mv := r.GetMetricsView().State.ValidSpec
+		if mv == nil {
+			continue
+		}
+
+		res = append(res, map[string]any{
+			"name":         r.Meta.Name.Name,
+			"display_name": mv.DisplayName,
+			"description":  mv.Description,
+		})
+	}
+
+	return res, nil
+}
+
+// aiToolGetMetricsView implements the "get_metrics_view" tool.
+func (s *Server) aiToolGetMetricsView(ctx context.Context, instanceID string, input map[string]any, priority int) (any, error) {
+	mv, err := s.aiToolMetricsView(ctx, instanceID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	dims := make([]map[string]any, 0, len(mv.Dimensions))
+	for _, d := range mv.Dimensions {
+		dims = append(dims, map[string]any{
+			"name":         d.Name,
+			"display_name": d.DisplayName,
+			"description":  d.Description,
+		})
+	}
+
+	measures := make([]map[string]any, 0, len(mv.Measures))
+	for _, m := range mv.Measures {
+		measures = append(measures, map[string]any{
+			"name":         m.Name,
+			"display_name": m.DisplayName,
+			"description":  m.Description,
+		})
+	}
+
+	return map[string]any{
+		"display_name":   mv.DisplayName,
+		"description":    mv.Description,
+		"time_dimension": mv.TimeDimension,
+		"dimensions":     dims,
+		"measures":       measures,
+	}, nil
+}
+
+// aiToolGetTimeRange implements the "get_time_range" tool.
+func (s *Server) aiToolGetTimeRange(ctx context.Context, instanceID string, input map[string]any, priority int) (any, error) {
+	mv, err := s.aiToolMetricsView(ctx, instanceID, input)
+	if err != nil {
+		return nil, err
+	}
+	if mv.TimeDimension == "" {
+		return nil, errors.New("the metrics view does not have a time dimension")
+	}
+
+	ts, err := queries.ResolveTimestampResult(ctx, s.runtime, instanceID, input["metrics_view"].(string), auth.GetClaims(ctx).SecurityClaims(), priority)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"min":       ts.Min.Format(time.RFC3339),
+		"max":       ts.Max.Format(time.RFC3339),
+		"watermark": ts.Watermark.Format(time.RFC3339),
+	}, nil
+}
+
+// aiToolQueryMetricsView implements the "query_metrics_view" tool.
+func (s *Server) aiToolQueryMetricsView(ctx context.Context, instanceID string, input map[string]any, priority int) (any, error) {
+	props := make(map[string]any, len(input))
+	for k, v := range input {
+		props[k] = v
+	}
+
+	// Cap the number of rows to keep the conversation small
+	limit, ok := props["limit"].(float64)
+	if !ok || limit <= 0 || limit > aiToolMaxQueryLimit {
+		props["limit"] = aiToolMaxQueryLimit
+	}
+
+	return s.aiToolResolveMetrics(ctx, instanceID, props, priority)
+}
+
+// aiToolSearchDimensionValues implements the "search_dimension_values" tool.
+func (s *Server) aiToolSearchDimensionValues(ctx context.Context, instanceID string, input map[string]any, priority int) (any, error) {
+	dim, _ := input["dimension"].(string)
+	if dim == "" {
+		return nil, errors.New(`missing "dimension"`)
+	}
+
+	limit := aiToolDefaultSearchLimit
+	if l, ok := input["limit"].(float64); ok && l > 0 && l <= aiToolMaxQueryLimit {
+		limit = int(l)
+	}
+
+	props := map[string]any{
+		"metrics_view": input["metrics_view"],
+		"dimensions":   []any{map[string]any{"name": dim}},
+		"sort":         []any{map[string]any{"name": dim}},
+		"limit":        limit,
+	}
+	if search, _ := input["search"].(string); search != "" {
+		props["where"] = map[string]any{
+			"cond": map[string]any{
+				"op":    "ilike",
+				"exprs": []any{map[string]any{"name": dim}, map[string]any{"val": "%" + search + "%"}},
+			},
+		}
+	}
+
+	rows, err := s.aiToolResolveMetrics(ctx, instanceID, props, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, row[dim])
+	}
+	return values, nil
+}
+
+// aiToolMetricsView returns the spec of the metrics view named in the tool input after applying the user's security policy.
+func (s *Server) aiToolMetricsView(ctx context.Context, instanceID string, input map[string]any) (*runtimev1.MetricsViewSpec, error) {
+	name, _ := input["metrics_view"].(string)
+	if name == "" {
+		return nil, errors.New(`missing "metrics_view"`)
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	r, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: name}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, fmt.Errorf("metrics view %q not found", name)
+		}
+		return nil, err
+	}
+	r, access, err := s.applySecurityPolicy(ctx, instanceID, r)
+	if err != nil {
+		return nil, err
+	}
+	if !access {
+		return nil, fmt.Errorf("metrics view %q not found", name)
+	}
+	mv := r.GetMetricsView().State.ValidSpec
+	if mv == nil {
+		return nil, fmt.Errorf("metrics view %q is invalid", name)
+	}
+
+	return mv, nil
+}
+
+// aiToolResolveMetrics runs a query using the "metrics" resolver and returns the rows as JSON-compatible maps.
+func (s *Server) aiToolResolveMetrics(ctx context.Context, instanceID string, props map[string]any, priority int) ([]map[string]any, error) {
+	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           "metrics",
+		ResolverProperties: props,
+		Args:               map[string]any{"priority": priority},
+		Claims:             auth.GetClaims(ctx).SecurityClaims(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	data, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]any
+	err = json.Unmarshal(data, &rows)
+	if err != nil {
+		return nil, err
+	}
+	if rows == nil {
+		rows = []map[string]any{}
+	}
+	return rows, nil
+}
@@ -0,0 +1,309 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// askMetricsViewMaxAttempts is the number of times to ask the AI for a query before giving up.
+// If the AI generates an invalid query, the validation error is sent back to it for correction.
+const askMetricsViewMaxAttempts = 2
+
+// askMetricsViewDefaultLimit is the row limit applied to generated queries that don't specify a limit.
+const askMetricsViewDefaultLimit = 100
+
+// AskMetricsView implements runtimev1.RuntimeServiceServer
+func (s *Server) AskMetricsView(ctx context.Context, req *runtimev1.AskMetricsViewRequest) (*runtimev1.AskMetricsViewResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metrics_view", req.MetricsView),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	// Find the metrics view and remove the fields that the user doesn't have access to
+	ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	r, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: req.MetricsView}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "metrics view %q not found", req.MetricsView)
+		}
+		return nil, err
+	}
+	r, access, err := s.applySecurityPolicy(ctx, req.InstanceId, r)
+	if err != nil {
+		return nil, err
+	}
+	if !access {
+		return nil, ErrForbidden
+	}
+	mv := r.GetMetricsView().State.ValidSpec
+	if mv == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "metrics view %q is invalid", req.MetricsView)
+	}
+
+	// Create an executor for validating the generated queries
+	claims := auth.GetClaims(ctx).SecurityClaims()
+	security, err := s.runtime.ResolveSecurity(req.InstanceId, claims, r)
+	if err != nil {
+		return nil, err
+	}
+	e, err := metricsview.NewExecutor(ctx, s.runtime, req.InstanceId, mv, r.GetMetricsView().State.Streaming, security, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+	defer e.Close()
+
+	// Ground the AI in the time range of the data
+	var ts metricsview.TimestampsResult
+	if mv.TimeDimension != "" {
+		ts, err = queries.ResolveTimestampResult(ctx, s.runtime, req.InstanceId, req.MetricsView, claims, int(req.Priority))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Ask the AI to translate the question to a query
+	start := time.Now()
+	props, explanation, err := s.askMetricsViewWithAI(ctx, req.InstanceId, req.MetricsView, req.Question, req.TimeZone, mv, ts, e)
+	s.activity.Record(ctx, activity.EventTypeLog, "ai_asked_metrics_view",
+		attribute.Int64("elapsed_ms", time.Since(start).Milliseconds()),
+		attribute.Bool("succeeded", err == nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the query with the user's security claims
+	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         req.InstanceId,
+		Resolver:           "metrics",
+		ResolverProperties: props,
+		Args:               map[string]any{"priority": req.Priority},
+		Claims:             claims,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer res.Close()
+
+	var data []*structpb.Struct
+	for {
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		rowStruct, err := pbutil.ToStruct(row, res.Schema())
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, rowStruct)
+	}
+
+	queryPB, err := structpb.NewStruct(props)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.AskMetricsViewResponse{
+		Query:       queryPB,
+		Explanation: explanation,
+		Schema:      res.Schema(),
+		Data:        data,
+	}, nil
+}
+
+// askMetricsViewWithAI uses AI to translate a question to a query against a metrics view.
+// It returns the query in the format of the "metrics" resolver's properties along with an explanation of the query.
+func (s *Server) askMetricsViewWithAI(ctx context.Context, instanceID, metricsView, question, timeZone string, mv *runtimev1.MetricsViewSpec, ts metricsview.TimestampsResult, e *metricsview.Executor) (map[string]any, string, error) {
+	// Build messages
+	msgs := []*drivers.CompletionMessage{
+		{Role: "system", Data: askMetricsViewSystemPrompt()},
+		{Role: "user", Data: askMetricsViewUserPrompt(question, mv, ts)},
+	}
+
+	// Connect to the AI service configured for the instance
+	ai, release, err := s.runtime.AI(ctx, instanceID)
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+
+	// Apply timeout
+	ctx, cancel := context.WithTimeout(ctx, aiGenerateTimeout)
+	defer cancel()
+
+	for attempt := 1; ; attempt++ {
+		res, err := ai.Complete(ctx, msgs, nil)
+		if err != nil {
+			return nil, "", err
+		}
+
+		props, explanation, err := parseAskMetricsViewResponse(res.Data, metricsView, timeZone, e)
+		if err == nil {
+			return props, explanation, nil
+		}
+		if attempt >= askMetricsViewMaxAttempts {
+			return nil, "", status.Errorf(codes.FailedPrecondition, "the AI did not generate a valid query: %s", err.Error())
+		}
+
+		// Ask the AI to correct the query
+		msgs = append(msgs,
+			res,
+			&drivers.CompletionMessage{Role: "user", Data: fmt.Sprintf("The query is invalid: %s\nPlease respond with a corrected query in the same format.", err.Error())},
+		)
+	}
+}
+
+// parseAskMetricsViewResponse parses and validates the AI's response to a question about a metrics view.
+func parseAskMetricsViewResponse(data, metricsView, timeZone string, e *metricsview.Executor) (map[string]any, string, error) {
+	// The AI may produce Markdown output. Remove the code tags around the JSON.
+	data = strings.TrimSpace(data)
+	data = strings.TrimPrefix(data, "```json")
+	data = strings.TrimPrefix(data, "```")
+	data = strings.TrimSuffix(data, "```")
+
+	var res struct {
+		Query       map[string]any `json:"query"`
+		Explanation string         `json:"explanation"`
+	}
+	if err := json.Unmarshal([]byte(data), &res); err != nil {
+		return nil, "", fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if res.Query == nil {
+		return nil, "", errors.New(`missing "query" field`)
+	}
+
+	props := res.Query
+	props["metrics_view"] = metricsView
+	if timeZone != "" {
+		props["time_zone"] = timeZone
+	}
+	if props["limit"] == nil {
+		props["limit"] = askMetricsViewDefaultLimit
+	}
+
+	qry := &metricsview.Query{}
+	if err := mapstructureutil.WeakDecode(props, qry); err != nil {
+		return nil, "", err
+	}
+	if err := e.ValidateQuery(qry); err != nil {
+		return nil, "", err
+	}
+
+	return props, res.Explanation, nil
+}
+
+// askMetricsViewSystemPrompt returns the static system prompt for answering questions about a metrics view.
+func askMetricsViewSystemPrompt() string {
+	return `
+You are an agent whose only task is to answer questions about a dataset by writing a query against a metrics view.
+A metrics view has dimensions that results can be grouped and filtered by, measures that are aggregated for each group, and optionally a time dimension.
+
+Your output should only consist of valid JSON in the format below:
+{
+  "query": {
+    "dimensions": [{"name": "<dimension>"}],
+    "measures": [{"name": "<measure>"}],
+    "where": <expression>,
+    "having": <expression>,
+    "sort": [{"name": "<dimension or measure in the query>", "desc": true}],
+    "time_range": {"start": "<RFC 3339 timestamp>", "end": "<RFC 3339 timestamp>"},
+    "comparison_time_range": {"start": "<RFC 3339 timestamp>", "end": "<RFC 3339 timestamp>"},
+    "limit": 10
+  },
+  "explanation": "<one or two sentences explaining how the query answers the question>"
+}
+
+All query fields except "dimensions" or "measures" are optional. Only use dimension and measure names listed by the user.
+
+To group by time, add a dimension for the time dimension with a grain (one of millisecond, second, minute, hour, day, week, month, quarter or year):
+{"name": "<time dimension>", "compute": {"time_floor": {"dimension": "<time dimension>", "grain": "day"}}}
+
+Expressions for "where" (on dimensions) and "having" (on measures in the query) have the format:
+{"cond": {"op": "<operator>", "exprs": [{"name": "<field>"}, {"val": <value>}]}}
+Operators are eq, neq, lt, lte, gt, gte, in, nin, ilike and nilike. For in and nin, "val" is a list. Conditions can be combined with the "and" and "or" operators, which take a list of expressions.
+
+To compare against a previous period, set "comparison_time_range" and add measures like:
+{"name": "<measure>__prev", "compute": {"comparison_value": {"measure": "<measure>"}}}
+{"name": "<measure>__delta_abs", "compute": {"comparison_delta": {"measure": "<measure>"}}}
+{"name": "<measure>__delta_rel", "compute": {"comparison_ratio": {"measure": "<measure>"}}}
+`
+}
+
+// askMetricsViewUserPrompt returns the prompt for a question about a metrics view.
+func askMetricsViewUserPrompt(question string, mv *runtimev1.MetricsViewSpec, ts metricsview.TimestampsResult) string {
+	var sb strings.Builder
+	sb.WriteString("The metrics view has the following fields.\n")
+	if mv.Description != "" {
+		fmt.Fprintf(&sb, "Description: %s\n", mv.Description)
+	}
+
+	if mv.TimeDimension != "" {
+		fmt.Fprintf(&sb, "\nTime dimension: %s\n", mv.TimeDimension)
+		if !ts.Min.IsZero() {
+			fmt.Fprintf(&sb, "The data covers the time range from %s to %s.\n", ts.Min.Format(time.RFC3339), ts.Max.Format(time.RFC3339))
+		}
+		if !ts.Watermark.IsZero() {
+			fmt.Fprintf(&sb, "The data is complete until %s. Interpret relative time ranges like \"last week\" relative to this time.\n", ts.Watermark.Format(time.RFC3339))
+		}
+	}
+
+	sb.WriteString("\nDimensions:\n")
+	for _, d := range mv.Dimensions {
+		fmt.Fprintf(&sb, "- name=%s", d.Name)
+		if d.DisplayName != "" {
+			fmt.Fprintf(&sb, ", display_name=%q", d.DisplayName)
+		}
+		if d.Description != "" {
+			fmt.Fprintf(&sb, ", description=%q", d.Description)
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\nMeasures:\n")
+	for _, m := range mv.Measures {
+		fmt.Fprintf(&sb, "- name=%s", m.Name)
+		if m.DisplayName != "" {
+			fmt.Fprintf(&sb, ", display_name=%q", m.DisplayName)
+		}
+		if m.Description != "" {
+			fmt.Fprintf(&sb, ", description=%q", m.Description)
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "\nQuestion: %s\n", question)
+	return sb.String()
+}
@@ -0,0 +1,84 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	_ "github.com/rilldata/rill/runtime/drivers/mock"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAskMetricsView(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 1 AS val
+UNION ALL
+SELECT 'DK' AS country, 2 AS val
+UNION ALL
+SELECT 'SE' AS country, 3 AS val
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(val)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	// Configure a mock AI service that first returns an invalid query, and then a corrected query
+	responses, err := json.Marshal([]string{
+		`{"query": {"dimensions": [{"name": "country"}], "measures": [{"name": "revenue"}]}, "explanation": "Revenue by country."}`,
+		"```json\n" + `{"query": {"dimensions": [{"name": "country"}], "measures": [{"name": "total"}], "sort": [{"name": "total", "desc": true}], "limit": 2}, "explanation": "The countries with the highest total."}` + "\n```",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	inst, err := rt.Instance(ctx, instanceID)
+	require.NoError(t, err)
+	inst.Connectors = append(inst.Connectors, &runtimev1.Connector{
+		Type:   "mock_ai",
+		Name:   "ai",
+		Config: map[string]string{"responses": string(responses)},
+	})
+	inst.AIConnector = "ai"
+	require.NoError(t, rt.EditInstance(ctx, inst, false))
+
+	srv, err := server.NewServer(ctx, &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	res, err := srv.AskMetricsView(testCtx(), &runtimev1.AskMetricsViewRequest{
+		InstanceId:  instanceID,
+		MetricsView: "mv1",
+		Question:    "Which two countries have the highest total?",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "The countries with the highest total.", res.Explanation)
+	require.Equal(t, "mv1", res.Query.AsMap()["metrics_view"])
+	require.Len(t, res.Data, 2)
+	require.Equal(t, "SE", res.Data[0].Fields["country"].GetStringValue())
+	require.Equal(t, 3.0, res.Data[0].Fields["total"].GetNumberValue())
+	require.Equal(t, "DK", res.Data[1].Fields["country"].GetStringValue())
+
+	// The scripted responses are exhausted
+	_, err = srv.AskMetricsView(testCtx(), &runtimev1.AskMetricsViewRequest{
+		InstanceId:  instanceID,
+		MetricsView: "mv1",
+		Question:    "Which country has the lowest total?",
+	})
+	require.ErrorContains(t, err, "no more scripted responses")
+}
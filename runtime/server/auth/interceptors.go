@@ -95,6 +95,16 @@ func HTTPMiddleware(aud *Audience, next http.Handler) http.Handler {
 	})
 }
 
+// WithToken is a variant of UnaryServerInterceptor for protocols that don't use an authorization header.
+// It sets claims on the ctx based on a raw bearer token (such as a password sent over the PostgreSQL wire protocol).
+// An empty token is treated like a request without an authorization header.
+func WithToken(ctx context.Context, aud *Audience, token string) (context.Context, error) {
+	if token == "" {
+		return parseClaims(ctx, aud, "")
+	}
+	return parseClaims(ctx, aud, "Bearer "+token)
+}
+
 func parseClaims(ctx context.Context, aud *Audience, authorizationHeader string) (context.Context, error) {
 	// When aud == nil, it means auth is disabled. Additionally, if auth header is not set then we set openClaims.
 	// If auth header is set then that means its running locally with some user context, so we set devJWTClaims.
@@ -55,6 +55,14 @@ func (s *Server) forwardQuery(ctx context.Context, instID string, idx int, qry *
 			res.Result = &runtimev1.QueryResult_MetricsViewComparisonResponse{MetricsViewComparisonResponse: r}
 		}
 
+	case *runtimev1.Query_MetricsViewContributionRequest:
+		var r *runtimev1.MetricsViewContributionResponse
+		q.MetricsViewContributionRequest.InstanceId = instID
+		r, err = s.MetricsViewContribution(ctx, q.MetricsViewContributionRequest)
+		if err == nil {
+			res.Result = &runtimev1.QueryResult_MetricsViewContributionResponse{MetricsViewContributionResponse: r}
+		}
+
 	case *runtimev1.Query_MetricsViewTimeSeriesRequest:
 		var r *runtimev1.MetricsViewTimeSeriesResponse
 		q.MetricsViewTimeSeriesRequest.InstanceId = instID
@@ -3,12 +3,21 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"slices"
 
+	"github.com/mitchellh/mapstructure"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	metricssqlparser "github.com/rilldata/rill/runtime/pkg/metricssql"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/rilldata/rill/runtime/pkg/rilltime"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
@@ -48,6 +57,12 @@ func (s *Server) ResolveCanvas(ctx context.Context, req *runtimev1.ResolveCanvas
 		}, nil
 	}
 
+	// Resolve the values of the canvas filters
+	filterValues, err := resolveCanvasFilterValues(spec, req.Filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Setup templating data
 	inst, err := s.runtime.Instance(ctx, req.InstanceId)
 	if err != nil {
@@ -58,7 +73,7 @@ func (s *Server) ResolveCanvas(ctx context.Context, req *runtimev1.ResolveCanvas
 		User:        auth.GetClaims(ctx).SecurityClaims().UserAttributes,
 		Variables:   inst.ResolveVariables(false),
 		ExtraProps: map[string]any{
-			"args": req.Args.AsMap(),
+			"args": canvasTemplateArgs(req.Args, filterValues),
 		},
 	}
 
@@ -111,13 +126,8 @@ func (s *Server) ResolveCanvas(ctx context.Context, req *runtimev1.ResolveCanvas
 		// Look for a metrics view reference in the renderer properties.
 		var mvName string
 		validSpec := cmp.GetComponent().State.ValidSpec
-		if validSpec != nil && validSpec.RendererProperties != nil {
-			for k, v := range validSpec.RendererProperties.Fields {
-				if k == "metrics_view" {
-					mvName = v.GetStringValue()
-					break
-				}
-			}
+		if validSpec != nil {
+			mvName = componentMetricsView(validSpec)
 		}
 		if mvName == "" {
 			continue
@@ -141,11 +151,26 @@ func (s *Server) ResolveCanvas(ctx context.Context, req *runtimev1.ResolveCanvas
 		metricsViews[mvName] = mv
 	}
 
+	// Build the filters that apply to each component.
+	componentFilters := make(map[string]*runtimev1.ComponentFilters, len(components))
+	for cmpName, cmp := range components {
+		validSpec := cmp.GetComponent().State.ValidSpec
+		if validSpec == nil {
+			continue
+		}
+		var mvSpec *runtimev1.MetricsViewSpec
+		if mv, ok := metricsViews[componentMetricsView(validSpec)]; ok {
+			mvSpec = mv.GetMetricsView().State.ValidSpec
+		}
+		componentFilters[cmpName] = buildComponentFilters(spec, filterValues, validSpec.Output, componentMetricsView(validSpec), mvSpec)
+	}
+
 	// Return the response
 	return &runtimev1.ResolveCanvasResponse{
 		Canvas:                 res,
 		ResolvedComponents:     components,
 		ReferencedMetricsViews: metricsViews,
+		ComponentFilters:       componentFilters,
 	}, nil
 }
 
@@ -155,6 +180,7 @@ func (s *Server) ResolveComponent(ctx context.Context, req *runtimev1.ResolveCom
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.instance_id", req.InstanceId),
 		attribute.String("args.component", req.Component),
+		attribute.String("args.canvas", req.Canvas),
 	)
 
 	// Check if user has access to query for component data (we use the ReadAPI permission for this for now)
@@ -185,8 +211,22 @@ func (s *Server) ResolveComponent(ctx context.Context, req *runtimev1.ResolveCom
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// Resolve the values of the canvas filters if the component is resolved in the context of a canvas
+	var canvasSpec *runtimev1.CanvasSpec
+	var filterValues map[string]*structpb.Value
+	if req.Canvas != "" {
+		canvasSpec, err = s.canvasSpecForComponent(ctx, ctrl, req.Canvas, req.Component)
+		if err != nil {
+			return nil, err
+		}
+		filterValues, err = resolveCanvasFilterValues(canvasSpec, req.Filters)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// Parse args
-	args := req.Args.AsMap()
+	args := canvasTemplateArgs(req.Args, filterValues)
 
 	// Setup templating data
 	td := rillv1.TemplateData{
@@ -217,8 +257,368 @@ func (s *Server) ResolveComponent(ctx context.Context, req *runtimev1.ResolveCom
 		}
 	}
 
+	// Build the canvas filters that apply to the component
+	var filters *runtimev1.ComponentFilters
+	if canvasSpec != nil {
+		mvName := componentMetricsView(&runtimev1.ComponentSpec{RendererProperties: rendererProps})
+		mvSpec, err := metricsViewSpecForComponent(ctx, ctrl, mvName)
+		if err != nil {
+			return nil, err
+		}
+		filters = buildComponentFilters(canvasSpec, filterValues, spec.Output, mvName, mvSpec)
+	}
+
+	// Resolve the component's data if it has a data resolver.
+	// The canvas filters are applied to each metrics view that the resolver queries.
+	var schema *runtimev1.StructType
+	var data []*structpb.Struct
+	if spec.Resolver != "" {
+		schema, data, err = s.resolveComponentData(ctx, req.InstanceId, ctrl, spec, td, canvasSpec, filterValues)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Return the response
 	return &runtimev1.ResolveComponentResponse{
 		RendererProperties: rendererProps,
+		Filters:            filters,
+		Schema:             schema,
+		Data:               data,
 	}, nil
 }
+
+// resolveComponentData resolves the data of a component with a data resolver.
+// The resolver applies the security policies of the metrics views it queries for the current user.
+// If canvas is not nil, the canvas filters are added to the resolver properties.
+func (s *Server) resolveComponentData(ctx context.Context, instanceID string, ctrl *runtime.Controller, spec *runtimev1.ComponentSpec, td rillv1.TemplateData, canvas *runtimev1.CanvasSpec, filterValues map[string]*structpb.Value) (*runtimev1.StructType, []*structpb.Struct, error) {
+	// The parser only allows metrics-backed resolvers, but we check again since the spec may have been created by an older version.
+	if !rillv1.IsComponentDataResolver(spec.Resolver) {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "resolver %q is not allowed for component data", spec.Resolver)
+	}
+
+	var props map[string]any
+	if spec.ResolverProperties != nil {
+		v, err := rillv1.ResolveTemplateRecursively(spec.ResolverProperties.AsMap(), td)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		var ok bool
+		props, ok = v.(map[string]any)
+		if !ok {
+			return nil, nil, status.Errorf(codes.Internal, "failed to convert resolved resolver properties to map: %v", v)
+		}
+	}
+	if props == nil {
+		props = make(map[string]any)
+	}
+
+	if canvas != nil {
+		err := applyCanvasFilters(ctx, ctrl, instanceID, spec, props, canvas, filterValues)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           spec.Resolver,
+		ResolverProperties: props,
+		Claims:             auth.GetClaims(ctx).SecurityClaims(),
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer res.Close()
+
+	var data []*structpb.Struct
+	for {
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		pb, err := pbutil.ToStruct(row, res.Schema())
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		data = append(data, pb)
+	}
+
+	return res.Schema(), data, nil
+}
+
+// canvasSpecForComponent returns the valid spec of a canvas and checks that it contains the component.
+func (s *Server) canvasSpecForComponent(ctx context.Context, ctrl *runtime.Controller, canvas, component string) (*runtimev1.CanvasSpec, error) {
+	res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindCanvas, Name: canvas}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "canvas with name %q not found", canvas)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	spec := res.GetCanvas().State.ValidSpec
+	if spec == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "canvas %q is invalid", canvas)
+	}
+	for _, item := range spec.Items {
+		if item.Component == component {
+			return spec, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "component %q is not in canvas %q", component, canvas)
+}
+
+// applyCanvasFilters adds the canvas filters that apply to each metrics view queried by a component's data resolver to the resolver properties.
+// For "metrics_sql", they are passed as additional filters for the query's metrics view.
+// For "metrics_join", they are combined with each query's filters, and the time range is only applied if all the metrics views have a time dimension.
+func applyCanvasFilters(ctx context.Context, ctrl *runtime.Controller, instanceID string, spec *runtimev1.ComponentSpec, props map[string]any, canvas *runtimev1.CanvasSpec, filterValues map[string]*structpb.Value) error {
+	switch spec.Resolver {
+	case "metrics_sql":
+		sql, _ := props["sql"].(string)
+		compiler := metricssqlparser.New(ctrl, instanceID, auth.GetClaims(ctx).SecurityClaims(), 0)
+		query, err := compiler.Rewrite(ctx, sql)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		mv, err := metricsViewSpecForComponent(ctx, ctrl, query.MetricsView)
+		if err != nil {
+			return err
+		}
+		if mv == nil {
+			return nil
+		}
+
+		filters := buildComponentFilters(canvas, filterValues, spec.Output, query.MetricsView, mv)
+		if filters.Where != nil {
+			props["additional_where"] = metricsview.NewExpressionFromProto(filters.Where)
+		}
+		if filters.Having != nil {
+			props["additional_having"] = metricsview.NewExpressionFromProto(filters.Having)
+		}
+		if filters.TimeRange != "" && mv.TimeDimension != "" {
+			props["additional_time_range"] = &metricsview.TimeRange{Expression: filters.TimeRange}
+		}
+	case "metrics_join":
+		queries, _ := props["queries"].([]any)
+		var timeRange string
+		allHaveTime := true
+		for _, q := range queries {
+			query, ok := q.(map[string]any)
+			if !ok {
+				continue
+			}
+			mvName, _ := query["metrics_view"].(string)
+			mv, err := metricsViewSpecForComponent(ctx, ctrl, mvName)
+			if err != nil {
+				return err
+			}
+			if mv == nil {
+				allHaveTime = false
+				continue
+			}
+			if mv.TimeDimension == "" {
+				allHaveTime = false
+			}
+
+			filters := buildComponentFilters(canvas, filterValues, spec.Output, mvName, mv)
+			timeRange = filters.TimeRange
+			for key, expr := range map[string]*runtimev1.Expression{"where": filters.Where, "having": filters.Having} {
+				if expr == nil {
+					continue
+				}
+				var existing *metricsview.Expression
+				if query[key] != nil {
+					err := mapstructure.WeakDecode(query[key], &existing)
+					if err != nil {
+						return status.Errorf(codes.InvalidArgument, "invalid %q for metrics view %q: %s", key, mvName, err.Error())
+					}
+				}
+				query[key] = andMetricsExpressions(existing, metricsview.NewExpressionFromProto(expr))
+			}
+		}
+		if timeRange != "" && allHaveTime && props["time_range"] == nil {
+			props["time_range"] = &metricsview.TimeRange{Expression: timeRange}
+		}
+	}
+	return nil
+}
+
+// andMetricsExpressions combines two optional metrics expressions with AND.
+func andMetricsExpressions(a, b *metricsview.Expression) *metricsview.Expression {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return &metricsview.Expression{
+		Condition: &metricsview.Condition{
+			Operator:    metricsview.OperatorAnd,
+			Expressions: []*metricsview.Expression{a, b},
+		},
+	}
+}
+
+// metricsViewSpecForComponent returns the valid spec of a metrics view referenced by a component.
+// It returns nil if the name is empty or the metrics view doesn't exist or is invalid, in which case no filters apply to it.
+func metricsViewSpecForComponent(ctx context.Context, ctrl *runtime.Controller, name string) (*runtimev1.MetricsViewSpec, error) {
+	if name == "" {
+		return nil, nil
+	}
+	mv, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: name}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return mv.GetMetricsView().State.ValidSpec, nil
+}
+
+// componentMetricsView returns the metrics view referenced in a component's renderer properties, if any.
+func componentMetricsView(spec *runtimev1.ComponentSpec) string {
+	if spec.RendererProperties == nil {
+		return ""
+	}
+	return spec.RendererProperties.Fields["metrics_view"].GetStringValue()
+}
+
+// resolveCanvasFilterValues returns the values of a canvas's filters keyed by filter name.
+// The provided values take precedence over the filters' default values. A null value clears a filter.
+func resolveCanvasFilterValues(spec *runtimev1.CanvasSpec, values *structpb.Struct) (map[string]*structpb.Value, error) {
+	res := make(map[string]*structpb.Value)
+	if spec.DefaultPreset != nil && spec.DefaultPreset.TimeRange != nil {
+		res[rillv1.CanvasTimeRangeFilterName] = structpb.NewStringValue(*spec.DefaultPreset.TimeRange)
+	}
+	for _, f := range spec.Filters {
+		if f.DefaultValue != nil {
+			res[f.Name] = f.DefaultValue
+		}
+	}
+
+	for name, v := range values.GetFields() {
+		if _, ok := v.Kind.(*structpb.Value_NullValue); ok {
+			delete(res, name)
+			continue
+		}
+
+		if name == rillv1.CanvasTimeRangeFilterName {
+			tr, ok := v.Kind.(*structpb.Value_StringValue)
+			if !ok {
+				return nil, fmt.Errorf("value for filter %q must be a string", name)
+			}
+			if _, err := rilltime.Parse(tr.StringValue, rilltime.ParseOptions{}); err != nil {
+				return nil, fmt.Errorf("invalid time range %q: %w", tr.StringValue, err)
+			}
+			res[name] = v
+			continue
+		}
+
+		idx := slices.IndexFunc(spec.Filters, func(f *runtimev1.CanvasFilter) bool { return f.Name == name })
+		if idx < 0 {
+			return nil, fmt.Errorf("filter %q not found in canvas", name)
+		}
+		f := spec.Filters[idx]
+
+		if f.Measure != "" {
+			if _, ok := v.Kind.(*structpb.Value_NumberValue); !ok {
+				return nil, fmt.Errorf("value for filter %q must be a number", name)
+			}
+			res[name] = v
+			continue
+		}
+
+		// Dimension filters take a list of values, but also accept a single value (such as a value clicked in a component).
+		switch v.Kind.(type) {
+		case *structpb.Value_ListValue:
+			res[name] = v
+		case *structpb.Value_StructValue:
+			return nil, fmt.Errorf("value for filter %q must be a list of values", name)
+		default:
+			res[name] = structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{v}})
+		}
+	}
+
+	return res, nil
+}
+
+// canvasTemplateArgs returns the args for resolving templating in a component.
+// The values of the canvas filters are available as args, but explicitly provided args take precedence.
+func canvasTemplateArgs(args *structpb.Struct, filterValues map[string]*structpb.Value) map[string]any {
+	res := make(map[string]any, len(filterValues))
+	for name, v := range filterValues {
+		res[name] = v.AsInterface()
+	}
+	for k, v := range args.AsMap() {
+		res[k] = v
+	}
+	return res
+}
+
+// buildComponentFilters builds the canvas filters that apply to a component.
+// Dimension and measure filters only apply if the metrics view has the dimension or measure (and matches the filter's metrics view, if set).
+// A filter bound to the component's own output variable is not applied to it, so that a chart used for cross-filtering is not filtered by its own selection.
+func buildComponentFilters(canvas *runtimev1.CanvasSpec, filterValues map[string]*structpb.Value, output *runtimev1.ComponentVariable, mvName string, mv *runtimev1.MetricsViewSpec) *runtimev1.ComponentFilters {
+	res := &runtimev1.ComponentFilters{}
+	isOwnOutput := func(name string) bool {
+		return output != nil && output.Name == name
+	}
+
+	if v, ok := filterValues[rillv1.CanvasTimeRangeFilterName]; ok && !isOwnOutput(rillv1.CanvasTimeRangeFilterName) {
+		res.TimeRange = v.GetStringValue()
+	}
+
+	if mv == nil {
+		return res
+	}
+
+	var where, having []*runtimev1.Expression
+	for _, f := range canvas.Filters {
+		v, ok := filterValues[f.Name]
+		if !ok || isOwnOutput(f.Name) {
+			continue
+		}
+		if f.MetricsView != "" && f.MetricsView != mvName {
+			continue
+		}
+
+		if f.Dimension != "" {
+			if !slices.ContainsFunc(mv.Dimensions, func(d *runtimev1.MetricsViewSpec_DimensionV2) bool { return d.Name == f.Dimension }) {
+				continue
+			}
+			vals := v.GetListValue().GetValues()
+			if len(vals) == 0 {
+				continue
+			}
+			exprs := make([]*runtimev1.Expression, len(vals))
+			for i, val := range vals {
+				exprs[i] = expressionpb.Value(val)
+			}
+			where = append(where, expressionpb.IdentIn(f.Dimension, exprs...))
+			continue
+		}
+
+		if !slices.ContainsFunc(mv.Measures, func(m *runtimev1.MetricsViewSpec_MeasureV2) bool { return m.Name == f.Measure }) {
+			continue
+		}
+		having = append(having, &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op:    f.Operator,
+					Exprs: []*runtimev1.Expression{expressionpb.Identifier(f.Measure), expressionpb.Value(v)},
+				},
+			},
+		})
+	}
+
+	if len(where) > 0 {
+		res.Where = expressionpb.AndAll(where...)
+	}
+	if len(having) > 0 {
+		res.Having = expressionpb.AndAll(having...)
+	}
+	return res
+}
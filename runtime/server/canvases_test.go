@@ -84,6 +84,187 @@ items:
 	require.Equal(t, "m1", res.ReferencedMetricsViews["mv1"].GetMetricsView().State.ValidSpec.Model)
 }
 
+func TestResolveCanvasFilters(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 'mobile' AS device, 10 AS revenue
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+- column: device
+measures:
+- name: revenue
+  expression: SUM(revenue)
+`,
+			"c1.yaml": `
+type: canvas
+defaults:
+  time_range: P7D
+filters:
+  dimensions:
+  - dimension: country
+    default: [US]
+  - dimension: device
+  measures:
+  - measure: revenue
+    operator: gte
+items:
+- component:
+    output:
+      name: country
+    bar_chart:
+      metrics_view: mv1
+- component:
+    kpi:
+      metrics_view: mv1
+      title: "{{ .args.device }}"
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 5, 0, 0)
+
+	server, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	filters := must(structpb.NewStruct(map[string]any{
+		"device":  "mobile",
+		"revenue": 5,
+	}))
+
+	res, err := server.ResolveCanvas(testCtx(), &runtimev1.ResolveCanvasRequest{
+		InstanceId: instanceID,
+		Canvas:     "c1",
+		Filters:    filters,
+	})
+	require.NoError(t, err)
+
+	// The chart that sets the country filter is not filtered by it
+	f0 := res.ComponentFilters["c1--component-0"]
+	require.Equal(t, "P7D", f0.TimeRange)
+	require.Len(t, f0.Where.GetCond().Exprs, 1)
+	require.Equal(t, "device", f0.Where.GetCond().Exprs[0].GetCond().Exprs[0].GetIdent())
+	require.Equal(t, "revenue", f0.Having.GetCond().Exprs[0].GetCond().Exprs[0].GetIdent())
+
+	// The other component is filtered by all filters, and can use the filter values in templating
+	f1 := res.ComponentFilters["c1--component-1"]
+	require.Len(t, f1.Where.GetCond().Exprs, 2)
+	props := res.ResolvedComponents["c1--component-1"].GetComponent().State.ValidSpec.RendererProperties.AsMap()
+	require.Equal(t, "[mobile]", props["title"])
+
+	// Resolving a single component in the canvas gives the same filters
+	cmp, err := server.ResolveComponent(testCtx(), &runtimev1.ResolveComponentRequest{
+		InstanceId: instanceID,
+		Component:  "c1--component-1",
+		Canvas:     "c1",
+		Filters:    filters,
+	})
+	require.NoError(t, err)
+	require.Len(t, cmp.Filters.Where.GetCond().Exprs, 2)
+
+	// Unknown filters are rejected
+	_, err = server.ResolveCanvas(testCtx(), &runtimev1.ResolveCanvasRequest{
+		InstanceId: instanceID,
+		Canvas:     "c1",
+		Filters:    must(structpb.NewStruct(map[string]any{"missing": "x"})),
+	})
+	require.ErrorContains(t, err, `filter "missing" not found`)
+}
+
+func TestResolveComponentDataFilters(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 10 AS revenue
+UNION ALL
+SELECT 'DK' AS country, 20 AS revenue
+`,
+			"m2.sql": `
+SELECT 'US' AS country, 1 AS orders
+UNION ALL
+SELECT 'DK' AS country, 2 AS orders
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: revenue
+  expression: SUM(revenue)
+`,
+			"mv2.yaml": `
+type: metrics_view
+version: 1
+model: m2
+dimensions:
+- column: country
+measures:
+- name: orders
+  expression: SUM(orders)
+`,
+			"c1.yaml": `
+type: canvas
+filters:
+  dimensions:
+  - dimension: country
+items:
+- component:
+    data:
+      metrics_sql: SELECT country, revenue FROM mv1
+    kpi:
+      measure: revenue
+- component:
+    data:
+      metrics_join:
+        queries:
+        - metrics_view: mv1
+          measures: [revenue]
+        - metrics_view: mv2
+          measures: [orders]
+        dimensions: [country]
+    kpi:
+      measure: revenue
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 7, 0, 0)
+
+	server, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	filters := must(structpb.NewStruct(map[string]any{"country": "DK"}))
+
+	// The filters are applied to the metrics view queried with metrics SQL
+	res, err := server.ResolveComponent(testCtx(), &runtimev1.ResolveComponentRequest{
+		InstanceId: instanceID,
+		Component:  "c1--component-0",
+		Canvas:     "c1",
+		Filters:    filters,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Data, 1)
+	require.Equal(t, "DK", res.Data[0].Fields["country"].GetStringValue())
+
+	// The filters are applied to every metrics view in a metrics join
+	res, err = server.ResolveComponent(testCtx(), &runtimev1.ResolveComponentRequest{
+		InstanceId: instanceID,
+		Component:  "c1--component-1",
+		Canvas:     "c1",
+		Filters:    filters,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Data, 1)
+	require.Equal(t, "DK", res.Data[0].Fields["country"].GetStringValue())
+}
+
 func must[T any](v T, err error) T {
 	if err != nil {
 		panic(err)
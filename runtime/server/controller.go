@@ -198,6 +198,41 @@ func (s *Server) GetResource(ctx context.Context, req *runtimev1.GetResourceRequ
 	return &runtimev1.GetResourceResponse{Resource: r}, nil
 }
 
+// GetResourceLineage implements runtimev1.RuntimeServiceServer
+func (s *Server) GetResourceLineage(ctx context.Context, req *runtimev1.GetResourceLineageRequest) (*runtimev1.GetResourceLineageResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.name.kind", req.Name.Kind),
+		attribute.String("args.name.name", req.Name.Name),
+		attribute.Bool("args.columns", req.Columns),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+		return nil, ErrForbidden
+	}
+
+	// The lineage bypasses resource-level security policies, so it's only available to admins.
+	if !auth.GetClaims(ctx).SecurityClaims().Admin() {
+		return nil, ErrForbidden
+	}
+
+	l, err := s.runtime.Lineage(ctx, req.InstanceId, req.Name, req.Columns)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Error(codes.NotFound, "resource not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &runtimev1.GetResourceLineageResponse{
+		Upstream:   l.Upstream,
+		Downstream: l.Downstream,
+		Edges:      l.Edges,
+		Columns:    l.Columns,
+	}, nil
+}
+
 // GetExplore implements runtimev1.RuntimeServiceServer
 func (s *Server) GetExplore(ctx context.Context, req *runtimev1.GetExploreRequest) (*runtimev1.GetExploreResponse, error) {
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
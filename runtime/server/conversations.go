@@ -0,0 +1,457 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// aiAgentMaxIterations is the maximum number of times the AI is called for a single user message.
+// It bounds the number of rounds of tool calls the AI can make before responding.
+const aiAgentMaxIterations = 10
+
+// aiAgentTimeout is the timeout for responding to a single user message, including tool calls.
+const aiAgentTimeout = 2 * time.Minute
+
+// aiConversationTitleMaxLen is the maximum length of a conversation title derived from its first message.
+const aiConversationTitleMaxLen = 100
+
+// ListConversations implements runtimev1.RuntimeServiceServer
+func (s *Server) ListConversations(ctx context.Context, req *runtimev1.ListConversationsRequest) (*runtimev1.ListConversationsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	ownerID, err := conversationOwnerID(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	var afterUpdatedOn time.Time
+	var afterID string
+	if req.PageToken != "" {
+		err := unmarshalPageToken(req.PageToken, &afterUpdatedOn, &afterID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to parse page token: %v", err)
+		}
+	}
+
+	catalog, release, err := s.runtime.Catalog(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer release()
+
+	convs, err := catalog.FindAIConversations(ctx, &drivers.FindAIConversationsOptions{
+		OwnerID:        ownerID,
+		Limit:          validPageSize(req.PageSize),
+		AfterUpdatedOn: afterUpdatedOn,
+		AfterID:        afterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*runtimev1.Conversation, len(convs))
+	for i, c := range convs {
+		res[i] = conversationToPB(c, nil)
+	}
+
+	var nextPageToken string
+	if len(convs) == validPageSize(req.PageSize) {
+		last := convs[len(convs)-1]
+		nextPageToken = marshalPageToken(last.UpdatedOn, last.ID)
+	}
+
+	return &runtimev1.ListConversationsResponse{
+		Conversations: res,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// GetConversation implements runtimev1.RuntimeServiceServer
+func (s *Server) GetConversation(ctx context.Context, req *runtimev1.GetConversationRequest) (*runtimev1.GetConversationResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.conversation_id", req.ConversationId),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	ownerID, err := conversationOwnerID(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, release, err := s.runtime.Catalog(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer release()
+
+	conv, err := findOwnConversation(ctx, catalog, req.ConversationId, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := catalog.FindAIMessages(ctx, conv.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.GetConversationResponse{Conversation: conversationToPB(conv, msgs)}, nil
+}
+
+// DeleteConversation implements runtimev1.RuntimeServiceServer
+func (s *Server) DeleteConversation(ctx context.Context, req *runtimev1.DeleteConversationRequest) (*runtimev1.DeleteConversationResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.conversation_id", req.ConversationId),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	ownerID, err := conversationOwnerID(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, release, err := s.runtime.Catalog(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer release()
+
+	conv, err := findOwnConversation(ctx, catalog, req.ConversationId, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = catalog.DeleteAIConversation(ctx, conv.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.DeleteConversationResponse{}, nil
+}
+
+// Complete implements runtimev1.RuntimeServiceServer
+func (s *Server) Complete(ctx context.Context, req *runtimev1.CompleteRequest) (*runtimev1.CompleteResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.conversation_id", req.ConversationId),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	ownerID, err := conversationOwnerID(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, release, err := s.runtime.Catalog(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer release()
+
+	// Find or create the conversation
+	var conv *drivers.AIConversation
+	var history []*drivers.AIMessage
+	if req.ConversationId != "" {
+		conv, err = findOwnConversation(ctx, catalog, req.ConversationId, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		history, err = catalog.FindAIMessages(ctx, conv.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		now := time.Now()
+		conv = &drivers.AIConversation{
+			ID:        uuid.NewString(),
+			OwnerID:   ownerID,
+			Title:     conversationTitle(req.Message),
+			CreatedOn: now,
+			UpdatedOn: now,
+		}
+		err = catalog.InsertAIConversation(ctx, conv)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Run the agent
+	start := time.Now()
+	added, err := s.runAIAgent(ctx, req.InstanceId, catalog, conv, history, req.Message, int(req.Priority))
+	s.activity.Record(ctx, activity.EventTypeLog, "ai_completed",
+		attribute.Int64("elapsed_ms", time.Since(start).Milliseconds()),
+		attribute.Int("messages", len(added)),
+		attribute.Bool("succeeded", err == nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*runtimev1.Message, len(added))
+	for i, m := range added {
+		msgs[i] = messageToPB(m)
+	}
+
+	return &runtimev1.CompleteResponse{
+		ConversationId: conv.ID,
+		Messages:       msgs,
+	}, nil
+}
+
+// runAIAgent adds a user message to a conversation and calls the AI until it responds without calling any tools.
+// All new messages are persisted in the catalog as they are created. It returns the new messages.
+func (s *Server) runAIAgent(ctx context.Context, instanceID string, catalog drivers.CatalogStore, conv *drivers.AIConversation, history []*drivers.AIMessage, message string, priority int) ([]*drivers.AIMessage, error) {
+	// Connect to the AI service configured for the instance
+	ai, release, err := s.runtime.AI(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Apply timeout
+	ctx, cancel := context.WithTimeout(ctx, aiAgentTimeout)
+	defer cancel()
+
+	// Prepare tools
+	tools := s.aiTools()
+	toolSpecs := make([]*drivers.CompletionTool, len(tools))
+	toolsByName := make(map[string]*aiTool, len(tools))
+	for i, t := range tools {
+		toolSpecs[i] = t.spec
+		toolsByName[t.spec.Name] = t
+	}
+
+	// Build the chat from the conversation's history. The system prompt is not persisted.
+	msgs := []*drivers.CompletionMessage{{Role: "system", Data: aiAgentSystemPrompt()}}
+	for _, m := range history {
+		msgs = append(msgs, &drivers.CompletionMessage{
+			Role:       m.Role,
+			Data:       m.Data,
+			ToolCalls:  m.ToolCalls,
+			ToolCallID: m.ToolCallID,
+		})
+	}
+
+	// add persists a new message and appends it to the chat
+	var added []*drivers.AIMessage
+	add := func(msg *drivers.CompletionMessage) error {
+		m := &drivers.AIMessage{
+			ID:             uuid.NewString(),
+			ConversationID: conv.ID,
+			Index:          len(history) + len(added),
+			Role:           msg.Role,
+			Data:           msg.Data,
+			ToolCalls:      msg.ToolCalls,
+			ToolCallID:     msg.ToolCallID,
+			CreatedOn:      time.Now(),
+		}
+		err := catalog.InsertAIMessage(ctx, m)
+		if err != nil {
+			return err
+		}
+		added = append(added, m)
+		msgs = append(msgs, msg)
+		return nil
+	}
+
+	err = add(&drivers.CompletionMessage{Role: "user", Data: message})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < aiAgentMaxIterations; i++ {
+		res, err := ai.Complete(ctx, msgs, toolSpecs)
+		if err != nil {
+			return nil, err
+		}
+		res.Role = "assistant"
+
+		err = add(res)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(res.ToolCalls) == 0 {
+			return added, nil
+		}
+
+		for _, call := range res.ToolCalls {
+			err = add(&drivers.CompletionMessage{
+				Role:       "tool",
+				Data:       s.callAITool(ctx, instanceID, toolsByName, call, priority),
+				ToolCallID: call.ID,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return nil, status.Errorf(codes.FailedPrecondition, "the AI did not respond within %d steps", aiAgentMaxIterations)
+}
+
+// callAITool runs a tool call requested by the AI and returns the result to send back to it.
+// Errors are returned to the AI as part of the result so it can correct its input.
+func (s *Server) callAITool(ctx context.Context, instanceID string, tools map[string]*aiTool, call *drivers.CompletionToolCall, priority int) string {
+	t, ok := tools[call.Name]
+	if !ok {
+		return fmt.Sprintf("Error: unknown tool %q", call.Name)
+	}
+
+	input := make(map[string]any)
+	if call.Input != "" {
+		err := json.Unmarshal([]byte(call.Input), &input)
+		if err != nil {
+			return fmt.Sprintf("Error: invalid input: %s", err.Error())
+		}
+	}
+
+	res, err := t.fn(ctx, instanceID, input, priority)
+	if err != nil {
+		return fmt.Sprintf("Error: %s", err.Error())
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return fmt.Sprintf("Error: %s", err.Error())
+	}
+	return string(data)
+}
+
+// conversationOwnerID returns the ID that identifies the caller as the owner of conversations.
+// The subject alone is not enough: embed tokens use the subject of the user who created the embed, and claims in local development have no subject.
+// So it combines the subject with a hash of the caller's user attributes, which identify the user an embed or local session is acting as.
+func conversationOwnerID(claims auth.Claims) (string, error) {
+	attrs := claims.SecurityClaims().UserAttributes
+	if attrs == nil {
+		attrs = map[string]any{}
+	}
+	data, err := json.Marshal(attrs) // Keys are marshalled in sorted order
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to hash user attributes: %s", err.Error())
+	}
+	hash := sha256.Sum256(data)
+	return claims.Subject() + ":" + hex.EncodeToString(hash[:]), nil
+}
+
+// findOwnConversation finds a conversation and checks that it is owned by the given owner.
+// It returns a NotFound error for conversations owned by others to avoid leaking their existence.
+func findOwnConversation(ctx context.Context, catalog drivers.CatalogStore, conversationID, ownerID string) (*drivers.AIConversation, error) {
+	conv, err := catalog.FindAIConversation(ctx, conversationID)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "conversation %q not found", conversationID)
+		}
+		return nil, err
+	}
+	if conv.OwnerID != ownerID {
+		return nil, status.Errorf(codes.NotFound, "conversation %q not found", conversationID)
+	}
+	return conv, nil
+}
+
+// conversationTitle derives a conversation title from its first message.
+func conversationTitle(message string) string {
+	title := []rune(message)
+	if len(title) > aiConversationTitleMaxLen {
+		return string(title[:aiConversationTitleMaxLen]) + "..."
+	}
+	return string(title)
+}
+
+func conversationToPB(c *drivers.AIConversation, msgs []*drivers.AIMessage) *runtimev1.Conversation {
+	pbs := make([]*runtimev1.Message, len(msgs))
+	for i, m := range msgs {
+		pbs[i] = messageToPB(m)
+	}
+
+	return &runtimev1.Conversation{
+		Id:        c.ID,
+		OwnerId:   c.OwnerID,
+		Title:     c.Title,
+		CreatedOn: timestamppb.New(c.CreatedOn),
+		UpdatedOn: timestamppb.New(c.UpdatedOn),
+		Messages:  pbs,
+	}
+}
+
+func messageToPB(m *drivers.AIMessage) *runtimev1.Message {
+	calls := make([]*runtimev1.ToolCall, len(m.ToolCalls))
+	for i, c := range m.ToolCalls {
+		var input *structpb.Struct
+		v := make(map[string]any)
+		if err := json.Unmarshal([]byte(c.Input), &v); err == nil {
+			input, _ = structpb.NewStruct(v)
+		}
+
+		calls[i] = &runtimev1.ToolCall{
+			Id:    c.ID,
+			Name:  c.Name,
+			Input: input,
+		}
+	}
+
+	return &runtimev1.Message{
+		Id:         m.ID,
+		Role:       m.Role,
+		Content:    m.Data,
+		ToolCalls:  calls,
+		ToolCallId: m.ToolCallID,
+		CreatedOn:  timestamppb.New(m.CreatedOn),
+	}
+}
+
+// aiAgentSystemPrompt returns the static system prompt for the AI agent.
+func aiAgentSystemPrompt() string {
+	return `
+You are a data analyst assistant. You answer questions about the user's data by querying metrics views using the provided tools.
+A metrics view has dimensions that results can be grouped and filtered by, measures that are aggregated for each group, and optionally a time dimension.
+
+Before querying a metrics view, use the tools to find the metrics views, their dimensions and measures, and the time range of their data.
+Use the search_dimension_values tool to find the exact dimension values to filter by.
+Only use numbers returned by the tools in your answers. If the data needed to answer a question is not available, say so.
+Keep your answers concise. Use Markdown for lists and tables.
+`
+}
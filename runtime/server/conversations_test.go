@@ -0,0 +1,144 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	_ "github.com/rilldata/rill/runtime/drivers/mock"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConversations(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 1 AS val
+UNION ALL
+SELECT 'DK' AS country, 2 AS val
+UNION ALL
+SELECT 'SE' AS country, 3 AS val
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(val)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	// Configure a mock AI service that calls tools before answering
+	responses, err := json.Marshal([]any{
+		map[string]any{"tool_calls": []any{
+			map[string]any{"id": "call_1", "name": "list_metrics_views"},
+		}},
+		map[string]any{"tool_calls": []any{
+			map[string]any{"id": "call_2", "name": "search_dimension_values", "input": map[string]any{"metrics_view": "mv1", "dimension": "country", "search": "e"}},
+			map[string]any{"id": "call_3", "name": "query_metrics_view", "input": map[string]any{"metrics_view": "mv1", "dimensions": []any{map[string]any{"name": "country"}}, "measures": []any{map[string]any{"name": "total"}}, "sort": []any{map[string]any{"name": "total", "desc": true}}, "limit": 1}},
+		}},
+		"SE has the highest total.",
+		map[string]any{"tool_calls": []any{
+			map[string]any{"id": "call_4", "name": "get_metrics_view", "input": map[string]any{"metrics_view": "missing"}},
+		}},
+		"There is no such metrics view.",
+		"Hi there.",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	inst, err := rt.Instance(ctx, instanceID)
+	require.NoError(t, err)
+	inst.Connectors = append(inst.Connectors, &runtimev1.Connector{
+		Type:   "mock_ai",
+		Name:   "ai",
+		Config: map[string]string{"responses": string(responses)},
+	})
+	inst.AIConnector = "ai"
+	require.NoError(t, rt.EditInstance(ctx, inst, false))
+
+	srv, err := server.NewServer(ctx, &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	// Start a conversation
+	res, err := srv.Complete(testCtx(), &runtimev1.CompleteRequest{
+		InstanceId: instanceID,
+		Message:    "Which country has the highest total?",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.ConversationId)
+	require.Len(t, res.Messages, 7)
+	require.Equal(t, "user", res.Messages[0].Role)
+	require.Equal(t, "list_metrics_views", res.Messages[1].ToolCalls[0].Name)
+	require.Equal(t, "call_1", res.Messages[2].ToolCallId)
+	require.Contains(t, res.Messages[2].Content, `"name":"mv1"`)
+	require.Equal(t, "mv1", res.Messages[3].ToolCalls[1].Input.AsMap()["metrics_view"])
+	require.JSONEq(t, `["DK","SE"]`, res.Messages[4].Content)
+	require.JSONEq(t, `[{"country":"SE","total":3}]`, res.Messages[5].Content)
+	require.Equal(t, "assistant", res.Messages[6].Role)
+	require.Equal(t, "SE has the highest total.", res.Messages[6].Content)
+
+	// Continue the conversation
+	res2, err := srv.Complete(testCtx(), &runtimev1.CompleteRequest{
+		InstanceId:     instanceID,
+		ConversationId: res.ConversationId,
+		Message:        "What about the missing metrics view?",
+	})
+	require.NoError(t, err)
+	require.Equal(t, res.ConversationId, res2.ConversationId)
+	require.Len(t, res2.Messages, 4)
+	require.Contains(t, res2.Messages[2].Content, "Error:")
+
+	// List and get the conversation
+	convs, err := srv.ListConversations(testCtx(), &runtimev1.ListConversationsRequest{InstanceId: instanceID})
+	require.NoError(t, err)
+	require.Len(t, convs.Conversations, 1)
+	require.Equal(t, "Which country has the highest total?", convs.Conversations[0].Title)
+
+	conv, err := srv.GetConversation(testCtx(), &runtimev1.GetConversationRequest{InstanceId: instanceID, ConversationId: res.ConversationId})
+	require.NoError(t, err)
+	require.Len(t, conv.Conversation.Messages, 11)
+
+	// Callers that share a subject but act as different users (such as embeds) can't see each other's conversations
+	embedCtx := viewerCtxWithAttributes(t, instanceID, "owner", map[string]any{"email": "a@example.com"})
+	res3, err := srv.Complete(embedCtx, &runtimev1.CompleteRequest{InstanceId: instanceID, Message: "Hello"})
+	require.NoError(t, err)
+	otherCtx := viewerCtxWithAttributes(t, instanceID, "owner", map[string]any{"email": "b@example.com"})
+	_, err = srv.GetConversation(otherCtx, &runtimev1.GetConversationRequest{InstanceId: instanceID, ConversationId: res3.ConversationId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	convs, err = srv.ListConversations(otherCtx, &runtimev1.ListConversationsRequest{InstanceId: instanceID})
+	require.NoError(t, err)
+	require.Len(t, convs.Conversations, 0)
+	convs, err = srv.ListConversations(embedCtx, &runtimev1.ListConversationsRequest{InstanceId: instanceID})
+	require.NoError(t, err)
+	require.Len(t, convs.Conversations, 1)
+
+	// Paginate the conversations
+	convs, err = srv.ListConversations(testCtx(), &runtimev1.ListConversationsRequest{InstanceId: instanceID, PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, convs.Conversations, 1)
+	require.NotEmpty(t, convs.NextPageToken)
+	convs, err = srv.ListConversations(testCtx(), &runtimev1.ListConversationsRequest{InstanceId: instanceID, PageSize: 1, PageToken: convs.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, convs.Conversations, 0)
+
+	// Delete the conversation
+	_, err = srv.DeleteConversation(testCtx(), &runtimev1.DeleteConversationRequest{InstanceId: instanceID, ConversationId: res.ConversationId})
+	require.NoError(t, err)
+	_, err = srv.GetConversation(testCtx(), &runtimev1.GetConversationRequest{InstanceId: instanceID, ConversationId: res.ConversationId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/csv"
 	"encoding/gob"
 	"encoding/json"
 	"errors"
@@ -27,6 +28,22 @@ func (s *Server) Export(ctx context.Context, req *runtimev1.ExportRequest) (*run
 		return nil, ErrForbidden
 	}
 
+	// Snapshots are rendered from dashboards, not from query results
+	if req.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PDF || req.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PNG {
+		return nil, status.Errorf(codes.InvalidArgument, "export format %q is only supported for reports", req.Format.String())
+	}
+
+	// Google Sheets exports are written directly instead of being downloaded
+	if req.Format == runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS {
+		u, err := s.exportToGoogleSheets(ctx, req, auth.GetClaims(ctx).SecurityClaims())
+		if err != nil {
+			return nil, err
+		}
+		return &runtimev1.ExportResponse{
+			SpreadsheetUrl: u,
+		}, nil
+	}
+
 	tkn, err := s.generateDownloadToken(req, auth.GetClaims(ctx).SecurityClaims())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate download token: %s", err.Error())
@@ -96,6 +113,69 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	q, err := s.exportQuery(req.Context(), request, claims)
+	if err != nil {
+		if errors.Is(err, ErrForbidden) {
+			http.Error(w, "action not allowed", http.StatusUnauthorized)
+			return
+		}
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Enforce the query budget of the user or token that requested the download
+	ctx, err := s.runtime.WithQueryBudget(req.Context(), request.InstanceId, claims)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = q.Export(ctx, s.runtime, request.InstanceId, w, &runtime.ExportOptions{
+		Format: request.Format,
+		PreWriteHook: func(filename string) error {
+			// Add timestamp to filename
+			filename += "_" + time.Now().Format("20060102150405")
+
+			// Write HTTP headers
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+			switch request.Format {
+			case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
+				w.Header().Set("Content-Type", "text/csv")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.csv\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
+				w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.xlsx\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.parquet\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.jsonl\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+				w.Header().Set("Content-Type", "application/vnd.apache.arrow.stream")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.arrow\"", filename))
+			default:
+				return fmt.Errorf("unsupported format %q", request.Format.String())
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.As(err, &runtime.QueryBudgetExceededError{}) {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// exportQuery builds the runtime query to export for an export request.
+func (s *Server) exportQuery(ctx context.Context, request *runtimev1.ExportRequest, claims *runtime.SecurityClaims) (runtime.Query, error) {
 	var q runtime.Query
 	switch v := request.Query.Query.(type) {
 	case *runtimev1.Query_MetricsViewAggregationRequest:
@@ -156,14 +236,9 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 		}
 	case *runtimev1.Query_MetricsViewRowsRequest:
 		r := v.MetricsViewRowsRequest
-		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, claims)
+		mv, security, err := resolveMVAndSecurityFromAttributes(ctx, s.runtime, request.InstanceId, r.MetricsViewName, claims)
 		if err != nil {
-			if errors.Is(err, ErrForbidden) {
-				http.Error(w, "action not allowed", http.StatusUnauthorized)
-				return
-			}
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			return nil, err
 		}
 
 		var limitPtr *int64
@@ -215,11 +290,23 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Having:              r.Having,
 			SecurityClaims:      claims,
 		}
+	case *runtimev1.Query_MetricsViewContributionRequest:
+		r := v.MetricsViewContributionRequest
+		q = &queries.MetricsViewContribution{
+			MetricsViewName:     r.MetricsViewName,
+			Measure:             r.Measure,
+			VolumeMeasure:       r.VolumeMeasure,
+			Dimensions:          r.Dimensions,
+			TimeRange:           r.TimeRange,
+			ComparisonTimeRange: r.ComparisonTimeRange,
+			Where:               r.Where,
+			Limit:               r.Limit,
+			SecurityClaims:      claims,
+		}
 	case *runtimev1.Query_TableRowsRequest:
 		r := v.TableRowsRequest
-		if !auth.GetClaims(req.Context()).CanInstance(r.InstanceId, auth.ReadOLAP) {
-			http.Error(w, "action not allowed", http.StatusUnauthorized)
-			return
+		if !auth.GetClaims(ctx).CanInstance(r.InstanceId, auth.ReadOLAP) {
+			return nil, ErrForbidden
 		}
 
 		q = &queries.TableHead{
@@ -228,38 +315,129 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Result:    nil,
 		}
 	default:
-		http.Error(w, fmt.Sprintf("unsupported request type: %s", reflect.TypeOf(v).Name()), http.StatusBadRequest)
-		return
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported request type: %s", reflect.TypeOf(v).Name())
 	}
 
-	err = q.Export(req.Context(), s.runtime, request.InstanceId, w, &runtime.ExportOptions{
-		Format: request.Format,
-		PreWriteHook: func(filename string) error {
-			// Add timestamp to filename
-			filename += "_" + time.Now().Format("20060102150405")
+	return q, nil
+}
 
-			// Write HTTP headers
-			w.Header().Set("X-Content-Type-Options", "nosniff")
-			switch request.Format {
-			case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-				w.Header().Set("Content-Type", "text/csv")
-				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.csv\"", filename))
-			case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
-				w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
-				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.xlsx\"", filename))
-			case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
-				w.Header().Set("Content-Type", "application/octet-stream")
-				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.parquet\"", filename))
-			default:
-				return fmt.Errorf("unsupported format %q", request.Format.String())
-			}
+// exportToGoogleSheets writes the result of an export request to a tab in a Google Sheet and returns the tab's URL.
+func (s *Server) exportToGoogleSheets(ctx context.Context, req *runtimev1.ExportRequest, claims *runtime.SecurityClaims) (string, error) {
+	if req.GoogleSheets == nil || req.GoogleSheets.SpreadsheetId == "" {
+		return "", status.Error(codes.InvalidArgument, "a spreadsheet ID is required for Google Sheets exports")
+	}
+	if req.Query == nil {
+		return "", status.Error(codes.InvalidArgument, "missing query")
+	}
+
+	// The export is written with the instance's service account, so don't let callers write to arbitrary spreadsheets it has access to.
+	err := s.checkGoogleSheetsDestination(ctx, req.InstanceId, req.GoogleSheets, claims)
+	if err != nil {
+		return "", err
+	}
+
+	q, err := s.exportQuery(ctx, req, claims)
+	if err != nil {
+		if errors.Is(err, ErrForbidden) || status.Code(err) == codes.InvalidArgument {
+			return "", err
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	// Check the instance is configured for Google Sheets before running the query
+	client, err := s.runtime.GoogleSheets(ctx, req.InstanceId)
+	if err != nil {
+		return "", status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	// Enforce the query budget of the user or token that requested the export
+	ctx, err = s.runtime.WithQueryBudget(ctx, req.InstanceId, claims)
+	if err != nil {
+		return "", err
+	}
+
+	// Export to CSV and let Google Sheets parse the values
+	var buf bytes.Buffer
+	var filename string
+	err = q.Export(ctx, s.runtime, req.InstanceId, &buf, &runtime.ExportOptions{
+		Format: runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+		PreWriteHook: func(name string) error {
+			filename = name
 			return nil
 		},
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return "", err
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to read export: %s", err.Error())
+	}
+	rows := make([][]any, len(records))
+	for i, record := range records {
+		row := make([]any, len(record))
+		for j, v := range record {
+			row[j] = v
+		}
+		rows[i] = row
+	}
+
+	sheet := req.GoogleSheets.Sheet
+	if sheet == "" {
+		sheet = filename
+	}
+
+	u, err := client.WriteSheet(ctx, req.GoogleSheets.SpreadsheetId, sheet, rows)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to export to Google Sheets: %s", err.Error())
+	}
+	return u, nil
+}
+
+// checkGoogleSheetsDestination returns an error if the caller is not allowed to export to the given spreadsheet tab.
+// Editors can export to any spreadsheet. Other users can only export to the tab of a report they own, since the export replaces the tab's contents.
+func (s *Server) checkGoogleSheetsDestination(ctx context.Context, instanceID string, opts *runtimev1.GoogleSheetsExportOptions, claims *runtime.SecurityClaims) error {
+	if auth.GetClaims(ctx).CanInstance(instanceID, auth.EditInstance) {
+		return nil
 	}
+
+	var userID string
+	if claims != nil {
+		userID, _ = claims.UserAttributes["id"].(string)
+	}
+	if userID == "" {
+		return status.Error(codes.PermissionDenied, "only editors and report owners can export to Google Sheets")
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, instanceID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	rs, err := ctrl.List(ctx, runtime.ResourceKindReport, "", false)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	for _, r := range rs {
+		spec := r.GetReport().Spec
+		if spec.ExportGoogleSheets.GetSpreadsheetId() != opts.SpreadsheetId {
+			continue
+		}
+		if spec.Annotations["admin_owner_user_id"] != userID {
+			continue
+		}
+
+		// Reports write to the tab named after the report if they don't declare one
+		sheet := spec.ExportGoogleSheets.GetSheet()
+		if sheet == "" {
+			sheet = r.Meta.Name.Name
+		}
+		if opts.Sheet == sheet {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, "the spreadsheet tab is not the export destination of a report you own")
 }
 
 func (s *Server) resolveExportLimit(base, override int64) int64 {
@@ -0,0 +1,148 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportGoogleSheetsDestination(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 10 AS amount
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(amount)
+`,
+			"r1.yaml": `
+type: report
+refresh:
+  cron: 0 * * * *
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: google_sheets
+  google_sheets:
+    spreadsheet_id: declared
+annotations:
+  admin_owner_user_id: viewer
+`,
+			"r2.yaml": `
+type: report
+refresh:
+  cron: 0 * * * *
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: google_sheets
+  google_sheets:
+    spreadsheet_id: foreign
+    sheet: Sheet1
+annotations:
+  admin_owner_user_id: someone_else
+`,
+		},
+		// Invalid credentials make exports fail after the destination check without calling Google Sheets
+		Variables: map[string]string{"rill.google_sheets.credentials_json": "{}"},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 5, 0, 0)
+
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	req := func(spreadsheetID, sheet string) *runtimev1.ExportRequest {
+		return &runtimev1.ExportRequest{
+			InstanceId: instanceID,
+			Format:     runtimev1.ExportFormat_EXPORT_FORMAT_GOOGLE_SHEETS,
+			Query: &runtimev1.Query{Query: &runtimev1.Query_MetricsViewAggregationRequest{MetricsViewAggregationRequest: &runtimev1.MetricsViewAggregationRequest{
+				MetricsView: "mv1",
+				Measures:    []*runtimev1.MetricsViewAggregationMeasure{{Name: "total"}},
+			}}},
+			GoogleSheets: &runtimev1.GoogleSheetsExportOptions{SpreadsheetId: spreadsheetID, Sheet: sheet},
+		}
+	}
+
+	// Viewers can't export to spreadsheets that are not declared in the project
+	ctx := viewerCtx(t, instanceID)
+	_, err = srv.Export(ctx, req("other", "r1"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Viewers pass the check for the tab of a report they own (but fail due to the invalid credentials)
+	_, err = srv.Export(ctx, req("declared", "r1"))
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Viewers can't overwrite other tabs of a declared spreadsheet
+	_, err = srv.Export(ctx, req("declared", "Sheet2"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.Export(ctx, req("declared", ""))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Viewers can't export to the destination of a report they don't own
+	_, err = srv.Export(ctx, req("foreign", "Sheet1"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Editors can export to any spreadsheet
+	_, err = srv.Export(testCtx(), req("other", ""))
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// viewerCtx returns a context with the claims of a JWT that can only read metrics in the instance.
+func viewerCtx(t *testing.T, instanceID string) context.Context {
+	return viewerCtxWithAttributes(t, instanceID, "viewer", nil)
+}
+
+// viewerCtxWithAttributes is like viewerCtx, but with the given subject and user attributes.
+func viewerCtxWithAttributes(t *testing.T, instanceID, subject string, attrs map[string]any) context.Context {
+	// The issuer URL must be known before creating the issuer, so the JWKS handler is resolved lazily
+	var iss *auth.Issuer
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		iss.WellKnownHandler().ServeHTTP(w, r)
+	}))
+	t.Cleanup(jwks.Close)
+
+	iss, err := auth.NewEphemeralIssuer(jwks.URL)
+	require.NoError(t, err)
+
+	aud, err := auth.OpenAudience(context.Background(), zap.NewNop(), jwks.URL, "http://example.org")
+	require.NoError(t, err)
+	t.Cleanup(aud.Close)
+
+	token, err := iss.NewToken(auth.TokenOptions{
+		AudienceURL:         "http://example.org",
+		Subject:             subject,
+		TTL:                 time.Hour,
+		InstancePermissions: map[string][]auth.Permission{instanceID: {auth.ReadMetrics}},
+		Attributes:          attrs,
+	})
+	require.NoError(t, err)
+
+	ctx, err := auth.WithToken(context.Background(), aud, token)
+	require.NoError(t, err)
+	return ctx
+}
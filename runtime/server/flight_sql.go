@@ -0,0 +1,487 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/flight"
+	"github.com/apache/arrow/go/v15/arrow/flight/flightsql"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/arrowutil"
+	"github.com/rilldata/rill/runtime/pkg/graceful"
+	"github.com/rilldata/rill/runtime/pkg/jsonval"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Request headers used by Flight SQL clients to select the instance and SQL dialect to query.
+// Arrow clients let you set custom headers as connection properties (e.g. as JDBC URL parameters).
+const (
+	flightSQLInstanceHeader = "instance_id"
+	flightSQLDialectHeader  = "dialect"
+)
+
+// Supported values for the flightSQLDialectHeader.
+const (
+	flightSQLDialectMetrics = "metrics"
+	flightSQLDialectSQL     = "sql"
+)
+
+// flightSQLBatchSize is the number of rows sent in each Arrow record batch.
+const flightSQLBatchSize = 1000
+
+// ServeFlightSQL starts an Arrow Flight SQL server on the port configured in Options.FlightSQLPort.
+// It serves Metrics SQL queries (the default) and, for admins, plain SQL queries against the instance's OLAP connector.
+func (s *Server) ServeFlightSQL(ctx context.Context) error {
+	srv := &flightSQLServer{
+		runtime: s.runtime,
+		mem:     memory.DefaultAllocator,
+	}
+	err := srv.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerName, "Rill")
+	if err != nil {
+		return err
+	}
+	err = srv.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerReadOnly, true)
+	if err != nil {
+		return err
+	}
+	err = srv.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerSql, true)
+	if err != nil {
+		return err
+	}
+	err = srv.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerTransaction, int32(flightsql.SqlTransactionNone))
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(
+		grpc.ChainStreamInterceptor(
+			observability.LoggingStreamServerInterceptor(s.logger),
+			auth.StreamServerInterceptor(s.aud),
+			errorMappingStreamServerInterceptor(),
+		),
+		grpc.ChainUnaryInterceptor(
+			observability.LoggingUnaryServerInterceptor(s.logger),
+			auth.UnaryServerInterceptor(s.aud),
+			errorMappingUnaryServerInterceptor(),
+		),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+	)
+	flight.RegisterFlightServiceServer(server, flightsql.NewFlightServerWithAllocator(srv, srv.mem))
+	s.logger.Sugar().Infof("serving Flight SQL on port:%v", s.opts.FlightSQLPort)
+	return graceful.ServeGRPC(ctx, server, s.opts.FlightSQLPort)
+}
+
+// flightSQLServer implements flightsql.Server.
+// It is stateless: the statement handles and tickets it issues encode the query to run.
+type flightSQLServer struct {
+	flightsql.BaseServer
+	runtime *runtime.Runtime
+	mem     memory.Allocator
+}
+
+// flightSQLQuery is a query received through Flight SQL.
+// It is serialized to JSON for use as statement handles and tickets.
+type flightSQLQuery struct {
+	InstanceID string `json:"instance_id"`
+	Dialect    string `json:"dialect"`
+	SQL        string `json:"sql"`
+}
+
+// newQuery builds a flightSQLQuery from a SQL string and the request headers.
+func (f *flightSQLServer) newQuery(ctx context.Context, sql string) (*flightSQLQuery, error) {
+	md := metautils.ExtractIncoming(ctx)
+
+	instanceID := md.Get(flightSQLInstanceHeader)
+	if instanceID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing %q header", flightSQLInstanceHeader)
+	}
+
+	dialect := md.Get(flightSQLDialectHeader)
+	switch dialect {
+	case "":
+		dialect = flightSQLDialectMetrics
+	case flightSQLDialectMetrics, flightSQLDialectSQL:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported dialect %q (expected %q or %q)", dialect, flightSQLDialectMetrics, flightSQLDialectSQL)
+	}
+
+	return &flightSQLQuery{
+		InstanceID: instanceID,
+		Dialect:    dialect,
+		SQL:        sql,
+	}, nil
+}
+
+// parseQuery decodes a flightSQLQuery from a statement handle.
+func (f *flightSQLServer) parseQuery(handle []byte) (*flightSQLQuery, error) {
+	q := &flightSQLQuery{}
+	err := json.Unmarshal(handle, q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid statement handle: %v", err)
+	}
+	return q, nil
+}
+
+// resolve checks the caller's permissions and executes the query.
+// If limit is greater than zero, it is pushed down into the query.
+// The caller must call Close on the result when done consuming it.
+func (f *flightSQLServer) resolve(ctx context.Context, q *flightSQLQuery, limit int64) (runtime.ResolverResult, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", q.InstanceID),
+		attribute.String("args.dialect", q.Dialect),
+		attribute.String("args.sql", q.SQL),
+	)
+	observability.AddRequestAttributes(ctx, f.runtime.GetInstanceAttributes(ctx, q.InstanceID)...)
+
+	claims := auth.GetClaims(ctx)
+
+	// Metrics SQL is compiled with the caller's security claims, so it's safe for anyone with access to metrics.
+	// Plain SQL bypasses metrics view security policies, so the "builtin_sql" resolver only allows it for admins.
+	var resolver string
+	var props, args map[string]any
+	switch q.Dialect {
+	case flightSQLDialectMetrics:
+		if !claims.CanInstance(q.InstanceID, auth.ReadMetrics) {
+			return nil, ErrForbidden
+		}
+		resolver = "metrics_sql"
+		props = map[string]any{"sql": q.SQL}
+	case flightSQLDialectSQL:
+		if !claims.CanInstance(q.InstanceID, auth.ReadOLAP) {
+			return nil, ErrForbidden
+		}
+		resolver = "builtin_sql"
+		args = map[string]any{"sql": q.SQL}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported dialect %q", q.Dialect)
+	}
+
+	return f.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         q.InstanceID,
+		Resolver:           resolver,
+		ResolverProperties: props,
+		Args:               args,
+		Claims:             claims.SecurityClaims(),
+		Limit:              limit,
+	})
+}
+
+// schema returns the Arrow schema of the query's result.
+// Flight SQL requires the schema before the results are fetched, so this executes the query with a limit of one row to get it cheaply.
+func (f *flightSQLServer) schema(ctx context.Context, q *flightSQLQuery) (*arrow.Schema, error) {
+	res, err := f.resolve(ctx, q, 1)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	return arrowutil.Schema(res.Schema()), nil
+}
+
+// flightInfo returns a FlightInfo for fetching the results of the query with the given ticket.
+func (f *flightSQLServer) flightInfo(ctx context.Context, q *flightSQLQuery, desc *flight.FlightDescriptor, ticket []byte) (*flight.FlightInfo, error) {
+	schema, err := f.schema(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flight.FlightInfo{
+		Schema:           flight.SerializeSchema(schema, f.mem),
+		FlightDescriptor: desc,
+		Endpoint:         []*flight.FlightEndpoint{{Ticket: &flight.Ticket{Ticket: ticket}}},
+		TotalRecords:     -1,
+		TotalBytes:       -1,
+	}, nil
+}
+
+// doGet executes the query and streams the result as Arrow record batches.
+func (f *flightSQLServer) doGet(ctx context.Context, q *flightSQLQuery) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	res, err := f.resolve(ctx, q, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rs := res.Schema()
+	schema := arrowutil.Schema(rs)
+	ch := make(chan flight.StreamChunk)
+	go func() {
+		defer close(ch)
+		defer res.Close()
+
+		builder := array.NewRecordBuilder(f.mem, schema)
+		defer builder.Release()
+
+		send := func() bool {
+			rec := builder.NewRecord()
+			select {
+			case ch <- flight.StreamChunk{Data: rec}:
+				return true
+			case <-ctx.Done():
+				rec.Release()
+				return false
+			}
+		}
+
+		sendErr := func(err error) {
+			select {
+			case ch <- flight.StreamChunk{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+
+		var n int
+		for {
+			row, err := res.Next()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				sendErr(err)
+				return
+			}
+
+			for i, field := range rs.Fields {
+				err := appendFlightSQLValue(builder.Field(i), field.Type, row[field.Name])
+				if err != nil {
+					sendErr(fmt.Errorf("failed to convert column %q: %w", field.Name, err))
+					return
+				}
+			}
+
+			n++
+			if n == flightSQLBatchSize {
+				if !send() {
+					return
+				}
+				n = 0
+			}
+		}
+
+		if n > 0 {
+			send()
+		}
+	}()
+
+	return schema, ch, nil
+}
+
+// GetFlightInfoStatement implements flightsql.Server.
+func (f *flightSQLServer) GetFlightInfoStatement(ctx context.Context, cmd flightsql.StatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	q, err := f.newQuery(ctx, cmd.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := json.Marshal(q)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := flightsql.CreateStatementQueryTicket(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.flightInfo(ctx, q, desc, ticket)
+}
+
+// GetSchemaStatement implements flightsql.Server.
+func (f *flightSQLServer) GetSchemaStatement(ctx context.Context, cmd flightsql.StatementQuery, desc *flight.FlightDescriptor) (*flight.SchemaResult, error) {
+	q, err := f.newQuery(ctx, cmd.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := f.schema(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flight.SchemaResult{Schema: flight.SerializeSchema(schema, f.mem)}, nil
+}
+
+// DoGetStatement implements flightsql.Server.
+func (f *flightSQLServer) DoGetStatement(ctx context.Context, ticket flightsql.StatementQueryTicket) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	q, err := f.parseQuery(ticket.GetStatementHandle())
+	if err != nil {
+		return nil, nil, err
+	}
+	return f.doGet(ctx, q)
+}
+
+// CreatePreparedStatement implements flightsql.Server.
+// Prepared statements are used by the JDBC driver for all queries. Parameter binding is not supported.
+func (f *flightSQLServer) CreatePreparedStatement(ctx context.Context, req flightsql.ActionCreatePreparedStatementRequest) (flightsql.ActionCreatePreparedStatementResult, error) {
+	q, err := f.newQuery(ctx, req.GetQuery())
+	if err != nil {
+		return flightsql.ActionCreatePreparedStatementResult{}, err
+	}
+
+	handle, err := json.Marshal(q)
+	if err != nil {
+		return flightsql.ActionCreatePreparedStatementResult{}, err
+	}
+
+	schema, err := f.schema(ctx, q)
+	if err != nil {
+		return flightsql.ActionCreatePreparedStatementResult{}, err
+	}
+
+	return flightsql.ActionCreatePreparedStatementResult{
+		Handle:        handle,
+		DatasetSchema: schema,
+	}, nil
+}
+
+// ClosePreparedStatement implements flightsql.Server.
+func (f *flightSQLServer) ClosePreparedStatement(ctx context.Context, req flightsql.ActionClosePreparedStatementRequest) error {
+	// Nothing to do since prepared statements are not stored on the server.
+	return nil
+}
+
+// GetFlightInfoPreparedStatement implements flightsql.Server.
+func (f *flightSQLServer) GetFlightInfoPreparedStatement(ctx context.Context, cmd flightsql.PreparedStatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	q, err := f.parseQuery(cmd.GetPreparedStatementHandle())
+	if err != nil {
+		return nil, err
+	}
+	return f.flightInfo(ctx, q, desc, desc.Cmd)
+}
+
+// GetSchemaPreparedStatement implements flightsql.Server.
+func (f *flightSQLServer) GetSchemaPreparedStatement(ctx context.Context, cmd flightsql.PreparedStatementQuery, desc *flight.FlightDescriptor) (*flight.SchemaResult, error) {
+	q, err := f.parseQuery(cmd.GetPreparedStatementHandle())
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := f.schema(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flight.SchemaResult{Schema: flight.SerializeSchema(schema, f.mem)}, nil
+}
+
+// DoGetPreparedStatement implements flightsql.Server.
+func (f *flightSQLServer) DoGetPreparedStatement(ctx context.Context, cmd flightsql.PreparedStatementQuery) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	q, err := f.parseQuery(cmd.GetPreparedStatementHandle())
+	if err != nil {
+		return nil, nil, err
+	}
+	return f.doGet(ctx, q)
+}
+
+// appendFlightSQLValue appends a value to an Arrow builder created for the type returned by arrowutil.Type.
+func appendFlightSQLValue(b array.Builder, t *runtimev1.Type, v any) error {
+	v, err := jsonval.ToValue(v, t)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		x, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		b.Append(x)
+	case *array.Int64Builder:
+		rv := reflect.ValueOf(v)
+		switch {
+		case rv.CanInt():
+			b.Append(rv.Int())
+		case rv.CanUint():
+			b.Append(int64(rv.Uint()))
+		case rv.CanFloat():
+			b.Append(int64(rv.Float()))
+		default:
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+	case *array.Uint64Builder:
+		rv := reflect.ValueOf(v)
+		switch {
+		case rv.CanUint():
+			b.Append(rv.Uint())
+		case rv.CanInt():
+			b.Append(uint64(rv.Int()))
+		case rv.CanFloat():
+			b.Append(uint64(rv.Float()))
+		default:
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+	case *array.Float64Builder:
+		rv := reflect.ValueOf(v)
+		switch {
+		case rv.CanFloat():
+			b.Append(rv.Float())
+		case rv.CanInt():
+			b.Append(float64(rv.Int()))
+		case rv.CanUint():
+			b.Append(float64(rv.Uint()))
+		default:
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+	case *array.TimestampBuilder:
+		x, err := flightSQLTime(v, time.RFC3339Nano)
+		if err != nil {
+			return err
+		}
+		ts, err := arrow.TimestampFromTime(x, arrow.Microsecond)
+		if err != nil {
+			return err
+		}
+		b.Append(ts)
+	case *array.BinaryBuilder:
+		x, ok := v.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		b.Append(x)
+	case *array.StringBuilder:
+		if x, ok := v.(string); ok {
+			b.Append(x)
+			return nil
+		}
+		x, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		b.Append(string(x))
+	default:
+		return fmt.Errorf("unsupported Arrow builder %T", b)
+	}
+	return nil
+}
+
+// flightSQLTime converts a time value to a time.Time.
+// Values may have been serialized to strings in the given layout (e.g. timestamps in cached results).
+func flightSQLTime(v any, layout string) (time.Time, error) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return time.Parse(layout, v)
+	default:
+		return time.Time{}, fmt.Errorf("unexpected value of type %T", v)
+	}
+}
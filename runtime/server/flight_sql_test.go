@@ -0,0 +1,102 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/flight"
+	"github.com/apache/arrow/go/v15/arrow/flight/flightsql"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFlightSQL(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 1 AS val
+UNION ALL
+SELECT 'DK' AS country, 2 AS val
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(val)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	// Find a free port
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	require.NoError(t, lis.Close())
+
+	srv, err := server.NewServer(context.Background(), &server.Options{FlightSQLPort: port}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = srv.ServeFlightSQL(ctx)
+	}()
+
+	client, err := flightsql.NewClient(fmt.Sprintf("localhost:%d", port), nil, nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	query := func(ctx context.Context, sql string) map[string]any {
+		// Retry until the server has started
+		var info *flight.FlightInfo
+		require.Eventually(t, func() bool {
+			info, err = client.Execute(ctx, sql)
+			return err == nil
+		}, 5*time.Second, 100*time.Millisecond)
+
+		rdr, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+		require.NoError(t, err)
+		defer rdr.Release()
+
+		res := make(map[string]any)
+		for rdr.Next() {
+			rec := rdr.Record()
+			for i := 0; i < int(rec.NumRows()); i++ {
+				res[rec.Column(0).(*array.String).Value(i)] = rec.Column(1).GetOneForMarshal(i)
+			}
+		}
+		require.NoError(t, rdr.Err())
+		return res
+	}
+
+	// Metrics SQL is the default dialect
+	mctx := metadata.AppendToOutgoingContext(ctx, "instance_id", instanceID)
+	res := query(mctx, "SELECT country, total FROM mv1")
+	require.Equal(t, map[string]any{"US": float64(1), "DK": float64(2)}, res)
+
+	// Plain SQL against the OLAP
+	sctx := metadata.AppendToOutgoingContext(ctx, "instance_id", instanceID, "dialect", "sql")
+	res = query(sctx, "SELECT country, val FROM m1")
+	require.Equal(t, map[string]any{"US": int64(1), "DK": int64(2)}, res)
+
+	// Missing instance ID
+	_, err = client.Execute(ctx, "SELECT country, total FROM mv1")
+	require.ErrorContains(t, err, `missing "instance_id" header`)
+}
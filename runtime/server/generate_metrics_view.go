@@ -216,7 +216,7 @@ func (s *Server) generateMetricsViewYAMLWithAI(ctx context.Context, instanceID,
 	defer cancel()
 
 	// Call AI service to infer a metrics view YAML
-	res, err := ai.Complete(ctx, msgs)
+	res, err := ai.Complete(ctx, msgs, nil)
 	if err != nil {
 		return nil, err
 	}
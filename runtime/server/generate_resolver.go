@@ -156,7 +156,7 @@ func (s *Server) generateResolverForTable(ctx context.Context, instanceID, userP
 	defer cancel()
 
 	// Call AI service to infer a metrics view YAML
-	res, err := ai.Complete(ctx, msgs)
+	res, err := ai.Complete(ctx, msgs, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -204,7 +204,7 @@ func (s *Server) generateResolverForMetricsView(ctx context.Context, instanceID,
 	defer cancel()
 
 	// Call AI service to infer a metrics view YAML
-	res, err := ai.Complete(ctx, msgs)
+	res, err := ai.Complete(ctx, msgs, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -0,0 +1,896 @@
+package server
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/jsonval"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Suffixes of the fields added to a metrics view's GraphQL type for each measure's comparison values.
+// They are only populated when the comparison_time_range argument is set.
+const (
+	graphQLComparisonValueSuffix = "__prev"
+	graphQLComparisonDeltaSuffix = "__delta_abs"
+	graphQLComparisonRatioSuffix = "__delta_rel"
+)
+
+// Limits on the size of GraphQL queries, which are checked before executing them.
+// Each metrics view field at the root of a query runs a separate query, so they are limited separately from the total number of selections.
+const (
+	graphQLMaxQueries    = 10
+	graphQLMaxSelections = 1000
+	graphQLMaxDepth      = 20
+)
+
+// graphQLDefaultLimit is the number of rows returned by a metrics view field if the limit argument is not set.
+// It is lowered to the instance's interactive row limit if that is smaller.
+const graphQLDefaultLimit = 100
+
+// graphQLSchemaCacheSize is the number of GraphQL schemas to cache.
+const graphQLSchemaCacheSize = 100
+
+// graphQLRequest is the body of a GraphQL request as sent by common GraphQL clients.
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// graphQLHandler serves a GraphQL API generated from the metrics views in an instance.
+// Each metrics view the user has access to becomes a query field returning rows with its dimensions and measures as fields.
+// Queries are resolved using the "metrics" resolver, so security policies are applied for the user.
+func (s *Server) graphQLHandler(w http.ResponseWriter, req *http.Request) error {
+	// Parse path parameters
+	ctx := req.Context()
+	instanceID := req.PathValue("instance_id")
+
+	// Add observability attributes
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", instanceID),
+	)
+	s.addInstanceRequestAttributes(ctx, instanceID)
+
+	// Check if user has access to query metrics data
+	if !auth.GetClaims(ctx).CanInstance(instanceID, auth.ReadMetrics) {
+		return httputil.Errorf(http.StatusForbidden, "does not have access to metrics data")
+	}
+
+	// Parse the request from the URL query (GET) or body (POST)
+	var gqlReq graphQLRequest
+	switch req.Method {
+	case http.MethodGet:
+		q := req.URL.Query()
+		gqlReq.Query = q.Get("query")
+		gqlReq.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &gqlReq.Variables); err != nil {
+				return httputil.Errorf(http.StatusBadRequest, "failed to parse variables: %w", err)
+			}
+		}
+	case http.MethodPost:
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return httputil.Errorf(http.StatusBadRequest, "failed to read request body: %w", err)
+		}
+		if strings.HasPrefix(req.Header.Get("Content-Type"), "application/graphql") {
+			gqlReq.Query = string(body)
+		} else if err := json.Unmarshal(body, &gqlReq); err != nil {
+			return httputil.Errorf(http.StatusBadRequest, "failed to unmarshal request body: %w", err)
+		}
+	default:
+		return httputil.Error(http.StatusMethodNotAllowed, fmt.Errorf("GET or POST only"))
+	}
+	if gqlReq.Query == "" {
+		return httputil.Errorf(http.StatusBadRequest, "missing GraphQL query")
+	}
+
+	// Build the schema for the metrics views the user has access to
+	schema, err := s.graphQLSchema(ctx, instanceID)
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+
+	// Execute the query.
+	// Following the GraphQL over HTTP conventions, execution errors are returned in the response body with a 200 status code.
+	res := executeGraphQL(ctx, schema, &gqlReq)
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(data)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
+	return nil
+}
+
+// executeGraphQL parses, validates and executes a GraphQL request.
+// It is equivalent to graphql.Do, except that it rejects queries that exceed the size limits before validating and executing them.
+func executeGraphQL(ctx context.Context, schema *graphql.Schema, req *graphQLRequest) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{
+		Body: []byte(req.Query),
+		Name: "GraphQL request",
+	})})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	err = checkGraphQLLimits(doc, req.OperationName)
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	validation := graphql.ValidateDocument(schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        *schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+}
+
+// checkGraphQLLimits returns an error if the operation to execute in a document exceeds the size limits.
+// Selections are counted after expanding fragments, so aliases and repeated fragment spreads each count towards the limits.
+// It runs before validation, so it skips unknown and cyclic fragment spreads, which are reported by validation.
+func checkGraphQLLimits(doc *ast.Document, operationName string) error {
+	var op *ast.OperationDefinition
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				op = def
+			}
+		case *ast.FragmentDefinition:
+			fragments[def.Name.Value] = def
+		}
+	}
+	if op == nil {
+		// Execution fails with a descriptive error
+		return nil
+	}
+
+	var queries, selections int
+	active := make(map[string]bool) // Fragments being expanded
+	var visit func(set *ast.SelectionSet, depth int) error
+	visit = func(set *ast.SelectionSet, depth int) error {
+		if set == nil {
+			return nil
+		}
+		if depth > graphQLMaxDepth {
+			return fmt.Errorf("query exceeds the maximum depth of %d", graphQLMaxDepth)
+		}
+		for _, sel := range set.Selections {
+			selections++
+			if selections > graphQLMaxSelections {
+				return fmt.Errorf("query exceeds the maximum of %d selections", graphQLMaxSelections)
+			}
+
+			var err error
+			switch sel := sel.(type) {
+			case *ast.Field:
+				// Fields at the root that are not introspection fields query a metrics view
+				if depth == 1 && !strings.HasPrefix(sel.Name.Value, "__") {
+					queries++
+					if queries > graphQLMaxQueries {
+						return fmt.Errorf("query exceeds the maximum of %d metrics view fields", graphQLMaxQueries)
+					}
+				}
+				err = visit(sel.SelectionSet, depth+1)
+			case *ast.InlineFragment:
+				err = visit(sel.SelectionSet, depth)
+			case *ast.FragmentSpread:
+				n := sel.Name.Value
+				if def, ok := fragments[n]; ok && !active[n] {
+					active[n] = true
+					err = visit(def.SelectionSet, depth)
+					active[n] = false
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return visit(op.SelectionSet, 1)
+}
+
+// graphQLSchema returns a GraphQL schema for the valid metrics views in an instance that the user has access to.
+// Building a schema runs a query for each metrics view, so schemas are cached by the instance, the state of its metrics views and the user's claims.
+func (s *Server) graphQLSchema(ctx context.Context, instanceID string) (*graphql.Schema, error) {
+	inst, err := s.runtime.Instance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := inst.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := ctrl.List(ctx, runtime.ResourceKindMetricsView, "", false)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Meta.Name.Name < rs[j].Meta.Name.Name })
+
+	claims := auth.GetClaims(ctx).SecurityClaims()
+	cacheKey, err := graphQLSchemaCacheKey(inst, rs, claims)
+	if err != nil {
+		return nil, err
+	}
+	s.graphQLSchemasMu.Lock()
+	cached, ok := s.graphQLSchemas.Get(cacheKey)
+	s.graphQLSchemasMu.Unlock()
+	if ok {
+		return cached.(*graphql.Schema), nil
+	}
+
+	b := newGraphQLSchemaBuilder(cfg.InteractiveSQLRowLimit)
+	for _, r := range rs {
+		r, access, err := s.applySecurityPolicy(ctx, instanceID, r)
+		if err != nil {
+			return nil, err
+		}
+		if !access {
+			continue
+		}
+		mv := r.GetMetricsView().State.ValidSpec
+		if mv == nil {
+			continue
+		}
+
+		// Get the data types of the metrics view's fields
+		q := &queries.MetricsViewSchema{
+			MetricsViewName: r.Meta.Name.Name,
+			SecurityClaims:  claims,
+		}
+		err = s.runtime.Query(ctx, instanceID, q, 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema for metrics view %q: %w", r.Meta.Name.Name, err)
+		}
+
+		b.addMetricsView(r.Meta.Name.Name, mv, q.Result.Schema, func(p graphql.ResolveParams, props map[string]any) (any, error) {
+			return s.resolveGraphQLMetricsView(p, instanceID, props)
+		})
+	}
+
+	schema, err := b.build()
+	if err != nil {
+		return nil, err
+	}
+
+	s.graphQLSchemasMu.Lock()
+	s.graphQLSchemas.Add(cacheKey, schema)
+	s.graphQLSchemasMu.Unlock()
+
+	return schema, nil
+}
+
+// graphQLSchemaCacheKey computes a cache key for the GraphQL schema of an instance's metrics views.
+// The instance's update time is included since security policies may depend on its variables.
+func graphQLSchemaCacheKey(inst *drivers.Instance, rs []*runtimev1.Resource, claims *runtime.SecurityClaims) (string, error) {
+	hash := md5.New()
+	_, err := hash.Write([]byte(inst.ID + inst.UpdatedOn.String()))
+	if err != nil {
+		return "", err
+	}
+	for _, r := range rs {
+		_, err = hash.Write([]byte(r.Meta.Name.Name + r.Meta.StateUpdatedOn.AsTime().String()))
+		if err != nil {
+			return "", err
+		}
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	_, err = hash.Write(claimsJSON)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// resolveGraphQLMetricsView resolves a metrics view query field using the "metrics" resolver.
+func (s *Server) resolveGraphQLMetricsView(p graphql.ResolveParams, instanceID string, props map[string]any) (any, error) {
+	ctx := p.Context
+
+	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           "metrics",
+		ResolverProperties: props,
+		Claims:             auth.GetClaims(ctx).SecurityClaims(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	types := make(map[string]*runtimev1.Type)
+	for _, f := range res.Schema().Fields {
+		types[f.Name] = f.Type
+	}
+
+	var rows []map[string]any
+	for {
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		for k, v := range row {
+			v, err = jsonval.ToValue(v, types[k])
+			if err != nil {
+				return nil, err
+			}
+			if t, ok := v.(time.Time); ok {
+				v = t.Format(time.RFC3339Nano)
+			}
+			row[k] = v
+		}
+		rows = append(rows, row)
+	}
+	if rows == nil {
+		rows = []map[string]any{}
+	}
+
+	return rows, nil
+}
+
+// graphQLMetricsViewResolver resolves a metrics view query field given the properties for the "metrics" resolver.
+type graphQLMetricsViewResolver func(p graphql.ResolveParams, props map[string]any) (any, error)
+
+// graphQLSchemaBuilder incrementally builds a GraphQL schema from metrics views.
+type graphQLSchemaBuilder struct {
+	rowLimit  int64
+	fields    graphql.Fields
+	typeNames map[string]bool
+	value     *graphql.Scalar
+	filter    *graphql.InputObject
+	timeRange *graphql.InputObject
+	timeGrain *graphql.Enum
+}
+
+// newGraphQLSchemaBuilder creates a schema builder. The row limit caps the limit argument of metrics view fields; if it is 0, there is no cap.
+func newGraphQLSchemaBuilder(rowLimit int64) *graphQLSchemaBuilder {
+	value := graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "Value",
+		Description: "A string, number or boolean value.",
+		Serialize:   func(v any) any { return v },
+		ParseValue:  func(v any) any { return v },
+		ParseLiteral: func(v ast.Value) any {
+			switch v := v.(type) {
+			case *ast.StringValue:
+				return v.Value
+			case *ast.BooleanValue:
+				return v.Value
+			case *ast.IntValue:
+				n, err := strconv.ParseInt(v.Value, 10, 64)
+				if err != nil {
+					return nil
+				}
+				return n
+			case *ast.FloatValue:
+				n, err := strconv.ParseFloat(v.Value, 64)
+				if err != nil {
+					return nil
+				}
+				return n
+			default:
+				return nil
+			}
+		},
+	})
+
+	timeGrainValues := graphql.EnumValueConfigMap{}
+	for _, g := range []metricsview.TimeGrain{
+		metricsview.TimeGrainMillisecond,
+		metricsview.TimeGrainSecond,
+		metricsview.TimeGrainMinute,
+		metricsview.TimeGrainHour,
+		metricsview.TimeGrainDay,
+		metricsview.TimeGrainWeek,
+		metricsview.TimeGrainMonth,
+		metricsview.TimeGrainQuarter,
+		metricsview.TimeGrainYear,
+	} {
+		timeGrainValues[strings.ToUpper(string(g))] = &graphql.EnumValueConfig{Value: string(g)}
+	}
+	timeGrain := graphql.NewEnum(graphql.EnumConfig{
+		Name:   "TimeGrain",
+		Values: timeGrainValues,
+	})
+
+	filter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "FieldFilter",
+		Description: "Conditions on a dimension or measure. Multiple conditions are combined with AND.",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"eq":     {Type: value},
+			"neq":    {Type: value},
+			"lt":     {Type: value},
+			"lte":    {Type: value},
+			"gt":     {Type: value},
+			"gte":    {Type: value},
+			"in":     {Type: graphql.NewList(value)},
+			"nin":    {Type: graphql.NewList(value)},
+			"ilike":  {Type: graphql.String},
+			"nilike": {Type: graphql.String},
+		},
+	})
+
+	timeRange := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "TimeRange",
+		Description: "A time range. Either start and end timestamps, a Rill time expression, or an ISO 8601 duration and offset.",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"start":          {Type: graphql.String, Description: "Inclusive start as an RFC 3339 timestamp."},
+			"end":            {Type: graphql.String, Description: "Exclusive end as an RFC 3339 timestamp."},
+			"expression":     {Type: graphql.String, Description: "A Rill time expression, such as 7D."},
+			"iso_duration":   {Type: graphql.String},
+			"iso_offset":     {Type: graphql.String},
+			"round_to_grain": {Type: timeGrain},
+		},
+	})
+
+	b := &graphQLSchemaBuilder{
+		rowLimit:  rowLimit,
+		fields:    graphql.Fields{},
+		typeNames: make(map[string]bool),
+		value:     value,
+		filter:    filter,
+		timeRange: timeRange,
+		timeGrain: timeGrain,
+	}
+	for _, n := range []string{"Query", "String", "Int", "Float", "Boolean", "ID", value.Name(), filter.Name(), timeRange.Name(), timeGrain.Name()} {
+		b.typeNames[n] = true
+	}
+	return b
+}
+
+// addMetricsView adds a query field and the types for a metrics view.
+// Metrics views and fields with names that can't be represented in GraphQL are skipped.
+func (b *graphQLSchemaBuilder) addMetricsView(name string, mv *runtimev1.MetricsViewSpec, schema *runtimev1.StructType, resolve graphQLMetricsViewResolver) {
+	typeName, ok := graphQLName(name)
+	if !ok || b.typeNames[typeName] {
+		return
+	}
+	filterName := typeName + "_filter"
+	havingName := typeName + "_having"
+	sortName := typeName + "_sort"
+	sortFieldName := typeName + "_field"
+	for _, n := range []string{filterName, havingName, sortName, sortFieldName} {
+		if b.typeNames[n] {
+			return
+		}
+	}
+
+	// Map the fields to GraphQL names
+	fields := graphql.Fields{}
+	dims := make(map[string]string)                // GraphQL name -> dimension name
+	measures := make(map[string]string)            // GraphQL name -> measure name
+	comparisons := make(map[string]map[string]any) // GraphQL name -> measure compute
+	whereFields := graphql.InputObjectConfigFieldMap{}
+	havingFields := graphql.InputObjectConfigFieldMap{}
+	sortValues := graphql.EnumValueConfigMap{}
+	for _, f := range schema.Fields {
+		n, ok := graphQLName(f.Name)
+		if !ok || fields[n] != nil {
+			continue
+		}
+
+		isMeasure := false
+		var description string
+		for _, m := range mv.Measures {
+			if m.Name == f.Name {
+				isMeasure = true
+				description = m.Description
+				break
+			}
+		}
+		for _, d := range mv.Dimensions {
+			if d.Name == f.Name {
+				description = d.Description
+				break
+			}
+		}
+
+		key := f.Name
+		fields[n] = &graphql.Field{
+			Type:        graphQLType(f.Type, b.value),
+			Description: description,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				// The GraphQL name may differ from the field name in the resolver's output
+				row, _ := p.Source.(map[string]any)
+				return row[key], nil
+			},
+		}
+		sortValues[n] = &graphql.EnumValueConfig{Value: f.Name}
+		if !isMeasure {
+			dims[n] = f.Name
+			whereFields[n] = &graphql.InputObjectFieldConfig{Type: b.filter}
+			continue
+		}
+
+		measures[n] = f.Name
+		havingFields[n] = &graphql.InputObjectFieldConfig{Type: b.filter}
+		for suffix, compute := range map[string]string{
+			graphQLComparisonValueSuffix: "comparison_value",
+			graphQLComparisonDeltaSuffix: "comparison_delta",
+			graphQLComparisonRatioSuffix: "comparison_ratio",
+		} {
+			comparisons[n+suffix] = map[string]any{compute: map[string]any{"measure": f.Name}}
+		}
+	}
+	for n := range comparisons {
+		if fields[n] != nil {
+			delete(comparisons, n)
+			continue
+		}
+		fields[n] = &graphql.Field{Type: graphql.Float}
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	rowType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        typeName,
+		Description: mv.Description,
+		Fields:      fields,
+	})
+
+	// The filter types are recursive to support AND and OR conditions
+	var whereType, havingType *graphql.InputObject
+	whereType = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: filterName,
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			whereFields["and"] = &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(whereType))}
+			whereFields["or"] = &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(whereType))}
+			return whereFields
+		}),
+	})
+	havingType = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: havingName,
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			havingFields["and"] = &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(havingType))}
+			havingFields["or"] = &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(havingType))}
+			return havingFields
+		}),
+	})
+
+	sortType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: sortName,
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field": {Type: graphql.NewNonNull(graphql.NewEnum(graphql.EnumConfig{Name: sortFieldName, Values: sortValues}))},
+			"desc":  {Type: graphql.Boolean, DefaultValue: false},
+		},
+	})
+
+	args := graphql.FieldConfigArgument{
+		"where":                 {Type: whereType, Description: "Filter on dimensions."},
+		"having":                {Type: havingType, Description: "Filter on measures."},
+		"sort":                  {Type: graphql.NewList(graphql.NewNonNull(sortType))},
+		"limit":                 {Type: graphql.Int, Description: fmt.Sprintf("Maximum number of rows to return. Defaults to %d.", b.defaultLimit())},
+		"offset":                {Type: graphql.Int},
+		"time_range":            {Type: b.timeRange},
+		"comparison_time_range": {Type: b.timeRange, Description: "Time range for the comparison fields of measures."},
+		"time_zone":             {Type: graphql.String},
+	}
+	if mv.TimeDimension != "" {
+		args["time_grain"] = &graphql.ArgumentConfig{Type: b.timeGrain, Description: fmt.Sprintf("Truncates the %q time dimension to a grain.", mv.TimeDimension)}
+	}
+
+	b.fields[typeName] = &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(rowType))),
+		Description: mv.Description,
+		Args:        args,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			q := &graphQLMetricsViewQuery{
+				metricsView:   name,
+				timeDimension: mv.TimeDimension,
+				dims:          dims,
+				measures:      measures,
+				comparisons:   comparisons,
+				defaultLimit:  b.defaultLimit(),
+				limitCap:      b.rowLimit,
+			}
+			props, err := q.props(p)
+			if err != nil {
+				return nil, err
+			}
+			return resolve(p, props)
+		},
+	}
+
+	for _, n := range []string{typeName, filterName, havingName, sortName, sortFieldName} {
+		b.typeNames[n] = true
+	}
+}
+
+// defaultLimit returns the limit to apply to metrics view fields that don't set the limit argument.
+func (b *graphQLSchemaBuilder) defaultLimit() int64 {
+	if b.rowLimit > 0 && b.rowLimit < graphQLDefaultLimit {
+		return b.rowLimit
+	}
+	return graphQLDefaultLimit
+}
+
+// build returns the schema for the metrics views added to the builder.
+func (b *graphQLSchemaBuilder) build() (*graphql.Schema, error) {
+	fields := b.fields
+	if len(fields) == 0 {
+		// A GraphQL schema must have at least one query field.
+		fields = graphql.Fields{
+			"_empty": {Type: graphql.Boolean, Description: "Placeholder when no metrics views are available."},
+		}
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: fields,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// graphQLMetricsViewQuery translates a GraphQL query field to properties for the "metrics" resolver.
+type graphQLMetricsViewQuery struct {
+	metricsView   string
+	timeDimension string
+	dims          map[string]string
+	measures      map[string]string
+	comparisons   map[string]map[string]any
+	defaultLimit  int64
+	limitCap      int64
+}
+
+func (q *graphQLMetricsViewQuery) props(p graphql.ResolveParams) (map[string]any, error) {
+	props := map[string]any{"metrics_view": q.metricsView}
+
+	// Only query the selected fields
+	var dims []map[string]any
+	var measures []map[string]any
+	for _, n := range graphQLSelectedFields(p) {
+		if d, ok := q.dims[n]; ok {
+			if d == q.timeDimension && p.Args["time_grain"] != nil {
+				dims = append(dims, map[string]any{
+					"name":    d,
+					"compute": map[string]any{"time_floor": map[string]any{"dimension": d, "grain": p.Args["time_grain"]}},
+				})
+			} else {
+				dims = append(dims, map[string]any{"name": d})
+			}
+		} else if m, ok := q.measures[n]; ok {
+			measures = append(measures, map[string]any{"name": m})
+		} else if c, ok := q.comparisons[n]; ok {
+			measures = append(measures, map[string]any{"name": n, "compute": c})
+		}
+	}
+	props["dimensions"] = dims
+	props["measures"] = measures
+
+	if v, ok := p.Args["where"].(map[string]any); ok {
+		expr, err := graphQLFilterExpression(v, q.dims)
+		if err != nil {
+			return nil, err
+		}
+		props["where"] = expr
+	}
+	if v, ok := p.Args["having"].(map[string]any); ok {
+		expr, err := graphQLFilterExpression(v, q.measures)
+		if err != nil {
+			return nil, err
+		}
+		props["having"] = expr
+	}
+
+	if v, ok := p.Args["sort"].([]any); ok {
+		var sorts []map[string]any
+		for _, s := range v {
+			s := s.(map[string]any)
+			sorts = append(sorts, map[string]any{"name": s["field"], "desc": s["desc"]})
+		}
+		props["sort"] = sorts
+	}
+
+	limit := q.defaultLimit
+	if v, ok := p.Args["limit"].(int); ok {
+		limit = int64(v)
+	}
+	if limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+	if q.limitCap > 0 && limit > q.limitCap {
+		return nil, fmt.Errorf("limit of %d rows exceeds the maximum of %d rows", limit, q.limitCap)
+	}
+	props["limit"] = limit
+
+	if v, ok := p.Args["offset"].(int); ok && v < 0 {
+		return nil, fmt.Errorf("offset must not be negative")
+	}
+
+	for _, k := range []string{"offset", "time_range", "comparison_time_range", "time_zone"} {
+		if v, ok := p.Args[k]; ok && v != nil {
+			props[k] = v
+		}
+	}
+
+	return props, nil
+}
+
+// graphQLFilterExpression converts a filter input object to a metrics view expression.
+// The names map the GraphQL field names of the filter to dimension or measure names.
+func graphQLFilterExpression(filter map[string]any, names map[string]string) (map[string]any, error) {
+	keys := make([]string, 0, len(filter))
+	for k := range filter {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var exprs []any
+	for _, k := range keys {
+		v := filter[k]
+		switch k {
+		case "and", "or":
+			list, _ := v.([]any)
+			var subexprs []any
+			for _, sub := range list {
+				expr, err := graphQLFilterExpression(sub.(map[string]any), names)
+				if err != nil {
+					return nil, err
+				}
+				if expr != nil {
+					subexprs = append(subexprs, expr)
+				}
+			}
+			if len(subexprs) > 0 {
+				exprs = append(exprs, map[string]any{"cond": map[string]any{"op": k, "exprs": subexprs}})
+			}
+		default:
+			name, ok := names[k]
+			if !ok {
+				return nil, fmt.Errorf("unknown filter field %q", k)
+			}
+			conds, _ := v.(map[string]any)
+			ops := make([]string, 0, len(conds))
+			for op := range conds {
+				ops = append(ops, op)
+			}
+			sort.Strings(ops)
+			for _, op := range ops {
+				exprs = append(exprs, map[string]any{"cond": map[string]any{
+					"op":    op,
+					"exprs": []any{map[string]any{"name": name}, map[string]any{"val": conds[op]}},
+				}})
+			}
+		}
+	}
+
+	switch len(exprs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return exprs[0].(map[string]any), nil
+	default:
+		return map[string]any{"cond": map[string]any{"op": "and", "exprs": exprs}}, nil
+	}
+}
+
+// graphQLSelectedFields returns the names of the fields selected on the rows returned by a query field.
+func graphQLSelectedFields(p graphql.ResolveParams) []string {
+	var res []string
+	seen := make(map[string]bool)
+	var visit func(set *ast.SelectionSet)
+	visit = func(set *ast.SelectionSet) {
+		if set == nil {
+			return
+		}
+		for _, sel := range set.Selections {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				n := sel.Name.Value
+				if !seen[n] {
+					seen[n] = true
+					res = append(res, n)
+				}
+			case *ast.InlineFragment:
+				visit(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				if def, ok := p.Info.Fragments[sel.Name.Value].(*ast.FragmentDefinition); ok {
+					visit(def.SelectionSet)
+				}
+			}
+		}
+	}
+	for _, f := range p.Info.FieldASTs {
+		visit(f.SelectionSet)
+	}
+	return res
+}
+
+// graphQLName converts a name to a valid GraphQL name.
+// It returns false for names that can't be converted, such as names that start with the reserved "__" prefix.
+func graphQLName(name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			sb.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+	res := sb.String()
+	if strings.HasPrefix(res, "__") {
+		return "", false
+	}
+	return res, true
+}
+
+// graphQLType returns the GraphQL output type for a metrics view field.
+func graphQLType(t *runtimev1.Type, value *graphql.Scalar) graphql.Output {
+	switch t.GetCode() {
+	case runtimev1.Type_CODE_BOOL:
+		return graphql.Boolean
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16:
+		return graphql.Int
+	case runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_DECIMAL:
+		// GraphQL's Int is 32-bit, so larger integers are represented as floats.
+		return graphql.Float
+	case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON:
+		return value
+	default:
+		return graphql.String
+	}
+}
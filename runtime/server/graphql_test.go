@@ -0,0 +1,128 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGraphQL(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 1 AS val
+UNION ALL
+SELECT 'DK' AS country, 2 AS val
+UNION ALL
+SELECT 'SE' AS country, 3 AS val
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(val)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+	handler, err := srv.HTTPHandler(context.Background(), nil)
+	require.NoError(t, err)
+
+	do := func(q string, vars map[string]any) map[string]any {
+		body, err := json.Marshal(map[string]any{"query": q, "variables": vars})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/v1/instances/%s/graphql", instanceID), bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		res := make(map[string]any)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res
+	}
+	query := func(q string, vars map[string]any) map[string]any {
+		res := do(q, vars)
+		require.Nil(t, res["errors"])
+		return res["data"].(map[string]any)
+	}
+	queryErr := func(q string) string {
+		res := do(q, nil)
+		errs, _ := res["errors"].([]any)
+		require.NotEmpty(t, errs)
+		return errs[0].(map[string]any)["message"].(string)
+	}
+
+	// Filter and sort
+	data := query(`query($countries: [Value]) {
+  mv1(where: {country: {in: $countries}}, sort: [{field: total, desc: true}]) {
+    country
+    total
+  }
+}`, map[string]any{"countries": []string{"US", "SE"}})
+	require.Equal(t, []any{
+		map[string]any{"country": "SE", "total": float64(3)},
+		map[string]any{"country": "US", "total": float64(1)},
+	}, data["mv1"])
+
+	// Measure filter with a fragment
+	data = query(`
+query { mv1(having: {total: {gte: 2}}, sort: [{field: country}]) { ...fields } }
+fragment fields on mv1 { country }
+`, nil)
+	require.Equal(t, []any{
+		map[string]any{"country": "DK"},
+		map[string]any{"country": "SE"},
+	}, data["mv1"])
+
+	// Introspection
+	data = query(`{ __type(name: "mv1") { fields { name } } }`, nil)
+	var names []string
+	for _, f := range data["__type"].(map[string]any)["fields"].([]any) {
+		names = append(names, f.(map[string]any)["name"].(string))
+	}
+	require.ElementsMatch(t, []string{"country", "total", "total__prev", "total__delta_abs", "total__delta_rel"}, names)
+
+	// Limits are capped at the instance's interactive row limit
+	data = query(`{ mv1(limit: 1, sort: [{field: country}]) { country } }`, nil)
+	require.Equal(t, []any{map[string]any{"country": "DK"}}, data["mv1"])
+	require.Contains(t, queryErr(`{ mv1(limit: 1000000) { country } }`), "exceeds the maximum of 10000 rows")
+
+	// Queries with too many metrics view fields are rejected before they're executed
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i := 0; i < 11; i++ {
+		fmt.Fprintf(&sb, " a%d: mv1 { country }", i)
+	}
+	sb.WriteString(" }")
+	require.Contains(t, queryErr(sb.String()), "maximum of 10 metrics view fields")
+
+	// Repeated fragment spreads count towards the selection limit
+	require.Contains(t, queryErr(`
+{ mv1 { ...f4 } }
+fragment f1 on mv1 { country total total__prev total__delta_abs total__delta_rel }
+fragment f2 on mv1 { ...f1 ...f1 ...f1 ...f1 ...f1 ...f1 ...f1 ...f1 }
+fragment f3 on mv1 { ...f2 ...f2 ...f2 ...f2 ...f2 ...f2 ...f2 ...f2 }
+fragment f4 on mv1 { ...f3 ...f3 ...f3 ...f3 ...f3 ...f3 ...f3 ...f3 }
+`), "maximum of 1000 selections")
+}
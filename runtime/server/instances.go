@@ -159,6 +159,7 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 		Environment:          valOrDefault(req.Environment, oldInst.Environment),
 		OLAPConnector:        valOrDefault(req.OlapConnector, oldInst.OLAPConnector),
 		ProjectOLAPConnector: oldInst.ProjectOLAPConnector,
+		ProjectAIConnector:   oldInst.ProjectAIConnector,
 		RepoConnector:        valOrDefault(req.RepoConnector, oldInst.RepoConnector),
 		AdminConnector:       valOrDefault(req.AdminConnector, oldInst.AdminConnector),
 		AIConnector:          valOrDefault(req.AiConnector, oldInst.AIConnector),
@@ -170,6 +171,7 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 		Annotations:          annotations,
 		EmbedCatalog:         valOrDefault(req.EmbedCatalog, oldInst.EmbedCatalog),
 		WatchRepo:            valOrDefault(req.WatchRepo, oldInst.WatchRepo),
+		NotificationPolicy:   oldInst.NotificationPolicy,
 	}
 
 	err = s.runtime.EditInstance(ctx, inst, true)
@@ -286,7 +288,7 @@ func instanceToPB(inst *drivers.Instance, sensitive bool) *runtimev1.Instance {
 		pb.OlapConnector = olapConnector
 		pb.RepoConnector = inst.RepoConnector
 		pb.AdminConnector = inst.AdminConnector
-		pb.AiConnector = inst.AIConnector
+		pb.AiConnector = inst.ResolveAIConnector()
 		pb.Connectors = inst.Connectors
 		pb.ProjectConnectors = inst.ProjectConnectors
 		pb.Variables = inst.Variables
@@ -0,0 +1,672 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pgServerVersion is the PostgreSQL version reported to clients.
+// Some clients change their behavior based on the version, so we report a recent version.
+const pgServerVersion = "15.0"
+
+// pgMaxConns is the maximum number of concurrent connections to the PostgreSQL wire protocol server.
+const pgMaxConns = 100
+
+// pgStartupTimeout is the time a client has to complete the startup and authentication flow.
+const pgStartupTimeout = 30 * time.Second
+
+// pgIdleTimeout is the time after which a connection that hasn't sent any messages is closed.
+const pgIdleTimeout = 30 * time.Minute
+
+// ServePGWire starts a server for the PostgreSQL wire protocol on the port configured in Options.PGWirePort.
+// Clients connect with the instance ID as the database name and a runtime JWT as the password (if auth is enabled).
+// Metrics views are exposed as tables, which can be queried with Metrics SQL and listed through the information schema and pg_catalog.
+// If a TLS certificate is configured, clients can upgrade to TLS. When auth is enabled, passwords are only accepted over TLS.
+func (s *Server) ServePGWire(ctx context.Context) error {
+	certPath, keyPath := s.opts.PGWireTLSCertPath, s.opts.PGWireTLSKeyPath
+	if certPath == "" && keyPath == "" {
+		certPath, keyPath = s.opts.TLSCertPath, s.opts.TLSKeyPath
+	}
+
+	var tlsConfig *tls.Config
+	if certPath != "" || keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return fmt.Errorf("pgwire: failed to load TLS certificate: %w", err)
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.opts.PGWirePort))
+	if err != nil {
+		if strings.Contains(err.Error(), "address already in use") {
+			return fmt.Errorf("pgwire port %d is in use by another process", s.opts.PGWirePort)
+		}
+		return err
+	}
+	s.logger.Sugar().Infof("serving PostgreSQL wire protocol on port:%v", s.opts.PGWirePort)
+
+	stop := context.AfterFunc(ctx, func() { _ = lis.Close() })
+	defer stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	sem := make(chan struct{}, pgMaxConns)
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		select {
+		case sem <- struct{}{}:
+		default:
+			rejectPGConn(conn, newPGError("53300", "too many connections"))
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			c := &pgConn{
+				server:    s,
+				conn:      conn,
+				backend:   pgproto3.NewBackend(conn, conn),
+				tlsConfig: tlsConfig,
+				typeMap:   pgtype.NewMap(),
+				stmts:     make(map[string]*pgStatement),
+				portals:   make(map[string]*pgPortal),
+			}
+			c.serve(ctx)
+		}()
+	}
+}
+
+// rejectPGConn sends a fatal error to a new connection and closes it.
+func rejectPGConn(conn net.Conn, err error) {
+	defer conn.Close()
+	_ = conn.SetWriteDeadline(time.Now().Add(time.Second))
+	c := &pgConn{backend: pgproto3.NewBackend(conn, conn)}
+	c.sendError(err, "FATAL")
+	_ = c.backend.Flush()
+}
+
+// pgConn is a client connection to the PostgreSQL wire protocol server.
+type pgConn struct {
+	server    *Server
+	conn      net.Conn
+	backend   *pgproto3.Backend
+	tlsConfig *tls.Config
+	typeMap   *pgtype.Map
+	parser    *parser.Parser
+	// catalog is created on the first catalog query
+	catalog *pgCatalog
+
+	// Set during startup
+	ctx        context.Context
+	instanceID string
+	params     map[string]string
+	tls        bool
+
+	// State for the extended query protocol
+	stmts      map[string]*pgStatement
+	portals    map[string]*pgPortal
+	skipToSync bool
+}
+
+// pgStatement is a prepared statement created with a Parse message.
+type pgStatement struct {
+	sql       string
+	paramOIDs []uint32
+}
+
+// pgPortal is a portal created with a Bind message.
+type pgPortal struct {
+	sql           string
+	resultFormats []int16
+	result        *pgResult
+}
+
+// pgError is an error with a PostgreSQL error code.
+type pgError struct {
+	code    string
+	message string
+}
+
+func (e *pgError) Error() string {
+	return e.message
+}
+
+// newPGError returns a pgError with the given PostgreSQL error code.
+func newPGError(code, format string, args ...any) error {
+	return &pgError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// serve handles the connection until the client disconnects or ctx is cancelled.
+func (c *pgConn) serve(ctx context.Context) {
+	defer func() {
+		_ = c.conn.Close()
+		if c.catalog != nil {
+			c.catalog.close()
+		}
+	}()
+	// The connection may be replaced by a TLS connection during startup, so we close the underlying connection on cancellation.
+	conn := c.conn
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	// Parsers are not goroutine safe, so we keep one for each connection.
+	c.parser = parser.New()
+	c.parser.SetSQLMode(mysql.ModeANSI | mysql.ModeANSIQuotes)
+
+	_ = c.conn.SetDeadline(time.Now().Add(pgStartupTimeout))
+	err := c.startup(ctx)
+	if err != nil {
+		c.sendError(err, "FATAL")
+		_ = c.backend.Flush()
+		return
+	}
+	_ = c.conn.SetDeadline(time.Time{})
+
+	for {
+		_ = c.conn.SetReadDeadline(time.Now().Add(pgIdleTimeout))
+		msg, err := c.backend.Receive()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				c.server.logger.Debug("pgwire: failed to receive message", zap.Error(err))
+			}
+			return
+		}
+
+		if _, ok := msg.(*pgproto3.Terminate); ok {
+			return
+		}
+
+		c.handle(msg)
+
+		err = c.backend.Flush()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// startup handles the startup message and authentication.
+func (c *pgConn) startup(ctx context.Context) error {
+	var startup *pgproto3.StartupMessage
+	for startup == nil {
+		msg, err := c.backend.ReceiveStartupMessage()
+		if err != nil {
+			return err
+		}
+
+		switch msg := msg.(type) {
+		case *pgproto3.StartupMessage:
+			startup = msg
+		case *pgproto3.SSLRequest:
+			if c.tlsConfig == nil || c.tls {
+				_, err := c.conn.Write([]byte("N"))
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			_, err := c.conn.Write([]byte("S"))
+			if err != nil {
+				return err
+			}
+			conn := tls.Server(c.conn, c.tlsConfig)
+			err = conn.HandshakeContext(ctx)
+			if err != nil {
+				return err
+			}
+			c.conn = conn
+			c.backend = pgproto3.NewBackend(conn, conn)
+			c.tls = true
+		case *pgproto3.GSSEncRequest:
+			// GSSAPI encryption is not supported. Clients fall back to TLS or unencrypted connections.
+			_, err := c.conn.Write([]byte("N"))
+			if err != nil {
+				return err
+			}
+		case *pgproto3.CancelRequest:
+			return newPGError("0A000", "cancel requests are not supported")
+		default:
+			return newPGError("08P01", "unexpected startup message %T", msg)
+		}
+	}
+
+	c.params = make(map[string]string)
+	for k, v := range startup.Parameters {
+		c.params[strings.ToLower(k)] = v
+	}
+
+	c.instanceID = startup.Parameters["database"]
+	if c.instanceID == "" {
+		return newPGError("3D000", "no database specified: use the instance ID as the database name")
+	}
+
+	// Authenticate using the password as the token.
+	// If auth is disabled, we skip the password prompt.
+	var token string
+	if c.server.aud != nil {
+		if !c.tls {
+			return newPGError("28000", "password authentication requires an SSL connection")
+		}
+
+		c.backend.Send(&pgproto3.AuthenticationCleartextPassword{})
+		err := c.backend.Flush()
+		if err != nil {
+			return err
+		}
+
+		err = c.backend.SetAuthType(pgproto3.AuthTypeCleartextPassword)
+		if err != nil {
+			return err
+		}
+		msg, err := c.backend.Receive()
+		if err != nil {
+			return err
+		}
+		pw, ok := msg.(*pgproto3.PasswordMessage)
+		if !ok {
+			return newPGError("08P01", "expected password message, got %T", msg)
+		}
+		token = pw.Password
+	}
+
+	// The instance is only looked up after authenticating, and all failures return the same error to avoid revealing which instances exist.
+	authErr := newPGError("28000", "authentication failed or database %q does not exist", c.instanceID)
+	var err error
+	c.ctx, err = auth.WithToken(ctx, c.server.aud, token)
+	if err != nil {
+		return authErr
+	}
+	if !auth.GetClaims(c.ctx).CanInstance(c.instanceID, auth.ReadMetrics) {
+		return authErr
+	}
+	_, err = c.server.runtime.Instance(c.ctx, c.instanceID)
+	if err != nil {
+		return authErr
+	}
+
+	var key [8]byte
+	_, err = rand.Read(key[:])
+	if err != nil {
+		return err
+	}
+
+	c.backend.Send(&pgproto3.AuthenticationOk{})
+	for _, k := range []string{"server_version", "server_encoding", "client_encoding", "DateStyle", "TimeZone", "integer_datetimes", "standard_conforming_strings"} {
+		c.backend.Send(&pgproto3.ParameterStatus{Name: k, Value: c.param(k)})
+	}
+	c.backend.Send(&pgproto3.BackendKeyData{ProcessID: binary.BigEndian.Uint32(key[:4]), SecretKey: binary.BigEndian.Uint32(key[4:])})
+	c.backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	return c.backend.Flush()
+}
+
+// param returns the value of a session parameter.
+func (c *pgConn) param(name string) string {
+	name = strings.ToLower(name)
+	switch name {
+	case "server_version":
+		return pgServerVersion
+	case "server_encoding", "client_encoding":
+		return "UTF8"
+	case "integer_datetimes", "standard_conforming_strings":
+		return "on"
+	}
+	if v, ok := c.params[name]; ok {
+		return v
+	}
+	switch name {
+	case "datestyle":
+		return "ISO, MDY"
+	case "timezone":
+		return "UTC"
+	case "transaction_isolation", "default_transaction_isolation":
+		return "read committed"
+	case "search_path":
+		return "public"
+	}
+	return ""
+}
+
+// handle handles a message received after startup.
+func (c *pgConn) handle(msg pgproto3.FrontendMessage) {
+	// After an error in the extended query protocol, messages are discarded until the next Sync.
+	if c.skipToSync {
+		if _, ok := msg.(*pgproto3.Sync); !ok {
+			return
+		}
+	}
+
+	var err error
+	switch msg := msg.(type) {
+	case *pgproto3.Query:
+		c.handleQuery(msg)
+		return
+	case *pgproto3.Parse:
+		err = c.handleParse(msg)
+	case *pgproto3.Bind:
+		err = c.handleBind(msg)
+	case *pgproto3.Describe:
+		err = c.handleDescribe(msg)
+	case *pgproto3.Execute:
+		err = c.handleExecute(msg)
+	case *pgproto3.Close:
+		c.handleClose(msg)
+	case *pgproto3.Sync:
+		c.skipToSync = false
+		c.closePortals()
+		c.backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	case *pgproto3.Flush:
+		// Messages are flushed after each message is handled.
+	default:
+		err = newPGError("0A000", "unsupported message type %T", msg)
+	}
+
+	if err != nil {
+		c.sendError(err, "ERROR")
+		c.skipToSync = true
+	}
+}
+
+// handleQuery handles a query in the simple query protocol.
+func (c *pgConn) handleQuery(msg *pgproto3.Query) {
+	defer c.backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+
+	stmts := splitPGStatements(msg.String)
+	if len(stmts) == 0 {
+		c.backend.Send(&pgproto3.EmptyQueryResponse{})
+		return
+	}
+
+	for _, sql := range stmts {
+		res, err := c.execute(sql)
+		if err != nil {
+			c.sendError(err, "ERROR")
+			return
+		}
+
+		if res.fields != nil {
+			c.backend.Send(res.rowDescription(nil))
+		}
+		err = c.sendRows(res, nil, 0)
+		if err != nil {
+			c.sendError(err, "ERROR")
+			return
+		}
+	}
+}
+
+// handleParse handles a Parse message in the extended query protocol.
+func (c *pgConn) handleParse(msg *pgproto3.Parse) error {
+	c.stmts[msg.Name] = &pgStatement{
+		sql:       msg.Query,
+		paramOIDs: msg.ParameterOIDs,
+	}
+	c.backend.Send(&pgproto3.ParseComplete{})
+	return nil
+}
+
+// handleBind handles a Bind message in the extended query protocol.
+func (c *pgConn) handleBind(msg *pgproto3.Bind) error {
+	stmt, ok := c.stmts[msg.PreparedStatement]
+	if !ok {
+		return newPGError("26000", "prepared statement %q does not exist", msg.PreparedStatement)
+	}
+
+	args := make([]*pgParam, len(msg.Parameters))
+	for i, p := range msg.Parameters {
+		var format int16
+		switch len(msg.ParameterFormatCodes) {
+		case 0:
+		case 1:
+			format = msg.ParameterFormatCodes[0]
+		default:
+			format = msg.ParameterFormatCodes[i]
+		}
+
+		var oid uint32
+		if i < len(stmt.paramOIDs) {
+			oid = stmt.paramOIDs[i]
+		}
+
+		v, err := c.decodeParam(oid, format, p)
+		if err != nil {
+			return newPGError("22P02", "invalid value for parameter $%d: %s", i+1, err.Error())
+		}
+		args[i] = v
+	}
+
+	sql, err := bindPGParams(stmt.sql, args)
+	if err != nil {
+		return err
+	}
+
+	if prev, ok := c.portals[msg.DestinationPortal]; ok {
+		prev.close()
+	}
+	c.portals[msg.DestinationPortal] = &pgPortal{
+		sql:           sql,
+		resultFormats: msg.ResultFormatCodes,
+	}
+	c.backend.Send(&pgproto3.BindComplete{})
+	return nil
+}
+
+// handleDescribe handles a Describe message in the extended query protocol.
+func (c *pgConn) handleDescribe(msg *pgproto3.Describe) error {
+	switch msg.ObjectType {
+	case 'S':
+		stmt, ok := c.stmts[msg.Name]
+		if !ok {
+			return newPGError("26000", "prepared statement %q does not exist", msg.Name)
+		}
+
+		// Report the parameters' types, defaulting to text for parameters where the client didn't specify a type.
+		n := countPGParams(stmt.sql)
+		oids := make([]uint32, n)
+		for i := range oids {
+			if i < len(stmt.paramOIDs) && stmt.paramOIDs[i] != 0 {
+				oids[i] = stmt.paramOIDs[i]
+			} else {
+				oids[i] = pgtype.TextOID
+			}
+		}
+		c.backend.Send(&pgproto3.ParameterDescription{ParameterOIDs: oids})
+
+		// To describe the result, we need to run the query. We bind NULL for all parameters.
+		sql, err := bindPGParams(stmt.sql, make([]*pgParam, n))
+		if err != nil {
+			return err
+		}
+		res, err := c.execute(sql)
+		if err != nil {
+			return err
+		}
+		res.close()
+
+		if res.fields == nil {
+			c.backend.Send(&pgproto3.NoData{})
+		} else {
+			c.backend.Send(res.rowDescription(nil))
+		}
+		return nil
+	case 'P':
+		p, ok := c.portals[msg.Name]
+		if !ok {
+			return newPGError("34000", "portal %q does not exist", msg.Name)
+		}
+
+		// Execute the portal's query and keep the result for the subsequent Execute message.
+		if p.result == nil {
+			res, err := c.execute(p.sql)
+			if err != nil {
+				return err
+			}
+			p.result = res
+		}
+
+		if p.result.fields == nil {
+			c.backend.Send(&pgproto3.NoData{})
+		} else {
+			c.backend.Send(p.result.rowDescription(p.resultFormats))
+		}
+		return nil
+	default:
+		return newPGError("08P01", "invalid describe object type %q", msg.ObjectType)
+	}
+}
+
+// handleExecute handles an Execute message in the extended query protocol.
+func (c *pgConn) handleExecute(msg *pgproto3.Execute) error {
+	p, ok := c.portals[msg.Portal]
+	if !ok {
+		return newPGError("34000", "portal %q does not exist", msg.Portal)
+	}
+
+	if p.result == nil {
+		res, err := c.execute(p.sql)
+		if err != nil {
+			return err
+		}
+		p.result = res
+	}
+
+	return c.sendRows(p.result, p.resultFormats, int(msg.MaxRows))
+}
+
+// handleClose handles a Close message in the extended query protocol.
+func (c *pgConn) handleClose(msg *pgproto3.Close) {
+	switch msg.ObjectType {
+	case 'S':
+		delete(c.stmts, msg.Name)
+	case 'P':
+		if p, ok := c.portals[msg.Name]; ok {
+			p.close()
+			delete(c.portals, msg.Name)
+		}
+	}
+	c.backend.Send(&pgproto3.CloseComplete{})
+}
+
+// closePortals closes all open portals. Portals only live until the end of a transaction, which is implicit at each Sync.
+func (c *pgConn) closePortals() {
+	for k, p := range c.portals {
+		p.close()
+		delete(c.portals, k)
+	}
+}
+
+func (p *pgPortal) close() {
+	if p.result != nil {
+		p.result.close()
+	}
+}
+
+// sendRows sends the rows of a result followed by CommandComplete.
+// If maxRows is positive and the result has more rows, it sends PortalSuspended instead of CommandComplete.
+func (c *pgConn) sendRows(res *pgResult, formats []int16, maxRows int) error {
+	if res.fields == nil {
+		res.close()
+		c.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(res.tag)})
+		return nil
+	}
+
+	for i := 0; maxRows <= 0 || i < maxRows; i++ {
+		row, err := res.next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				res.close()
+				c.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(fmt.Sprintf("SELECT %d", res.count))})
+				return nil
+			}
+			res.close()
+			return err
+		}
+
+		values := make([][]byte, len(row))
+		for j, v := range row {
+			values[j], err = c.encodeValue(res.fields[j].oid, resultFormat(formats, j), v)
+			if err != nil {
+				res.close()
+				return fmt.Errorf("failed to encode column %q: %w", res.fields[j].name, err)
+			}
+		}
+		c.backend.Send(&pgproto3.DataRow{Values: values})
+	}
+
+	c.backend.Send(&pgproto3.PortalSuspended{})
+	return nil
+}
+
+// sendError sends an ErrorResponse for the error.
+func (c *pgConn) sendError(err error, severity string) {
+	code := "XX000"
+	var pgErr *pgError
+	switch {
+	case errors.As(err, &pgErr):
+		code = pgErr.code
+	case errors.Is(err, ErrForbidden), errors.Is(err, runtime.ErrForbidden), errors.Is(err, queries.ErrForbidden):
+		code = "42501"
+	case errors.As(err, &runtime.QueryBudgetExceededError{}):
+		code = "53000"
+	case errors.Is(err, context.DeadlineExceeded):
+		code = "57014"
+	}
+
+	msg := err.Error()
+	if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
+		msg = s.Message()
+	}
+
+	c.backend.Send(&pgproto3.ErrorResponse{
+		Severity:            severity,
+		SeverityUnlocalized: severity,
+		Code:                code,
+		Message:             msg,
+	})
+}
+
+// resultFormat returns the format code for the i'th column given the format codes from a Bind message.
+func resultFormat(formats []int16, i int) int16 {
+	switch len(formats) {
+	case 0:
+		return pgtype.TextFormatCode
+	case 1:
+		return formats[0]
+	default:
+		if i < len(formats) {
+			return formats[i]
+		}
+		return pgtype.TextFormatCode
+	}
+}
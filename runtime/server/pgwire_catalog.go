@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/marcboeker/go-duckdb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/proto"
+)
+
+// pgCatalogQueryTimeout is the maximum duration of a catalog query.
+const pgCatalogQueryTimeout = 30 * time.Second
+
+// pgCatalogRowLimit is the maximum number of rows returned by a catalog query.
+const pgCatalogRowLimit = 10000
+
+// pgCatalog is an in-memory DuckDB database that serves catalog queries for a connection.
+// It contains an empty view for each metrics view the user has access to in a database named after the instance,
+// which makes DuckDB's information schema and pg_catalog describe the metrics views as they appear to PostgreSQL clients.
+// The database has no access to external data and its configuration is locked, so clients can run arbitrary queries against it.
+type pgCatalog struct {
+	db *sql.DB
+	// views maps the name of each view in the database to the schema it was created with.
+	views map[string]*runtimev1.StructType
+}
+
+// newPGCatalog creates a new catalog database for the given instance.
+func newPGCatalog(ctx context.Context, instanceID string) (*pgCatalog, error) {
+	database := drivers.DialectDuckDB.EscapeIdentifier(instanceID)
+	connector, err := duckdb.NewConnector("", func(conn driver.ExecerContext) error {
+		for _, qry := range []string{
+			fmt.Sprintf("ATTACH IF NOT EXISTS ':memory:' AS %s", database),
+			fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s.%s", database, pgSchemaName),
+			fmt.Sprintf("USE %s.%s", database, pgSchemaName),
+		} {
+			_, err := conn.ExecContext(ctx, qry, nil)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Using a single connection since the database is only used by one client connection at a time
+	db := sql.OpenDB(connector)
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxIdleTime(0)
+	db.SetConnMaxLifetime(0)
+
+	for _, qry := range []string{
+		"SET threads=1",
+		"SET memory_limit='64MB'",
+		"SET enable_external_access=false",
+		"SET lock_configuration=true",
+	} {
+		_, err := db.ExecContext(ctx, qry)
+		if err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
+	return &pgCatalog{db: db, views: make(map[string]*runtimev1.StructType)}, nil
+}
+
+// close closes the catalog database.
+func (c *pgCatalog) close() {
+	_ = c.db.Close()
+}
+
+// sync creates, replaces and drops views so the database contains exactly the given metrics views.
+func (c *pgCatalog) sync(ctx context.Context, views map[string]*runtimev1.StructType) error {
+	for name := range c.views {
+		if _, ok := views[name]; ok {
+			continue
+		}
+		_, err := c.db.ExecContext(ctx, fmt.Sprintf("DROP VIEW IF EXISTS %s", drivers.DialectDuckDB.EscapeIdentifier(name)))
+		if err != nil {
+			return err
+		}
+		delete(c.views, name)
+	}
+
+	for name, schema := range views {
+		if prev, ok := c.views[name]; ok && proto.Equal(prev, schema) {
+			continue
+		}
+		_, err := c.db.ExecContext(ctx, pgCatalogViewSQL(name, schema))
+		if err != nil {
+			return err
+		}
+		c.views[name] = proto.Clone(schema).(*runtimev1.StructType)
+	}
+
+	return nil
+}
+
+// query runs a query and buffers its result.
+// The result is buffered since the database only has one connection, which must be released before the next query.
+func (c *pgCatalog) query(ctx context.Context, qry string) (*pgResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, pgCatalogQueryTimeout)
+	defer cancel()
+
+	rows, err := c.db.QueryContext(ctx, qry)
+	if err != nil {
+		return nil, newPGError("42000", "%s", err.Error())
+	}
+	defer rows.Close()
+
+	cts, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]pgField, len(cts))
+	for i, ct := range cts {
+		fields[i] = pgField{name: ct.Name(), oid: pgDuckDBTypeOID(ct.DatabaseTypeName())}
+	}
+
+	var res [][]any
+	for rows.Next() {
+		if len(res) == pgCatalogRowLimit {
+			return nil, newPGError("54000", "catalog queries can return at most %d rows", pgCatalogRowLimit)
+		}
+
+		row := make([]any, len(cts))
+		ptrs := make([]any, len(cts))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		err := rows.Scan(ptrs...)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range row {
+			if d, ok := v.(duckdb.Decimal); ok {
+				row[i] = d.Float64()
+			}
+		}
+		res = append(res, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, newPGError("42000", "%s", err.Error())
+	}
+
+	return newStaticPGResult(fields, res), nil
+}
+
+// pgCatalogViewSQL returns a statement that creates an empty view with the columns of a metrics view.
+// Scanning the view fails with an error that explains how to query the metrics view, which is surfaced if a query that isn't valid Metrics SQL references it.
+func pgCatalogViewSQL(name string, schema *runtimev1.StructType) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE OR REPLACE VIEW %s AS SELECT ", drivers.DialectDuckDB.EscapeIdentifier(name))
+	for i, f := range schema.Fields {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "CAST(NULL AS %s) AS %s", pgTypeName(pgTypeOID(f.Type)), drivers.DialectDuckDB.EscapeIdentifier(f.Name))
+	}
+	msg := fmt.Sprintf("metrics view %q can only be queried with Metrics SQL", name)
+	fmt.Fprintf(&b, " FROM (SELECT error(%s)) AS t(e) WHERE t.e IS NULL", drivers.DialectDuckDB.EscapeStringValue(msg))
+	return b.String()
+}
+
+// pgDuckDBTypeOID returns the PostgreSQL type used for values of a DuckDB type.
+func pgDuckDBTypeOID(typ string) uint32 {
+	switch typ {
+	case "BOOLEAN":
+		return pgtype.BoolOID
+	case "TINYINT", "SMALLINT", "INTEGER", "BIGINT", "UTINYINT", "USMALLINT", "UINTEGER":
+		return pgtype.Int8OID
+	case "UBIGINT", "HUGEINT", "UHUGEINT", "FLOAT", "DOUBLE":
+		return pgtype.Float8OID
+	case "DATE":
+		return pgtype.DateOID
+	case "BLOB":
+		return pgtype.ByteaOID
+	}
+	switch {
+	case strings.HasPrefix(typ, "DECIMAL"):
+		return pgtype.Float8OID
+	case strings.HasPrefix(typ, "TIMESTAMP"):
+		return pgtype.TimestamptzOID
+	default:
+		return pgtype.TextOID
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPGCatalog(t *testing.T) {
+	ctx := context.Background()
+	c, err := newPGCatalog(ctx, "my-instance")
+	require.NoError(t, err)
+	defer c.close()
+
+	mv1 := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "country", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "total", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+	}}
+	mv2 := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "time", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+	}}
+	require.NoError(t, c.sync(ctx, map[string]*runtimev1.StructType{"mv1": mv1, "mv2": mv2}))
+
+	res, err := c.query(ctx, "SELECT table_catalog, table_schema, table_name, table_type FROM information_schema.tables ORDER BY table_name")
+	require.NoError(t, err)
+	require.Equal(t, [][]any{{"my-instance", "public", "mv1", "VIEW"}, {"my-instance", "public", "mv2", "VIEW"}}, pgResultRows(t, res))
+
+	res, err = c.query(ctx, "SELECT c.relname, c.relkind FROM pg_catalog.pg_class c JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace WHERE n.nspname = 'public' AND c.relname ~ '^(mv2)$'")
+	require.NoError(t, err)
+	require.Equal(t, [][]any{{"mv2", "v"}}, pgResultRows(t, res))
+
+	// Scanning a metrics view fails, but empty scans don't
+	_, err = c.query(ctx, "SELECT count(*) FROM mv1")
+	require.ErrorContains(t, err, `metrics view "mv1" can only be queried with Metrics SQL`)
+	res, err = c.query(ctx, "SELECT count(*) FROM mv1 WHERE false")
+	require.NoError(t, err)
+	require.Equal(t, [][]any{{int64(0)}}, pgResultRows(t, res))
+
+	// Views are dropped and replaced when the metrics views change
+	mv1.Fields = mv1.Fields[:1]
+	require.NoError(t, c.sync(ctx, map[string]*runtimev1.StructType{"mv1": mv1}))
+	res, err = c.query(ctx, "SELECT table_name, column_name FROM information_schema.columns ORDER BY table_name, ordinal_position")
+	require.NoError(t, err)
+	require.Equal(t, [][]any{{"mv1", "country"}}, pgResultRows(t, res))
+
+	// External access and configuration changes are not allowed
+	_, err = c.query(ctx, "SELECT * FROM read_csv('/etc/hosts')")
+	require.Error(t, err)
+	_, err = c.query(ctx, "SET enable_external_access=true")
+	require.Error(t, err)
+
+	// Results are capped
+	_, err = c.query(ctx, "SELECT * FROM range(100000)")
+	require.ErrorContains(t, err, "catalog queries can return at most")
+}
+
+func pgResultRows(t *testing.T, res *pgResult) [][]any {
+	var rows [][]any
+	for {
+		row, err := res.next()
+		if err != nil {
+			break
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,615 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/jsonval"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+)
+
+// pgSchemaName is the schema that metrics views appear in.
+const pgSchemaName = "public"
+
+// pgField describes a column in a result.
+type pgField struct {
+	name string
+	oid  uint32
+}
+
+// pgResult is the result of executing a statement.
+type pgResult struct {
+	// fields is nil for statements that don't return rows.
+	fields []pgField
+	// tag is the command tag for statements that don't return rows.
+	tag     string
+	nextFn  func() ([]any, error)
+	closeFn func()
+	count   int
+}
+
+// newStaticPGResult returns a pgResult that returns the given rows.
+func newStaticPGResult(fields []pgField, rows [][]any) *pgResult {
+	return &pgResult{
+		fields: fields,
+		nextFn: func() ([]any, error) {
+			if len(rows) == 0 {
+				return nil, io.EOF
+			}
+			row := rows[0]
+			rows = rows[1:]
+			return row, nil
+		},
+	}
+}
+
+// newTagPGResult returns a pgResult for a statement that doesn't return rows.
+func newTagPGResult(tag string) *pgResult {
+	return &pgResult{tag: tag}
+}
+
+// next returns the next row. It returns io.EOF when there are no more rows.
+func (r *pgResult) next() ([]any, error) {
+	row, err := r.nextFn()
+	if err != nil {
+		return nil, err
+	}
+	r.count++
+	return row, nil
+}
+
+// close releases the result's resources. It is safe to call multiple times.
+func (r *pgResult) close() {
+	if r.closeFn != nil {
+		r.closeFn()
+		r.closeFn = nil
+	}
+}
+
+// rowDescription returns a RowDescription message for the result given the format codes from a Bind message.
+func (r *pgResult) rowDescription(formats []int16) *pgproto3.RowDescription {
+	fields := make([]pgproto3.FieldDescription, len(r.fields))
+	for i, f := range r.fields {
+		fields[i] = pgproto3.FieldDescription{
+			Name:         []byte(f.name),
+			DataTypeOID:  f.oid,
+			DataTypeSize: pgTypeSize(f.oid),
+			TypeModifier: -1,
+			Format:       resultFormat(formats, i),
+		}
+	}
+	return &pgproto3.RowDescription{Fields: fields}
+}
+
+// execute executes a single SQL statement.
+// It handles session and transaction statements as no-ops, runs catalog queries against the connection's catalog database, and runs other queries as Metrics SQL.
+func (c *pgConn) execute(sql string) (*pgResult, error) {
+	sql = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(sql), ";"))
+	keyword, rest, _ := strings.Cut(sql, " ")
+	rest = strings.TrimSpace(rest)
+
+	switch strings.ToUpper(keyword) {
+	case "SET":
+		c.handleSet(rest)
+		return newTagPGResult("SET"), nil
+	case "RESET":
+		delete(c.params, strings.ToLower(rest))
+		return newTagPGResult("RESET"), nil
+	case "BEGIN", "START":
+		return newTagPGResult("BEGIN"), nil
+	case "COMMIT", "END":
+		return newTagPGResult("COMMIT"), nil
+	case "ROLLBACK", "ABORT":
+		return newTagPGResult("ROLLBACK"), nil
+	case "DISCARD":
+		return newTagPGResult("DISCARD ALL"), nil
+	case "DEALLOCATE":
+		return newTagPGResult("DEALLOCATE"), nil
+	case "SHOW":
+		name := strings.Trim(strings.ToLower(rest), `"`)
+		if name == "all" {
+			return nil, newPGError("0A000", "SHOW ALL is not supported")
+		}
+		return newStaticPGResult([]pgField{{name: name, oid: pgtype.TextOID}}, [][]any{{c.param(name)}}), nil
+	case "SELECT", "WITH":
+		return c.executeSelect(sql)
+	default:
+		return nil, newPGError("0A000", "unsupported statement: only SELECT queries are supported")
+	}
+}
+
+// handleSet stores a session parameter set with a statement like "SET [SESSION | LOCAL] name { TO | = } value".
+func (c *pgConn) handleSet(stmt string) {
+	upper := strings.ToUpper(stmt)
+	if strings.HasPrefix(upper, "SESSION ") || strings.HasPrefix(upper, "LOCAL ") {
+		_, stmt, _ = strings.Cut(stmt, " ")
+		stmt = strings.TrimSpace(stmt)
+		upper = strings.ToUpper(stmt)
+	}
+
+	var name, value string
+	if strings.HasPrefix(upper, "TIME ZONE ") {
+		name, value = "timezone", stmt[len("TIME ZONE "):]
+	} else if i := strings.IndexAny(stmt, "= "); i > 0 {
+		name, value = stmt[:i], strings.TrimSpace(stmt[i+1:])
+		if strings.HasPrefix(strings.ToUpper(value), "TO ") {
+			value = value[len("TO "):]
+		}
+	} else {
+		return
+	}
+
+	c.params[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(value), `'"`)
+}
+
+// executeSelect executes a SELECT statement.
+// Queries on metrics views are run as Metrics SQL. Catalog queries, which reference the information schema or pg_catalog or have no FROM clause, are run against the connection's catalog database.
+// Clients often use PostgreSQL-specific syntax in catalog queries, so queries that fail to parse as Metrics SQL are also run against the catalog database (where scanning a metrics view fails with a helpful error).
+func (c *pgConn) executeSelect(sql string) (*pgResult, error) {
+	nodes, _, err := c.parser.ParseSQL(sql)
+	if err != nil {
+		return c.selectCatalog(sql)
+	}
+	if len(nodes) != 1 {
+		return nil, newPGError("42601", "expected exactly one SQL statement")
+	}
+	sel, ok := nodes[0].(*ast.SelectStmt)
+	if !ok {
+		return nil, newPGError("0A000", "unsupported statement: only SELECT queries are supported")
+	}
+
+	if sel.From == nil || pgIsCatalogSelect(sel) {
+		return c.selectCatalog(sql)
+	}
+	return c.selectMetrics(sql)
+}
+
+// selectMetrics executes a Metrics SQL query.
+func (c *pgConn) selectMetrics(sql string) (*pgResult, error) {
+	res, err := c.server.runtime.Resolve(c.ctx, &runtime.ResolveOptions{
+		InstanceID:         c.instanceID,
+		Resolver:           "metrics_sql",
+		ResolverProperties: map[string]any{"sql": sql},
+		Claims:             auth.GetClaims(c.ctx).SecurityClaims(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schema := res.Schema()
+	fields := make([]pgField, len(schema.Fields))
+	for i, f := range schema.Fields {
+		fields[i] = pgField{name: f.Name, oid: pgTypeOID(f.Type)}
+	}
+
+	return &pgResult{
+		fields: fields,
+		nextFn: func() ([]any, error) {
+			row, err := res.Next()
+			if err != nil {
+				return nil, err
+			}
+			vals := make([]any, len(schema.Fields))
+			for i, f := range schema.Fields {
+				vals[i], err = jsonval.ToValue(row[f.Name], f.Type)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return vals, nil
+		},
+		closeFn: func() { _ = res.Close() },
+	}, nil
+}
+
+// selectCatalog executes a query against the connection's catalog database.
+// Before running the query, the catalog is synced with the metrics views that the user currently has access to.
+func (c *pgConn) selectCatalog(sql string) (*pgResult, error) {
+	if c.catalog == nil {
+		catalog, err := newPGCatalog(c.ctx, c.instanceID)
+		if err != nil {
+			return nil, err
+		}
+		c.catalog = catalog
+	}
+
+	names, err := c.metricsViewNames()
+	if err != nil {
+		return nil, err
+	}
+	claims := auth.GetClaims(c.ctx).SecurityClaims()
+	views := make(map[string]*runtimev1.StructType, len(names))
+	for _, name := range names {
+		q := &queries.MetricsViewSchema{
+			MetricsViewName: name,
+			SecurityClaims:  claims,
+		}
+		err := c.server.runtime.Query(c.ctx, c.instanceID, q, 1)
+		if err != nil {
+			return nil, err
+		}
+		views[name] = q.Result.Schema
+	}
+
+	err = c.catalog.sync(c.ctx, views)
+	if err != nil {
+		return nil, err
+	}
+	return c.catalog.query(c.ctx, sql)
+}
+
+// metricsViewNames returns the sorted names of the valid metrics views that the user has access to.
+func (c *pgConn) metricsViewNames() ([]string, error) {
+	ctrl, err := c.server.runtime.Controller(c.ctx, c.instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := ctrl.List(c.ctx, runtime.ResourceKindMetricsView, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, r := range rs {
+		r, access, err := c.server.applySecurityPolicy(c.ctx, c.instanceID, r)
+		if err != nil {
+			return nil, err
+		}
+		if !access || r.GetMetricsView().State.ValidSpec == nil {
+			continue
+		}
+		names = append(names, r.Meta.Name.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// pgIsCatalogSelect returns true if the statement references a table in the information schema or pg_catalog.
+// Tables prefixed with "pg_" are also considered catalog tables since pg_catalog is implicitly on the search path in PostgreSQL.
+func pgIsCatalogSelect(sel *ast.SelectStmt) bool {
+	v := &pgTableVisitor{}
+	sel.Accept(v)
+	for _, t := range v.tables {
+		schema := t.Schema.L
+		if schema == "information_schema" || schema == "pg_catalog" || (schema == "" && strings.HasPrefix(t.Name.L, "pg_")) {
+			return true
+		}
+	}
+	return false
+}
+
+// pgTableVisitor is an AST visitor that collects the tables referenced in a statement.
+type pgTableVisitor struct {
+	tables []*ast.TableName
+}
+
+func (v *pgTableVisitor) Enter(n ast.Node) (ast.Node, bool) {
+	if t, ok := n.(*ast.TableName); ok {
+		v.tables = append(v.tables, t)
+	}
+	return n, false
+}
+
+func (v *pgTableVisitor) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
+
+// pgFloat64 converts a numeric value to a float64.
+func pgFloat64(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return float64(rv.Int()), true
+	case rv.CanUint():
+		return float64(rv.Uint()), true
+	case rv.CanFloat():
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// pgInt64 converts a numeric value to an int64.
+func pgInt64(v any) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return rv.Int(), true
+	case rv.CanUint():
+		return int64(rv.Uint()), true
+	case rv.CanFloat():
+		return int64(rv.Float()), true
+	default:
+		return 0, false
+	}
+}
+
+// pgTypeOID returns the PostgreSQL type used for values of the given type.
+// Types without a direct equivalent are sent as text (complex types are serialized to JSON).
+func pgTypeOID(t *runtimev1.Type) uint32 {
+	switch t.GetCode() {
+	case runtimev1.Type_CODE_BOOL:
+		return pgtype.BoolOID
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32:
+		return pgtype.Int8OID
+	case runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256, runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_DECIMAL:
+		return pgtype.Float8OID
+	case runtimev1.Type_CODE_TIMESTAMP:
+		return pgtype.TimestamptzOID
+	case runtimev1.Type_CODE_DATE:
+		return pgtype.DateOID
+	case runtimev1.Type_CODE_BYTES:
+		return pgtype.ByteaOID
+	default:
+		return pgtype.TextOID
+	}
+}
+
+// pgTypeName returns the name of a type returned by pgTypeOID.
+func pgTypeName(oid uint32) string {
+	switch oid {
+	case pgtype.BoolOID:
+		return "boolean"
+	case pgtype.Int8OID:
+		return "bigint"
+	case pgtype.Float8OID:
+		return "double precision"
+	case pgtype.TimestamptzOID:
+		return "timestamp with time zone"
+	case pgtype.DateOID:
+		return "date"
+	case pgtype.ByteaOID:
+		return "bytea"
+	default:
+		return "text"
+	}
+}
+
+// pgTypeSize returns the size of a type returned by pgTypeOID (negative for variable-length types).
+func pgTypeSize(oid uint32) int16 {
+	switch oid {
+	case pgtype.BoolOID:
+		return 1
+	case pgtype.DateOID:
+		return 4
+	case pgtype.Int8OID, pgtype.Float8OID, pgtype.TimestamptzOID:
+		return 8
+	default:
+		return -1
+	}
+}
+
+// encodeValue encodes a value for a column of a type returned by pgTypeOID.
+func (c *pgConn) encodeValue(oid uint32, formatCode int16, v any) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch oid {
+	case pgtype.Int8OID:
+		i, ok := pgInt64(v)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value of type %T", v)
+		}
+		v = i
+	case pgtype.Float8OID:
+		f, ok := pgFloat64(v)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value of type %T", v)
+		}
+		v = f
+	case pgtype.TimestamptzOID:
+		if s, ok := v.(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, err
+			}
+			v = t
+		}
+	case pgtype.DateOID:
+		if s, ok := v.(string); ok {
+			t, err := time.Parse(time.DateOnly, s)
+			if err != nil {
+				return nil, err
+			}
+			v = t
+		}
+	case pgtype.TextOID:
+		if _, ok := v.(string); !ok {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			v = string(b)
+		}
+	}
+
+	// Passing a non-nil buffer ensures empty values are not encoded as NULL.
+	return c.typeMap.Encode(oid, formatCode, v, []byte{})
+}
+
+// pgParam is a parameter value received in a Bind message.
+type pgParam struct {
+	text string
+	oid  uint32
+}
+
+// decodeParam decodes a parameter from a Bind message. It returns nil for NULL.
+func (c *pgConn) decodeParam(oid uint32, formatCode int16, src []byte) (*pgParam, error) {
+	if src == nil {
+		return nil, nil
+	}
+	if formatCode == pgtype.TextFormatCode {
+		return &pgParam{text: string(src), oid: oid}, nil
+	}
+
+	// Convert binary parameters to their text representation
+	t, ok := c.typeMap.TypeForOID(oid)
+	if !ok {
+		return nil, errors.New("binary parameters must have a known type")
+	}
+	v, err := t.Codec.DecodeValue(c.typeMap, oid, formatCode, src)
+	if err != nil {
+		return nil, err
+	}
+	text, err := c.typeMap.Encode(oid, pgtype.TextFormatCode, v, []byte{})
+	if err != nil {
+		return nil, err
+	}
+	return &pgParam{text: string(text), oid: oid}, nil
+}
+
+// literal returns the parameter as a SQL literal.
+func (p *pgParam) literal() string {
+	if p == nil {
+		return "NULL"
+	}
+	switch p.oid {
+	case pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID, pgtype.Float4OID, pgtype.Float8OID, pgtype.NumericOID:
+		if _, err := strconv.ParseFloat(p.text, 64); err == nil {
+			return p.text
+		}
+	case pgtype.BoolOID:
+		switch strings.ToLower(p.text) {
+		case "t", "true":
+			return "TRUE"
+		case "f", "false":
+			return "FALSE"
+		}
+	}
+	// Metrics SQL uses MySQL-style string literals, so backslashes must also be escaped.
+	s := strings.ReplaceAll(p.text, `\`, `\\`)
+	s = strings.ReplaceAll(s, "'", "''")
+	return "'" + s + "'"
+}
+
+// bindPGParams replaces parameter placeholders ($1, $2, ...) in the SQL with the parameters' literal values.
+func bindPGParams(sql string, params []*pgParam) (string, error) {
+	return replacePGParams(sql, func(n int) (string, error) {
+		if n < 1 || n > len(params) {
+			return "", newPGError("08P01", "missing value for parameter $%d", n)
+		}
+		return params[n-1].literal(), nil
+	})
+}
+
+// countPGParams returns the number of parameters referenced in the SQL.
+func countPGParams(sql string) int {
+	var count int
+	_, _ = replacePGParams(sql, func(n int) (string, error) {
+		count = max(count, n)
+		return "", nil
+	})
+	return count
+}
+
+// replacePGParams calls fn for each parameter placeholder outside of quotes and comments in the SQL and replaces it with the result.
+func replacePGParams(sql string, fn func(n int) (string, error)) (string, error) {
+	var sb strings.Builder
+	err := scanPGSQL(sql, func(i int) (int, error) {
+		if sql[i] != '$' {
+			sb.WriteByte(sql[i])
+			return i + 1, nil
+		}
+		j := i + 1
+		for j < len(sql) && sql[j] >= '0' && sql[j] <= '9' {
+			j++
+		}
+		if j == i+1 {
+			sb.WriteByte(sql[i])
+			return i + 1, nil
+		}
+		n, err := strconv.Atoi(sql[i+1 : j])
+		if err != nil {
+			return 0, err
+		}
+		s, err := fn(n)
+		if err != nil {
+			return 0, err
+		}
+		sb.WriteString(s)
+		return j, nil
+	}, func(s string) { sb.WriteString(s) })
+	if err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// splitPGStatements splits a string of semicolon-separated statements, ignoring empty statements.
+func splitPGStatements(sql string) []string {
+	var stmts []string
+	var sb strings.Builder
+	_ = scanPGSQL(sql, func(i int) (int, error) {
+		if sql[i] == ';' {
+			stmts = append(stmts, sb.String())
+			sb.Reset()
+		} else {
+			sb.WriteByte(sql[i])
+		}
+		return i + 1, nil
+	}, func(s string) { sb.WriteString(s) })
+	stmts = append(stmts, sb.String())
+
+	var res []string
+	for _, s := range stmts {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// scanPGSQL scans SQL, calling code for each byte outside of quoted strings, quoted identifiers and comments.
+// The code callback returns the index to continue scanning from. Quoted and commented spans are passed to other.
+func scanPGSQL(sql string, code func(i int) (int, error), other func(s string)) error {
+	i := 0
+	for i < len(sql) {
+		switch {
+		case sql[i] == '\'' || sql[i] == '"':
+			q := sql[i]
+			j := i + 1
+			for j < len(sql) {
+				if sql[j] == q {
+					if j+1 < len(sql) && sql[j+1] == q {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			j = min(j+1, len(sql))
+			other(sql[i:j])
+			i = j
+		case strings.HasPrefix(sql[i:], "--"):
+			j := strings.IndexByte(sql[i:], '\n')
+			if j < 0 {
+				j = len(sql) - i
+			}
+			other(sql[i : i+j])
+			i += j
+		default:
+			next, err := code(i)
+			if err != nil {
+				return err
+			}
+			i = next
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPGWire(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 1 AS val
+UNION ALL
+SELECT 'DK' AS country, 2 AS val
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(val)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	// Find a free port
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	require.NoError(t, lis.Close())
+
+	srv, err := server.NewServer(context.Background(), &server.Options{PGWirePort: port}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = srv.ServePGWire(ctx)
+	}()
+
+	// Test both the simple and extended query protocols
+	for _, mode := range []string{"simple_protocol", "cache_statement"} {
+		t.Run(mode, func(t *testing.T) {
+			var conn *pgx.Conn
+			require.Eventually(t, func() bool {
+				conn, err = pgx.Connect(ctx, fmt.Sprintf("postgres://rill@localhost:%d/%s?default_query_exec_mode=%s", port, instanceID, mode))
+				return err == nil
+			}, 5*time.Second, 100*time.Millisecond)
+			defer conn.Close(ctx)
+
+			// Metrics views are listed in the information schema
+			var name string
+			err := conn.QueryRow(ctx, "SELECT table_name FROM information_schema.tables WHERE table_schema = $1", "public").Scan(&name)
+			require.NoError(t, err)
+			require.Equal(t, "mv1", name)
+
+			rows, err := conn.Query(ctx, "SELECT column_name, data_type FROM information_schema.columns WHERE table_name = 'mv1' ORDER BY ordinal_position")
+			require.NoError(t, err)
+			cols, err := pgx.CollectRows(rows, pgx.RowToStructByPos[struct{ Name, Type string }])
+			require.NoError(t, err)
+			require.Equal(t, []struct{ Name, Type string }{{"country", "VARCHAR"}, {"total", "DOUBLE"}}, cols)
+
+			// Metrics views are listed in pg_catalog (as used by psql's \d)
+			var relkind string
+			err = conn.QueryRow(ctx, "SELECT c.relname, c.relkind FROM pg_catalog.pg_class c LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace WHERE n.nspname = 'public' AND c.relname ~ '^(mv1)$'").Scan(&name, &relkind)
+			require.NoError(t, err)
+			require.Equal(t, "mv1", name)
+			require.Equal(t, "v", relkind)
+
+			// Queries without a FROM clause run against the catalog
+			var db string
+			err = conn.QueryRow(ctx, "SELECT current_database()::text").Scan(&db)
+			require.NoError(t, err)
+			require.Equal(t, instanceID, db)
+
+			// Metrics views can't be scanned in queries that aren't Metrics SQL
+			_, err = conn.Exec(ctx, "SELECT country::text FROM mv1")
+			require.ErrorContains(t, err, "can only be queried with Metrics SQL")
+
+			// Metrics SQL
+			rows, err = conn.Query(ctx, "SELECT country, total FROM mv1 WHERE country = $1", "DK")
+			require.NoError(t, err)
+			res, err := pgx.CollectRows(rows, pgx.RowToStructByPos[struct {
+				Country string
+				Total   float64
+			}])
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			require.Equal(t, "DK", res[0].Country)
+			require.Equal(t, 2.0, res[0].Total)
+
+			// Session statements are accepted
+			_, err = conn.Exec(ctx, "SET application_name = 'test'")
+			require.NoError(t, err)
+			err = conn.QueryRow(ctx, "SHOW application_name").Scan(&name)
+			require.NoError(t, err)
+			require.Equal(t, "test", name)
+
+			// Other statements are rejected
+			_, err = conn.Exec(ctx, "DELETE FROM m1")
+			require.ErrorContains(t, err, "only SELECT queries are supported")
+		})
+	}
+
+	// Unknown databases return the same error as failed authentication
+	_, err = pgx.Connect(ctx, fmt.Sprintf("postgres://rill@localhost:%d/%s", port, "unknown"))
+	require.ErrorContains(t, err, `authentication failed or database "unknown" does not exist`)
+}
@@ -2,15 +2,21 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"sync"
 	"time"
 
 	jsonvalue "github.com/Andrew-M-C/go.jsonvalue"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -83,6 +89,77 @@ func (s *Server) Query(ctx context.Context, req *runtimev1.QueryRequest) (*runti
 	return resp, nil
 }
 
+// QueryResolver implements QueryService.
+func (s *Server) QueryResolver(ctx context.Context, req *runtimev1.QueryResolverRequest) (*runtimev1.QueryResolverResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.resolver", req.Resolver),
+		attribute.Bool("args.impersonate", req.Attributes != nil),
+		attribute.Int("args.limit", int(req.Limit)),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadAPI) {
+		return nil, ErrForbidden
+	}
+
+	// Only admins can run ad-hoc resolvers (same as for the built-in SQL APIs).
+	claims := auth.GetClaims(ctx).SecurityClaims()
+	if !claims.SkipChecks && !claims.Admin() {
+		return nil, status.Error(codes.PermissionDenied, "must be an admin to run ad-hoc queries")
+	}
+
+	// Impersonate another user by building claims from their attributes the same way as ExplainSecurity.
+	if req.Attributes != nil {
+		attrs := req.Attributes.AsMap()
+		email, ok := attrs["email"].(string)
+		if ok && attrs["domain"] == nil {
+			attrs["domain"] = email[strings.LastIndex(email, "@")+1:]
+		}
+		claims = &runtime.SecurityClaims{UserAttributes: attrs}
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         req.InstanceId,
+		Resolver:           req.Resolver,
+		ResolverProperties: req.ResolverProperties.AsMap(),
+		Args:               req.ResolverArgs.AsMap(),
+		Claims:             claims,
+		Limit:              int64(limit),
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer res.Close()
+
+	var data []*structpb.Struct
+	for len(data) < limit {
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		rowStruct, err := pbutil.ToStruct(row, res.Schema())
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, rowStruct)
+	}
+
+	return &runtimev1.QueryResolverResponse{
+		Schema: res.Schema(),
+		Data:   data,
+	}, nil
+}
+
 func rowsToData(rows *drivers.Result) ([]*structpb.Struct, error) {
 	var data []*structpb.Struct
 	for rows.Next() {
@@ -187,6 +187,55 @@ func (s *Server) MetricsViewComparison(ctx context.Context, req *runtimev1.Metri
 	return q.Result, nil
 }
 
+// MetricsViewContribution implements QueryService.
+func (s *Server) MetricsViewContribution(ctx context.Context, req *runtimev1.MetricsViewContributionRequest) (*runtimev1.MetricsViewContributionResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.String("args.measure", req.Measure),
+		attribute.String("args.volume_measure", req.VolumeMeasure),
+		attribute.StringSlice("args.dimensions", req.Dimensions),
+		attribute.Int("args.filter_count", filterCount(req.Where)),
+		attribute.Int64("args.limit", req.Limit),
+		attribute.Bool("args.narrative", req.Narrative),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if req.TimeRange != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.base_time_range.start", safeTimeStr(req.TimeRange.Start)))
+		observability.AddRequestAttributes(ctx, attribute.String("args.base_time_range.end", safeTimeStr(req.TimeRange.End)))
+	}
+	if req.ComparisonTimeRange != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.comparison_time_range.start", safeTimeStr(req.ComparisonTimeRange.Start)))
+		observability.AddRequestAttributes(ctx, attribute.String("args.comparison_time_range.end", safeTimeStr(req.ComparisonTimeRange.End)))
+	}
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	q := &queries.MetricsViewContribution{
+		MetricsViewName:     req.MetricsViewName,
+		Measure:             req.Measure,
+		VolumeMeasure:       req.VolumeMeasure,
+		Dimensions:          req.Dimensions,
+		TimeRange:           req.TimeRange,
+		ComparisonTimeRange: req.ComparisonTimeRange,
+		Where:               req.Where,
+		Limit:               req.Limit,
+		Narrative:           req.Narrative,
+		SecurityClaims:      auth.GetClaims(ctx).SecurityClaims(),
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
+
 // MetricsViewTimeSeries implements QueryService.
 func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.MetricsViewTimeSeriesRequest) (*runtimev1.MetricsViewTimeSeriesResponse, error) {
 	observability.AddRequestAttributes(ctx,
@@ -202,6 +251,13 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
 
+	if req.Forecast != nil {
+		observability.AddRequestAttributes(ctx,
+			attribute.Int("args.forecast.periods", int(req.Forecast.Periods)),
+			attribute.String("args.forecast.method", req.Forecast.Method.String()),
+		)
+	}
+
 	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
 		return nil, ErrForbidden
 	}
@@ -218,6 +274,7 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		HavingSQL:       req.HavingSql,
 		TimeZone:        req.TimeZone,
 		Filter:          req.Filter,
+		Forecast:        req.Forecast,
 		SecurityClaims:  auth.GetClaims(ctx).SecurityClaims(),
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
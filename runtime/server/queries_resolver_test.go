@@ -0,0 +1,77 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestQueryResolver(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 1 AS val
+UNION ALL
+SELECT 'DK' AS country, 2 AS val
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: SUM(val)
+security:
+  access: true
+  row_filter: country = '{{ .user.country }}'
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	server, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	// SQL with a row limit
+	res, err := server.QueryResolver(testCtx(), &runtimev1.QueryResolverRequest{
+		InstanceId: instanceID,
+		Resolver:   "sql",
+		ResolverProperties: must(structpb.NewStruct(map[string]any{
+			"sql": "SELECT country FROM m1 ORDER BY country",
+		})),
+		Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Schema.Fields, 1)
+	require.Equal(t, "country", res.Schema.Fields[0].Name)
+	require.Len(t, res.Data, 1)
+	require.Equal(t, "DK", res.Data[0].Fields["country"].GetStringValue())
+
+	// Metrics SQL with impersonation applies the metrics view's security policy
+	res, err = server.QueryResolver(testCtx(), &runtimev1.QueryResolverRequest{
+		InstanceId: instanceID,
+		Resolver:   "metrics_sql",
+		ResolverProperties: must(structpb.NewStruct(map[string]any{
+			"sql": "SELECT country, total FROM mv1",
+		})),
+		Attributes: must(structpb.NewStruct(map[string]any{
+			"email":   "jane@example.com",
+			"country": "US",
+		})),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Data, 1)
+	require.Equal(t, "US", res.Data[0].Fields["country"].GetStringValue())
+	require.Equal(t, 1.0, res.Data[0].Fields["total"].GetNumberValue())
+}
@@ -2,12 +2,15 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const _tableHeadDefaultLimit = 25
@@ -81,6 +84,42 @@ func (s *Server) TableColumns(ctx context.Context, req *runtimev1.TableColumnsRe
 	return q.Result, nil
 }
 
+func (s *Server) TableDiff(ctx context.Context, req *runtimev1.TableDiffRequest) (*runtimev1.TableDiffResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.connector", req.Connector),
+		attribute.String("args.database", req.Database),
+		attribute.String("args.database_schema", req.DatabaseSchema),
+		attribute.String("args.base_table", req.BaseTableName),
+		attribute.String("args.compare_table", req.CompareTableName),
+		attribute.StringSlice("args.keys", req.Keys),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadProfiling) {
+		return nil, ErrForbidden
+	}
+
+	q := &queries.TableDiff{
+		Connector:        req.Connector,
+		Database:         req.Database,
+		DatabaseSchema:   req.DatabaseSchema,
+		BaseTableName:    req.BaseTableName,
+		CompareTableName: req.CompareTableName,
+		Keys:             req.Keys,
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		if errors.Is(err, queries.ErrKeyDiffNotSupported) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return q.Result, nil
+}
+
 func (s *Server) TableRows(ctx context.Context, req *runtimev1.TableRowsRequest) (*runtimev1.TableRowsResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.instance_id", req.InstanceId),
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// explainSecurityDefaultKinds are the resource kinds explained by ExplainSecurity when no resources are specified.
+var explainSecurityDefaultKinds = []string{
+	runtime.ResourceKindMetricsView,
+	runtime.ResourceKindExplore,
+	runtime.ResourceKindCanvas,
+	runtime.ResourceKindAPI,
+}
+
+// ExplainSecurity implements runtimev1.RuntimeServiceServer
+func (s *Server) ExplainSecurity(ctx context.Context, req *runtimev1.ExplainSecurityRequest) (*runtimev1.ExplainSecurityResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Int("args.resources", len(req.Resources)),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+		return nil, ErrForbidden
+	}
+
+	// Simulating arbitrary attributes reveals other users' access, so it's only available to admins.
+	if !auth.GetClaims(ctx).SecurityClaims().Admin() {
+		return nil, status.Error(codes.PermissionDenied, "must be an admin to explain security policies")
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var rs []*runtimev1.Resource
+	if len(req.Resources) == 0 {
+		for _, kind := range explainSecurityDefaultKinds {
+			kindRs, err := ctrl.List(ctx, kind, "", false)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			rs = append(rs, kindRs...)
+		}
+	} else {
+		for _, n := range req.Resources {
+			r, err := ctrl.Get(ctx, n, false)
+			if err != nil {
+				if errors.Is(err, drivers.ErrResourceNotFound) {
+					return nil, status.Errorf(codes.NotFound, "resource %q not found", n.Name)
+				}
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			rs = append(rs, r)
+		}
+	}
+
+	// Build the simulated claims the same way as IssueDevJWT
+	attrs := req.Attributes.AsMap()
+	email, ok := attrs["email"].(string)
+	if ok && attrs["domain"] == nil {
+		attrs["domain"] = email[strings.LastIndex(email, "@")+1:]
+	}
+	claims := &runtime.SecurityClaims{UserAttributes: attrs}
+
+	res := make([]*runtimev1.ResourceSecurityExplanation, 0, len(rs))
+	for _, r := range rs {
+		exp, err := s.runtime.ExplainSecurity(req.InstanceId, claims, r)
+		if err != nil {
+			res = append(res, &runtimev1.ResourceSecurityExplanation{
+				Resource:        r.Meta.Name,
+				AccessRuleIndex: -1,
+				Error:           err.Error(),
+			})
+			continue
+		}
+		res = append(res, securityExplanationToPB(r, exp))
+	}
+
+	return &runtimev1.ExplainSecurityResponse{Resources: res}, nil
+}
+
+// securityExplanationToPB converts a runtime.SecurityExplanation for a resource to its protobuf representation.
+func securityExplanationToPB(r *runtimev1.Resource, exp *runtime.SecurityExplanation) *runtimev1.ResourceSecurityExplanation {
+	res := &runtimev1.ResourceSecurityExplanation{
+		Resource:        r.Meta.Name,
+		Access:          exp.Resolved.CanAccess(),
+		AccessRuleIndex: int32(exp.AccessRule),
+		AllFields:       exp.Resolved.CanAccessAllFields(),
+		RowFilter:       exp.Resolved.RowFilter(),
+		QueryFilter:     exp.Resolved.QueryFilter(),
+		Rules:           make([]*runtimev1.SecurityRuleEvaluation, len(exp.Rules)),
+	}
+
+	for i, rule := range exp.Rules {
+		res.Rules[i] = &runtimev1.SecurityRuleEvaluation{
+			Rule:             rule.Rule,
+			Source:           rule.Source,
+			ConditionMatched: rule.ConditionMatched,
+			Skipped:          rule.Skipped,
+		}
+	}
+
+	if !res.AllFields {
+		for _, f := range securityFields(r) {
+			idx, ok := exp.FieldRules[f]
+			if !ok {
+				idx = -1
+			}
+			res.Fields = append(res.Fields, &runtimev1.FieldSecurityExplanation{
+				Field:     f,
+				Allowed:   exp.Resolved.CanAccessField(f),
+				RuleIndex: int32(idx),
+			})
+		}
+	}
+
+	return res
+}
+
+// securityFields returns the names of the fields that field access rules apply to for a resource.
+func securityFields(r *runtimev1.Resource) []string {
+	var fields []string
+	switch r.Resource.(type) {
+	case *runtimev1.Resource_MetricsView:
+		spec := r.GetMetricsView().State.ValidSpec
+		if spec == nil {
+			return nil
+		}
+		for _, d := range spec.Dimensions {
+			fields = append(fields, d.Name)
+		}
+		for _, m := range spec.Measures {
+			fields = append(fields, m.Name)
+		}
+	case *runtimev1.Resource_Explore:
+		spec := r.GetExplore().State.ValidSpec
+		if spec == nil {
+			return nil
+		}
+		fields = append(fields, spec.Dimensions...)
+		fields = append(fields, spec.Measures...)
+	}
+	return fields
+}
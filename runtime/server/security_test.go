@@ -0,0 +1,75 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestExplainSecurity(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"m1.sql": `
+SELECT 'US' AS country, 'acme' AS tenant
+`,
+			"mv1.yaml": `
+type: metrics_view
+version: 1
+model: m1
+dimensions:
+- column: country
+- column: tenant
+measures:
+- expression: COUNT(*)
+security:
+  access: true
+  row_filter: tenant = '{{ .user.tenant }}'
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	req := &runtimev1.ExplainSecurityRequest{
+		InstanceId: instanceID,
+		Attributes: must(structpb.NewStruct(map[string]any{"tenant": "acme"})),
+		Resources:  []*runtimev1.ResourceName{{Kind: "rill.runtime.v1.MetricsView", Name: "mv1"}},
+	}
+
+	// Admins can simulate other users
+	res, err := srv.ExplainSecurity(testCtx(), req)
+	require.NoError(t, err)
+	require.Len(t, res.Resources, 1)
+	require.True(t, res.Resources[0].Access)
+	require.Equal(t, "tenant = 'acme'", res.Resources[0].RowFilter)
+
+	// Non-admins can't, even if they can read the instance's resources
+	ctx := userCtx(t, map[string]any{"email": "jane@example.com", "tenant": "other", "admin": false})
+	_, err = srv.ExplainSecurity(ctx, req)
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// userCtx returns a context with the claims of a local user with the given attributes and no admin privileges.
+func userCtx(t *testing.T, attrs map[string]any) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"attr": attrs}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	ctx, err := auth.WithToken(context.Background(), nil, token)
+	require.NoError(t, err)
+	return ctx
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hashicorp/golang-lru/simplelru"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -38,6 +40,8 @@ var ErrForbidden = status.Error(codes.Unauthenticated, "action not allowed")
 type Options struct {
 	HTTPPort        int
 	GRPCPort        int
+	FlightSQLPort   int
+	PGWirePort      int
 	AllowedOrigins  []string
 	ServePrometheus bool
 	SessionKeyPairs [][]byte
@@ -46,6 +50,10 @@ type Options struct {
 	AuthAudienceURL string
 	TLSCertPath     string
 	TLSKeyPath      string
+	// PGWireTLSCertPath and PGWireTLSKeyPath configure TLS for the PostgreSQL wire protocol server.
+	// If not set, TLSCertPath and TLSKeyPath are used.
+	PGWireTLSCertPath string
+	PGWireTLSKeyPath  string
 }
 
 type Server struct {
@@ -59,6 +67,9 @@ type Server struct {
 	codec    *securetoken.Codec
 	limiter  ratelimit.Limiter
 	activity *activity.Client
+
+	graphQLSchemas   *simplelru.LRU
+	graphQLSchemasMu sync.Mutex
 }
 
 var (
@@ -79,13 +90,19 @@ func NewServer(ctx context.Context, opts *Options, rt *runtime.Runtime, logger *
 		codec = securetoken.NewCodec(opts.SessionKeyPairs)
 	}
 
+	graphQLSchemas, err := simplelru.NewLRU(graphQLSchemaCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	srv := &Server{
-		runtime:  rt,
-		opts:     opts,
-		logger:   logger,
-		codec:    codec,
-		limiter:  limiter,
-		activity: activityClient,
+		runtime:        rt,
+		opts:           opts,
+		logger:         logger,
+		codec:          codec,
+		limiter:        limiter,
+		activity:       activityClient,
+		graphQLSchemas: graphQLSchemas,
 	}
 
 	if opts.AuthEnable {
@@ -139,6 +156,7 @@ func (s *Server) ServeGRPC(ctx context.Context) error {
 			middleware.ActivityUnaryServerInterceptor(s.activity),
 			errorMappingUnaryServerInterceptor(),
 			grpc_auth.UnaryServerInterceptor(s.checkRateLimit),
+			s.queryBudgetUnaryServerInterceptor(),
 		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
@@ -215,6 +233,9 @@ func (s *Server) HTTPHandler(ctx context.Context, registerAdditionalHandlers fun
 	// Add handler for combined OpenAPI spec of custom APIs
 	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/api/openapi", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.combinedOpenAPISpec))))
 
+	// Add handler for the GraphQL API generated from metrics views
+	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/graphql", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.graphQLHandler))))
+
 	// Serving static assets
 	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/assets/{path...}", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.assetsHandler))))
 
@@ -328,6 +349,9 @@ func mapGRPCError(err error) error {
 	if errors.Is(err, runtime.ErrForbidden) {
 		return ErrForbidden
 	}
+	if errors.As(err, &runtime.QueryBudgetExceededError{}) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
 	return err
 }
 
@@ -352,6 +376,27 @@ func (s *Server) checkRateLimit(ctx context.Context) (context.Context, error) {
 	return ctx, nil
 }
 
+// queryBudgetUnaryServerInterceptor is an interceptor that enforces the instance's query budget for requests to the QueryService.
+func (s *Server) queryBudgetUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, "/rill.runtime.v1.QueryService/") {
+			return handler(ctx, req)
+		}
+
+		r, ok := req.(interface{ GetInstanceId() string })
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		ctx, err := s.runtime.WithQueryBudget(ctx, r.GetInstanceId(), auth.GetClaims(ctx).SecurityClaims())
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
 func (s *Server) addInstanceRequestAttributes(ctx context.Context, instanceID string) {
 	attrs := s.runtime.GetInstanceAttributes(ctx, instanceID)
 	observability.AddRequestAttributes(ctx, attrs...)
@@ -0,0 +1,22 @@
+package runtime
+
+import (
+	"errors"
+
+	"github.com/rilldata/rill/runtime/pkg/snapshot"
+)
+
+// SnapshotRenderer returns a renderer for PDF and PNG snapshots of dashboards using the runtime's snapshots config.
+func (r *Runtime) SnapshotRenderer() (snapshot.Renderer, error) {
+	switch r.opts.SnapshotsRendererURL {
+	case "":
+		return nil, errors.New("no renderer is configured for snapshots")
+	case "local":
+		return snapshot.NewLocal(), nil
+	default:
+		return snapshot.NewHTTP(&snapshot.HTTPOptions{
+			Endpoint: r.opts.SnapshotsRendererURL,
+			Token:    r.opts.SnapshotsRendererToken,
+		})
+	}
+}
@@ -64,6 +64,7 @@ func New(t TestingT) *runtime.Runtime {
 		ControllerLogBufferCapacity:  10000,
 		ControllerLogBufferSizeBytes: int64(datasize.MB * 16),
 		AllowHostAccess:              true,
+		SnapshotsRendererURL:         "local",
 	}
 
 	logger := zap.NewNop()
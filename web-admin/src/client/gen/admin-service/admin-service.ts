@@ -68,6 +68,8 @@ import type {
   V1UnsubscribeAlertResponse,
   V1GetAlertYAMLResponse,
   V1GetCloneCredentialsResponse,
+  V1ConnectProjectToGitResponse,
+  AdminServiceConnectProjectToGitBody,
   V1ConnectProjectToGithubResponse,
   AdminServiceConnectProjectToGithubBody,
   V1GetDeploymentCredentialsResponse,
@@ -2342,6 +2344,71 @@ export const createAdminServiceGetCloneCredentials = <
   return query;
 };
 
+/**
+ * @summary Connects a project to a Git repository on GitLab, Bitbucket or a generic Git server.
+Replaces the project's current Github repository or archive.
+ */
+export const adminServiceConnectProjectToGit = (
+  organization: string,
+  project: string,
+  adminServiceConnectProjectToGitBody: AdminServiceConnectProjectToGitBody,
+) => {
+  return httpClient<V1ConnectProjectToGitResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/connect-to-git`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceConnectProjectToGitBody,
+  });
+};
+
+export type AdminServiceConnectProjectToGitMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceConnectProjectToGit>>
+>;
+export type AdminServiceConnectProjectToGitMutationBody =
+  AdminServiceConnectProjectToGitBody;
+export type AdminServiceConnectProjectToGitMutationError = RpcStatus;
+
+export const createAdminServiceConnectProjectToGit = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceConnectProjectToGit>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceConnectProjectToGitBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceConnectProjectToGit>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceConnectProjectToGitBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceConnectProjectToGit(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceConnectProjectToGit>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceConnectProjectToGitBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary Connects a rill managed project to github.
 Replaces the contents of the remote repo with the contents of the project.
@@ -39,6 +39,12 @@ export type AdminServiceGetReportMetaBody = {
   ownerId?: string;
   executionTime?: string;
   emailRecipients?: string[];
+  /** If true, the response includes the security attributes of each email recipient (used for running burst reports as each recipient). */
+  recipientAttributes?: boolean;
+  /** Type and name of a canvas or explore to render a snapshot of.
+If set, the response includes snapshot URLs with short-lived access tokens for the owner and for each recipient who is a member of the project. */
+  snapshotType?: string;
+  snapshotResource?: string;
 };
 
 export type AdminServicePullVirtualRepoParams = {
@@ -83,6 +89,7 @@ export type AdminServiceUpdateProjectBody = {
 export type AdminServiceGetProjectParams = {
   accessTokenTtlSeconds?: number;
   issueSuperuserToken?: boolean;
+  branch?: string;
 };
 
 export type AdminServiceCreateProjectBody = {
@@ -265,6 +272,20 @@ export type AdminServiceConnectProjectToGithubBody = {
   force?: boolean;
 };
 
+export type AdminServiceConnectProjectToGitBody = {
+  /** Type of Git provider. Options: "gitlab", "bitbucket", "git". */
+  provider?: string;
+  /** HTTPS or SSH URL of the repository. */
+  remote?: string;
+  /** Username and password (or access token) for HTTPS remotes. */
+  username?: string;
+  password?: string;
+  /** Private key for SSH remotes. If not set for an SSH remote, a deploy key is generated. */
+  sshPrivateKey?: string;
+  branch?: string;
+  subpath?: string;
+};
+
 export type AdminServiceListProjectMemberUsergroupsParams = {
   pageSize?: number;
   pageToken?: string;
@@ -477,6 +498,20 @@ export interface V1TriggerReconcileResponse {
   [key: string]: any;
 }
 
+export interface V1ToolCall {
+  id?: string;
+  name?: string;
+  /** Input for the tool, encoded as JSON. */
+  input?: string;
+}
+
+export interface V1Tool {
+  name?: string;
+  description?: string;
+  /** JSON schema for the tool's input, encoded as JSON. */
+  inputSchema?: string;
+}
+
 export interface V1SudoUpdateUserQuotasResponse {
   user?: V1User;
 }
@@ -669,6 +704,7 @@ export interface V1ReportOptions {
   queryArgsJson?: string;
   exportLimit?: string;
   exportFormat?: V1ExportFormat;
+  exportGoogleSheets?: V1GoogleSheetsExportOptions;
   emailRecipients?: string[];
   slackUsers?: string[];
   slackChannels?: string[];
@@ -1044,6 +1080,16 @@ export interface V1HibernateProjectResponse {
   [key: string]: any;
 }
 
+/**
+ * GoogleSheetsExportOptions configures the destination of an export in EXPORT_FORMAT_GOOGLE_SHEETS.
+ */
+export interface V1GoogleSheetsExportOptions {
+  /** ID of the spreadsheet, as found in its URL. */
+  spreadsheetId?: string;
+  /** Name of the tab to write to. It is created if it doesn't exist, and its contents are replaced if it does. */
+  sheet?: string;
+}
+
 export type V1GithubPermission =
   (typeof V1GithubPermission)[keyof typeof V1GithubPermission];
 
@@ -1063,6 +1109,38 @@ export interface V1GetUserResponse {
   user?: V1User;
 }
 
+/**
+ * Errors for email recipients whose attributes could not be resolved, if attributes were requested.
+Recipients with an error are not included in recipient_attributes.
+ */
+export type V1GetReportMetaResponseRecipientAttributeErrors = {
+  [key: string]: string;
+};
+
+/**
+ * Snapshot URLs for each email recipient who is a member of the project, if a snapshot was requested.
+The access token in each URL is scoped to the recipient's own permissions.
+ */
+export type V1GetReportMetaResponseRecipientSnapshotUrls = {
+  [key: string]: string;
+};
+
+export type V1GetReportMetaResponseRecipientAttributesItem = {
+  [key: string]: any;
+};
+
+/**
+ * Attributes of each email recipient, if requested. Used to run queries on behalf of each recipient.
+ */
+export type V1GetReportMetaResponseRecipientAttributes = {
+  [key: string]: V1GetReportMetaResponseRecipientAttributesItem;
+};
+
+/**
+ * Attributes of the report's owner, if any. Used to run queries on behalf of the owner.
+ */
+export type V1GetReportMetaResponseQueryForAttributes = { [key: string]: any };
+
 export type V1GetReportMetaResponseRecipientUrls = {
   [key: string]: GetReportMetaResponseURLs;
 };
@@ -1070,6 +1148,16 @@ export type V1GetReportMetaResponseRecipientUrls = {
 export interface V1GetReportMetaResponse {
   baseUrls?: GetReportMetaResponseURLs;
   recipientUrls?: V1GetReportMetaResponseRecipientUrls;
+  /** Attributes of the report's owner, if any. Used to run queries on behalf of the owner. */
+  queryForAttributes?: V1GetReportMetaResponseQueryForAttributes;
+  /** Attributes of each email recipient, if requested. Used to run queries on behalf of each recipient. */
+  recipientAttributes?: V1GetReportMetaResponseRecipientAttributes;
+  /** Snapshot URLs for each email recipient who is a member of the project, if a snapshot was requested.
+The access token in each URL is scoped to the recipient's own permissions. */
+  recipientSnapshotUrls?: V1GetReportMetaResponseRecipientSnapshotUrls;
+  /** Errors for email recipients whose attributes could not be resolved, if attributes were requested.
+Recipients with an error are not included in recipient_attributes. */
+  recipientAttributeErrors?: V1GetReportMetaResponseRecipientAttributeErrors;
 }
 
 export interface V1GetRepoMetaResponse {
@@ -1080,6 +1168,9 @@ export interface V1GetRepoMetaResponse {
   archiveDownloadUrl?: string;
   archiveId?: string;
   archiveCreatedOn?: string;
+  /** For SSH remotes, the private key to authenticate with and the host key to accept. */
+  gitSshPrivateKey?: string;
+  gitSshHostKey?: string;
 }
 
 /**
@@ -1102,6 +1193,7 @@ export interface V1GetProjectResponse {
   prodDeployment?: V1Deployment;
   jwt?: string;
   projectPermissions?: V1ProjectPermissions;
+  previewDeployment?: V1Deployment;
 }
 
 export interface V1GetProjectByIDResponse {
@@ -1227,6 +1319,16 @@ export interface V1Expression {
   subquery?: V1Subquery;
 }
 
+/**
+ *  - EXPORT_FORMAT_JSONL: Newline-delimited JSON, with one JSON object per row.
+ - EXPORT_FORMAT_ARROW: Arrow IPC stream.
+ - EXPORT_FORMAT_GOOGLE_SHEETS: Writes the result to a tab in a Google Sheet instead of a file.
+It requires the export request to provide GoogleSheetsExportOptions.
+ - EXPORT_FORMAT_PDF: Rendered snapshot of a canvas or explore dashboard as a PDF document.
+It is only supported for reports, which must provide ReportSnapshotOptions.
+ - EXPORT_FORMAT_PNG: Rendered snapshot of a canvas or explore dashboard as a PNG image.
+It is only supported for reports, which must provide ReportSnapshotOptions.
+ */
 export type V1ExportFormat =
   (typeof V1ExportFormat)[keyof typeof V1ExportFormat];
 
@@ -1236,6 +1338,11 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
+  EXPORT_FORMAT_GOOGLE_SHEETS: "EXPORT_FORMAT_GOOGLE_SHEETS",
+  EXPORT_FORMAT_PDF: "EXPORT_FORMAT_PDF",
+  EXPORT_FORMAT_PNG: "EXPORT_FORMAT_PNG",
 } as const;
 
 export interface V1EditUsergroupResponse {
@@ -1271,6 +1378,7 @@ export interface V1Deployment {
   statusMessage?: string;
   createdOn?: string;
   updatedOn?: string;
+  preview?: boolean;
 }
 
 export interface V1DenyProjectAccessResponse {
@@ -1369,6 +1477,14 @@ export interface V1ConnectProjectToGithubResponse {
   [key: string]: any;
 }
 
+export interface V1ConnectProjectToGitResponse {
+  /** Public key of the SSH key pair used for the connection. If a deploy key was generated, it must be added to the repository. */
+  sshPublicKey?: string;
+  /** URL and secret to configure a webhook for receiving pushes. If not set, the provider doesn't support webhooks and pushes are detected by polling. */
+  webhookUrl?: string;
+  webhookSecret?: string;
+}
+
 export interface V1Condition {
   op?: V1Operation;
   exprs?: V1Expression[];
@@ -1377,6 +1493,10 @@ export interface V1Condition {
 export interface V1CompletionMessage {
   role?: string;
   data?: string;
+  /** Tool calls requested by the AI. Only set for messages with role "assistant". */
+  toolCalls?: V1ToolCall[];
+  /** ID of the tool call that the message contains the result of. Only set for messages with role "tool". */
+  toolCallId?: string;
 }
 
 export interface V1CompleteResponse {
@@ -1385,6 +1505,8 @@ export interface V1CompleteResponse {
 
 export interface V1CompleteRequest {
   messages?: V1CompletionMessage[];
+  /** Tools that the AI may call instead of responding with text. */
+  tools?: V1Tool[];
 }
 
 export interface V1CancelBillingSubscriptionResponse {
@@ -1604,4 +1726,6 @@ export interface GetReportMetaResponseURLs {
   openUrl?: string;
   exportUrl?: string;
   editUrl?: string;
+  /** URL for rendering a snapshot of the report's canvas or explore. It embeds a short-lived access token. */
+  snapshotUrl?: string;
 }
@@ -15,6 +15,13 @@ export class CompleteRequest extends Message<CompleteRequest> {
    */
   messages: CompletionMessage[] = [];
 
+  /**
+   * Tools that the AI may call instead of responding with text.
+   *
+   * @generated from field: repeated rill.admin.v1.Tool tools = 2;
+   */
+  tools: Tool[] = [];
+
   constructor(data?: PartialMessage<CompleteRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -24,6 +31,7 @@ export class CompleteRequest extends Message<CompleteRequest> {
   static readonly typeName = "rill.admin.v1.CompleteRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "messages", kind: "message", T: CompletionMessage, repeated: true },
+    { no: 2, name: "tools", kind: "message", T: Tool, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteRequest {
@@ -94,6 +102,20 @@ export class CompletionMessage extends Message<CompletionMessage> {
    */
   data = "";
 
+  /**
+   * Tool calls requested by the AI. Only set for messages with role "assistant".
+   *
+   * @generated from field: repeated rill.admin.v1.ToolCall tool_calls = 3;
+   */
+  toolCalls: ToolCall[] = [];
+
+  /**
+   * ID of the tool call that the message contains the result of. Only set for messages with role "tool".
+   *
+   * @generated from field: string tool_call_id = 4;
+   */
+  toolCallId = "";
+
   constructor(data?: PartialMessage<CompletionMessage>) {
     super();
     proto3.util.initPartial(data, this);
@@ -104,6 +126,8 @@ export class CompletionMessage extends Message<CompletionMessage> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "data", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "tool_calls", kind: "message", T: ToolCall, repeated: true },
+    { no: 4, name: "tool_call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompletionMessage {
@@ -123,3 +147,105 @@ export class CompletionMessage extends Message<CompletionMessage> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.Tool
+ */
+export class Tool extends Message<Tool> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * JSON schema for the tool's input, encoded as JSON.
+   *
+   * @generated from field: string input_schema = 3;
+   */
+  inputSchema = "";
+
+  constructor(data?: PartialMessage<Tool>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.Tool";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "input_schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Tool {
+    return new Tool().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Tool {
+    return new Tool().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Tool {
+    return new Tool().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Tool | PlainMessage<Tool> | undefined, b: Tool | PlainMessage<Tool> | undefined): boolean {
+    return proto3.util.equals(Tool, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ToolCall
+ */
+export class ToolCall extends Message<ToolCall> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Input for the tool, encoded as JSON.
+   *
+   * @generated from field: string input = 3;
+   */
+  input = "";
+
+  constructor(data?: PartialMessage<ToolCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ToolCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "input", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ToolCall {
+    return new ToolCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ToolCall {
+    return new ToolCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ToolCall {
+    return new ToolCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ToolCall | PlainMessage<ToolCall> | undefined, b: ToolCall | PlainMessage<ToolCall> | undefined): boolean {
+    return proto3.util.equals(ToolCall, a, b);
+  }
+}
+
@@ -6,7 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
 import { Expression } from "../../runtime/v1/expression_pb.js";
-import { ExportFormat } from "../../runtime/v1/export_format_pb.js";
+import { ExportFormat, GoogleSheetsExportOptions } from "../../runtime/v1/export_format_pb.js";
 
 /**
  * @generated from enum rill.admin.v1.GithubPermission
@@ -874,6 +874,13 @@ export class GetProjectRequest extends Message<GetProjectRequest> {
    */
   issueSuperuserToken = false;
 
+  /**
+   * Optional branch to get the preview deployment for. If set, the JWT is issued for the preview deployment instead of the prod deployment.
+   *
+   * @generated from field: string branch = 5;
+   */
+  branch = "";
+
   constructor(data?: PartialMessage<GetProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -886,6 +893,7 @@ export class GetProjectRequest extends Message<GetProjectRequest> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "access_token_ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 4, name: "issue_superuser_token", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectRequest {
@@ -929,6 +937,13 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
    */
   projectPermissions?: ProjectPermissions;
 
+  /**
+   * The preview deployment for the requested branch (only set if a branch was requested).
+   *
+   * @generated from field: rill.admin.v1.Deployment preview_deployment = 5;
+   */
+  previewDeployment?: Deployment;
+
   constructor(data?: PartialMessage<GetProjectResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -941,6 +956,7 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
     { no: 2, name: "prod_deployment", kind: "message", T: Deployment },
     { no: 3, name: "jwt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "project_permissions", kind: "message", T: ProjectPermissions },
+    { no: 5, name: "preview_deployment", kind: "message", T: Deployment },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectResponse {
@@ -8568,6 +8584,152 @@ export class ConnectProjectToGithubResponse extends Message<ConnectProjectToGith
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.ConnectProjectToGitRequest
+ */
+export class ConnectProjectToGitRequest extends Message<ConnectProjectToGitRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * Type of Git provider. Options: "gitlab", "bitbucket", "git".
+   *
+   * @generated from field: string provider = 3;
+   */
+  provider = "";
+
+  /**
+   * HTTPS or SSH URL of the repository.
+   *
+   * @generated from field: string remote = 4;
+   */
+  remote = "";
+
+  /**
+   * Username and password (or access token) for HTTPS remotes.
+   *
+   * @generated from field: string username = 5;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 6;
+   */
+  password = "";
+
+  /**
+   * Private key for SSH remotes. If not set for an SSH remote, a deploy key is generated.
+   *
+   * @generated from field: string ssh_private_key = 7;
+   */
+  sshPrivateKey = "";
+
+  /**
+   * @generated from field: string branch = 8;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: string subpath = 9;
+   */
+  subpath = "";
+
+  constructor(data?: PartialMessage<ConnectProjectToGitRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ConnectProjectToGitRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "remote", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "ssh_private_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectProjectToGitRequest {
+    return new ConnectProjectToGitRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectProjectToGitRequest {
+    return new ConnectProjectToGitRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectProjectToGitRequest {
+    return new ConnectProjectToGitRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectProjectToGitRequest | PlainMessage<ConnectProjectToGitRequest> | undefined, b: ConnectProjectToGitRequest | PlainMessage<ConnectProjectToGitRequest> | undefined): boolean {
+    return proto3.util.equals(ConnectProjectToGitRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ConnectProjectToGitResponse
+ */
+export class ConnectProjectToGitResponse extends Message<ConnectProjectToGitResponse> {
+  /**
+   * Public key of the SSH key pair used for the connection. If a deploy key was generated, it must be added to the repository.
+   *
+   * @generated from field: string ssh_public_key = 1;
+   */
+  sshPublicKey = "";
+
+  /**
+   * URL and secret to configure a webhook for receiving pushes. If not set, the provider doesn't support webhooks and pushes are detected by polling.
+   *
+   * @generated from field: string webhook_url = 2;
+   */
+  webhookUrl = "";
+
+  /**
+   * @generated from field: string webhook_secret = 3;
+   */
+  webhookSecret = "";
+
+  constructor(data?: PartialMessage<ConnectProjectToGitResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ConnectProjectToGitResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ssh_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "webhook_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectProjectToGitResponse {
+    return new ConnectProjectToGitResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectProjectToGitResponse {
+    return new ConnectProjectToGitResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectProjectToGitResponse {
+    return new ConnectProjectToGitResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectProjectToGitResponse | PlainMessage<ConnectProjectToGitResponse> | undefined, b: ConnectProjectToGitResponse | PlainMessage<ConnectProjectToGitResponse> | undefined): boolean {
+    return proto3.util.equals(ConnectProjectToGitResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.UploadProjectAssetsRequest
  */
@@ -9307,6 +9469,18 @@ export class GetRepoMetaResponse extends Message<GetRepoMetaResponse> {
    */
   archiveCreatedOn?: Timestamp;
 
+  /**
+   * For SSH remotes, the private key to authenticate with and the host key to accept.
+   *
+   * @generated from field: string git_ssh_private_key = 7;
+   */
+  gitSshPrivateKey = "";
+
+  /**
+   * @generated from field: string git_ssh_host_key = 8;
+   */
+  gitSshHostKey = "";
+
   constructor(data?: PartialMessage<GetRepoMetaResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -9321,6 +9495,8 @@ export class GetRepoMetaResponse extends Message<GetRepoMetaResponse> {
     { no: 4, name: "archive_download_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "archive_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "archive_created_on", kind: "message", T: Timestamp },
+    { no: 7, name: "git_ssh_private_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "git_ssh_host_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRepoMetaResponse {
@@ -9472,6 +9648,26 @@ export class GetReportMetaRequest extends Message<GetReportMetaRequest> {
    */
   emailRecipients: string[] = [];
 
+  /**
+   * If true, the response includes the security attributes of each email recipient (used for running burst reports as each recipient).
+   *
+   * @generated from field: bool recipient_attributes = 8;
+   */
+  recipientAttributes = false;
+
+  /**
+   * Type and name of a canvas or explore to render a snapshot of.
+   * If set, the response includes snapshot URLs with short-lived access tokens for the owner and for each recipient who is a member of the project.
+   *
+   * @generated from field: string snapshot_type = 9;
+   */
+  snapshotType = "";
+
+  /**
+   * @generated from field: string snapshot_resource = 10;
+   */
+  snapshotResource = "";
+
   constructor(data?: PartialMessage<GetReportMetaRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -9486,6 +9682,9 @@ export class GetReportMetaRequest extends Message<GetReportMetaRequest> {
     { no: 5, name: "owner_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "execution_time", kind: "message", T: Timestamp },
     { no: 7, name: "email_recipients", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "recipient_attributes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "snapshot_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "snapshot_resource", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaRequest {
@@ -9519,6 +9718,36 @@ export class GetReportMetaResponse extends Message<GetReportMetaResponse> {
    */
   recipientUrls: { [key: string]: GetReportMetaResponse_URLs } = {};
 
+  /**
+   * Attributes of the report's owner, if any. Used to run queries on behalf of the owner.
+   *
+   * @generated from field: google.protobuf.Struct query_for_attributes = 3;
+   */
+  queryForAttributes?: Struct;
+
+  /**
+   * Attributes of each email recipient, if requested. Used to run queries on behalf of each recipient.
+   *
+   * @generated from field: map<string, google.protobuf.Struct> recipient_attributes = 4;
+   */
+  recipientAttributes: { [key: string]: Struct } = {};
+
+  /**
+   * Snapshot URLs for each email recipient who is a member of the project, if a snapshot was requested.
+   * The access token in each URL is scoped to the recipient's own permissions.
+   *
+   * @generated from field: map<string, string> recipient_snapshot_urls = 5;
+   */
+  recipientSnapshotUrls: { [key: string]: string } = {};
+
+  /**
+   * Errors for email recipients whose attributes could not be resolved, if attributes were requested.
+   * Recipients with an error are not included in recipient_attributes.
+   *
+   * @generated from field: map<string, string> recipient_attribute_errors = 6;
+   */
+  recipientAttributeErrors: { [key: string]: string } = {};
+
   constructor(data?: PartialMessage<GetReportMetaResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -9529,6 +9758,10 @@ export class GetReportMetaResponse extends Message<GetReportMetaResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "base_urls", kind: "message", T: GetReportMetaResponse_URLs },
     { no: 2, name: "recipient_urls", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: GetReportMetaResponse_URLs} },
+    { no: 3, name: "query_for_attributes", kind: "message", T: Struct },
+    { no: 4, name: "recipient_attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Struct} },
+    { no: 5, name: "recipient_snapshot_urls", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "recipient_attribute_errors", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaResponse {
@@ -9567,6 +9800,13 @@ export class GetReportMetaResponse_URLs extends Message<GetReportMetaResponse_UR
    */
   editUrl = "";
 
+  /**
+   * URL for rendering a snapshot of the report's canvas or explore. It embeds a short-lived access token.
+   *
+   * @generated from field: string snapshot_url = 4;
+   */
+  snapshotUrl = "";
+
   constructor(data?: PartialMessage<GetReportMetaResponse_URLs>) {
     super();
     proto3.util.initPartial(data, this);
@@ -9578,6 +9818,7 @@ export class GetReportMetaResponse_URLs extends Message<GetReportMetaResponse_UR
     { no: 1, name: "open_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "export_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "edit_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "snapshot_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReportMetaResponse_URLs {
@@ -12400,6 +12641,11 @@ export class Deployment extends Message<Deployment> {
    */
   updatedOn?: Timestamp;
 
+  /**
+   * @generated from field: bool preview = 11;
+   */
+  preview = false;
+
   constructor(data?: PartialMessage<Deployment>) {
     super();
     proto3.util.initPartial(data, this);
@@ -12417,6 +12663,7 @@ export class Deployment extends Message<Deployment> {
     { no: 8, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 9, name: "created_on", kind: "message", T: Timestamp },
     { no: 10, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 11, name: "preview", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Deployment {
@@ -13270,6 +13517,11 @@ export class ReportOptions extends Message<ReportOptions> {
    */
   exportFormat = ExportFormat.UNSPECIFIED;
 
+  /**
+   * @generated from field: rill.runtime.v1.GoogleSheetsExportOptions export_google_sheets = 16;
+   */
+  exportGoogleSheets?: GoogleSheetsExportOptions;
+
   /**
    * @generated from field: repeated string email_recipients = 8;
    */
@@ -13320,6 +13572,7 @@ export class ReportOptions extends Message<ReportOptions> {
     { no: 4, name: "query_args_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "export_limit", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 6, name: "export_format", kind: "enum", T: proto3.getEnumType(ExportFormat) },
+    { no: 16, name: "export_google_sheets", kind: "message", T: GoogleSheetsExportOptions },
     { no: 8, name: "email_recipients", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 10, name: "slack_users", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 11, name: "slack_channels", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
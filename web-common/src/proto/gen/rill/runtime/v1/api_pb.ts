@@ -5,7 +5,9 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
-import { RefreshModelTrigger, Resource, ResourceName } from "./resources_pb.js";
+import { StructType } from "./schema_pb.js";
+import { RefreshModelTrigger, Resource, ResourceName, SecurityRule } from "./resources_pb.js";
+import { Expression } from "./expression_pb.js";
 
 /**
  * FileEvent describes a file change.
@@ -105,6 +107,46 @@ proto3.util.setEnumType(ResourceEvent, "rill.runtime.v1.ResourceEvent", [
   { no: 2, name: "RESOURCE_EVENT_DELETE" },
 ]);
 
+/**
+ * SecurityRuleSource describes where a security rule was declared.
+ *
+ * @generated from enum rill.runtime.v1.SecurityRuleSource
+ */
+export enum SecurityRuleSource {
+  /**
+   * @generated from enum value: SECURITY_RULE_SOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The rule was provided in the claims of the request, for example the restrictions of a magic auth token.
+   *
+   * @generated from enum value: SECURITY_RULE_SOURCE_CLAIMS = 1;
+   */
+  CLAIMS = 1,
+
+  /**
+   * The rule is built into the runtime, for example default access for resources without security policies.
+   *
+   * @generated from enum value: SECURITY_RULE_SOURCE_BUILT_IN = 2;
+   */
+  BUILT_IN = 2,
+
+  /**
+   * The rule was declared on the resource itself.
+   *
+   * @generated from enum value: SECURITY_RULE_SOURCE_RESOURCE = 3;
+   */
+  RESOURCE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SecurityRuleSource)
+proto3.util.setEnumType(SecurityRuleSource, "rill.runtime.v1.SecurityRuleSource", [
+  { no: 0, name: "SECURITY_RULE_SOURCE_UNSPECIFIED" },
+  { no: 1, name: "SECURITY_RULE_SOURCE_CLAIMS" },
+  { no: 2, name: "SECURITY_RULE_SOURCE_BUILT_IN" },
+  { no: 3, name: "SECURITY_RULE_SOURCE_RESOURCE" },
+]);
+
 /**
  * Request message for RuntimeService.Ping
  *
@@ -2471,459 +2513,1120 @@ export class GenerateRendererResponse extends Message<GenerateRendererResponse>
 }
 
 /**
- * @generated from message rill.runtime.v1.Log
+ * @generated from message rill.runtime.v1.AskMetricsViewRequest
  */
-export class Log extends Message<Log> {
+export class AskMetricsViewRequest extends Message<AskMetricsViewRequest> {
   /**
-   * @generated from field: rill.runtime.v1.LogLevel level = 1;
+   * @generated from field: string instance_id = 1;
    */
-  level = LogLevel.UNSPECIFIED;
+  instanceId = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp time = 2;
+   * @generated from field: string metrics_view = 2;
    */
-  time?: Timestamp;
+  metricsView = "";
 
   /**
-   * @generated from field: string message = 3;
+   * @generated from field: string question = 3;
    */
-  message = "";
+  question = "";
 
   /**
-   * @generated from field: string json_payload = 4;
+   * Optional time zone to use for time ranges and time grains in the generated query.
+   *
+   * @generated from field: string time_zone = 4;
    */
-  jsonPayload = "";
+  timeZone = "";
 
-  constructor(data?: PartialMessage<Log>) {
+  /**
+   * @generated from field: int32 priority = 5;
+   */
+  priority = 0;
+
+  constructor(data?: PartialMessage<AskMetricsViewRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.Log";
+  static readonly typeName = "rill.runtime.v1.AskMetricsViewRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "level", kind: "enum", T: proto3.getEnumType(LogLevel) },
-    { no: 2, name: "time", kind: "message", T: Timestamp },
-    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "json_payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "metrics_view", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "question", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Log {
-    return new Log().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskMetricsViewRequest {
+    return new AskMetricsViewRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Log {
-    return new Log().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AskMetricsViewRequest {
+    return new AskMetricsViewRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Log {
-    return new Log().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AskMetricsViewRequest {
+    return new AskMetricsViewRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Log | PlainMessage<Log> | undefined, b: Log | PlainMessage<Log> | undefined): boolean {
-    return proto3.util.equals(Log, a, b);
+  static equals(a: AskMetricsViewRequest | PlainMessage<AskMetricsViewRequest> | undefined, b: AskMetricsViewRequest | PlainMessage<AskMetricsViewRequest> | undefined): boolean {
+    return proto3.util.equals(AskMetricsViewRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.ModelPartition
+ * @generated from message rill.runtime.v1.AskMetricsViewResponse
  */
-export class ModelPartition extends Message<ModelPartition> {
-  /**
-   * @generated from field: string key = 1;
-   */
-  key = "";
-
-  /**
-   * @generated from field: google.protobuf.Struct data = 2;
-   */
-  data?: Struct;
-
+export class AskMetricsViewResponse extends Message<AskMetricsViewResponse> {
   /**
-   * @generated from field: google.protobuf.Timestamp watermark = 3;
+   * The generated query in the format of the "metrics" resolver's properties.
+   *
+   * @generated from field: google.protobuf.Struct query = 1;
    */
-  watermark?: Timestamp;
+  query?: Struct;
 
   /**
-   * @generated from field: google.protobuf.Timestamp executed_on = 4;
+   * Explanation of how the query answers the question.
+   *
+   * @generated from field: string explanation = 2;
    */
-  executedOn?: Timestamp;
+  explanation = "";
 
   /**
-   * @generated from field: string error = 5;
+   * @generated from field: rill.runtime.v1.StructType schema = 3;
    */
-  error = "";
+  schema?: StructType;
 
   /**
-   * @generated from field: uint32 elapsed_ms = 6;
+   * @generated from field: repeated google.protobuf.Struct data = 4;
    */
-  elapsedMs = 0;
+  data: Struct[] = [];
 
-  constructor(data?: PartialMessage<ModelPartition>) {
+  constructor(data?: PartialMessage<AskMetricsViewResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.ModelPartition";
+  static readonly typeName = "rill.runtime.v1.AskMetricsViewResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "data", kind: "message", T: Struct },
-    { no: 3, name: "watermark", kind: "message", T: Timestamp },
-    { no: 4, name: "executed_on", kind: "message", T: Timestamp },
-    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "elapsed_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 1, name: "query", kind: "message", T: Struct },
+    { no: 2, name: "explanation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "schema", kind: "message", T: StructType },
+    { no: 4, name: "data", kind: "message", T: Struct, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelPartition {
-    return new ModelPartition().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskMetricsViewResponse {
+    return new AskMetricsViewResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelPartition {
-    return new ModelPartition().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AskMetricsViewResponse {
+    return new AskMetricsViewResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelPartition {
-    return new ModelPartition().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AskMetricsViewResponse {
+    return new AskMetricsViewResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ModelPartition | PlainMessage<ModelPartition> | undefined, b: ModelPartition | PlainMessage<ModelPartition> | undefined): boolean {
-    return proto3.util.equals(ModelPartition, a, b);
+  static equals(a: AskMetricsViewResponse | PlainMessage<AskMetricsViewResponse> | undefined, b: AskMetricsViewResponse | PlainMessage<AskMetricsViewResponse> | undefined): boolean {
+    return proto3.util.equals(AskMetricsViewResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.GetLogsRequest
+ * @generated from message rill.runtime.v1.ListConversationsRequest
  */
-export class GetLogsRequest extends Message<GetLogsRequest> {
+export class ListConversationsRequest extends Message<ListConversationsRequest> {
   /**
    * @generated from field: string instance_id = 1;
    */
   instanceId = "";
 
   /**
-   * @generated from field: bool ascending = 2;
-   */
-  ascending = false;
-
-  /**
-   * @generated from field: int32 limit = 3;
+   * @generated from field: uint32 page_size = 2;
    */
-  limit = 0;
+  pageSize = 0;
 
   /**
-   * @generated from field: rill.runtime.v1.LogLevel level = 4;
+   * @generated from field: string page_token = 3;
    */
-  level = LogLevel.UNSPECIFIED;
+  pageToken = "";
 
-  constructor(data?: PartialMessage<GetLogsRequest>) {
+  constructor(data?: PartialMessage<ListConversationsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.GetLogsRequest";
+  static readonly typeName = "rill.runtime.v1.ListConversationsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "ascending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "level", kind: "enum", T: proto3.getEnumType(LogLevel) },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLogsRequest {
-    return new GetLogsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConversationsRequest {
+    return new ListConversationsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLogsRequest {
-    return new GetLogsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConversationsRequest {
+    return new ListConversationsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLogsRequest {
-    return new GetLogsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConversationsRequest {
+    return new ListConversationsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetLogsRequest | PlainMessage<GetLogsRequest> | undefined, b: GetLogsRequest | PlainMessage<GetLogsRequest> | undefined): boolean {
-    return proto3.util.equals(GetLogsRequest, a, b);
+  static equals(a: ListConversationsRequest | PlainMessage<ListConversationsRequest> | undefined, b: ListConversationsRequest | PlainMessage<ListConversationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListConversationsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.GetLogsResponse
+ * @generated from message rill.runtime.v1.ListConversationsResponse
  */
-export class GetLogsResponse extends Message<GetLogsResponse> {
+export class ListConversationsResponse extends Message<ListConversationsResponse> {
   /**
-   * @generated from field: repeated rill.runtime.v1.Log logs = 1;
+   * @generated from field: repeated rill.runtime.v1.Conversation conversations = 1;
    */
-  logs: Log[] = [];
+  conversations: Conversation[] = [];
 
-  constructor(data?: PartialMessage<GetLogsResponse>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListConversationsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.GetLogsResponse";
+  static readonly typeName = "rill.runtime.v1.ListConversationsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "logs", kind: "message", T: Log, repeated: true },
+    { no: 1, name: "conversations", kind: "message", T: Conversation, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLogsResponse {
-    return new GetLogsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConversationsResponse {
+    return new ListConversationsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLogsResponse {
-    return new GetLogsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConversationsResponse {
+    return new ListConversationsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLogsResponse {
-    return new GetLogsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConversationsResponse {
+    return new ListConversationsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetLogsResponse | PlainMessage<GetLogsResponse> | undefined, b: GetLogsResponse | PlainMessage<GetLogsResponse> | undefined): boolean {
-    return proto3.util.equals(GetLogsResponse, a, b);
+  static equals(a: ListConversationsResponse | PlainMessage<ListConversationsResponse> | undefined, b: ListConversationsResponse | PlainMessage<ListConversationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListConversationsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.WatchLogsRequest
+ * @generated from message rill.runtime.v1.GetConversationRequest
  */
-export class WatchLogsRequest extends Message<WatchLogsRequest> {
+export class GetConversationRequest extends Message<GetConversationRequest> {
   /**
    * @generated from field: string instance_id = 1;
    */
   instanceId = "";
 
   /**
-   * @generated from field: bool replay = 2;
-   */
-  replay = false;
-
-  /**
-   * @generated from field: int32 replay_limit = 3;
-   */
-  replayLimit = 0;
-
-  /**
-   * @generated from field: rill.runtime.v1.LogLevel level = 4;
+   * @generated from field: string conversation_id = 2;
    */
-  level = LogLevel.UNSPECIFIED;
+  conversationId = "";
 
-  constructor(data?: PartialMessage<WatchLogsRequest>) {
+  constructor(data?: PartialMessage<GetConversationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.WatchLogsRequest";
+  static readonly typeName = "rill.runtime.v1.GetConversationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "replay", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "replay_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "level", kind: "enum", T: proto3.getEnumType(LogLevel) },
+    { no: 2, name: "conversation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchLogsRequest {
-    return new WatchLogsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConversationRequest {
+    return new GetConversationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchLogsRequest {
-    return new WatchLogsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConversationRequest {
+    return new GetConversationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchLogsRequest {
-    return new WatchLogsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConversationRequest {
+    return new GetConversationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchLogsRequest | PlainMessage<WatchLogsRequest> | undefined, b: WatchLogsRequest | PlainMessage<WatchLogsRequest> | undefined): boolean {
-    return proto3.util.equals(WatchLogsRequest, a, b);
+  static equals(a: GetConversationRequest | PlainMessage<GetConversationRequest> | undefined, b: GetConversationRequest | PlainMessage<GetConversationRequest> | undefined): boolean {
+    return proto3.util.equals(GetConversationRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.WatchLogsResponse
+ * @generated from message rill.runtime.v1.GetConversationResponse
  */
-export class WatchLogsResponse extends Message<WatchLogsResponse> {
+export class GetConversationResponse extends Message<GetConversationResponse> {
   /**
-   * @generated from field: rill.runtime.v1.Log log = 1;
+   * @generated from field: rill.runtime.v1.Conversation conversation = 1;
    */
-  log?: Log;
+  conversation?: Conversation;
 
-  constructor(data?: PartialMessage<WatchLogsResponse>) {
+  constructor(data?: PartialMessage<GetConversationResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.WatchLogsResponse";
+  static readonly typeName = "rill.runtime.v1.GetConversationResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "log", kind: "message", T: Log },
+    { no: 1, name: "conversation", kind: "message", T: Conversation },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchLogsResponse {
-    return new WatchLogsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConversationResponse {
+    return new GetConversationResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchLogsResponse {
-    return new WatchLogsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConversationResponse {
+    return new GetConversationResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchLogsResponse {
-    return new WatchLogsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConversationResponse {
+    return new GetConversationResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchLogsResponse | PlainMessage<WatchLogsResponse> | undefined, b: WatchLogsResponse | PlainMessage<WatchLogsResponse> | undefined): boolean {
-    return proto3.util.equals(WatchLogsResponse, a, b);
+  static equals(a: GetConversationResponse | PlainMessage<GetConversationResponse> | undefined, b: GetConversationResponse | PlainMessage<GetConversationResponse> | undefined): boolean {
+    return proto3.util.equals(GetConversationResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.ListResourcesRequest
+ * @generated from message rill.runtime.v1.DeleteConversationRequest
  */
-export class ListResourcesRequest extends Message<ListResourcesRequest> {
+export class DeleteConversationRequest extends Message<DeleteConversationRequest> {
   /**
-   * Instance to list resources from.
-   *
    * @generated from field: string instance_id = 1;
    */
   instanceId = "";
 
   /**
-   * Filter by resource kind (optional).
-   *
-   * @generated from field: string kind = 2;
-   */
-  kind = "";
-
-  /**
-   * Filter by resource path (optional).
-   *
-   * @generated from field: string path = 3;
-   */
-  path = "";
-
-  /**
-   * Skip security checks
-   *
-   * @generated from field: bool skip_security_checks = 4;
+   * @generated from field: string conversation_id = 2;
    */
-  skipSecurityChecks = false;
+  conversationId = "";
 
-  constructor(data?: PartialMessage<ListResourcesRequest>) {
+  constructor(data?: PartialMessage<DeleteConversationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.ListResourcesRequest";
+  static readonly typeName = "rill.runtime.v1.DeleteConversationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "skip_security_checks", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "conversation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListResourcesRequest {
-    return new ListResourcesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteConversationRequest {
+    return new DeleteConversationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListResourcesRequest {
-    return new ListResourcesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteConversationRequest {
+    return new DeleteConversationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListResourcesRequest {
-    return new ListResourcesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteConversationRequest {
+    return new DeleteConversationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListResourcesRequest | PlainMessage<ListResourcesRequest> | undefined, b: ListResourcesRequest | PlainMessage<ListResourcesRequest> | undefined): boolean {
-    return proto3.util.equals(ListResourcesRequest, a, b);
+  static equals(a: DeleteConversationRequest | PlainMessage<DeleteConversationRequest> | undefined, b: DeleteConversationRequest | PlainMessage<DeleteConversationRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteConversationRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.ListResourcesResponse
+ * @generated from message rill.runtime.v1.DeleteConversationResponse
  */
-export class ListResourcesResponse extends Message<ListResourcesResponse> {
-  /**
-   * @generated from field: repeated rill.runtime.v1.Resource resources = 1;
-   */
-  resources: Resource[] = [];
-
-  constructor(data?: PartialMessage<ListResourcesResponse>) {
+export class DeleteConversationResponse extends Message<DeleteConversationResponse> {
+  constructor(data?: PartialMessage<DeleteConversationResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.ListResourcesResponse";
+  static readonly typeName = "rill.runtime.v1.DeleteConversationResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "resources", kind: "message", T: Resource, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListResourcesResponse {
-    return new ListResourcesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteConversationResponse {
+    return new DeleteConversationResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListResourcesResponse {
-    return new ListResourcesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteConversationResponse {
+    return new DeleteConversationResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListResourcesResponse {
-    return new ListResourcesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteConversationResponse {
+    return new DeleteConversationResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListResourcesResponse | PlainMessage<ListResourcesResponse> | undefined, b: ListResourcesResponse | PlainMessage<ListResourcesResponse> | undefined): boolean {
-    return proto3.util.equals(ListResourcesResponse, a, b);
+  static equals(a: DeleteConversationResponse | PlainMessage<DeleteConversationResponse> | undefined, b: DeleteConversationResponse | PlainMessage<DeleteConversationResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteConversationResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.WatchResourcesRequest
+ * @generated from message rill.runtime.v1.CompleteRequest
  */
-export class WatchResourcesRequest extends Message<WatchResourcesRequest> {
+export class CompleteRequest extends Message<CompleteRequest> {
   /**
    * @generated from field: string instance_id = 1;
    */
   instanceId = "";
 
   /**
-   * @generated from field: string kind = 2;
+   * Optional ID of an existing conversation to continue.
+   *
+   * @generated from field: string conversation_id = 2;
    */
-  kind = "";
+  conversationId = "";
 
   /**
-   * @generated from field: bool replay = 3;
+   * @generated from field: string message = 3;
    */
-  replay = false;
+  message = "";
 
   /**
-   * @generated from field: string level = 4;
+   * @generated from field: int32 priority = 4;
    */
-  level = "";
+  priority = 0;
 
-  constructor(data?: PartialMessage<WatchResourcesRequest>) {
+  constructor(data?: PartialMessage<CompleteRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.WatchResourcesRequest";
+  static readonly typeName = "rill.runtime.v1.CompleteRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "replay", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "conversation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchResourcesRequest {
-    return new WatchResourcesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteRequest {
+    return new CompleteRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchResourcesRequest {
-    return new WatchResourcesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteRequest {
+    return new CompleteRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchResourcesRequest {
-    return new WatchResourcesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteRequest {
+    return new CompleteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteRequest | PlainMessage<CompleteRequest> | undefined, b: CompleteRequest | PlainMessage<CompleteRequest> | undefined): boolean {
+    return proto3.util.equals(CompleteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.CompleteResponse
+ */
+export class CompleteResponse extends Message<CompleteResponse> {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId = "";
+
+  /**
+   * The messages added to the conversation, starting with the user's message.
+   *
+   * @generated from field: repeated rill.runtime.v1.Message messages = 2;
+   */
+  messages: Message[] = [];
+
+  constructor(data?: PartialMessage<CompleteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.CompleteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "conversation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "messages", kind: "message", T: Message, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteResponse {
+    return new CompleteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteResponse {
+    return new CompleteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteResponse {
+    return new CompleteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteResponse | PlainMessage<CompleteResponse> | undefined, b: CompleteResponse | PlainMessage<CompleteResponse> | undefined): boolean {
+    return proto3.util.equals(CompleteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.Conversation
+ */
+export class Conversation extends Message<Conversation> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string owner_id = 2;
+   */
+  ownerId = "";
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 5;
+   */
+  updatedOn?: Timestamp;
+
+  /**
+   * Only populated when getting a single conversation.
+   *
+   * @generated from field: repeated rill.runtime.v1.Message messages = 6;
+   */
+  messages: Message[] = [];
+
+  constructor(data?: PartialMessage<Conversation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Conversation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "owner_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_on", kind: "message", T: Timestamp },
+    { no: 5, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 6, name: "messages", kind: "message", T: Message, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Conversation {
+    return new Conversation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Conversation {
+    return new Conversation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Conversation {
+    return new Conversation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Conversation | PlainMessage<Conversation> | undefined, b: Conversation | PlainMessage<Conversation> | undefined): boolean {
+    return proto3.util.equals(Conversation, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.Message
+ */
+export class Message extends Message<Message> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Role is one of "user", "assistant" or "tool".
+   *
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  /**
+   * Tools called by the assistant.
+   *
+   * @generated from field: repeated rill.runtime.v1.ToolCall tool_calls = 4;
+   */
+  toolCalls: ToolCall[] = [];
+
+  /**
+   * For messages with role "tool", the ID of the tool call that the message is a result of.
+   *
+   * @generated from field: string tool_call_id = 5;
+   */
+  toolCallId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 6;
+   */
+  createdOn?: Timestamp;
+
+  constructor(data?: PartialMessage<Message>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Message";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "tool_calls", kind: "message", T: ToolCall, repeated: true },
+    { no: 5, name: "tool_call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Message {
+    return new Message().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Message {
+    return new Message().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Message {
+    return new Message().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Message | PlainMessage<Message> | undefined, b: Message | PlainMessage<Message> | undefined): boolean {
+    return proto3.util.equals(Message, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ToolCall
+ */
+export class ToolCall extends Message<ToolCall> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct input = 3;
+   */
+  input?: Struct;
+
+  constructor(data?: PartialMessage<ToolCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ToolCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "input", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ToolCall {
+    return new ToolCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ToolCall {
+    return new ToolCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ToolCall {
+    return new ToolCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ToolCall | PlainMessage<ToolCall> | undefined, b: ToolCall | PlainMessage<ToolCall> | undefined): boolean {
+    return proto3.util.equals(ToolCall, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.Log
+ */
+export class Log extends Message<Log> {
+  /**
+   * @generated from field: rill.runtime.v1.LogLevel level = 1;
+   */
+  level = LogLevel.UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 2;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  /**
+   * @generated from field: string json_payload = 4;
+   */
+  jsonPayload = "";
+
+  constructor(data?: PartialMessage<Log>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Log";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "level", kind: "enum", T: proto3.getEnumType(LogLevel) },
+    { no: 2, name: "time", kind: "message", T: Timestamp },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "json_payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Log {
+    return new Log().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Log {
+    return new Log().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Log {
+    return new Log().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Log | PlainMessage<Log> | undefined, b: Log | PlainMessage<Log> | undefined): boolean {
+    return proto3.util.equals(Log, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ModelPartition
+ */
+export class ModelPartition extends Message<ModelPartition> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct data = 2;
+   */
+  data?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp watermark = 3;
+   */
+  watermark?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp executed_on = 4;
+   */
+  executedOn?: Timestamp;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  /**
+   * @generated from field: uint32 elapsed_ms = 6;
+   */
+  elapsedMs = 0;
+
+  constructor(data?: PartialMessage<ModelPartition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelPartition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "data", kind: "message", T: Struct },
+    { no: 3, name: "watermark", kind: "message", T: Timestamp },
+    { no: 4, name: "executed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "elapsed_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelPartition {
+    return new ModelPartition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelPartition {
+    return new ModelPartition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelPartition {
+    return new ModelPartition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelPartition | PlainMessage<ModelPartition> | undefined, b: ModelPartition | PlainMessage<ModelPartition> | undefined): boolean {
+    return proto3.util.equals(ModelPartition, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetLogsRequest
+ */
+export class GetLogsRequest extends Message<GetLogsRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: bool ascending = 2;
+   */
+  ascending = false;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit = 0;
+
+  /**
+   * @generated from field: rill.runtime.v1.LogLevel level = 4;
+   */
+  level = LogLevel.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<GetLogsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetLogsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ascending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "level", kind: "enum", T: proto3.getEnumType(LogLevel) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLogsRequest {
+    return new GetLogsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLogsRequest {
+    return new GetLogsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLogsRequest {
+    return new GetLogsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLogsRequest | PlainMessage<GetLogsRequest> | undefined, b: GetLogsRequest | PlainMessage<GetLogsRequest> | undefined): boolean {
+    return proto3.util.equals(GetLogsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetLogsResponse
+ */
+export class GetLogsResponse extends Message<GetLogsResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.Log logs = 1;
+   */
+  logs: Log[] = [];
+
+  constructor(data?: PartialMessage<GetLogsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetLogsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "logs", kind: "message", T: Log, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLogsResponse {
+    return new GetLogsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLogsResponse {
+    return new GetLogsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLogsResponse {
+    return new GetLogsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLogsResponse | PlainMessage<GetLogsResponse> | undefined, b: GetLogsResponse | PlainMessage<GetLogsResponse> | undefined): boolean {
+    return proto3.util.equals(GetLogsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.WatchLogsRequest
+ */
+export class WatchLogsRequest extends Message<WatchLogsRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: bool replay = 2;
+   */
+  replay = false;
+
+  /**
+   * @generated from field: int32 replay_limit = 3;
+   */
+  replayLimit = 0;
+
+  /**
+   * @generated from field: rill.runtime.v1.LogLevel level = 4;
+   */
+  level = LogLevel.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<WatchLogsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.WatchLogsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "replay", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "replay_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "level", kind: "enum", T: proto3.getEnumType(LogLevel) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchLogsRequest {
+    return new WatchLogsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchLogsRequest {
+    return new WatchLogsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchLogsRequest {
+    return new WatchLogsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchLogsRequest | PlainMessage<WatchLogsRequest> | undefined, b: WatchLogsRequest | PlainMessage<WatchLogsRequest> | undefined): boolean {
+    return proto3.util.equals(WatchLogsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.WatchLogsResponse
+ */
+export class WatchLogsResponse extends Message<WatchLogsResponse> {
+  /**
+   * @generated from field: rill.runtime.v1.Log log = 1;
+   */
+  log?: Log;
+
+  constructor(data?: PartialMessage<WatchLogsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.WatchLogsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "log", kind: "message", T: Log },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchLogsResponse {
+    return new WatchLogsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchLogsResponse {
+    return new WatchLogsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchLogsResponse {
+    return new WatchLogsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchLogsResponse | PlainMessage<WatchLogsResponse> | undefined, b: WatchLogsResponse | PlainMessage<WatchLogsResponse> | undefined): boolean {
+    return proto3.util.equals(WatchLogsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ListResourcesRequest
+ */
+export class ListResourcesRequest extends Message<ListResourcesRequest> {
+  /**
+   * Instance to list resources from.
+   *
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Filter by resource kind (optional).
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * Filter by resource path (optional).
+   *
+   * @generated from field: string path = 3;
+   */
+  path = "";
+
+  /**
+   * Skip security checks
+   *
+   * @generated from field: bool skip_security_checks = 4;
+   */
+  skipSecurityChecks = false;
+
+  constructor(data?: PartialMessage<ListResourcesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ListResourcesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "skip_security_checks", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListResourcesRequest {
+    return new ListResourcesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListResourcesRequest {
+    return new ListResourcesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListResourcesRequest {
+    return new ListResourcesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListResourcesRequest | PlainMessage<ListResourcesRequest> | undefined, b: ListResourcesRequest | PlainMessage<ListResourcesRequest> | undefined): boolean {
+    return proto3.util.equals(ListResourcesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ListResourcesResponse
+ */
+export class ListResourcesResponse extends Message<ListResourcesResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.Resource resources = 1;
+   */
+  resources: Resource[] = [];
+
+  constructor(data?: PartialMessage<ListResourcesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ListResourcesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resources", kind: "message", T: Resource, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListResourcesResponse {
+    return new ListResourcesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListResourcesResponse {
+    return new ListResourcesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListResourcesResponse {
+    return new ListResourcesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListResourcesResponse | PlainMessage<ListResourcesResponse> | undefined, b: ListResourcesResponse | PlainMessage<ListResourcesResponse> | undefined): boolean {
+    return proto3.util.equals(ListResourcesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.WatchResourcesRequest
+ */
+export class WatchResourcesRequest extends Message<WatchResourcesRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: bool replay = 3;
+   */
+  replay = false;
+
+  /**
+   * @generated from field: string level = 4;
+   */
+  level = "";
+
+  constructor(data?: PartialMessage<WatchResourcesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.WatchResourcesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "replay", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchResourcesRequest {
+    return new WatchResourcesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchResourcesRequest {
+    return new WatchResourcesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchResourcesRequest {
+    return new WatchResourcesRequest().fromJsonString(jsonString, options);
   }
 
   static equals(a: WatchResourcesRequest | PlainMessage<WatchResourcesRequest> | undefined, b: WatchResourcesRequest | PlainMessage<WatchResourcesRequest> | undefined): boolean {
@@ -2932,137 +3635,395 @@ export class WatchResourcesRequest extends Message<WatchResourcesRequest> {
 }
 
 /**
- * @generated from message rill.runtime.v1.WatchResourcesResponse
+ * @generated from message rill.runtime.v1.WatchResourcesResponse
+ */
+export class WatchResourcesResponse extends Message<WatchResourcesResponse> {
+  /**
+   * @generated from field: rill.runtime.v1.ResourceEvent event = 1;
+   */
+  event = ResourceEvent.UNSPECIFIED;
+
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName name = 2;
+   */
+  name?: ResourceName;
+
+  /**
+   * @generated from field: rill.runtime.v1.Resource resource = 3;
+   */
+  resource?: Resource;
+
+  constructor(data?: PartialMessage<WatchResourcesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.WatchResourcesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event", kind: "enum", T: proto3.getEnumType(ResourceEvent) },
+    { no: 2, name: "name", kind: "message", T: ResourceName },
+    { no: 3, name: "resource", kind: "message", T: Resource },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchResourcesResponse {
+    return new WatchResourcesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchResourcesResponse {
+    return new WatchResourcesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchResourcesResponse {
+    return new WatchResourcesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchResourcesResponse | PlainMessage<WatchResourcesResponse> | undefined, b: WatchResourcesResponse | PlainMessage<WatchResourcesResponse> | undefined): boolean {
+    return proto3.util.equals(WatchResourcesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetResourceRequest
+ */
+export class GetResourceRequest extends Message<GetResourceRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName name = 2;
+   */
+  name?: ResourceName;
+
+  /**
+   * @generated from field: bool skip_security_checks = 3;
+   */
+  skipSecurityChecks = false;
+
+  constructor(data?: PartialMessage<GetResourceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetResourceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "message", T: ResourceName },
+    { no: 3, name: "skip_security_checks", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResourceRequest {
+    return new GetResourceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResourceRequest {
+    return new GetResourceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResourceRequest {
+    return new GetResourceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetResourceRequest | PlainMessage<GetResourceRequest> | undefined, b: GetResourceRequest | PlainMessage<GetResourceRequest> | undefined): boolean {
+    return proto3.util.equals(GetResourceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetResourceResponse
+ */
+export class GetResourceResponse extends Message<GetResourceResponse> {
+  /**
+   * @generated from field: rill.runtime.v1.Resource resource = 1;
+   */
+  resource?: Resource;
+
+  constructor(data?: PartialMessage<GetResourceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetResourceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: Resource },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResourceResponse {
+    return new GetResourceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResourceResponse {
+    return new GetResourceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResourceResponse {
+    return new GetResourceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetResourceResponse | PlainMessage<GetResourceResponse> | undefined, b: GetResourceResponse | PlainMessage<GetResourceResponse> | undefined): boolean {
+    return proto3.util.equals(GetResourceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetResourceLineageRequest
+ */
+export class GetResourceLineageRequest extends Message<GetResourceLineageRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName name = 2;
+   */
+  name?: ResourceName;
+
+  /**
+   * Whether to include column-level lineage for the models and metrics views in the graph.
+   *
+   * @generated from field: bool columns = 3;
+   */
+  columns = false;
+
+  constructor(data?: PartialMessage<GetResourceLineageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetResourceLineageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "message", T: ResourceName },
+    { no: 3, name: "columns", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResourceLineageRequest {
+    return new GetResourceLineageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResourceLineageRequest {
+    return new GetResourceLineageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResourceLineageRequest {
+    return new GetResourceLineageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetResourceLineageRequest | PlainMessage<GetResourceLineageRequest> | undefined, b: GetResourceLineageRequest | PlainMessage<GetResourceLineageRequest> | undefined): boolean {
+    return proto3.util.equals(GetResourceLineageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetResourceLineageResponse
+ */
+export class GetResourceLineageResponse extends Message<GetResourceLineageResponse> {
+  /**
+   * Resources that the resource depends on, directly or indirectly.
+   *
+   * @generated from field: repeated rill.runtime.v1.ResourceName upstream = 1;
+   */
+  upstream: ResourceName[] = [];
+
+  /**
+   * Resources that depend on the resource, directly or indirectly.
+   *
+   * @generated from field: repeated rill.runtime.v1.ResourceName downstream = 2;
+   */
+  downstream: ResourceName[] = [];
+
+  /**
+   * Direct dependencies between the resources in the graph (including the requested resource).
+   *
+   * @generated from field: repeated rill.runtime.v1.ResourceLineageEdge edges = 3;
+   */
+  edges: ResourceLineageEdge[] = [];
+
+  /**
+   * Column-level lineage for the models and metrics views in the graph.
+   * Only populated if columns is true in the request.
+   *
+   * @generated from field: repeated rill.runtime.v1.ColumnLineage columns = 4;
+   */
+  columns: ColumnLineage[] = [];
+
+  constructor(data?: PartialMessage<GetResourceLineageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetResourceLineageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "upstream", kind: "message", T: ResourceName, repeated: true },
+    { no: 2, name: "downstream", kind: "message", T: ResourceName, repeated: true },
+    { no: 3, name: "edges", kind: "message", T: ResourceLineageEdge, repeated: true },
+    { no: 4, name: "columns", kind: "message", T: ColumnLineage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResourceLineageResponse {
+    return new GetResourceLineageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResourceLineageResponse {
+    return new GetResourceLineageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResourceLineageResponse {
+    return new GetResourceLineageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetResourceLineageResponse | PlainMessage<GetResourceLineageResponse> | undefined, b: GetResourceLineageResponse | PlainMessage<GetResourceLineageResponse> | undefined): boolean {
+    return proto3.util.equals(GetResourceLineageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ResourceLineageEdge
  */
-export class WatchResourcesResponse extends Message<WatchResourcesResponse> {
-  /**
-   * @generated from field: rill.runtime.v1.ResourceEvent event = 1;
-   */
-  event = ResourceEvent.UNSPECIFIED;
-
+export class ResourceLineageEdge extends Message<ResourceLineageEdge> {
   /**
-   * @generated from field: rill.runtime.v1.ResourceName name = 2;
+   * The resource that is depended on.
+   *
+   * @generated from field: rill.runtime.v1.ResourceName from = 1;
    */
-  name?: ResourceName;
+  from?: ResourceName;
 
   /**
-   * @generated from field: rill.runtime.v1.Resource resource = 3;
+   * The resource that depends on from.
+   *
+   * @generated from field: rill.runtime.v1.ResourceName to = 2;
    */
-  resource?: Resource;
+  to?: ResourceName;
 
-  constructor(data?: PartialMessage<WatchResourcesResponse>) {
+  constructor(data?: PartialMessage<ResourceLineageEdge>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.WatchResourcesResponse";
+  static readonly typeName = "rill.runtime.v1.ResourceLineageEdge";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "event", kind: "enum", T: proto3.getEnumType(ResourceEvent) },
-    { no: 2, name: "name", kind: "message", T: ResourceName },
-    { no: 3, name: "resource", kind: "message", T: Resource },
+    { no: 1, name: "from", kind: "message", T: ResourceName },
+    { no: 2, name: "to", kind: "message", T: ResourceName },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchResourcesResponse {
-    return new WatchResourcesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceLineageEdge {
+    return new ResourceLineageEdge().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchResourcesResponse {
-    return new WatchResourcesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceLineageEdge {
+    return new ResourceLineageEdge().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchResourcesResponse {
-    return new WatchResourcesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceLineageEdge {
+    return new ResourceLineageEdge().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WatchResourcesResponse | PlainMessage<WatchResourcesResponse> | undefined, b: WatchResourcesResponse | PlainMessage<WatchResourcesResponse> | undefined): boolean {
-    return proto3.util.equals(WatchResourcesResponse, a, b);
+  static equals(a: ResourceLineageEdge | PlainMessage<ResourceLineageEdge> | undefined, b: ResourceLineageEdge | PlainMessage<ResourceLineageEdge> | undefined): boolean {
+    return proto3.util.equals(ResourceLineageEdge, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.GetResourceRequest
+ * @generated from message rill.runtime.v1.ColumnLineage
  */
-export class GetResourceRequest extends Message<GetResourceRequest> {
+export class ColumnLineage extends Message<ColumnLineage> {
   /**
-   * @generated from field: string instance_id = 1;
+   * @generated from field: rill.runtime.v1.ResourceName resource = 1;
    */
-  instanceId = "";
+  resource?: ResourceName;
 
   /**
-   * @generated from field: rill.runtime.v1.ResourceName name = 2;
+   * Name of a column of a model, or of a dimension or measure of a metrics view.
+   *
+   * @generated from field: string column = 2;
    */
-  name?: ResourceName;
+  column = "";
 
   /**
-   * @generated from field: bool skip_security_checks = 3;
+   * The upstream columns that the column is derived from.
+   *
+   * @generated from field: repeated rill.runtime.v1.ColumnLineageSource sources = 3;
    */
-  skipSecurityChecks = false;
+  sources: ColumnLineageSource[] = [];
 
-  constructor(data?: PartialMessage<GetResourceRequest>) {
+  constructor(data?: PartialMessage<ColumnLineage>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.GetResourceRequest";
+  static readonly typeName = "rill.runtime.v1.ColumnLineage";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "message", T: ResourceName },
-    { no: 3, name: "skip_security_checks", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "resource", kind: "message", T: ResourceName },
+    { no: 2, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "sources", kind: "message", T: ColumnLineageSource, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResourceRequest {
-    return new GetResourceRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnLineage {
+    return new ColumnLineage().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResourceRequest {
-    return new GetResourceRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnLineage {
+    return new ColumnLineage().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResourceRequest {
-    return new GetResourceRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnLineage {
+    return new ColumnLineage().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetResourceRequest | PlainMessage<GetResourceRequest> | undefined, b: GetResourceRequest | PlainMessage<GetResourceRequest> | undefined): boolean {
-    return proto3.util.equals(GetResourceRequest, a, b);
+  static equals(a: ColumnLineage | PlainMessage<ColumnLineage> | undefined, b: ColumnLineage | PlainMessage<ColumnLineage> | undefined): boolean {
+    return proto3.util.equals(ColumnLineage, a, b);
   }
 }
 
 /**
- * @generated from message rill.runtime.v1.GetResourceResponse
+ * @generated from message rill.runtime.v1.ColumnLineageSource
  */
-export class GetResourceResponse extends Message<GetResourceResponse> {
+export class ColumnLineageSource extends Message<ColumnLineageSource> {
   /**
-   * @generated from field: rill.runtime.v1.Resource resource = 1;
+   * @generated from field: rill.runtime.v1.ResourceName resource = 1;
    */
-  resource?: Resource;
+  resource?: ResourceName;
 
-  constructor(data?: PartialMessage<GetResourceResponse>) {
+  /**
+   * @generated from field: string column = 2;
+   */
+  column = "";
+
+  constructor(data?: PartialMessage<ColumnLineageSource>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.runtime.v1.GetResourceResponse";
+  static readonly typeName = "rill.runtime.v1.ColumnLineageSource";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "resource", kind: "message", T: Resource },
+    { no: 1, name: "resource", kind: "message", T: ResourceName },
+    { no: 2, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResourceResponse {
-    return new GetResourceResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnLineageSource {
+    return new ColumnLineageSource().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResourceResponse {
-    return new GetResourceResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnLineageSource {
+    return new ColumnLineageSource().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResourceResponse {
-    return new GetResourceResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnLineageSource {
+    return new ColumnLineageSource().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetResourceResponse | PlainMessage<GetResourceResponse> | undefined, b: GetResourceResponse | PlainMessage<GetResourceResponse> | undefined): boolean {
-    return proto3.util.equals(GetResourceResponse, a, b);
+  static equals(a: ColumnLineageSource | PlainMessage<ColumnLineageSource> | undefined, b: ColumnLineageSource | PlainMessage<ColumnLineageSource> | undefined): boolean {
+    return proto3.util.equals(ColumnLineageSource, a, b);
   }
 }
 
@@ -4138,6 +5099,319 @@ export class IssueDevJWTResponse extends Message<IssueDevJWTResponse> {
   }
 }
 
+/**
+ * Request message for RuntimeService.ExplainSecurity
+ *
+ * @generated from message rill.runtime.v1.ExplainSecurityRequest
+ */
+export class ExplainSecurityRequest extends Message<ExplainSecurityRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * User attributes to simulate. They are available in security rule templates as {{ .user }}.
+   *
+   * @generated from field: google.protobuf.Struct attributes = 2;
+   */
+  attributes?: Struct;
+
+  /**
+   * Optional resources to explain. If empty, all metrics views, explores, canvases and APIs are explained.
+   *
+   * @generated from field: repeated rill.runtime.v1.ResourceName resources = 3;
+   */
+  resources: ResourceName[] = [];
+
+  constructor(data?: PartialMessage<ExplainSecurityRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ExplainSecurityRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "attributes", kind: "message", T: Struct },
+    { no: 3, name: "resources", kind: "message", T: ResourceName, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainSecurityRequest {
+    return new ExplainSecurityRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainSecurityRequest {
+    return new ExplainSecurityRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainSecurityRequest {
+    return new ExplainSecurityRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainSecurityRequest | PlainMessage<ExplainSecurityRequest> | undefined, b: ExplainSecurityRequest | PlainMessage<ExplainSecurityRequest> | undefined): boolean {
+    return proto3.util.equals(ExplainSecurityRequest, a, b);
+  }
+}
+
+/**
+ * Response message for RuntimeService.ExplainSecurity
+ *
+ * @generated from message rill.runtime.v1.ExplainSecurityResponse
+ */
+export class ExplainSecurityResponse extends Message<ExplainSecurityResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.ResourceSecurityExplanation resources = 1;
+   */
+  resources: ResourceSecurityExplanation[] = [];
+
+  constructor(data?: PartialMessage<ExplainSecurityResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ExplainSecurityResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resources", kind: "message", T: ResourceSecurityExplanation, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainSecurityResponse {
+    return new ExplainSecurityResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainSecurityResponse {
+    return new ExplainSecurityResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainSecurityResponse {
+    return new ExplainSecurityResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainSecurityResponse | PlainMessage<ExplainSecurityResponse> | undefined, b: ExplainSecurityResponse | PlainMessage<ExplainSecurityResponse> | undefined): boolean {
+    return proto3.util.equals(ExplainSecurityResponse, a, b);
+  }
+}
+
+/**
+ * ResourceSecurityExplanation describes the resolved security of a single resource for the simulated user.
+ *
+ * @generated from message rill.runtime.v1.ResourceSecurityExplanation
+ */
+export class ResourceSecurityExplanation extends Message<ResourceSecurityExplanation> {
+  /**
+   * @generated from field: rill.runtime.v1.ResourceName resource = 1;
+   */
+  resource?: ResourceName;
+
+  /**
+   * Whether the user can access the resource.
+   *
+   * @generated from field: bool access = 2;
+   */
+  access = false;
+
+  /**
+   * Index in rules of the rule that decided access.
+   * It is -1 if no rule granted or denied access, in which case access is implicitly denied.
+   *
+   * @generated from field: int32 access_rule_index = 3;
+   */
+  accessRuleIndex = 0;
+
+  /**
+   * Whether all fields can be accessed (i.e. no field access rules applied).
+   *
+   * @generated from field: bool all_fields = 4;
+   */
+  allFields = false;
+
+  /**
+   * Access decision for each field. Only populated if all_fields is false.
+   *
+   * @generated from field: repeated rill.runtime.v1.FieldSecurityExplanation fields = 5;
+   */
+  fields: FieldSecurityExplanation[] = [];
+
+  /**
+   * The effective SQL row filter.
+   *
+   * @generated from field: string row_filter = 6;
+   */
+  rowFilter = "";
+
+  /**
+   * The effective query filter expression.
+   *
+   * @generated from field: rill.runtime.v1.Expression query_filter = 7;
+   */
+  queryFilter?: Expression;
+
+  /**
+   * The rules that were evaluated, in evaluation order.
+   *
+   * @generated from field: repeated rill.runtime.v1.SecurityRuleEvaluation rules = 8;
+   */
+  rules: SecurityRuleEvaluation[] = [];
+
+  /**
+   * Error encountered while resolving the rules (e.g. an invalid template).
+   *
+   * @generated from field: string error = 9;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ResourceSecurityExplanation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ResourceSecurityExplanation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: ResourceName },
+    { no: 2, name: "access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "access_rule_index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "all_fields", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "fields", kind: "message", T: FieldSecurityExplanation, repeated: true },
+    { no: 6, name: "row_filter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "query_filter", kind: "message", T: Expression },
+    { no: 8, name: "rules", kind: "message", T: SecurityRuleEvaluation, repeated: true },
+    { no: 9, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceSecurityExplanation {
+    return new ResourceSecurityExplanation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceSecurityExplanation {
+    return new ResourceSecurityExplanation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceSecurityExplanation {
+    return new ResourceSecurityExplanation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceSecurityExplanation | PlainMessage<ResourceSecurityExplanation> | undefined, b: ResourceSecurityExplanation | PlainMessage<ResourceSecurityExplanation> | undefined): boolean {
+    return proto3.util.equals(ResourceSecurityExplanation, a, b);
+  }
+}
+
+/**
+ * FieldSecurityExplanation describes the access decision for a single field of a metrics view or explore.
+ *
+ * @generated from message rill.runtime.v1.FieldSecurityExplanation
+ */
+export class FieldSecurityExplanation extends Message<FieldSecurityExplanation> {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field = "";
+
+  /**
+   * @generated from field: bool allowed = 2;
+   */
+  allowed = false;
+
+  /**
+   * Index in ResourceSecurityExplanation.rules of the rule that decided access to the field.
+   * It is -1 if no rule mentioned the field, in which case it is implicitly denied.
+   *
+   * @generated from field: int32 rule_index = 3;
+   */
+  ruleIndex = 0;
+
+  constructor(data?: PartialMessage<FieldSecurityExplanation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.FieldSecurityExplanation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "allowed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "rule_index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FieldSecurityExplanation {
+    return new FieldSecurityExplanation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FieldSecurityExplanation {
+    return new FieldSecurityExplanation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FieldSecurityExplanation {
+    return new FieldSecurityExplanation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FieldSecurityExplanation | PlainMessage<FieldSecurityExplanation> | undefined, b: FieldSecurityExplanation | PlainMessage<FieldSecurityExplanation> | undefined): boolean {
+    return proto3.util.equals(FieldSecurityExplanation, a, b);
+  }
+}
+
+/**
+ * SecurityRuleEvaluation describes the evaluation of a single security rule.
+ *
+ * @generated from message rill.runtime.v1.SecurityRuleEvaluation
+ */
+export class SecurityRuleEvaluation extends Message<SecurityRuleEvaluation> {
+  /**
+   * @generated from field: rill.runtime.v1.SecurityRule rule = 1;
+   */
+  rule?: SecurityRule;
+
+  /**
+   * @generated from field: rill.runtime.v1.SecurityRuleSource source = 2;
+   */
+  source = SecurityRuleSource.UNSPECIFIED;
+
+  /**
+   * Whether the rule's condition evaluated to true (or the rule has no condition).
+   *
+   * @generated from field: bool condition_matched = 3;
+   */
+  conditionMatched = false;
+
+  /**
+   * Whether evaluation of the rule was skipped because access had already been denied by an earlier rule.
+   *
+   * @generated from field: bool skipped = 4;
+   */
+  skipped = false;
+
+  constructor(data?: PartialMessage<SecurityRuleEvaluation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.SecurityRuleEvaluation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: SecurityRule },
+    { no: 2, name: "source", kind: "enum", T: proto3.getEnumType(SecurityRuleSource) },
+    { no: 3, name: "condition_matched", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "skipped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecurityRuleEvaluation {
+    return new SecurityRuleEvaluation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecurityRuleEvaluation {
+    return new SecurityRuleEvaluation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecurityRuleEvaluation {
+    return new SecurityRuleEvaluation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecurityRuleEvaluation | PlainMessage<SecurityRuleEvaluation> | undefined, b: SecurityRuleEvaluation | PlainMessage<SecurityRuleEvaluation> | undefined): boolean {
+    return proto3.util.equals(SecurityRuleEvaluation, a, b);
+  }
+}
+
 /**
  * Request message for RuntimeService.AnalyzeVariables
  *
@@ -3,7 +3,8 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3 } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum rill.runtime.v1.ExportFormat
@@ -28,6 +29,44 @@ export enum ExportFormat {
    * @generated from enum value: EXPORT_FORMAT_PARQUET = 3;
    */
   PARQUET = 3,
+
+  /**
+   * Newline-delimited JSON, with one JSON object per row.
+   *
+   * @generated from enum value: EXPORT_FORMAT_JSONL = 4;
+   */
+  JSONL = 4,
+
+  /**
+   * Arrow IPC stream.
+   *
+   * @generated from enum value: EXPORT_FORMAT_ARROW = 5;
+   */
+  ARROW = 5,
+
+  /**
+   * Writes the result to a tab in a Google Sheet instead of a file.
+   * It requires the export request to provide GoogleSheetsExportOptions.
+   *
+   * @generated from enum value: EXPORT_FORMAT_GOOGLE_SHEETS = 6;
+   */
+  GOOGLE_SHEETS = 6,
+
+  /**
+   * Rendered snapshot of a canvas or explore dashboard as a PDF document.
+   * It is only supported for reports, which must provide ReportSnapshotOptions.
+   *
+   * @generated from enum value: EXPORT_FORMAT_PDF = 7;
+   */
+  PDF = 7,
+
+  /**
+   * Rendered snapshot of a canvas or explore dashboard as a PNG image.
+   * It is only supported for reports, which must provide ReportSnapshotOptions.
+   *
+   * @generated from enum value: EXPORT_FORMAT_PNG = 8;
+   */
+  PNG = 8,
 }
 // Retrieve enum metadata with: proto3.getEnumType(ExportFormat)
 proto3.util.setEnumType(ExportFormat, "rill.runtime.v1.ExportFormat", [
@@ -35,5 +74,59 @@ proto3.util.setEnumType(ExportFormat, "rill.runtime.v1.ExportFormat", [
   { no: 1, name: "EXPORT_FORMAT_CSV" },
   { no: 2, name: "EXPORT_FORMAT_XLSX" },
   { no: 3, name: "EXPORT_FORMAT_PARQUET" },
+  { no: 4, name: "EXPORT_FORMAT_JSONL" },
+  { no: 5, name: "EXPORT_FORMAT_ARROW" },
+  { no: 6, name: "EXPORT_FORMAT_GOOGLE_SHEETS" },
+  { no: 7, name: "EXPORT_FORMAT_PDF" },
+  { no: 8, name: "EXPORT_FORMAT_PNG" },
 ]);
 
+/**
+ * GoogleSheetsExportOptions configures the destination of an export in EXPORT_FORMAT_GOOGLE_SHEETS.
+ *
+ * @generated from message rill.runtime.v1.GoogleSheetsExportOptions
+ */
+export class GoogleSheetsExportOptions extends Message<GoogleSheetsExportOptions> {
+  /**
+   * ID of the spreadsheet, as found in its URL.
+   *
+   * @generated from field: string spreadsheet_id = 1;
+   */
+  spreadsheetId = "";
+
+  /**
+   * Name of the tab to write to. It is created if it doesn't exist, and its contents are replaced if it does.
+   *
+   * @generated from field: string sheet = 2;
+   */
+  sheet = "";
+
+  constructor(data?: PartialMessage<GoogleSheetsExportOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GoogleSheetsExportOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spreadsheet_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sheet", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GoogleSheetsExportOptions {
+    return new GoogleSheetsExportOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GoogleSheetsExportOptions {
+    return new GoogleSheetsExportOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GoogleSheetsExportOptions {
+    return new GoogleSheetsExportOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GoogleSheetsExportOptions | PlainMessage<GoogleSheetsExportOptions> | undefined, b: GoogleSheetsExportOptions | PlainMessage<GoogleSheetsExportOptions> | undefined): boolean {
+    return proto3.util.equals(GoogleSheetsExportOptions, a, b);
+  }
+}
+
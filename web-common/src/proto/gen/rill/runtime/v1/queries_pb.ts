@@ -6,7 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct, Timestamp, Value } from "@bufbuild/protobuf";
 import { StructType } from "./schema_pb.js";
-import { ExportFormat } from "./export_format_pb.js";
+import { ExportFormat, GoogleSheetsExportOptions } from "./export_format_pb.js";
 import { Expression } from "./expression_pb.js";
 import { TimeGrain } from "./time_grain_pb.js";
 import { Resource } from "./resources_pb.js";
@@ -115,6 +115,40 @@ proto3.util.setEnumType(MetricsViewComparisonMeasureType, "rill.runtime.v1.Metri
   { no: 4, name: "METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA" },
 ]);
 
+/**
+ * @generated from enum rill.runtime.v1.ForecastMethod
+ */
+export enum ForecastMethod {
+  /**
+   * Uses Holt-Winters if the series covers at least two seasons, and a linear trend otherwise.
+   *
+   * @generated from enum value: FORECAST_METHOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FORECAST_METHOD_SEASONAL_NAIVE = 1;
+   */
+  SEASONAL_NAIVE = 1,
+
+  /**
+   * @generated from enum value: FORECAST_METHOD_HOLT_WINTERS = 2;
+   */
+  HOLT_WINTERS = 2,
+
+  /**
+   * @generated from enum value: FORECAST_METHOD_LINEAR_TREND = 3;
+   */
+  LINEAR_TREND = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ForecastMethod)
+proto3.util.setEnumType(ForecastMethod, "rill.runtime.v1.ForecastMethod", [
+  { no: 0, name: "FORECAST_METHOD_UNSPECIFIED" },
+  { no: 1, name: "FORECAST_METHOD_SEASONAL_NAIVE" },
+  { no: 2, name: "FORECAST_METHOD_HOLT_WINTERS" },
+  { no: 3, name: "FORECAST_METHOD_LINEAR_TREND" },
+]);
+
 /**
  * @generated from enum rill.runtime.v1.HistogramMethod
  */
@@ -257,6 +291,126 @@ export class QueryResponse extends Message<QueryResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.QueryResolverRequest
+ */
+export class QueryResolverRequest extends Message<QueryResolverRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * Resolver to use, such as "sql" or "metrics_sql".
+   *
+   * @generated from field: string resolver = 2;
+   */
+  resolver = "";
+
+  /**
+   * Properties for the resolver, such as the SQL query.
+   *
+   * @generated from field: google.protobuf.Struct resolver_properties = 3;
+   */
+  resolverProperties?: Struct;
+
+  /**
+   * Args for the resolver.
+   *
+   * @generated from field: google.protobuf.Struct resolver_args = 4;
+   */
+  resolverArgs?: Struct;
+
+  /**
+   * Optional user attributes to run the query as. If not set, the query runs with the caller's attributes.
+   *
+   * @generated from field: google.protobuf.Struct attributes = 5;
+   */
+  attributes?: Struct;
+
+  /**
+   * Maximum number of rows to return. Defaults to 100.
+   *
+   * @generated from field: int32 limit = 6;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<QueryResolverRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.QueryResolverRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "resolver_properties", kind: "message", T: Struct },
+    { no: 4, name: "resolver_args", kind: "message", T: Struct },
+    { no: 5, name: "attributes", kind: "message", T: Struct },
+    { no: 6, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryResolverRequest {
+    return new QueryResolverRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryResolverRequest {
+    return new QueryResolverRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryResolverRequest {
+    return new QueryResolverRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryResolverRequest | PlainMessage<QueryResolverRequest> | undefined, b: QueryResolverRequest | PlainMessage<QueryResolverRequest> | undefined): boolean {
+    return proto3.util.equals(QueryResolverRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.QueryResolverResponse
+ */
+export class QueryResolverResponse extends Message<QueryResolverResponse> {
+  /**
+   * @generated from field: rill.runtime.v1.StructType schema = 1;
+   */
+  schema?: StructType;
+
+  /**
+   * @generated from field: repeated google.protobuf.Struct data = 2;
+   */
+  data: Struct[] = [];
+
+  constructor(data?: PartialMessage<QueryResolverResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.QueryResolverResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "message", T: StructType },
+    { no: 2, name: "data", kind: "message", T: Struct, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryResolverResponse {
+    return new QueryResolverResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryResolverResponse {
+    return new QueryResolverResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryResolverResponse {
+    return new QueryResolverResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryResolverResponse | PlainMessage<QueryResolverResponse> | undefined, b: QueryResolverResponse | PlainMessage<QueryResolverResponse> | undefined): boolean {
+    return proto3.util.equals(QueryResolverResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.QueryBatchRequest
  */
@@ -379,6 +533,13 @@ export class ExportRequest extends Message<ExportRequest> {
    */
   bakedQuery = "";
 
+  /**
+   * Destination for EXPORT_FORMAT_GOOGLE_SHEETS.
+   *
+   * @generated from field: rill.runtime.v1.GoogleSheetsExportOptions google_sheets = 6;
+   */
+  googleSheets?: GoogleSheetsExportOptions;
+
   constructor(data?: PartialMessage<ExportRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -392,6 +553,7 @@ export class ExportRequest extends Message<ExportRequest> {
     { no: 3, name: "format", kind: "enum", T: proto3.getEnumType(ExportFormat) },
     { no: 4, name: "query", kind: "message", T: Query },
     { no: 5, name: "baked_query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "google_sheets", kind: "message", T: GoogleSheetsExportOptions },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportRequest {
@@ -420,6 +582,13 @@ export class ExportResponse extends Message<ExportResponse> {
    */
   downloadUrlPath = "";
 
+  /**
+   * URL of the sheet the data was written to (only for EXPORT_FORMAT_GOOGLE_SHEETS).
+   *
+   * @generated from field: string spreadsheet_url = 2;
+   */
+  spreadsheetUrl = "";
+
   constructor(data?: PartialMessage<ExportResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -429,6 +598,7 @@ export class ExportResponse extends Message<ExportResponse> {
   static readonly typeName = "rill.runtime.v1.ExportResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "download_url_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "spreadsheet_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportResponse {
@@ -667,6 +837,12 @@ export class Query extends Message<Query> {
      */
     value: TableRowsRequest;
     case: "tableRowsRequest";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.MetricsViewContributionRequest metrics_view_contribution_request = 21;
+     */
+    value: MetricsViewContributionRequest;
+    case: "metricsViewContributionRequest";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Query>) {
@@ -696,6 +872,7 @@ export class Query extends Message<Query> {
     { no: 17, name: "table_cardinality_request", kind: "message", T: TableCardinalityRequest, oneof: "query" },
     { no: 18, name: "table_columns_request", kind: "message", T: TableColumnsRequest, oneof: "query" },
     { no: 19, name: "table_rows_request", kind: "message", T: TableRowsRequest, oneof: "query" },
+    { no: 21, name: "metrics_view_contribution_request", kind: "message", T: MetricsViewContributionRequest, oneof: "query" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Query {
@@ -836,6 +1013,12 @@ export class QueryResult extends Message<QueryResult> {
      */
     value: TableRowsResponse;
     case: "tableRowsResponse";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.MetricsViewContributionResponse metrics_view_contribution_response = 22;
+     */
+    value: MetricsViewContributionResponse;
+    case: "metricsViewContributionResponse";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<QueryResult>) {
@@ -865,6 +1048,7 @@ export class QueryResult extends Message<QueryResult> {
     { no: 18, name: "table_cardinality_response", kind: "message", T: TableCardinalityResponse, oneof: "result" },
     { no: 19, name: "table_columns_response", kind: "message", T: TableColumnsResponse, oneof: "result" },
     { no: 20, name: "table_rows_response", kind: "message", T: TableRowsResponse, oneof: "result" },
+    { no: 22, name: "metrics_view_contribution_response", kind: "message", T: MetricsViewContributionResponse, oneof: "result" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryResult {
@@ -1987,6 +2171,358 @@ export class MetricsViewComparisonResponse extends Message<MetricsViewComparison
   }
 }
 
+/**
+ * Request message for QueryService.MetricsViewContribution
+ *
+ * @generated from message rill.runtime.v1.MetricsViewContributionRequest
+ */
+export class MetricsViewContributionRequest extends Message<MetricsViewContributionRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string metrics_view_name = 2;
+   */
+  metricsViewName = "";
+
+  /**
+   * Required. The measure to explain the change of.
+   * The contributions of dimension values only add up to the total change for additive measures, such as sums and counts.
+   *
+   * @generated from field: string measure = 3;
+   */
+  measure = "";
+
+  /**
+   * Optional. A measure for the volume that drives the measure, such as the number of orders for a revenue measure.
+   * If set, the change of each dimension value is decomposed into volume, mix and rate effects.
+   *
+   * @generated from field: string volume_measure = 4;
+   */
+  volumeMeasure = "";
+
+  /**
+   * Optional. Defaults to all dimensions of the metrics view
+   *
+   * @generated from field: repeated string dimensions = 5;
+   */
+  dimensions: string[] = [];
+
+  /**
+   * Required
+   *
+   * @generated from field: rill.runtime.v1.TimeRange time_range = 6;
+   */
+  timeRange?: TimeRange;
+
+  /**
+   * Required
+   *
+   * @generated from field: rill.runtime.v1.TimeRange comparison_time_range = 7;
+   */
+  comparisonTimeRange?: TimeRange;
+
+  /**
+   * Optional
+   *
+   * @generated from field: rill.runtime.v1.Expression where = 8;
+   */
+  where?: Expression;
+
+  /**
+   * Optional. The max number of values to return for each dimension. Defaults to 10
+   *
+   * @generated from field: int64 limit = 9;
+   */
+  limit = protoInt64.zero;
+
+  /**
+   * Optional. If true, the response includes a summary of the results written by AI.
+   *
+   * @generated from field: bool narrative = 10;
+   */
+  narrative = false;
+
+  /**
+   * Optional
+   *
+   * @generated from field: int32 priority = 11;
+   */
+  priority = 0;
+
+  constructor(data?: PartialMessage<MetricsViewContributionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewContributionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "metrics_view_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "measure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "volume_measure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "dimensions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "time_range", kind: "message", T: TimeRange },
+    { no: 7, name: "comparison_time_range", kind: "message", T: TimeRange },
+    { no: 8, name: "where", kind: "message", T: Expression },
+    { no: 9, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "narrative", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewContributionRequest {
+    return new MetricsViewContributionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewContributionRequest {
+    return new MetricsViewContributionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewContributionRequest {
+    return new MetricsViewContributionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewContributionRequest | PlainMessage<MetricsViewContributionRequest> | undefined, b: MetricsViewContributionRequest | PlainMessage<MetricsViewContributionRequest> | undefined): boolean {
+    return proto3.util.equals(MetricsViewContributionRequest, a, b);
+  }
+}
+
+/**
+ * Response message for QueryService.MetricsViewContribution
+ *
+ * @generated from message rill.runtime.v1.MetricsViewContributionResponse
+ */
+export class MetricsViewContributionResponse extends Message<MetricsViewContributionResponse> {
+  /**
+   * Totals of the measure (and volume measure) across all dimension values
+   *
+   * @generated from field: rill.runtime.v1.MetricsViewContributionTotals totals = 1;
+   */
+  totals?: MetricsViewContributionTotals;
+
+  /**
+   * Dimension values ordered by the magnitude of their contribution to the change
+   *
+   * @generated from field: repeated rill.runtime.v1.MetricsViewContributionRow rows = 2;
+   */
+  rows: MetricsViewContributionRow[] = [];
+
+  /**
+   * Summary of the results written by AI. Only set if requested.
+   *
+   * @generated from field: string narrative = 3;
+   */
+  narrative = "";
+
+  constructor(data?: PartialMessage<MetricsViewContributionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewContributionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "totals", kind: "message", T: MetricsViewContributionTotals },
+    { no: 2, name: "rows", kind: "message", T: MetricsViewContributionRow, repeated: true },
+    { no: 3, name: "narrative", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewContributionResponse {
+    return new MetricsViewContributionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewContributionResponse {
+    return new MetricsViewContributionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewContributionResponse {
+    return new MetricsViewContributionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewContributionResponse | PlainMessage<MetricsViewContributionResponse> | undefined, b: MetricsViewContributionResponse | PlainMessage<MetricsViewContributionResponse> | undefined): boolean {
+    return proto3.util.equals(MetricsViewContributionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.MetricsViewContributionTotals
+ */
+export class MetricsViewContributionTotals extends Message<MetricsViewContributionTotals> {
+  /**
+   * @generated from field: double base_value = 1;
+   */
+  baseValue = 0;
+
+  /**
+   * @generated from field: double comparison_value = 2;
+   */
+  comparisonValue = 0;
+
+  /**
+   * @generated from field: double delta_abs = 3;
+   */
+  deltaAbs = 0;
+
+  /**
+   * Null if the comparison value is zero
+   *
+   * @generated from field: google.protobuf.Value delta_rel = 4;
+   */
+  deltaRel?: Value;
+
+  /**
+   * @generated from field: double base_volume = 5;
+   */
+  baseVolume = 0;
+
+  /**
+   * @generated from field: double comparison_volume = 6;
+   */
+  comparisonVolume = 0;
+
+  constructor(data?: PartialMessage<MetricsViewContributionTotals>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewContributionTotals";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "base_value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "comparison_value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "delta_abs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "delta_rel", kind: "message", T: Value },
+    { no: 5, name: "base_volume", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "comparison_volume", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewContributionTotals {
+    return new MetricsViewContributionTotals().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewContributionTotals {
+    return new MetricsViewContributionTotals().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewContributionTotals {
+    return new MetricsViewContributionTotals().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewContributionTotals | PlainMessage<MetricsViewContributionTotals> | undefined, b: MetricsViewContributionTotals | PlainMessage<MetricsViewContributionTotals> | undefined): boolean {
+    return proto3.util.equals(MetricsViewContributionTotals, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.MetricsViewContributionRow
+ */
+export class MetricsViewContributionRow extends Message<MetricsViewContributionRow> {
+  /**
+   * @generated from field: string dimension = 1;
+   */
+  dimension = "";
+
+  /**
+   * @generated from field: google.protobuf.Value dimension_value = 2;
+   */
+  dimensionValue?: Value;
+
+  /**
+   * @generated from field: double base_value = 3;
+   */
+  baseValue = 0;
+
+  /**
+   * @generated from field: double comparison_value = 4;
+   */
+  comparisonValue = 0;
+
+  /**
+   * The change of the measure for the dimension value
+   *
+   * @generated from field: double delta_abs = 5;
+   */
+  deltaAbs = 0;
+
+  /**
+   * The change as a fraction of the total change. Zero if the total didn't change.
+   *
+   * @generated from field: double contribution = 6;
+   */
+  contribution = 0;
+
+  /**
+   * The volume and effects are only set if a volume measure was requested.
+   * The effects add up to delta_abs:
+   * - volume_effect is the change explained by the change of the total volume,
+   * - mix_effect is the change explained by the dimension value's share of the total volume,
+   * - rate_effect is the change explained by the measure's value per unit of volume.
+   *
+   * @generated from field: double base_volume = 7;
+   */
+  baseVolume = 0;
+
+  /**
+   * @generated from field: double comparison_volume = 8;
+   */
+  comparisonVolume = 0;
+
+  /**
+   * @generated from field: double volume_effect = 9;
+   */
+  volumeEffect = 0;
+
+  /**
+   * @generated from field: double mix_effect = 10;
+   */
+  mixEffect = 0;
+
+  /**
+   * @generated from field: double rate_effect = 11;
+   */
+  rateEffect = 0;
+
+  constructor(data?: PartialMessage<MetricsViewContributionRow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewContributionRow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dimension_value", kind: "message", T: Value },
+    { no: 3, name: "base_value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "comparison_value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "delta_abs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "contribution", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "base_volume", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "comparison_volume", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "volume_effect", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 10, name: "mix_effect", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 11, name: "rate_effect", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewContributionRow {
+    return new MetricsViewContributionRow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewContributionRow {
+    return new MetricsViewContributionRow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewContributionRow {
+    return new MetricsViewContributionRow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewContributionRow | PlainMessage<MetricsViewContributionRow> | undefined, b: MetricsViewContributionRow | PlainMessage<MetricsViewContributionRow> | undefined): boolean {
+    return proto3.util.equals(MetricsViewContributionRow, a, b);
+  }
+}
+
 /**
  * 2 of the (start, end, iso_duration) should be set
  *
@@ -2390,6 +2926,13 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
    */
   filter?: MetricsViewFilter;
 
+  /**
+   * Optional. If set, the measures are forecasted for a number of periods after the last period with data.
+   *
+   * @generated from field: rill.runtime.v1.MetricsViewTimeSeriesForecast forecast = 15;
+   */
+  forecast?: MetricsViewTimeSeriesForecast;
+
   constructor(data?: PartialMessage<MetricsViewTimeSeriesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2411,6 +2954,7 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
     { no: 10, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 12, name: "filter", kind: "message", T: MetricsViewFilter },
+    { no: 15, name: "forecast", kind: "message", T: MetricsViewTimeSeriesForecast },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewTimeSeriesRequest {
@@ -2448,6 +2992,13 @@ export class MetricsViewTimeSeriesResponse extends Message<MetricsViewTimeSeries
    */
   data: TimeSeriesValue[] = [];
 
+  /**
+   * Forecasted values. Only set if a forecast was requested.
+   *
+   * @generated from field: repeated rill.runtime.v1.TimeSeriesForecastValue forecast = 3;
+   */
+  forecast: TimeSeriesForecastValue[] = [];
+
   constructor(data?: PartialMessage<MetricsViewTimeSeriesResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2458,6 +3009,7 @@ export class MetricsViewTimeSeriesResponse extends Message<MetricsViewTimeSeries
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "meta", kind: "message", T: MetricsViewColumn, repeated: true },
     { no: 2, name: "data", kind: "message", T: TimeSeriesValue, repeated: true },
+    { no: 3, name: "forecast", kind: "message", T: TimeSeriesForecastValue, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewTimeSeriesResponse {
@@ -2477,6 +3029,136 @@ export class MetricsViewTimeSeriesResponse extends Message<MetricsViewTimeSeries
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.MetricsViewTimeSeriesForecast
+ */
+export class MetricsViewTimeSeriesForecast extends Message<MetricsViewTimeSeriesForecast> {
+  /**
+   * Optional. Defaults to all the measures in the request.
+   *
+   * @generated from field: repeated string measure_names = 1;
+   */
+  measureNames: string[] = [];
+
+  /**
+   * Number of periods to forecast.
+   *
+   * @generated from field: int32 periods = 2;
+   */
+  periods = 0;
+
+  /**
+   * @generated from field: rill.runtime.v1.ForecastMethod method = 3;
+   */
+  method = ForecastMethod.UNSPECIFIED;
+
+  /**
+   * Optional. Number of periods in a season. Defaults to a length based on the time granularity, e.g. 7 for days.
+   *
+   * @generated from field: int32 season_length = 4;
+   */
+  seasonLength = 0;
+
+  /**
+   * Optional. Confidence level of the prediction intervals. Defaults to 0.95.
+   *
+   * @generated from field: double confidence = 5;
+   */
+  confidence = 0;
+
+  constructor(data?: PartialMessage<MetricsViewTimeSeriesForecast>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewTimeSeriesForecast";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "measure_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "periods", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "method", kind: "enum", T: proto3.getEnumType(ForecastMethod) },
+    { no: 4, name: "season_length", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "confidence", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewTimeSeriesForecast {
+    return new MetricsViewTimeSeriesForecast().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewTimeSeriesForecast {
+    return new MetricsViewTimeSeriesForecast().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewTimeSeriesForecast {
+    return new MetricsViewTimeSeriesForecast().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewTimeSeriesForecast | PlainMessage<MetricsViewTimeSeriesForecast> | undefined, b: MetricsViewTimeSeriesForecast | PlainMessage<MetricsViewTimeSeriesForecast> | undefined): boolean {
+    return proto3.util.equals(MetricsViewTimeSeriesForecast, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TimeSeriesForecastValue
+ */
+export class TimeSeriesForecastValue extends Message<TimeSeriesForecastValue> {
+  /**
+   * @generated from field: google.protobuf.Timestamp ts = 1;
+   */
+  ts?: Timestamp;
+
+  /**
+   * Forecasted value for each measure
+   *
+   * @generated from field: google.protobuf.Struct records = 2;
+   */
+  records?: Struct;
+
+  /**
+   * Lower bound of the prediction interval for each measure
+   *
+   * @generated from field: google.protobuf.Struct lower = 3;
+   */
+  lower?: Struct;
+
+  /**
+   * Upper bound of the prediction interval for each measure
+   *
+   * @generated from field: google.protobuf.Struct upper = 4;
+   */
+  upper?: Struct;
+
+  constructor(data?: PartialMessage<TimeSeriesForecastValue>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TimeSeriesForecastValue";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ts", kind: "message", T: Timestamp },
+    { no: 2, name: "records", kind: "message", T: Struct },
+    { no: 3, name: "lower", kind: "message", T: Struct },
+    { no: 4, name: "upper", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimeSeriesForecastValue {
+    return new TimeSeriesForecastValue().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimeSeriesForecastValue {
+    return new TimeSeriesForecastValue().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimeSeriesForecastValue {
+    return new TimeSeriesForecastValue().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TimeSeriesForecastValue | PlainMessage<TimeSeriesForecastValue> | undefined, b: TimeSeriesForecastValue | PlainMessage<TimeSeriesForecastValue> | undefined): boolean {
+    return proto3.util.equals(TimeSeriesForecastValue, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.MetricsViewTotalsRequest
  */
@@ -3492,6 +4174,14 @@ export class ResolveCanvasRequest extends Message<ResolveCanvasRequest> {
    */
   args?: Struct;
 
+  /**
+   * Optional values for the canvas filters, keyed by filter name. Filters not set here use their default value.
+   * The time range filter is keyed by "time_range".
+   *
+   * @generated from field: google.protobuf.Struct filters = 4;
+   */
+  filters?: Struct;
+
   constructor(data?: PartialMessage<ResolveCanvasRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3503,6 +4193,7 @@ export class ResolveCanvasRequest extends Message<ResolveCanvasRequest> {
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "canvas", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "args", kind: "message", T: Struct },
+    { no: 4, name: "filters", kind: "message", T: Struct },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveCanvasRequest {
@@ -3549,6 +4240,13 @@ export class ResolveCanvasResponse extends Message<ResolveCanvasResponse> {
    */
   referencedMetricsViews: { [key: string]: Resource } = {};
 
+  /**
+   * The canvas filters that apply to each component, keyed by component name.
+   *
+   * @generated from field: map<string, rill.runtime.v1.ComponentFilters> component_filters = 4;
+   */
+  componentFilters: { [key: string]: ComponentFilters } = {};
+
   constructor(data?: PartialMessage<ResolveCanvasResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3560,6 +4258,7 @@ export class ResolveCanvasResponse extends Message<ResolveCanvasResponse> {
     { no: 1, name: "canvas", kind: "message", T: Resource },
     { no: 2, name: "resolved_components", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Resource} },
     { no: 3, name: "referenced_metrics_views", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Resource} },
+    { no: 4, name: "component_filters", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ComponentFilters} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveCanvasResponse {
@@ -3604,6 +4303,20 @@ export class ResolveComponentRequest extends Message<ResolveComponentRequest> {
    */
   args?: Struct;
 
+  /**
+   * Optional name of a canvas that contains the component. If set, the canvas's filters are applied to the component.
+   *
+   * @generated from field: string canvas = 4;
+   */
+  canvas = "";
+
+  /**
+   * Optional values for the canvas filters, keyed by filter name. Only used if canvas is set.
+   *
+   * @generated from field: google.protobuf.Struct filters = 5;
+   */
+  filters?: Struct;
+
   constructor(data?: PartialMessage<ResolveComponentRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3615,6 +4328,8 @@ export class ResolveComponentRequest extends Message<ResolveComponentRequest> {
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "component", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "args", kind: "message", T: Struct },
+    { no: 4, name: "canvas", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "filters", kind: "message", T: Struct },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveComponentRequest {
@@ -3645,6 +4360,27 @@ export class ResolveComponentResponse extends Message<ResolveComponentResponse>
    */
   rendererProperties?: Struct;
 
+  /**
+   * The canvas filters that apply to the component. Only set if a canvas was provided.
+   *
+   * @generated from field: rill.runtime.v1.ComponentFilters filters = 3;
+   */
+  filters?: ComponentFilters;
+
+  /**
+   * Schema of the data. Only set if the component has a data resolver.
+   *
+   * @generated from field: rill.runtime.v1.StructType schema = 4;
+   */
+  schema?: StructType;
+
+  /**
+   * Data returned by the component's data resolver. Only set if the component has a data resolver.
+   *
+   * @generated from field: repeated google.protobuf.Struct data = 5;
+   */
+  data: Struct[] = [];
+
   constructor(data?: PartialMessage<ResolveComponentResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3654,6 +4390,9 @@ export class ResolveComponentResponse extends Message<ResolveComponentResponse>
   static readonly typeName = "rill.runtime.v1.ResolveComponentResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "renderer_properties", kind: "message", T: Struct },
+    { no: 3, name: "filters", kind: "message", T: ComponentFilters },
+    { no: 4, name: "schema", kind: "message", T: StructType },
+    { no: 5, name: "data", kind: "message", T: Struct, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveComponentResponse {
@@ -3673,6 +4412,63 @@ export class ResolveComponentResponse extends Message<ResolveComponentResponse>
   }
 }
 
+/**
+ * Filters from a canvas that apply to a component's metrics view.
+ *
+ * @generated from message rill.runtime.v1.ComponentFilters
+ */
+export class ComponentFilters extends Message<ComponentFilters> {
+  /**
+   * Filter on dimensions to apply to the component's queries.
+   *
+   * @generated from field: rill.runtime.v1.Expression where = 1;
+   */
+  where?: Expression;
+
+  /**
+   * Filter on measures to apply to the component's queries.
+   *
+   * @generated from field: rill.runtime.v1.Expression having = 2;
+   */
+  having?: Expression;
+
+  /**
+   * Time range to apply to the component's queries.
+   *
+   * @generated from field: string time_range = 3;
+   */
+  timeRange = "";
+
+  constructor(data?: PartialMessage<ComponentFilters>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ComponentFilters";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "where", kind: "message", T: Expression },
+    { no: 2, name: "having", kind: "message", T: Expression },
+    { no: 3, name: "time_range", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ComponentFilters {
+    return new ComponentFilters().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ComponentFilters {
+    return new ComponentFilters().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ComponentFilters {
+    return new ComponentFilters().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ComponentFilters | PlainMessage<ComponentFilters> | undefined, b: ComponentFilters | PlainMessage<ComponentFilters> | undefined): boolean {
+    return proto3.util.equals(ComponentFilters, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ColumnRollupIntervalRequest
  */
@@ -5905,6 +6701,338 @@ export class ProfileColumn extends Message<ProfileColumn> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.TableDiffRequest
+ */
+export class TableDiffRequest extends Message<TableDiffRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string connector = 2;
+   */
+  connector = "";
+
+  /**
+   * @generated from field: string database = 3;
+   */
+  database = "";
+
+  /**
+   * @generated from field: string database_schema = 4;
+   */
+  databaseSchema = "";
+
+  /**
+   * Table to use as the baseline
+   *
+   * @generated from field: string base_table_name = 5;
+   */
+  baseTableName = "";
+
+  /**
+   * Table to compare against the baseline
+   *
+   * @generated from field: string compare_table_name = 6;
+   */
+  compareTableName = "";
+
+  /**
+   * Columns that uniquely identify a row in both tables.
+   * If provided, rows are matched on the keys to count added, removed and changed rows.
+   *
+   * @generated from field: repeated string keys = 7;
+   */
+  keys: string[] = [];
+
+  /**
+   * @generated from field: int32 priority = 8;
+   */
+  priority = 0;
+
+  constructor(data?: PartialMessage<TableDiffRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TableDiffRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "database", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "database_schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "base_table_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "compare_table_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableDiffRequest {
+    return new TableDiffRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableDiffRequest {
+    return new TableDiffRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableDiffRequest {
+    return new TableDiffRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableDiffRequest | PlainMessage<TableDiffRequest> | undefined, b: TableDiffRequest | PlainMessage<TableDiffRequest> | undefined): boolean {
+    return proto3.util.equals(TableDiffRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TableDiffResponse
+ */
+export class TableDiffResponse extends Message<TableDiffResponse> {
+  /**
+   * @generated from field: int64 base_row_count = 1;
+   */
+  baseRowCount = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 compare_row_count = 2;
+   */
+  compareRowCount = protoInt64.zero;
+
+  /**
+   * Columns of the base table followed by the columns only present in the compare table
+   *
+   * @generated from field: repeated rill.runtime.v1.TableDiffColumn columns = 3;
+   */
+  columns: TableDiffColumn[] = [];
+
+  /**
+   * Only set if keys were provided in the request
+   *
+   * @generated from field: rill.runtime.v1.TableDiffRows rows = 4;
+   */
+  rows?: TableDiffRows;
+
+  constructor(data?: PartialMessage<TableDiffResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TableDiffResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "base_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "compare_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "columns", kind: "message", T: TableDiffColumn, repeated: true },
+    { no: 4, name: "rows", kind: "message", T: TableDiffRows },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableDiffResponse {
+    return new TableDiffResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableDiffResponse {
+    return new TableDiffResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableDiffResponse {
+    return new TableDiffResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableDiffResponse | PlainMessage<TableDiffResponse> | undefined, b: TableDiffResponse | PlainMessage<TableDiffResponse> | undefined): boolean {
+    return proto3.util.equals(TableDiffResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TableDiffColumn
+ */
+export class TableDiffColumn extends Message<TableDiffColumn> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Empty if the column is not present in the base table
+   *
+   * @generated from field: string base_type = 2;
+   */
+  baseType = "";
+
+  /**
+   * Empty if the column is not present in the compare table
+   *
+   * @generated from field: string compare_type = 3;
+   */
+  compareType = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.TableDiffColumnProfile base = 4;
+   */
+  base?: TableDiffColumnProfile;
+
+  /**
+   * @generated from field: rill.runtime.v1.TableDiffColumnProfile compare = 5;
+   */
+  compare?: TableDiffColumnProfile;
+
+  /**
+   * Number of key-matched rows where the column's value changed. Only set if keys were provided in the request.
+   *
+   * @generated from field: int64 changed_rows = 6;
+   */
+  changedRows = protoInt64.zero;
+
+  constructor(data?: PartialMessage<TableDiffColumn>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TableDiffColumn";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "base_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "compare_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "base", kind: "message", T: TableDiffColumnProfile },
+    { no: 5, name: "compare", kind: "message", T: TableDiffColumnProfile },
+    { no: 6, name: "changed_rows", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableDiffColumn {
+    return new TableDiffColumn().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableDiffColumn {
+    return new TableDiffColumn().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableDiffColumn {
+    return new TableDiffColumn().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableDiffColumn | PlainMessage<TableDiffColumn> | undefined, b: TableDiffColumn | PlainMessage<TableDiffColumn> | undefined): boolean {
+    return proto3.util.equals(TableDiffColumn, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TableDiffColumnProfile
+ */
+export class TableDiffColumnProfile extends Message<TableDiffColumnProfile> {
+  /**
+   * @generated from field: double null_count = 1;
+   */
+  nullCount = 0;
+
+  /**
+   * @generated from field: double cardinality = 2;
+   */
+  cardinality = 0;
+
+  /**
+   * Only set for numeric columns
+   *
+   * @generated from field: rill.runtime.v1.NumericStatistics numeric_statistics = 3;
+   */
+  numericStatistics?: NumericStatistics;
+
+  constructor(data?: PartialMessage<TableDiffColumnProfile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TableDiffColumnProfile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "null_count", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "cardinality", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "numeric_statistics", kind: "message", T: NumericStatistics },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableDiffColumnProfile {
+    return new TableDiffColumnProfile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableDiffColumnProfile {
+    return new TableDiffColumnProfile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableDiffColumnProfile {
+    return new TableDiffColumnProfile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableDiffColumnProfile | PlainMessage<TableDiffColumnProfile> | undefined, b: TableDiffColumnProfile | PlainMessage<TableDiffColumnProfile> | undefined): boolean {
+    return proto3.util.equals(TableDiffColumnProfile, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.TableDiffRows
+ */
+export class TableDiffRows extends Message<TableDiffRows> {
+  /**
+   * Rows only present in the compare table
+   *
+   * @generated from field: int64 added = 1;
+   */
+  added = protoInt64.zero;
+
+  /**
+   * Rows only present in the base table
+   *
+   * @generated from field: int64 removed = 2;
+   */
+  removed = protoInt64.zero;
+
+  /**
+   * Key-matched rows where at least one shared column changed
+   *
+   * @generated from field: int64 changed = 3;
+   */
+  changed = protoInt64.zero;
+
+  /**
+   * Key-matched rows where all shared columns are equal
+   *
+   * @generated from field: int64 unchanged = 4;
+   */
+  unchanged = protoInt64.zero;
+
+  constructor(data?: PartialMessage<TableDiffRows>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TableDiffRows";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "added", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "changed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "unchanged", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableDiffRows {
+    return new TableDiffRows().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableDiffRows {
+    return new TableDiffRows().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableDiffRows {
+    return new TableDiffRows().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableDiffRows | PlainMessage<TableDiffRows> | undefined, b: TableDiffRows | PlainMessage<TableDiffRows> | undefined): boolean {
+    return proto3.util.equals(TableDiffRows, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.TableRowsRequest
  */
@@ -5,10 +5,10 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct, Timestamp, Value } from "@bufbuild/protobuf";
-import { StructType } from "./schema_pb.js";
+import { StructType, Type_Code } from "./schema_pb.js";
 import { TimeGrain } from "./time_grain_pb.js";
-import { Expression } from "./expression_pb.js";
-import { ExportFormat } from "./export_format_pb.js";
+import { Expression, Operation } from "./expression_pb.js";
+import { ExportFormat, GoogleSheetsExportOptions } from "./export_format_pb.js";
 import { Color } from "./colors_pb.js";
 
 /**
@@ -957,6 +957,13 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   triggerFull = false;
 
+  /**
+   * contract is an optional set of expectations on the model's output schema.
+   *
+   * @generated from field: rill.runtime.v1.ModelContract contract = 23;
+   */
+  contract?: ModelContract;
+
   constructor(data?: PartialMessage<ModelSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -982,6 +989,7 @@ export class ModelSpec extends Message<ModelSpec> {
     { no: 12, name: "output_properties", kind: "message", T: Struct },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 22, name: "trigger_full", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 23, name: "contract", kind: "message", T: ModelContract },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelSpec {
@@ -1001,6 +1009,105 @@ export class ModelSpec extends Message<ModelSpec> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.ModelContract
+ */
+export class ModelContract extends Message<ModelContract> {
+  /**
+   * If enforced is true, contract violations fail the model and the previous output is kept.
+   * Otherwise, violations are recorded in the model's state and the new output is used.
+   *
+   * @generated from field: bool enforced = 1;
+   */
+  enforced = false;
+
+  /**
+   * If allow_extra_columns is true, the output may contain columns that are not listed in the contract.
+   *
+   * @generated from field: bool allow_extra_columns = 2;
+   */
+  allowExtraColumns = false;
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.ModelContractColumn columns = 3;
+   */
+  columns: ModelContractColumn[] = [];
+
+  constructor(data?: PartialMessage<ModelContract>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelContract";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enforced", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "allow_extra_columns", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "columns", kind: "message", T: ModelContractColumn, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelContract {
+    return new ModelContract().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelContract {
+    return new ModelContract().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelContract {
+    return new ModelContract().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelContract | PlainMessage<ModelContract> | undefined, b: ModelContract | PlainMessage<ModelContract> | undefined): boolean {
+    return proto3.util.equals(ModelContract, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ModelContractColumn
+ */
+export class ModelContractColumn extends Message<ModelContractColumn> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Expected type of the column. If unspecified, only the presence of the column is checked.
+   *
+   * @generated from field: rill.runtime.v1.Type.Code type = 2;
+   */
+  type = Type_Code.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ModelContractColumn>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelContractColumn";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(Type_Code) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelContractColumn {
+    return new ModelContractColumn().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelContractColumn {
+    return new ModelContractColumn().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelContractColumn {
+    return new ModelContractColumn().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelContractColumn | PlainMessage<ModelContractColumn> | undefined, b: ModelContractColumn | PlainMessage<ModelContractColumn> | undefined): boolean {
+    return proto3.util.equals(ModelContractColumn, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ModelState
  */
@@ -1082,6 +1189,28 @@ export class ModelState extends Message<ModelState> {
    */
   partitionsHaveErrors = false;
 
+  /**
+   * result_schema is the schema of the model's output as of the latest execution. It is only tracked for models with a contract.
+   *
+   * @generated from field: rill.runtime.v1.StructType result_schema = 12;
+   */
+  resultSchema?: StructType;
+
+  /**
+   * contract_violations contains the contract violations found in the latest execution.
+   * It is only populated for models with a contract that is not enforced (enforced contracts fail the model instead).
+   *
+   * @generated from field: repeated string contract_violations = 13;
+   */
+  contractViolations: string[] = [];
+
+  /**
+   * schema_changes contains the most recent changes to the model's output schema, oldest first. It is only tracked for models with a contract.
+   *
+   * @generated from field: repeated rill.runtime.v1.ModelSchemaChange schema_changes = 14;
+   */
+  schemaChanges: ModelSchemaChange[] = [];
+
   constructor(data?: PartialMessage<ModelState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1101,6 +1230,9 @@ export class ModelState extends Message<ModelState> {
     { no: 8, name: "incremental_state_schema", kind: "message", T: StructType },
     { no: 10, name: "partitions_model_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 11, name: "partitions_have_errors", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "result_schema", kind: "message", T: StructType },
+    { no: 13, name: "contract_violations", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 14, name: "schema_changes", kind: "message", T: ModelSchemaChange, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelState {
@@ -1120,6 +1252,114 @@ export class ModelState extends Message<ModelState> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.ModelSchemaChange
+ */
+export class ModelSchemaChange extends Message<ModelSchemaChange> {
+  /**
+   * @generated from field: google.protobuf.Timestamp detected_on = 1;
+   */
+  detectedOn?: Timestamp;
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.ModelColumnChange columns = 2;
+   */
+  columns: ModelColumnChange[] = [];
+
+  constructor(data?: PartialMessage<ModelSchemaChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelSchemaChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "detected_on", kind: "message", T: Timestamp },
+    { no: 2, name: "columns", kind: "message", T: ModelColumnChange, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelSchemaChange {
+    return new ModelSchemaChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelSchemaChange {
+    return new ModelSchemaChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelSchemaChange {
+    return new ModelSchemaChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelSchemaChange | PlainMessage<ModelSchemaChange> | undefined, b: ModelSchemaChange | PlainMessage<ModelSchemaChange> | undefined): boolean {
+    return proto3.util.equals(ModelSchemaChange, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ModelColumnChange
+ */
+export class ModelColumnChange extends Message<ModelColumnChange> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool added = 2;
+   */
+  added = false;
+
+  /**
+   * @generated from field: bool removed = 3;
+   */
+  removed = false;
+
+  /**
+   * Unspecified if the column was added
+   *
+   * @generated from field: rill.runtime.v1.Type.Code previous_type = 4;
+   */
+  previousType = Type_Code.UNSPECIFIED;
+
+  /**
+   * Unspecified if the column was removed
+   *
+   * @generated from field: rill.runtime.v1.Type.Code new_type = 5;
+   */
+  newType = Type_Code.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ModelColumnChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelColumnChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "added", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "removed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "previous_type", kind: "enum", T: proto3.getEnumType(Type_Code) },
+    { no: 5, name: "new_type", kind: "enum", T: proto3.getEnumType(Type_Code) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelColumnChange {
+    return new ModelColumnChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelColumnChange {
+    return new ModelColumnChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelColumnChange {
+    return new ModelColumnChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelColumnChange | PlainMessage<ModelColumnChange> | undefined, b: ModelColumnChange | PlainMessage<ModelColumnChange> | undefined): boolean {
+    return proto3.util.equals(ModelColumnChange, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.MetricsViewV2
  */
@@ -1893,6 +2133,12 @@ export class SecurityRule extends Message<SecurityRule> {
      */
     value: SecurityRuleRowFilter;
     case: "rowFilter";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.SecurityRuleMinGroupSize min_group_size = 4;
+     */
+    value: SecurityRuleMinGroupSize;
+    case: "minGroupSize";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<SecurityRule>) {
@@ -1906,6 +2152,7 @@ export class SecurityRule extends Message<SecurityRule> {
     { no: 1, name: "access", kind: "message", T: SecurityRuleAccess, oneof: "rule" },
     { no: 2, name: "field_access", kind: "message", T: SecurityRuleFieldAccess, oneof: "rule" },
     { no: 3, name: "row_filter", kind: "message", T: SecurityRuleRowFilter, oneof: "rule" },
+    { no: 4, name: "min_group_size", kind: "message", T: SecurityRuleMinGroupSize, oneof: "rule" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecurityRule {
@@ -2076,6 +2323,59 @@ export class SecurityRuleRowFilter extends Message<SecurityRuleRowFilter> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.SecurityRuleMinGroupSize
+ */
+export class SecurityRuleMinGroupSize extends Message<SecurityRuleMinGroupSize> {
+  /**
+   * @generated from field: string condition = 1;
+   */
+  condition = "";
+
+  /**
+   * Minimum number of underlying rows a group must aggregate over to be returned.
+   *
+   * @generated from field: uint32 min_group_size = 2;
+   */
+  minGroupSize = 0;
+
+  /**
+   * Relative amount of deterministic noise to apply to measure values (e.g. 0.05 for up to +/- 5%).
+   *
+   * @generated from field: double noise = 3;
+   */
+  noise = 0;
+
+  constructor(data?: PartialMessage<SecurityRuleMinGroupSize>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.SecurityRuleMinGroupSize";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "condition", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_group_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "noise", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecurityRuleMinGroupSize {
+    return new SecurityRuleMinGroupSize().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecurityRuleMinGroupSize {
+    return new SecurityRuleMinGroupSize().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecurityRuleMinGroupSize {
+    return new SecurityRuleMinGroupSize().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecurityRuleMinGroupSize | PlainMessage<SecurityRuleMinGroupSize> | undefined, b: SecurityRuleMinGroupSize | PlainMessage<SecurityRuleMinGroupSize> | undefined): boolean {
+    return proto3.util.equals(SecurityRuleMinGroupSize, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.MetricsViewState
  */
@@ -2979,6 +3279,16 @@ export class ReportSpec extends Message<ReportSpec> {
    */
   exportFormat = ExportFormat.UNSPECIFIED;
 
+  /**
+   * @generated from field: rill.runtime.v1.GoogleSheetsExportOptions export_google_sheets = 16;
+   */
+  exportGoogleSheets?: GoogleSheetsExportOptions;
+
+  /**
+   * @generated from field: rill.runtime.v1.ReportSnapshotOptions export_snapshot = 17;
+   */
+  exportSnapshot?: ReportSnapshotOptions;
+
   /**
    * @generated from field: repeated rill.runtime.v1.Notifier notifiers = 11;
    */
@@ -3011,6 +3321,13 @@ export class ReportSpec extends Message<ReportSpec> {
    */
   intervalsCheckUnclosed = false;
 
+  /**
+   * If set, the report is executed once per slice of recipients and each slice's output is only sent to its recipients.
+   *
+   * @generated from field: rill.runtime.v1.ReportBurst burst = 18;
+   */
+  burst?: ReportBurst;
+
   constructor(data?: PartialMessage<ReportSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3027,12 +3344,15 @@ export class ReportSpec extends Message<ReportSpec> {
     { no: 6, name: "query_args_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "export_limit", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 8, name: "export_format", kind: "enum", T: proto3.getEnumType(ExportFormat) },
+    { no: 16, name: "export_google_sheets", kind: "message", T: GoogleSheetsExportOptions },
+    { no: 17, name: "export_snapshot", kind: "message", T: ReportSnapshotOptions },
     { no: 11, name: "notifiers", kind: "message", T: Notifier, repeated: true },
     { no: 10, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 12, name: "watermark_inherit", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 13, name: "intervals_iso_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 14, name: "intervals_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 15, name: "intervals_check_unclosed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 18, name: "burst", kind: "message", T: ReportBurst },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSpec {
@@ -3052,6 +3372,189 @@ export class ReportSpec extends Message<ReportSpec> {
   }
 }
 
+/**
+ * ReportBurst configures bursting of a report, where the report is executed with different security claims for different recipients.
+ *
+ * @generated from message rill.runtime.v1.ReportBurst
+ */
+export class ReportBurst extends Message<ReportBurst> {
+  /**
+   * If true, the report is executed once per email recipient with the recipient's security attributes.
+   *
+   * @generated from field: bool per_recipient = 1;
+   */
+  perRecipient = false;
+
+  /**
+   * Optional dimension to slice the report by. If set, each slice's query is filtered to the slice's value.
+   *
+   * @generated from field: string dimension = 2;
+   */
+  dimension = "";
+
+  /**
+   * Slices to execute the report for. Only used if per_recipient is false.
+   *
+   * @generated from field: repeated rill.runtime.v1.ReportBurstSlice slices = 3;
+   */
+  slices: ReportBurstSlice[] = [];
+
+  /**
+   * Maximum number of slices to execute concurrently. Defaults to 4 if not set.
+   *
+   * @generated from field: uint32 concurrency = 4;
+   */
+  concurrency = 0;
+
+  constructor(data?: PartialMessage<ReportBurst>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ReportBurst";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "per_recipient", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "slices", kind: "message", T: ReportBurstSlice, repeated: true },
+    { no: 4, name: "concurrency", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportBurst {
+    return new ReportBurst().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportBurst {
+    return new ReportBurst().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportBurst {
+    return new ReportBurst().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportBurst | PlainMessage<ReportBurst> | undefined, b: ReportBurst | PlainMessage<ReportBurst> | undefined): boolean {
+    return proto3.util.equals(ReportBurst, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ReportBurstSlice
+ */
+export class ReportBurstSlice extends Message<ReportBurstSlice> {
+  /**
+   * Value of the burst dimension to filter the slice by. Only used if ReportBurst.dimension is set.
+   *
+   * @generated from field: google.protobuf.Value value = 1;
+   */
+  value?: Value;
+
+  /**
+   * Security attributes to execute the slice's query with. Defaults to the attributes of the report's owner.
+   *
+   * @generated from field: google.protobuf.Struct attributes = 2;
+   */
+  attributes?: Struct;
+
+  /**
+   * Email addresses to send the slice's output to.
+   *
+   * @generated from field: repeated string recipients = 3;
+   */
+  recipients: string[] = [];
+
+  constructor(data?: PartialMessage<ReportBurstSlice>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ReportBurstSlice";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "value", kind: "message", T: Value },
+    { no: 2, name: "attributes", kind: "message", T: Struct },
+    { no: 3, name: "recipients", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportBurstSlice {
+    return new ReportBurstSlice().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportBurstSlice {
+    return new ReportBurstSlice().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportBurstSlice {
+    return new ReportBurstSlice().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportBurstSlice | PlainMessage<ReportBurstSlice> | undefined, b: ReportBurstSlice | PlainMessage<ReportBurstSlice> | undefined): boolean {
+    return proto3.util.equals(ReportBurstSlice, a, b);
+  }
+}
+
+/**
+ * ReportSnapshotOptions configures the dashboard rendered by a report in EXPORT_FORMAT_PDF or EXPORT_FORMAT_PNG.
+ *
+ * @generated from message rill.runtime.v1.ReportSnapshotOptions
+ */
+export class ReportSnapshotOptions extends Message<ReportSnapshotOptions> {
+  /**
+   * Name of the canvas to render. Only one of canvas and explore is set.
+   *
+   * @generated from field: string canvas = 1;
+   */
+  canvas = "";
+
+  /**
+   * Name of the explore to render. Only one of canvas and explore is set.
+   *
+   * @generated from field: string explore = 2;
+   */
+  explore = "";
+
+  /**
+   * Size of the browser viewport in pixels. The renderer's defaults are used if not set.
+   *
+   * @generated from field: uint32 width = 3;
+   */
+  width = 0;
+
+  /**
+   * @generated from field: uint32 height = 4;
+   */
+  height = 0;
+
+  constructor(data?: PartialMessage<ReportSnapshotOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ReportSnapshotOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "canvas", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "explore", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "width", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "height", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSnapshotOptions {
+    return new ReportSnapshotOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSnapshotOptions {
+    return new ReportSnapshotOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSnapshotOptions {
+    return new ReportSnapshotOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSnapshotOptions | PlainMessage<ReportSnapshotOptions> | undefined, b: ReportSnapshotOptions | PlainMessage<ReportSnapshotOptions> | undefined): boolean {
+    return proto3.util.equals(ReportSnapshotOptions, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ReportState
  */
@@ -3136,6 +3639,13 @@ export class ReportExecution extends Message<ReportExecution> {
    */
   finishedOn?: Timestamp;
 
+  /**
+   * Results of sending a burst report to each of its recipients.
+   *
+   * @generated from field: repeated rill.runtime.v1.ReportBurstResult burst_results = 6;
+   */
+  burstResults: ReportBurstResult[] = [];
+
   constructor(data?: PartialMessage<ReportExecution>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3149,6 +3659,7 @@ export class ReportExecution extends Message<ReportExecution> {
     { no: 3, name: "report_time", kind: "message", T: Timestamp },
     { no: 4, name: "started_on", kind: "message", T: Timestamp },
     { no: 5, name: "finished_on", kind: "message", T: Timestamp },
+    { no: 6, name: "burst_results", kind: "message", T: ReportBurstResult, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportExecution {
@@ -3168,6 +3679,59 @@ export class ReportExecution extends Message<ReportExecution> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.ReportBurstResult
+ */
+export class ReportBurstResult extends Message<ReportBurstResult> {
+  /**
+   * @generated from field: string recipient = 1;
+   */
+  recipient = "";
+
+  /**
+   * The slice the recipient was sent, which is the recipient's email or the slice's dimension value.
+   *
+   * @generated from field: string slice = 2;
+   */
+  slice = "";
+
+  /**
+   * Error that prevented the recipient from receiving the report, if any.
+   *
+   * @generated from field: string error_message = 3;
+   */
+  errorMessage = "";
+
+  constructor(data?: PartialMessage<ReportBurstResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ReportBurstResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recipient", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "slice", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportBurstResult {
+    return new ReportBurstResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportBurstResult {
+    return new ReportBurstResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportBurstResult {
+    return new ReportBurstResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportBurstResult | PlainMessage<ReportBurstResult> | undefined, b: ReportBurstResult | PlainMessage<ReportBurstResult> | undefined): boolean {
+    return proto3.util.equals(ReportBurstResult, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.Alert
  */
@@ -3463,6 +4027,13 @@ export class AlertState extends Message<AlertState> {
    */
   executionCount = 0;
 
+  /**
+   * Number of consecutive executions that did not pass. Used to escalate notifications for alerts that don't recover.
+   *
+   * @generated from field: uint32 consecutive_failures = 7;
+   */
+  consecutiveFailures = 0;
+
   constructor(data?: PartialMessage<AlertState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3477,6 +4048,7 @@ export class AlertState extends Message<AlertState> {
     { no: 4, name: "current_execution", kind: "message", T: AlertExecution },
     { no: 5, name: "execution_history", kind: "message", T: AlertExecution, repeated: true },
     { no: 6, name: "execution_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "consecutive_failures", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertState {
@@ -4322,6 +4894,18 @@ export class ComponentSpec extends Message<ComponentSpec> {
    */
   definedInCanvas = false;
 
+  /**
+   * Optional resolver for the component's data, such as "metrics_join" for joining multiple metrics views.
+   *
+   * @generated from field: string resolver = 10;
+   */
+  resolver = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct resolver_properties = 11;
+   */
+  resolverProperties?: Struct;
+
   constructor(data?: PartialMessage<ComponentSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4337,6 +4921,8 @@ export class ComponentSpec extends Message<ComponentSpec> {
     { no: 8, name: "input", kind: "message", T: ComponentVariable, repeated: true },
     { no: 9, name: "output", kind: "message", T: ComponentVariable },
     { no: 6, name: "defined_in_canvas", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "resolver_properties", kind: "message", T: Struct },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ComponentSpec {
@@ -4598,6 +5184,14 @@ export class CanvasSpec extends Message<CanvasSpec> {
    */
   banner = "";
 
+  /**
+   * Filters that apply to all the components in the canvas.
+   * In addition to these, the canvas always has a time range filter based on the default preset.
+   *
+   * @generated from field: repeated rill.runtime.v1.CanvasFilter filters = 18;
+   */
+  filters: CanvasFilter[] = [];
+
   constructor(data?: PartialMessage<CanvasSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4621,6 +5215,7 @@ export class CanvasSpec extends Message<CanvasSpec> {
     { no: 16, name: "layout", kind: "message", T: Value },
     { no: 6, name: "security_rules", kind: "message", T: SecurityRule, repeated: true },
     { no: 17, name: "banner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "filters", kind: "message", T: CanvasFilter, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CanvasSpec {
@@ -4640,6 +5235,92 @@ export class CanvasSpec extends Message<CanvasSpec> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.CanvasFilter
+ */
+export class CanvasFilter extends Message<CanvasFilter> {
+  /**
+   * Name of the filter. It is unique within the canvas.
+   * A component with an output variable of the same name sets the filter's value when clicked (cross-filtering).
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * Optional. If set, the filter only applies to components that query this metrics view.
+   *
+   * @generated from field: string metrics_view = 3;
+   */
+  metricsView = "";
+
+  /**
+   * Dimension to filter on. Only one of dimension and measure is set.
+   *
+   * @generated from field: string dimension = 4;
+   */
+  dimension = "";
+
+  /**
+   * Measure to filter on. Only one of dimension and measure is set.
+   *
+   * @generated from field: string measure = 5;
+   */
+  measure = "";
+
+  /**
+   * Comparison operator for measure filters.
+   *
+   * @generated from field: rill.runtime.v1.Operation operator = 6;
+   */
+  operator = Operation.UNSPECIFIED;
+
+  /**
+   * Optional default value. A list of values for dimension filters and a number for measure filters.
+   *
+   * @generated from field: google.protobuf.Value default_value = 7;
+   */
+  defaultValue?: Value;
+
+  constructor(data?: PartialMessage<CanvasFilter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.CanvasFilter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metrics_view", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "measure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "operator", kind: "enum", T: proto3.getEnumType(Operation) },
+    { no: 7, name: "default_value", kind: "message", T: Value },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CanvasFilter {
+    return new CanvasFilter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CanvasFilter {
+    return new CanvasFilter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CanvasFilter {
+    return new CanvasFilter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CanvasFilter | PlainMessage<CanvasFilter> | undefined, b: CanvasFilter | PlainMessage<CanvasFilter> | undefined): boolean {
+    return proto3.util.equals(CanvasFilter, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.CanvasState
  */
@@ -53,6 +53,20 @@ Will trigger models with RefreshModelTrigger.full set to true. */
   allSourcesModelsFull?: boolean;
 };
 
+/**
+ * User attributes to simulate. They are available in security rule templates as {{ .user }}.
+ */
+export type RuntimeServiceExplainSecurityBodyAttributes = {
+  [key: string]: any;
+};
+
+export type RuntimeServiceExplainSecurityBody = {
+  /** User attributes to simulate. They are available in security rule templates as {{ .user }}. */
+  attributes?: RuntimeServiceExplainSecurityBodyAttributes;
+  /** Optional resources to explain. If empty, all metrics views, explores, canvases and APIs are explained. */
+  resources?: V1ResourceName[];
+};
+
 export type RuntimeServiceGetExploreParams = { name?: string };
 
 export type RuntimeServiceWatchResources200 = {
@@ -72,6 +86,12 @@ export type RuntimeServiceListResourcesParams = {
   skipSecurityChecks?: boolean;
 };
 
+export type RuntimeServiceGetResourceLineageParams = {
+  "name.kind"?: string;
+  "name.name"?: string;
+  columns?: boolean;
+};
+
 export type RuntimeServiceGetResourceParams = {
   "name.kind"?: string;
   "name.name"?: string;
@@ -84,6 +104,36 @@ export type QueryServiceExportReportBody = {
   executionTime?: string;
 };
 
+/**
+ * Optional user attributes to run the query as. If not set, the query runs with the caller's attributes.
+ */
+export type QueryServiceQueryResolverBodyAttributes = { [key: string]: any };
+
+/**
+ * Args for the resolver.
+ */
+export type QueryServiceQueryResolverBodyResolverArgs = { [key: string]: any };
+
+/**
+ * Properties for the resolver, such as the SQL query.
+ */
+export type QueryServiceQueryResolverBodyResolverProperties = {
+  [key: string]: any;
+};
+
+export type QueryServiceQueryResolverBody = {
+  /** Resolver to use, such as "sql" or "metrics_sql". */
+  resolver?: string;
+  /** Properties for the resolver, such as the SQL query. */
+  resolverProperties?: QueryServiceQueryResolverBodyResolverProperties;
+  /** Args for the resolver. */
+  resolverArgs?: QueryServiceQueryResolverBodyResolverArgs;
+  /** Optional user attributes to run the query as. If not set, the query runs with the caller's attributes. */
+  attributes?: QueryServiceQueryResolverBodyAttributes;
+  /** Maximum number of rows to return. Defaults to 100. */
+  limit?: number;
+};
+
 export type QueryServiceQueryBatch200 = {
   result?: V1QueryBatchResponse;
   error?: RpcStatus;
@@ -133,6 +183,18 @@ export type QueryServiceColumnTimeRangeParams = {
   priority?: number;
 };
 
+export type QueryServiceTableDiffBody = {
+  connector?: string;
+  database?: string;
+  databaseSchema?: string;
+  baseTableName?: string;
+  compareTableName?: string;
+  /** Columns that uniquely identify a row in both tables.
+If provided, rows are matched on the keys to count added, removed and changed rows. */
+  keys?: string[];
+  priority?: number;
+};
+
 export type QueryServiceTableCardinalityParams = {
   connector?: string;
   database?: string;
@@ -240,6 +302,7 @@ export type QueryServiceMetricsViewTimeSeriesBody = {
   timeZone?: string;
   priority?: number;
   filter?: V1MetricsViewFilter;
+  forecast?: V1MetricsViewTimeSeriesForecast;
 };
 
 export type QueryServiceMetricsViewTimeRangesBody = {
@@ -276,6 +339,23 @@ export type QueryServiceMetricsViewRowsBody = {
   filter?: V1MetricsViewFilter;
 };
 
+export type QueryServiceMetricsViewContributionBody = {
+  /** Required. The measure to explain the change of.
+The contributions of dimension values only add up to the total change for additive measures, such as sums and counts. */
+  measure?: string;
+  /** Optional. A measure for the volume that drives the measure, such as the number of orders for a revenue measure.
+If set, the change of each dimension value is decomposed into volume, mix and rate effects. */
+  volumeMeasure?: string;
+  dimensions?: string[];
+  timeRange?: V1TimeRange;
+  comparisonTimeRange?: V1TimeRange;
+  where?: V1Expression;
+  limit?: string;
+  /** Optional. If true, the response includes a summary of the results written by AI. */
+  narrative?: boolean;
+  priority?: number;
+};
+
 export type QueryServiceMetricsViewComparisonBody = {
   dimension?: V1MetricsViewAggregationDimension;
   measures?: V1MetricsViewAggregationMeasure[];
@@ -325,6 +405,7 @@ export type QueryServiceExportBody = {
   format?: V1ExportFormat;
   query?: V1Query;
   bakedQuery?: string;
+  googleSheets?: V1GoogleSheetsExportOptions;
 };
 
 export type QueryServiceColumnDescriptiveStatisticsParams = {
@@ -335,10 +416,19 @@ export type QueryServiceColumnDescriptiveStatisticsParams = {
   priority?: number;
 };
 
+/**
+ * Optional values for the canvas filters, keyed by filter name. Only used if canvas is set.
+ */
+export type QueryServiceResolveComponentBodyFilters = { [key: string]: any };
+
 export type QueryServiceResolveComponentBodyArgs = { [key: string]: any };
 
 export type QueryServiceResolveComponentBody = {
   args?: QueryServiceResolveComponentBodyArgs;
+  /** Optional name of a canvas that contains the component. If set, the canvas's filters are applied to the component. */
+  canvas?: string;
+  /** Optional values for the canvas filters, keyed by filter name. Only used if canvas is set. */
+  filters?: QueryServiceResolveComponentBodyFilters;
 };
 
 export type QueryServiceTableColumnsParams = {
@@ -356,10 +446,19 @@ export type QueryServiceColumnCardinalityParams = {
   priority?: number;
 };
 
+/**
+ * Optional values for the canvas filters, keyed by filter name. Filters not set here use their default value.
+The time range filter is keyed by "time_range".
+ */
+export type QueryServiceResolveCanvasBodyFilters = { [key: string]: any };
+
 export type QueryServiceResolveCanvasBodyArgs = { [key: string]: any };
 
 export type QueryServiceResolveCanvasBody = {
   args?: QueryServiceResolveCanvasBodyArgs;
+  /** Optional values for the canvas filters, keyed by filter name. Filters not set here use their default value.
+The time range filter is keyed by "time_range". */
+  filters?: QueryServiceResolveCanvasBodyFilters;
 };
 
 export type RuntimeServiceGetModelPartitionsParams = {
@@ -369,6 +468,13 @@ export type RuntimeServiceGetModelPartitionsParams = {
   pageToken?: string;
 };
 
+export type RuntimeServiceAskMetricsViewBody = {
+  question?: string;
+  /** Optional time zone to use for time ranges and time grains in the generated query. */
+  timeZone?: string;
+  priority?: number;
+};
+
 export type RuntimeServiceWatchLogs200 = {
   result?: V1WatchLogsResponse;
   error?: RpcStatus;
@@ -495,6 +601,18 @@ export type RuntimeServiceCreateDirectoryBody = {
 
 export type RuntimeServiceListFilesParams = { glob?: string };
 
+export type RuntimeServiceListConversationsParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
+export type RuntimeServiceCompleteBody = {
+  /** Optional ID of an existing conversation to continue. */
+  conversationId?: string;
+  message?: string;
+  priority?: number;
+};
+
 export type RuntimeServiceEditInstanceBodyAnnotations = {
   [key: string]: string;
 };
@@ -601,6 +719,14 @@ export interface V1TopK {
   entries?: TopKEntry[];
 }
 
+export type V1ToolCallInput = { [key: string]: any };
+
+export interface V1ToolCall {
+  id?: string;
+  name?: string;
+  input?: V1ToolCallInput;
+}
+
 export type V1TimeSeriesValueRecords = { [key: string]: any };
 
 export interface V1TimeSeriesValue {
@@ -621,6 +747,19 @@ export interface V1TimeSeriesResponse {
   sampleSize?: number;
 }
 
+export type V1TimeSeriesForecastValueUpper = { [key: string]: any };
+
+export type V1TimeSeriesForecastValueLower = { [key: string]: any };
+
+export type V1TimeSeriesForecastValueRecords = { [key: string]: any };
+
+export interface V1TimeSeriesForecastValue {
+  ts?: string;
+  records?: V1TimeSeriesForecastValueRecords;
+  lower?: V1TimeSeriesForecastValueLower;
+  upper?: V1TimeSeriesForecastValueUpper;
+}
+
 export interface V1TimeRangeSummary {
   min?: string;
   max?: string;
@@ -696,6 +835,36 @@ export interface V1TableInfo {
   hasUnsupportedDataTypes?: boolean;
 }
 
+export interface V1TableDiffRows {
+  added?: string;
+  removed?: string;
+  changed?: string;
+  unchanged?: string;
+}
+
+export interface V1TableDiffColumnProfile {
+  nullCount?: number;
+  cardinality?: number;
+  numericStatistics?: V1NumericStatistics;
+}
+
+export interface V1TableDiffColumn {
+  name?: string;
+  baseType?: string;
+  compareType?: string;
+  base?: V1TableDiffColumnProfile;
+  compare?: V1TableDiffColumnProfile;
+  /** Number of key-matched rows where the column's value changed. Only set if keys were provided in the request. */
+  changedRows?: string;
+}
+
+export interface V1TableDiffResponse {
+  baseRowCount?: string;
+  compareRowCount?: string;
+  columns?: V1TableDiffColumn[];
+  rows?: V1TableDiffRows;
+}
+
 export type V1TableColumnsResponseUnsupportedColumns = {
   [key: string]: string;
 };
@@ -749,30 +918,45 @@ export interface V1SourceState {
   refreshedOn?: string;
 }
 
-export type V1SourceSpecProperties = { [key: string]: any };
-
-export interface V1SourceSpec {
-  sourceConnector?: string;
-  sinkConnector?: string;
-  properties?: V1SourceSpecProperties;
-  refreshSchedule?: V1Schedule;
-  timeoutSeconds?: number;
-  stageChanges?: boolean;
-  streamIngestion?: boolean;
-  trigger?: boolean;
-}
-
 export interface V1SourceV2 {
   spec?: V1SourceSpec;
   state?: V1SourceState;
 }
 
+export type V1SourceSpecProperties = { [key: string]: any };
+
+/**
+ * SecurityRuleSource describes where a security rule was declared.
+
+ - SECURITY_RULE_SOURCE_CLAIMS: The rule was provided in the claims of the request, for example the restrictions of a magic auth token.
+ - SECURITY_RULE_SOURCE_BUILT_IN: The rule is built into the runtime, for example default access for resources without security policies.
+ - SECURITY_RULE_SOURCE_RESOURCE: The rule was declared on the resource itself.
+ */
+export type V1SecurityRuleSource =
+  (typeof V1SecurityRuleSource)[keyof typeof V1SecurityRuleSource];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1SecurityRuleSource = {
+  SECURITY_RULE_SOURCE_UNSPECIFIED: "SECURITY_RULE_SOURCE_UNSPECIFIED",
+  SECURITY_RULE_SOURCE_CLAIMS: "SECURITY_RULE_SOURCE_CLAIMS",
+  SECURITY_RULE_SOURCE_BUILT_IN: "SECURITY_RULE_SOURCE_BUILT_IN",
+  SECURITY_RULE_SOURCE_RESOURCE: "SECURITY_RULE_SOURCE_RESOURCE",
+} as const;
+
 export interface V1SecurityRuleRowFilter {
   condition?: string;
   sql?: string;
   expression?: V1Expression;
 }
 
+export interface V1SecurityRuleMinGroupSize {
+  condition?: string;
+  /** Minimum number of underlying rows a group must aggregate over to be returned. */
+  minGroupSize?: number;
+  /** Relative amount of deterministic noise to apply to measure values (e.g. 0.05 for up to +/- 5%). */
+  noise?: number;
+}
+
 export interface V1SecurityRuleFieldAccess {
   condition?: string;
   allow?: boolean;
@@ -789,6 +973,19 @@ export interface V1SecurityRule {
   access?: V1SecurityRuleAccess;
   fieldAccess?: V1SecurityRuleFieldAccess;
   rowFilter?: V1SecurityRuleRowFilter;
+  minGroupSize?: V1SecurityRuleMinGroupSize;
+}
+
+/**
+ * SecurityRuleEvaluation describes the evaluation of a single security rule.
+ */
+export interface V1SecurityRuleEvaluation {
+  rule?: V1SecurityRule;
+  source?: V1SecurityRuleSource;
+  /** Whether the rule's condition evaluated to true (or the rule has no condition). */
+  conditionMatched?: boolean;
+  /** Whether evaluation of the rule was skipped because access had already been denied by an earlier rule. */
+  skipped?: boolean;
 }
 
 export interface V1Schedule {
@@ -799,6 +996,17 @@ export interface V1Schedule {
   timeZone?: string;
 }
 
+export interface V1SourceSpec {
+  sourceConnector?: string;
+  sinkConnector?: string;
+  properties?: V1SourceSpecProperties;
+  refreshSchedule?: V1Schedule;
+  timeoutSeconds?: number;
+  stageChanges?: boolean;
+  streamIngestion?: boolean;
+  trigger?: boolean;
+}
+
 export interface V1S3Object {
   name?: string;
   modifiedOn?: string;
@@ -830,6 +1038,29 @@ export interface V1ResourceName {
   name?: string;
 }
 
+/**
+ * ResourceSecurityExplanation describes the resolved security of a single resource for the simulated user.
+ */
+export interface V1ResourceSecurityExplanation {
+  resource?: V1ResourceName;
+  /** Whether the user can access the resource. */
+  access?: boolean;
+  /** Index in rules of the rule that decided access.
+It is -1 if no rule granted or denied access, in which case access is implicitly denied. */
+  accessRuleIndex?: number;
+  /** Whether all fields can be accessed (i.e. no field access rules applied). */
+  allFields?: boolean;
+  /** Access decision for each field. Only populated if all_fields is false. */
+  fields?: V1FieldSecurityExplanation[];
+  /** The effective SQL row filter. */
+  rowFilter?: string;
+  queryFilter?: V1Expression;
+  /** The rules that were evaluated, in evaluation order. */
+  rules?: V1SecurityRuleEvaluation[];
+  /** Error encountered while resolving the rules (e.g. an invalid template). */
+  error?: string;
+}
+
 export interface V1ResourceMeta {
   name?: V1ResourceName;
   refs?: V1ResourceName[];
@@ -849,6 +1080,11 @@ export interface V1ResourceMeta {
   renamedFrom?: V1ResourceName;
 }
 
+export interface V1ResourceLineageEdge {
+  from?: V1ResourceName;
+  to?: V1ResourceName;
+}
+
 export type V1ResourceEvent =
   (typeof V1ResourceEvent)[keyof typeof V1ResourceEvent];
 
@@ -859,14 +1095,47 @@ export const V1ResourceEvent = {
   RESOURCE_EVENT_DELETE: "RESOURCE_EVENT_DELETE",
 } as const;
 
+export interface V1Resource {
+  meta?: V1ResourceMeta;
+  projectParser?: V1ProjectParser;
+  source?: V1SourceV2;
+  model?: V1ModelV2;
+  metricsView?: V1MetricsViewV2;
+  explore?: V1Explore;
+  migration?: V1Migration;
+  report?: V1Report;
+  alert?: V1Alert;
+  pullTrigger?: V1PullTrigger;
+  refreshTrigger?: V1RefreshTrigger;
+  bucketPlanner?: V1BucketPlanner;
+  theme?: V1Theme;
+  component?: V1Component;
+  canvas?: V1Canvas;
+  api?: V1API;
+  connector?: V1ConnectorV2;
+}
+
+export type V1ResolveComponentResponseDataItem = { [key: string]: any };
+
 export type V1ResolveComponentResponseRendererProperties = {
   [key: string]: any;
 };
 
 export interface V1ResolveComponentResponse {
   rendererProperties?: V1ResolveComponentResponseRendererProperties;
+  filters?: V1ComponentFilters;
+  schema?: V1StructType;
+  /** Data returned by the component's data resolver. Only set if the component has a data resolver. */
+  data?: V1ResolveComponentResponseDataItem[];
 }
 
+/**
+ * The canvas filters that apply to each component, keyed by component name.
+ */
+export type V1ResolveCanvasResponseComponentFilters = {
+  [key: string]: V1ComponentFilters;
+};
+
 /**
  * All the metrics view resources referenced in the components' renderer_properties.metrics_view field.
  */
@@ -891,17 +1160,25 @@ The resources state.valid_spec.renderer_properties will have templating resolved
   resolvedComponents?: V1ResolveCanvasResponseResolvedComponents;
   /** All the metrics view resources referenced in the components' renderer_properties.metrics_view field. */
   referencedMetricsViews?: V1ResolveCanvasResponseReferencedMetricsViews;
-}
-
-export interface V1ReportState {
-  nextRunOn?: string;
-  currentExecution?: V1ReportExecution;
-  executionHistory?: V1ReportExecution[];
-  executionCount?: number;
+  /** The canvas filters that apply to each component, keyed by component name. */
+  componentFilters?: V1ResolveCanvasResponseComponentFilters;
 }
 
 export type V1ReportSpecAnnotations = { [key: string]: string };
 
+/**
+ * ReportSnapshotOptions configures the dashboard rendered by a report in EXPORT_FORMAT_PDF or EXPORT_FORMAT_PNG.
+ */
+export interface V1ReportSnapshotOptions {
+  /** Name of the canvas to render. Only one of canvas and explore is set. */
+  canvas?: string;
+  /** Name of the explore to render. Only one of canvas and explore is set. */
+  explore?: string;
+  /** Size of the browser viewport in pixels. The renderer's defaults are used if not set. */
+  width?: number;
+  height?: number;
+}
+
 export interface V1ReportSpec {
   displayName?: string;
   trigger?: boolean;
@@ -911,6 +1188,8 @@ export interface V1ReportSpec {
   queryArgsJson?: string;
   exportLimit?: string;
   exportFormat?: V1ExportFormat;
+  exportGoogleSheets?: V1GoogleSheetsExportOptions;
+  exportSnapshot?: V1ReportSnapshotOptions;
   notifiers?: V1Notifier[];
   annotations?: V1ReportSpecAnnotations;
   /** If true, will use the lowest watermark of its refs instead of the trigger time. */
@@ -918,6 +1197,7 @@ export interface V1ReportSpec {
   intervalsIsoDuration?: string;
   intervalsLimit?: number;
   intervalsCheckUnclosed?: boolean;
+  burst?: V1ReportBurst;
 }
 
 export interface V1ReportExecution {
@@ -926,6 +1206,51 @@ export interface V1ReportExecution {
   reportTime?: string;
   startedOn?: string;
   finishedOn?: string;
+  /** Results of sending a burst report to each of its recipients. */
+  burstResults?: V1ReportBurstResult[];
+}
+
+export interface V1ReportState {
+  nextRunOn?: string;
+  currentExecution?: V1ReportExecution;
+  executionHistory?: V1ReportExecution[];
+  executionCount?: number;
+}
+
+/**
+ * Security attributes to execute the slice's query with. Defaults to the attributes of the report's owner.
+ */
+export type V1ReportBurstSliceAttributes = { [key: string]: any };
+
+export interface V1ReportBurstSlice {
+  /** Value of the burst dimension to filter the slice by. Only used if ReportBurst.dimension is set. */
+  value?: unknown;
+  /** Security attributes to execute the slice's query with. Defaults to the attributes of the report's owner. */
+  attributes?: V1ReportBurstSliceAttributes;
+  /** Email addresses to send the slice's output to. */
+  recipients?: string[];
+}
+
+export interface V1ReportBurstResult {
+  recipient?: string;
+  /** The slice the recipient was sent, which is the recipient's email or the slice's dimension value. */
+  slice?: string;
+  /** Error that prevented the recipient from receiving the report, if any. */
+  errorMessage?: string;
+}
+
+/**
+ * ReportBurst configures bursting of a report, where the report is executed with different security claims for different recipients.
+ */
+export interface V1ReportBurst {
+  /** If true, the report is executed once per email recipient with the recipient's security attributes. */
+  perRecipient?: boolean;
+  /** Optional dimension to slice the report by. If set, each slice's query is filtered to the slice's value. */
+  dimension?: string;
+  /** Slices to execute the report for. Only used if per_recipient is false. */
+  slices?: V1ReportBurstSlice[];
+  /** Maximum number of slices to execute concurrently. Defaults to 4 if not set. */
+  concurrency?: number;
 }
 
 export interface V1Report {
@@ -933,26 +1258,6 @@ export interface V1Report {
   state?: V1ReportState;
 }
 
-export interface V1Resource {
-  meta?: V1ResourceMeta;
-  projectParser?: V1ProjectParser;
-  source?: V1SourceV2;
-  model?: V1ModelV2;
-  metricsView?: V1MetricsViewV2;
-  explore?: V1Explore;
-  migration?: V1Migration;
-  report?: V1Report;
-  alert?: V1Alert;
-  pullTrigger?: V1PullTrigger;
-  refreshTrigger?: V1RefreshTrigger;
-  bucketPlanner?: V1BucketPlanner;
-  theme?: V1Theme;
-  component?: V1Component;
-  canvas?: V1Canvas;
-  api?: V1API;
-  connector?: V1ConnectorV2;
-}
-
 export interface V1RenameFileResponse {
   [key: string]: any;
 }
@@ -961,14 +1266,6 @@ export interface V1RefreshTriggerState {
   [key: string]: any;
 }
 
-export interface V1RefreshTriggerSpec {
-  /** Resources to refresh. The refreshable types are sources, models, alerts, reports, and the project parser.
-If a model is specified, a normal incremental refresh is triggered. Use the "models" field to trigger other kinds of model refreshes. */
-  resources?: V1ResourceName[];
-  /** Models to refresh. These are specified separately to enable more fine-grained configuration. */
-  models?: V1RefreshModelTrigger[];
-}
-
 export interface V1RefreshTrigger {
   spec?: V1RefreshTriggerSpec;
   state?: V1RefreshTriggerState;
@@ -986,6 +1283,14 @@ For non-incremental models, this is equivalent to a normal refresh. */
   allErroredPartitions?: boolean;
 }
 
+export interface V1RefreshTriggerSpec {
+  /** Resources to refresh. The refreshable types are sources, models, alerts, reports, and the project parser.
+If a model is specified, a normal incremental refresh is triggered. Use the "models" field to trigger other kinds of model refreshes. */
+  resources?: V1ResourceName[];
+  /** Models to refresh. These are specified separately to enable more fine-grained configuration. */
+  models?: V1RefreshModelTrigger[];
+}
+
 export type V1ReconcileStatus =
   (typeof V1ReconcileStatus)[keyof typeof V1ReconcileStatus];
 
@@ -1017,6 +1322,7 @@ export interface V1QueryResult {
   tableCardinalityResponse?: V1TableCardinalityResponse;
   tableColumnsResponse?: V1TableColumnsResponse;
   tableRowsResponse?: V1TableRowsResponse;
+  metricsViewContributionResponse?: V1MetricsViewContributionResponse;
 }
 
 export type V1QueryResponseDataItem = { [key: string]: any };
@@ -1026,6 +1332,13 @@ export interface V1QueryResponse {
   data?: V1QueryResponseDataItem[];
 }
 
+export type V1QueryResolverResponseDataItem = { [key: string]: any };
+
+export interface V1QueryResolverResponse {
+  schema?: V1StructType;
+  data?: V1QueryResolverResponseDataItem[];
+}
+
 export interface V1QueryBatchResponse {
   index?: number;
   result?: V1QueryResult;
@@ -1052,6 +1365,7 @@ export interface V1Query {
   tableCardinalityRequest?: V1TableCardinalityRequest;
   tableColumnsRequest?: V1TableColumnsRequest;
   tableRowsRequest?: V1TableRowsRequest;
+  metricsViewContributionRequest?: V1MetricsViewContributionRequest;
 }
 
 export interface V1PutFileResponse {
@@ -1074,13 +1388,6 @@ export interface V1PullTrigger {
   state?: V1PullTriggerState;
 }
 
-export interface V1ProjectParserState {
-  parseErrors?: V1ParseError[];
-  currentCommitSha?: string;
-  currentCommitOn?: string;
-  watching?: boolean;
-}
-
 export interface V1ProjectParserSpec {
   [key: string]: any;
 }
@@ -1108,6 +1415,13 @@ export interface V1ParseError {
   external?: boolean;
 }
 
+export interface V1ProjectParserState {
+  parseErrors?: V1ParseError[];
+  currentCommitSha?: string;
+  currentCommitOn?: string;
+  watching?: boolean;
+}
+
 export type V1Operation = (typeof V1Operation)[keyof typeof V1Operation];
 
 // eslint-disable-next-line @typescript-eslint/no-redeclare
@@ -1172,11 +1486,6 @@ export interface V1Notifier {
   properties?: V1NotifierProperties;
 }
 
-export interface V1ModelV2 {
-  spec?: V1ModelSpec;
-  state?: V1ModelState;
-}
-
 /**
  * incremental_state contains the result of the most recent invocation of the model's incremental state resolver.
  */
@@ -1209,6 +1518,17 @@ export interface V1ModelState {
   partitionsModelId?: string;
   /** partitions_have_errors is true if one or more partitions failed to execute. */
   partitionsHaveErrors?: boolean;
+  resultSchema?: V1StructType;
+  /** contract_violations contains the contract violations found in the latest execution.
+It is only populated for models with a contract that is not enforced (enforced contracts fail the model instead). */
+  contractViolations?: string[];
+  /** schema_changes contains the most recent changes to the model's output schema, oldest first. It is only tracked for models with a contract. */
+  schemaChanges?: V1ModelSchemaChange[];
+}
+
+export interface V1ModelV2 {
+  spec?: V1ModelSpec;
+  state?: V1ModelState;
 }
 
 export type V1ModelSpecOutputProperties = { [key: string]: any };
@@ -1242,6 +1562,12 @@ export interface V1ModelSpec {
   outputProperties?: V1ModelSpecOutputProperties;
   trigger?: boolean;
   triggerFull?: boolean;
+  contract?: V1ModelContract;
+}
+
+export interface V1ModelSchemaChange {
+  detectedOn?: string;
+  columns?: V1ModelColumnChange[];
 }
 
 export type V1ModelPartitionData = { [key: string]: any };
@@ -1255,6 +1581,28 @@ export interface V1ModelPartition {
   elapsedMs?: number;
 }
 
+export interface V1ModelContractColumn {
+  name?: string;
+  type?: TypeCode;
+}
+
+export interface V1ModelContract {
+  /** If enforced is true, contract violations fail the model and the previous output is kept.
+Otherwise, violations are recorded in the model's state and the new output is used. */
+  enforced?: boolean;
+  /** If allow_extra_columns is true, the output may contain columns that are not listed in the contract. */
+  allowExtraColumns?: boolean;
+  columns?: V1ModelContractColumn[];
+}
+
+export interface V1ModelColumnChange {
+  name?: string;
+  added?: boolean;
+  removed?: boolean;
+  previousType?: TypeCode;
+  newType?: TypeCode;
+}
+
 export interface V1MigrationState {
   version?: number;
 }
@@ -1282,26 +1630,8 @@ export interface V1MetricsViewTotalsResponse {
   data?: V1MetricsViewTotalsResponseData;
 }
 
-export interface V1MetricsViewTotalsRequest {
-  instanceId?: string;
-  metricsViewName?: string;
-  measureNames?: string[];
-  timeStart?: string;
-  timeEnd?: string;
-  where?: V1Expression;
-  /** Optional. If both where and where_sql are set, both will be applied with an AND between them. */
-  whereSql?: string;
-  priority?: number;
-  filter?: V1MetricsViewFilter;
-}
-
 export type V1MetricsViewToplistResponseDataItem = { [key: string]: any };
 
-export interface V1MetricsViewToplistResponse {
-  meta?: V1MetricsViewColumn[];
-  data?: V1MetricsViewToplistResponseDataItem[];
-}
-
 export interface V1MetricsViewToplistRequest {
   instanceId?: string;
   metricsViewName?: string;
@@ -1323,6 +1653,20 @@ export interface V1MetricsViewToplistRequest {
 export interface V1MetricsViewTimeSeriesResponse {
   meta?: V1MetricsViewColumn[];
   data?: V1TimeSeriesValue[];
+  /** Forecasted values. Only set if a forecast was requested. */
+  forecast?: V1TimeSeriesForecastValue[];
+}
+
+export interface V1MetricsViewTimeSeriesForecast {
+  /** Optional. Defaults to all the measures in the request. */
+  measureNames?: string[];
+  /** Number of periods to forecast. */
+  periods?: number;
+  method?: V1ForecastMethod;
+  /** Optional. Number of periods in a season. Defaults to a length based on the time granularity, e.g. 7 for days. */
+  seasonLength?: number;
+  /** Optional. Confidence level of the prediction intervals. Defaults to 0.95. */
+  confidence?: number;
 }
 
 export interface V1MetricsViewTimeSeriesRequest {
@@ -1341,6 +1685,7 @@ export interface V1MetricsViewTimeSeriesRequest {
   timeZone?: string;
   priority?: number;
   filter?: V1MetricsViewFilter;
+  forecast?: V1MetricsViewTimeSeriesForecast;
 }
 
 export interface V1MetricsViewTimeRangesResponse {
@@ -1431,6 +1776,19 @@ export interface V1MetricsViewFilter {
   exclude?: MetricsViewFilterCond[];
 }
 
+export interface V1MetricsViewTotalsRequest {
+  instanceId?: string;
+  metricsViewName?: string;
+  measureNames?: string[];
+  timeStart?: string;
+  timeEnd?: string;
+  where?: V1Expression;
+  /** Optional. If both where and where_sql are set, both will be applied with an AND between them. */
+  whereSql?: string;
+  priority?: number;
+  filter?: V1MetricsViewFilter;
+}
+
 export interface V1MetricsViewRowsRequest {
   instanceId?: string;
   metricsViewName?: string;
@@ -1446,6 +1804,61 @@ export interface V1MetricsViewRowsRequest {
   filter?: V1MetricsViewFilter;
 }
 
+export interface V1MetricsViewContributionTotals {
+  baseValue?: number;
+  comparisonValue?: number;
+  deltaAbs?: number;
+  deltaRel?: unknown;
+  baseVolume?: number;
+  comparisonVolume?: number;
+}
+
+export interface V1MetricsViewContributionRow {
+  dimension?: string;
+  dimensionValue?: unknown;
+  baseValue?: number;
+  comparisonValue?: number;
+  deltaAbs?: number;
+  /** The change as a fraction of the total change. Zero if the total didn't change. */
+  contribution?: number;
+  /** The volume and effects are only set if a volume measure was requested.
+The effects add up to delta_abs:
+- volume_effect is the change explained by the change of the total volume,
+- mix_effect is the change explained by the dimension value's share of the total volume,
+- rate_effect is the change explained by the measure's value per unit of volume. */
+  baseVolume?: number;
+  comparisonVolume?: number;
+  volumeEffect?: number;
+  mixEffect?: number;
+  rateEffect?: number;
+}
+
+export interface V1MetricsViewContributionResponse {
+  totals?: V1MetricsViewContributionTotals;
+  rows?: V1MetricsViewContributionRow[];
+  /** Summary of the results written by AI. Only set if requested. */
+  narrative?: string;
+}
+
+export interface V1MetricsViewContributionRequest {
+  instanceId?: string;
+  metricsViewName?: string;
+  /** Required. The measure to explain the change of.
+The contributions of dimension values only add up to the total change for additive measures, such as sums and counts. */
+  measure?: string;
+  /** Optional. A measure for the volume that drives the measure, such as the number of orders for a revenue measure.
+If set, the change of each dimension value is decomposed into volume, mix and rate effects. */
+  volumeMeasure?: string;
+  dimensions?: string[];
+  timeRange?: V1TimeRange;
+  comparisonTimeRange?: V1TimeRange;
+  where?: V1Expression;
+  limit?: string;
+  /** Optional. If true, the response includes a summary of the results written by AI. */
+  narrative?: boolean;
+  priority?: number;
+}
+
 export interface V1MetricsViewComparisonValue {
   measureName?: string;
   baseValue?: unknown;
@@ -1510,35 +1923,17 @@ export interface V1MetricsViewComparisonMeasureAlias {
   alias?: string;
 }
 
-export interface V1MetricsViewComparisonRequest {
-  instanceId?: string;
-  metricsViewName?: string;
-  dimension?: V1MetricsViewAggregationDimension;
-  measures?: V1MetricsViewAggregationMeasure[];
-  comparisonMeasures?: string[];
-  sort?: V1MetricsViewComparisonSort[];
-  timeRange?: V1TimeRange;
-  comparisonTimeRange?: V1TimeRange;
-  where?: V1Expression;
-  /** Optional. If both where and where_sql are set, both will be applied with an AND between them. */
-  whereSql?: string;
-  having?: V1Expression;
-  /** Optional. If both having and having_sql are set, both will be applied with an AND between them. */
-  havingSql?: string;
-  aliases?: V1MetricsViewComparisonMeasureAlias[];
-  limit?: string;
-  offset?: string;
-  priority?: number;
-  exact?: boolean;
-  filter?: V1MetricsViewFilter;
-}
-
 export interface V1MetricsViewColumn {
   name?: string;
   type?: string;
   nullable?: boolean;
 }
 
+export interface V1MetricsViewToplistResponse {
+  meta?: V1MetricsViewColumn[];
+  data?: V1MetricsViewToplistResponseDataItem[];
+}
+
 export interface V1MetricsViewAggregationSort {
   name?: string;
   desc?: boolean;
@@ -1625,6 +2020,41 @@ export interface V1MetricsViewAggregationDimension {
   alias?: string;
 }
 
+export interface V1MetricsViewComparisonRequest {
+  instanceId?: string;
+  metricsViewName?: string;
+  dimension?: V1MetricsViewAggregationDimension;
+  measures?: V1MetricsViewAggregationMeasure[];
+  comparisonMeasures?: string[];
+  sort?: V1MetricsViewComparisonSort[];
+  timeRange?: V1TimeRange;
+  comparisonTimeRange?: V1TimeRange;
+  where?: V1Expression;
+  /** Optional. If both where and where_sql are set, both will be applied with an AND between them. */
+  whereSql?: string;
+  having?: V1Expression;
+  /** Optional. If both having and having_sql are set, both will be applied with an AND between them. */
+  havingSql?: string;
+  aliases?: V1MetricsViewComparisonMeasureAlias[];
+  limit?: string;
+  offset?: string;
+  priority?: number;
+  exact?: boolean;
+  filter?: V1MetricsViewFilter;
+}
+
+export interface V1Message {
+  id?: string;
+  /** Role is one of "user", "assistant" or "tool". */
+  role?: string;
+  content?: string;
+  /** Tools called by the assistant. */
+  toolCalls?: V1ToolCall[];
+  /** For messages with role "tool", the ID of the tool call that the message is a result of. */
+  toolCallId?: string;
+  createdOn?: string;
+}
+
 export interface V1MapType {
   keyType?: Runtimev1Type;
   valueType?: Runtimev1Type;
@@ -1671,6 +2101,11 @@ export interface V1ListExamplesResponse {
   examples?: V1Example[];
 }
 
+export interface V1ListConversationsResponse {
+  conversations?: V1Conversation[];
+  nextPageToken?: string;
+}
+
 export interface V1ListConnectorDriversResponse {
   connectors?: V1ConnectorDriver[];
 }
@@ -1767,10 +2202,32 @@ export interface V1HealthResponse {
   instancesHealth?: V1HealthResponseInstancesHealth;
 }
 
+/**
+ * GoogleSheetsExportOptions configures the destination of an export in EXPORT_FORMAT_GOOGLE_SHEETS.
+ */
+export interface V1GoogleSheetsExportOptions {
+  /** ID of the spreadsheet, as found in its URL. */
+  spreadsheetId?: string;
+  /** Name of the tab to write to. It is created if it doesn't exist, and its contents are replaced if it does. */
+  sheet?: string;
+}
+
 export interface V1GetResourceResponse {
   resource?: V1Resource;
 }
 
+export interface V1GetResourceLineageResponse {
+  /** Resources that the resource depends on, directly or indirectly. */
+  upstream?: V1ResourceName[];
+  /** Resources that depend on the resource, directly or indirectly. */
+  downstream?: V1ResourceName[];
+  /** Direct dependencies between the resources in the graph (including the requested resource). */
+  edges?: V1ResourceLineageEdge[];
+  /** Column-level lineage for the models and metrics views in the graph.
+Only populated if columns is true in the request. */
+  columns?: V1ColumnLineage[];
+}
+
 export interface V1GetModelPartitionsResponse {
   partitions?: V1ModelPartition[];
   nextPageToken?: string;
@@ -1794,6 +2251,10 @@ export interface V1GetExploreResponse {
   metricsView?: V1Resource;
 }
 
+export interface V1GetConversationResponse {
+  conversation?: V1Conversation;
+}
+
 export type V1GenerateResolverResponseResolverProperties = {
   [key: string]: any;
 };
@@ -1839,6 +2300,20 @@ export interface V1GCSGetCredentialsInfoResponse {
   projectId?: string;
 }
 
+/**
+ *  - FORECAST_METHOD_UNSPECIFIED: Uses Holt-Winters if the series covers at least two seasons, and a linear trend otherwise.
+ */
+export type V1ForecastMethod =
+  (typeof V1ForecastMethod)[keyof typeof V1ForecastMethod];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1ForecastMethod = {
+  FORECAST_METHOD_UNSPECIFIED: "FORECAST_METHOD_UNSPECIFIED",
+  FORECAST_METHOD_SEASONAL_NAIVE: "FORECAST_METHOD_SEASONAL_NAIVE",
+  FORECAST_METHOD_HOLT_WINTERS: "FORECAST_METHOD_HOLT_WINTERS",
+  FORECAST_METHOD_LINEAR_TREND: "FORECAST_METHOD_LINEAR_TREND",
+} as const;
+
 /**
  * FileEvent describes a file change.
  */
@@ -1867,6 +2342,17 @@ export interface V1FieldSelector {
   duckdbExpression?: string;
 }
 
+/**
+ * FieldSecurityExplanation describes the access decision for a single field of a metrics view or explore.
+ */
+export interface V1FieldSecurityExplanation {
+  field?: string;
+  allowed?: boolean;
+  /** Index in ResourceSecurityExplanation.rules of the rule that decided access to the field.
+It is -1 if no rule mentioned the field, in which case it is implicitly denied. */
+  ruleIndex?: number;
+}
+
 export interface V1Expression {
   ident?: string;
   val?: unknown;
@@ -1876,12 +2362,24 @@ export interface V1Expression {
 
 export interface V1ExportResponse {
   downloadUrlPath?: string;
+  /** URL of the sheet the data was written to (only for EXPORT_FORMAT_GOOGLE_SHEETS). */
+  spreadsheetUrl?: string;
 }
 
 export interface V1ExportReportResponse {
   downloadUrlPath?: string;
 }
 
+/**
+ *  - EXPORT_FORMAT_JSONL: Newline-delimited JSON, with one JSON object per row.
+ - EXPORT_FORMAT_ARROW: Arrow IPC stream.
+ - EXPORT_FORMAT_GOOGLE_SHEETS: Writes the result to a tab in a Google Sheet instead of a file.
+It requires the export request to provide GoogleSheetsExportOptions.
+ - EXPORT_FORMAT_PDF: Rendered snapshot of a canvas or explore dashboard as a PDF document.
+It is only supported for reports, which must provide ReportSnapshotOptions.
+ - EXPORT_FORMAT_PNG: Rendered snapshot of a canvas or explore dashboard as a PNG image.
+It is only supported for reports, which must provide ReportSnapshotOptions.
+ */
 export type V1ExportFormat =
   (typeof V1ExportFormat)[keyof typeof V1ExportFormat];
 
@@ -1891,6 +2389,11 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
+  EXPORT_FORMAT_GOOGLE_SHEETS: "EXPORT_FORMAT_GOOGLE_SHEETS",
+  EXPORT_FORMAT_PDF: "EXPORT_FORMAT_PDF",
+  EXPORT_FORMAT_PNG: "EXPORT_FORMAT_PNG",
 } as const;
 
 export type V1ExploreWebView =
@@ -1905,11 +2408,6 @@ export const V1ExploreWebView = {
   EXPLORE_WEB_VIEW_CANVAS: "EXPLORE_WEB_VIEW_CANVAS",
 } as const;
 
-export interface V1ExploreTimeRange {
-  range?: string;
-  comparisonTimeRanges?: V1ExploreComparisonTimeRange[];
-}
-
 export interface V1ExploreSpec {
   displayName?: string;
   description?: string;
@@ -1956,25 +2454,6 @@ export const V1ExploreSortType = {
   EXPLORE_SORT_TYPE_DIMENSION: "EXPLORE_SORT_TYPE_DIMENSION",
 } as const;
 
-export interface V1ExploreComparisonTimeRange {
-  /** ISO 8601 duration string to use as an offset from the base time range. */
-  offset?: string;
-  /** ISO 8601 duration string for the duration of the comparison time range.
-If not specified, it should fallback to the range of the base time range. */
-  range?: string;
-}
-
-export type V1ExploreComparisonMode =
-  (typeof V1ExploreComparisonMode)[keyof typeof V1ExploreComparisonMode];
-
-// eslint-disable-next-line @typescript-eslint/no-redeclare
-export const V1ExploreComparisonMode = {
-  EXPLORE_COMPARISON_MODE_UNSPECIFIED: "EXPLORE_COMPARISON_MODE_UNSPECIFIED",
-  EXPLORE_COMPARISON_MODE_NONE: "EXPLORE_COMPARISON_MODE_NONE",
-  EXPLORE_COMPARISON_MODE_TIME: "EXPLORE_COMPARISON_MODE_TIME",
-  EXPLORE_COMPARISON_MODE_DIMENSION: "EXPLORE_COMPARISON_MODE_DIMENSION",
-} as const;
-
 export interface V1ExplorePreset {
   /** Dimensions to show. If `dimensions_selector` is set, this will only be set in `state.valid_spec`. */
   dimensions?: string[];
@@ -2008,11 +2487,39 @@ If not found in `time_ranges`, it should be added to the list. */
   pivotSortAsc?: boolean;
 }
 
+export interface V1ExploreComparisonTimeRange {
+  /** ISO 8601 duration string to use as an offset from the base time range. */
+  offset?: string;
+  /** ISO 8601 duration string for the duration of the comparison time range.
+If not specified, it should fallback to the range of the base time range. */
+  range?: string;
+}
+
+export interface V1ExploreTimeRange {
+  range?: string;
+  comparisonTimeRanges?: V1ExploreComparisonTimeRange[];
+}
+
+export type V1ExploreComparisonMode =
+  (typeof V1ExploreComparisonMode)[keyof typeof V1ExploreComparisonMode];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1ExploreComparisonMode = {
+  EXPLORE_COMPARISON_MODE_UNSPECIFIED: "EXPLORE_COMPARISON_MODE_UNSPECIFIED",
+  EXPLORE_COMPARISON_MODE_NONE: "EXPLORE_COMPARISON_MODE_NONE",
+  EXPLORE_COMPARISON_MODE_TIME: "EXPLORE_COMPARISON_MODE_TIME",
+  EXPLORE_COMPARISON_MODE_DIMENSION: "EXPLORE_COMPARISON_MODE_DIMENSION",
+} as const;
+
 export interface V1Explore {
   spec?: V1ExploreSpec;
   state?: V1ExploreState;
 }
 
+export interface V1ExplainSecurityResponse {
+  resources?: V1ResourceSecurityExplanation[];
+}
+
 /**
  * Example contains metadata about an example project that is available for unpacking.
  */
@@ -2039,6 +2546,10 @@ export interface V1DeleteFileResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteConversationResponse {
+  [key: string]: any;
+}
+
 export interface V1CreateTriggerResponse {
   [key: string]: any;
 }
@@ -2073,19 +2584,18 @@ export interface V1CreateDirectoryResponse {
   [key: string]: any;
 }
 
-export interface V1ConnectorState {
-  specHash?: string;
+export interface V1Conversation {
+  id?: string;
+  ownerId?: string;
+  title?: string;
+  createdOn?: string;
+  updatedOn?: string;
+  /** Only populated when getting a single conversation. */
+  messages?: V1Message[];
 }
 
-export interface V1ConnectorSpec {
-  driver?: string;
-  properties?: V1ConnectorSpecProperties;
-  templatedProperties?: string[];
-  provision?: boolean;
-  provisionArgs?: V1ConnectorSpecProvisionArgs;
-  /** DEPRECATED: properties_from_variables stores properties whose value is a variable.
-NOTE : properties_from_variables and properties both should be used to get all properties. */
-  propertiesFromVariables?: V1ConnectorSpecPropertiesFromVariables;
+export interface V1ConnectorState {
+  specHash?: string;
 }
 
 export interface V1ConnectorV2 {
@@ -2103,6 +2613,17 @@ export type V1ConnectorSpecProvisionArgs = { [key: string]: any };
 
 export type V1ConnectorSpecProperties = { [key: string]: string };
 
+export interface V1ConnectorSpec {
+  driver?: string;
+  properties?: V1ConnectorSpecProperties;
+  templatedProperties?: string[];
+  provision?: boolean;
+  provisionArgs?: V1ConnectorSpecProvisionArgs;
+  /** DEPRECATED: properties_from_variables stores properties whose value is a variable.
+NOTE : properties_from_variables and properties both should be used to get all properties. */
+  propertiesFromVariables?: V1ConnectorSpecPropertiesFromVariables;
+}
+
 /**
  * ConnectorDriver represents a connector driver available in the runtime.
  */
@@ -2153,6 +2674,8 @@ export interface V1ComponentVariable {
   defaultValue?: unknown;
 }
 
+export type V1ComponentSpecResolverProperties = { [key: string]: any };
+
 export type V1ComponentSpecRendererProperties = { [key: string]: any };
 
 export interface V1ComponentSpec {
@@ -2163,17 +2686,36 @@ export interface V1ComponentSpec {
   input?: V1ComponentVariable[];
   output?: V1ComponentVariable;
   definedInCanvas?: boolean;
+  /** Optional resolver for the component's data, such as "metrics_join" for joining multiple metrics views. */
+  resolver?: string;
+  resolverProperties?: V1ComponentSpecResolverProperties;
 }
 
 export interface V1ComponentState {
   validSpec?: V1ComponentSpec;
 }
 
+/**
+ * Filters from a canvas that apply to a component's metrics view.
+ */
+export interface V1ComponentFilters {
+  where?: V1Expression;
+  having?: V1Expression;
+  /** Time range to apply to the component's queries. */
+  timeRange?: string;
+}
+
 export interface V1Component {
   spec?: V1ComponentSpec;
   state?: V1ComponentState;
 }
 
+export interface V1CompleteResponse {
+  conversationId?: string;
+  /** The messages added to the conversation, starting with the user's message. */
+  messages?: V1Message[];
+}
+
 export interface V1ColumnTopKResponse {
   categoricalSummary?: V1CategoricalSummary;
 }
@@ -2296,6 +2838,19 @@ export interface V1ColumnNullCountRequest {
   priority?: number;
 }
 
+export interface V1ColumnLineageSource {
+  resource?: V1ResourceName;
+  column?: string;
+}
+
+export interface V1ColumnLineage {
+  resource?: V1ResourceName;
+  /** Name of a column of a model, or of a dimension or measure of a metrics view. */
+  column?: string;
+  /** The upstream columns that the column is derived from. */
+  sources?: V1ColumnLineageSource[];
+}
+
 export interface V1ColumnDescriptiveStatisticsResponse {
   numericSummary?: V1NumericSummary;
 }
@@ -2310,10 +2865,6 @@ export interface V1ColumnDescriptiveStatisticsRequest {
   priority?: number;
 }
 
-export interface V1ColumnCardinalityResponse {
-  categoricalSummary?: V1CategoricalSummary;
-}
-
 export interface V1ColumnCardinalityRequest {
   instanceId?: string;
   connector?: string;
@@ -2340,6 +2891,10 @@ export interface V1CategoricalSummary {
   cardinality?: number;
 }
 
+export interface V1ColumnCardinalityResponse {
+  categoricalSummary?: V1CategoricalSummary;
+}
+
 export interface V1CanvasPreset {
   /** Time range for the explore.
 It corresponds to the `range` property of the explore's `time_ranges`.
@@ -2359,6 +2914,22 @@ export interface V1CanvasItem {
   height?: number;
 }
 
+export interface V1CanvasFilter {
+  /** Name of the filter. It is unique within the canvas.
+A component with an output variable of the same name sets the filter's value when clicked (cross-filtering). */
+  name?: string;
+  displayName?: string;
+  /** Optional. If set, the filter only applies to components that query this metrics view. */
+  metricsView?: string;
+  /** Dimension to filter on. Only one of dimension and measure is set. */
+  dimension?: string;
+  /** Measure to filter on. Only one of dimension and measure is set. */
+  measure?: string;
+  operator?: V1Operation;
+  /** Optional default value. A list of values for dimension filters and a number for measure filters. */
+  defaultValue?: unknown;
+}
+
 export interface V1CanvasSpec {
   /** Display name for the canvas. */
   displayName?: string;
@@ -2391,6 +2962,9 @@ The values should be valid IANA location identifiers. */
   securityRules?: V1SecurityRule[];
   /** Banner text that can be displayed in Rill Cloud. */
   banner?: string;
+  /** Filters that apply to all the components in the canvas.
+In addition to these, the canvas always has a time range filter based on the default preset. */
+  filters?: V1CanvasFilter[];
 }
 
 export interface V1CanvasState {
@@ -2416,10 +2990,6 @@ export interface V1BucketPlannerState {
   region?: string;
 }
 
-export interface V1BucketPlannerSpec {
-  extractPolicy?: V1BucketExtractPolicy;
-}
-
 export interface V1BucketPlanner {
   spec?: V1BucketPlannerSpec;
   state?: V1BucketPlannerState;
@@ -2432,6 +3002,10 @@ export interface V1BucketExtractPolicy {
   filesLimit?: string;
 }
 
+export interface V1BucketPlannerSpec {
+  extractPolicy?: V1BucketExtractPolicy;
+}
+
 export interface V1BigQueryListTablesResponse {
   nextPageToken?: string;
   names?: string[];
@@ -2461,6 +3035,22 @@ export interface V1AssertionResult {
   errorMessage?: string;
 }
 
+export type V1AskMetricsViewResponseDataItem = { [key: string]: any };
+
+/**
+ * The generated query in the format of the "metrics" resolver's properties.
+ */
+export type V1AskMetricsViewResponseQuery = { [key: string]: any };
+
+export interface V1AskMetricsViewResponse {
+  /** The generated query in the format of the "metrics" resolver's properties. */
+  query?: V1AskMetricsViewResponseQuery;
+  /** Explanation of how the query answers the question. */
+  explanation?: string;
+  schema?: V1StructType;
+  data?: V1AskMetricsViewResponseDataItem[];
+}
+
 export interface V1AnalyzedVariable {
   /** Name of the variable. */
   name?: string;
@@ -2554,6 +3144,8 @@ export interface V1AlertState {
   currentExecution?: V1AlertExecution;
   executionHistory?: V1AlertExecution[];
   executionCount?: number;
+  /** Number of consecutive executions that did not pass. Used to escalate notifications for alerts that don't recover. */
+  consecutiveFailures?: number;
 }
 
 export interface V1Alert {
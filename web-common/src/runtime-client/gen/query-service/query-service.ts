@@ -31,6 +31,8 @@ import type {
   QueryServiceMetricsViewAggregationBody,
   V1MetricsViewComparisonResponse,
   QueryServiceMetricsViewComparisonBody,
+  V1MetricsViewContributionResponse,
+  QueryServiceMetricsViewContributionBody,
   V1MetricsViewRowsResponse,
   QueryServiceMetricsViewRowsBody,
   V1MetricsViewSchemaResponse,
@@ -61,6 +63,8 @@ import type {
   QueryServiceColumnTimeGrainParams,
   V1TableCardinalityResponse,
   QueryServiceTableCardinalityParams,
+  V1TableDiffResponse,
+  QueryServiceTableDiffBody,
   V1ColumnTimeRangeResponse,
   QueryServiceColumnTimeRangeParams,
   V1ColumnTimeSeriesResponse,
@@ -71,6 +75,8 @@ import type {
   QueryServiceQueryBody,
   QueryServiceQueryBatch200,
   QueryServiceQueryBatchBody,
+  V1QueryResolverResponse,
+  QueryServiceQueryResolverBody,
   V1ExportReportResponse,
   QueryServiceExportReportBody,
 } from "../index.schemas";
@@ -743,6 +749,77 @@ export const createQueryServiceMetricsViewComparison = <
   return query;
 };
 
+/**
+ * @summary MetricsViewContribution explains the change of a measure between two time ranges.
+It ranks the values of the metrics view's dimensions by their contribution to the change,
+and optionally decomposes each contribution into volume, mix and rate effects.
+ */
+export const queryServiceMetricsViewContribution = (
+  instanceId: string,
+  metricsViewName: string,
+  queryServiceMetricsViewContributionBody: QueryServiceMetricsViewContributionBody,
+) => {
+  return httpClient<V1MetricsViewContributionResponse>({
+    url: `/v1/instances/${instanceId}/queries/metrics-views/${metricsViewName}/contribution`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: queryServiceMetricsViewContributionBody,
+  });
+};
+
+export type QueryServiceMetricsViewContributionMutationResult = NonNullable<
+  Awaited<ReturnType<typeof queryServiceMetricsViewContribution>>
+>;
+export type QueryServiceMetricsViewContributionMutationBody =
+  QueryServiceMetricsViewContributionBody;
+export type QueryServiceMetricsViewContributionMutationError =
+  ErrorType<RpcStatus>;
+
+export const createQueryServiceMetricsViewContribution = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof queryServiceMetricsViewContribution>>,
+    TError,
+    {
+      instanceId: string;
+      metricsViewName: string;
+      data: QueryServiceMetricsViewContributionBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof queryServiceMetricsViewContribution>>,
+    {
+      instanceId: string;
+      metricsViewName: string;
+      data: QueryServiceMetricsViewContributionBody;
+    }
+  > = (props) => {
+    const { instanceId, metricsViewName, data } = props ?? {};
+
+    return queryServiceMetricsViewContribution(
+      instanceId,
+      metricsViewName,
+      data,
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof queryServiceMetricsViewContribution>>,
+    TError,
+    {
+      instanceId: string;
+      metricsViewName: string;
+      data: QueryServiceMetricsViewContributionBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * ie. without granularity
 | column1 | column2 | dimension |
@@ -1978,6 +2055,65 @@ export const createQueryServiceTableCardinality = <
   return query;
 };
 
+/**
+ * @summary TableDiff compares the schema, row counts, column profiles and (optionally) key-matched rows of two tables
+ */
+export const queryServiceTableDiff = (
+  instanceId: string,
+  queryServiceTableDiffBody: QueryServiceTableDiffBody,
+) => {
+  return httpClient<V1TableDiffResponse>({
+    url: `/v1/instances/${instanceId}/queries/table-diff`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: queryServiceTableDiffBody,
+  });
+};
+
+export type QueryServiceTableDiffMutationResult = NonNullable<
+  Awaited<ReturnType<typeof queryServiceTableDiff>>
+>;
+export type QueryServiceTableDiffMutationBody = QueryServiceTableDiffBody;
+export type QueryServiceTableDiffMutationError = ErrorType<RpcStatus>;
+
+export const createQueryServiceTableDiff = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof queryServiceTableDiff>>,
+    TError,
+    {
+      instanceId: string;
+      data: QueryServiceTableDiffBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof queryServiceTableDiff>>,
+    {
+      instanceId: string;
+      data: QueryServiceTableDiffBody;
+    }
+  > = (props) => {
+    const { instanceId, data } = props ?? {};
+
+    return queryServiceTableDiff(instanceId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof queryServiceTableDiff>>,
+    TError,
+    {
+      instanceId: string;
+      data: QueryServiceTableDiffBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary Get the time range summaries (min, max) for a column
  */
@@ -2348,6 +2484,67 @@ export const createQueryServiceQueryBatch = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary QueryResolver runs an ad-hoc query using a resolver, such as "sql" or "metrics_sql".
+Only admins can run ad-hoc resolvers. They can optionally impersonate another user by passing their attributes.
+ */
+export const queryServiceQueryResolver = (
+  instanceId: string,
+  queryServiceQueryResolverBody: QueryServiceQueryResolverBody,
+) => {
+  return httpClient<V1QueryResolverResponse>({
+    url: `/v1/instances/${instanceId}/query/resolver`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: queryServiceQueryResolverBody,
+  });
+};
+
+export type QueryServiceQueryResolverMutationResult = NonNullable<
+  Awaited<ReturnType<typeof queryServiceQueryResolver>>
+>;
+export type QueryServiceQueryResolverMutationBody =
+  QueryServiceQueryResolverBody;
+export type QueryServiceQueryResolverMutationError = ErrorType<RpcStatus>;
+
+export const createQueryServiceQueryResolver = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof queryServiceQueryResolver>>,
+    TError,
+    {
+      instanceId: string;
+      data: QueryServiceQueryResolverBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof queryServiceQueryResolver>>,
+    {
+      instanceId: string;
+      data: QueryServiceQueryResolverBody;
+    }
+  > = (props) => {
+    const { instanceId, data } = props ?? {};
+
+    return queryServiceQueryResolver(instanceId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof queryServiceQueryResolver>>,
+    TError,
+    {
+      instanceId: string;
+      data: QueryServiceQueryResolverBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary ExportReport builds a URL to download the results of a query as a file.
  */
@@ -31,6 +31,12 @@ import type {
   RuntimeServiceDeleteInstanceBody,
   V1EditInstanceResponse,
   RuntimeServiceEditInstanceBody,
+  V1CompleteResponse,
+  RuntimeServiceCompleteBody,
+  V1ListConversationsResponse,
+  RuntimeServiceListConversationsParams,
+  V1GetConversationResponse,
+  V1DeleteConversationResponse,
   V1AnalyzeConnectorsResponse,
   V1ListNotifierConnectorsResponse,
   V1ListFilesResponse,
@@ -61,16 +67,22 @@ import type {
   RuntimeServiceGetLogsParams,
   RuntimeServiceWatchLogs200,
   RuntimeServiceWatchLogsParams,
+  V1AskMetricsViewResponse,
+  RuntimeServiceAskMetricsViewBody,
   V1GetModelPartitionsResponse,
   RuntimeServiceGetModelPartitionsParams,
   V1GetResourceResponse,
   RuntimeServiceGetResourceParams,
+  V1GetResourceLineageResponse,
+  RuntimeServiceGetResourceLineageParams,
   V1ListResourcesResponse,
   RuntimeServiceListResourcesParams,
   RuntimeServiceWatchResources200,
   RuntimeServiceWatchResourcesParams,
   V1GetExploreResponse,
   RuntimeServiceGetExploreParams,
+  V1ExplainSecurityResponse,
+  RuntimeServiceExplainSecurityBody,
   V1CreateTriggerResponse,
   RuntimeServiceCreateTriggerBody,
   V1AnalyzeVariablesResponse,
@@ -691,6 +703,269 @@ export const createRuntimeServiceEditInstance = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary Complete adds a user message to an AI conversation and runs the AI agent until it has responded.
+The agent can call tools that list metrics views and query them on behalf of the user.
+If no conversation ID is provided, a new conversation is started.
+ */
+export const runtimeServiceComplete = (
+  instanceId: string,
+  runtimeServiceCompleteBody: RuntimeServiceCompleteBody,
+) => {
+  return httpClient<V1CompleteResponse>({
+    url: `/v1/instances/${instanceId}/ai/complete`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: runtimeServiceCompleteBody,
+  });
+};
+
+export type RuntimeServiceCompleteMutationResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceComplete>>
+>;
+export type RuntimeServiceCompleteMutationBody = RuntimeServiceCompleteBody;
+export type RuntimeServiceCompleteMutationError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceComplete = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServiceComplete>>,
+    TError,
+    {
+      instanceId: string;
+      data: RuntimeServiceCompleteBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof runtimeServiceComplete>>,
+    {
+      instanceId: string;
+      data: RuntimeServiceCompleteBody;
+    }
+  > = (props) => {
+    const { instanceId, data } = props ?? {};
+
+    return runtimeServiceComplete(instanceId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof runtimeServiceComplete>>,
+    TError,
+    {
+      instanceId: string;
+      data: RuntimeServiceCompleteBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListConversations lists the current user's AI conversations.
+ */
+export const runtimeServiceListConversations = (
+  instanceId: string,
+  params?: RuntimeServiceListConversationsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListConversationsResponse>({
+    url: `/v1/instances/${instanceId}/ai/conversations`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getRuntimeServiceListConversationsQueryKey = (
+  instanceId: string,
+  params?: RuntimeServiceListConversationsParams,
+) => [
+  `/v1/instances/${instanceId}/ai/conversations`,
+  ...(params ? [params] : []),
+];
+
+export type RuntimeServiceListConversationsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceListConversations>>
+>;
+export type RuntimeServiceListConversationsQueryError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceListConversations = <
+  TData = Awaited<ReturnType<typeof runtimeServiceListConversations>>,
+  TError = ErrorType<RpcStatus>,
+>(
+  instanceId: string,
+  params?: RuntimeServiceListConversationsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceListConversations>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & {
+  queryKey: QueryKey;
+} => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceListConversationsQueryKey(instanceId, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceListConversations>>
+  > = ({ signal }) =>
+    runtimeServiceListConversations(instanceId, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceListConversations>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!instanceId,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & {
+    queryKey: QueryKey;
+  };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary GetConversation returns an AI conversation of the current user including its messages.
+ */
+export const runtimeServiceGetConversation = (
+  instanceId: string,
+  conversationId: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetConversationResponse>({
+    url: `/v1/instances/${instanceId}/ai/conversations/${conversationId}`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getRuntimeServiceGetConversationQueryKey = (
+  instanceId: string,
+  conversationId: string,
+) => [`/v1/instances/${instanceId}/ai/conversations/${conversationId}`];
+
+export type RuntimeServiceGetConversationQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceGetConversation>>
+>;
+export type RuntimeServiceGetConversationQueryError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceGetConversation = <
+  TData = Awaited<ReturnType<typeof runtimeServiceGetConversation>>,
+  TError = ErrorType<RpcStatus>,
+>(
+  instanceId: string,
+  conversationId: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceGetConversation>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & {
+  queryKey: QueryKey;
+} => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceGetConversationQueryKey(instanceId, conversationId);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceGetConversation>>
+  > = ({ signal }) =>
+    runtimeServiceGetConversation(instanceId, conversationId, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceGetConversation>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(instanceId && conversationId),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & {
+    queryKey: QueryKey;
+  };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary DeleteConversation deletes an AI conversation of the current user.
+ */
+export const runtimeServiceDeleteConversation = (
+  instanceId: string,
+  conversationId: string,
+) => {
+  return httpClient<V1DeleteConversationResponse>({
+    url: `/v1/instances/${instanceId}/ai/conversations/${conversationId}`,
+    method: "delete",
+  });
+};
+
+export type RuntimeServiceDeleteConversationMutationResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceDeleteConversation>>
+>;
+
+export type RuntimeServiceDeleteConversationMutationError =
+  ErrorType<RpcStatus>;
+
+export const createRuntimeServiceDeleteConversation = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServiceDeleteConversation>>,
+    TError,
+    {
+      instanceId: string;
+      conversationId: string;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof runtimeServiceDeleteConversation>>,
+    {
+      instanceId: string;
+      conversationId: string;
+    }
+  > = (props) => {
+    const { instanceId, conversationId } = props ?? {};
+
+    return runtimeServiceDeleteConversation(instanceId, conversationId);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof runtimeServiceDeleteConversation>>,
+    TError,
+    {
+      instanceId: string;
+      conversationId: string;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary AnalyzeConnectors scans all the project files and returns information about all referenced connectors.
  */
@@ -1713,6 +1988,71 @@ export const createRuntimeServiceWatchLogs = <
   return query;
 };
 
+/**
+ * @summary AskMetricsView answers a natural language question about a metrics view.
+It uses AI to translate the question to a metrics query, and returns the query's result along with an explanation.
+ */
+export const runtimeServiceAskMetricsView = (
+  instanceId: string,
+  metricsView: string,
+  runtimeServiceAskMetricsViewBody: RuntimeServiceAskMetricsViewBody,
+) => {
+  return httpClient<V1AskMetricsViewResponse>({
+    url: `/v1/instances/${instanceId}/metrics-views/${metricsView}/ask`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: runtimeServiceAskMetricsViewBody,
+  });
+};
+
+export type RuntimeServiceAskMetricsViewMutationResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceAskMetricsView>>
+>;
+export type RuntimeServiceAskMetricsViewMutationBody =
+  RuntimeServiceAskMetricsViewBody;
+export type RuntimeServiceAskMetricsViewMutationError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceAskMetricsView = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServiceAskMetricsView>>,
+    TError,
+    {
+      instanceId: string;
+      metricsView: string;
+      data: RuntimeServiceAskMetricsViewBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof runtimeServiceAskMetricsView>>,
+    {
+      instanceId: string;
+      metricsView: string;
+      data: RuntimeServiceAskMetricsViewBody;
+    }
+  > = (props) => {
+    const { instanceId, metricsView, data } = props ?? {};
+
+    return runtimeServiceAskMetricsView(instanceId, metricsView, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof runtimeServiceAskMetricsView>>,
+    TError,
+    {
+      instanceId: string;
+      metricsView: string;
+      data: RuntimeServiceAskMetricsViewBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary GetModelPartitions returns the partitions of a model
  */
@@ -1860,6 +2200,81 @@ export const createRuntimeServiceGetResource = <
   return query;
 };
 
+/**
+ * @summary GetResourceLineage returns the upstream and downstream resources of a resource.
+It can also return best-effort column-level lineage for the models and metrics views in the graph.
+ */
+export const runtimeServiceGetResourceLineage = (
+  instanceId: string,
+  params?: RuntimeServiceGetResourceLineageParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetResourceLineageResponse>({
+    url: `/v1/instances/${instanceId}/resource/lineage`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getRuntimeServiceGetResourceLineageQueryKey = (
+  instanceId: string,
+  params?: RuntimeServiceGetResourceLineageParams,
+) => [
+  `/v1/instances/${instanceId}/resource/lineage`,
+  ...(params ? [params] : []),
+];
+
+export type RuntimeServiceGetResourceLineageQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceGetResourceLineage>>
+>;
+export type RuntimeServiceGetResourceLineageQueryError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceGetResourceLineage = <
+  TData = Awaited<ReturnType<typeof runtimeServiceGetResourceLineage>>,
+  TError = ErrorType<RpcStatus>,
+>(
+  instanceId: string,
+  params?: RuntimeServiceGetResourceLineageParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceGetResourceLineage>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & {
+  queryKey: QueryKey;
+} => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceGetResourceLineageQueryKey(instanceId, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceGetResourceLineage>>
+  > = ({ signal }) =>
+    runtimeServiceGetResourceLineage(instanceId, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceGetResourceLineage>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!instanceId,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & {
+    queryKey: QueryKey;
+  };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
 /**
  * @summary ListResources lists the resources stored in the catalog
  */
@@ -2076,6 +2491,67 @@ export const createRuntimeServiceGetExplore = <
   return query;
 };
 
+/**
+ * @summary ExplainSecurity simulates the security policies of the project's resources for a user with the provided attributes.
+For each resource, it returns the access decision, the visible fields, the effective row filter and the rules that produced each outcome.
+ */
+export const runtimeServiceExplainSecurity = (
+  instanceId: string,
+  runtimeServiceExplainSecurityBody: RuntimeServiceExplainSecurityBody,
+) => {
+  return httpClient<V1ExplainSecurityResponse>({
+    url: `/v1/instances/${instanceId}/security/explain`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: runtimeServiceExplainSecurityBody,
+  });
+};
+
+export type RuntimeServiceExplainSecurityMutationResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceExplainSecurity>>
+>;
+export type RuntimeServiceExplainSecurityMutationBody =
+  RuntimeServiceExplainSecurityBody;
+export type RuntimeServiceExplainSecurityMutationError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceExplainSecurity = <
+  TError = ErrorType<RpcStatus>,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServiceExplainSecurity>>,
+    TError,
+    {
+      instanceId: string;
+      data: RuntimeServiceExplainSecurityBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof runtimeServiceExplainSecurity>>,
+    {
+      instanceId: string;
+      data: RuntimeServiceExplainSecurityBody;
+    }
+  > = (props) => {
+    const { instanceId, data } = props ?? {};
+
+    return runtimeServiceExplainSecurity(instanceId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof runtimeServiceExplainSecurity>>,
+    TError,
+    {
+      instanceId: string;
+      data: RuntimeServiceExplainSecurityBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary CreateTrigger submits a refresh trigger, which will asynchronously refresh the specified resources.
 Triggers are ephemeral resources that will be cleaned up by the controller.